@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/controller"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/create"
+	"github.com/weaveworks/eksctl/pkg/ctl/delete"
+	"github.com/weaveworks/eksctl/pkg/ctl/upgrade"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+)
+
+func controllerCmd(cmd *cmdutils.Cmd) {
+	var (
+		kubeconfig, kubeContext, namespace string
+		metricsListen                      string
+		resyncPeriod                       time.Duration
+		installCRD                         bool
+	)
+
+	cmd.SetDescription("controller", "Reconcile EKS clusters with ClusterConfig objects of a Kubernetes cluster (experimental)",
+		"Watch ClusterConfig objects of a management cluster, create the EKS cluster of each object if it does not exist, and converge its version, nodegroups, addons and iamserviceaccounts to the object otherwise, with the AWS credentials of eksctl")
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&kubeconfig, "kubeconfig", "", "path to the kubeconfig of the management cluster, defaults to the in-cluster config or the default kubeconfig")
+		fs.StringVar(&kubeContext, "context", "", "context of the kubeconfig to use")
+		fs.StringVarP(&namespace, "namespace", "n", "", "namespace of the ClusterConfig objects to watch, defaults to all namespaces")
+		fs.DurationVar(&resyncPeriod, "resync-period", 10*time.Minute, "period at which all ClusterConfig objects are reconciled again")
+		fs.BoolVar(&installCRD, "install-crd", false, "create or update the ClusterConfig CRD before watching its objects")
+		fs.StringVar(&metricsListen, "metrics-listen", "", "address to serve Prometheus metrics on at /metrics, e.g. :9090")
+	})
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		cmdutils.SetUnattended("eksctl controller")
+
+		loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+		loadingRules.ExplicitPath = kubeconfig
+		restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{CurrentContext: kubeContext}).ClientConfig()
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if installCRD {
+			crdClient, err := apiextensionsclientset.NewForConfig(restConfig)
+			if err != nil {
+				return err
+			}
+			if err := controller.InstallCRD(ctx, crdClient); err != nil {
+				return err
+			}
+			logger.Info("installed CRD %q", controller.CustomResourceDefinition().Name)
+		}
+
+		if metricsListen != "" {
+			go serveMetrics(metricsListen)
+		}
+
+		client, err := dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return err
+		}
+		actions := &commandActions{providerConfig: cmd.ProviderConfig}
+		return controller.New(client, actions, namespace, resyncPeriod).Run(ctx)
+	}
+}
+
+func serveMetrics(listen string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	logger.Info("serving metrics on %s", listen)
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           mux,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	if err := server.ListenAndServe(); err != nil {
+		logger.Warning("serving metrics: %v", err)
+	}
+}
+
+// commandActions reconciles clusters by running eksctl commands in the eksctl process
+type commandActions struct {
+	providerConfig api.ProviderConfig
+}
+
+// ClusterStatus returns the status of the EKS cluster, or CREATING if it does not exist yet but its stack is being
+// created
+func (a *commandActions) ClusterStatus(cfg *api.ClusterConfig) (string, error) {
+	providerConfig := a.providerConfig
+	providerConfig.Region = cfg.Metadata.Region
+	ctl, err := eks.New(&providerConfig, cfg)
+	if err != nil {
+		return "", err
+	}
+	cluster, err := ctl.DescribeControlPlane(cfg.Metadata)
+	if err == nil {
+		return aws.StringValue(cluster.Status), nil
+	}
+	if awsError, ok := errors.Cause(err).(awserr.Error); !ok || awsError.Code() != awseks.ErrCodeResourceNotFoundException {
+		return "", err
+	}
+	stack, err := ctl.NewStackManager(cfg).DescribeClusterStack()
+	if err != nil {
+		return "", err
+	}
+	if stack != nil && aws.StringValue(stack.StackStatus) == cloudformation.StackStatusCreateInProgress {
+		return awseks.ClusterStatusCreating, nil
+	}
+	return "", nil
+}
+
+func (a *commandActions) CreateCluster(cfg *api.ClusterConfig) error {
+	return runInProcess(create.Command, cfg, a.providerConfig.Profile, "cluster")
+}
+
+// UpdateCluster upgrades the control plane to the version of the ClusterConfig, creates its nodegroups,
+// iamserviceaccounts and addons missing from the cluster, and deletes the nodegroups and iamserviceaccounts removed
+// from it; the create commands skip the existing nodegroups and iamserviceaccounts, and existing addons are updated
+func (a *commandActions) UpdateCluster(cfg *api.ClusterConfig) error {
+	profile := a.providerConfig.Profile
+	if cfg.Metadata.Version != "" {
+		if err := runInProcess(upgrade.Command, cfg, profile, "cluster", "--approve"); err != nil {
+			return err
+		}
+	}
+	if len(cfg.NodeGroups) > 0 || len(cfg.ManagedNodeGroups) > 0 {
+		if err := runInProcess(create.Command, cfg, profile, "nodegroup"); err != nil {
+			return err
+		}
+	}
+	if err := runInProcess(delete.Command, cfg, profile, "nodegroup", "--only-missing", "--approve"); err != nil {
+		return err
+	}
+	if cfg.IAM != nil && len(cfg.IAM.ServiceAccounts) > 0 {
+		if err := runInProcess(create.Command, cfg, profile, "iamserviceaccount", "--approve"); err != nil {
+			return err
+		}
+	}
+	if cfg.IAM != nil && api.IsEnabled(cfg.IAM.WithOIDC) {
+		if err := runInProcess(delete.Command, cfg, profile, "iamserviceaccount", "--only-missing", "--approve"); err != nil {
+			return err
+		}
+	}
+	if len(cfg.Addons) > 0 {
+		if err := runInProcess(create.Command, cfg, profile, "addon", "--if-not-exists"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
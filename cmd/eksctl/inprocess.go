@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// runInProcess runs an eksctl command with the ClusterConfig as its config file in the eksctl process, e.g.
+// runInProcess(create.Command, cfg, profile, "nodegroup") runs eksctl create nodegroup --config-file <cfg>
+func runInProcess(verbCmd func(*cmdutils.FlagGrouping) *cobra.Command, cfg *api.ClusterConfig, profile string, args ...string) error {
+	configFile, err := os.CreateTemp("", "eksctl-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(configFile.Name())
+
+	cfg.TypeMeta = api.ClusterConfigTypeMeta()
+	if err := json.NewEncoder(configFile).Encode(cfg); err != nil {
+		_ = configFile.Close()
+		return errors.Wrap(err, "writing ClusterConfig")
+	}
+	if err := configFile.Close(); err != nil {
+		return err
+	}
+
+	args = append(args, "--config-file", configFile.Name())
+	if profile != "" {
+		args = append(args, "--profile", profile)
+	}
+	cmd := verbCmd(cmdutils.NewGrouping())
+	cmd.SetArgs(args)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	return cmd.Execute()
+}
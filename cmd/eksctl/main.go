@@ -1,18 +1,24 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/fatih/color"
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/eksctl/pkg/ctl/deregister"
 	"github.com/weaveworks/eksctl/pkg/ctl/register"
+	"golang.org/x/term"
 
 	"github.com/weaveworks/eksctl/pkg/actions/anywhere"
 	"github.com/weaveworks/eksctl/pkg/ctl/associate"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/completion"
+	"github.com/weaveworks/eksctl/pkg/ctl/config"
 	"github.com/weaveworks/eksctl/pkg/ctl/create"
 	"github.com/weaveworks/eksctl/pkg/ctl/delete"
 	"github.com/weaveworks/eksctl/pkg/ctl/disassociate"
@@ -25,6 +31,9 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/update"
 	"github.com/weaveworks/eksctl/pkg/ctl/upgrade"
 	"github.com/weaveworks/eksctl/pkg/ctl/utils"
+	"github.com/weaveworks/eksctl/pkg/logging"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/utils/operation"
 )
 
 func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
@@ -43,12 +52,15 @@ func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
 	rootCmd.AddCommand(register.Command(flagGrouping))
 	rootCmd.AddCommand(deregister.Command(flagGrouping))
 	rootCmd.AddCommand(utils.Command(flagGrouping))
+	rootCmd.AddCommand(config.Command(flagGrouping))
 	rootCmd.AddCommand(completion.Command(rootCmd))
 	//Ensures "eksctl --help" presents eksctl anywhere as a command, but adds no subcommands since we invoke the binary.
 	rootCmd.AddCommand(cmdutils.NewVerbCmd("anywhere", "EKS anywhere", ""))
 
 	cmdutils.AddResourceCmd(flagGrouping, rootCmd, infoCmd)
 	cmdutils.AddResourceCmd(flagGrouping, rootCmd, versionCmd)
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, serveCmd)
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, controllerCmd)
 }
 
 func main() {
@@ -60,6 +72,9 @@ func main() {
 				logger.Debug("ignoring cobra error %q", err.Error())
 			}
 		},
+		PersistentPreRun: func(c *cobra.Command, _ []string) {
+			operation.Start(c.CommandPath(), time.Now())
+		},
 		SilenceUsage: true,
 	}
 
@@ -82,23 +97,113 @@ func main() {
 
 	addCommands(rootCmd, flagGrouping)
 	checkCommand(rootCmd)
+	completion.RegisterDynamicCompletions(rootCmd, completion.NewInventory())
 
 	rootCmd.PersistentFlags().BoolP("help", "h", false, "help for this command")
 
-	loggerLevel := rootCmd.PersistentFlags().IntP("verbose", "v", 3, "set log level, use 0 to silence, 4 for debugging and 5 for debugging with AWS debug logging")
-	colorValue := rootCmd.PersistentFlags().StringP("color", "C", "true", "toggle colorized logs (valid options: true, false, fabulous)")
+	var loggingOptions logging.Options
+	rootCmd.PersistentFlags().IntVarP(&loggingOptions.Level, "verbose", "v", 3, "set log level, use 0 to silence, 4 for debugging and 5 for debugging with AWS debug logging")
+	rootCmd.PersistentFlags().StringVarP(&loggingOptions.Color, "color", "C", "true", "toggle colorized logs (valid options: true, false, fabulous)")
+	rootCmd.PersistentFlags().StringVar(&loggingOptions.Format, "log-format", logging.FormatText, "format of the logs (valid options: text, json)")
+	rootCmd.PersistentFlags().StringToStringVar(&loggingOptions.SubsystemLevels, "log-level", nil, fmt.Sprintf("set log level per subsystem, overriding --verbose, e.g. cfn=debug,k8s=warning (valid subsystems: %s)", strings.Join(logging.Subsystems(), ", ")))
+
+	var noColor bool
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colors, same as --color=false")
+
+	var progress bool
+	rootCmd.PersistentFlags().BoolVar(&progress, "progress", false, "render the progress of tasks and a summary of the command instead of information log lines")
+
+	var nonInteractive bool
+	rootCmd.PersistentFlags().BoolVar(&nonInteractive, "non-interactive", false, "fail instead of prompting for input, log without colors to stderr, print a JSON summary to stdout and exit with a code per class of failure")
+
+	var autoApprove bool
+	rootCmd.PersistentFlags().BoolVar(&autoApprove, "auto-approve", false, "execute the change sets of CloudFormation stack updates without prompting for confirmation once their changes are printed")
+
+	var metricsFile string
+	rootCmd.PersistentFlags().StringVar(&metricsFile, "metrics-file", "", "write Prometheus metrics of the command, such as the durations of its stacks and its AWS API calls, to the file once it is done, e.g. for the textfile collector of the node exporter")
+
+	var allowReplacement bool
+	rootCmd.PersistentFlags().BoolVar(&allowReplacement, "allow-replacement", false, "allow CloudFormation stack updates to replace or remove the VPC, the subnets and the control plane of clusters, which their stack policy denies otherwise")
 
 	cobra.OnInitialize(func() {
-		initLogger(*loggerLevel, *colorValue)
+		if nonInteractive {
+			loggingOptions.Color = "false"
+			cmdutils.SetNonInteractive()
+		}
+		if autoApprove {
+			cmdutils.SetAutoApprove()
+		}
+		if allowReplacement {
+			cmdutils.SetAllowReplacement()
+		}
+		if noColor {
+			loggingOptions.Color = "false"
+		}
+		if progress {
+			if nonInteractive || loggingOptions.Format == logging.FormatJSON {
+				fmt.Println("--progress cannot be used with --non-interactive or --log-format=json")
+				os.Exit(1)
+			}
+			// the progress of tasks replaces the information log lines, unless asked for
+			if !rootCmd.PersistentFlags().Changed("verbose") {
+				loggingOptions.Level = 2
+			}
+		}
+		if err := logging.Configure(loggingOptions); err != nil {
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+		if loggingOptions.Color == "false" {
+			color.NoColor = true
+		}
+		if nonInteractive {
+			// stdout only holds the summary
+			logger.Writer = os.Stderr
+		}
+		if progress {
+			logger.Writer = logging.EnableProgress(os.Stderr, term.IsTerminal(int(os.Stderr.Fd())), loggingOptions.Color == "true")
+		}
 	})
 
 	rootCmd.SetUsageFunc(flagGrouping.Usage)
 
-	if err := rootCmd.Execute(); err != nil {
+	startTime := time.Now()
+	cmd, err := rootCmd.ExecuteC()
+	if progress {
+		logging.FinishProgress(cmd.CommandPath(), startTime, err)
+	}
+	if metricsFile != "" {
+		writeMetrics(metricsFile, cmd.CommandPath(), startTime, err)
+	}
+	if nonInteractive {
+		printSummary(cmdutils.NewSummary(cmd.CommandPath(), startTime, err))
+		os.Exit(cmdutils.ExitCode(err))
+	}
+	if err != nil {
 		os.Exit(1)
 	}
 }
 
+func writeMetrics(path, command string, startTime time.Time, err error) {
+	reason := ""
+	if err != nil {
+		reason = cmdutils.FailureClass(err)
+	}
+	metrics.ObserveOperation(command, time.Since(startTime), reason)
+	if err := metrics.WriteFile(path); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write metrics: %v\n", err)
+	}
+}
+
+func printSummary(summary cmdutils.Summary) {
+	data, err := json.Marshal(summary)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to print summary: %v\n", err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
 func checkCommand(rootCmd *cobra.Command) {
 	for _, cmd := range rootCmd.Commands() {
 		// just a precaution as the verb command didn't have runE
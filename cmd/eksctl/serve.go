@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/create"
+	"github.com/weaveworks/eksctl/pkg/server"
+)
+
+func serveCmd(cmd *cmdutils.Cmd) {
+	var listen, tlsCertFile, tlsKeyFile, tokenAuthFile, authCommand string
+
+	cmd.SetDescription("serve", "Serve the eksctl API over HTTP(S)",
+		"Serve an API to list and get clusters, plan the stacks of a ClusterConfig and create its cluster, with the AWS credentials of eksctl, to drive eksctl from other programs without running a command per request")
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&listen, "listen", "127.0.0.1:8080", "address to listen on")
+		fs.StringVar(&tlsCertFile, "tls-cert-file", "", "file with the x509 certificate to serve HTTPS with, HTTP is served if unset")
+		fs.StringVar(&tlsKeyFile, "tls-private-key-file", "", "file with the private key of --tls-cert-file")
+		fs.StringVar(&tokenAuthFile, "token-auth-file", "", "CSV file with a bearer token and a user name per line, to authenticate requests with")
+		fs.StringVar(&authCommand, "auth-command", "", fmt.Sprintf("command authenticating the bearer token of requests, set in %s, by printing the user name and exiting with a zero status", server.AuthTokenEnvVar))
+	})
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		cmdutils.SetUnattended("eksctl serve")
+
+		var authenticator server.Authenticator
+		switch {
+		case tokenAuthFile != "" && authCommand != "":
+			return fmt.Errorf("--token-auth-file and --auth-command %s", cmdutils.IncompatibleFlags)
+		case tokenAuthFile != "":
+			tokenAuthenticator, err := server.NewTokenFileAuthenticator(tokenAuthFile)
+			if err != nil {
+				return err
+			}
+			authenticator = tokenAuthenticator
+		case authCommand != "":
+			authenticator = server.NewCommandAuthenticator(authCommand)
+		default:
+			return errors.New("one of --token-auth-file and --auth-command must be set")
+		}
+
+		backend := &server.EKSBackend{
+			ProviderConfig: cmd.ProviderConfig,
+			ApplyFunc: func(cfg *api.ClusterConfig) error {
+				return createCluster(cfg, cmd.ProviderConfig.Profile)
+			},
+		}
+
+		if (tlsCertFile == "") != (tlsKeyFile == "") {
+			return errors.New("--tls-cert-file and --tls-private-key-file must be set together")
+		}
+
+		listener, err := net.Listen("tcp", listen)
+		if err != nil {
+			return err
+		}
+		if tlsCertFile != "" {
+			if listener, err = server.NewTLSListener(listener, tlsCertFile, tlsKeyFile); err != nil {
+				return err
+			}
+			logger.Info("serving the eksctl API on https://%s", listener.Addr())
+		} else {
+			if !server.IsLoopback(listener.Addr()) {
+				logger.Warning("serving plain HTTP on %s, which sends bearer tokens unencrypted over the network; set --tls-cert-file and --tls-private-key-file to serve HTTPS", listener.Addr())
+			}
+			logger.Info("serving the eksctl API on http://%s", listener.Addr())
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		return server.New(backend, authenticator).Serve(ctx, listener)
+	}
+}
+
+// createCluster runs eksctl create cluster with the ClusterConfig in the eksctl process
+func createCluster(cfg *api.ClusterConfig, profile string) error {
+	return runInProcess(create.Command, cfg, profile, "cluster")
+}
@@ -73,7 +73,7 @@ func (p *Params) GenerateKubeconfigPath() {
 // provided to this `Params` object.
 func (p *Params) GenerateCommands() {
 	p.EksctlCmd = runner.NewCmd(p.EksctlPath).
-		WithArgs("--region", p.Region).
+		WithArgs("--region", p.Region, "--auto-approve").
 		WithTimeout(30 * time.Minute)
 
 	p.EksctlHelpCmd = runner.NewCmd(p.EksctlPath).
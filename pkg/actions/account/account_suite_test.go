@@ -0,0 +1,11 @@
+package account_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestAccount(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
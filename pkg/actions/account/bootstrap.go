@@ -0,0 +1,260 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	autoScalingServiceLinkedRole = "AWSServiceRoleForAutoScaling"
+
+	// KMSGrantName is the name of the KMS grants allowing the Auto Scaling service-linked role to use keys
+	KMSGrantName = "eksctl-autoscaling-ebs-encryption"
+
+	// LogResourcePolicyName is the name of the CloudWatch Logs resource policy allowing AWS services to
+	// deliver logs to the log groups of the account
+	LogResourcePolicyName = "eksctl-log-delivery"
+
+	// maxLogResourcePolicies is the number of CloudWatch Logs resource policies allowed per region
+	maxLogResourcePolicies = 10
+)
+
+type serviceLinkedRole struct {
+	name    string
+	service string
+}
+
+// serviceLinkedRoles are the service-linked roles used by clusters, nodegroups and Fargate profiles. They are
+// created on first use, which fails if the caller is not allowed to create them
+var serviceLinkedRoles = []serviceLinkedRole{
+	{name: "AWSServiceRoleForAmazonEKS", service: "eks.amazonaws.com"},
+	{name: "AWSServiceRoleForAmazonEKSNodegroup", service: "eks-nodegroup.amazonaws.com"},
+	{name: "AWSServiceRoleForAmazonEKSForFargate", service: "eks-fargate.amazonaws.com"},
+	{name: autoScalingServiceLinkedRole, service: "autoscaling.amazonaws.com"},
+	{name: "AWSServiceRoleForEC2Spot", service: "spot.amazonaws.com"},
+}
+
+// kmsGrantOperations are the operations required to launch instances with volumes encrypted with a key
+var kmsGrantOperations = []string{
+	kms.GrantOperationEncrypt,
+	kms.GrantOperationDecrypt,
+	kms.GrantOperationReEncryptFrom,
+	kms.GrantOperationReEncryptTo,
+	kms.GrantOperationGenerateDataKey,
+	kms.GrantOperationGenerateDataKeyWithoutPlaintext,
+	kms.GrantOperationDescribeKey,
+	kms.GrantOperationCreateGrant,
+}
+
+// Bootstrapper creates the account-scoped prerequisites of clusters
+type Bootstrapper struct {
+	provider api.ClusterProvider
+}
+
+// New creates a new Bootstrapper
+func New(provider api.ClusterProvider) *Bootstrapper {
+	return &Bootstrapper{
+		provider: provider,
+	}
+}
+
+// Bootstrap creates the prerequisites that do not exist yet: the service-linked roles, the KMS grants allowing
+// the Auto Scaling service-linked role to use the default EBS encryption key of the region and kmsKeyIDs, and the
+// CloudWatch Logs resource policy. Service-linked roles are global, the other prerequisites are regional
+func (b *Bootstrapper) Bootstrap(kmsKeyIDs []string) error {
+	for _, role := range serviceLinkedRoles {
+		if err := b.ensureServiceLinkedRole(role); err != nil {
+			return err
+		}
+	}
+
+	identity, err := b.provider.STSV2().GetCallerIdentity(context.TODO(), &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return errors.Wrap(err, "getting the caller identity")
+	}
+	accountID := aws.StringValue(identity.Account)
+
+	if err := b.ensureKMSGrants(accountID, kmsKeyIDs); err != nil {
+		return err
+	}
+	return b.ensureLogResourcePolicy(accountID)
+}
+
+func (b *Bootstrapper) ensureServiceLinkedRole(role serviceLinkedRole) error {
+	_, err := b.provider.IAM().GetRole(&iam.GetRoleInput{RoleName: aws.String(role.name)})
+	if err == nil {
+		logger.Info("service-linked role %q already exists", role.name)
+		return nil
+	}
+	if !isErrCode(err, iam.ErrCodeNoSuchEntityException) {
+		return errors.Wrapf(err, "getting service-linked role %q", role.name)
+	}
+
+	if _, err := b.provider.IAM().CreateServiceLinkedRole(&iam.CreateServiceLinkedRoleInput{
+		AWSServiceName: aws.String(role.service),
+	}); err != nil {
+		// the role is created concurrently, e.g. by a service on first use
+		if isErrCode(err, iam.ErrCodeInvalidInputException) && strings.Contains(err.Error(), "has been taken") {
+			logger.Info("service-linked role %q already exists", role.name)
+			return nil
+		}
+		return errors.Wrapf(err, "creating service-linked role %q", role.name)
+	}
+	logger.Info("created service-linked role %q", role.name)
+	return nil
+}
+
+// ensureKMSGrants allows the Auto Scaling service-linked role, which launches the instances of both managed and
+// self-managed nodegroups, to use the customer managed keys encrypting their volumes
+func (b *Bootstrapper) ensureKMSGrants(accountID string, kmsKeyIDs []string) error {
+	keyIDs := kmsKeyIDs
+	encryption, err := b.provider.EC2().GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
+	if err != nil {
+		return errors.Wrap(err, "checking EBS encryption by default")
+	}
+	if aws.BoolValue(encryption.EbsEncryptionByDefault) {
+		output, err := b.provider.EC2().GetEbsDefaultKmsKeyId(&ec2.GetEbsDefaultKmsKeyIdInput{})
+		if err != nil {
+			return errors.Wrap(err, "getting the default KMS key for EBS encryption")
+		}
+		keyIDs = append([]string{aws.StringValue(output.KmsKeyId)}, keyIDs...)
+	}
+
+	grantee := fmt.Sprintf("arn:%s:iam::%s:role/aws-service-role/autoscaling.amazonaws.com/%s", api.Partition(b.provider.Region()), accountID, autoScalingServiceLinkedRole)
+	for _, keyID := range keyIDs {
+		if err := b.ensureKMSGrant(keyID, grantee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Bootstrapper) ensureKMSGrant(keyID, grantee string) error {
+	key, err := b.provider.KMS().DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		return errors.Wrapf(err, "describing KMS key %q", keyID)
+	}
+	if aws.StringValue(key.KeyMetadata.KeyManager) == kms.KeyManagerTypeAws {
+		logger.Info("KMS key %q is managed by AWS and can be used by the %s service-linked role", keyID, autoScalingServiceLinkedRole)
+		return nil
+	}
+	keyARN := key.KeyMetadata.Arn
+
+	granted := false
+	if err := b.provider.KMS().ListGrantsPages(&kms.ListGrantsInput{KeyId: keyARN}, func(page *kms.ListGrantsResponse, _ bool) bool {
+		for _, grant := range page.Grants {
+			if strings.Contains(aws.StringValue(grant.GranteePrincipal), autoScalingServiceLinkedRole) {
+				granted = true
+				return false
+			}
+		}
+		return true
+	}); err != nil {
+		return errors.Wrapf(err, "listing grants of KMS key %q", keyID)
+	}
+	if granted {
+		logger.Info("KMS key %q already has a grant for the %s service-linked role", keyID, autoScalingServiceLinkedRole)
+		return nil
+	}
+
+	if _, err := b.provider.KMS().CreateGrant(&kms.CreateGrantInput{
+		KeyId:            keyARN,
+		Name:             aws.String(KMSGrantName),
+		GranteePrincipal: aws.String(grantee),
+		Operations:       aws.StringSlice(kmsGrantOperations),
+	}); err != nil {
+		return errors.Wrapf(err, "creating grant on KMS key %q", keyID)
+	}
+	logger.Info("created grant on KMS key %q for the %s service-linked role", keyID, autoScalingServiceLinkedRole)
+	return nil
+}
+
+// ensureLogResourcePolicy allows EventBridge and CloudWatch Logs log delivery to write to the log groups used by
+// EventBridge rules and vended logs
+func (b *Bootstrapper) ensureLogResourcePolicy(accountID string) error {
+	policy, err := logResourcePolicy(api.Partition(b.provider.Region()), b.provider.Region(), accountID)
+	if err != nil {
+		return err
+	}
+
+	var policies []*cloudwatchlogs.ResourcePolicy
+	input := &cloudwatchlogs.DescribeResourcePoliciesInput{}
+	for {
+		output, err := b.provider.CloudWatchLogs().DescribeResourcePolicies(input)
+		if err != nil {
+			return errors.Wrap(err, "describing CloudWatch Logs resource policies")
+		}
+		policies = append(policies, output.ResourcePolicies...)
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	exists := false
+	for _, p := range policies {
+		if aws.StringValue(p.PolicyName) == LogResourcePolicyName {
+			if aws.StringValue(p.PolicyDocument) == policy {
+				logger.Info("CloudWatch Logs resource policy %q already exists", LogResourcePolicyName)
+				return nil
+			}
+			exists = true
+		}
+	}
+	if !exists && len(policies) >= maxLogResourcePolicies {
+		return fmt.Errorf("cannot create CloudWatch Logs resource policy %q, %s already has the maximum of %d resource policies", LogResourcePolicyName, b.provider.Region(), maxLogResourcePolicies)
+	}
+
+	if _, err := b.provider.CloudWatchLogs().PutResourcePolicy(&cloudwatchlogs.PutResourcePolicyInput{
+		PolicyName:     aws.String(LogResourcePolicyName),
+		PolicyDocument: aws.String(policy),
+	}); err != nil {
+		return errors.Wrapf(err, "putting CloudWatch Logs resource policy %q", LogResourcePolicyName)
+	}
+	logger.Info("put CloudWatch Logs resource policy %q", LogResourcePolicyName)
+	return nil
+}
+
+func logResourcePolicy(partition, region, accountID string) (string, error) {
+	logGroupARN := func(prefix string) string {
+		return fmt.Sprintf("arn:%s:logs:%s:%s:log-group:%s*", partition, region, accountID, prefix)
+	}
+	policy, err := json.Marshal(cft.MapOfInterfaces{
+		"Version": "2012-10-17",
+		"Statement": []cft.MapOfInterfaces{
+			{
+				"Sid":    "EKSCTLLogDelivery",
+				"Effect": "Allow",
+				"Principal": cft.MapOfInterfaces{
+					"Service": []string{"events.amazonaws.com", "delivery.logs.amazonaws.com"},
+				},
+				"Action":   []string{"logs:CreateLogStream", "logs:PutLogEvents"},
+				"Resource": []string{logGroupARN("/aws/events/"), logGroupARN("/aws/vendedlogs/")},
+			},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "marshalling CloudWatch Logs resource policy")
+	}
+	return string(policy), nil
+}
+
+func isErrCode(err error, code string) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == code
+}
@@ -0,0 +1,117 @@
+package account_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/account"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Bootstrap account", func() {
+	var (
+		provider *mockprovider.MockProvider
+	)
+
+	mockKMS := func(keyManager string, grants ...*kms.GrantListEntry) {
+		for _, keyID := range []string{"default", "custom"} {
+			provider.MockKMS().On("DescribeKey", &kms.DescribeKeyInput{KeyId: aws.String(keyID)}).Return(&kms.DescribeKeyOutput{
+				KeyMetadata: &kms.KeyMetadata{
+					Arn:        aws.String("arn:aws:kms:us-west-2:123456789012:key/" + keyID),
+					KeyManager: aws.String(keyManager),
+				},
+			}, nil)
+		}
+		provider.MockKMS().On("ListGrantsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(*kms.ListGrantsResponse, bool) bool)
+			fn(&kms.ListGrantsResponse{Grants: grants}, true)
+		}).Return(nil)
+		provider.MockKMS().On("CreateGrant", mock.Anything).Return(&kms.CreateGrantOutput{}, nil)
+	}
+
+	mockLogResourcePolicies := func(policies ...*cloudwatchlogs.ResourcePolicy) {
+		provider.MockCloudWatchLogs().On("DescribeResourcePolicies", mock.Anything).Return(&cloudwatchlogs.DescribeResourcePoliciesOutput{
+			ResourcePolicies: policies,
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+
+		provider.MockIAM().On("GetRole", &iam.GetRoleInput{RoleName: aws.String("AWSServiceRoleForAmazonEKS")}).Return(&iam.GetRoleOutput{}, nil)
+		provider.MockIAM().On("GetRole", mock.Anything).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+		provider.MockIAM().On("CreateServiceLinkedRole", mock.Anything).Return(&iam.CreateServiceLinkedRoleOutput{}, nil)
+		provider.MockSTSV2().On("GetCallerIdentity", mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil)
+		provider.MockEC2().On("GetEbsEncryptionByDefault", mock.Anything).Return(&ec2.GetEbsEncryptionByDefaultOutput{EbsEncryptionByDefault: aws.Bool(true)}, nil)
+		provider.MockEC2().On("GetEbsDefaultKmsKeyId", mock.Anything).Return(&ec2.GetEbsDefaultKmsKeyIdOutput{KmsKeyId: aws.String("default")}, nil)
+		provider.MockCloudWatchLogs().On("PutResourcePolicy", mock.Anything).Return(&cloudwatchlogs.PutResourcePolicyOutput{}, nil)
+	})
+
+	It("creates the missing prerequisites", func() {
+		mockLogResourcePolicies()
+		mockKMS(kms.KeyManagerTypeCustomer)
+		Expect(account.New(provider).Bootstrap([]string{"custom"})).To(Succeed())
+
+		provider.MockIAM().AssertNumberOfCalls(GinkgoT(), "CreateServiceLinkedRole", 4)
+		provider.MockIAM().AssertNotCalled(GinkgoT(), "CreateServiceLinkedRole", &iam.CreateServiceLinkedRoleInput{AWSServiceName: aws.String("eks.amazonaws.com")})
+		provider.MockIAM().AssertCalled(GinkgoT(), "CreateServiceLinkedRole", &iam.CreateServiceLinkedRoleInput{AWSServiceName: aws.String("spot.amazonaws.com")})
+
+		provider.MockKMS().AssertNumberOfCalls(GinkgoT(), "CreateGrant", 2)
+		for _, keyID := range []string{"default", "custom"} {
+			provider.MockKMS().AssertCalled(GinkgoT(), "CreateGrant", mock.MatchedBy(func(input *kms.CreateGrantInput) bool {
+				return *input.KeyId == "arn:aws:kms:us-west-2:123456789012:key/"+keyID &&
+					*input.GranteePrincipal == "arn:aws:iam::123456789012:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"
+			}))
+		}
+
+		provider.MockCloudWatchLogs().AssertCalled(GinkgoT(), "PutResourcePolicy", mock.MatchedBy(func(input *cloudwatchlogs.PutResourcePolicyInput) bool {
+			return *input.PolicyName == account.LogResourcePolicyName
+		}))
+	})
+
+	It("does not create grants on keys managed by AWS or already granted", func() {
+		mockLogResourcePolicies()
+		mockKMS(kms.KeyManagerTypeCustomer, &kms.GrantListEntry{
+			GranteePrincipal: aws.String("arn:aws:iam::123456789012:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"),
+		})
+		Expect(account.New(provider).Bootstrap(nil)).To(Succeed())
+		provider.MockKMS().AssertNotCalled(GinkgoT(), "CreateGrant", mock.Anything)
+
+		provider.MockKMS().ExpectedCalls = nil
+		provider.MockKMS().Calls = nil
+		mockKMS(kms.KeyManagerTypeAws)
+		Expect(account.New(provider).Bootstrap(nil)).To(Succeed())
+		provider.MockKMS().AssertNotCalled(GinkgoT(), "CreateGrant", mock.Anything)
+		provider.MockKMS().AssertNotCalled(GinkgoT(), "ListGrantsPages", mock.Anything, mock.Anything)
+	})
+
+	It("fails when the region has the maximum number of log resource policies", func() {
+		mockKMS(kms.KeyManagerTypeCustomer)
+		var policies []*cloudwatchlogs.ResourcePolicy
+		for i := 0; i < 10; i++ {
+			policies = append(policies, &cloudwatchlogs.ResourcePolicy{PolicyName: aws.String("other")})
+		}
+		mockLogResourcePolicies(policies...)
+
+		err := account.New(provider).Bootstrap(nil)
+		Expect(err).To(MatchError(ContainSubstring("already has the maximum of 10 resource policies")))
+		provider.MockCloudWatchLogs().AssertNotCalled(GinkgoT(), "PutResourcePolicy", mock.Anything)
+	})
+
+	It("returns an error when creating a service-linked role fails", func() {
+		provider.MockIAM().ExpectedCalls = nil
+		provider.MockIAM().On("GetRole", mock.Anything).Return(nil, awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil))
+		provider.MockIAM().On("CreateServiceLinkedRole", mock.Anything).Return(nil, awserr.New("AccessDenied", "denied", nil))
+
+		err := account.New(provider).Bootstrap(nil)
+		Expect(err).To(MatchError(ContainSubstring(`creating service-linked role "AWSServiceRoleForAmazonEKS"`)))
+	})
+})
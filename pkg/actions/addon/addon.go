@@ -132,7 +132,7 @@ func supportedVersion(version string) error {
 }
 
 func (a *Manager) makeAddonName(name string) string {
-	return fmt.Sprintf("eksctl-%s-addon-%s", a.clusterConfig.Metadata.Name, name)
+	return a.clusterConfig.StackName(fmt.Sprintf("eksctl-%s-addon-%s", a.clusterConfig.Metadata.Name, name))
 }
 
 func (a *Manager) parseVersion(v string) (*version.Version, error) {
@@ -0,0 +1,136 @@
+package addon
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// The shapes of the EKS operations reading and setting the configuration values of addons, sent with
+// eks.SendEKSRequest, mirror those of the SDK with the configuration values added
+
+type addonConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	AddonVersion        *string `locationName:"addonVersion" type:"string"`
+	ConfigurationValues *string `locationName:"configurationValues" type:"string"`
+}
+
+type describeAddonConfigurationInput struct {
+	_ struct{} `type:"structure" nopayload:"true"`
+
+	AddonName   *string `location:"uri" locationName:"addonName" type:"string" required:"true"`
+	ClusterName *string `location:"uri" locationName:"name" min:"1" type:"string" required:"true"`
+}
+
+type describeAddonConfigurationOutput struct {
+	_ struct{} `type:"structure"`
+
+	Addon *addonConfiguration `locationName:"addon" type:"structure"`
+}
+
+type createAddonWithConfigurationInput struct {
+	_ struct{} `type:"structure"`
+
+	AddonName             *string            `locationName:"addonName" type:"string" required:"true"`
+	AddonVersion          *string            `locationName:"addonVersion" type:"string"`
+	ClientRequestToken    *string            `locationName:"clientRequestToken" type:"string" idempotencyToken:"true"`
+	ClusterName           *string            `location:"uri" locationName:"name" min:"1" type:"string" required:"true"`
+	ConfigurationValues   *string            `locationName:"configurationValues" type:"string"`
+	ResolveConflicts      *string            `locationName:"resolveConflicts" type:"string"`
+	ServiceAccountRoleArn *string            `locationName:"serviceAccountRoleArn" min:"1" type:"string"`
+	Tags                  map[string]*string `locationName:"tags" min:"1" type:"map"`
+}
+
+type createAddonWithConfigurationOutput struct {
+	_ struct{} `type:"structure"`
+
+	Addon *awseks.Addon `locationName:"addon" type:"structure"`
+}
+
+type updateAddonWithConfigurationInput struct {
+	_ struct{} `type:"structure"`
+
+	AddonName             *string `location:"uri" locationName:"addonName" type:"string" required:"true"`
+	AddonVersion          *string `locationName:"addonVersion" type:"string"`
+	ClientRequestToken    *string `locationName:"clientRequestToken" type:"string" idempotencyToken:"true"`
+	ClusterName           *string `location:"uri" locationName:"name" min:"1" type:"string" required:"true"`
+	ConfigurationValues   *string `locationName:"configurationValues" type:"string"`
+	ResolveConflicts      *string `locationName:"resolveConflicts" type:"string"`
+	ServiceAccountRoleArn *string `locationName:"serviceAccountRoleArn" min:"1" type:"string"`
+}
+
+type updateAddonWithConfigurationOutput struct {
+	_ struct{} `type:"structure"`
+
+	Update *awseks.Update `locationName:"update" type:"structure"`
+}
+
+// describeConfiguration returns the version and the configuration values of the addon
+func (a *Manager) describeConfiguration(addon *api.Addon) (*addonConfiguration, error) {
+	output := &describeAddonConfigurationOutput{}
+	err := eks.SendEKSRequest(a.eksAPI, &request.Operation{
+		Name:       "DescribeAddon",
+		HTTPMethod: "GET",
+		HTTPPath:   "/clusters/{name}/addons/{addonName}",
+	}, &describeAddonConfigurationInput{
+		AddonName:   &addon.Name,
+		ClusterName: &a.clusterConfig.Metadata.Name,
+	}, output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addon %q: %v", addon.Name, err)
+	}
+	if output.Addon == nil {
+		return nil, fmt.Errorf("unexpected response from EKS API - no addon returned for %q", addon.Name)
+	}
+	return output.Addon, nil
+}
+
+// createAddonWithConfiguration creates the addon like awseks.CreateAddon, with the configuration values of the addon
+func (a *Manager) createAddonWithConfiguration(input *awseks.CreateAddonInput, configurationValues string) error {
+	output := &createAddonWithConfigurationOutput{}
+	err := eks.SendEKSRequest(a.eksAPI, &request.Operation{
+		Name:       "CreateAddon",
+		HTTPMethod: "POST",
+		HTTPPath:   "/clusters/{name}/addons",
+	}, &createAddonWithConfigurationInput{
+		AddonName:             input.AddonName,
+		AddonVersion:          input.AddonVersion,
+		ClusterName:           input.ClusterName,
+		ConfigurationValues:   aws.String(configurationValues),
+		ResolveConflicts:      input.ResolveConflicts,
+		ServiceAccountRoleArn: input.ServiceAccountRoleArn,
+		Tags:                  input.Tags,
+	}, output)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create addon %q", aws.StringValue(input.AddonName))
+	}
+	return nil
+}
+
+// updateAddonWithConfiguration updates the addon like awseks.UpdateAddon, with the configuration values of the addon
+func (a *Manager) updateAddonWithConfiguration(input *awseks.UpdateAddonInput, configurationValues string) error {
+	output := &updateAddonWithConfigurationOutput{}
+	err := eks.SendEKSRequest(a.eksAPI, &request.Operation{
+		Name:       "UpdateAddon",
+		HTTPMethod: "POST",
+		HTTPPath:   "/clusters/{name}/addons/{addonName}/update",
+	}, &updateAddonWithConfigurationInput{
+		AddonName:             input.AddonName,
+		AddonVersion:          input.AddonVersion,
+		ClusterName:           input.ClusterName,
+		ConfigurationValues:   aws.String(configurationValues),
+		ResolveConflicts:      input.ResolveConflicts,
+		ServiceAccountRoleArn: input.ServiceAccountRoleArn,
+	}, output)
+	if err != nil {
+		return fmt.Errorf("failed to update addon %q: %v", aws.StringValue(input.AddonName), err)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package addon_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Configuration values", func() {
+	var (
+		addonManager *addon.Manager
+		server       *httptest.Server
+		requests     []string
+		bodies       map[string]map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		bodies = map[string]map[string]interface{}{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request := r.Method + " " + r.URL.Path
+			requests = append(requests, request)
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			if len(body) > 0 {
+				var decoded map[string]interface{}
+				Expect(json.Unmarshal(body, &decoded)).To(Succeed())
+				bodies[request] = decoded
+			}
+
+			switch request {
+			case "GET /clusters/my-cluster/addons/my-addon":
+				_, _ = w.Write([]byte(`{"addon": {
+					"addonName": "my-addon",
+					"addonVersion": "v1.7.5-eksbuild.1",
+					"status": "ACTIVE",
+					"configurationValues": "{\"replicaCount\": 2, \"resources\": {\"limits\": {\"memory\": \"170Mi\"}}, \"tolerations\": [{\"key\": \"a\"}]}"
+				}}`))
+			case "GET /addons/supported-versions":
+				_, _ = w.Write([]byte(`{"addons": [{"addonName": "my-addon", "addonVersions": [
+					{"addonVersion": "v1.7.5-eksbuild.1"},
+					{"addonVersion": "v1.7.7-eksbuild.2"}
+				]}]}`))
+			case "POST /clusters/my-cluster/addons/my-addon/update":
+				_, _ = w.Write([]byte(`{"update": {"id": "u123", "status": "InProgress"}}`))
+			case "POST /clusters/my-cluster/addons":
+				_, _ = w.Write([]byte(`{"addon": {"addonName": "my-addon", "status": "CREATING"}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		sess := session.Must(session.NewSession(&aws.Config{
+			Region:      aws.String("us-west-2"),
+			Endpoint:    aws.String(server.URL),
+			Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		}))
+		var err error
+		addonManager, err = addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
+			Version: "1.21",
+			Name:    "my-cluster",
+		}}, awseks.New(sess), &fakes.FakeStackManager{}, false, nil, nil, 5*time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("PlanUpdate", func() {
+		It("returns the changes of the version and the configuration values", func() {
+			plan, err := addonManager.PlanUpdate(&api.Addon{
+				Name:                "my-addon",
+				Version:             "latest",
+				ConfigurationValues: "replicaCount: 3\nresources:\n  limits:\n    memory: 170Mi\n  requests:\n    cpu: 100m\n",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(addon.UpdatePlan{
+				Name:           "my-addon",
+				CurrentVersion: "v1.7.5-eksbuild.1",
+				DesiredVersion: "v1.7.7-eksbuild.2",
+				ConfigurationValues: []addon.ValueChange{
+					{Path: "replicaCount", Current: float64(2), Desired: float64(3)},
+					{Path: "resources.requests.cpu", Desired: "100m"},
+					{Path: "tolerations[0].key", Current: "a"},
+				},
+			}))
+			Expect(plan.HasChanges()).To(BeTrue())
+			Expect(plan.Diff()).To(Equal(` - name: my-addon
+-  version: v1.7.5-eksbuild.1
++  version: v1.7.7-eksbuild.2
+   configurationValues:
+-    replicaCount: 2
++    replicaCount: 3
++    resources.requests.cpu: "100m"
+-    tolerations[0].key: "a"
+`))
+		})
+
+		It("keeps the current version and configuration values when they are not set", func() {
+			plan, err := addonManager.PlanUpdate(&api.Addon{Name: "my-addon"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.HasChanges()).To(BeFalse())
+			Expect(plan.DesiredVersion).To(Equal("v1.7.5-eksbuild.1"))
+			Expect(requests).To(Equal([]string{"GET /clusters/my-cluster/addons/my-addon"}))
+		})
+
+		It("does not update the addon", func() {
+			_, err := addonManager.PlanUpdate(&api.Addon{Name: "my-addon", Version: "latest", ConfigurationValues: `{"replicaCount": 3}`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests).NotTo(ContainElement(HavePrefix("POST")))
+		})
+	})
+
+	It("updates addons with their configuration values", func() {
+		Expect(addonManager.Update(&api.Addon{Name: "my-addon", ConfigurationValues: `{"replicaCount": 3}`}, false)).To(Succeed())
+		Expect(bodies).To(HaveKeyWithValue("POST /clusters/my-cluster/addons/my-addon/update", And(
+			HaveKeyWithValue("addonVersion", "v1.7.5-eksbuild.1"),
+			HaveKeyWithValue("configurationValues", `{"replicaCount": 3}`),
+		)))
+	})
+
+	It("creates addons with their configuration values", func() {
+		Expect(addonManager.Create(&api.Addon{Name: "my-addon", Version: "latest", ConfigurationValues: `{"replicaCount": 3}`}, false)).To(Succeed())
+		Expect(bodies).To(HaveKeyWithValue("POST /clusters/my-cluster/addons", And(
+			HaveKeyWithValue("addonName", "my-addon"),
+			HaveKeyWithValue("addonVersion", "v1.7.7-eksbuild.2"),
+			HaveKeyWithValue("configurationValues", `{"replicaCount": 3}`),
+		)))
+	})
+
+	It("creates the adot addon with configuration values enabling the collectors of its pipelines", func() {
+		Expect(addonManager.Create(&api.Addon{
+			Name:    "my-addon",
+			Version: "latest",
+			ADOT: &api.ADOTAddonConfig{
+				Pipelines: api.ADOTPipelines{XRay: &api.ADOTXRayPipeline{}},
+			},
+		}, false)).To(Succeed())
+		Expect(bodies).To(HaveKeyWithValue("POST /clusters/my-cluster/addons", And(
+			HaveKeyWithValue("configurationValues", `{"collector":{"xray":{"enabled":true}}}`),
+			Not(HaveKey("serviceAccountRoleArn")),
+		)))
+	})
+
+	It("merges the configuration shortcuts into the configuration values of the addon", func() {
+		Expect(addonManager.Create(&api.Addon{
+			Name:                "my-addon",
+			Version:             "latest",
+			ConfigurationValues: `{"replicaCount": 2, "resources": {"limits": {"memory": "170Mi"}}}`,
+			CoreDNS:             &api.CoreDNSAddonConfig{Replicas: aws.Int(4)},
+		}, false)).To(Succeed())
+		Expect(bodies["POST /clusters/my-cluster/addons"]["configurationValues"]).To(MatchJSON(`{
+			"replicaCount": 4,
+			"resources": {"limits": {"memory": "170Mi"}}
+		}`))
+
+		Expect(addonManager.Update(&api.Addon{
+			Name:      "my-addon",
+			KubeProxy: &api.KubeProxyAddonConfig{Mode: api.KubeProxyModeIPVS},
+		}, false)).To(Succeed())
+		Expect(bodies["POST /clusters/my-cluster/addons/my-addon/update"]["configurationValues"]).To(MatchJSON(`{"mode": "ipvs"}`))
+	})
+
+	It("fails with EKS clients that cannot send requests", func() {
+		var err error
+		addonManager, err = addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
+			Version: "1.21",
+			Name:    "my-cluster",
+		}}, mockprovider.NewMockProvider().EKS(), &fakes.FakeStackManager{}, false, nil, nil, 5*time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = addonManager.PlanUpdate(&api.Addon{Name: "my-addon"})
+		Expect(err).To(MatchError(ContainSubstring("cannot send DescribeAddon requests")))
+	})
+})
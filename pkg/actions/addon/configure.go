@@ -0,0 +1,50 @@
+package addon
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// makeConfigurationValues returns the configuration values to send with the addon. Those of the adot addon are rendered
+// from its pipelines, and the role of the addon is moved from its operator to its collectors. The configuration
+// shortcuts of the kube-proxy and coredns addons are merged into their configuration values, overriding the values
+// they set
+func (a *Manager) makeConfigurationValues(addon *api.Addon, serviceAccountRoleARN **string) (string, error) {
+	var shortcuts map[string]interface{}
+	switch {
+	case addon.ADOT != nil:
+		configurationValues, err := defaultaddons.MakeADOTConfigurationValues(addon.ADOT.Pipelines, aws.StringValue(*serviceAccountRoleARN))
+		if err != nil {
+			return "", errors.Wrapf(err, "rendering the configuration values of addon %q", addon.Name)
+		}
+		*serviceAccountRoleARN = nil
+		return configurationValues, nil
+	case addon.KubeProxy != nil:
+		shortcuts = defaultaddons.KubeProxyConfigurationValues(addon.KubeProxy)
+	case addon.CoreDNS != nil:
+		shortcuts = defaultaddons.CoreDNSConfigurationValues(addon.CoreDNS)
+	default:
+		return addon.ConfigurationValues, nil
+	}
+
+	values := map[string]interface{}{}
+	if addon.ConfigurationValues != "" {
+		if err := yaml.Unmarshal([]byte(addon.ConfigurationValues), &values); err != nil {
+			return "", errors.Wrapf(err, "parsing the configuration values of addon %q", addon.Name)
+		}
+	}
+	for k, v := range shortcuts {
+		values[k] = v
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", errors.Wrapf(err, "rendering the configuration values of addon %q", addon.Name)
+	}
+	return string(data), nil
+}
@@ -11,22 +11,43 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/uuid"
 	"github.com/kris-nova/logger"
 
 	"k8s.io/apimachinery/pkg/types"
 
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 )
 
 const (
-	kubeSystemNamespace = "kube-system"
-	vpcCNIName          = "vpc-cni"
-	ebsCSIDriverName    = "aws-ebs-csi-driver"
+	kubeSystemNamespace  = "kube-system"
+	vpcCNIName           = "vpc-cni"
+	ebsCSIDriverName     = "aws-ebs-csi-driver"
+	adotName             = "adot"
+	podIdentityAgentName = "eks-pod-identity-agent"
+
+	ebsCSIControllerServiceAccount = "ebs-csi-controller-sa"
 )
 
+// CreateOrUpdate creates the addon, or updates it if it already exists
+func (a *Manager) CreateOrUpdate(addon *api.Addon, wait bool) error {
+	exists, err := a.Exists(addon)
+	if err != nil {
+		return err
+	}
+	if exists {
+		logger.Info("addon %q already exists, updating it", addon.Name)
+		return a.Update(addon, wait)
+	}
+	return a.Create(addon, wait)
+}
+
 func (a *Manager) Create(addon *api.Addon, wait bool) error {
 	version := addon.Version
 	if version != "" {
@@ -59,11 +80,20 @@ func (a *Manager) Create(addon *api.Addon, wait bool) error {
 	if len(addon.Tags) > 0 {
 		createAddonInput.Tags = aws.StringMap(addon.Tags)
 	}
-	if a.withOIDC {
+	if len(addon.PodIdentityAssociations) > 0 {
+		roleARN, err := a.reconcilePodIdentityAssociations(addon)
+		if err != nil {
+			return err
+		}
+		if roleARN != "" {
+			createAddonInput.ServiceAccountRoleArn = &roleARN
+		}
+	} else if a.withOIDC {
 		if addon.ServiceAccountRoleARN != "" {
 			logger.Info("using provided ServiceAccountRoleARN %q", addon.ServiceAccountRoleARN)
 			createAddonInput.ServiceAccountRoleArn = &addon.ServiceAccountRoleARN
 		} else if hasPoliciesSet(addon) {
+			logger.Info("creating role using provided policies")
 			outputRole, err := a.createRole(addon, namespace, serviceAccount)
 			if err != nil {
 				return err
@@ -75,22 +105,14 @@ func (a *Manager) Create(addon *api.Addon, wait bool) error {
 				logger.Info("creating role using recommended policies")
 				addon.AttachPolicyARNs = policyARNs
 				addon.AttachPolicy = policyDocument
-				resourceSet := builder.NewIAMRoleResourceSetWithAttachPolicy(addon.Name, namespace, serviceAccount, addon.PermissionsBoundary, addon.AttachPolicy, a.oidcManager)
-				if len(policyARNs) != 0 {
-					resourceSet = builder.NewIAMRoleResourceSetWithAttachPolicyARNs(addon.Name, namespace, serviceAccount, addon.PermissionsBoundary, addon.AttachPolicyARNs, a.oidcManager)
-				}
 				if wellKnownPolicies != nil {
 					addon.WellKnownPolicies = *wellKnownPolicies
-					resourceSet = builder.NewIAMRoleResourceSetWithWellKnownPolicies(addon.Name, namespace, serviceAccount, addon.PermissionsBoundary, addon.WellKnownPolicies, a.oidcManager)
-				}
-				if err := resourceSet.AddAllResources(); err != nil {
-					return err
 				}
-				err := a.createStack(resourceSet, addon)
+				outputRole, err := a.createRole(addon, namespace, serviceAccount)
 				if err != nil {
 					return err
 				}
-				createAddonInput.ServiceAccountRoleArn = &resourceSet.OutputRole
+				createAddonInput.ServiceAccountRoleArn = &outputRole
 			} else {
 				logger.Info("no recommended policies found, proceeding without any IAM")
 			}
@@ -116,23 +138,87 @@ func (a *Manager) Create(addon *api.Addon, wait bool) error {
 		}
 	}
 
-	logger.Info("creating addon")
-	output, err := a.eksAPI.CreateAddon(createAddonInput)
+	configurationValues, err := a.makeConfigurationValues(addon, &createAddonInput.ServiceAccountRoleArn)
 	if err != nil {
-		return errors.Wrapf(err, "failed to create addon %q", addon.Name)
+		return err
 	}
 
-	if output != nil {
-		logger.Debug("EKS Create Addon output: %s", output.String())
+	logger.Info("creating addon")
+	if configurationValues != "" {
+		if err := a.createAddonWithConfiguration(createAddonInput, configurationValues); err != nil {
+			return err
+		}
+	} else {
+		output, err := a.eksAPI.CreateAddon(createAddonInput)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create addon %q", addon.Name)
+		}
+
+		if output != nil {
+			logger.Debug("EKS Create Addon output: %s", output.String())
+		}
 	}
 
 	if wait {
-		return a.waitForAddonToBeActive(addon)
+		if err := a.waitForAddonToBeActive(addon); err != nil {
+			return err
+		}
+		return a.waitForAddonRollout(addon)
 	}
 	logger.Info("successfully created addon")
 	return nil
 }
 
+// reconcilePodIdentityAssociations creates, or updates, the stack of the pod identity associations of the addon. When
+// the cluster doesn't support pod identity and the addon has a single association, IRSA is used instead, and the ARN
+// of the role to use for the service account of the addon is returned
+func (a *Manager) reconcilePodIdentityAssociations(addon *api.Addon) (string, error) {
+	supported, err := a.supportsPodIdentity()
+	if err != nil {
+		return "", err
+	}
+	if supported {
+		logger.Info("reconciling pod identity associations for addon %q", addon.Name)
+		resourceSet := builder.NewPodIdentityAssociationResourceSet(a.clusterConfig.Metadata.Name, addon.Name, addon.PodIdentityAssociations)
+		if err := resourceSet.AddAllResources(); err != nil {
+			return "", err
+		}
+		return "", a.createOrUpdateStack(resourceSet, addon)
+	}
+
+	if !a.withOIDC || len(addon.PodIdentityAssociations) > 1 {
+		return "", fmt.Errorf("podIdentityAssociations of addon %q require the %q addon to be installed", addon.Name, podIdentityAgentName)
+	}
+	p := addon.PodIdentityAssociations[0]
+	logger.Warning("the %q addon is not installed, using IAM Roles for Service Accounts for addon %q instead of pod identity", podIdentityAgentName, addon.Name)
+	if p.RoleARN != "" {
+		return p.RoleARN, nil
+	}
+	resourceSet := builder.NewIAMRoleResourceSetWithPolicies(addon.Name, p.Namespace, p.ServiceAccountName, p.PermissionsBoundaryARN, p.PermissionPolicy, p.PermissionPolicyARNs, p.WellKnownPolicies, a.oidcManager)
+	if err := resourceSet.AddAllResources(); err != nil {
+		return "", err
+	}
+	if err := a.createOrUpdateStack(resourceSet, addon); err != nil {
+		return "", err
+	}
+	return resourceSet.OutputRole, nil
+}
+
+// supportsPodIdentity returns whether the pod identity agent addon is installed in the cluster
+func (a *Manager) supportsPodIdentity() (bool, error) {
+	_, err := a.eksAPI.DescribeAddon(&eks.DescribeAddonInput{
+		ClusterName: &a.clusterConfig.Metadata.Name,
+		AddonName:   aws.String(podIdentityAgentName),
+	})
+	if err != nil {
+		if awsError, ok := err.(awserr.Error); ok && awsError.Code() == eks.ErrCodeResourceNotFoundException {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get addon %q", podIdentityAgentName)
+	}
+	return true, nil
+}
+
 func (a *Manager) patchAWSNodeSA() error {
 	serviceaccounts := a.clientSet.CoreV1().ServiceAccounts("kube-system")
 	sa, err := serviceaccounts.Get(context.TODO(), "aws-node", metav1.GetOptions{})
@@ -203,6 +289,18 @@ func (a *Manager) getRecommendedPolicies(addon *api.Addon) (api.InlineDocument,
 		return nil, nil, &api.WellKnownPolicies{
 			EBSCSIController: true,
 		}
+	case adotName:
+		partition := api.Partition(a.clusterConfig.Metadata.Region)
+		policies := []string{"AmazonPrometheusRemoteWriteAccess", "AWSXrayWriteOnlyAccess", "CloudWatchAgentServerPolicy"}
+		if addon.ADOT != nil {
+			// only grant the permissions needed by the configured pipelines
+			policies = defaultaddons.ADOTPolicyNames(addon.ADOT.Pipelines)
+		}
+		var policyARNs []string
+		for _, policy := range policies {
+			policyARNs = append(policyARNs, fmt.Sprintf("arn:%s:iam::aws:policy/%s", partition, policy))
+		}
+		return nil, policyARNs, nil
 	default:
 		return nil, nil, nil
 	}
@@ -214,6 +312,16 @@ func (a *Manager) getKnownServiceAccountLocation(addon *api.Addon) (string, stri
 	case vpcCNIName:
 		logger.Debug("found known service account location %s/%s", api.AWSNodeMeta.Namespace, api.AWSNodeMeta.Name)
 		return api.AWSNodeMeta.Namespace, api.AWSNodeMeta.Name
+	case ebsCSIDriverName:
+		logger.Debug("found known service account location %s/%s", kubeSystemNamespace, ebsCSIControllerServiceAccount)
+		return kubeSystemNamespace, ebsCSIControllerServiceAccount
+	case adotName:
+		if addon.ADOT == nil {
+			return "", ""
+		}
+		// the role is assumed by the collectors, not by the operator of the addon
+		logger.Debug("found known service account location %s/%s", defaultaddons.ADOTCollectorNamespace, defaultaddons.ADOTCollectorServiceAccounts)
+		return defaultaddons.ADOTCollectorNamespace, defaultaddons.ADOTCollectorServiceAccounts
 	default:
 		return "", ""
 	}
@@ -238,17 +346,7 @@ func (a *Manager) createRole(addon *api.Addon, namespace, serviceAccount string)
 }
 
 func (a *Manager) createRoleResourceSet(addon *api.Addon, namespace, serviceAccount string) (*builder.IAMRoleResourceSet, error) {
-	var resourceSet *builder.IAMRoleResourceSet
-	if len(addon.AttachPolicyARNs) != 0 {
-		logger.Info("creating role using provided policies ARNs")
-		resourceSet = builder.NewIAMRoleResourceSetWithAttachPolicyARNs(addon.Name, namespace, serviceAccount, addon.PermissionsBoundary, addon.AttachPolicyARNs, a.oidcManager)
-	} else if addon.WellKnownPolicies.HasPolicy() {
-		logger.Info("creating role using provided well known policies")
-		resourceSet = builder.NewIAMRoleResourceSetWithWellKnownPolicies(addon.Name, namespace, serviceAccount, addon.PermissionsBoundary, addon.WellKnownPolicies, a.oidcManager)
-	} else {
-		logger.Info("creating role using provided policies")
-		resourceSet = builder.NewIAMRoleResourceSetWithAttachPolicy(addon.Name, namespace, serviceAccount, addon.PermissionsBoundary, addon.AttachPolicy, a.oidcManager)
-	}
+	resourceSet := builder.NewIAMRoleResourceSetWithPolicies(addon.Name, namespace, serviceAccount, addon.PermissionsBoundary, addon.AttachPolicy, addon.AttachPolicyARNs, addon.WellKnownPolicies, a.oidcManager)
 	return resourceSet, resourceSet.AddAllResources()
 }
 
@@ -267,6 +365,43 @@ func (a *Manager) createStack(resourceSet builder.ResourceSet, addon *api.Addon)
 	return <-errChan
 }
 
+// createOrUpdateStack creates the stack of the addon, or updates it with the template of the resource set if it
+// already exists, collecting the outputs of the resource set in both cases
+func (a *Manager) createOrUpdateStack(resourceSet builder.ResourceSet, addon *api.Addon) error {
+	stackName := a.makeAddonName(addon.Name)
+	existingStacks, err := a.stackManager.ListStacksMatching(stackName)
+	if err != nil {
+		return err
+	}
+	if len(existingStacks) == 0 {
+		return a.createStack(resourceSet, addon)
+	}
+
+	template, err := resourceSet.RenderJSON()
+	if err != nil {
+		return err
+	}
+	var templateBody manager.TemplateBody = template
+	if err := a.stackManager.UpdateStack(manager.UpdateStackOptions{
+		StackName:     stackName,
+		ChangeSetName: fmt.Sprintf("updating-iam-%s", uuid.NewString()),
+		Description:   "updating the IAM resources of the addon",
+		TemplateData:  templateBody,
+		Wait:          true,
+	}); err != nil {
+		return err
+	}
+
+	existingStacks, err = a.stackManager.ListStacksMatching(stackName)
+	if err != nil {
+		return err
+	}
+	if len(existingStacks) == 0 {
+		return fmt.Errorf("stack %q was not found after updating it", stackName)
+	}
+	return resourceSet.GetAllOutputs(*existingStacks[0])
+}
+
 func makeIPv6VPCCNIPolicyDocument() map[string]interface{} {
 	return map[string]interface{}{
 		"Version": "2012-10-17",
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -472,12 +473,80 @@ var _ = Describe("Create", func() {
 					output, err := resourceSet.RenderJSON()
 					Expect(err).NotTo(HaveOccurred())
 					Expect(string(output)).To(ContainSubstring("PolicyEBSCSIController"))
+					Expect(string(output)).To(ContainSubstring(":sub\":\"system:serviceaccount:kube-system:ebs-csi-controller-sa"))
 					Expect(*createAddonInput.ClusterName).To(Equal("my-cluster"))
 					Expect(*createAddonInput.AddonName).To(Equal("aws-ebs-csi-driver"))
 					Expect(*createAddonInput.AddonVersion).To(Equal("v1.0.0-eksbuild.1"))
 					Expect(*createAddonInput.ServiceAccountRoleArn).To(Equal("role-arn"))
 				})
 			})
+
+			When("it's the adot addon", func() {
+				It("creates a role with the recommended policies and attaches it to the addon", func() {
+					err := manager.Create(&api.Addon{
+						Name:    "adot",
+						Version: "v1.0.0-eksbuild.1",
+					}, false)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+					name, resourceSet, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+					Expect(name).To(Equal("eksctl-my-cluster-addon-adot"))
+					output, err := resourceSet.RenderJSON()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(output)).To(ContainSubstring("arn:aws:iam::aws:policy/AmazonPrometheusRemoteWriteAccess"))
+					Expect(string(output)).To(ContainSubstring("arn:aws:iam::aws:policy/AWSXrayWriteOnlyAccess"))
+					Expect(string(output)).To(ContainSubstring("arn:aws:iam::aws:policy/CloudWatchAgentServerPolicy"))
+					Expect(*createAddonInput.ServiceAccountRoleArn).To(Equal("role-arn"))
+				})
+
+				When("collector pipelines are configured", func() {
+					It("only grants the permissions of the configured pipelines to the service accounts of the collectors", func() {
+						err := manager.Create(&api.Addon{
+							Name:    "adot",
+							Version: "v1.0.0-eksbuild.1",
+							ADOT: &api.ADOTAddonConfig{
+								Pipelines: api.ADOTPipelines{XRay: &api.ADOTXRayPipeline{}},
+							},
+						}, false)
+						// the configuration values of the addon cannot be sent by the mock EKS client
+						Expect(err).To(MatchError(ContainSubstring("cannot send CreateAddon requests")))
+
+						Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+						_, resourceSet, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+						output, err := resourceSet.RenderJSON()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(output)).To(ContainSubstring("arn:aws:iam::aws:policy/AWSXrayWriteOnlyAccess"))
+						Expect(string(output)).NotTo(ContainSubstring("AmazonPrometheusRemoteWriteAccess"))
+						Expect(string(output)).To(ContainSubstring(`"StringLike":{"oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E:sub":"system:serviceaccount:opentelemetry-operator-system:adot-col-*"}`))
+					})
+				})
+			})
+		})
+	})
+
+	When("attachPolicyARNs, attachPolicy and wellKnownPolicies are configured", func() {
+		It("creates a role with all the policies to attach to the addon", func() {
+			err := manager.Create(&api.Addon{
+				Name:             "my-addon",
+				Version:          "v1.0.0-eksbuild.1",
+				AttachPolicyARNs: []string{"arn-1"},
+				AttachPolicy: api.InlineDocument{
+					"foo": "policy-bar",
+				},
+				WellKnownPolicies: api.WellKnownPolicies{
+					AutoScaler: true,
+				},
+			}, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+			_, resourceSet, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+			output, err := resourceSet.RenderJSON()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(output)).To(ContainSubstring("arn-1"))
+			Expect(string(output)).To(ContainSubstring("policy-bar"))
+			Expect(string(output)).To(ContainSubstring("autoscaling:SetDesiredCapacity"))
 		})
 	})
 
@@ -567,6 +636,79 @@ var _ = Describe("Create", func() {
 		})
 	})
 
+	When("podIdentityAssociations are configured", func() {
+		var podIdentityAgentErr error
+
+		BeforeEach(func() {
+			podIdentityAgentErr = nil
+		})
+
+		JustBeforeEach(func() {
+			mockProvider.MockEKS().On("DescribeAddon", &awseks.DescribeAddonInput{
+				ClusterName: aws.String("my-cluster"),
+				AddonName:   aws.String("eks-pod-identity-agent"),
+			}).Return(&awseks.DescribeAddonOutput{}, podIdentityAgentErr)
+		})
+
+		newAddon := func(associations ...api.PodIdentityAssociation) *api.Addon {
+			return &api.Addon{
+				Name:                    "my-addon",
+				Version:                 "v1.0.0-eksbuild.1",
+				PodIdentityAssociations: associations,
+			}
+		}
+
+		When("the pod identity agent is installed", func() {
+			It("creates the roles and associations", func() {
+				err := manager.Create(newAddon(
+					api.PodIdentityAssociation{Namespace: "kube-system", ServiceAccountName: "sa-1", PermissionPolicyARNs: []string{"arn-1"}},
+					api.PodIdentityAssociation{Namespace: "kube-system", ServiceAccountName: "sa-2", RoleARN: "existing-role"},
+				), false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(createAddonInput.ServiceAccountRoleArn).To(BeNil())
+
+				Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+				name, resourceSet, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+				Expect(name).To(Equal("eksctl-my-cluster-addon-my-addon"))
+				output, err := resourceSet.RenderJSON()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(output)).To(ContainSubstring("AWS::EKS::PodIdentityAssociation"))
+				Expect(string(output)).To(ContainSubstring("pods.eks.amazonaws.com"))
+				Expect(string(output)).To(ContainSubstring("arn-1"))
+				Expect(string(output)).To(ContainSubstring("existing-role"))
+			})
+		})
+
+		When("the pod identity agent is not installed", func() {
+			BeforeEach(func() {
+				podIdentityAgentErr = awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil)
+			})
+
+			It("falls back to IRSA for a single association", func() {
+				err := manager.Create(newAddon(
+					api.PodIdentityAssociation{Namespace: "kube-system", ServiceAccountName: "sa-1", PermissionPolicyARNs: []string{"arn-1"}},
+				), false)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+				_, resourceSet, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+				output, err := resourceSet.RenderJSON()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(output)).To(ContainSubstring("sts:AssumeRoleWithWebIdentity"))
+				Expect(string(output)).To(ContainSubstring("system:serviceaccount:kube-system:sa-1"))
+			})
+
+			It("errors for multiple associations", func() {
+				err := manager.Create(newAddon(
+					api.PodIdentityAssociation{Namespace: "kube-system", ServiceAccountName: "sa-1", RoleARN: "arn-1"},
+					api.PodIdentityAssociation{Namespace: "kube-system", ServiceAccountName: "sa-2", RoleARN: "arn-2"},
+				), false)
+				Expect(err).To(MatchError(`podIdentityAssociations of addon "my-addon" require the "eks-pod-identity-agent" addon to be installed`))
+				Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	When("tags are configured", func() {
 		It("uses the Tags to create the addon", func() {
 			err := manager.Create(&api.Addon{
@@ -5,3 +5,5 @@ import "time"
 func (m *Manager) SetTimeout(timeout time.Duration) {
 	m.timeout = timeout
 }
+
+var VersionSkew = versionSkew
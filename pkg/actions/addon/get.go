@@ -9,6 +9,7 @@ import (
 	"github.com/kris-nova/logger"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/eks"
 )
 
@@ -63,17 +64,30 @@ func (a *Manager) Get(addon *api.Addon) (Summary, error) {
 	}, nil
 }
 
-func (a *Manager) GetAll() ([]Summary, error) {
-	logger.Info("getting all addons")
-	output, err := a.eksAPI.ListAddons(&eks.ListAddonsInput{
+// Exists returns true if the addon is installed on the cluster
+func (a *Manager) Exists(addon *api.Addon) (bool, error) {
+	_, err := a.eksAPI.DescribeAddon(&eks.DescribeAddonInput{
 		ClusterName: &a.clusterConfig.Metadata.Name,
+		AddonName:   &addon.Name,
 	})
+	if err != nil {
+		if awsError, ok := err.(awserr.Error); ok && awsError.Code() == eks.ErrCodeResourceNotFoundException {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get addon %q: %v", addon.Name, err)
+	}
+	return true, nil
+}
+
+func (a *Manager) GetAll() ([]Summary, error) {
+	logger.Info("getting all addons")
+	addons, err := a.listAddons()
 	if err != nil {
 		return []Summary{}, fmt.Errorf("failed to list addons: %v", err)
 	}
 
 	var summaries []Summary
-	for _, addon := range output.Addons {
+	for _, addon := range addons {
 		summary, err := a.Get(&api.Addon{Name: *addon})
 		if err != nil {
 			return nil, err
@@ -83,6 +97,20 @@ func (a *Manager) GetAll() ([]Summary, error) {
 	return summaries, nil
 }
 
+// listAddons returns the names of all addons of the cluster, across all pages of results
+func (a *Manager) listAddons() ([]*string, error) {
+	var addons []*string
+	if err := a.eksAPI.ListAddonsPages(&eks.ListAddonsInput{
+		ClusterName: &a.clusterConfig.Metadata.Name,
+	}, func(output *eks.ListAddonsOutput, _ bool) bool {
+		addons = append(addons, output.Addons...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return addons, nil
+}
+
 func (a *Manager) findNewerVersions(addon *api.Addon) (string, error) {
 	var newerVersions []string
 	currentVersion, err := semver.Parse(strings.TrimPrefix(addon.Version, "v"))
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -113,6 +114,48 @@ var _ = Describe("Get", func() {
 		})
 	})
 
+	Describe("Exists", func() {
+		It("returns true when the addon exists", func() {
+			mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(1))
+				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.DescribeAddonInput{}))
+				describeAddonInput = args[0].(*awseks.DescribeAddonInput)
+			}).Return(&awseks.DescribeAddonOutput{
+				Addon: &awseks.Addon{
+					AddonName: aws.String("my-addon"),
+				},
+			}, nil)
+
+			exists, err := manager.Exists(&api.Addon{
+				Name: "my-addon",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(*describeAddonInput.ClusterName).To(Equal("my-cluster"))
+			Expect(*describeAddonInput.AddonName).To(Equal("my-addon"))
+		})
+
+		It("returns false when the addon is not found", func() {
+			mockProvider.MockEKS().On("DescribeAddon", mock.Anything).
+				Return(nil, awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil))
+
+			exists, err := manager.Exists(&api.Addon{
+				Name: "my-addon",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("returns other errors", func() {
+			mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Return(nil, fmt.Errorf("foo"))
+
+			_, err := manager.Exists(&api.Addon{
+				Name: "my-addon",
+			})
+			Expect(err).To(MatchError(`failed to get addon "my-addon": foo`))
+		})
+	})
+
 	Describe("GetAll", func() {
 		var listAddonsInput *awseks.ListAddonsInput
 		It("returns an addon", func() {
@@ -140,13 +183,15 @@ var _ = Describe("Get", func() {
 				},
 			}, nil)
 
-			mockProvider.MockEKS().On("ListAddons", mock.Anything).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
+			mockProvider.MockEKS().On("ListAddonsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
 				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.ListAddonsInput{}))
 				listAddonsInput = args[0].(*awseks.ListAddonsInput)
-			}).Return(&awseks.ListAddonsOutput{
-				Addons: aws.StringSlice([]string{"my-addon"}),
-			}, nil)
+				consume := args[1].(func(*awseks.ListAddonsOutput, bool) bool)
+				consume(&awseks.ListAddonsOutput{
+					Addons: aws.StringSlice([]string{"my-addon"}),
+				}, true)
+			}).Return(nil)
 
 			mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Run(func(args mock.Arguments) {
 				Expect(args).To(HaveLen(1))
@@ -180,13 +225,15 @@ var _ = Describe("Get", func() {
 
 		When("it fails to get the addon", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("ListAddons", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
+				mockProvider.MockEKS().On("ListAddonsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
 					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.ListAddonsInput{}))
 					listAddonsInput = args[0].(*awseks.ListAddonsInput)
-				}).Return(&awseks.ListAddonsOutput{
-					Addons: aws.StringSlice([]string{"my-addon"}),
-				}, nil)
+					consume := args[1].(func(*awseks.ListAddonsOutput, bool) bool)
+					consume(&awseks.ListAddonsOutput{
+						Addons: aws.StringSlice([]string{"my-addon"}),
+					}, true)
+				}).Return(nil)
 
 				mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Run(func(args mock.Arguments) {
 					Expect(args).To(HaveLen(1))
@@ -205,13 +252,11 @@ var _ = Describe("Get", func() {
 
 		When("it fails to list addons", func() {
 			It("returns an error", func() {
-				mockProvider.MockEKS().On("ListAddons", mock.Anything).Run(func(args mock.Arguments) {
-					Expect(args).To(HaveLen(1))
+				mockProvider.MockEKS().On("ListAddonsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					Expect(args).To(HaveLen(2))
 					Expect(args[0]).To(BeAssignableToTypeOf(&awseks.ListAddonsInput{}))
 					listAddonsInput = args[0].(*awseks.ListAddonsInput)
-				}).Return(&awseks.ListAddonsOutput{
-					Addons: aws.StringSlice([]string{"my-addon"}),
-				}, fmt.Errorf("foo"))
+				}).Return(fmt.Errorf("foo"))
 
 				_, err := manager.GetAll()
 				Expect(err).To(MatchError(`failed to list addons: foo`))
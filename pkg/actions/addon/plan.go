@@ -0,0 +1,161 @@
+package addon
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// UpdatePlan holds the current and desired version of an addon, and the changes of its configuration values an
+// update would make
+type UpdatePlan struct {
+	Name           string `json:"name"`
+	CurrentVersion string `json:"currentVersion"`
+	DesiredVersion string `json:"desiredVersion"`
+	// ConfigurationValues are the changed configuration values, sorted by path
+	ConfigurationValues []ValueChange `json:"configurationValues,omitempty"`
+}
+
+// ValueChange is the change of a configuration value, at a path such as `resources.limits.memory` or
+// `tolerations[0].key`. Current is nil for added values, and Desired for removed values
+type ValueChange struct {
+	Path    string      `json:"path"`
+	Current interface{} `json:"current,omitempty"`
+	Desired interface{} `json:"desired,omitempty"`
+}
+
+// HasChanges returns whether the update would change the version or the configuration values of the addon
+func (p UpdatePlan) HasChanges() bool {
+	return p.CurrentVersion != p.DesiredVersion || len(p.ConfigurationValues) > 0
+}
+
+// Diff returns the changes of the plan as a diff of the addon in the cluster config file
+func (p UpdatePlan) Diff() string {
+	var diff strings.Builder
+	fmt.Fprintf(&diff, " - name: %s\n", p.Name)
+	if p.CurrentVersion != p.DesiredVersion {
+		fmt.Fprintf(&diff, "-  version: %s\n", p.CurrentVersion)
+		fmt.Fprintf(&diff, "+  version: %s\n", p.DesiredVersion)
+	}
+	if len(p.ConfigurationValues) > 0 {
+		fmt.Fprintln(&diff, "   configurationValues:")
+		for _, c := range p.ConfigurationValues {
+			if c.Current != nil {
+				fmt.Fprintf(&diff, "-    %s: %s\n", c.Path, formatValue(c.Current))
+			}
+			if c.Desired != nil {
+				fmt.Fprintf(&diff, "+    %s: %s\n", c.Path, formatValue(c.Desired))
+			}
+		}
+	}
+	return diff.String()
+}
+
+// PlanUpdate returns the changes Update would make to the version and the configuration values of the addon,
+// comparing those of the addon in the cluster with the desired ones
+func (a *Manager) PlanUpdate(addon *api.Addon) (UpdatePlan, error) {
+	current, err := a.describeConfiguration(addon)
+	if err != nil {
+		return UpdatePlan{}, err
+	}
+	plan := UpdatePlan{
+		Name:           addon.Name,
+		CurrentVersion: aws.StringValue(current.AddonVersion),
+		DesiredVersion: aws.StringValue(current.AddonVersion),
+	}
+	if addon.Version != "" {
+		if plan.DesiredVersion, err = a.getLatestMatchingVersion(addon); err != nil {
+			return UpdatePlan{}, fmt.Errorf("failed to fetch addon version: %w", err)
+		}
+	}
+
+	// configuration values that are not set are not sent by Update, which leaves them unchanged
+	if addon.ConfigurationValues == "" {
+		return plan, nil
+	}
+	currentValues, err := flattenConfigurationValues(aws.StringValue(current.ConfigurationValues))
+	if err != nil {
+		return UpdatePlan{}, errors.Wrapf(err, "parsing the configuration values of addon %q in the cluster", addon.Name)
+	}
+	desiredValues, err := flattenConfigurationValues(addon.ConfigurationValues)
+	if err != nil {
+		return UpdatePlan{}, errors.Wrapf(err, "parsing the configuration values of addon %q", addon.Name)
+	}
+	plan.ConfigurationValues = diffValues(currentValues, desiredValues)
+	return plan, nil
+}
+
+// flattenConfigurationValues parses a JSON or YAML document, and returns its values by path
+func flattenConfigurationValues(document string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if strings.TrimSpace(document) == "" {
+		return values, nil
+	}
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal([]byte(document), &parsed); err != nil {
+		return nil, err
+	}
+	flattenValue("", parsed, values)
+	return values, nil
+}
+
+func flattenValue(path string, value interface{}, values map[string]interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 && path != "" {
+			values[path] = v
+		}
+		for key, child := range v {
+			if path != "" {
+				key = path + "." + key
+			}
+			flattenValue(key, child, values)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			values[path] = v
+		}
+		for i, child := range v {
+			flattenValue(fmt.Sprintf("%s[%d]", path, i), child, values)
+		}
+	default:
+		values[path] = v
+	}
+}
+
+func diffValues(current, desired map[string]interface{}) []ValueChange {
+	var changes []ValueChange
+	for path, currentValue := range current {
+		desiredValue, ok := desired[path]
+		if !ok {
+			changes = append(changes, ValueChange{Path: path, Current: currentValue})
+		} else if !reflect.DeepEqual(currentValue, desiredValue) {
+			changes = append(changes, ValueChange{Path: path, Current: currentValue, Desired: desiredValue})
+		}
+	}
+	for path, desiredValue := range desired {
+		if _, ok := current[path]; !ok {
+			changes = append(changes, ValueChange{Path: path, Desired: desiredValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+func formatValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
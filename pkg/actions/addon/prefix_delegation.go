@@ -0,0 +1,117 @@
+package addon
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	enablePrefixDelegationEnvVar = "ENABLE_PREFIX_DELEGATION"
+	warmPrefixTargetEnvVar       = "WARM_PREFIX_TARGET"
+)
+
+// UsesPrefixDelegation returns whether a nodegroup of the config computes its maximum number of pods for prefix
+// delegation, which must be called once maxPodsCalculation has been resolved
+func UsesPrefixDelegation(cfg *api.ClusterConfig) bool {
+	for _, ng := range cfg.NodeGroups {
+		if ng.MaxPodsCalculation == api.MaxPodsCalculationPrefixDelegation {
+			return true
+		}
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		if ng.MaxPodsCalculation == api.MaxPodsCalculationPrefixDelegation {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigurePrefixDelegation enables prefix delegation in the configuration values of the vpc-cni addon of the config
+// when a nodegroup uses it, adding the addon to the config if it is not there
+func ConfigurePrefixDelegation(cfg *api.ClusterConfig) error {
+	if !UsesPrefixDelegation(cfg) {
+		return nil
+	}
+	var vpcCNI *api.Addon
+	for _, addon := range cfg.Addons {
+		if addon.CanonicalName() == vpcCNIName {
+			vpcCNI = addon
+		}
+	}
+	if vpcCNI == nil {
+		logger.Info("adding the %s addon to enable prefix delegation", vpcCNIName)
+		vpcCNI = &api.Addon{Name: vpcCNIName}
+		cfg.Addons = append(cfg.Addons, vpcCNI)
+	}
+
+	configurationValues, _, err := withPrefixDelegation(vpcCNI.ConfigurationValues)
+	if err != nil {
+		return errors.Wrapf(err, "enabling prefix delegation in the configuration values of addon %q", vpcCNI.Name)
+	}
+	vpcCNI.ConfigurationValues = configurationValues
+	return nil
+}
+
+// EnablePrefixDelegation enables prefix delegation in the configuration values of the vpc-cni addon of the cluster
+func (a *Manager) EnablePrefixDelegation(wait bool) error {
+	vpcCNI := &api.Addon{Name: vpcCNIName}
+	exists, err := a.Exists(vpcCNI)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("prefix delegation is enabled through the %[1]s addon, which must be created first with `eksctl create addon --name %[1]s --force`", vpcCNIName)
+	}
+
+	current, err := a.describeConfiguration(vpcCNI)
+	if err != nil {
+		return err
+	}
+	configurationValues, changed, err := withPrefixDelegation(aws.StringValue(current.ConfigurationValues))
+	if err != nil {
+		return errors.Wrapf(err, "enabling prefix delegation in the configuration values of addon %q", vpcCNI.Name)
+	}
+	if !changed {
+		logger.Info("prefix delegation is already enabled in addon %q", vpcCNI.Name)
+		return nil
+	}
+	logger.Info("enabling prefix delegation in addon %q", vpcCNI.Name)
+	vpcCNI.ConfigurationValues = configurationValues
+	return a.Update(vpcCNI, wait)
+}
+
+// withPrefixDelegation returns the configuration values of the vpc-cni addon with prefix delegation enabled, and
+// whether they changed. A warm prefix target is set unless one already is
+func withPrefixDelegation(configurationValues string) (string, bool, error) {
+	values := map[string]interface{}{}
+	if configurationValues != "" {
+		if err := yaml.Unmarshal([]byte(configurationValues), &values); err != nil {
+			return "", false, err
+		}
+	}
+
+	env, ok := values["env"].(map[string]interface{})
+	if !ok {
+		env = map[string]interface{}{}
+		values["env"] = env
+	}
+	changed := env[enablePrefixDelegationEnvVar] != "true"
+	env[enablePrefixDelegationEnvVar] = "true"
+	if _, ok := env[warmPrefixTargetEnvVar]; !ok {
+		env[warmPrefixTargetEnvVar] = "1"
+		changed = true
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", false, err
+	}
+	return string(data), changed, nil
+}
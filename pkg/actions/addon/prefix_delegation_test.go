@@ -0,0 +1,46 @@
+package addon_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("Prefix delegation", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		ng := cfg.NewNodeGroup()
+		ng.MaxPodsCalculation = api.MaxPodsCalculationPrefixDelegation
+	})
+
+	It("adds the vpc-cni addon with prefix delegation enabled", func() {
+		Expect(addon.ConfigurePrefixDelegation(cfg)).To(Succeed())
+		Expect(cfg.Addons).To(HaveLen(1))
+		Expect(cfg.Addons[0].Name).To(Equal("vpc-cni"))
+		Expect(cfg.Addons[0].ConfigurationValues).To(MatchJSON(`{"env": {"ENABLE_PREFIX_DELEGATION": "true", "WARM_PREFIX_TARGET": "1"}}`))
+	})
+
+	It("keeps the configuration values of the vpc-cni addon", func() {
+		cfg.Addons = []*api.Addon{{
+			Name:                "vpc-cni",
+			ConfigurationValues: "env:\n  WARM_PREFIX_TARGET: \"2\"\n  AWS_VPC_K8S_CNI_LOGLEVEL: DEBUG\n",
+		}}
+		Expect(addon.ConfigurePrefixDelegation(cfg)).To(Succeed())
+		Expect(cfg.Addons).To(HaveLen(1))
+		Expect(cfg.Addons[0].ConfigurationValues).To(MatchJSON(`{"env": {
+			"ENABLE_PREFIX_DELEGATION": "true",
+			"WARM_PREFIX_TARGET": "2",
+			"AWS_VPC_K8S_CNI_LOGLEVEL": "DEBUG"
+		}}`))
+	})
+
+	It("does nothing when no nodegroup uses prefix delegation", func() {
+		cfg.NodeGroups[0].MaxPodsCalculation = api.MaxPodsCalculationENI
+		Expect(addon.ConfigurePrefixDelegation(cfg)).To(Succeed())
+		Expect(cfg.Addons).To(BeEmpty())
+	})
+})
@@ -0,0 +1,206 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+)
+
+type workloadKind string
+
+const (
+	daemonSetKind  workloadKind = "daemonset"
+	deploymentKind workloadKind = "deployment"
+)
+
+type addonWorkload struct {
+	kind workloadKind
+	name string
+}
+
+// addonWorkloads are the workloads in kube-system deployed by well-known addons
+var addonWorkloads = map[string][]addonWorkload{
+	api.VPCCNIAddon:    {{kind: daemonSetKind, name: "aws-node"}},
+	api.CoreDNSAddon:   {{kind: deploymentKind, name: "coredns"}},
+	api.KubeProxyAddon: {{kind: daemonSetKind, name: "kube-proxy"}},
+	ebsCSIDriverName: {
+		{kind: deploymentKind, name: "ebs-csi-controller"},
+		{kind: daemonSetKind, name: "ebs-csi-node"},
+	},
+}
+
+// workloadStatus is the rollout status of a workload
+type workloadStatus struct {
+	ready   bool
+	message string
+	images  []string
+}
+
+// waitForAddonRollout waits for the workloads of well-known addons to be rolled out and ready, as an addon is
+// reported ACTIVE by EKS before its pods are, and warns about workloads running images of a different version
+// than the addon
+func (a *Manager) waitForAddonRollout(addon *api.Addon) error {
+	workloads, ok := addonWorkloads[addon.CanonicalName()]
+	if !ok || a.clientSet == nil {
+		return nil
+	}
+
+	output, err := a.eksAPI.DescribeAddon(&awseks.DescribeAddonInput{
+		ClusterName: &a.clusterConfig.Metadata.Name,
+		AddonName:   &addon.Name,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing addon %q", addon.Name)
+	}
+	addonVersion := ""
+	if output.Addon != nil && output.Addon.AddonVersion != nil {
+		addonVersion = *output.Addon.AddonVersion
+	}
+
+	for _, workload := range workloads {
+		status, err := a.waitForWorkload(addon, workload)
+		if err != nil {
+			return err
+		}
+		if status == nil {
+			continue
+		}
+		logger.Info("%s %q of addon %q is ready", workload.kind, workload.name, addon.Name)
+		if skew := versionSkew(addonVersion, status.images); len(skew) > 0 {
+			logger.Warning("%s %q runs image(s) %s which do not match version %q of addon %q", workload.kind, workload.name, strings.Join(skew, ", "), addonVersion, addon.Name)
+		}
+	}
+	return nil
+}
+
+// waitForWorkload waits for the workload to be ready, returning a nil status if it does not exist
+func (a *Manager) waitForWorkload(addon *api.Addon, workload addonWorkload) (*workloadStatus, error) {
+	var status *workloadStatus
+	operation := func() (bool, error) {
+		var err error
+		status, err = a.getWorkloadStatus(workload)
+		if err != nil {
+			return false, err
+		}
+		return status == nil || status.ready, nil
+	}
+
+	w := waiter.Waiter{
+		Operation: operation,
+		NextDelay: func(_ int) time.Duration {
+			return a.timeout / 10
+		},
+	}
+	if err := w.WaitWithTimeout(a.timeout); err != nil {
+		if err == context.DeadlineExceeded && status != nil {
+			return nil, errors.Errorf("timed out waiting for %s %q of addon %q to be ready: %s", workload.kind, workload.name, addon.Name, status.message)
+		}
+		return nil, err
+	}
+	if status == nil {
+		logger.Debug("%s %q of addon %q not found, skipping waiting for it", workload.kind, workload.name, addon.Name)
+	}
+	return status, nil
+}
+
+func (a *Manager) getWorkloadStatus(workload addonWorkload) (*workloadStatus, error) {
+	switch workload.kind {
+	case daemonSetKind:
+		daemonSet, err := a.clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), workload.name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrapf(err, "getting daemonset %q", workload.name)
+		}
+		return daemonSetStatus(daemonSet), nil
+	case deploymentKind:
+		deployment, err := a.clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Get(context.TODO(), workload.name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrapf(err, "getting deployment %q", workload.name)
+		}
+		return deploymentStatus(deployment), nil
+	default:
+		return nil, fmt.Errorf("unexpected workload kind %q", workload.kind)
+	}
+}
+
+func daemonSetStatus(daemonSet *appsv1.DaemonSet) *workloadStatus {
+	s := daemonSet.Status
+	desired := s.DesiredNumberScheduled
+	return &workloadStatus{
+		ready: s.ObservedGeneration >= daemonSet.Generation && s.UpdatedNumberScheduled == desired && s.NumberAvailable == desired,
+		message: fmt.Sprintf("%d of %d pods updated, %d of %d pods available",
+			s.UpdatedNumberScheduled, desired, s.NumberAvailable, desired),
+		images: containerImages(daemonSet.Spec.Template.Spec),
+	}
+}
+
+func deploymentStatus(deployment *appsv1.Deployment) *workloadStatus {
+	s := deployment.Status
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	return &workloadStatus{
+		ready: s.ObservedGeneration >= deployment.Generation && s.UpdatedReplicas == desired && s.Replicas == desired && s.AvailableReplicas == desired,
+		message: fmt.Sprintf("%d of %d replicas updated, %d of %d replicas available",
+			s.UpdatedReplicas, desired, s.AvailableReplicas, desired),
+		images: containerImages(deployment.Spec.Template.Spec),
+	}
+}
+
+func containerImages(podSpec corev1.PodSpec) []string {
+	var images []string
+	for _, c := range podSpec.Containers {
+		images = append(images, c.Image)
+	}
+	return images
+}
+
+// versionSkew returns the images built by EKS for a version of an addon, i.e. whose tag contains `eksbuild`, with a
+// different version than the addon; other images, e.g. of sidecars, have unrelated versions
+func versionSkew(addonVersion string, images []string) []string {
+	if addonVersion == "" {
+		return nil
+	}
+	var skew []string
+	for _, image := range images {
+		if tag := imageTag(image); strings.Contains(tag, "eksbuild") && normalizeImageVersion(tag) != normalizeImageVersion(addonVersion) {
+			skew = append(skew, image)
+		}
+	}
+	return skew
+}
+
+// normalizeImageVersion strips the variant from the version of an image built by EKS, e.g. kube-proxy runs the
+// `v1.21.2-minimal-eksbuild.2` image for version `v1.21.2-eksbuild.2` of the addon
+func normalizeImageVersion(version string) string {
+	return strings.Replace(version, "-minimal-eksbuild", "-eksbuild", 1)
+}
+
+func imageTag(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i:], "/") {
+		return ""
+	}
+	return image[i+1:]
+}
@@ -0,0 +1,97 @@
+package addon_test
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Addon rollout", func() {
+	var (
+		mockProvider *mockprovider.MockProvider
+		clientSet    *fake.Clientset
+	)
+
+	newCoreDNS := func(replicas, available int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "coredns",
+				Namespace: metav1.NamespaceSystem,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: aws.Int32(replicas),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Image: "602401143452.dkr.ecr.us-west-2.amazonaws.com/eks/coredns:v1.8.4-eksbuild.1"}},
+					},
+				},
+			},
+			Status: appsv1.DeploymentStatus{
+				Replicas:          replicas,
+				UpdatedReplicas:   replicas,
+				AvailableReplicas: available,
+			},
+		}
+	}
+
+	create := func() error {
+		manager, err := addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
+			Version: "1.21",
+			Name:    "my-cluster",
+		}}, mockProvider.EKS(), new(fakes.FakeStackManager), false, nil, clientSet, 5*time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		manager.SetTimeout(100 * time.Millisecond)
+		return manager.Create(&api.Addon{Name: "coredns"}, true)
+	}
+
+	BeforeEach(func() {
+		mockProvider = mockprovider.NewMockProvider()
+		mockProvider.MockEKS().On("CreateAddon", mock.Anything).Return(&awseks.CreateAddonOutput{}, nil)
+		mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Return(&awseks.DescribeAddonOutput{
+			Addon: &awseks.Addon{
+				AddonName:    aws.String("coredns"),
+				AddonVersion: aws.String("v1.8.4-eksbuild.1"),
+				Status:       aws.String(awseks.AddonStatusActive),
+			},
+		}, nil)
+	})
+
+	It("waits for the workloads of the addon to be ready", func() {
+		clientSet = fake.NewSimpleClientset(newCoreDNS(2, 2))
+		Expect(create()).To(Succeed())
+	})
+
+	It("times out when the workloads of the addon are not ready", func() {
+		clientSet = fake.NewSimpleClientset(newCoreDNS(2, 1))
+		Expect(create()).To(MatchError(`timed out waiting for deployment "coredns" of addon "coredns" to be ready: 2 of 2 replicas updated, 1 of 2 replicas available`))
+	})
+
+	It("does not wait for workloads that do not exist", func() {
+		clientSet = fake.NewSimpleClientset()
+		Expect(create()).To(Succeed())
+	})
+
+	DescribeTable("version skew", func(images []string, expected []string) {
+		Expect(addon.VersionSkew("v1.10.1-eksbuild.1", images)).To(Equal(expected))
+	},
+		Entry("matching version", []string{"eks/amazon-k8s-cni:v1.10.1-eksbuild.1"}, nil),
+		Entry("different version", []string{"eks/amazon-k8s-cni:v1.9.0-eksbuild.1"}, []string{"eks/amazon-k8s-cni:v1.9.0-eksbuild.1"}),
+		Entry("images not built for the addon", []string{"eks/csi-provisioner:v2.1.1", "registry:5000/sidecar"}, nil),
+		Entry("minimal image of the version", []string{"eks/kube-proxy:v1.10.1-minimal-eksbuild.1"}, nil),
+		Entry("minimal image of a different version", []string{"eks/kube-proxy:v1.9.0-minimal-eksbuild.1"}, []string{"eks/kube-proxy:v1.9.0-minimal-eksbuild.1"}),
+	)
+})
@@ -15,9 +15,13 @@ func CreateAddonTasks(cfg *api.ClusterConfig, clusterProvider *eks.ClusterProvid
 	var preAddons []*api.Addon
 	var postAddons []*api.Addon
 	for _, addon := range cfg.Addons {
-		if strings.ToLower(addon.Name) == "vpc-cni" {
+		switch strings.ToLower(addon.Name) {
+		case "vpc-cni":
 			preAddons = append(preAddons, addon)
-		} else {
+		case podIdentityAgentName:
+			// the pod identity agent must exist before the pod identity associations of other addons are created
+			postAddons = append([]*api.Addon{addon}, postAddons...)
+		default:
 			postAddons = append(postAddons, addon)
 		}
 	}
@@ -51,8 +51,21 @@ func (a *Manager) Update(addon *api.Addon, wait bool) error {
 		updateAddonInput.AddonVersion = &version
 	}
 
+	if addon.ADOT != nil && addon.ServiceAccountRoleARN == "" && !hasPoliciesSet(addon) {
+		// the policies of the collectors follow their pipelines
+		_, addon.AttachPolicyARNs, _ = a.getRecommendedPolicies(addon)
+	}
+
 	//check if we have been provided a different set of policies/role
-	if addon.ServiceAccountRoleARN != "" {
+	if len(addon.PodIdentityAssociations) > 0 {
+		roleARN, err := a.reconcilePodIdentityAssociations(addon)
+		if err != nil {
+			return err
+		}
+		if roleARN != "" {
+			updateAddonInput.ServiceAccountRoleArn = &roleARN
+		}
+	} else if addon.ServiceAccountRoleARN != "" {
 		updateAddonInput.ServiceAccountRoleArn = &addon.ServiceAccountRoleARN
 	} else if hasPoliciesSet(addon) {
 		serviceAccountRoleARN, err := a.updateWithNewPolicies(addon)
@@ -70,20 +83,34 @@ func (a *Manager) Update(addon *api.Addon, wait bool) error {
 	logger.Info("updating addon")
 	logger.Debug(updateAddonInput.String())
 
-	output, err := a.eksAPI.UpdateAddon(updateAddonInput)
+	configurationValues, err := a.makeConfigurationValues(addon, &updateAddonInput.ServiceAccountRoleArn)
 	if err != nil {
-		return fmt.Errorf("failed to update addon %q: %v", addon.Name, err)
+		return err
 	}
-	if output != nil {
-		logger.Debug(output.String())
+	if configurationValues != "" {
+		if err := a.updateAddonWithConfiguration(updateAddonInput, configurationValues); err != nil {
+			return err
+		}
+	} else {
+		output, err := a.eksAPI.UpdateAddon(updateAddonInput)
+		if err != nil {
+			return fmt.Errorf("failed to update addon %q: %v", addon.Name, err)
+		}
+		if output != nil {
+			logger.Debug(output.String())
+		}
 	}
 	if wait {
-		return a.waitForAddonToBeActive(addon)
+		if err := a.waitForAddonToBeActive(addon); err != nil {
+			return err
+		}
+		return a.waitForAddonRollout(addon)
 	}
 	return nil
 }
 
 func (a *Manager) updateWithNewPolicies(addon *api.Addon) (string, error) {
+	logger.Info("updating role using provided policies")
 	stackName := a.makeAddonName(addon.Name)
 	existingStacks, err := a.stackManager.ListStacksMatching(stackName)
 	if err != nil {
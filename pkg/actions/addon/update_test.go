@@ -107,6 +107,29 @@ var _ = Describe("Update", func() {
 			}).Return(&awseks.UpdateAddonOutput{}, nil)
 		})
 
+		When("creating an addon that already exists with CreateOrUpdate", func() {
+			It("updates the addon", func() {
+				mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Return(&awseks.DescribeAddonOutput{
+					Addon: &awseks.Addon{
+						AddonName:    aws.String("my-addon"),
+						AddonVersion: aws.String("v1.0.0-eksbuild.2"),
+						Status:       aws.String("created"),
+					},
+				}, nil).Once()
+
+				err := addonManager.CreateOrUpdate(&api.Addon{
+					Name:    "my-addon",
+					Version: "v1.7.5",
+				}, false)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*updateAddonInput.ClusterName).To(Equal("my-cluster"))
+				Expect(*updateAddonInput.AddonName).To(Equal("my-addon"))
+				Expect(*updateAddonInput.AddonVersion).To(Equal("v1.7.5-eksbuild.2"))
+				mockProvider.MockEKS().AssertNotCalled(GinkgoT(), "CreateAddon", mock.Anything)
+			})
+		})
+
 		When("updating the version", func() {
 			It("updates the addon and preserves the existing role", func() {
 				err := addonManager.Update(&api.Addon{
@@ -259,6 +282,39 @@ var _ = Describe("Update", func() {
 				})
 			})
 
+			When("podIdentityAssociations are configured", func() {
+				It("updates the stack of the associations", func() {
+					mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Return(&awseks.DescribeAddonOutput{
+						Addon: &awseks.Addon{
+							AddonName: aws.String("eks-pod-identity-agent"),
+							Status:    aws.String("ACTIVE"),
+						},
+					}, nil)
+					fakeStackManager.ListStacksMatchingReturns([]*manager.Stack{
+						{StackName: aws.String("eksctl-my-cluster-addon-my-addon")},
+					}, nil)
+
+					err := addonManager.Update(&api.Addon{
+						Name:    "my-addon",
+						Version: "v1.0.0-eksbuild.2",
+						PodIdentityAssociations: []api.PodIdentityAssociation{
+							{Namespace: "kube-system", ServiceAccountName: "sa-1", PermissionPolicyARNs: []string{"arn-1"}},
+						},
+					}, false)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+					Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+					options := fakeStackManager.UpdateStackArgsForCall(0)
+					Expect(options.StackName).To(Equal("eksctl-my-cluster-addon-my-addon"))
+					Expect(options.Wait).To(BeTrue())
+					template := string(options.TemplateData.(manager.TemplateBody))
+					Expect(template).To(ContainSubstring("AWS::EKS::PodIdentityAssociation"))
+					Expect(template).To(ContainSubstring("arn-1"))
+					Expect(updateAddonInput.ServiceAccountRoleArn).To(BeNil())
+				})
+			})
+
 			When("attachPolicyARNs is configured", func() {
 				When("its an update to an existing cloudformation", func() {
 					It("updates the stack", func() {
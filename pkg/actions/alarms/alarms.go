@@ -0,0 +1,159 @@
+package alarms
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// nodeCountMetrics are the group metrics of Auto Scaling groups the node count alarms use
+var nodeCountMetrics = []string{"GroupDesiredCapacity", "GroupInServiceInstances"}
+
+type Manager struct {
+	cfg          *api.ClusterConfig
+	stackManager manager.StackManager
+	ec2API       ec2iface.EC2API
+	asgAPI       autoscalingiface.AutoScalingAPI
+}
+
+func New(cfg *api.ClusterConfig, stackManager manager.StackManager, ec2API ec2iface.EC2API, asgAPI autoscalingiface.AutoScalingAPI) *Manager {
+	return &Manager{
+		cfg:          cfg,
+		stackManager: stackManager,
+		ec2API:       ec2API,
+		asgAPI:       asgAPI,
+	}
+}
+
+// Apply creates the stack of the alarms configured for the cluster, or updates it when it exists, so that
+// the alarms monitor the nodegroups and NAT gateways of the cluster at the time it is called
+func (m *Manager) Apply() error {
+	targets, err := m.findTargets()
+	if err != nil {
+		return err
+	}
+	resourceSet := builder.NewAlarmsResourceSet(m.cfg.Metadata.Name, m.cfg.Alarms, targets)
+	if err := resourceSet.AddAllResources(); err != nil {
+		return err
+	}
+
+	stackName := m.stackManager.MakeAlarmsStackName()
+	stack, err := m.stackManager.GetAlarmsStack()
+	if err != nil {
+		return err
+	}
+	if stack == nil {
+		logger.Info("creating CloudWatch alarms stack %q", stackName)
+		errs := make(chan error)
+		if err := m.stackManager.CreateStack(stackName, resourceSet, nil, nil, errs); err != nil {
+			return err
+		}
+		if err := <-errs; err != nil {
+			return errors.Wrapf(err, "creating CloudWatch alarms stack %q", stackName)
+		}
+		if len(m.cfg.Alarms.Emails) > 0 {
+			logger.Info("confirm the subscription emails sent to %s to receive notifications", strings.Join(m.cfg.Alarms.Emails, ", "))
+		}
+		return nil
+	}
+
+	template, err := resourceSet.RenderJSON()
+	if err != nil {
+		return errors.Wrapf(err, "rendering template for %q stack", stackName)
+	}
+	return m.stackManager.UpdateStack(manager.UpdateStackOptions{
+		StackName:     stackName,
+		ChangeSetName: m.stackManager.MakeChangeSetName("update-alarms"),
+		Description:   "updating CloudWatch alarms",
+		TemplateData:  manager.TemplateBody(template),
+		Wait:          true,
+	})
+}
+
+func (m *Manager) findTargets() (builder.AlarmTargets, error) {
+	var targets builder.AlarmTargets
+	if m.cfg.Alarms.NodeCount != nil {
+		asgs, err := m.findAutoScalingGroups()
+		if err != nil {
+			return targets, err
+		}
+		targets.AutoScalingGroups = asgs
+	}
+	if m.cfg.Alarms.NATGatewayBandwidth != nil {
+		natGatewayIDs, err := m.findNATGateways()
+		if err != nil {
+			return targets, err
+		}
+		targets.NATGatewayIDs = natGatewayIDs
+	}
+	return targets, nil
+}
+
+// findAutoScalingGroups returns the Auto Scaling groups of the nodegroups, and enables the collection of the group
+// metrics the node count alarms use, which Auto Scaling does not publish by default
+func (m *Manager) findAutoScalingGroups() ([]builder.NodeGroupAutoScalingGroup, error) {
+	stacks, err := m.stackManager.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	var asgs []builder.NodeGroupAutoScalingGroup
+	for _, s := range stacks {
+		nodeGroupName := m.stackManager.GetNodeGroupName(s)
+		names, err := m.stackManager.GetAutoScalingGroupName(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting Auto Scaling group of nodegroup %q", nodeGroupName)
+		}
+		for _, name := range strings.Split(names, ",") {
+			if name == "" {
+				continue
+			}
+			if _, err := m.asgAPI.EnableMetricsCollection(&autoscaling.EnableMetricsCollectionInput{
+				AutoScalingGroupName: aws.String(name),
+				Granularity:          aws.String("1Minute"),
+				Metrics:              aws.StringSlice(nodeCountMetrics),
+			}); err != nil {
+				return nil, errors.Wrapf(err, "enabling metrics collection of Auto Scaling group %q", name)
+			}
+			asgs = append(asgs, builder.NodeGroupAutoScalingGroup{NodeGroup: nodeGroupName, Name: name})
+		}
+	}
+	if len(asgs) == 0 {
+		logger.Warning("no nodegroups found, no node count alarms will be created")
+	}
+	return asgs, nil
+}
+
+func (m *Manager) findNATGateways() ([]string, error) {
+	if m.cfg.VPC == nil || m.cfg.VPC.ID == "" {
+		return nil, errors.New("the VPC of the cluster must be known to create NAT gateway alarms")
+	}
+	var natGatewayIDs []string
+	if err := m.ec2API.DescribeNatGatewaysPages(&ec2.DescribeNatGatewaysInput{
+		Filter: []*ec2.Filter{
+			{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{m.cfg.VPC.ID})},
+			{Name: aws.String("state"), Values: aws.StringSlice([]string{ec2.NatGatewayStateAvailable})},
+		},
+	}, func(output *ec2.DescribeNatGatewaysOutput, _ bool) bool {
+		for _, natGateway := range output.NatGateways {
+			natGatewayIDs = append(natGatewayIDs, aws.StringValue(natGateway.NatGatewayId))
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "describing NAT gateways of VPC %q", m.cfg.VPC.ID)
+	}
+	if len(natGatewayIDs) == 0 {
+		logger.Warning("no NAT gateways found in VPC %q, no NAT gateway bandwidth alarms will be created", m.cfg.VPC.ID)
+	}
+	return natGatewayIDs, nil
+}
@@ -0,0 +1,13 @@
+package alarms_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAlarms(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CloudWatch Alarms Suite")
+}
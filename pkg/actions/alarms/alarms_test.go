@@ -0,0 +1,109 @@
+package alarms_test
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/alarms"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("CloudWatch alarms", func() {
+	var (
+		cfg              *api.ClusterConfig
+		p                *mockprovider.MockProvider
+		fakeStackManager *fakes.FakeStackManager
+		resources        map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.VPC.ID = "vpc-123"
+		cfg.Alarms = &api.ClusterAlarms{
+			Emails:              []string{"ops@example.com"},
+			NodeCount:           &api.NodeCountAlarm{},
+			NATGatewayBandwidth: &api.NATGatewayBandwidthAlarm{BytesPerMinute: 1000},
+		}
+		api.SetClusterConfigDefaults(cfg)
+
+		p = mockprovider.NewMockProvider()
+		p.MockASG().On("EnableMetricsCollection", mock.MatchedBy(func(input *autoscaling.EnableMetricsCollectionInput) bool {
+			return aws.StringValue(input.AutoScalingGroupName) == "asg-1" &&
+				len(input.Metrics) == 2
+		})).Return(&autoscaling.EnableMetricsCollectionOutput{}, nil)
+		p.MockEC2().On("DescribeNatGatewaysPages", mock.MatchedBy(func(input *ec2.DescribeNatGatewaysInput) bool {
+			return aws.StringValue(input.Filter[0].Values[0]) == "vpc-123"
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeNatGatewaysOutput, bool) bool)
+			consume(&ec2.DescribeNatGatewaysOutput{
+				NatGateways: []*ec2.NatGateway{{NatGatewayId: aws.String("nat-1")}},
+			}, true)
+		}).Return(nil)
+
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.MakeAlarmsStackNameReturns("eksctl-my-cluster-alarms")
+		fakeStackManager.DescribeNodeGroupStacksReturns([]*manager.Stack{{StackName: aws.String("eksctl-my-cluster-nodegroup-ng-1")}}, nil)
+		fakeStackManager.GetNodeGroupNameReturns("ng-1")
+		fakeStackManager.GetAutoScalingGroupNameReturns("asg-1", nil)
+		fakeStackManager.CreateStackStub = func(_ string, rs builder.ResourceSet, _, _ map[string]string, errs chan error) error {
+			resources = renderResources(rs.RenderJSON())
+			go func() {
+				errs <- nil
+			}()
+			return nil
+		}
+	})
+
+	It("creates the alarms stack for the nodegroups and NAT gateways of the cluster", func() {
+		Expect(alarms.New(cfg, fakeStackManager, p.MockEC2(), p.MockASG()).Apply()).To(Succeed())
+
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+		stackName, _, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+		Expect(stackName).To(Equal("eksctl-my-cluster-alarms"))
+		Expect(resources).To(HaveKey("AlarmsTopic"))
+		Expect(resources).To(HaveKey("NodeCountAlarmng1"))
+		Expect(resources).To(HaveKey("NATGatewayBandwidthAlarmnat1"))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+		p.MockASG().AssertExpectations(GinkgoT())
+	})
+
+	It("updates the alarms stack when it exists", func() {
+		fakeStackManager.GetAlarmsStackReturns(&manager.Stack{StackName: aws.String("eksctl-my-cluster-alarms"), StackStatus: aws.String(cfn.StackStatusCreateComplete)}, nil)
+		fakeStackManager.MakeChangeSetNameReturns("eksctl-update-alarms")
+
+		Expect(alarms.New(cfg, fakeStackManager, p.MockEC2(), p.MockASG()).Apply()).To(Succeed())
+
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+		options := fakeStackManager.UpdateStackArgsForCall(0)
+		Expect(options.StackName).To(Equal("eksctl-my-cluster-alarms"))
+		Expect(options.ChangeSetName).To(Equal("eksctl-update-alarms"))
+		Expect(options.Wait).To(BeTrue())
+		resources = renderResources(options.TemplateData.(manager.TemplateBody), nil)
+		Expect(resources).To(HaveKey("NodeCountAlarmng1"))
+	})
+
+	It("requires the VPC of the cluster for NAT gateway alarms", func() {
+		cfg.VPC.ID = ""
+		Expect(alarms.New(cfg, fakeStackManager, p.MockEC2(), p.MockASG()).Apply()).To(MatchError(ContainSubstring("the VPC of the cluster must be known")))
+	})
+})
+
+func renderResources(templateBody []byte, err error) map[string]interface{} {
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	var template map[string]interface{}
+	ExpectWithOffset(1, json.Unmarshal(templateBody, &template)).To(Succeed())
+	return template["Resources"].(map[string]interface{})
+}
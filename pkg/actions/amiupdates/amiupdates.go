@@ -0,0 +1,314 @@
+// Package amiupdates compares the AMIs of the nodegroups of a cluster to the latest release of their channel, the
+// EKS optimized Amazon Linux 2 or Amazon Linux 2023 AMIs or the Bottlerocket AMIs for the Kubernetes version of the
+// nodegroup.
+package amiupdates
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// ImageFamilyAmazonLinux2023 is the channel of the EKS optimized Amazon Linux 2023 AMIs. It is not an amiFamily of
+// eksctl, but nodegroups can use these AMIs as custom AMIs, or as the AMI type of managed nodegroups
+const ImageFamilyAmazonLinux2023 = "AmazonLinux2023"
+
+var (
+	// amazon-eks-node-1.21-v20220123, amazon-eks-gpu-node-1.21-v20220123, amazon-eks-arm64-node-1.21-v20220123
+	amazonLinux2ImageName = regexp.MustCompile(`^amazon-eks-(?:gpu-|arm64-)?node-(\d+\.\d+)-(v\d+)$`)
+	// amazon-eks-node-al2023-x86_64-standard-1.29-v20240213, amazon-eks-node-al2023-arm64-standard-1.29-v20240213
+	amazonLinux2023ImageName = regexp.MustCompile(`^amazon-eks-node-al2023-(x86_64|arm64)-(standard|nvidia|neuron)-(\d+\.\d+)-(v\d+)$`)
+	// bottlerocket-aws-k8s-1.21-x86_64-v1.5.2-1602f3a1
+	bottlerocketImageName = regexp.MustCompile(`^bottlerocket-aws-k8s-(\d+\.\d+)-(?:x86_64|aarch64)-(v\d+\.\d+\.\d+)-`)
+)
+
+// Status is the AMI of a nodegroup compared to the latest release of its channel
+type Status struct {
+	NodeGroup string
+	Managed   bool
+	// ImageFamily is the channel of the AMI, empty when the AMI is not in a known channel
+	ImageFamily string
+	// Current and Latest are AMI IDs for unmanaged nodegroups, and release versions for managed nodegroups
+	Current string
+	Latest  string
+	// CurrentRelease and LatestRelease are the tags of the releases of the AMIs, e.g. v20220123 or v1.5.2
+	CurrentRelease string
+	LatestRelease  string
+	// SecurityFixes are the CVE and Amazon Linux security advisory IDs mentioned in the release notes of the releases
+	// after the current one, up to the latest
+	SecurityFixes []string
+	// SkipReason is why the AMI was not compared
+	SkipReason string
+}
+
+// UpdateAvailable returns whether a newer AMI is released in the channel of the nodegroup
+func (s Status) UpdateAvailable() bool {
+	return s.SkipReason == "" && s.Latest != "" && s.Current != s.Latest
+}
+
+// Checker checks the AMIs of the nodegroups of a cluster for updates
+type Checker struct {
+	clusterName  string
+	stackManager manager.StackManager
+	eksAPI       eksiface.EKSAPI
+	ec2API       ec2iface.EC2API
+	ssmAPI       ssmiface.SSMAPI
+	releaseNotes ReleaseNotes
+}
+
+// New creates a Checker, releaseNotes may be nil to not report security fixes
+func New(clusterName string, stackManager manager.StackManager, eksAPI eksiface.EKSAPI, ec2API ec2iface.EC2API, ssmAPI ssmiface.SSMAPI, releaseNotes ReleaseNotes) *Checker {
+	return &Checker{
+		clusterName:  clusterName,
+		stackManager: stackManager,
+		eksAPI:       eksAPI,
+		ec2API:       ec2API,
+		ssmAPI:       ssmAPI,
+		releaseNotes: releaseNotes,
+	}
+}
+
+// Check returns the status of the AMI of each nodegroup of the cluster
+func (c *Checker) Check() ([]Status, error) {
+	var statuses []Status
+
+	summaries, err := c.stackManager.GetUnmanagedNodeGroupSummaries("")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup stack summaries")
+	}
+	for _, summary := range summaries {
+		status, err := c.checkUnmanaged(summary)
+		if err != nil {
+			return nil, errors.Wrapf(err, "checking AMI of nodegroup %q", summary.Name)
+		}
+		statuses = append(statuses, status)
+	}
+
+	managedNodeGroups, err := eks.ListNodegroups(c.eksAPI, c.clusterName)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range managedNodeGroups {
+		output, err := c.eksAPI.DescribeNodegroup(&awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String(c.clusterName),
+			NodegroupName: name,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing nodegroup %q", *name)
+		}
+		status, err := c.checkManaged(output.Nodegroup)
+		if err != nil {
+			return nil, errors.Wrapf(err, "checking AMI of nodegroup %q", *name)
+		}
+		statuses = append(statuses, status)
+	}
+
+	for i, status := range statuses {
+		if !status.UpdateAvailable() || c.releaseNotes == nil {
+			continue
+		}
+		fixes, err := c.releaseNotes.SecurityFixes(status.ImageFamily, status.CurrentRelease, status.LatestRelease)
+		if err != nil {
+			logger.Warning("unable to read the release notes of %s AMIs: %v", status.ImageFamily, err)
+			continue
+		}
+		statuses[i].SecurityFixes = fixes
+	}
+	return statuses, nil
+}
+
+func (c *Checker) checkUnmanaged(summary *manager.NodeGroupSummary) (Status, error) {
+	status := Status{
+		NodeGroup: summary.Name,
+		Current:   summary.ImageID,
+	}
+	image, err := c.describeImage(summary.ImageID)
+	if err != nil {
+		return status, err
+	}
+	if image == nil {
+		status.SkipReason = fmt.Sprintf("AMI %s is not found", summary.ImageID)
+		return status, nil
+	}
+	imageName := aws.StringValue(image.Name)
+	var version string
+	status.ImageFamily, version, status.CurrentRelease = parseImageName(imageName)
+	if status.ImageFamily == "" {
+		status.SkipReason = fmt.Sprintf("AMI %s is not an EKS optimized Amazon Linux 2, Amazon Linux 2023 or Bottlerocket AMI", summary.ImageID)
+		return status, nil
+	}
+
+	var parameterName string
+	if status.ImageFamily == ImageFamilyAmazonLinux2023 {
+		m := amazonLinux2023ImageName.FindStringSubmatch(imageName)
+		parameterName = amazonLinux2023ParameterName(version, m[1], m[2], "image_id")
+	} else if parameterName, err = ami.MakeSSMParameterName(version, summary.InstanceType, status.ImageFamily); err != nil {
+		return status, err
+	}
+	if status.Latest, err = c.getParameter(parameterName); err != nil {
+		return status, err
+	}
+	if status.Latest == status.Current {
+		status.LatestRelease = status.CurrentRelease
+		return status, nil
+	}
+	latestImage, err := c.describeImage(status.Latest)
+	if err != nil {
+		return status, err
+	}
+	if latestImage != nil {
+		_, _, status.LatestRelease = parseImageName(aws.StringValue(latestImage.Name))
+	}
+	return status, nil
+}
+
+func (c *Checker) checkManaged(ng *awseks.Nodegroup) (Status, error) {
+	status := Status{
+		NodeGroup: aws.StringValue(ng.NodegroupName),
+		Managed:   true,
+		Current:   aws.StringValue(ng.ReleaseVersion),
+	}
+	version := aws.StringValue(ng.Version)
+
+	var parameterName string
+	switch amiType := aws.StringValue(ng.AmiType); amiType {
+	case awseks.AMITypesAl2X8664, awseks.AMITypesAl2X8664Gpu, awseks.AMITypesAl2Arm64:
+		status.ImageFamily = api.NodeImageFamilyAmazonLinux2
+		parameterName = fmt.Sprintf("/aws/service/eks/optimized-ami/%s/%s/recommended/release_version", version, managedImageType(amiType))
+		status.CurrentRelease = amazonLinux2Release(status.Current)
+	case amiTypeAL2023X8664Standard, amiTypeAL2023Arm64Standard, amiTypeAL2023X8664Nvidia, amiTypeAL2023X8664Neuron:
+		status.ImageFamily = ImageFamilyAmazonLinux2023
+		arch, flavor := amazonLinux2023Variant(amiType)
+		parameterName = amazonLinux2023ParameterName(version, arch, flavor, "release_version")
+		status.CurrentRelease = amazonLinux2Release(status.Current)
+	case awseks.AMITypesBottlerocketX8664, awseks.AMITypesBottlerocketArm64:
+		status.ImageFamily = api.NodeImageFamilyBottlerocket
+		arch := "x86_64"
+		if amiType == awseks.AMITypesBottlerocketArm64 {
+			arch = "arm64"
+		}
+		parameterName = fmt.Sprintf("/aws/service/bottlerocket/aws-k8s-%s/%s/latest/image_version", version, arch)
+		status.CurrentRelease = bottlerocketRelease(status.Current)
+	default:
+		status.SkipReason = fmt.Sprintf("AMI type %s is not in a release channel", amiType)
+		return status, nil
+	}
+
+	var err error
+	if status.Latest, err = c.getParameter(parameterName); err != nil {
+		return status, err
+	}
+	if status.ImageFamily == api.NodeImageFamilyBottlerocket {
+		status.LatestRelease = bottlerocketRelease(status.Latest)
+	} else {
+		status.LatestRelease = amazonLinux2Release(status.Latest)
+	}
+	return status, nil
+}
+
+func (c *Checker) describeImage(imageID string) (*ec2.Image, error) {
+	output, err := c.ec2API.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{imageID}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing AMI %s", imageID)
+	}
+	if len(output.Images) == 0 {
+		return nil, nil
+	}
+	return output.Images[0], nil
+}
+
+func (c *Checker) getParameter(name string) (string, error) {
+	output, err := c.ssmAPI.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "getting SSM parameter %s", name)
+	}
+	return aws.StringValue(output.Parameter.Value), nil
+}
+
+// parseImageName returns the image family, the Kubernetes version and the release of an EKS optimized AMI
+func parseImageName(name string) (imageFamily, version, release string) {
+	if m := amazonLinux2ImageName.FindStringSubmatch(name); m != nil {
+		return api.NodeImageFamilyAmazonLinux2, m[1], m[2]
+	}
+	if m := amazonLinux2023ImageName.FindStringSubmatch(name); m != nil {
+		return ImageFamilyAmazonLinux2023, m[3], m[4]
+	}
+	if m := bottlerocketImageName.FindStringSubmatch(name); m != nil {
+		return api.NodeImageFamilyBottlerocket, m[1], m[2]
+	}
+	return "", "", ""
+}
+
+// AMI types of the Amazon Linux 2023 managed nodegroups, which the EKS API of this version of the AWS SDK does not
+// declare
+const (
+	amiTypeAL2023X8664Standard = "AL2023_x86_64_STANDARD"
+	amiTypeAL2023Arm64Standard = "AL2023_ARM_64_STANDARD"
+	amiTypeAL2023X8664Nvidia   = "AL2023_x86_64_NVIDIA"
+	amiTypeAL2023X8664Neuron   = "AL2023_x86_64_NEURON"
+)
+
+// amazonLinux2023Variant returns the architecture and the flavor of the AMIs of an Amazon Linux 2023 AMI type
+func amazonLinux2023Variant(amiType string) (arch, flavor string) {
+	switch amiType {
+	case amiTypeAL2023Arm64Standard:
+		return "arm64", "standard"
+	case amiTypeAL2023X8664Nvidia:
+		return "x86_64", "nvidia"
+	case amiTypeAL2023X8664Neuron:
+		return "x86_64", "neuron"
+	default:
+		return "x86_64", "standard"
+	}
+}
+
+// amazonLinux2023ParameterName returns the name of an SSM parameter of the recommended Amazon Linux 2023 AMI
+func amazonLinux2023ParameterName(version, arch, flavor, parameter string) string {
+	return fmt.Sprintf("/aws/service/eks/optimized-ami/%s/amazon-linux-2023/%s/%s/recommended/%s", version, arch, flavor, parameter)
+}
+
+// amazonLinux2Release returns the release of a managed nodegroup release version, e.g. v20220123 for 1.21.5-20220123
+func amazonLinux2Release(releaseVersion string) string {
+	if i := strings.LastIndex(releaseVersion, "-"); i >= 0 {
+		return "v" + releaseVersion[i+1:]
+	}
+	return ""
+}
+
+// bottlerocketRelease returns the release of a Bottlerocket image version, e.g. v1.5.2 for 1.5.2-1602f3a1
+func bottlerocketRelease(imageVersion string) string {
+	if imageVersion == "" {
+		return ""
+	}
+	return "v" + strings.SplitN(imageVersion, "-", 2)[0]
+}
+
+func managedImageType(amiType string) string {
+	switch amiType {
+	case awseks.AMITypesAl2X8664Gpu:
+		return "amazon-linux-2-gpu"
+	case awseks.AMITypesAl2Arm64:
+		return "amazon-linux-2-arm64"
+	default:
+		return "amazon-linux-2"
+	}
+}
@@ -0,0 +1,13 @@
+package amiupdates_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAMIUpdates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AMI Updates Suite")
+}
@@ -0,0 +1,308 @@
+package amiupdates_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/amiupdates"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	executorfakes "github.com/weaveworks/eksctl/pkg/executor/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+type fakeReleaseNotes struct{}
+
+func (fakeReleaseNotes) SecurityFixes(imageFamily, currentRelease, latestRelease string) ([]string, error) {
+	return []string{fmt.Sprintf("CVE-%s-%s", currentRelease, latestRelease)}, nil
+}
+
+var _ = Describe("AMI updates", func() {
+	Describe("Checker", func() {
+		var (
+			p            *mockprovider.MockProvider
+			stackManager *fakes.FakeStackManager
+		)
+
+		mockImage := func(id, name string) {
+			p.MockEC2().On("DescribeImages", &ec2.DescribeImagesInput{ImageIds: aws.StringSlice([]string{id})}).
+				Return(&ec2.DescribeImagesOutput{Images: []*ec2.Image{{ImageId: aws.String(id), Name: aws.String(name)}}}, nil)
+		}
+		mockParameter := func(name, value string) {
+			p.MockSSM().On("GetParameter", &ssm.GetParameterInput{Name: aws.String(name)}).
+				Return(&ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(value)}}, nil)
+		}
+		mockManagedNodeGroups := func(nodeGroups ...*awseks.Nodegroup) {
+			var names []*string
+			for _, ng := range nodeGroups {
+				names = append(names, ng.NodegroupName)
+				p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{ClusterName: aws.String("my-cluster"), NodegroupName: ng.NodegroupName}).
+					Return(&awseks.DescribeNodegroupOutput{Nodegroup: ng}, nil)
+			}
+			p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: names}, true)
+			}).Return(nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			stackManager = &fakes.FakeStackManager{}
+		})
+
+		It("compares the AMIs of unmanaged and managed nodegroups to the latest release of their channel", func() {
+			stackManager.GetUnmanagedNodeGroupSummariesReturns([]*manager.NodeGroupSummary{
+				{Name: "al2", ImageID: "ami-old", InstanceType: "m5.large"},
+				{Name: "custom", ImageID: "ami-custom", InstanceType: "m5.large"},
+			}, nil)
+			mockImage("ami-old", "amazon-eks-node-1.21-v20220112")
+			mockImage("ami-new", "amazon-eks-node-1.21-v20220123")
+			mockImage("ami-custom", "my-golden-image")
+			mockParameter("/aws/service/eks/optimized-ami/1.21/amazon-linux-2/recommended/image_id", "ami-new")
+
+			mockManagedNodeGroups(&awseks.Nodegroup{
+				NodegroupName:  aws.String("bottlerocket"),
+				AmiType:        aws.String(awseks.AMITypesBottlerocketArm64),
+				Version:        aws.String("1.21"),
+				ReleaseVersion: aws.String("1.5.2-1602f3a1"),
+			}, &awseks.Nodegroup{
+				NodegroupName:  aws.String("gpu"),
+				AmiType:        aws.String(awseks.AMITypesAl2X8664Gpu),
+				Version:        aws.String("1.20"),
+				ReleaseVersion: aws.String("1.20.11-20220123"),
+			})
+			mockParameter("/aws/service/bottlerocket/aws-k8s-1.21/arm64/latest/image_version", "1.5.3-a5c0c5e3")
+			mockParameter("/aws/service/eks/optimized-ami/1.20/amazon-linux-2-gpu/recommended/release_version", "1.20.11-20220123")
+
+			statuses, err := amiupdates.New("my-cluster", stackManager, p.EKS(), p.EC2(), p.SSM(), fakeReleaseNotes{}).Check()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(Equal([]amiupdates.Status{
+				{
+					NodeGroup:      "al2",
+					ImageFamily:    api.NodeImageFamilyAmazonLinux2,
+					Current:        "ami-old",
+					Latest:         "ami-new",
+					CurrentRelease: "v20220112",
+					LatestRelease:  "v20220123",
+					SecurityFixes:  []string{"CVE-v20220112-v20220123"},
+				},
+				{
+					NodeGroup:  "custom",
+					Current:    "ami-custom",
+					SkipReason: "AMI ami-custom is not an EKS optimized Amazon Linux 2, Amazon Linux 2023 or Bottlerocket AMI",
+				},
+				{
+					NodeGroup:      "bottlerocket",
+					Managed:        true,
+					ImageFamily:    api.NodeImageFamilyBottlerocket,
+					Current:        "1.5.2-1602f3a1",
+					Latest:         "1.5.3-a5c0c5e3",
+					CurrentRelease: "v1.5.2",
+					LatestRelease:  "v1.5.3",
+					SecurityFixes:  []string{"CVE-v1.5.2-v1.5.3"},
+				},
+				{
+					NodeGroup:      "gpu",
+					Managed:        true,
+					ImageFamily:    api.NodeImageFamilyAmazonLinux2,
+					Current:        "1.20.11-20220123",
+					Latest:         "1.20.11-20220123",
+					CurrentRelease: "v20220123",
+					LatestRelease:  "v20220123",
+				},
+			}))
+
+			Expect(amiupdates.UpgradeCommands("my-cluster", statuses)).To(Equal([]string{
+				`# nodegroup "al2" is unmanaged, replace it by a nodegroup using AMI ami-new declared in the config file`,
+				"eksctl create nodegroup --config-file=<config-file> --include=<new-nodegroup>",
+				"eksctl delete nodegroup --cluster=my-cluster --name=al2 --approve",
+				"eksctl upgrade nodegroup --cluster=my-cluster --name=bottlerocket --release-version=1.5.3-a5c0c5e3",
+			}))
+		})
+
+		It("compares Amazon Linux 2023 AMIs to the latest release of their channel", func() {
+			stackManager.GetUnmanagedNodeGroupSummariesReturns([]*manager.NodeGroupSummary{
+				{Name: "al2023", ImageID: "ami-old", InstanceType: "m6g.large"},
+			}, nil)
+			mockImage("ami-old", "amazon-eks-node-al2023-arm64-standard-1.29-v20240202")
+			mockImage("ami-new", "amazon-eks-node-al2023-arm64-standard-1.29-v20240213")
+			mockParameter("/aws/service/eks/optimized-ami/1.29/amazon-linux-2023/arm64/standard/recommended/image_id", "ami-new")
+
+			mockManagedNodeGroups(&awseks.Nodegroup{
+				NodegroupName:  aws.String("managed-al2023"),
+				AmiType:        aws.String("AL2023_x86_64_NVIDIA"),
+				Version:        aws.String("1.29"),
+				ReleaseVersion: aws.String("1.29.0-20240202"),
+			})
+			mockParameter("/aws/service/eks/optimized-ami/1.29/amazon-linux-2023/x86_64/nvidia/recommended/release_version", "1.29.0-20240213")
+
+			statuses, err := amiupdates.New("my-cluster", stackManager, p.EKS(), p.EC2(), p.SSM(), nil).Check()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(Equal([]amiupdates.Status{
+				{
+					NodeGroup:      "al2023",
+					ImageFamily:    amiupdates.ImageFamilyAmazonLinux2023,
+					Current:        "ami-old",
+					Latest:         "ami-new",
+					CurrentRelease: "v20240202",
+					LatestRelease:  "v20240213",
+				},
+				{
+					NodeGroup:      "managed-al2023",
+					Managed:        true,
+					ImageFamily:    amiupdates.ImageFamilyAmazonLinux2023,
+					Current:        "1.29.0-20240202",
+					Latest:         "1.29.0-20240213",
+					CurrentRelease: "v20240202",
+					LatestRelease:  "v20240213",
+				},
+			}))
+		})
+
+		It("skips managed nodegroups with a custom AMI", func() {
+			mockManagedNodeGroups(&awseks.Nodegroup{
+				NodegroupName:  aws.String("custom"),
+				AmiType:        aws.String(awseks.AMITypesCustom),
+				ReleaseVersion: aws.String("ami-custom"),
+			})
+
+			statuses, err := amiupdates.New("my-cluster", stackManager, p.EKS(), p.EC2(), p.SSM(), nil).Check()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses).To(HaveLen(1))
+			Expect(statuses[0].SkipReason).To(Equal("AMI type CUSTOM is not in a release channel"))
+			Expect(statuses[0].UpdateAvailable()).To(BeFalse())
+		})
+	})
+
+	Describe("GitHubReleaseNotes", func() {
+		var (
+			server   *httptest.Server
+			requests int
+		)
+
+		BeforeEach(func() {
+			requests = 0
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				Expect(r.URL.Path).To(Equal("/repos/awslabs/amazon-eks-ami/releases"))
+				fmt.Fprint(w, `[
+					{"tag_name": "v20220210", "body": "Patches CVE-2022-0001"},
+					{"tag_name": "v20220123", "body": "Updates the kernel for ALAS2KERNEL-5.4-2022-017 and CVE-2021-4155, CVE-2021-4155"},
+					{"tag_name": "v20220119", "body": "Fixes ALAS2-2022-1735"},
+					{"tag_name": "v20220112", "body": "Patches CVE-2021-44228"}
+				]`)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("returns the security fixes of the releases after the current one, up to the latest", func() {
+			releaseNotes := &amiupdates.GitHubReleaseNotes{BaseURL: server.URL, Client: server.Client()}
+			fixes, err := releaseNotes.SecurityFixes(api.NodeImageFamilyAmazonLinux2, "v20220112", "v20220123")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fixes).To(Equal([]string{"ALAS2-2022-1735", "ALAS2KERNEL-5.4-2022-017", "CVE-2021-4155"}))
+
+			_, err = releaseNotes.SecurityFixes(api.NodeImageFamilyAmazonLinux2, "v20220119", "v20220210")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests).To(Equal(1))
+		})
+	})
+
+	Describe("PullRequest", func() {
+		const config = `nodeGroups:
+  - name: al2
+    ami: ami-old # pinned until the next review
+managedNodeGroups:
+  - name: bottlerocket
+    releaseVersion: 1.5.2-1602f3a1
+`
+		var statuses []amiupdates.Status
+
+		BeforeEach(func() {
+			statuses = []amiupdates.Status{
+				{NodeGroup: "al2", ImageFamily: api.NodeImageFamilyAmazonLinux2, Current: "ami-old", Latest: "ami-new", SecurityFixes: []string{"CVE-2021-4155"}},
+				{NodeGroup: "bottlerocket", Managed: true, ImageFamily: api.NodeImageFamilyBottlerocket, Current: "1.5.2-1602f3a1", Latest: "1.5.3-a5c0c5e3"},
+				{NodeGroup: "other", Managed: true, ImageFamily: api.NodeImageFamilyAmazonLinux2, Current: "1.21.5-20220112", Latest: "1.21.5-20220123"},
+			}
+		})
+
+		It("updates the AMIs pinned in the config file", func() {
+			updated, updates, err := amiupdates.UpdateConfig([]byte(config), statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(updated)).To(Equal(`nodeGroups:
+  - name: al2
+    ami: ami-new # pinned until the next review
+managedNodeGroups:
+  - name: bottlerocket
+    releaseVersion: 1.5.3-a5c0c5e3
+`))
+			Expect(updates).To(Equal(statuses[:2]))
+		})
+
+		It("only updates the fields of the nodegroups of the statuses", func() {
+			const config = `nodeGroups:
+  - name: al2
+    ami: "ami-old"
+  - name: copy
+    ami: ami-old
+    tags:
+      previous-ami: ami-old
+`
+			updated, updates, err := amiupdates.UpdateConfig([]byte(config), statuses)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(updated)).To(Equal(`nodeGroups:
+  - name: al2
+    ami: "ami-new"
+  - name: copy
+    ami: ami-old
+    tags:
+      previous-ami: ami-old
+`))
+			Expect(updates).To(Equal(statuses[:1]))
+		})
+
+		It("commits the updated config file to a new branch and opens a pull request", func() {
+			dir, err := os.MkdirTemp("", "amiupdates")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+			configFile := filepath.Join(dir, "cluster.yaml")
+			Expect(os.WriteFile(configFile, []byte(config), 0644)).To(Succeed())
+
+			fakeExecutor := &executorfakes.FakeExecutor{}
+			pr := amiupdates.NewPullRequest("my-cluster", configFile)
+			pr.Executor = fakeExecutor
+			pr.Now = func() time.Time { return time.Date(2022, 2, 1, 10, 0, 0, 0, time.UTC) }
+			Expect(pr.Open(statuses)).To(Succeed())
+
+			Expect(fakeExecutor.ExecInDirCallCount()).To(Equal(4))
+			command, commandDir, args := fakeExecutor.ExecInDirArgsForCall(0)
+			Expect(command).To(Equal("git"))
+			Expect(commandDir).To(Equal(dir + "/"))
+			Expect(args).To(Equal([]string{"checkout", "-b", "eksctl-ami-updates-my-cluster-20220201100000"}))
+			_, _, args = fakeExecutor.ExecInDirArgsForCall(1)
+			Expect(args).To(Equal([]string{"commit", "-m", "Update AMIs of the nodegroups of cluster my-cluster", "--", "cluster.yaml"}))
+			command, _, args = fakeExecutor.ExecInDirArgsForCall(3)
+			Expect(command).To(Equal("gh"))
+			Expect(args[len(args)-1]).To(ContainSubstring("- `al2` (AmazonLinux2): `ami-old` → `ami-new`, fixes CVE-2021-4155\n"))
+
+			updated, err := os.ReadFile(configFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(updated)).To(ContainSubstring("ami: ami-new"))
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package amiupdates
+
+import (
+	"fmt"
+)
+
+// UpgradeCommands returns the commands updating the nodegroups with a newer AMI. Managed nodegroups are upgraded in
+// place, while unmanaged nodegroups are immutable and are replaced by a nodegroup created from the config file
+func UpgradeCommands(clusterName string, statuses []Status) []string {
+	var commands []string
+	for _, s := range statuses {
+		if !s.UpdateAvailable() {
+			continue
+		}
+		if s.Managed {
+			commands = append(commands, fmt.Sprintf("eksctl upgrade nodegroup --cluster=%s --name=%s --release-version=%s", clusterName, s.NodeGroup, s.Latest))
+			continue
+		}
+		commands = append(commands,
+			fmt.Sprintf("# nodegroup %q is unmanaged, replace it by a nodegroup using AMI %s declared in the config file", s.NodeGroup, s.Latest),
+			"eksctl create nodegroup --config-file=<config-file> --include=<new-nodegroup>",
+			fmt.Sprintf("eksctl delete nodegroup --cluster=%s --name=%s --approve", clusterName, s.NodeGroup),
+		)
+	}
+	return commands
+}
@@ -0,0 +1,157 @@
+package amiupdates
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"gopkg.in/yaml.v3"
+
+	"github.com/weaveworks/eksctl/pkg/executor"
+)
+
+// PullRequest opens a pull request updating the AMI IDs and release versions pinned in a config file, in the git
+// repository of the config file, with `git` and the GitHub CLI `gh`
+type PullRequest struct {
+	ClusterName string
+	ConfigFile  string
+	Executor    executor.Executor
+	Now         func() time.Time
+}
+
+// NewPullRequest creates a PullRequest updating configFile
+func NewPullRequest(clusterName, configFile string) *PullRequest {
+	return &PullRequest{
+		ClusterName: clusterName,
+		ConfigFile:  configFile,
+		Executor:    executor.NewShellExecutor(executor.EnvVars{}),
+		Now:         time.Now,
+	}
+}
+
+// UpdateConfig replaces the outdated AMI IDs and release versions pinned in the config file with the latest ones,
+// keeping its formatting and comments, and returns the updated nodegroups. Only the `ami` of the unmanaged nodegroup
+// and the `releaseVersion` of the managed nodegroup each status is for are replaced
+func UpdateConfig(config []byte, statuses []Status) ([]byte, []Status, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(config, &doc); err != nil {
+		return nil, nil, fmt.Errorf("parsing config file: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return config, nil, nil
+	}
+
+	lines := strings.SplitAfter(string(config), "\n")
+	var updates []Status
+	for _, s := range statuses {
+		if !s.UpdateAvailable() {
+			continue
+		}
+		nodeGroupsKey, field := "nodeGroups", "ami"
+		if s.Managed {
+			nodeGroupsKey, field = "managedNodeGroups", "releaseVersion"
+		}
+		value := pinnedValue(doc.Content[0], nodeGroupsKey, s.NodeGroup, field)
+		if value == nil || value.Value != s.Current {
+			continue
+		}
+		// the position of the value is used to replace it in place, as encoding the document would reformat it
+		line, column := value.Line-1, value.Column-1
+		i := strings.Index(lines[line][column:], s.Current)
+		if i < 0 {
+			continue
+		}
+		i += column
+		lines[line] = lines[line][:i] + s.Latest + lines[line][i+len(s.Current):]
+		updates = append(updates, s)
+	}
+	return []byte(strings.Join(lines, "")), updates, nil
+}
+
+// pinnedValue returns the value of field in the nodegroup named nodeGroupName of the list nodeGroupsKey, or nil
+func pinnedValue(config *yaml.Node, nodeGroupsKey, nodeGroupName, field string) *yaml.Node {
+	nodeGroups := mappingValue(config, nodeGroupsKey)
+	if nodeGroups == nil || nodeGroups.Kind != yaml.SequenceNode {
+		return nil
+	}
+	for _, ng := range nodeGroups.Content {
+		if name := mappingValue(ng, "name"); name != nil && name.Value == nodeGroupName {
+			if value := mappingValue(ng, field); value != nil && value.Kind == yaml.ScalarNode {
+				return value
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// Open commits the updated config file to a new branch, pushes it to the `origin` remote and opens a pull request
+// against the default branch of the repository. It opens no pull request when the config file pins none of the
+// outdated AMIs, as nodegroups without a pinned AMI get the latest one when they are upgraded or replaced
+func (p *PullRequest) Open(statuses []Status) error {
+	config, err := os.ReadFile(p.ConfigFile)
+	if err != nil {
+		return err
+	}
+	updated, updates, err := UpdateConfig(config, statuses)
+	if err != nil {
+		return fmt.Errorf("updating %q: %w", p.ConfigFile, err)
+	}
+	if len(updates) == 0 {
+		logger.Info("config file %q pins none of the outdated AMIs, not opening a pull request", p.ConfigFile)
+		return nil
+	}
+
+	dir, file := filepath.Split(p.ConfigFile)
+	if dir == "" {
+		dir = "."
+	}
+	branch := fmt.Sprintf("eksctl-ami-updates-%s-%s", p.ClusterName, p.Now().UTC().Format("20060102150405"))
+	title := fmt.Sprintf("Update AMIs of the nodegroups of cluster %s", p.ClusterName)
+
+	if err := p.Executor.ExecInDir("git", dir, "checkout", "-b", branch); err != nil {
+		return fmt.Errorf("creating branch %q: %w", branch, err)
+	}
+	if err := os.WriteFile(p.ConfigFile, updated, 0644); err != nil {
+		return err
+	}
+	if err := p.Executor.ExecInDir("git", dir, "commit", "-m", title, "--", file); err != nil {
+		return fmt.Errorf("committing %q: %w", p.ConfigFile, err)
+	}
+	if err := p.Executor.ExecInDir("git", dir, "push", "--set-upstream", "origin", branch); err != nil {
+		return fmt.Errorf("pushing branch %q: %w", branch, err)
+	}
+	if err := p.Executor.ExecInDir("gh", dir, "pr", "create", "--head", branch, "--title", title, "--body", pullRequestBody(updates)); err != nil {
+		return fmt.Errorf("opening pull request: %w", err)
+	}
+	logger.Success("opened a pull request updating the AMIs of %d nodegroup(s) from branch %q", len(updates), branch)
+	return nil
+}
+
+func pullRequestBody(updates []Status) string {
+	var body strings.Builder
+	body.WriteString("Updates the AMIs of the following nodegroups to the latest release of their channel:\n\n")
+	for _, s := range updates {
+		fmt.Fprintf(&body, "- `%s` (%s): `%s` → `%s`", s.NodeGroup, s.ImageFamily, s.Current, s.Latest)
+		if len(s.SecurityFixes) > 0 {
+			fmt.Fprintf(&body, ", fixes %s", strings.Join(s.SecurityFixes, ", "))
+		}
+		body.WriteString("\n")
+	}
+	return body.String()
+}
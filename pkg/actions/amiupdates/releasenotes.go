@@ -0,0 +1,120 @@
+package amiupdates
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	// GitHubAPIURL is the URL of the GitHub API the release notes are read from
+	GitHubAPIURL = "https://api.github.com"
+
+	releaseNotesTimeout = 10 * time.Second
+)
+
+// releaseRepositories are the GitHub repositories the AMIs of each image family are released from
+var releaseRepositories = map[string]string{
+	api.NodeImageFamilyAmazonLinux2: "awslabs/amazon-eks-ami",
+	ImageFamilyAmazonLinux2023:      "awslabs/amazon-eks-ami",
+	api.NodeImageFamilyBottlerocket: "bottlerocket-os/bottlerocket",
+}
+
+// securityFixPattern matches CVE IDs, and Amazon Linux security advisories listing the packages updated for CVEs
+var securityFixPattern = regexp.MustCompile(`\b(CVE-\d{4}-\d{4,}|ALAS(?:2023|2)?(?:KERNEL-[0-9.]+)?-\d{4}-\d+)\b`)
+
+// ReleaseNotes reads the security fixes of AMI releases
+type ReleaseNotes interface {
+	// SecurityFixes returns the security fixes of the releases of the image family after currentRelease, up to
+	// latestRelease
+	SecurityFixes(imageFamily, currentRelease, latestRelease string) ([]string, error)
+}
+
+// GitHubReleaseNotes reads the notes of the GitHub releases of the AMIs
+type GitHubReleaseNotes struct {
+	BaseURL string
+	Client  *http.Client
+
+	releases map[string][]gitHubRelease
+}
+
+type gitHubRelease struct {
+	TagName string `json:"tag_name"`
+	Body    string `json:"body"`
+}
+
+// NewGitHubReleaseNotes creates a GitHubReleaseNotes reading the releases from the GitHub API
+func NewGitHubReleaseNotes() *GitHubReleaseNotes {
+	return &GitHubReleaseNotes{
+		BaseURL: GitHubAPIURL,
+		Client:  &http.Client{Timeout: releaseNotesTimeout},
+	}
+}
+
+// SecurityFixes returns the CVE and security advisory IDs mentioned in the notes of the releases after currentRelease,
+// up to latestRelease
+func (g *GitHubReleaseNotes) SecurityFixes(imageFamily, currentRelease, latestRelease string) ([]string, error) {
+	releases, err := g.listReleases(imageFamily)
+	if err != nil {
+		return nil, err
+	}
+
+	fixes := map[string]struct{}{}
+	// releases are listed from the newest to the oldest
+	inRange := latestRelease == ""
+	for _, r := range releases {
+		if r.TagName == latestRelease {
+			inRange = true
+		}
+		if r.TagName == currentRelease {
+			break
+		}
+		if !inRange {
+			continue
+		}
+		for _, fix := range securityFixPattern.FindAllString(r.Body, -1) {
+			fixes[fix] = struct{}{}
+		}
+	}
+
+	var sorted []string
+	for fix := range fixes {
+		sorted = append(sorted, fix)
+	}
+	sort.Strings(sorted)
+	return sorted, nil
+}
+
+func (g *GitHubReleaseNotes) listReleases(imageFamily string) ([]gitHubRelease, error) {
+	repository, ok := releaseRepositories[imageFamily]
+	if !ok {
+		return nil, fmt.Errorf("no release notes known for image family %s", imageFamily)
+	}
+	if releases, ok := g.releases[repository]; ok {
+		return releases, nil
+	}
+
+	resp, err := g.Client.Get(fmt.Sprintf("%s/repos/%s/releases?per_page=100", g.BaseURL, repository))
+	if err != nil {
+		return nil, fmt.Errorf("listing releases of %s: %w", repository, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing releases of %s: unexpected status %s", repository, resp.Status)
+	}
+	var releases []gitHubRelease
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("decoding releases of %s: %w", repository, err)
+	}
+
+	if g.releases == nil {
+		g.releases = map[string][]gitHubRelease{}
+	}
+	g.releases[repository] = releases
+	return releases, nil
+}
@@ -0,0 +1,101 @@
+package bootstrap
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/utils/file"
+)
+
+// manifestDownloadTimeout is the timeout of downloading a manifest from a URL
+const manifestDownloadTimeout = 30 * time.Second
+
+// manifestHTTPClient downloads manifests from URLs
+var manifestHTTPClient = &http.Client{Timeout: manifestDownloadTimeout}
+
+// RawClient applies and deletes the resources of Kubernetes manifests.
+//
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate -o fakes/fake_raw_client.go . RawClient
+type RawClient interface {
+	CreateOrReplace(manifest []byte, plan bool) error
+	Delete(manifest []byte) error
+}
+
+// ManifestReader reads a manifest from a local path or a URL.
+type ManifestReader func(location string) ([]byte, error)
+
+// Manager applies and deletes bootstrap manifests.
+type Manager struct {
+	RawClient    RawClient
+	ReadManifest ManifestReader
+}
+
+// New creates a new Manager.
+func New(rawClient RawClient) *Manager {
+	return &Manager{
+		RawClient:    rawClient,
+		ReadManifest: ReadManifest,
+	}
+}
+
+// Apply creates or replaces the resources of the manifests, in order.
+func (m *Manager) Apply(manifests []string) error {
+	for _, location := range manifests {
+		manifest, err := m.ReadManifest(location)
+		if err != nil {
+			return err
+		}
+		logger.Info("applying bootstrap manifest %q", location)
+		if err := m.RawClient.CreateOrReplace(manifest, false); err != nil {
+			return fmt.Errorf("failed to apply bootstrap manifest %q: %w", location, err)
+		}
+	}
+	return nil
+}
+
+// Delete deletes the resources of the manifests, in reverse order.
+func (m *Manager) Delete(manifests []string) error {
+	for i := len(manifests) - 1; i >= 0; i-- {
+		location := manifests[i]
+		manifest, err := m.ReadManifest(location)
+		if err != nil {
+			return err
+		}
+		logger.Info("deleting resources of manifest %q", location)
+		if err := m.RawClient.Delete(manifest); err != nil {
+			return fmt.Errorf("failed to delete resources of manifest %q: %w", location, err)
+		}
+	}
+	return nil
+}
+
+// ReadManifest reads a manifest from a local path or an http(s) URL.
+func ReadManifest(location string) ([]byte, error) {
+	if !file.IsURL(location) {
+		manifest, err := os.ReadFile(file.ExpandPath(location))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest %q: %w", location, err)
+		}
+		return manifest, nil
+	}
+
+	resp, err := manifestHTTPClient.Get(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download manifest %q: %w", location, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download manifest %q: unexpected status %q", location, resp.Status)
+	}
+	manifest, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download manifest %q: %w", location, err)
+	}
+	return manifest, nil
+}
@@ -0,0 +1,92 @@
+package bootstrap_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/bootstrap"
+	"github.com/weaveworks/eksctl/pkg/actions/bootstrap/fakes"
+)
+
+var _ = Describe("Bootstrap manifests", func() {
+	var (
+		fakeRawClient *fakes.FakeRawClient
+		manager       *bootstrap.Manager
+	)
+
+	BeforeEach(func() {
+		fakeRawClient = &fakes.FakeRawClient{}
+		manager = &bootstrap.Manager{
+			RawClient: fakeRawClient,
+			ReadManifest: func(location string) ([]byte, error) {
+				return []byte(location), nil
+			},
+		}
+	})
+
+	Describe("Apply", func() {
+		It("applies the manifests in order", func() {
+			Expect(manager.Apply([]string{"namespaces.yaml", "priority-classes.yaml"})).To(Succeed())
+			Expect(fakeRawClient.CreateOrReplaceCallCount()).To(Equal(2))
+			manifest, plan := fakeRawClient.CreateOrReplaceArgsForCall(0)
+			Expect(string(manifest)).To(Equal("namespaces.yaml"))
+			Expect(plan).To(BeFalse())
+			manifest, _ = fakeRawClient.CreateOrReplaceArgsForCall(1)
+			Expect(string(manifest)).To(Equal("priority-classes.yaml"))
+		})
+
+		It("stops at the first error", func() {
+			fakeRawClient.CreateOrReplaceReturns(errors.New("nope"))
+			err := manager.Apply([]string{"namespaces.yaml", "priority-classes.yaml"})
+			Expect(err).To(MatchError(`failed to apply bootstrap manifest "namespaces.yaml": nope`))
+			Expect(fakeRawClient.CreateOrReplaceCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the manifests in reverse order", func() {
+			Expect(manager.Delete([]string{"namespaces.yaml", "services.yaml"})).To(Succeed())
+			Expect(fakeRawClient.DeleteCallCount()).To(Equal(2))
+			Expect(string(fakeRawClient.DeleteArgsForCall(0))).To(Equal("services.yaml"))
+			Expect(string(fakeRawClient.DeleteArgsForCall(1))).To(Equal("namespaces.yaml"))
+		})
+	})
+
+	Describe("ReadManifest", func() {
+		It("reads a local file", func() {
+			dir, err := os.MkdirTemp("", "bootstrap")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "namespace.yaml")
+			Expect(os.WriteFile(path, []byte("kind: Namespace"), 0644)).To(Succeed())
+
+			manifest, err := bootstrap.ReadManifest(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifest)).To(Equal("kind: Namespace"))
+		})
+
+		It("downloads a URL", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/namespace.yaml" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte("kind: Namespace"))
+			}))
+			defer server.Close()
+
+			manifest, err := bootstrap.ReadManifest(server.URL + "/namespace.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifest)).To(Equal("kind: Namespace"))
+
+			_, err = bootstrap.ReadManifest(server.URL + "/missing.yaml")
+			Expect(err).To(MatchError(ContainSubstring(`unexpected status "404 Not Found"`)))
+		})
+	})
+})
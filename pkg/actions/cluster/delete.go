@@ -11,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/pkg/errors"
 
+	"github.com/weaveworks/eksctl/pkg/actions/bootstrap"
+	"github.com/weaveworks/eksctl/pkg/actions/hooks"
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
@@ -62,6 +64,27 @@ func deleteSharedResources(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, sta
 	return nil
 }
 
+// runPreDeleteHooks runs the preDelete hooks, while the cluster is still operable
+func runPreDeleteHooks(cfg *api.ClusterConfig, ctl *eks.ClusterProvider) error {
+	if cfg.Hooks == nil || len(cfg.Hooks.PreDelete) == 0 {
+		return nil
+	}
+	return hooks.New(cfg, ctl).RunPreDelete()
+}
+
+// deleteBootstrapManifests deletes the resources of the pre-delete bootstrap manifests, while the cluster
+// and its nodegroups are still able to clean up the resources they manage
+func deleteBootstrapManifests(cfg *api.ClusterConfig, ctl *eks.ClusterProvider) error {
+	if cfg.Bootstrap == nil || len(cfg.Bootstrap.PreDeleteManifests) == 0 {
+		return nil
+	}
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	return bootstrap.New(rawClient).Delete(cfg.Bootstrap.PreDeleteManifests)
+}
+
 func handleErrors(errs []error, subject string) error {
 	logger.Info("%d error(s) occurred while deleting %s", len(errs), subject)
 	for _, err := range errs {
@@ -159,6 +182,22 @@ func checkForUndeletedStacks(stackManager manager.StackManager) error {
 	return nil
 }
 
+// deleteCrossAccountNodeGroups deletes the nodegroups of the config created in other accounts, whose stacks are not
+// deleted with those of the cluster and refer to its control plane security group
+func deleteCrossAccountNodeGroups(cfg *api.ClusterConfig, ctl *eks.ClusterProvider) error {
+	var nodeGroups []*api.NodeGroup
+	for _, ng := range cfg.NodeGroups {
+		if ng.AccountRoleARN != "" {
+			nodeGroups = append(nodeGroups, ng)
+		}
+	}
+	if len(nodeGroups) == 0 {
+		return nil
+	}
+	logger.Info("will delete %d nodegroup(s) of other accounts in cluster %q", len(nodeGroups), cfg.Metadata.Name)
+	return nodegroup.New(cfg, ctl, nil).Delete(nodeGroups, nil, true, false)
+}
+
 func drainAllNodegroups(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, clientSet kubernetes.Interface, allStacks []manager.NodeGroupStack) error {
 	if len(allStacks) == 0 {
 		return nil
@@ -173,7 +212,7 @@ func drainAllNodegroups(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackM
 
 	logger.Info("will drain %d unmanaged nodegroup(s) in cluster %q", len(cfg.NodeGroups), cfg.Metadata.Name)
 	nodeGroupManager := nodegroup.New(cfg, ctl, clientSet)
-	if err := nodeGroupManager.Drain(cmdutils.ToKubeNodeGroups(cfg), false, ctl.Provider.WaitTimeout(), 0, false, false); err != nil {
+	if err := nodeGroupManager.Drain(cmdutils.ToKubeNodeGroups(cfg), false, ctl.Provider.WaitTimeout(), 0, false, false, 0); err != nil {
 		return err
 	}
 	attemptVpcCniDeletion(cfg.Metadata.Name, ctl, clientSet)
@@ -39,7 +39,7 @@ func (c *OwnedCluster) Upgrade(dryRun bool) error {
 		return errors.Wrapf(err, "getting VPC configuration for cluster %q", c.cfg.Metadata.Name)
 	}
 
-	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, dryRun)
+	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, c.newClientSet, dryRun)
 	if err != nil {
 		return err
 	}
@@ -67,7 +67,7 @@ func (c *OwnedCluster) Upgrade(dryRun bool) error {
 	return nil
 }
 
-func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool) error {
+func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool, parallel int) error {
 	var (
 		clientSet kubernetes.Interface
 		oidc      *iamoidc.OpenIDConnectManager
@@ -78,6 +78,14 @@ func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool) error {
 		logger.Debug("failed to check if cluster is operable: %v", err)
 	}
 
+	if err := deleteCrossAccountNodeGroups(c.cfg, c.ctl); err != nil {
+		if force {
+			logger.Warning("error occurred during deletion: %v", err)
+		} else {
+			return err
+		}
+	}
+
 	oidcSupported := true
 	if clusterOperable {
 		var err error
@@ -101,6 +109,20 @@ func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool) error {
 			}
 			oidcSupported = false
 		}
+		if err := runPreDeleteHooks(c.cfg, c.ctl); err != nil {
+			if force {
+				logger.Warning("error occurred during deletion: %v", err)
+			} else {
+				return err
+			}
+		}
+		if err := deleteBootstrapManifests(c.cfg, c.ctl); err != nil {
+			if force {
+				logger.Warning("error occurred during deletion: %v", err)
+			} else {
+				return err
+			}
+		}
 		allStacks, err := c.stackManager.ListNodeGroupStacks()
 		if err != nil {
 			return err
@@ -143,6 +165,7 @@ func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool) error {
 		return nil
 	}
 
+	tasks.SetLimit(parallel)
 	logger.Info(tasks.Describe())
 	if errs := tasks.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "cluster with nodegroup(s)")
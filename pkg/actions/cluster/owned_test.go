@@ -60,18 +60,21 @@ var _ = Describe("Delete", func() {
 				Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
 			}, nil)
 
-			p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+			p.MockEKS().On("ListFargateProfilesPages", &awseks.ListFargateProfilesInput{
 				ClusterName: strings.Pointer(clusterName),
-			}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{"fargate-1"})}, nil)
+			}, mock.Anything).Once().Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListFargateProfilesOutput, bool) bool)
+				consume(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{"fargate-1"})}, true)
+			}).Return(nil)
 
 			p.MockEKS().On("DeleteFargateProfile", &awseks.DeleteFargateProfileInput{
 				ClusterName:        aws.String(clusterName),
 				FargateProfileName: aws.String("fargate-1"),
 			}).Once().Return(&awseks.DeleteFargateProfileOutput{}, nil)
 
-			p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+			p.MockEKS().On("ListFargateProfilesPages", &awseks.ListFargateProfilesInput{
 				ClusterName: strings.Pointer(clusterName),
-			}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
+			}, mock.Anything).Once().Return(nil)
 
 			fakeStackManager.DeleteTasksForDeprecatedStacksReturns(&tasks.TaskTree{
 				Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
@@ -98,7 +101,7 @@ var _ = Describe("Delete", func() {
 				return fakeClientSet, nil
 			})
 
-			err := c.Delete(time.Microsecond, false, false)
+			err := c.Delete(time.Microsecond, false, false, 0)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(ranDeleteDeprecatedTasks).To(BeTrue())
@@ -137,7 +140,7 @@ var _ = Describe("Delete", func() {
 
 			c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager)
 
-			err := c.Delete(time.Microsecond, false, false)
+			err := c.Delete(time.Microsecond, false, false, 0)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(ranDeleteDeprecatedTasks).To(BeTrue())
@@ -0,0 +1,513 @@
+package cluster
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// describeTagsBatchSize is the maximum number of load balancers the DescribeTags APIs of ELB and ELBv2 accept
+const describeTagsBatchSize = 20
+
+// PlannedResource is a resource listed in a DeletionPlan
+type PlannedResource struct {
+	Type   string
+	ID     string
+	Reason string
+}
+
+// PlannedStack is a stack deleted along with the cluster, and the resources deleted with it
+type PlannedStack struct {
+	Name      string
+	Resources []PlannedResource
+}
+
+// DeletionPlan lists what deleting a cluster would delete, and the resources which would block the deletion
+type DeletionPlan struct {
+	ClusterName string
+	// Stacks are the stacks of the cluster, deleted along with their resources
+	Stacks []PlannedStack
+	// Resources are deleted by eksctl outside of stacks
+	Resources []PlannedResource
+	// Blockers are not deleted with the cluster, and make deleting the security groups, subnets or VPC of the
+	// cluster fail while they exist
+	Blockers []PlannedResource
+}
+
+// PlanDeletion enumerates what deleting the cluster would delete, without deleting or changing anything
+func PlanDeletion(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager) (*DeletionPlan, error) {
+	planner := &deletionPlanner{
+		cfg:          cfg,
+		ctl:          ctl,
+		stackManager: stackManager,
+		plan:         &DeletionPlan{ClusterName: cfg.Metadata.Name},
+	}
+	if err := planner.planDeletion(); err != nil {
+		return nil, err
+	}
+	return planner.plan, nil
+}
+
+type deletionPlanner struct {
+	cfg          *api.ClusterConfig
+	ctl          *eks.ClusterProvider
+	stackManager manager.StackManager
+	plan         *DeletionPlan
+
+	// stackResourceIDs holds the physical IDs of all resources of the stacks
+	stackResourceIDs []string
+}
+
+func (p *deletionPlanner) planDeletion() error {
+	cluster, err := p.ctl.DescribeControlPlane(p.cfg.Metadata)
+	if err != nil {
+		if !isNotFound(errors.Cause(err)) {
+			return err
+		}
+		cluster = nil
+	}
+
+	stacks, err := p.stackManager.DescribeStacks()
+	if err != nil {
+		return errors.Wrap(err, "describing stacks")
+	}
+	if cluster == nil && len(stacks) == 0 {
+		return fmt.Errorf("cluster %q does not exist", p.cfg.Metadata.Name)
+	}
+
+	var (
+		clusterStackName = p.stackManager.MakeClusterStackName()
+		ownedCluster     bool
+		vpcID            string
+		ownedVPC         bool
+	)
+	for _, s := range stacks {
+		stackName := aws.StringValue(s.StackName)
+		resources, err := p.listStackResources(stackName)
+		if err != nil {
+			return err
+		}
+		if stackName == clusterStackName {
+			ownedCluster = true
+			for _, r := range resources {
+				if r.Type == "AWS::EC2::VPC" {
+					vpcID, ownedVPC = r.ID, true
+				}
+			}
+		}
+		p.plan.Stacks = append(p.plan.Stacks, PlannedStack{Name: stackName, Resources: resources})
+	}
+
+	if cluster == nil {
+		return p.planNetwork(vpcID, ownedVPC)
+	}
+
+	if !ownedCluster {
+		p.addResource("AWS::EKS::Cluster", p.cfg.Metadata.Name, "not created by eksctl")
+	}
+	if err := p.planNodeGroups(ownedCluster); err != nil {
+		return err
+	}
+	if err := p.planFargateProfiles(); err != nil {
+		return err
+	}
+	if err := p.planOIDCProvider(); err != nil {
+		return err
+	}
+	if cluster.ResourcesVpcConfig != nil {
+		vpcID = aws.StringValue(cluster.ResourcesVpcConfig.VpcId)
+	}
+	return p.planNetwork(vpcID, ownedVPC)
+}
+
+func (p *deletionPlanner) listStackResources(stackName string) ([]PlannedResource, error) {
+	var (
+		resources []PlannedResource
+		nextToken *string
+	)
+	for {
+		output, err := p.ctl.Provider.CloudFormation().ListStackResources(&cloudformation.ListStackResourcesInput{
+			StackName: aws.String(stackName),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing resources of stack %q", stackName)
+		}
+		for _, r := range output.StackResourceSummaries {
+			if aws.StringValue(r.ResourceStatus) == cloudformation.ResourceStatusDeleteComplete {
+				continue
+			}
+			id := aws.StringValue(r.PhysicalResourceId)
+			resources = append(resources, PlannedResource{
+				Type: aws.StringValue(r.ResourceType),
+				ID:   id,
+			})
+			if id != "" {
+				p.stackResourceIDs = append(p.stackResourceIDs, id)
+			}
+		}
+		if nextToken = output.NextToken; nextToken == nil {
+			return resources, nil
+		}
+	}
+}
+
+// planNodeGroups lists the managed nodegroups which were not created by eksctl. These are deleted along with
+// unowned clusters, but make deleting the stack of an owned cluster fail
+func (p *deletionPlanner) planNodeGroups(ownedCluster bool) error {
+	nodeGroupStacks, err := p.stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return err
+	}
+	hasStack := map[string]bool{}
+	for _, s := range nodeGroupStacks {
+		hasStack[s.NodeGroupName] = true
+	}
+
+	nodeGroups, err := eks.ListNodegroups(p.ctl.Provider.EKS(), p.cfg.Metadata.Name)
+	if err != nil {
+		return errors.Wrap(err, "listing nodegroups")
+	}
+	for _, name := range aws.StringValueSlice(nodeGroups) {
+		if hasStack[name] {
+			continue
+		}
+		if ownedCluster {
+			p.addBlocker("AWS::EKS::Nodegroup", name, "managed nodegroup not created by eksctl, which must be deleted before the cluster")
+		} else {
+			p.addResource("AWS::EKS::Nodegroup", name, "managed nodegroup not created by eksctl")
+		}
+	}
+	return nil
+}
+
+func (p *deletionPlanner) planFargateProfiles() error {
+	fargateClient := fargate.NewFromProvider(p.cfg.Metadata.Name, p.ctl.Provider, p.stackManager)
+	profiles, err := fargateClient.ListProfiles()
+	if err != nil {
+		return errors.Wrap(err, "listing Fargate profiles")
+	}
+	for _, name := range aws.StringValueSlice(profiles) {
+		p.addResource("AWS::EKS::FargateProfile", name, "")
+	}
+	return nil
+}
+
+func (p *deletionPlanner) planOIDCProvider() error {
+	oidc, err := p.ctl.NewOpenIDConnectManager(p.cfg)
+	if err != nil {
+		if _, ok := err.(*eks.UnsupportedOIDCError); ok {
+			return nil
+		}
+		return err
+	}
+	exists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return errors.Wrap(err, "checking OIDC provider")
+	}
+	if exists {
+		p.addResource("AWS::IAM::OIDCProvider", oidc.ProviderARN, "")
+	}
+	return nil
+}
+
+// planNetwork lists the load balancers and network interfaces in the VPC of the cluster. Load balancers created
+// by the cluster, and network interfaces left over by it, are deleted. Anything else in use blocks deleting the
+// security groups of the cluster, and the subnets when the VPC was created by eksctl
+func (p *deletionPlanner) planNetwork(vpcID string, ownedVPC bool) error {
+	if vpcID == "" {
+		return nil
+	}
+	if err := p.planLoadBalancers(vpcID, ownedVPC); err != nil {
+		return err
+	}
+	instanceIDs, err := p.clusterInstanceIDs(vpcID)
+	if err != nil {
+		return err
+	}
+
+	securityGroupRE, err := regexp.Compile(vpc.FmtSecurityGroupNameRegexForCluster(p.cfg.Metadata.Name))
+	if err != nil {
+		return errors.Wrap(err, "failed to create security group regex")
+	}
+
+	input := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{vpcID}),
+			},
+		},
+	}
+	for {
+		output, err := p.ctl.Provider.EC2().DescribeNetworkInterfaces(input)
+		if err != nil {
+			return errors.Wrapf(err, "listing network interfaces in %q", vpcID)
+		}
+		for _, eni := range output.NetworkInterfaces {
+			p.planNetworkInterface(eni, securityGroupRE, instanceIDs, ownedVPC)
+		}
+		if input.NextToken = output.NextToken; input.NextToken == nil {
+			return nil
+		}
+	}
+}
+
+func (p *deletionPlanner) planNetworkInterface(eni *ec2.NetworkInterface, securityGroupRE *regexp.Regexp, clusterInstanceIDs map[string]bool, ownedVPC bool) {
+	var ownSecurityGroup bool
+	for _, sg := range eni.Groups {
+		if securityGroupRE.MatchString(aws.StringValue(sg.GroupName)) {
+			ownSecurityGroup = true
+			break
+		}
+	}
+
+	id := aws.StringValue(eni.NetworkInterfaceId)
+	description := aws.StringValue(eni.Description)
+	switch {
+	case aws.StringValue(eni.Status) == ec2.NetworkInterfaceStatusAvailable:
+		if ownSecurityGroup {
+			p.addResource("AWS::EC2::NetworkInterface", id, "dangling network interface of the cluster")
+		} else if ownedVPC {
+			p.addBlocker("AWS::EC2::NetworkInterface", id, "unattached network interface in the VPC of the cluster")
+		}
+	case strings.HasPrefix(description, "Amazon EKS "):
+		// network interfaces of the control plane are deleted along with the cluster
+	case strings.HasPrefix(description, "ELB "):
+		// network interfaces of load balancers are covered by planLoadBalancers
+	case eni.Attachment != nil && clusterInstanceIDs[aws.StringValue(eni.Attachment.InstanceId)]:
+		// network interfaces of the nodes are deleted along with the nodegroups
+	case p.isStackResourceInterface(description):
+		// e.g. the network interfaces of NAT gateways and VPC endpoints of the stacks
+	case ownSecurityGroup:
+		p.addBlocker("AWS::EC2::NetworkInterface", id, fmt.Sprintf("in use by %s, and uses a security group of the cluster", describeAttachment(eni)))
+	case ownedVPC:
+		p.addBlocker("AWS::EC2::NetworkInterface", id, fmt.Sprintf("in use by %s in the VPC of the cluster", describeAttachment(eni)))
+	}
+}
+
+func (p *deletionPlanner) isStackResourceInterface(description string) bool {
+	for _, id := range p.stackResourceIDs {
+		if strings.Contains(description, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func describeAttachment(eni *ec2.NetworkInterface) string {
+	if eni.Attachment != nil && eni.Attachment.InstanceId != nil {
+		return "instance " + aws.StringValue(eni.Attachment.InstanceId)
+	}
+	if description := aws.StringValue(eni.Description); description != "" {
+		return fmt.Sprintf("%q", description)
+	}
+	if eni.InterfaceType != nil {
+		return aws.StringValue(eni.InterfaceType)
+	}
+	return "unknown resource"
+}
+
+// clusterInstanceIDs returns the IDs of the instances of the nodegroups of the cluster, which are tagged as owned, or
+// as shared in VPCs shared with other clusters
+func (p *deletionPlanner) clusterInstanceIDs(vpcID string) (map[string]bool, error) {
+	instanceIDs := map[string]bool{}
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{vpcID}),
+			},
+			{
+				Name:   aws.String("tag:" + clusterTagKey(p.cfg.Metadata.Name)),
+				Values: aws.StringSlice([]string{api.ClusterTagOwned, api.ClusterTagShared}),
+			},
+		},
+	}
+	for {
+		output, err := p.ctl.Provider.EC2().DescribeInstances(input)
+		if err != nil {
+			return nil, errors.Wrap(err, "listing instances of the cluster")
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				instanceIDs[aws.StringValue(instance.InstanceId)] = true
+			}
+		}
+		if input.NextToken = output.NextToken; input.NextToken == nil {
+			return instanceIDs, nil
+		}
+	}
+}
+
+func (p *deletionPlanner) planLoadBalancers(vpcID string, ownedVPC bool) error {
+	classicNames, err := p.classicLoadBalancersInVPC(vpcID)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(classicNames); i += describeTagsBatchSize {
+		batch := classicNames[i:minInt(i+describeTagsBatchSize, len(classicNames))]
+		output, err := p.ctl.Provider.ELB().DescribeTags(&elb.DescribeTagsInput{
+			LoadBalancerNames: aws.StringSlice(batch),
+		})
+		if err != nil {
+			return errors.Wrap(err, "describing tags of load balancers")
+		}
+		for _, description := range output.TagDescriptions {
+			tags := map[string]string{}
+			for _, tag := range description.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			p.planLoadBalancer("AWS::ElasticLoadBalancing::LoadBalancer", aws.StringValue(description.LoadBalancerName), tags, ownedVPC)
+		}
+	}
+
+	arns, err := p.loadBalancersInVPC(vpcID)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(arns); i += describeTagsBatchSize {
+		batch := arns[i:minInt(i+describeTagsBatchSize, len(arns))]
+		output, err := p.ctl.Provider.ELBV2().DescribeTags(&elbv2.DescribeTagsInput{
+			ResourceArns: aws.StringSlice(batch),
+		})
+		if err != nil {
+			return errors.Wrap(err, "describing tags of load balancers")
+		}
+		for _, description := range output.TagDescriptions {
+			tags := map[string]string{}
+			for _, tag := range description.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			p.planLoadBalancer("AWS::ElasticLoadBalancingV2::LoadBalancer", aws.StringValue(description.ResourceArn), tags, ownedVPC)
+		}
+	}
+	return nil
+}
+
+func (p *deletionPlanner) planLoadBalancer(resourceType, id string, tags map[string]string, ownedVPC bool) {
+	clusterName := p.cfg.Metadata.Name
+	if _, ok := tags[clusterTagKey(clusterName)]; ok || tags["elbv2.k8s.aws/cluster"] == clusterName {
+		p.addResource(resourceType, id, "created by a Kubernetes Service or Ingress, deleted when the cluster is operable")
+		return
+	}
+	if ownedVPC {
+		p.addBlocker(resourceType, id, "load balancer in the VPC of the cluster, not created by the cluster")
+	}
+}
+
+func (p *deletionPlanner) classicLoadBalancersInVPC(vpcID string) ([]string, error) {
+	var (
+		names []string
+		input = &elb.DescribeLoadBalancersInput{}
+	)
+	for {
+		output, err := p.ctl.Provider.ELB().DescribeLoadBalancers(input)
+		if err != nil {
+			return nil, errors.Wrap(err, "listing load balancers")
+		}
+		for _, lb := range output.LoadBalancerDescriptions {
+			if aws.StringValue(lb.VPCId) == vpcID {
+				names = append(names, aws.StringValue(lb.LoadBalancerName))
+			}
+		}
+		if input.Marker = output.NextMarker; input.Marker == nil {
+			return names, nil
+		}
+	}
+}
+
+func (p *deletionPlanner) loadBalancersInVPC(vpcID string) ([]string, error) {
+	var (
+		arns  []string
+		input = &elbv2.DescribeLoadBalancersInput{}
+	)
+	for {
+		output, err := p.ctl.Provider.ELBV2().DescribeLoadBalancers(input)
+		if err != nil {
+			return nil, errors.Wrap(err, "listing load balancers")
+		}
+		for _, lb := range output.LoadBalancers {
+			if aws.StringValue(lb.VpcId) == vpcID {
+				arns = append(arns, aws.StringValue(lb.LoadBalancerArn))
+			}
+		}
+		if input.Marker = output.NextMarker; input.Marker == nil {
+			return arns, nil
+		}
+	}
+}
+
+func (p *deletionPlanner) addResource(resourceType, id, reason string) {
+	p.plan.Resources = append(p.plan.Resources, PlannedResource{Type: resourceType, ID: id, Reason: reason})
+}
+
+func (p *deletionPlanner) addBlocker(resourceType, id, reason string) {
+	p.plan.Blockers = append(p.plan.Blockers, PlannedResource{Type: resourceType, ID: id, Reason: reason})
+}
+
+// Describe returns a human-readable description of the plan
+func (d *DeletionPlan) Describe() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "deleting cluster %q would delete the following resources\n", d.ClusterName)
+
+	stacks := make([]PlannedStack, len(d.Stacks))
+	copy(stacks, d.Stacks)
+	sort.Slice(stacks, func(i, j int) bool { return stacks[i].Name < stacks[j].Name })
+	for _, s := range stacks {
+		fmt.Fprintf(&b, "stack %q:\n", s.Name)
+		for _, r := range s.Resources {
+			describeResource(&b, r)
+		}
+	}
+
+	if len(d.Resources) > 0 {
+		fmt.Fprintf(&b, "outside of stacks:\n")
+		for _, r := range d.Resources {
+			describeResource(&b, r)
+		}
+	}
+
+	if len(d.Blockers) == 0 {
+		fmt.Fprintf(&b, "no resources were found which would block the deletion\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "the following resources are not deleted with the cluster, and would block the deletion until removed:\n")
+	for _, r := range d.Blockers {
+		describeResource(&b, r)
+	}
+	return b.String()
+}
+
+func describeResource(b *strings.Builder, r PlannedResource) {
+	fmt.Fprintf(b, "  - %s %s", r.Type, r.ID)
+	if r.Reason != "" {
+		fmt.Fprintf(b, " (%s)", r.Reason)
+	}
+	b.WriteString("\n")
+}
+
+func clusterTagKey(clusterName string) string {
+	return "kubernetes.io/cluster/" + clusterName
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
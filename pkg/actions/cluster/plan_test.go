@@ -0,0 +1,255 @@
+package cluster_test
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("PlanDeletion", func() {
+	const clusterName = "my-cluster"
+
+	var (
+		p                *mockprovider.MockProvider
+		cfg              *api.ClusterConfig
+		fakeStackManager *fakes.FakeStackManager
+		ctl              *eks.ClusterProvider
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.MakeClusterStackNameReturns("eksctl-my-cluster-cluster")
+		ctl = &eks.ClusterProvider{Provider: p, Status: &eks.ProviderStatus{}}
+
+		p.MockEKS().On("ListFargateProfilesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*awseks.ListFargateProfilesOutput, bool) bool)
+			consume(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{"fp-default"})}, true)
+		}).Return(nil)
+		p.MockELB().On("DescribeLoadBalancers", mock.Anything).Return(&elb.DescribeLoadBalancersOutput{}, nil)
+		p.MockELBV2().On("DescribeLoadBalancers", mock.Anything).Return(&elbv2.DescribeLoadBalancersOutput{}, nil)
+		p.MockEC2().On("DescribeInstances", mock.Anything).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{
+				{Instances: []*ec2.Instance{{InstanceId: aws.String("i-node")}}},
+			},
+		}, nil)
+	})
+
+	mockStackResources := func(stackName string, resources map[string]string) {
+		var summaries []*cloudformation.StackResourceSummary
+		for resourceType, id := range resources {
+			summaries = append(summaries, &cloudformation.StackResourceSummary{
+				ResourceType:       aws.String(resourceType),
+				PhysicalResourceId: aws.String(id),
+				ResourceStatus:     aws.String(cloudformation.ResourceStatusCreateComplete),
+			})
+		}
+		p.MockCloudFormation().On("ListStackResources", &cloudformation.ListStackResourcesInput{
+			StackName: aws.String(stackName),
+		}).Return(&cloudformation.ListStackResourcesOutput{StackResourceSummaries: summaries}, nil)
+	}
+
+	mockNetworkInterfaces := func(enis ...*ec2.NetworkInterface) {
+		p.MockEC2().On("DescribeNetworkInterfaces", mock.MatchedBy(func(input *ec2.DescribeNetworkInterfacesInput) bool {
+			return aws.StringValue(input.Filters[0].Values[0]) == "vpc-1234"
+		})).Return(&ec2.DescribeNetworkInterfacesOutput{NetworkInterfaces: enis}, nil)
+	}
+
+	networkInterface := func(id, status, description, instanceID, securityGroupName string) *ec2.NetworkInterface {
+		eni := &ec2.NetworkInterface{
+			NetworkInterfaceId: aws.String(id),
+			Status:             aws.String(status),
+			Description:        aws.String(description),
+			Groups:             []*ec2.GroupIdentifier{{GroupId: aws.String("sg-1"), GroupName: aws.String(securityGroupName)}},
+		}
+		if instanceID != "" {
+			eni.Attachment = &ec2.NetworkInterfaceAttachment{InstanceId: aws.String(instanceID)}
+		}
+		return eni
+	}
+
+	Context("when the cluster was created by eksctl", func() {
+		BeforeEach(func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{
+				Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
+			}, nil)
+			fakeStackManager.DescribeStacksReturns([]*manager.Stack{
+				{StackName: aws.String("eksctl-my-cluster-cluster")},
+				{StackName: aws.String("eksctl-my-cluster-nodegroup-ng-1")},
+			}, nil)
+			fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{{NodeGroupName: "ng-1"}}, nil)
+			mockStackResources("eksctl-my-cluster-cluster", map[string]string{
+				"AWS::EKS::Cluster":    clusterName,
+				"AWS::EC2::VPC":        "vpc-1234",
+				"AWS::EC2::NatGateway": "nat-1",
+			})
+			mockStackResources("eksctl-my-cluster-nodegroup-ng-1", map[string]string{
+				"AWS::EKS::Nodegroup": "ng-1",
+			})
+			p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"ng-1", "external"})}, true)
+			}).Return(nil)
+		})
+
+		It("lists the stacks, the resources deleted outside of stacks, and the resources blocking the deletion", func() {
+			mockNetworkInterfaces(
+				networkInterface("eni-control-plane", "in-use", "Amazon EKS my-cluster", "", "eks-cluster-sg-my-cluster"),
+				networkInterface("eni-node", "in-use", "", "i-node", "eksctl-my-cluster-cluster-ClusterSharedNodeSecurityGroup-1"),
+				networkInterface("eni-dangling", "available", "aws-K8S-i-node", "", "eksctl-my-cluster-nodegroup-ng-1-SG-1"),
+				networkInterface("eni-nat", "in-use", "Interface for NAT Gateway nat-1", "", "default"),
+				networkInterface("eni-other", "in-use", "", "i-other", "default"),
+			)
+
+			plan, err := cluster.PlanDeletion(cfg, ctl, fakeStackManager)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan.Stacks).To(HaveLen(2))
+			Expect(plan.Stacks[0].Name).To(Equal("eksctl-my-cluster-cluster"))
+			Expect(plan.Stacks[0].Resources).To(ContainElement(cluster.PlannedResource{Type: "AWS::EC2::VPC", ID: "vpc-1234"}))
+			Expect(plan.Stacks[1].Resources).To(ConsistOf(cluster.PlannedResource{Type: "AWS::EKS::Nodegroup", ID: "ng-1"}))
+
+			Expect(resourceIDs(plan.Resources)).To(ConsistOf("fp-default", "eni-dangling"))
+			Expect(resourceIDs(plan.Blockers)).To(ConsistOf("external", "eni-other"))
+		})
+
+		It("lists the load balancers in the VPC", func() {
+			mockNetworkInterfaces()
+			p.MockELB().ExpectedCalls = nil
+			p.MockELB().On("DescribeLoadBalancers", mock.Anything).Return(&elb.DescribeLoadBalancersOutput{
+				LoadBalancerDescriptions: []*elb.LoadBalancerDescription{
+					{LoadBalancerName: aws.String("service-lb"), VPCId: aws.String("vpc-1234")},
+					{LoadBalancerName: aws.String("other-vpc-lb"), VPCId: aws.String("vpc-other")},
+				},
+			}, nil)
+			p.MockELB().On("DescribeTags", &elb.DescribeTagsInput{
+				LoadBalancerNames: aws.StringSlice([]string{"service-lb"}),
+			}).Return(&elb.DescribeTagsOutput{
+				TagDescriptions: []*elb.TagDescription{{
+					LoadBalancerName: aws.String("service-lb"),
+					Tags:             []*elb.Tag{{Key: aws.String("kubernetes.io/cluster/my-cluster"), Value: aws.String("owned")}},
+				}},
+			}, nil)
+			p.MockELBV2().ExpectedCalls = nil
+			p.MockELBV2().On("DescribeLoadBalancers", mock.Anything).Return(&elbv2.DescribeLoadBalancersOutput{
+				LoadBalancers: []*elbv2.LoadBalancer{
+					{LoadBalancerArn: aws.String("arn:ingress-lb"), VpcId: aws.String("vpc-1234")},
+					{LoadBalancerArn: aws.String("arn:manual-lb"), VpcId: aws.String("vpc-1234")},
+				},
+			}, nil)
+			p.MockELBV2().On("DescribeTags", &elbv2.DescribeTagsInput{
+				ResourceArns: aws.StringSlice([]string{"arn:ingress-lb", "arn:manual-lb"}),
+			}).Return(&elbv2.DescribeTagsOutput{
+				TagDescriptions: []*elbv2.TagDescription{
+					{
+						ResourceArn: aws.String("arn:ingress-lb"),
+						Tags:        []*elbv2.Tag{{Key: aws.String("elbv2.k8s.aws/cluster"), Value: aws.String(clusterName)}},
+					},
+					{
+						ResourceArn: aws.String("arn:manual-lb"),
+					},
+				},
+			}, nil)
+
+			plan, err := cluster.PlanDeletion(cfg, ctl, fakeStackManager)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceIDs(plan.Resources)).To(ConsistOf("fp-default", "service-lb", "arn:ingress-lb"))
+			Expect(resourceIDs(plan.Blockers)).To(ConsistOf("external", "arn:manual-lb"))
+			Expect(plan.Describe()).To(ContainSubstring("AWS::ElasticLoadBalancingV2::LoadBalancer arn:manual-lb (load balancer in the VPC of the cluster, not created by the cluster)"))
+		})
+	})
+
+	Context("when the cluster was not created by eksctl", func() {
+		BeforeEach(func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{
+				Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusActive),
+			}, nil)
+			p.MockEKS().On("ListNodegroupsPages", &awseks.ListNodegroupsInput{ClusterName: aws.String(clusterName)}, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"managed"})}, false)
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"managed-2"})}, true)
+			}).Return(nil)
+		})
+
+		It("lists the cluster and its nodegroups, and only blocks on resources using the security groups of the cluster", func() {
+			mockNetworkInterfaces(
+				networkInterface("eni-other", "in-use", "", "i-other", "default"),
+				networkInterface("eni-lambda", "in-use", "AWS Lambda VPC ENI", "", "eksctl-my-cluster-cluster-ClusterSharedNodeSecurityGroup-1"),
+			)
+
+			plan, err := cluster.PlanDeletion(cfg, ctl, fakeStackManager)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Stacks).To(BeEmpty())
+			Expect(resourceIDs(plan.Resources)).To(ConsistOf(clusterName, "managed", "managed-2", "fp-default"))
+			Expect(plan.Blockers).To(ConsistOf(cluster.PlannedResource{
+				Type:   "AWS::EC2::NetworkInterface",
+				ID:     "eni-lambda",
+				Reason: `in use by "AWS Lambda VPC ENI", and uses a security group of the cluster`,
+			}))
+			p.MockEC2().AssertCalled(GinkgoT(), "DescribeInstances", mock.MatchedBy(func(input *ec2.DescribeInstancesInput) bool {
+				for _, filter := range input.Filters {
+					if aws.StringValue(filter.Name) == "tag:kubernetes.io/cluster/my-cluster" {
+						return strings.Join(aws.StringValueSlice(filter.Values), ",") == "owned,shared"
+					}
+				}
+				return false
+			}))
+		})
+	})
+
+	When("neither the cluster nor its stacks exist", func() {
+		It("returns an error", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(nil, awserr.New(awseks.ErrCodeResourceNotFoundException, "not found", nil))
+
+			_, err := cluster.PlanDeletion(cfg, ctl, fakeStackManager)
+			Expect(err).To(MatchError(`cluster "my-cluster" does not exist`))
+		})
+	})
+
+	Describe("Describe", func() {
+		It("describes the plan", func() {
+			plan := &cluster.DeletionPlan{
+				ClusterName: clusterName,
+				Stacks: []cluster.PlannedStack{{
+					Name:      "eksctl-my-cluster-cluster",
+					Resources: []cluster.PlannedResource{{Type: "AWS::EKS::Cluster", ID: clusterName}},
+				}},
+				Resources: []cluster.PlannedResource{{Type: "AWS::EKS::FargateProfile", ID: "fp-default"}},
+			}
+			Expect(plan.Describe()).To(Equal(`deleting cluster "my-cluster" would delete the following resources
+stack "eksctl-my-cluster-cluster":
+  - AWS::EKS::Cluster my-cluster
+outside of stacks:
+  - AWS::EKS::FargateProfile fp-default
+no resources were found which would block the deletion
+`))
+		})
+	})
+})
+
+func resourceIDs(resources []cluster.PlannedResource) []string {
+	var ids []string
+	for _, r := range resources {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
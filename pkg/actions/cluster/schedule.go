@@ -0,0 +1,267 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+const (
+	// UpgradeDocumentName is the name of the SSM Automation document upgrading the control plane of a cluster,
+	// shared by the clusters of the account and region
+	UpgradeDocumentName = "eksctl-UpgradeClusterControlPlane"
+
+	// defaultMaintenanceWindowDuration is the duration in hours of maintenance windows without a duration
+	defaultMaintenanceWindowDuration = 2
+
+	upgradeTaskName = "upgrade-control-plane"
+)
+
+// upgradeDocument updates the version of the control plane and waits for the update to succeed, within the
+// 65 minutes eksctl waits for upgrades, then deletes the maintenance window so that a recurring schedule does not
+// run the upgrade again
+const upgradeDocument = `description: Upgrades the control plane of an EKS cluster, scheduled by eksctl
+schemaVersion: '0.3'
+assumeRole: '{{ AutomationAssumeRole }}'
+parameters:
+  ClusterName:
+    type: String
+  Version:
+    type: String
+  WindowId:
+    type: String
+  AutomationAssumeRole:
+    type: String
+mainSteps:
+- name: UpdateClusterVersion
+  action: aws:executeAwsApi
+  inputs:
+    Service: eks
+    Api: UpdateClusterVersion
+    name: '{{ ClusterName }}'
+    version: '{{ Version }}'
+  outputs:
+  - Name: UpdateId
+    Selector: $.update.id
+    Type: String
+- name: WaitForUpdate
+  action: aws:waitForAwsResourceProperty
+  timeoutSeconds: 3900
+  inputs:
+    Service: eks
+    Api: DescribeUpdate
+    name: '{{ ClusterName }}'
+    updateId: '{{ UpdateClusterVersion.UpdateId }}'
+    PropertySelector: $.update.status
+    DesiredValues:
+    - Successful
+- name: DeleteMaintenanceWindow
+  action: aws:executeAwsApi
+  isEnd: true
+  inputs:
+    Service: ssm
+    Api: DeleteMaintenanceWindow
+    WindowId: '{{ WindowId }}'
+`
+
+// UpgradeScheduler schedules upgrades of the control plane in the maintenance window of the cluster, with an SSM
+// maintenance window running an SSM Automation
+type UpgradeScheduler struct {
+	cfg    *api.ClusterConfig
+	ssmAPI ssmiface.SSMAPI
+}
+
+// NewUpgradeScheduler creates an UpgradeScheduler
+func NewUpgradeScheduler(cfg *api.ClusterConfig, ssmAPI ssmiface.SSMAPI) *UpgradeScheduler {
+	return &UpgradeScheduler{
+		cfg:    cfg,
+		ssmAPI: ssmAPI,
+	}
+}
+
+// MaintenanceWindowName returns the name of the SSM maintenance window upgrades of the cluster are scheduled in
+func MaintenanceWindowName(clusterName string) string {
+	return fmt.Sprintf("eksctl-%s-upgrade", clusterName)
+}
+
+// Schedule schedules the upgrade of the control plane from currentVersion to the version of the cluster, or to the
+// next version, replacing any previously scheduled upgrade
+func (s *UpgradeScheduler) Schedule(currentVersion string, dryRun bool) error {
+	meta := s.cfg.Metadata
+	window := s.cfg.MaintenanceWindow
+	if window == nil {
+		return fmt.Errorf("maintenanceWindow must be set to schedule upgrades of cluster %q", meta.Name)
+	}
+	versionUpdateRequired, err := requiresVersionUpgrade(meta, currentVersion)
+	if err != nil {
+		return err
+	}
+	if !versionUpdateRequired {
+		logger.Info("no cluster version update required")
+		return nil
+	}
+
+	cmdutils.LogIntendedAction(dryRun, "schedule upgrade of cluster %q control plane from current version %q to %q in maintenance window %q", meta.Name, currentVersion, meta.Version, window.Schedule)
+	if dryRun {
+		return nil
+	}
+
+	if err := s.deleteMaintenanceWindows(); err != nil {
+		return err
+	}
+	if err := s.ensureDocument(); err != nil {
+		return err
+	}
+
+	duration := defaultMaintenanceWindowDuration
+	if window.Duration != nil {
+		duration = *window.Duration
+	}
+	input := &ssm.CreateMaintenanceWindowInput{
+		Name:                     aws.String(MaintenanceWindowName(meta.Name)),
+		Description:              aws.String(fmt.Sprintf("Upgrade of the control plane of EKS cluster %q to %s, scheduled by eksctl", meta.Name, meta.Version)),
+		Schedule:                 aws.String(window.Schedule),
+		Duration:                 aws.Int64(int64(duration)),
+		Cutoff:                   aws.Int64(0),
+		AllowUnassociatedTargets: aws.Bool(true),
+		Tags: []*ssm.Tag{
+			{Key: aws.String(api.ClusterNameTag), Value: aws.String(meta.Name)},
+		},
+	}
+	if window.Timezone != "" {
+		input.ScheduleTimezone = aws.String(window.Timezone)
+	}
+	output, err := s.ssmAPI.CreateMaintenanceWindow(input)
+	if err != nil {
+		return fmt.Errorf("creating maintenance window for cluster %q: %w", meta.Name, err)
+	}
+
+	_, err = s.ssmAPI.RegisterTaskWithMaintenanceWindow(&ssm.RegisterTaskWithMaintenanceWindowInput{
+		WindowId:       output.WindowId,
+		Name:           aws.String(upgradeTaskName),
+		TaskArn:        aws.String(UpgradeDocumentName),
+		TaskType:       aws.String(ssm.MaintenanceWindowTaskTypeAutomation),
+		ServiceRoleArn: aws.String(window.ServiceRoleARN),
+		MaxConcurrency: aws.String("1"),
+		MaxErrors:      aws.String("1"),
+		TaskInvocationParameters: &ssm.MaintenanceWindowTaskInvocationParameters{
+			Automation: &ssm.MaintenanceWindowAutomationParameters{
+				DocumentVersion: aws.String("$DEFAULT"),
+				Parameters: map[string][]*string{
+					"ClusterName":          aws.StringSlice([]string{meta.Name}),
+					"Version":              aws.StringSlice([]string{meta.Version}),
+					"WindowId":             []*string{output.WindowId},
+					"AutomationAssumeRole": aws.StringSlice([]string{window.ServiceRoleARN}),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("registering upgrade task with maintenance window %q: %w", *output.WindowId, err)
+	}
+	logger.Success("scheduled upgrade of cluster %q control plane to version %q in maintenance window %q (%s)", meta.Name, meta.Version, *output.WindowId, window.Schedule)
+	logger.Info("run `eksctl upgrade cluster --name=%s --cancel-schedule --approve` to cancel it", meta.Name)
+	return nil
+}
+
+// Cancel cancels the scheduled upgrade of the control plane, deleting the maintenance window of the cluster
+func (s *UpgradeScheduler) Cancel(dryRun bool) error {
+	meta := s.cfg.Metadata
+	windows, err := s.maintenanceWindows()
+	if err != nil {
+		return err
+	}
+	if len(windows) == 0 {
+		logger.Info("no upgrade of cluster %q is scheduled", meta.Name)
+		return nil
+	}
+	cmdutils.LogIntendedAction(dryRun, "cancel scheduled upgrade of cluster %q control plane", meta.Name)
+	if dryRun {
+		return nil
+	}
+	if err := s.deleteMaintenanceWindows(); err != nil {
+		return err
+	}
+	logger.Success("cancelled scheduled upgrade of cluster %q control plane", meta.Name)
+	return nil
+}
+
+func (s *UpgradeScheduler) maintenanceWindows() ([]*ssm.MaintenanceWindowIdentity, error) {
+	var windows []*ssm.MaintenanceWindowIdentity
+	err := s.ssmAPI.DescribeMaintenanceWindowsPages(&ssm.DescribeMaintenanceWindowsInput{
+		Filters: []*ssm.MaintenanceWindowFilter{
+			{Key: aws.String("Name"), Values: aws.StringSlice([]string{MaintenanceWindowName(s.cfg.Metadata.Name)})},
+		},
+	}, func(output *ssm.DescribeMaintenanceWindowsOutput, _ bool) bool {
+		windows = append(windows, output.WindowIdentities...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing maintenance windows of cluster %q: %w", s.cfg.Metadata.Name, err)
+	}
+	return windows, nil
+}
+
+func (s *UpgradeScheduler) deleteMaintenanceWindows() error {
+	windows, err := s.maintenanceWindows()
+	if err != nil {
+		return err
+	}
+	for _, w := range windows {
+		logger.Debug("deleting maintenance window %q", *w.WindowId)
+		if _, err := s.ssmAPI.DeleteMaintenanceWindow(&ssm.DeleteMaintenanceWindowInput{WindowId: w.WindowId}); err != nil {
+			return fmt.Errorf("deleting maintenance window %q: %w", *w.WindowId, err)
+		}
+	}
+	return nil
+}
+
+// ensureDocument creates the upgrade document, or updates it and makes the new version the default one when it
+// exists with a different content, e.g. when it was created by an earlier version of eksctl
+func (s *UpgradeScheduler) ensureDocument() error {
+	_, err := s.ssmAPI.CreateDocument(&ssm.CreateDocumentInput{
+		Name:           aws.String(UpgradeDocumentName),
+		Content:        aws.String(upgradeDocument),
+		DocumentFormat: aws.String(ssm.DocumentFormatYaml),
+		DocumentType:   aws.String(ssm.DocumentTypeAutomation),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == ssm.ErrCodeDocumentAlreadyExists {
+		return s.updateDocument()
+	}
+	if err != nil {
+		return fmt.Errorf("creating SSM document %q: %w", UpgradeDocumentName, err)
+	}
+	return nil
+}
+
+func (s *UpgradeScheduler) updateDocument() error {
+	output, err := s.ssmAPI.UpdateDocument(&ssm.UpdateDocumentInput{
+		Name:            aws.String(UpgradeDocumentName),
+		Content:         aws.String(upgradeDocument),
+		DocumentFormat:  aws.String(ssm.DocumentFormatYaml),
+		DocumentVersion: aws.String("$LATEST"),
+	})
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == ssm.ErrCodeDuplicateDocumentContent {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("updating SSM document %q: %w", UpgradeDocumentName, err)
+	}
+
+	version := output.DocumentDescription.DocumentVersion
+	logger.Debug("updated SSM document %q to version %s", UpgradeDocumentName, *version)
+	if _, err := s.ssmAPI.UpdateDocumentDefaultVersion(&ssm.UpdateDocumentDefaultVersionInput{
+		Name:            aws.String(UpgradeDocumentName),
+		DocumentVersion: version,
+	}); err != nil {
+		return fmt.Errorf("setting the default version of SSM document %q: %w", UpgradeDocumentName, err)
+	}
+	return nil
+}
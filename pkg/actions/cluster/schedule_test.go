@@ -0,0 +1,128 @@
+package cluster_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("UpgradeScheduler", func() {
+	var (
+		cfg       *api.ClusterConfig
+		p         *mockprovider.MockProvider
+		scheduler *cluster.UpgradeScheduler
+	)
+
+	mockWindows := func(ids ...string) {
+		p.MockSSM().On("DescribeMaintenanceWindowsPages", mock.MatchedBy(func(input *ssm.DescribeMaintenanceWindowsInput) bool {
+			return *input.Filters[0].Values[0] == "eksctl-my-cluster-upgrade"
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			output := &ssm.DescribeMaintenanceWindowsOutput{}
+			for _, id := range ids {
+				output.WindowIdentities = append(output.WindowIdentities, &ssm.MaintenanceWindowIdentity{WindowId: aws.String(id)})
+			}
+			args.Get(1).(func(*ssm.DescribeMaintenanceWindowsOutput, bool) bool)(output, true)
+		}).Return(nil)
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Version = ""
+		cfg.MaintenanceWindow = &api.MaintenanceWindow{
+			Schedule:       "cron(0 2 ? * SUN *)",
+			Timezone:       "Europe/Paris",
+			ServiceRoleARN: "arn:aws:iam::123456789012:role/eksctl-upgrade",
+		}
+		p = mockprovider.NewMockProvider()
+		scheduler = cluster.NewUpgradeScheduler(cfg, p.MockSSM())
+	})
+
+	It("replaces the scheduled upgrade with a maintenance window upgrading to the next version", func() {
+		mockWindows("mw-old")
+		p.MockSSM().On("DeleteMaintenanceWindow", &ssm.DeleteMaintenanceWindowInput{WindowId: aws.String("mw-old")}).
+			Return(&ssm.DeleteMaintenanceWindowOutput{}, nil)
+		p.MockSSM().On("CreateDocument", mock.MatchedBy(func(input *ssm.CreateDocumentInput) bool {
+			return *input.Name == cluster.UpgradeDocumentName && *input.DocumentType == ssm.DocumentTypeAutomation
+		})).Return(nil, awserr.New(ssm.ErrCodeDocumentAlreadyExists, "exists", nil))
+		p.MockSSM().On("UpdateDocument", mock.Anything).Return(nil, awserr.New(ssm.ErrCodeDuplicateDocumentContent, "same content", nil))
+		p.MockSSM().On("CreateMaintenanceWindow", mock.Anything).Return(&ssm.CreateMaintenanceWindowOutput{WindowId: aws.String("mw-new")}, nil)
+		p.MockSSM().On("RegisterTaskWithMaintenanceWindow", mock.Anything).Return(&ssm.RegisterTaskWithMaintenanceWindowOutput{}, nil)
+
+		Expect(scheduler.Schedule("1.20", false)).To(Succeed())
+
+		createInput := p.MockSSM().Calls[4].Arguments.Get(0).(*ssm.CreateMaintenanceWindowInput)
+		Expect(*createInput.Name).To(Equal("eksctl-my-cluster-upgrade"))
+		Expect(*createInput.Schedule).To(Equal("cron(0 2 ? * SUN *)"))
+		Expect(*createInput.ScheduleTimezone).To(Equal("Europe/Paris"))
+		Expect(*createInput.Duration).To(Equal(int64(2)))
+		Expect(*createInput.AllowUnassociatedTargets).To(BeTrue())
+
+		taskInput := p.MockSSM().Calls[5].Arguments.Get(0).(*ssm.RegisterTaskWithMaintenanceWindowInput)
+		Expect(*taskInput.WindowId).To(Equal("mw-new"))
+		Expect(*taskInput.TaskType).To(Equal(ssm.MaintenanceWindowTaskTypeAutomation))
+		Expect(*taskInput.ServiceRoleArn).To(Equal("arn:aws:iam::123456789012:role/eksctl-upgrade"))
+		params := taskInput.TaskInvocationParameters.Automation.Parameters
+		Expect(aws.StringValueSlice(params["ClusterName"])).To(Equal([]string{"my-cluster"}))
+		Expect(aws.StringValueSlice(params["Version"])).To(Equal([]string{"1.21"}))
+		Expect(aws.StringValueSlice(params["WindowId"])).To(Equal([]string{"mw-new"}))
+		p.MockSSM().AssertNotCalled(GinkgoT(), "UpdateDocumentDefaultVersion", mock.Anything)
+	})
+
+	It("updates the upgrade document when it exists with a different content", func() {
+		mockWindows()
+		p.MockSSM().On("CreateDocument", mock.Anything).Return(nil, awserr.New(ssm.ErrCodeDocumentAlreadyExists, "exists", nil))
+		p.MockSSM().On("UpdateDocument", mock.MatchedBy(func(input *ssm.UpdateDocumentInput) bool {
+			return *input.Name == cluster.UpgradeDocumentName && *input.DocumentVersion == "$LATEST"
+		})).Return(&ssm.UpdateDocumentOutput{DocumentDescription: &ssm.DocumentDescription{DocumentVersion: aws.String("2")}}, nil)
+		p.MockSSM().On("UpdateDocumentDefaultVersion", &ssm.UpdateDocumentDefaultVersionInput{
+			Name:            aws.String(cluster.UpgradeDocumentName),
+			DocumentVersion: aws.String("2"),
+		}).Return(&ssm.UpdateDocumentDefaultVersionOutput{}, nil)
+		p.MockSSM().On("CreateMaintenanceWindow", mock.Anything).Return(&ssm.CreateMaintenanceWindowOutput{WindowId: aws.String("mw-new")}, nil)
+		p.MockSSM().On("RegisterTaskWithMaintenanceWindow", mock.Anything).Return(&ssm.RegisterTaskWithMaintenanceWindowOutput{}, nil)
+
+		Expect(scheduler.Schedule("1.20", false)).To(Succeed())
+		p.MockSSM().AssertNumberOfCalls(GinkgoT(), "UpdateDocumentDefaultVersion", 1)
+	})
+
+	It("does not schedule anything in plan mode, or when no upgrade is required", func() {
+		Expect(scheduler.Schedule("1.20", true)).To(Succeed())
+		cfg.Metadata.Version = ""
+		Expect(scheduler.Schedule(api.LatestVersion, false)).To(Succeed())
+		Expect(p.MockSSM().Calls).To(BeEmpty())
+	})
+
+	It("returns an error when the maintenance window cannot be created", func() {
+		mockWindows()
+		p.MockSSM().On("CreateDocument", mock.Anything).Return(&ssm.CreateDocumentOutput{}, nil)
+		p.MockSSM().On("CreateMaintenanceWindow", mock.Anything).Return(nil, errors.New("access denied"))
+
+		Expect(scheduler.Schedule("1.20", false)).To(MatchError(`creating maintenance window for cluster "my-cluster": access denied`))
+	})
+
+	It("cancels the scheduled upgrade", func() {
+		mockWindows("mw-1")
+		p.MockSSM().On("DeleteMaintenanceWindow", &ssm.DeleteMaintenanceWindowInput{WindowId: aws.String("mw-1")}).
+			Return(&ssm.DeleteMaintenanceWindowOutput{}, nil)
+
+		Expect(scheduler.Cancel(false)).To(Succeed())
+		p.MockSSM().AssertNumberOfCalls(GinkgoT(), "DeleteMaintenanceWindow", 1)
+	})
+
+	It("does nothing when cancelling without a scheduled upgrade", func() {
+		mockWindows()
+
+		Expect(scheduler.Cancel(false)).To(Succeed())
+		p.MockSSM().AssertNotCalled(GinkgoT(), "DeleteMaintenanceWindow", mock.Anything)
+	})
+})
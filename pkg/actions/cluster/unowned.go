@@ -47,7 +47,7 @@ func NewUnownedCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackMa
 }
 
 func (c *UnownedCluster) Upgrade(dryRun bool) error {
-	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, dryRun)
+	versionUpdateRequired, err := upgrade(c.cfg, c.ctl, c.newClientSet, dryRun)
 	if err != nil {
 		return err
 	}
@@ -57,7 +57,7 @@ func (c *UnownedCluster) Upgrade(dryRun bool) error {
 	return nil
 }
 
-func (c *UnownedCluster) Delete(waitInterval time.Duration, wait, force bool) error {
+func (c *UnownedCluster) Delete(waitInterval time.Duration, wait, force bool, parallel int) error {
 	clusterName := c.cfg.Metadata.Name
 
 	if err := c.checkClusterExists(clusterName); err != nil {
@@ -69,6 +69,14 @@ func (c *UnownedCluster) Delete(waitInterval time.Duration, wait, force bool) er
 		logger.Debug("failed to check if cluster is operable: %v", err)
 	}
 
+	if err := deleteCrossAccountNodeGroups(c.cfg, c.ctl); err != nil {
+		if force {
+			logger.Warning("error occurred during deletion: %v", err)
+		} else {
+			return err
+		}
+	}
+
 	allStacks, err := c.stackManager.ListNodeGroupStacks()
 	if err != nil {
 		return err
@@ -81,6 +89,21 @@ func (c *UnownedCluster) Delete(waitInterval time.Duration, wait, force bool) er
 			return err
 		}
 
+		if err := runPreDeleteHooks(c.cfg, c.ctl); err != nil {
+			if force {
+				logger.Warning("error occurred during deletion: %v", err)
+			} else {
+				return err
+			}
+		}
+		if err := deleteBootstrapManifests(c.cfg, c.ctl); err != nil {
+			if force {
+				logger.Warning("error occurred during deletion: %v", err)
+			} else {
+				return err
+			}
+		}
+
 		if err := drainAllNodegroups(c.cfg, c.ctl, c.stackManager, clientSet, allStacks); err != nil {
 			return err
 		}
@@ -102,11 +125,11 @@ func (c *UnownedCluster) Delete(waitInterval time.Duration, wait, force bool) er
 
 	// we have to wait for nodegroups to delete before deleting the cluster
 	// so the `wait` value is ignored here
-	if err := c.deleteAndWaitForNodegroupsDeletion(waitInterval, allStacks); err != nil {
+	if err := c.deleteAndWaitForNodegroupsDeletion(waitInterval, allStacks, parallel); err != nil {
 		return err
 	}
 
-	if err := c.deleteIAMAndOIDC(wait, clusterOperable, clientSet); err != nil {
+	if err := c.deleteIAMAndOIDC(wait, clusterOperable, clientSet, parallel); err != nil {
 		if err != nil {
 			if force {
 				logger.Warning("error occurred during deletion: %v", err)
@@ -157,7 +180,7 @@ func (c *UnownedCluster) checkClusterExists(clusterName string) error {
 	return nil
 }
 
-func (c *UnownedCluster) deleteIAMAndOIDC(wait bool, clusterOperable bool, clientSet kubernetes.Interface) error {
+func (c *UnownedCluster) deleteIAMAndOIDC(wait bool, clusterOperable bool, clientSet kubernetes.Interface, parallel int) error {
 	var oidc *iamoidc.OpenIDConnectManager
 	oidcSupported := true
 
@@ -202,6 +225,7 @@ func (c *UnownedCluster) deleteIAMAndOIDC(wait bool, clusterOperable bool, clien
 		return nil
 	}
 
+	tasksTree.SetLimit(parallel)
 	logger.Info(tasksTree.Describe())
 	if errs := tasksTree.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "cluster IAM and OIDC")
@@ -246,19 +270,17 @@ func (c *UnownedCluster) deleteCluster(wait bool) error {
 	return waiters.Wait(clusterName, msg, acceptors, newRequest, c.ctl.Provider.WaitTimeout(), nil)
 }
 
-func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Duration, allStacks []manager.NodeGroupStack) error {
+func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Duration, allStacks []manager.NodeGroupStack, parallel int) error {
 	clusterName := c.cfg.Metadata.Name
 	eksAPI := c.ctl.Provider.EKS()
 
 	// get all managed nodegroups for this cluster
-	nodeGroups, err := eksAPI.ListNodegroups(&awseks.ListNodegroupsInput{
-		ClusterName: &clusterName,
-	})
+	nodeGroups, err := eks.ListNodegroups(eksAPI, clusterName)
 	if err != nil {
 		return err
 	}
 
-	if len(allStacks) == 0 && len(nodeGroups.Nodegroups) == 0 {
+	if len(allStacks) == 0 && len(nodeGroups) == 0 {
 		logger.Warning("no nodegroups found for %s", clusterName)
 		return nil
 	}
@@ -269,7 +291,7 @@ func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Du
 		return err
 	}
 
-	for _, n := range nodeGroups.Nodegroups {
+	for _, n := range nodeGroups {
 		isUnowned := func() bool {
 			for _, stack := range allStacks {
 				if stack.NodeGroupName == *n {
@@ -287,6 +309,7 @@ func (c *UnownedCluster) deleteAndWaitForNodegroupsDeletion(waitInterval time.Du
 
 	// TODO what dis?
 	tasks.PlanMode = false
+	tasks.SetLimit(parallel)
 	logger.Info(tasks.Describe())
 	if errs := tasks.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "nodegroup(s)")
@@ -301,13 +324,11 @@ func isNotFound(err error) bool {
 
 func (c *UnownedCluster) waitForUnownedNgsDeletion(interval time.Duration) *manager.DeleteWaitCondition {
 	condition := func() (bool, error) {
-		nodeGroups, err := c.ctl.Provider.EKS().ListNodegroups(&awseks.ListNodegroupsInput{
-			ClusterName: &c.cfg.Metadata.Name,
-		})
+		nodeGroups, err := eks.ListNodegroups(c.ctl.Provider.EKS(), c.cfg.Metadata.Name)
 		if err != nil {
 			return false, err
 		}
-		if len(nodeGroups.Nodegroups) == 0 {
+		if len(nodeGroups) == 0 {
 			return true, nil
 		}
 
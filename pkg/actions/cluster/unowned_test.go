@@ -63,18 +63,21 @@ var _ = Describe("Delete", func() {
 				AddonName:   strings.Pointer("vpc-cni"),
 			}).Return(&awseks.DeleteAddonOutput{}, nil)
 
-			p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+			p.MockEKS().On("ListFargateProfilesPages", &awseks.ListFargateProfilesInput{
 				ClusterName: strings.Pointer(clusterName),
-			}).Once().Return(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{"fargate-1"})}, nil)
+			}, mock.Anything).Once().Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListFargateProfilesOutput, bool) bool)
+				consume(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{"fargate-1"})}, true)
+			}).Return(nil)
 
 			p.MockEKS().On("DeleteFargateProfile", &awseks.DeleteFargateProfileInput{
 				ClusterName:        aws.String(clusterName),
 				FargateProfileName: aws.String("fargate-1"),
 			}).Once().Return(&awseks.DeleteFargateProfileOutput{}, nil)
 
-			p.MockEKS().On("ListFargateProfiles", &awseks.ListFargateProfilesInput{
+			p.MockEKS().On("ListFargateProfilesPages", &awseks.ListFargateProfilesInput{
 				ClusterName: strings.Pointer(clusterName),
-			}).Once().Return(&awseks.ListFargateProfilesOutput{}, nil)
+			}, mock.Anything).Once().Return(nil)
 
 			fargateStackName := aws.String("eksctl-my-cluster-fargate")
 			p.MockCloudFormation().On("DescribeStacks", &cloudformation.DescribeStacksInput{
@@ -110,9 +113,10 @@ var _ = Describe("Delete", func() {
 			fakeStackManager.GetFargateStackReturns(&cloudformation.Stack{StackName: aws.String("fargate-role")}, nil)
 			fakeStackManager.DeleteStackByNameReturns(nil, nil)
 
-			p.MockEKS().On("ListNodegroups", mock.Anything).Return(&awseks.ListNodegroupsOutput{
-				Nodegroups: aws.StringSlice([]string{"ng-1", "ng-2"}),
-			}, nil)
+			p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"ng-1", "ng-2"})}, true)
+			}).Return(nil)
 
 			fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{{NodeGroupName: "ng-1"}}, nil)
 
@@ -143,7 +147,7 @@ var _ = Describe("Delete", func() {
 				return fakeClientSet, nil
 			})
 
-			err := c.Delete(time.Microsecond, false, false)
+			err := c.Delete(time.Microsecond, false, false, 0)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(deleteCallCount).To(Equal(1))
 			Expect(unownedDeleteCallCount).To(Equal(1))
@@ -175,9 +179,10 @@ var _ = Describe("Delete", func() {
 
 			p.MockEC2().On("DescribeSecurityGroupsWithContext", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
 
-			p.MockEKS().On("ListNodegroups", mock.Anything).Return(&awseks.ListNodegroupsOutput{
-				Nodegroups: aws.StringSlice([]string{"ng-1", "ng-2"}),
-			}, nil)
+			p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"ng-1", "ng-2"})}, true)
+			}).Return(nil)
 
 			fakeStackManager.ListNodeGroupStacksReturns([]manager.NodeGroupStack{{NodeGroupName: "ng-1"}}, nil)
 
@@ -206,7 +211,7 @@ var _ = Describe("Delete", func() {
 			p.MockEKS().On("DeleteCluster", mock.Anything).Return(&awseks.DeleteClusterOutput{}, nil)
 
 			c := cluster.NewUnownedCluster(cfg, ctl, fakeStackManager)
-			err := c.Delete(time.Microsecond, false, false)
+			err := c.Delete(time.Microsecond, false, false, 0)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(deleteCallCount).To(Equal(1))
@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/weaveworks/eksctl/pkg/printers"
@@ -9,11 +10,13 @@ import (
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/deprecatedapis"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/utils"
 )
 
-func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (bool, error) {
+func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, newClientSet func() (kubernetes.Interface, error), dryRun bool) (bool, error) {
 	currentVersion := ctl.ControlPlaneVersion()
 	versionUpdateRequired, err := requiresVersionUpgrade(cfg.Metadata, currentVersion)
 	if err != nil {
@@ -27,11 +30,15 @@ func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (boo
 
 	if versionUpdateRequired {
 		msgNodeGroupsAndAddons := "you will need to follow the upgrade procedure for all of nodegroups and add-ons"
+		warnDeprecatedAPIs(cfg.Metadata.Version, newClientSet)
 		cmdutils.LogIntendedAction(dryRun, "upgrade cluster %q control plane from current version %q to %q", cfg.Metadata.Name, currentVersion, cfg.Metadata.Version)
 		if !dryRun {
 			if err := ctl.UpdateClusterVersionBlocking(cfg); err != nil {
 				return false, err
 			}
+			if err := ctl.ProbeEndpoints(cfg); err != nil {
+				logger.Warning("the endpoints of cluster %q are not ready: %v", cfg.Metadata.Name, err)
+			}
 			logger.Success("cluster %q control plane has been upgraded to version %q", cfg.Metadata.Name, cfg.Metadata.Version)
 			logger.Info(msgNodeGroupsAndAddons)
 		}
@@ -41,6 +48,29 @@ func upgrade(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (boo
 	return versionUpdateRequired, nil
 }
 
+// warnDeprecatedAPIs logs a warning for every deprecated API in use in the cluster that is removed in the
+// target version, as clients using them will fail once the control plane has been upgraded
+func warnDeprecatedAPIs(targetVersion string, newClientSet func() (kubernetes.Interface, error)) {
+	clientSet, err := newClientSet()
+	if err != nil {
+		logger.Warning("unable to check for deprecated APIs in use: %v", err)
+		return
+	}
+	findings, err := deprecatedapis.Get(context.TODO(), clientSet)
+	if err != nil {
+		logger.Warning("unable to check for deprecated APIs in use: %v", err)
+		return
+	}
+	blocking, err := deprecatedapis.BlockingUpgradeTo(findings, targetVersion)
+	if err != nil {
+		logger.Warning("unable to check for deprecated APIs in use: %v", err)
+		return
+	}
+	for _, f := range blocking {
+		logger.Warning("deprecated API %s is in use and is removed in Kubernetes %s, migrate its clients before upgrading", f.API(), f.RemovedRelease)
+	}
+}
+
 func requiresVersionUpgrade(clusterMeta *api.ClusterMeta, currentEKSVersion string) (bool, error) {
 	nextVersion, err := getNextVersion(currentEKSVersion)
 	if err != nil {
@@ -0,0 +1,120 @@
+package clusteroutputs
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// Outputs is the JSON document written with --write-outputs, holding the identifiers of the cluster and the
+// outputs of all of its stacks, for later steps of a pipeline to consume
+type Outputs struct {
+	ClusterName                 string   `json:"clusterName"`
+	Region                      string   `json:"region"`
+	Version                     string   `json:"version,omitempty"`
+	ARN                         string   `json:"arn,omitempty"`
+	Endpoint                    string   `json:"endpoint,omitempty"`
+	CertificateAuthorityData    string   `json:"certificateAuthorityData,omitempty"`
+	OIDCIssuer                  string   `json:"oidcIssuer,omitempty"`
+	VPCID                       string   `json:"vpcID,omitempty"`
+	ClusterSecurityGroupID      string   `json:"clusterSecurityGroupID,omitempty"`
+	ControlPlaneSecurityGroupID string   `json:"controlPlaneSecurityGroupID,omitempty"`
+	SharedNodeSecurityGroupID   string   `json:"sharedNodeSecurityGroupID,omitempty"`
+	PrivateSubnetIDs            []string `json:"privateSubnetIDs,omitempty"`
+	PublicSubnetIDs             []string `json:"publicSubnetIDs,omitempty"`
+
+	// Stacks maps the name of each stack of the cluster to its outputs
+	Stacks map[string]map[string]string `json:"stacks"`
+}
+
+// New builds the Outputs of a cluster from the cluster, and its stacks. The security groups and subnets eksctl
+// manages are read from the outputs of the cluster stack, which is not required to be among stacks
+func New(meta *api.ClusterMeta, cluster *awseks.Cluster, clusterStackName string, stacks []*manager.Stack) *Outputs {
+	o := &Outputs{
+		ClusterName: meta.Name,
+		Region:      meta.Region,
+		Version:     aws.StringValue(cluster.Version),
+		ARN:         aws.StringValue(cluster.Arn),
+		Endpoint:    aws.StringValue(cluster.Endpoint),
+		Stacks:      map[string]map[string]string{},
+	}
+	if cluster.CertificateAuthority != nil {
+		o.CertificateAuthorityData = aws.StringValue(cluster.CertificateAuthority.Data)
+	}
+	if cluster.Identity != nil && cluster.Identity.Oidc != nil {
+		o.OIDCIssuer = aws.StringValue(cluster.Identity.Oidc.Issuer)
+	}
+	if vpcConfig := cluster.ResourcesVpcConfig; vpcConfig != nil {
+		o.VPCID = aws.StringValue(vpcConfig.VpcId)
+		o.ClusterSecurityGroupID = aws.StringValue(vpcConfig.ClusterSecurityGroupId)
+	}
+
+	for _, s := range stacks {
+		stackOutputs := map[string]string{}
+		for _, output := range s.Outputs {
+			stackOutputs[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+		}
+		o.Stacks[aws.StringValue(s.StackName)] = stackOutputs
+	}
+
+	if clusterStackOutputs, ok := o.Stacks[clusterStackName]; ok {
+		o.ControlPlaneSecurityGroupID = clusterStackOutputs[outputs.ClusterSecurityGroup]
+		o.SharedNodeSecurityGroupID = clusterStackOutputs[outputs.ClusterSharedNodeSecurityGroup]
+		o.PrivateSubnetIDs = splitIDs(clusterStackOutputs[outputs.ClusterSubnetsPrivate])
+		publicSubnets, ok := clusterStackOutputs[outputs.ClusterSubnetsPublic]
+		if !ok {
+			publicSubnets = clusterStackOutputs[outputs.ClusterSubnetsPublicLegacy]
+		}
+		o.PublicSubnetIDs = splitIDs(publicSubnets)
+	}
+	return o
+}
+
+// WriteFile writes the Outputs to a file as JSON, replacing the file if it exists
+func (o *Outputs) WriteFile(path string) error {
+	data, err := json.MarshalIndent(o, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshalling outputs")
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return errors.Wrapf(err, "writing outputs to %q", path)
+	}
+	return nil
+}
+
+// Write describes the cluster and its stacks, and writes their Outputs to a file
+func Write(path string, ctl *eks.ClusterProvider, cfg *api.ClusterConfig, stackManager manager.StackManager) error {
+	cluster, err := ctl.DescribeControlPlane(cfg.Metadata)
+	if err != nil {
+		return err
+	}
+	stacks, err := stackManager.DescribeStacks()
+	if err != nil {
+		return errors.Wrap(err, "describing stacks")
+	}
+	if err := New(cfg.Metadata, cluster, stackManager.MakeClusterStackName(), stacks).WriteFile(path); err != nil {
+		return err
+	}
+	logger.Success("wrote outputs of cluster %q to %q", cfg.Metadata.Name, path)
+	return nil
+}
+
+func splitIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	split := strings.Split(ids, ",")
+	sort.Strings(split)
+	return split
+}
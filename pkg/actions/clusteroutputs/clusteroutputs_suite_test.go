@@ -0,0 +1,13 @@
+package clusteroutputs_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestClusterOutputs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cluster Outputs Suite")
+}
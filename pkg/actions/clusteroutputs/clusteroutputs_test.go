@@ -0,0 +1,137 @@
+package clusteroutputs_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/clusteroutputs"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Outputs", func() {
+	var (
+		cfg     *api.ClusterConfig
+		cluster *awseks.Cluster
+		stacks  []*manager.Stack
+	)
+
+	newStack := func(name string, stackOutputs map[string]string) *manager.Stack {
+		stack := &manager.Stack{StackName: aws.String(name)}
+		for key, value := range stackOutputs {
+			stack.Outputs = append(stack.Outputs, &cfn.Output{OutputKey: aws.String(key), OutputValue: aws.String(value)})
+		}
+		return stack
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.Metadata.Region = "us-west-2"
+		cluster = &awseks.Cluster{
+			Name:                 aws.String("cluster-1"),
+			Version:              aws.String("1.21"),
+			Arn:                  aws.String("arn:aws:eks:us-west-2:123456789012:cluster/cluster-1"),
+			Endpoint:             aws.String("https://ABCDEF.gr7.us-west-2.eks.amazonaws.com"),
+			CertificateAuthority: &awseks.Certificate{Data: aws.String("Y2EtZGF0YQ==")},
+			Identity: &awseks.Identity{Oidc: &awseks.OIDC{
+				Issuer: aws.String("https://oidc.eks.us-west-2.amazonaws.com/id/ABCDEF"),
+			}},
+			ResourcesVpcConfig: &awseks.VpcConfigResponse{
+				VpcId:                  aws.String("vpc-1"),
+				ClusterSecurityGroupId: aws.String("sg-cluster"),
+			},
+		}
+		stacks = []*manager.Stack{
+			newStack("eksctl-cluster-1-cluster", map[string]string{
+				"VPC":                     "vpc-1",
+				"SecurityGroup":           "sg-control-plane",
+				"SharedNodeSecurityGroup": "sg-nodes",
+				"SubnetsPrivate":          "subnet-private-b,subnet-private-a",
+				"SubnetsPublic":           "subnet-public-a",
+			}),
+			newStack("eksctl-cluster-1-nodegroup-ng-1", map[string]string{
+				"InstanceRoleARN": "arn:aws:iam::123456789012:role/ng-1",
+			}),
+		}
+	})
+
+	It("holds the identifiers of the cluster and the outputs of its stacks", func() {
+		o := clusteroutputs.New(cfg.Metadata, cluster, "eksctl-cluster-1-cluster", stacks)
+		Expect(*o).To(Equal(clusteroutputs.Outputs{
+			ClusterName:                 "cluster-1",
+			Region:                      "us-west-2",
+			Version:                     "1.21",
+			ARN:                         "arn:aws:eks:us-west-2:123456789012:cluster/cluster-1",
+			Endpoint:                    "https://ABCDEF.gr7.us-west-2.eks.amazonaws.com",
+			CertificateAuthorityData:    "Y2EtZGF0YQ==",
+			OIDCIssuer:                  "https://oidc.eks.us-west-2.amazonaws.com/id/ABCDEF",
+			VPCID:                       "vpc-1",
+			ClusterSecurityGroupID:      "sg-cluster",
+			ControlPlaneSecurityGroupID: "sg-control-plane",
+			SharedNodeSecurityGroupID:   "sg-nodes",
+			PrivateSubnetIDs:            []string{"subnet-private-a", "subnet-private-b"},
+			PublicSubnetIDs:             []string{"subnet-public-a"},
+			Stacks: map[string]map[string]string{
+				"eksctl-cluster-1-cluster": {
+					"VPC":                     "vpc-1",
+					"SecurityGroup":           "sg-control-plane",
+					"SharedNodeSecurityGroup": "sg-nodes",
+					"SubnetsPrivate":          "subnet-private-b,subnet-private-a",
+					"SubnetsPublic":           "subnet-public-a",
+				},
+				"eksctl-cluster-1-nodegroup-ng-1": {
+					"InstanceRoleARN": "arn:aws:iam::123456789012:role/ng-1",
+				},
+			},
+		}))
+	})
+
+	It("reads the public subnets from the legacy output", func() {
+		stacks = []*manager.Stack{newStack("eksctl-cluster-1-cluster", map[string]string{"Subnets": "subnet-public-a"})}
+		o := clusteroutputs.New(cfg.Metadata, cluster, "eksctl-cluster-1-cluster", stacks)
+		Expect(o.PublicSubnetIDs).To(ConsistOf("subnet-public-a"))
+		Expect(o.PrivateSubnetIDs).To(BeEmpty())
+	})
+
+	It("only holds the identifiers of the cluster without a cluster stack", func() {
+		o := clusteroutputs.New(cfg.Metadata, cluster, "eksctl-cluster-1-cluster", nil)
+		Expect(o.VPCID).To(Equal("vpc-1"))
+		Expect(o.ControlPlaneSecurityGroupID).To(BeEmpty())
+		Expect(o.Stacks).To(BeEmpty())
+	})
+
+	It("writes the outputs of the cluster as JSON", func() {
+		p := mockprovider.NewMockProvider()
+		p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{Cluster: cluster}, nil)
+		stackManager := &fakes.FakeStackManager{}
+		stackManager.DescribeStacksReturns(stacks, nil)
+		stackManager.MakeClusterStackNameReturns("eksctl-cluster-1-cluster")
+
+		dir, err := os.MkdirTemp("", "clusteroutputs")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "outputs.json")
+		Expect(clusteroutputs.Write(path, &eks.ClusterProvider{Provider: p}, cfg, stackManager)).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		var written map[string]interface{}
+		Expect(json.Unmarshal(data, &written)).To(Succeed())
+		Expect(written).To(HaveKeyWithValue("clusterName", "cluster-1"))
+		Expect(written).To(HaveKeyWithValue("endpoint", "https://ABCDEF.gr7.us-west-2.eks.amazonaws.com"))
+		Expect(written).To(HaveKeyWithValue("privateSubnetIDs", ConsistOf("subnet-private-a", "subnet-private-b")))
+		Expect(written).To(HaveKeyWithValue("stacks", HaveKey("eksctl-cluster-1-nodegroup-ng-1")))
+	})
+})
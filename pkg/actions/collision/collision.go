@@ -0,0 +1,299 @@
+package collision
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Types of the resources whose names are checked
+const (
+	TypeStack          = "CloudFormation stack"
+	TypeIAMRole        = "IAM role"
+	TypeLaunchTemplate = "launch template"
+)
+
+// leftoverStackStatuses are the statuses of stacks left behind by failed runs, which CloudFormation does not delete
+var leftoverStackStatuses = map[string]bool{
+	cloudformation.StackStatusCreateFailed:         true,
+	cloudformation.StackStatusRollbackComplete:     true,
+	cloudformation.StackStatusRollbackFailed:       true,
+	cloudformation.StackStatusDeleteFailed:         true,
+	cloudformation.StackStatusUpdateRollbackFailed: true,
+}
+
+// Collision is an existing resource with the name of a resource eksctl is about to create
+type Collision struct {
+	Type string
+	Name string
+	// Leftover is whether the resource was left behind by a failed run of eksctl, rather than being in use
+	Leftover bool
+	// Detail describes the existing resource, e.g. the status of a stack
+	Detail string
+	// Cleanup suggests how to resolve the collision
+	Cleanup string
+}
+
+func (c Collision) String() string {
+	s := fmt.Sprintf("%s %q", c.Type, c.Name)
+	var details []string
+	if c.Detail != "" {
+		details = append(details, c.Detail)
+	}
+	if c.Leftover {
+		details = append(details, "left over from a failed run")
+	}
+	if len(details) > 0 {
+		s += fmt.Sprintf(" (%s)", strings.Join(details, ", "))
+	}
+	return s + ": " + c.Cleanup
+}
+
+// Checker checks that the resources eksctl creates do not collide with existing resources
+type Checker struct {
+	provider api.ClusterProvider
+}
+
+// New creates a new Checker
+func New(provider api.ClusterProvider) *Checker {
+	return &Checker{
+		provider: provider,
+	}
+}
+
+// Check returns an error listing the existing resources that have the names of the stacks, IAM roles and launch
+// templates eksctl creates for the nodegroups of cfg, and for the cluster itself when createCluster is true, so that
+// they are reported before any stack is created
+func (c *Checker) Check(cfg *api.ClusterConfig, createCluster bool) error {
+	collisions, err := c.Find(cfg, createCluster)
+	if err != nil {
+		return err
+	}
+	if len(collisions) == 0 {
+		return nil
+	}
+	lines := make([]string, len(collisions))
+	for i, collision := range collisions {
+		lines[i] = "- " + collision.String()
+	}
+	return fmt.Errorf("found %d existing resource(s) with the names of resources eksctl would create for cluster %q:\n%s", len(collisions), cfg.Metadata.Name, strings.Join(lines, "\n"))
+}
+
+// Find returns the existing resources that have the names of the resources eksctl creates. The resources of a stack
+// that already exists are not checked, as deleting the stack resolves their collisions. Resources the caller is not
+// allowed to describe are skipped with a warning
+func (c *Checker) Find(cfg *api.ClusterConfig, createCluster bool) ([]Collision, error) {
+	var collisions []Collision
+	meta := cfg.Metadata
+
+	if createCluster {
+		stackName := cfg.StackName(fmt.Sprintf("eksctl-%s-cluster", meta.Name))
+		collision, err := c.findStack(stackName,
+			fmt.Sprintf("delete it with 'eksctl delete cluster --region=%s --name=%s'", meta.Region, meta.Name),
+			"the cluster already exists, use 'eksctl create nodegroup' to add nodegroups to it",
+		)
+		if err != nil {
+			return nil, err
+		}
+		if collision != nil {
+			// the stacks of nodegroups are deleted along with the cluster
+			return []Collision{*collision}, nil
+		}
+		if cfg.IAM == nil || !api.IsSetAndNonEmptyString(cfg.IAM.ServiceRoleARN) {
+			collision, err := c.findRole(cfg, cfg.ServiceRoleName())
+			if err != nil {
+				return nil, err
+			}
+			if collision != nil {
+				collisions = append(collisions, *collision)
+			}
+		}
+	}
+
+	var launchTemplateNames []string
+	checkNodeGroup := func(ng *api.NodeGroupBase, createsLaunchTemplate bool) error {
+		stackName := cfg.StackName(fmt.Sprintf("eksctl-%s-nodegroup-%s", meta.Name, ng.Name))
+		collision, err := c.findStack(stackName,
+			fmt.Sprintf("delete it with 'eksctl delete nodegroup --region=%s --cluster=%s --name=%s'", meta.Region, meta.Name, ng.Name),
+			fmt.Sprintf("the nodegroup already exists, exclude it with '--exclude=%s'", ng.Name),
+		)
+		if err != nil {
+			return err
+		}
+		if collision != nil {
+			collisions = append(collisions, *collision)
+			return nil
+		}
+		collision, err = c.findRole(cfg, nodeGroupInstanceRoleName(cfg, ng))
+		if err != nil {
+			return err
+		}
+		if collision != nil {
+			collisions = append(collisions, *collision)
+		}
+		if createsLaunchTemplate {
+			// launch templates are named after the stacks of their nodegroups
+			launchTemplateNames = append(launchTemplateNames, stackName)
+		}
+		return nil
+	}
+
+	for _, ng := range cfg.NodeGroups {
+		if err := checkNodeGroup(ng.NodeGroupBase, true); err != nil {
+			return nil, err
+		}
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		// no launch template is created for managed nodegroups using an existing one
+		if err := checkNodeGroup(ng.NodeGroupBase, ng.LaunchTemplate == nil); err != nil {
+			return nil, err
+		}
+	}
+
+	launchTemplateCollisions, err := c.findLaunchTemplates(meta.Region, launchTemplateNames)
+	if err != nil {
+		return nil, err
+	}
+	return append(collisions, launchTemplateCollisions...), nil
+}
+
+// nodeGroupInstanceRoleName returns the name of the instance role created for a nodegroup, or an empty string if
+// no role is created or its name is generated by CloudFormation
+func nodeGroupInstanceRoleName(cfg *api.ClusterConfig, ng *api.NodeGroupBase) string {
+	if ng.IAM == nil {
+		return cfg.NodeGroupInstanceRoleName(ng.Name)
+	}
+	if ng.IAM.InstanceRoleARN != "" || ng.IAM.InstanceProfileARN != "" {
+		return ""
+	}
+	if ng.IAM.InstanceRoleName != "" {
+		return ng.IAM.InstanceRoleName
+	}
+	return cfg.NodeGroupInstanceRoleName(ng.Name)
+}
+
+// findStack returns the collision with stack stackName if it exists, suggesting cleanupLeftover if the stack was
+// left behind by a failed run, and resolveInUse otherwise
+func (c *Checker) findStack(stackName, cleanupLeftover, resolveInUse string) (*Collision, error) {
+	output, err := c.provider.CloudFormation().DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ValidationError" && strings.Contains(awsErr.Message(), "does not exist") {
+			return nil, nil
+		}
+		if isAccessDenied(err) {
+			logger.Warning("skipping check for existing stack %q: %v", stackName, err)
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "describing stack %q", stackName)
+	}
+	if len(output.Stacks) == 0 {
+		return nil, nil
+	}
+	status := aws.StringValue(output.Stacks[0].StackStatus)
+	if status == cloudformation.StackStatusDeleteComplete {
+		return nil, nil
+	}
+	collision := &Collision{
+		Type:     TypeStack,
+		Name:     stackName,
+		Leftover: leftoverStackStatuses[status],
+		Detail:   status,
+		Cleanup:  cleanupLeftover,
+	}
+	if !collision.Leftover {
+		collision.Cleanup = resolveInUse
+	}
+	return collision, nil
+}
+
+func (c *Checker) findRole(cfg *api.ClusterConfig, roleName string) (*Collision, error) {
+	if roleName == "" {
+		return nil, nil
+	}
+	output, err := c.provider.IAM().GetRole(&iam.GetRoleInput{
+		RoleName: aws.String(roleName),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == iam.ErrCodeNoSuchEntityException {
+			return nil, nil
+		}
+		if isAccessDenied(err) {
+			logger.Warning("skipping check for existing IAM role %q: %v", roleName, err)
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "getting IAM role %q", roleName)
+	}
+
+	leftover := false
+	for _, tag := range output.Role.Tags {
+		if aws.StringValue(tag.Key) == api.ClusterNameTag && aws.StringValue(tag.Value) == cfg.Metadata.Name {
+			leftover = true
+		}
+	}
+	collision := &Collision{
+		Type:     TypeIAMRole,
+		Name:     roleName,
+		Leftover: leftover,
+		Cleanup:  fmt.Sprintf("detach its policies and delete it with 'aws iam delete-role --role-name=%s'", roleName),
+	}
+	if !leftover {
+		collision.Detail = "not created for this cluster"
+		collision.Cleanup = "change naming or iam.instanceRoleName so that the names do not collide"
+	}
+	return collision, nil
+}
+
+func (c *Checker) findLaunchTemplates(region string, names []string) ([]Collision, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	var collisions []Collision
+	input := &ec2.DescribeLaunchTemplatesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("launch-template-name"),
+				Values: aws.StringSlice(names),
+			},
+		},
+	}
+	for {
+		output, err := c.provider.EC2().DescribeLaunchTemplates(input)
+		if err != nil {
+			if isAccessDenied(err) {
+				logger.Warning("skipping check for existing launch templates: %v", err)
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "describing launch templates")
+		}
+		for _, lt := range output.LaunchTemplates {
+			name := aws.StringValue(lt.LaunchTemplateName)
+			collisions = append(collisions, Collision{
+				Type: TypeLaunchTemplate,
+				Name: name,
+				// the launch templates of nodegroups are deleted along with their stacks, which do not exist
+				Leftover: true,
+				Cleanup:  fmt.Sprintf("delete it with 'aws ec2 delete-launch-template --region=%s --launch-template-name=%s'", region, name),
+			})
+		}
+		if output.NextToken == nil {
+			return collisions, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func isAccessDenied(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && (awsErr.Code() == "AccessDenied" || awsErr.Code() == "UnauthorizedOperation")
+}
@@ -0,0 +1,13 @@
+package collision_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCollision(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Name Collision Suite")
+}
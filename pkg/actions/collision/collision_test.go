@@ -0,0 +1,149 @@
+package collision_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/actions/collision"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Name collisions", func() {
+	var (
+		cfg      *api.ClusterConfig
+		provider *mockprovider.MockProvider
+		stacks   map[string]string
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng-1"
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+
+		provider = mockprovider.NewMockProvider()
+		stacks = map[string]string{}
+		provider.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cloudformation.DescribeStacksInput) *cloudformation.DescribeStacksOutput {
+			status, ok := stacks[aws.StringValue(input.StackName)]
+			if !ok {
+				return nil
+			}
+			return &cloudformation.DescribeStacksOutput{
+				Stacks: []*cloudformation.Stack{{StackName: input.StackName, StackStatus: aws.String(status)}},
+			}
+		}, func(input *cloudformation.DescribeStacksInput) error {
+			if _, ok := stacks[aws.StringValue(input.StackName)]; !ok {
+				return awserr.New("ValidationError", "Stack with id "+aws.StringValue(input.StackName)+" does not exist", nil)
+			}
+			return nil
+		})
+	})
+
+	mockLaunchTemplates := func(names ...string) {
+		var launchTemplates []*ec2.LaunchTemplate
+		for _, name := range names {
+			launchTemplates = append(launchTemplates, &ec2.LaunchTemplate{LaunchTemplateName: aws.String(name)})
+		}
+		provider.MockEC2().On("DescribeLaunchTemplates", mock.MatchedBy(func(input *ec2.DescribeLaunchTemplatesInput) bool {
+			return len(input.Filters) == 1 && aws.StringValue(input.Filters[0].Name) == "launch-template-name"
+		})).Return(&ec2.DescribeLaunchTemplatesOutput{LaunchTemplates: launchTemplates}, nil)
+	}
+
+	It("finds no collisions when no resources exist", func() {
+		mockLaunchTemplates()
+
+		Expect(collision.New(provider).Check(cfg, true)).To(Succeed())
+		provider.MockEC2().AssertCalled(GinkgoT(), "DescribeLaunchTemplates", mock.MatchedBy(func(input *ec2.DescribeLaunchTemplatesInput) bool {
+			return sets.NewString(aws.StringValueSlice(input.Filters[0].Values)...).Equal(sets.NewString("eksctl-my-cluster-nodegroup-ng-1", "eksctl-my-cluster-nodegroup-mng-1"))
+		}))
+		provider.MockIAM().AssertNotCalled(GinkgoT(), "GetRole", mock.Anything)
+	})
+
+	It("reports a cluster stack left over from a failed run", func() {
+		stacks["eksctl-my-cluster-cluster"] = cloudformation.StackStatusRollbackComplete
+
+		collisions, err := collision.New(provider).Find(cfg, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(collisions).To(ConsistOf(collision.Collision{
+			Type:     collision.TypeStack,
+			Name:     "eksctl-my-cluster-cluster",
+			Leftover: true,
+			Detail:   cloudformation.StackStatusRollbackComplete,
+			Cleanup:  "delete it with 'eksctl delete cluster --region=us-west-2 --name=my-cluster'",
+		}))
+		provider.MockEC2().AssertNotCalled(GinkgoT(), "DescribeLaunchTemplates", mock.Anything)
+	})
+
+	It("does not check the resources of existing nodegroup stacks", func() {
+		stacks["eksctl-my-cluster-nodegroup-ng-1"] = cloudformation.StackStatusCreateComplete
+		mockLaunchTemplates()
+
+		collisions, err := collision.New(provider).Find(cfg, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(collisions).To(HaveLen(1))
+		Expect(collisions[0].Leftover).To(BeFalse())
+		Expect(collisions[0].Cleanup).To(Equal("the nodegroup already exists, exclude it with '--exclude=ng-1'"))
+		provider.MockEC2().AssertCalled(GinkgoT(), "DescribeLaunchTemplates", mock.MatchedBy(func(input *ec2.DescribeLaunchTemplatesInput) bool {
+			return sets.NewString(aws.StringValueSlice(input.Filters[0].Values)...).Equal(sets.NewString("eksctl-my-cluster-nodegroup-mng-1"))
+		}))
+	})
+
+	Context("with naming set", func() {
+		BeforeEach(func() {
+			cfg.Naming = &api.Naming{Prefix: "acme-"}
+
+			provider.MockIAM().On("GetRole", mock.Anything).Return(func(input *iam.GetRoleInput) *iam.GetRoleOutput {
+				if aws.StringValue(input.RoleName) != "acme-my-cluster-ng-1-NodeInstanceRole" {
+					return nil
+				}
+				return &iam.GetRoleOutput{
+					Role: &iam.Role{
+						RoleName: input.RoleName,
+						Tags:     []*iam.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("my-cluster")}},
+					},
+				}
+			}, func(input *iam.GetRoleInput) error {
+				if aws.StringValue(input.RoleName) != "acme-my-cluster-ng-1-NodeInstanceRole" {
+					return awserr.New(iam.ErrCodeNoSuchEntityException, "not found", nil)
+				}
+				return nil
+			})
+		})
+
+		It("reports existing stacks, roles and launch templates with cleanup suggestions", func() {
+			stacks["acme-eksctl-my-cluster-nodegroup-ng-2"] = cloudformation.StackStatusRollbackComplete
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-2"
+			mockLaunchTemplates("acme-eksctl-my-cluster-nodegroup-mng-1")
+
+			err := collision.New(provider).Check(cfg, true)
+			Expect(err).To(MatchError(`found 3 existing resource(s) with the names of resources eksctl would create for cluster "my-cluster":
+- IAM role "acme-my-cluster-ng-1-NodeInstanceRole" (left over from a failed run): detach its policies and delete it with 'aws iam delete-role --role-name=acme-my-cluster-ng-1-NodeInstanceRole'
+- CloudFormation stack "acme-eksctl-my-cluster-nodegroup-ng-2" (ROLLBACK_COMPLETE, left over from a failed run): delete it with 'eksctl delete nodegroup --region=us-west-2 --cluster=my-cluster --name=ng-2'
+- launch template "acme-eksctl-my-cluster-nodegroup-mng-1" (left over from a failed run): delete it with 'aws ec2 delete-launch-template --region=us-west-2 --launch-template-name=acme-eksctl-my-cluster-nodegroup-mng-1'`))
+			provider.MockIAM().AssertCalled(GinkgoT(), "GetRole", &iam.GetRoleInput{RoleName: aws.String("acme-my-cluster-ServiceRole")})
+			provider.MockCloudFormation().AssertCalled(GinkgoT(), "DescribeStacks", &cloudformation.DescribeStacksInput{StackName: aws.String("acme-eksctl-my-cluster-cluster")})
+		})
+
+		It("skips the resources the caller is not allowed to describe", func() {
+			provider.MockEC2().On("DescribeLaunchTemplates", mock.Anything).Return(nil, awserr.New("UnauthorizedOperation", "not allowed", nil))
+
+			collisions, err := collision.New(provider).Find(cfg, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(collisions).To(HaveLen(1))
+			Expect(collisions[0].Type).To(Equal(collision.TypeIAMRole))
+		})
+	})
+})
@@ -0,0 +1,423 @@
+package connectivity
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const httpsPort = 443
+
+// Result is the outcome of a single connectivity check
+type Result struct {
+	Check   string
+	Passed  bool
+	Message string
+}
+
+// Checker diagnoses the network configuration nodes need to join a fully-private cluster: the VPC endpoints of
+// the AWS services they use, the security groups of these endpoints and of the control plane, and the DNS
+// resolution of their host names from the node subnets
+type Checker struct {
+	ec2API  ec2iface.EC2API
+	ssmAPI  ssmiface.SSMAPI
+	cluster *awseks.Cluster
+	region  string
+
+	// ProbeTimeout is the time to wait for the DNS probe to run on a node
+	ProbeTimeout time.Duration
+	// PollInterval is the interval at which the result of the DNS probe is polled
+	PollInterval time.Duration
+}
+
+func New(ec2API ec2iface.EC2API, ssmAPI ssmiface.SSMAPI, cluster *awseks.Cluster, region string) *Checker {
+	return &Checker{
+		ec2API:       ec2API,
+		ssmAPI:       ssmAPI,
+		cluster:      cluster,
+		region:       region,
+		ProbeTimeout: 2 * time.Minute,
+		PollInterval: 5 * time.Second,
+	}
+}
+
+type vpcInfo struct {
+	cidrs     []*net.IPNet
+	endpoints []*ec2.VpcEndpoint
+	nodes     []*ec2.Instance
+}
+
+// Check runs all checks, the DNS probe being run with SSM on a node of each node subnet, and returns their results
+func (c *Checker) Check(endpointServices []string) ([]Result, error) {
+	vpcConfig := c.cluster.ResourcesVpcConfig
+	if vpcConfig == nil || vpcConfig.VpcId == nil {
+		return nil, errors.New("cluster has no VPC configuration")
+	}
+
+	info, err := c.describeVPC(aws.StringValue(vpcConfig.VpcId))
+	if err != nil {
+		return nil, err
+	}
+
+	results := []Result{c.checkPrivateAccess()}
+	results = append(results, c.checkEndpoints(info, endpointServices)...)
+
+	sgResults, err := c.checkSecurityGroups(info)
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, sgResults...)
+
+	dnsResults, err := c.checkDNS(info, endpointServices)
+	if err != nil {
+		return nil, err
+	}
+	return append(results, dnsResults...), nil
+}
+
+func (c *Checker) describeVPC(vpcID string) (*vpcInfo, error) {
+	vpcs, err := c.ec2API.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{vpcID})})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing VPC %q", vpcID)
+	}
+	if len(vpcs.Vpcs) == 0 {
+		return nil, fmt.Errorf("VPC %q not found", vpcID)
+	}
+
+	info := &vpcInfo{}
+	for _, association := range vpcs.Vpcs[0].CidrBlockAssociationSet {
+		_, cidr, err := net.ParseCIDR(aws.StringValue(association.CidrBlock))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing CIDR of VPC %q", vpcID)
+		}
+		info.cidrs = append(info.cidrs, cidr)
+	}
+
+	vpcFilter := []*ec2.Filter{{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{vpcID})}}
+	if err := c.ec2API.DescribeVpcEndpointsPages(&ec2.DescribeVpcEndpointsInput{Filters: vpcFilter}, func(output *ec2.DescribeVpcEndpointsOutput, _ bool) bool {
+		info.endpoints = append(info.endpoints, output.VpcEndpoints...)
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "describing VPC endpoints of VPC %q", vpcID)
+	}
+
+	if err := c.ec2API.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: append(vpcFilter,
+			&ec2.Filter{Name: aws.String("tag-key"), Values: aws.StringSlice([]string{"kubernetes.io/cluster/" + aws.StringValue(c.cluster.Name)})},
+			&ec2.Filter{Name: aws.String("instance-state-name"), Values: aws.StringSlice([]string{ec2.InstanceStateNameRunning})},
+		),
+	}, func(output *ec2.DescribeInstancesOutput, _ bool) bool {
+		for _, reservation := range output.Reservations {
+			info.nodes = append(info.nodes, reservation.Instances...)
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "describing nodes")
+	}
+	return info, nil
+}
+
+func (c *Checker) checkPrivateAccess() Result {
+	result := Result{Check: "cluster endpoint"}
+	if aws.BoolValue(c.cluster.ResourcesVpcConfig.EndpointPrivateAccess) {
+		result.Passed = true
+		result.Message = "private access is enabled"
+	} else {
+		result.Message = "private access is disabled, nodes without internet access cannot reach the API server"
+	}
+	return result
+}
+
+func (c *Checker) checkEndpoints(info *vpcInfo, endpointServices []string) []Result {
+	var results []Result
+	for _, service := range endpointServices {
+		result := Result{Check: "VPC endpoint " + service}
+		endpoint := findEndpoint(info.endpoints, service)
+		switch {
+		case endpoint == nil:
+			result.Message = "not found"
+		case aws.StringValue(endpoint.State) != "available":
+			result.Message = fmt.Sprintf("%s is %s", aws.StringValue(endpoint.VpcEndpointId), strings.ToLower(aws.StringValue(endpoint.State)))
+		case aws.StringValue(endpoint.VpcEndpointType) == ec2.VpcEndpointTypeInterface && !aws.BoolValue(endpoint.PrivateDnsEnabled):
+			result.Message = fmt.Sprintf("private DNS is disabled for %s", aws.StringValue(endpoint.VpcEndpointId))
+		default:
+			result.Passed = true
+			result.Message = aws.StringValue(endpoint.VpcEndpointId)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func findEndpoint(endpoints []*ec2.VpcEndpoint, service string) *ec2.VpcEndpoint {
+	for _, endpoint := range endpoints {
+		if strings.HasSuffix(aws.StringValue(endpoint.ServiceName), "."+service) {
+			return endpoint
+		}
+	}
+	return nil
+}
+
+// checkSecurityGroups checks that the security groups of the interface endpoints and of the control plane permit
+// HTTPS from the VPC, from the nodes, or from themselves
+func (c *Checker) checkSecurityGroups(info *vpcInfo) ([]Result, error) {
+	nodeGroups := map[string]bool{}
+	for _, node := range info.nodes {
+		for _, sg := range node.SecurityGroups {
+			nodeGroups[aws.StringValue(sg.GroupId)] = true
+		}
+	}
+
+	targets := map[string][]string{}
+	for _, endpoint := range info.endpoints {
+		if aws.StringValue(endpoint.VpcEndpointType) != ec2.VpcEndpointTypeInterface {
+			continue
+		}
+		var groups []string
+		for _, group := range endpoint.Groups {
+			groups = append(groups, aws.StringValue(group.GroupId))
+		}
+		targets["security groups of "+aws.StringValue(endpoint.VpcEndpointId)] = groups
+	}
+	vpcConfig := c.cluster.ResourcesVpcConfig
+	controlPlaneGroups := aws.StringValueSlice(vpcConfig.SecurityGroupIds)
+	if vpcConfig.ClusterSecurityGroupId != nil {
+		controlPlaneGroups = append(controlPlaneGroups, aws.StringValue(vpcConfig.ClusterSecurityGroupId))
+	}
+	targets["security groups of the control plane"] = controlPlaneGroups
+
+	var names []string
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var results []Result
+	for _, name := range names {
+		groupIDs := targets[name]
+		result := Result{Check: name}
+		if len(groupIDs) == 0 {
+			result.Message = "no security groups"
+			results = append(results, result)
+			continue
+		}
+		output, err := c.ec2API.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{GroupIds: aws.StringSlice(groupIDs)})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing %s", name)
+		}
+		allowedSources := map[string]bool{}
+		for id := range nodeGroups {
+			allowedSources[id] = true
+		}
+		for _, id := range groupIDs {
+			allowedSources[id] = true
+		}
+		for _, sg := range output.SecurityGroups {
+			if permitsHTTPS(sg, info.cidrs, allowedSources) {
+				result.Passed = true
+				result.Message = fmt.Sprintf("%s permits port %d", aws.StringValue(sg.GroupId), httpsPort)
+				break
+			}
+		}
+		if !result.Passed {
+			result.Message = fmt.Sprintf("none of %s permits port %d from the VPC or the nodes", strings.Join(groupIDs, ", "), httpsPort)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func permitsHTTPS(sg *ec2.SecurityGroup, vpcCIDRs []*net.IPNet, allowedSources map[string]bool) bool {
+	for _, permission := range sg.IpPermissions {
+		protocol := aws.StringValue(permission.IpProtocol)
+		if protocol != "-1" && !(protocol == "tcp" && aws.Int64Value(permission.FromPort) <= httpsPort && aws.Int64Value(permission.ToPort) >= httpsPort) {
+			continue
+		}
+		for _, pair := range permission.UserIdGroupPairs {
+			if allowedSources[aws.StringValue(pair.GroupId)] {
+				return true
+			}
+		}
+		for _, ipRange := range permission.IpRanges {
+			_, cidr, err := net.ParseCIDR(aws.StringValue(ipRange.CidrIp))
+			if err != nil {
+				continue
+			}
+			for _, vpcCIDR := range vpcCIDRs {
+				if containsCIDR(cidr, vpcCIDR) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func containsCIDR(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+// checkDNS runs a probe resolving the host names of the interface endpoints and of the API server on a node of each
+// node subnet, and checks that they resolve to addresses of the VPC
+func (c *Checker) checkDNS(info *vpcInfo, endpointServices []string) ([]Result, error) {
+	if len(info.nodes) == 0 {
+		return []Result{{Check: "DNS", Message: "no running nodes found to probe DNS resolution from"}}, nil
+	}
+
+	hosts, err := c.hostNames(endpointServices)
+	if err != nil {
+		return nil, err
+	}
+
+	probed := map[string]bool{}
+	var results []Result
+	for _, node := range info.nodes {
+		subnetID := aws.StringValue(node.SubnetId)
+		if probed[subnetID] {
+			continue
+		}
+		probed[subnetID] = true
+
+		instanceID := aws.StringValue(node.InstanceId)
+		addresses, err := c.resolve(instanceID, hosts)
+		if err != nil {
+			results = append(results, Result{
+				Check:   "DNS from " + subnetID,
+				Message: fmt.Sprintf("probing DNS resolution from %s: %v", instanceID, err),
+			})
+			continue
+		}
+		for _, host := range hosts {
+			result := Result{Check: fmt.Sprintf("DNS of %s from %s", host, subnetID)}
+			address := net.ParseIP(addresses[host])
+			switch {
+			case address == nil:
+				result.Message = "does not resolve"
+			case !inCIDRs(address, info.cidrs):
+				result.Message = fmt.Sprintf("resolves to %s, outside of the VPC", address)
+			default:
+				result.Passed = true
+				result.Message = fmt.Sprintf("resolves to %s", address)
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func inCIDRs(ip net.IP, cidrs []*net.IPNet) bool {
+	for _, cidr := range cidrs {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// hostNames returns the host names of the API server and of the interface endpoint services. S3 uses a gateway
+// endpoint, its host name resolves to public addresses
+func (c *Checker) hostNames(endpointServices []string) ([]string, error) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.region)
+	if !ok {
+		return nil, fmt.Errorf("unknown region %q", c.region)
+	}
+	clusterARN, err := arn.Parse(aws.StringValue(c.cluster.Arn))
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing cluster ARN")
+	}
+
+	var hosts []string
+	if endpoint := strings.TrimPrefix(aws.StringValue(c.cluster.Endpoint), "https://"); endpoint != "" {
+		hosts = append(hosts, endpoint)
+	}
+	for _, service := range endpointServices {
+		var host string
+		switch service {
+		case api.EndpointServiceS3:
+			continue
+		case api.EndpointServiceECRAPI:
+			host = "api.ecr"
+		case api.EndpointServiceECRDKR:
+			host = clusterARN.AccountID + ".dkr.ecr"
+		default:
+			host = service
+		}
+		hosts = append(hosts, fmt.Sprintf("%s.%s.%s", host, c.region, partition.DNSSuffix()))
+	}
+	return hosts, nil
+}
+
+// resolve runs a shell script resolving the given host names on the instance, and returns their first IPv4 address
+func (c *Checker) resolve(instanceID string, hosts []string) (map[string]string, error) {
+	script := fmt.Sprintf(`for host in %s; do echo "$host $(getent ahostsv4 "$host" | awk 'NR == 1 { print $1 }')"; done`, strings.Join(hosts, " "))
+	output, err := c.ssmAPI.SendCommand(&ssm.SendCommandInput{
+		DocumentName: aws.String("AWS-RunShellScript"),
+		InstanceIds:  aws.StringSlice([]string{instanceID}),
+		Comment:      aws.String("eksctl private connectivity check"),
+		Parameters: map[string][]*string{
+			"commands": aws.StringSlice([]string{script}),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "sending command, the instance may not be managed by SSM")
+	}
+	commandID := output.Command.CommandId
+
+	logger.Info("waiting for the DNS probe to run on %s", instanceID)
+	deadline := time.Now().Add(c.ProbeTimeout)
+	for {
+		invocation, err := c.ssmAPI.GetCommandInvocation(&ssm.GetCommandInvocationInput{
+			CommandId:  commandID,
+			InstanceId: aws.String(instanceID),
+		})
+		if err != nil && !isInvocationDoesNotExist(err) {
+			return nil, errors.Wrap(err, "getting command invocation")
+		}
+		if err == nil {
+			switch aws.StringValue(invocation.Status) {
+			case ssm.CommandInvocationStatusSuccess:
+				return parseAddresses(aws.StringValue(invocation.StandardOutputContent)), nil
+			case ssm.CommandInvocationStatusPending, ssm.CommandInvocationStatusInProgress, ssm.CommandInvocationStatusDelayed:
+			default:
+				return nil, fmt.Errorf("command %s: %s", aws.StringValue(invocation.Status), aws.StringValue(invocation.StandardErrorContent))
+			}
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out after %s", c.ProbeTimeout)
+		}
+		time.Sleep(c.PollInterval)
+	}
+}
+
+func isInvocationDoesNotExist(err error) bool {
+	var notExist *ssm.InvocationDoesNotExist
+	return errors.As(err, &notExist)
+}
+
+func parseAddresses(output string) map[string]string {
+	addresses := map[string]string{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 {
+			addresses[fields[0]] = fields[1]
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,13 @@
+package connectivity_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestConnectivity(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Private Connectivity Suite")
+}
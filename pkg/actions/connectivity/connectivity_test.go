@@ -0,0 +1,181 @@
+package connectivity_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/connectivity"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Private connectivity", func() {
+	var (
+		provider  *mockprovider.MockProvider
+		cluster   *awseks.Cluster
+		endpoints []*ec2.VpcEndpoint
+		addresses map[string]string
+		checker   *connectivity.Checker
+	)
+
+	interfaceEndpoint := func(service string) *ec2.VpcEndpoint {
+		return &ec2.VpcEndpoint{
+			VpcEndpointId:     aws.String("vpce-" + service),
+			ServiceName:       aws.String("com.amazonaws.us-west-2." + service),
+			VpcEndpointType:   aws.String(ec2.VpcEndpointTypeInterface),
+			State:             aws.String("available"),
+			PrivateDnsEnabled: aws.Bool(true),
+			Groups:            []*ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-endpoints")}},
+		}
+	}
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+		cluster = &awseks.Cluster{
+			Name:     aws.String("private"),
+			Arn:      aws.String("arn:aws:eks:us-west-2:123456789012:cluster/private"),
+			Endpoint: aws.String("https://ABCDEF.gr7.us-west-2.eks.amazonaws.com"),
+			ResourcesVpcConfig: &awseks.VpcConfigResponse{
+				VpcId:                  aws.String("vpc-1"),
+				EndpointPrivateAccess:  aws.Bool(true),
+				ClusterSecurityGroupId: aws.String("sg-cluster"),
+			},
+		}
+		endpoints = []*ec2.VpcEndpoint{
+			interfaceEndpoint("ec2"),
+			interfaceEndpoint("ecr.api"),
+			interfaceEndpoint("ecr.dkr"),
+			interfaceEndpoint("sts"),
+			{
+				VpcEndpointId:   aws.String("vpce-s3"),
+				ServiceName:     aws.String("com.amazonaws.us-west-2.s3"),
+				VpcEndpointType: aws.String(ec2.VpcEndpointTypeGateway),
+				State:           aws.String("available"),
+			},
+		}
+		addresses = map[string]string{
+			"ABCDEF.gr7.us-west-2.eks.amazonaws.com":       "192.168.10.4",
+			"ec2.us-west-2.amazonaws.com":                  "192.168.10.5",
+			"api.ecr.us-west-2.amazonaws.com":              "192.168.10.6",
+			"123456789012.dkr.ecr.us-west-2.amazonaws.com": "192.168.10.7",
+			"sts.us-west-2.amazonaws.com":                  "192.168.10.8",
+		}
+
+		provider.MockEC2().On("DescribeVpcs", mock.Anything).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []*ec2.Vpc{{
+				VpcId:                   aws.String("vpc-1"),
+				CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{{CidrBlock: aws.String("192.168.0.0/16")}},
+			}},
+		}, nil)
+		provider.MockEC2().On("DescribeVpcEndpointsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeVpcEndpointsOutput, bool) bool)
+			consume(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: endpoints}, true)
+		}).Return(nil)
+		provider.MockEC2().On("DescribeInstancesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeInstancesOutput, bool) bool)
+			consume(&ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{{
+					Instances: []*ec2.Instance{
+						{InstanceId: aws.String("i-1"), SubnetId: aws.String("subnet-1"), SecurityGroups: []*ec2.GroupIdentifier{{GroupId: aws.String("sg-nodes")}}},
+						{InstanceId: aws.String("i-2"), SubnetId: aws.String("subnet-1"), SecurityGroups: []*ec2.GroupIdentifier{{GroupId: aws.String("sg-nodes")}}},
+					},
+				}},
+			}, true)
+		}).Return(nil)
+		provider.MockEC2().On("DescribeSecurityGroups", &ec2.DescribeSecurityGroupsInput{GroupIds: aws.StringSlice([]string{"sg-endpoints"})}).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []*ec2.SecurityGroup{{
+				GroupId: aws.String("sg-endpoints"),
+				IpPermissions: []*ec2.IpPermission{{
+					IpProtocol: aws.String("tcp"),
+					FromPort:   aws.Int64(443),
+					ToPort:     aws.Int64(443),
+					IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("192.168.0.0/16")}},
+				}},
+			}},
+		}, nil)
+		provider.MockEC2().On("DescribeSecurityGroups", &ec2.DescribeSecurityGroupsInput{GroupIds: aws.StringSlice([]string{"sg-cluster"})}).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []*ec2.SecurityGroup{{
+				GroupId: aws.String("sg-cluster"),
+				IpPermissions: []*ec2.IpPermission{{
+					IpProtocol:       aws.String("-1"),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-nodes")}},
+				}},
+			}},
+		}, nil)
+
+		provider.MockSSM().On("SendCommand", mock.Anything).Return(&ssm.SendCommandOutput{
+			Command: &ssm.Command{CommandId: aws.String("command-1")},
+		}, nil)
+		provider.MockSSM().On("GetCommandInvocation", mock.Anything).Return(func(*ssm.GetCommandInvocationInput) *ssm.GetCommandInvocationOutput {
+			var output string
+			for host, address := range addresses {
+				output += fmt.Sprintf("%s %s\n", host, address)
+			}
+			return &ssm.GetCommandInvocationOutput{
+				Status:                aws.String(ssm.CommandInvocationStatusSuccess),
+				StandardOutputContent: aws.String(output),
+			}
+		}, nil)
+
+		checker = connectivity.New(provider.EC2(), provider.SSM(), cluster, "us-west-2")
+		checker.PollInterval = time.Millisecond
+	})
+
+	failedChecks := func(results []connectivity.Result) []string {
+		var failed []string
+		for _, r := range results {
+			if !r.Passed {
+				failed = append(failed, r.Check+": "+r.Message)
+			}
+		}
+		return failed
+	}
+
+	It("passes when the endpoints, security groups and DNS are configured", func() {
+		results, err := checker.Check(api.RequiredEndpointServices())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failedChecks(results)).To(BeEmpty())
+
+		provider.MockSSM().AssertNumberOfCalls(GinkgoT(), "SendCommand", 1)
+	})
+
+	It("reports missing endpoints and endpoints without private DNS", func() {
+		endpoints = endpoints[1:]
+		endpoints[0].PrivateDnsEnabled = aws.Bool(false)
+
+		results, err := checker.Check(api.RequiredEndpointServices())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failedChecks(results)).To(ConsistOf(
+			"VPC endpoint ec2: not found",
+			"VPC endpoint ecr.api: private DNS is disabled for vpce-ecr.api",
+		))
+	})
+
+	It("reports host names resolving outside of the VPC", func() {
+		addresses["sts.us-west-2.amazonaws.com"] = "52.94.2.1"
+		delete(addresses, "ec2.us-west-2.amazonaws.com")
+
+		results, err := checker.Check(api.RequiredEndpointServices())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failedChecks(results)).To(ConsistOf(
+			"DNS of ec2.us-west-2.amazonaws.com from subnet-1: does not resolve",
+			"DNS of sts.us-west-2.amazonaws.com from subnet-1: resolves to 52.94.2.1, outside of the VPC",
+		))
+	})
+
+	It("reports a disabled private endpoint", func() {
+		cluster.ResourcesVpcConfig.EndpointPrivateAccess = aws.Bool(false)
+
+		results, err := checker.Check(api.RequiredEndpointServices())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failedChecks(results)).To(ConsistOf(ContainSubstring("cluster endpoint: private access is disabled")))
+	})
+})
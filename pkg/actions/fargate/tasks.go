@@ -17,8 +17,8 @@ type createFargateStackTask struct {
 
 func (t *createFargateStackTask) Describe() string { return "create fargate IAM stacK" }
 
-func makeClusterStackName(clusterName string) string {
-	return "eksctl-" + clusterName + "-fargate"
+func makeClusterStackName(cfg *api.ClusterConfig) string {
+	return cfg.StackName("eksctl-" + cfg.Metadata.Name + "-fargate")
 }
 
 func (t *createFargateStackTask) Do(errs chan error) error {
@@ -26,7 +26,7 @@ func (t *createFargateStackTask) Do(errs chan error) error {
 	if err := rs.AddAllResources(); err != nil {
 		return errors.Wrap(err, "couldn't add all resources to fargate resource set")
 	}
-	return t.stackManager.CreateStack(makeClusterStackName(t.cfg.Metadata.Name), rs, nil, nil, errs)
+	return t.stackManager.CreateStack(makeClusterStackName(t.cfg), rs, nil, nil, errs)
 }
 
 // ensureFargateRoleStackExists creates fargate IAM resources if they
@@ -0,0 +1,73 @@
+package helmrelease
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers/helm"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+// InstallerFactory creates a Helm installer for releases of the given namespace.
+type InstallerFactory func(namespace string) (providers.HelmInstaller, error)
+
+// Manager installs and upgrades the Helm releases of a cluster.
+type Manager struct {
+	ClusterConfig *api.ClusterConfig
+	NewInstaller  InstallerFactory
+}
+
+// RESTClientGetterProvider creates REST client getters for the cluster of a ClusterConfig.
+type RESTClientGetterProvider interface {
+	NewRESTClientGetter(spec *api.ClusterConfig, namespace string) (*kubernetes.RESTClientGetter, error)
+}
+
+// New creates a new Manager using Helm installers connecting to the cluster of cfg.
+func New(cfg *api.ClusterConfig, clientGetterProvider RESTClientGetterProvider) *Manager {
+	return &Manager{
+		ClusterConfig: cfg,
+		NewInstaller: func(namespace string) (providers.HelmInstaller, error) {
+			clientGetter, err := clientGetterProvider.NewRESTClientGetter(cfg, namespace)
+			if err != nil {
+				return nil, err
+			}
+			return helm.NewInstaller(helm.Options{
+				Namespace:        namespace,
+				RESTClientGetter: clientGetter,
+			})
+		},
+	}
+}
+
+// Apply installs the Helm releases that do not exist yet and upgrades the other ones.
+func (m *Manager) Apply(ctx context.Context) error {
+	for _, r := range m.ClusterConfig.HelmReleases {
+		if err := m.apply(ctx, r); err != nil {
+			return fmt.Errorf("failed to apply Helm release %s/%s: %w", r.Namespace, r.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) apply(ctx context.Context, r *api.HelmRelease) error {
+	installer, err := m.NewInstaller(r.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to create installer: %w", err)
+	}
+	if err := installer.AddRepo(r.Repository, r.Name); err != nil {
+		return fmt.Errorf("failed to add repository: %w", err)
+	}
+	logger.Info("applying Helm release %s/%s (chart %q, version %q)", r.Namespace, r.Name, r.Chart, r.Version)
+	return installer.InstallOrUpgradeChart(ctx, providers.InstallChartOpts{
+		ChartName:       fmt.Sprintf("%s/%s", r.Name, r.Chart),
+		CreateNamespace: true,
+		Namespace:       r.Namespace,
+		ReleaseName:     r.Name,
+		Values:          r.ChartValues(),
+		Version:         r.Version,
+	})
+}
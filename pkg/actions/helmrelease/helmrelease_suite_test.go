@@ -0,0 +1,13 @@
+package helmrelease_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestHelmRelease(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Helm Release Suite")
+}
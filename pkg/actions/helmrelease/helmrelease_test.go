@@ -0,0 +1,102 @@
+package helmrelease_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/helmrelease"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers/fakes"
+)
+
+var _ = Describe("Apply", func() {
+	var (
+		cfg           *api.ClusterConfig
+		fakeInstaller *fakes.FakeHelmInstaller
+		namespaces    []string
+		manager       *helmrelease.Manager
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.HelmReleases = []*api.HelmRelease{
+			{
+				Name:           "external-dns",
+				Chart:          "external-dns",
+				Repository:     "https://kubernetes-sigs.github.io/external-dns/",
+				Version:        "1.7.1",
+				Namespace:      "kube-system",
+				Values:         api.InlineDocument{"policy": "sync"},
+				ServiceAccount: "external-dns",
+			},
+			{
+				Name:       "podinfo",
+				Chart:      "podinfo",
+				Repository: "https://stefanprodan.github.io/podinfo",
+				Version:    "6.0.3",
+				Namespace:  "default",
+			},
+		}
+		fakeInstaller = &fakes.FakeHelmInstaller{}
+		namespaces = nil
+		manager = &helmrelease.Manager{
+			ClusterConfig: cfg,
+			NewInstaller: func(namespace string) (providers.HelmInstaller, error) {
+				namespaces = append(namespaces, namespace)
+				return fakeInstaller, nil
+			},
+		}
+	})
+
+	It("installs or upgrades every release", func() {
+		Expect(manager.Apply(context.Background())).To(Succeed())
+		Expect(namespaces).To(Equal([]string{"kube-system", "default"}))
+
+		Expect(fakeInstaller.AddRepoCallCount()).To(Equal(2))
+		repoURL, name := fakeInstaller.AddRepoArgsForCall(0)
+		Expect(repoURL).To(Equal("https://kubernetes-sigs.github.io/external-dns/"))
+		Expect(name).To(Equal("external-dns"))
+
+		Expect(fakeInstaller.InstallOrUpgradeChartCallCount()).To(Equal(2))
+		_, opts := fakeInstaller.InstallOrUpgradeChartArgsForCall(0)
+		Expect(opts).To(Equal(providers.InstallChartOpts{
+			ChartName:       "external-dns/external-dns",
+			CreateNamespace: true,
+			Namespace:       "kube-system",
+			ReleaseName:     "external-dns",
+			Values: map[string]interface{}{
+				"policy": "sync",
+				"serviceAccount": map[string]interface{}{
+					"create": false,
+					"name":   "external-dns",
+				},
+			},
+			Version: "1.7.1",
+		}))
+		_, opts = fakeInstaller.InstallOrUpgradeChartArgsForCall(1)
+		Expect(opts.ChartName).To(Equal("podinfo/podinfo"))
+		Expect(opts.Values).To(BeEmpty())
+	})
+
+	When("a release fails", func() {
+		It("returns an error and stops", func() {
+			fakeInstaller.InstallOrUpgradeChartReturns(errors.New("nope"))
+			err := manager.Apply(context.Background())
+			Expect(err).To(MatchError("failed to apply Helm release kube-system/external-dns: nope"))
+			Expect(fakeInstaller.InstallOrUpgradeChartCallCount()).To(Equal(1))
+		})
+	})
+
+	When("the repository cannot be added", func() {
+		It("returns an error", func() {
+			fakeInstaller.AddRepoReturns(errors.New("nope"))
+			err := manager.Apply(context.Background())
+			Expect(err).To(MatchError("failed to apply Helm release kube-system/external-dns: failed to add repository: nope"))
+			Expect(fakeInstaller.InstallOrUpgradeChartCallCount()).To(Equal(0))
+		})
+	})
+})
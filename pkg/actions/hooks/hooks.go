@@ -0,0 +1,89 @@
+package hooks
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kris-nova/logger"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+)
+
+// Runner runs the lifecycle hooks of a cluster.
+type Runner struct {
+	ClusterConfig *api.ClusterConfig
+	Kubeconfig    *clientcmdapi.Config
+	NewExecutor   func(envVars executor.EnvVars) executor.Executor
+}
+
+// New creates a Runner for the cluster.
+func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider) *Runner {
+	return &Runner{
+		ClusterConfig: cfg,
+		Kubeconfig:    kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), "", ctl.Provider.Profile()),
+		NewExecutor:   executor.NewShellExecutor,
+	}
+}
+
+// RunPostCreate runs the postCreate hooks.
+func (r *Runner) RunPostCreate() error {
+	if r.ClusterConfig.Hooks == nil {
+		return nil
+	}
+	return r.run("postCreate", r.ClusterConfig.Hooks.PostCreate)
+}
+
+// RunPreDelete runs the preDelete hooks.
+func (r *Runner) RunPreDelete() error {
+	if r.ClusterConfig.Hooks == nil {
+		return nil
+	}
+	return r.run("preDelete", r.ClusterConfig.Hooks.PreDelete)
+}
+
+func (r *Runner) run(hook string, commands []string) error {
+	if len(commands) == 0 {
+		return nil
+	}
+
+	kubeconfigFile, err := os.CreateTemp("", r.ClusterConfig.Metadata.Name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(kubeconfigFile.Name()); err != nil {
+			logger.Critical("failed to remove temporary kubeconfig %s", kubeconfigFile.Name())
+		}
+	}()
+	logger.Debug("writing temporary kubeconfig to %s", kubeconfigFile.Name())
+	if _, err := kubeconfig.Write(kubeconfigFile.Name(), *r.Kubeconfig, true); err != nil {
+		return err
+	}
+
+	shell := r.NewExecutor(r.envVars(kubeconfigFile.Name()))
+	for i, command := range commands {
+		logger.Info("running %s hook %d: %s", hook, i, command)
+		if err := shell.Exec("sh", "-c", command); err != nil {
+			return fmt.Errorf("%s hook %d failed: %w", hook, i, err)
+		}
+	}
+	return nil
+}
+
+func (r *Runner) envVars(kubeconfigPath string) executor.EnvVars {
+	meta := r.ClusterConfig.Metadata
+	envVars := executor.EnvVars{
+		"KUBECONFIG":             kubeconfigPath,
+		"EKSCTL_CLUSTER_NAME":    meta.Name,
+		"EKSCTL_CLUSTER_REGION":  meta.Region,
+		"EKSCTL_CLUSTER_VERSION": meta.Version,
+	}
+	if r.ClusterConfig.Status != nil {
+		envVars["EKSCTL_CLUSTER_ENDPOINT"] = r.ClusterConfig.Status.Endpoint
+	}
+	return envVars
+}
@@ -0,0 +1,89 @@
+package hooks_test
+
+import (
+	"errors"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/weaveworks/eksctl/pkg/actions/hooks"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/executor/fakes"
+)
+
+var _ = Describe("Runner", func() {
+	var (
+		cfg          *api.ClusterConfig
+		fakeExecutor *fakes.FakeExecutor
+		envVars      executor.EnvVars
+		runner       *hooks.Runner
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Version = "1.21"
+		cfg.Status = &api.ClusterStatus{Endpoint: "https://example.com"}
+		cfg.Hooks = &api.Hooks{
+			PostCreate: []string{"./register.sh", "kubectl get nodes"},
+			PreDelete:  []string{"./deregister.sh"},
+		}
+		fakeExecutor = &fakes.FakeExecutor{}
+		runner = &hooks.Runner{
+			ClusterConfig: cfg,
+			Kubeconfig:    clientcmdapi.NewConfig(),
+			NewExecutor: func(e executor.EnvVars) executor.Executor {
+				envVars = e
+				return fakeExecutor
+			},
+		}
+	})
+
+	It("runs the postCreate hooks in order with the cluster metadata", func() {
+		var kubeconfigExisted bool
+		fakeExecutor.ExecStub = func(string, ...string) error {
+			_, err := os.Stat(envVars["KUBECONFIG"])
+			kubeconfigExisted = err == nil
+			return nil
+		}
+
+		Expect(runner.RunPostCreate()).To(Succeed())
+		Expect(fakeExecutor.ExecCallCount()).To(Equal(2))
+		command, args := fakeExecutor.ExecArgsForCall(0)
+		Expect(command).To(Equal("sh"))
+		Expect(args).To(Equal([]string{"-c", "./register.sh"}))
+		_, args = fakeExecutor.ExecArgsForCall(1)
+		Expect(args).To(Equal([]string{"-c", "kubectl get nodes"}))
+
+		Expect(envVars).To(HaveKeyWithValue("EKSCTL_CLUSTER_NAME", "cluster-1"))
+		Expect(envVars).To(HaveKeyWithValue("EKSCTL_CLUSTER_REGION", "us-west-2"))
+		Expect(envVars).To(HaveKeyWithValue("EKSCTL_CLUSTER_VERSION", "1.21"))
+		Expect(envVars).To(HaveKeyWithValue("EKSCTL_CLUSTER_ENDPOINT", "https://example.com"))
+		Expect(kubeconfigExisted).To(BeTrue())
+		Expect(envVars["KUBECONFIG"]).NotTo(BeAnExistingFile())
+	})
+
+	It("stops at the first failing hook", func() {
+		fakeExecutor.ExecReturns(errors.New("exit status 1"))
+		Expect(runner.RunPostCreate()).To(MatchError("postCreate hook 0 failed: exit status 1"))
+		Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+	})
+
+	It("runs the preDelete hooks", func() {
+		Expect(runner.RunPreDelete()).To(Succeed())
+		Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+		_, args := fakeExecutor.ExecArgsForCall(0)
+		Expect(args).To(Equal([]string{"-c", "./deregister.sh"}))
+	})
+
+	It("does nothing without hooks", func() {
+		cfg.Hooks = nil
+		Expect(runner.RunPostCreate()).To(Succeed())
+		Expect(runner.RunPreDelete()).To(Succeed())
+		Expect(fakeExecutor.ExecCallCount()).To(Equal(0))
+	})
+})
@@ -0,0 +1,318 @@
+package iampreflight
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Operation is an eksctl operation whose permissions can be checked
+type Operation string
+
+// Operations whose permissions can be checked
+const (
+	OperationCreateCluster   Operation = "create cluster"
+	OperationCreateNodeGroup Operation = "create nodegroup"
+	OperationDeleteCluster   Operation = "delete cluster"
+)
+
+// stackActions are the actions used to manage the CloudFormation stacks of any operation
+var stackActions = []string{
+	"cloudformation:CreateStack",
+	"cloudformation:DescribeStacks",
+	"cloudformation:DescribeStackEvents",
+	"cloudformation:DescribeStackResources",
+	"cloudformation:ListStacks",
+}
+
+// stackResourceActions are the actions CloudFormation performs with the permissions of the caller to create the
+// resources common to all stacks, unless a service role is used
+var stackResourceActions = []string{
+	"ec2:AuthorizeSecurityGroupEgress",
+	"ec2:AuthorizeSecurityGroupIngress",
+	"ec2:CreateSecurityGroup",
+	"ec2:CreateTags",
+	"ec2:DescribeSecurityGroups",
+	"iam:AttachRolePolicy",
+	"iam:CreateRole",
+	"iam:GetRole",
+	"iam:PassRole",
+	"iam:PutRolePolicy",
+	"iam:TagRole",
+}
+
+var vpcActions = []string{
+	"ec2:AllocateAddress",
+	"ec2:AssociateRouteTable",
+	"ec2:AttachInternetGateway",
+	"ec2:CreateInternetGateway",
+	"ec2:CreateRoute",
+	"ec2:CreateRouteTable",
+	"ec2:CreateSubnet",
+	"ec2:CreateVpc",
+	"ec2:ModifySubnetAttribute",
+	"ec2:ModifyVpcAttribute",
+}
+
+var unmanagedNodeGroupActions = []string{
+	"autoscaling:CreateAutoScalingGroup",
+	"autoscaling:DescribeAutoScalingGroups",
+	"ec2:CreateLaunchTemplate",
+	"ec2:RunInstances",
+	"iam:AddRoleToInstanceProfile",
+	"iam:CreateInstanceProfile",
+}
+
+var managedNodeGroupActions = []string{
+	"ec2:CreateLaunchTemplate",
+	"eks:CreateNodegroup",
+	"eks:DescribeNodegroup",
+}
+
+var deleteClusterActions = []string{
+	"cloudformation:DeleteStack",
+	"cloudformation:DescribeStacks",
+	"cloudformation:ListStacks",
+	"ec2:DeleteNetworkInterface",
+	"ec2:DescribeNetworkInterfaces",
+	"eks:DeleteCluster",
+	"eks:DeleteFargateProfile",
+	"eks:DescribeCluster",
+	"eks:ListFargateProfiles",
+	"eks:ListNodegroups",
+	"elasticloadbalancing:DeleteLoadBalancer",
+	"elasticloadbalancing:DescribeLoadBalancers",
+	"iam:DeleteOpenIDConnectProvider",
+	"iam:GetOpenIDConnectProvider",
+}
+
+// stackDeletionResourceActions are the actions CloudFormation performs with the permissions of the caller to delete
+// the resources of the stacks of a cluster, unless a service role is used
+var stackDeletionResourceActions = []string{
+	"autoscaling:DeleteAutoScalingGroup",
+	"autoscaling:UpdateAutoScalingGroup",
+	"ec2:DeleteInternetGateway",
+	"ec2:DeleteLaunchTemplate",
+	"ec2:DeleteNatGateway",
+	"ec2:DeleteRoute",
+	"ec2:DeleteRouteTable",
+	"ec2:DeleteSecurityGroup",
+	"ec2:DeleteSubnet",
+	"ec2:DeleteVpc",
+	"ec2:DetachInternetGateway",
+	"ec2:DisassociateRouteTable",
+	"ec2:ReleaseAddress",
+	"ec2:RevokeSecurityGroupEgress",
+	"ec2:RevokeSecurityGroupIngress",
+	"eks:DeleteNodegroup",
+	"iam:DeleteInstanceProfile",
+	"iam:DeleteRole",
+	"iam:DeleteRolePolicy",
+	"iam:DetachRolePolicy",
+	"iam:RemoveRoleFromInstanceProfile",
+}
+
+// Actions returns the IAM actions of the API calls planned for operation on the cluster described by cfg. Actions
+// performed by CloudFormation to create the resources of stacks are omitted when stacks are created with a service
+// role, as cloudFormationRoleARN is then the principal performing them
+func Actions(operation Operation, cfg *api.ClusterConfig, cloudFormationRoleARN string) []string {
+	actions := sets.NewString()
+	addStackActions := func() {
+		actions.Insert(stackActions...)
+		if cloudFormationRoleARN != "" {
+			actions.Insert("iam:PassRole")
+			return
+		}
+		actions.Insert(stackResourceActions...)
+		if len(cfg.NodeGroups) > 0 {
+			actions.Insert(unmanagedNodeGroupActions...)
+		}
+		if len(cfg.ManagedNodeGroups) > 0 {
+			actions.Insert(managedNodeGroupActions...)
+		}
+	}
+
+	switch operation {
+	case OperationCreateCluster:
+		addStackActions()
+		actions.Insert("eks:DescribeCluster", "ec2:DescribeAvailabilityZones", "ec2:DescribeSubnets", "ec2:DescribeVpcs")
+		if cloudFormationRoleARN == "" {
+			actions.Insert("eks:CreateCluster", "eks:TagResource")
+			if cfg.VPC == nil || cfg.VPC.ID == "" {
+				actions.Insert(vpcActions...)
+				if cfg.VPC == nil || cfg.VPC.NAT == nil || cfg.VPC.NAT.Gateway == nil || *cfg.VPC.NAT.Gateway != api.ClusterDisableNAT {
+					actions.Insert("ec2:CreateNatGateway")
+				}
+			}
+		}
+		if cfg.IAM != nil && api.IsEnabled(cfg.IAM.WithOIDC) {
+			actions.Insert("iam:CreateOpenIDConnectProvider", "iam:GetOpenIDConnectProvider")
+		}
+		if len(cfg.FargateProfiles) > 0 {
+			actions.Insert("eks:CreateFargateProfile", "eks:DescribeFargateProfile")
+		}
+		if len(cfg.Addons) > 0 {
+			actions.Insert("eks:CreateAddon", "eks:DescribeAddon")
+		}
+		if cfg.SecretsEncryption != nil && cfg.SecretsEncryption.KeyARN != "" {
+			actions.Insert("kms:CreateGrant", "kms:DescribeKey")
+		}
+		if cfg.HasClusterCloudWatchLogging() {
+			actions.Insert("eks:UpdateClusterConfig")
+		}
+	case OperationCreateNodeGroup:
+		addStackActions()
+		actions.Insert("eks:DescribeCluster", "ec2:DescribeSubnets")
+	case OperationDeleteCluster:
+		actions.Insert(deleteClusterActions...)
+		if cloudFormationRoleARN == "" {
+			actions.Insert(stackDeletionResourceActions...)
+		}
+	}
+	return actions.List()
+}
+
+// Checker checks that the caller has the permissions required by operations
+type Checker struct {
+	provider api.ClusterProvider
+}
+
+// New creates a new Checker
+func New(provider api.ClusterProvider) *Checker {
+	return &Checker{
+		provider: provider,
+	}
+}
+
+// Check simulates the policies of the caller against the actions of operation, and returns an error listing the
+// actions the caller is not allowed to perform. Service control policies of organizations are not evaluated
+func (c *Checker) Check(operation Operation, cfg *api.ClusterConfig) error {
+	principalARN, err := c.principalARN()
+	if err != nil {
+		return err
+	}
+	if principalARN == "" {
+		return nil
+	}
+
+	actions := Actions(operation, cfg, c.provider.CloudFormationRoleARN())
+	logger.Info("checking that %q is allowed the %d IAM actions required to %s %q", principalARN, len(actions), operation, cfg.Metadata.Name)
+	parsed, err := arn.Parse(principalARN)
+	if err != nil {
+		return errors.Wrapf(err, "parsing the principal ARN %q", principalARN)
+	}
+	actionsByResource := map[string][]string{}
+	for _, action := range actions {
+		resource := resourceARN(action, parsed, c.provider.Region(), cfg.Metadata.Name)
+		actionsByResource[resource] = append(actionsByResource[resource], action)
+	}
+	var denied []string
+	for resource, actions := range actionsByResource {
+		resourceDenied, err := c.simulate(principalARN, actions, resource)
+		if err != nil {
+			return err
+		}
+		denied = append(denied, resourceDenied...)
+	}
+	sort.Strings(denied)
+	if len(denied) == 0 {
+		logger.Success("%q is allowed all IAM actions required to %s %q", principalARN, operation, cfg.Metadata.Name)
+		return nil
+	}
+	return fmt.Errorf("%q is missing %d IAM permission(s) required to %s %q: %s", principalARN, len(denied), operation, cfg.Metadata.Name, strings.Join(denied, ", "))
+}
+
+// principalARN returns the ARN of the IAM user or role of the caller, which can be simulated, or an empty string for
+// the root user, which is allowed all actions
+func (c *Checker) principalARN() (string, error) {
+	identity, err := c.provider.STSV2().GetCallerIdentity(context.TODO(), &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "getting the caller identity")
+	}
+	callerARN := aws.StringValue(identity.Arn)
+	parsed, err := arn.Parse(callerARN)
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing the caller ARN %q", callerARN)
+	}
+
+	switch resource := parsed.Resource; {
+	case resource == "root":
+		logger.Info("skipping IAM permissions check for the root user %q", callerARN)
+		return "", nil
+	case strings.HasPrefix(resource, "user/"):
+		return callerARN, nil
+	case strings.HasPrefix(resource, "assumed-role/"):
+		// the session ARN does not include the path of the role
+		parts := strings.Split(resource, "/")
+		output, err := c.provider.IAM().GetRole(&iam.GetRoleInput{RoleName: aws.String(parts[1])})
+		if err != nil {
+			return "", errors.Wrapf(err, "getting role %q of the caller", parts[1])
+		}
+		return aws.StringValue(output.Role.Arn), nil
+	default:
+		return "", fmt.Errorf("unable to check IAM permissions of %q, only IAM users and roles are supported", callerARN)
+	}
+}
+
+// resourceARN returns the ARN of a resource with the name eksctl gives the resources action is performed on, so that
+// policies only allowing actions on the resources of eksctl, e.g. on roles named eksctl-*, are simulated like when
+// the operation runs, or "*" for actions whose resources are not scoped by name
+func resourceARN(action string, principal arn.ARN, region, clusterName string) string {
+	if !strings.HasPrefix(action, "iam:") {
+		return "*"
+	}
+	var resource string
+	switch {
+	case strings.Contains(action, "OpenIDConnectProvider"):
+		resource = fmt.Sprintf("oidc-provider/oidc.eks.%s.amazonaws.com", region)
+	case strings.Contains(action, "InstanceProfile"):
+		resource = fmt.Sprintf("instance-profile/eksctl-%s-nodegroup-NodeInstanceProfile", clusterName)
+	case strings.Contains(action, "Role"):
+		resource = fmt.Sprintf("role/eksctl-%s-cluster-ServiceRole", clusterName)
+	default:
+		return "*"
+	}
+	return arn.ARN{
+		Partition: principal.Partition,
+		Service:   "iam",
+		AccountID: principal.AccountID,
+		Resource:  resource,
+	}.String()
+}
+
+func (c *Checker) simulate(principalARN string, actions []string, resource string) ([]string, error) {
+	var denied []string
+	input := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalARN),
+		ActionNames:     aws.StringSlice(actions),
+	}
+	if resource != "*" {
+		input.ResourceArns = aws.StringSlice([]string{resource})
+	}
+	for {
+		output, err := c.provider.IAM().SimulatePrincipalPolicy(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "simulating the policies of %q, which requires iam:SimulatePrincipalPolicy", principalARN)
+		}
+		for _, result := range output.EvaluationResults {
+			if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, fmt.Sprintf("%s (%s)", aws.StringValue(result.EvalActionName), aws.StringValue(result.EvalDecision)))
+			}
+		}
+		if !aws.BoolValue(output.IsTruncated) {
+			return denied, nil
+		}
+		input.Marker = output.Marker
+	}
+}
@@ -0,0 +1,13 @@
+package iampreflight_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestIAMPreflight(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IAM Preflight Suite")
+}
@@ -0,0 +1,153 @@
+package iampreflight_test
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/iampreflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("IAM preflight", func() {
+	var (
+		cfg      *api.ClusterConfig
+		provider *mockprovider.MockProvider
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		provider = mockprovider.NewMockProvider()
+	})
+
+	Describe("Actions", func() {
+		It("includes the actions to create the VPC and nodegroups of a new cluster", func() {
+			cfg.NodeGroups = []*api.NodeGroup{api.NewNodeGroup()}
+			cfg.IAM.WithOIDC = api.Enabled()
+
+			actions := iampreflight.Actions(iampreflight.OperationCreateCluster, cfg, "")
+			Expect(actions).To(ContainElements("cloudformation:CreateStack", "eks:CreateCluster", "ec2:CreateVpc", "ec2:CreateNatGateway",
+				"autoscaling:CreateAutoScalingGroup", "iam:CreateOpenIDConnectProvider"))
+			Expect(actions).NotTo(ContainElement("eks:CreateNodegroup"))
+		})
+
+		It("omits the actions to create a VPC when using an existing one", func() {
+			cfg.VPC.ID = "vpc-1234"
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{api.NewManagedNodeGroup()}
+
+			actions := iampreflight.Actions(iampreflight.OperationCreateCluster, cfg, "")
+			Expect(actions).To(ContainElement("eks:CreateNodegroup"))
+			Expect(actions).NotTo(ContainElements("ec2:CreateVpc", "ec2:CreateNatGateway"))
+		})
+
+		It("omits the actions performed by CloudFormation when using a service role", func() {
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{api.NewManagedNodeGroup()}
+
+			actions := iampreflight.Actions(iampreflight.OperationCreateNodeGroup, cfg, "arn:aws:iam::123456789012:role/cfn")
+			Expect(actions).To(ContainElements("cloudformation:CreateStack", "iam:PassRole"))
+			Expect(actions).NotTo(ContainElements("eks:CreateNodegroup", "iam:CreateRole"))
+		})
+
+		It("includes the deletions CloudFormation performs with the permissions of the caller", func() {
+			actions := iampreflight.Actions(iampreflight.OperationDeleteCluster, cfg, "")
+			Expect(actions).To(ContainElements("cloudformation:DeleteStack", "ec2:DeleteSecurityGroup", "ec2:DeleteVpc", "iam:DeleteRole"))
+
+			actions = iampreflight.Actions(iampreflight.OperationDeleteCluster, cfg, "arn:aws:iam::123456789012:role/cfn")
+			Expect(actions).To(ContainElement("cloudformation:DeleteStack"))
+			Expect(actions).NotTo(ContainElements("ec2:DeleteVpc", "iam:DeleteRole"))
+		})
+	})
+
+	Describe("Check", func() {
+		mockSimulation := func(principalARN string, denied ...string) {
+			provider.MockIAM().On("SimulatePrincipalPolicy", mock.MatchedBy(func(input *iam.SimulatePrincipalPolicyInput) bool {
+				return aws.StringValue(input.PolicySourceArn) == principalARN
+			})).Return(func(input *iam.SimulatePrincipalPolicyInput) *iam.SimulatePolicyResponse {
+				isDenied := map[string]bool{}
+				for _, action := range denied {
+					isDenied[action] = true
+				}
+				output := &iam.SimulatePolicyResponse{IsTruncated: aws.Bool(false)}
+				for _, action := range aws.StringValueSlice(input.ActionNames) {
+					decision := iam.PolicyEvaluationDecisionTypeAllowed
+					if isDenied[action] {
+						decision = iam.PolicyEvaluationDecisionTypeImplicitDeny
+					}
+					output.EvaluationResults = append(output.EvaluationResults, &iam.EvaluationResult{
+						EvalActionName: aws.String(action),
+						EvalDecision:   aws.String(decision),
+					})
+				}
+				return output
+			}, nil)
+		}
+
+		mockCaller := func(callerARN string) {
+			provider.MockSTSV2().On("GetCallerIdentity", mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{Arn: aws.String(callerARN)}, nil)
+		}
+
+		It("succeeds when the user is allowed all actions", func() {
+			mockCaller("arn:aws:iam::123456789012:user/admin")
+			mockSimulation("arn:aws:iam::123456789012:user/admin")
+
+			Expect(iampreflight.New(provider).Check(iampreflight.OperationDeleteCluster, cfg)).To(Succeed())
+		})
+
+		It("lists the actions the role of the caller is not allowed", func() {
+			mockCaller("arn:aws:sts::123456789012:assumed-role/deployer/session")
+			provider.MockIAM().On("GetRole", &iam.GetRoleInput{RoleName: aws.String("deployer")}).Return(&iam.GetRoleOutput{
+				Role: &iam.Role{Arn: aws.String("arn:aws:iam::123456789012:role/ci/deployer")},
+			}, nil)
+			mockSimulation("arn:aws:iam::123456789012:role/ci/deployer", "eks:DeleteCluster", "iam:DeleteOpenIDConnectProvider")
+
+			err := iampreflight.New(provider).Check(iampreflight.OperationDeleteCluster, cfg)
+			Expect(err).To(MatchError(`"arn:aws:iam::123456789012:role/ci/deployer" is missing 2 IAM permission(s) required to delete cluster "my-cluster": ` +
+				"eks:DeleteCluster (implicitDeny), iam:DeleteOpenIDConnectProvider (implicitDeny)"))
+		})
+
+		It("simulates IAM actions against the resources eksctl creates", func() {
+			mockCaller("arn:aws:iam::123456789012:user/deployer")
+			var resources [][]string
+			provider.MockIAM().On("SimulatePrincipalPolicy", mock.Anything).Run(func(args mock.Arguments) {
+				input := args[0].(*iam.SimulatePrincipalPolicyInput)
+				resources = append(resources, aws.StringValueSlice(input.ResourceArns))
+				for _, action := range aws.StringValueSlice(input.ActionNames) {
+					switch {
+					case strings.HasPrefix(action, "iam:") && strings.Contains(action, "Role") && !strings.Contains(action, "InstanceProfile"):
+						Expect(input.ResourceArns).To(Equal(aws.StringSlice([]string{"arn:aws:iam::123456789012:role/eksctl-my-cluster-cluster-ServiceRole"})))
+					case strings.HasPrefix(action, "iam:") && strings.Contains(action, "InstanceProfile"):
+						Expect(input.ResourceArns).To(Equal(aws.StringSlice([]string{"arn:aws:iam::123456789012:instance-profile/eksctl-my-cluster-nodegroup-NodeInstanceProfile"})))
+					case strings.HasPrefix(action, "iam:"):
+						Expect(input.ResourceArns).To(Equal(aws.StringSlice([]string{"arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com"})))
+					default:
+						Expect(input.ResourceArns).To(BeEmpty())
+					}
+				}
+			}).Return(&iam.SimulatePolicyResponse{IsTruncated: aws.Bool(false)}, nil)
+
+			Expect(iampreflight.New(provider).Check(iampreflight.OperationDeleteCluster, cfg)).To(Succeed())
+			Expect(resources).To(HaveLen(4))
+		})
+
+		It("skips the root user", func() {
+			mockCaller("arn:aws:iam::123456789012:root")
+
+			Expect(iampreflight.New(provider).Check(iampreflight.OperationCreateCluster, cfg)).To(Succeed())
+			provider.MockIAM().AssertNotCalled(GinkgoT(), "SimulatePrincipalPolicy", mock.Anything)
+		})
+
+		It("fails for federated users", func() {
+			mockCaller("arn:aws:sts::123456789012:federated-user/bob")
+
+			err := iampreflight.New(provider).Check(iampreflight.OperationCreateCluster, cfg)
+			Expect(err).To(MatchError(ContainSubstring("only IAM users and roles are supported")))
+		})
+	})
+})
@@ -32,19 +32,16 @@ func (m *Manager) Get(options GetIdentityProvidersOptions) ([]Summary, error) {
 	summaries := []Summary{}
 	var configs []*eks.IdentityProviderConfig
 
-	input := eks.ListIdentityProviderConfigsInput{
-		ClusterName: aws.String(m.metadata.Name),
-	}
-	list, err := m.eksAPI.ListIdentityProviderConfigs(&input)
+	list, err := m.listIdentityProviderConfigs()
 	if err != nil {
 		return summaries, err
 	}
 
 	if options.Name == "" {
-		configs = list.IdentityProviderConfigs
+		configs = list
 	} else {
 		var getCfg *eks.IdentityProviderConfig
-		for _, cfg := range list.IdentityProviderConfigs {
+		for _, cfg := range list {
 			if aws.StringValue(cfg.Name) == options.Name {
 				getCfg = &eks.IdentityProviderConfig{
 					Name: aws.String(options.Name),
@@ -85,3 +82,17 @@ func (m *Manager) Get(options GetIdentityProvidersOptions) ([]Summary, error) {
 	}
 	return summaries, nil
 }
+
+// listIdentityProviderConfigs returns all identity provider configs of the cluster, across all pages of results
+func (m *Manager) listIdentityProviderConfigs() ([]*eks.IdentityProviderConfig, error) {
+	var configs []*eks.IdentityProviderConfig
+	if err := m.eksAPI.ListIdentityProviderConfigsPages(&eks.ListIdentityProviderConfigsInput{
+		ClusterName: aws.String(m.metadata.Name),
+	}, func(list *eks.ListIdentityProviderConfigsOutput, _ bool) bool {
+		configs = append(configs, list.IdentityProviderConfigs...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
@@ -1,11 +1,18 @@
 package irsa
 
 import (
+	"github.com/pkg/errors"
+
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 )
 
 func (a *Manager) CreateIAMServiceAccount(iamServiceAccounts []*api.ClusterIAMServiceAccount, plan bool) error {
+	if !plan {
+		if err := a.oidcManager.EnsureClientIDs(); err != nil {
+			return err
+		}
+	}
 	taskTree := a.stackManager.NewTasksToCreateIAMServiceAccounts(iamServiceAccounts, a.oidcManager, kubernetes.NewCachedClientSet(a.clientSet))
 	taskTree.PlanMode = plan
 
@@ -15,3 +22,49 @@ func (a *Manager) CreateIAMServiceAccount(iamServiceAccounts []*api.ClusterIAMSe
 
 	return err
 }
+
+// UpdateExistingIAMServiceAccounts converges the given iamserviceaccounts whose stack exists: the policies of their
+// roles are updated, and their serviceaccounts are created if they are missing from Kubernetes. The other
+// iamserviceaccounts are left for CreateIAMServiceAccount
+func (a *Manager) UpdateExistingIAMServiceAccounts(iamServiceAccounts []*api.ClusterIAMServiceAccount, plan bool) error {
+	existing, err := a.stackManager.GetIAMServiceAccounts()
+	if err != nil {
+		return errors.Wrap(err, "getting existing iamserviceaccounts")
+	}
+	roleARNs := map[string]string{}
+	for _, sa := range existing {
+		if sa.Status != nil && sa.Status.RoleARN != nil {
+			roleARNs[sa.NameString()] = *sa.Status.RoleARN
+		}
+	}
+
+	var toUpdate []*api.ClusterIAMServiceAccount
+	for _, sa := range iamServiceAccounts {
+		if _, ok := roleARNs[sa.NameString()]; ok {
+			toUpdate = append(toUpdate, sa)
+		}
+	}
+	if len(toUpdate) == 0 {
+		return nil
+	}
+
+	if err := a.UpdateIAMServiceAccounts(toUpdate, plan); err != nil {
+		return err
+	}
+	if plan {
+		return nil
+	}
+
+	for _, sa := range toUpdate {
+		if api.IsEnabled(sa.RoleOnly) {
+			continue
+		}
+		roleARN := roleARNs[sa.NameString()]
+		sa.Status = &api.ClusterIAMServiceAccountStatus{RoleARN: &roleARN}
+		sa.SetAnnotations()
+		if err := kubernetes.MaybeCreateServiceAccountOrUpdateMetadata(a.clientSet, sa.AsObjectMeta()); err != nil {
+			return errors.Wrapf(err, "failed to create service account %s", sa.NameString())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package irsa_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+)
+
+var _ = Describe("UpdateExistingIAMServiceAccounts", func() {
+	var (
+		irsaManager      *irsa.Manager
+		fakeStackManager *fakes.FakeStackManager
+		clientSet        *fake.Clientset
+		existingSA       *api.ClusterIAMServiceAccount
+		missingSA        *api.ClusterIAMServiceAccount
+	)
+
+	BeforeEach(func() {
+		existingSA = &api.ClusterIAMServiceAccount{
+			ClusterIAMMeta:   api.ClusterIAMMeta{Name: "existing-sa", Namespace: "default"},
+			AttachPolicyARNs: []string{"arn-123"},
+		}
+		missingSA = &api.ClusterIAMServiceAccount{
+			ClusterIAMMeta:   api.ClusterIAMMeta{Name: "missing-sa", Namespace: "default"},
+			AttachPolicyARNs: []string{"arn-123"},
+		}
+
+		fakeStackManager = new(fakes.FakeStackManager)
+		roleARN := "arn:aws:iam::456123987123:role/existing-sa"
+		fakeStackManager.GetIAMServiceAccountsReturns([]*api.ClusterIAMServiceAccount{
+			{
+				ClusterIAMMeta: api.ClusterIAMMeta{Name: "existing-sa", Namespace: "default"},
+				Status:         &api.ClusterIAMServiceAccountStatus{RoleARN: &roleARN},
+			},
+		}, nil)
+		fakeStackManager.ListStacksMatchingReturns([]*cloudformation.Stack{
+			{StackName: aws.String("eksctl-my-cluster-addon-iamserviceaccount-default-existing-sa")},
+		}, nil)
+
+		oidc, err := iamoidc.NewOpenIDConnectManager(nil, "456123987123", "https://oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E", "aws", nil)
+		Expect(err).NotTo(HaveOccurred())
+		oidc.ProviderARN = "arn:aws:iam::456123987123:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E"
+		clientSet = fake.NewSimpleClientset()
+		irsaManager = irsa.New("my-cluster", fakeStackManager, oidc, clientSet)
+	})
+
+	It("updates the roles of the existing iamserviceaccounts and recreates their serviceaccounts", func() {
+		Expect(irsaManager.UpdateExistingIAMServiceAccounts([]*api.ClusterIAMServiceAccount{existingSA, missingSA}, false)).To(Succeed())
+
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+		Expect(fakeStackManager.UpdateStackArgsForCall(0).StackName).To(Equal("eksctl-my-cluster-addon-iamserviceaccount-default-existing-sa"))
+
+		sa, err := clientSet.CoreV1().ServiceAccounts("default").Get(context.TODO(), "existing-sa", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sa.Annotations).To(HaveKeyWithValue(api.AnnotationEKSRoleARN, "arn:aws:iam::456123987123:role/existing-sa"))
+
+		_, err = clientSet.CoreV1().ServiceAccounts("default").Get(context.TODO(), "missing-sa", metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("only updates the roles in plan mode", func() {
+		Expect(irsaManager.UpdateExistingIAMServiceAccounts([]*api.ClusterIAMServiceAccount{existingSA}, true)).To(Succeed())
+
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+		list, err := clientSet.CoreV1().ServiceAccounts("default").List(context.TODO(), metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(BeEmpty())
+	})
+
+	It("does nothing when no iamserviceaccount exists", func() {
+		Expect(irsaManager.UpdateExistingIAMServiceAccounts([]*api.ClusterIAMServiceAccount{missingSA}, false)).To(Succeed())
+
+		Expect(fakeStackManager.ListStacksMatchingCallCount()).To(Equal(0))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(0))
+	})
+})
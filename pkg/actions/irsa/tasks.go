@@ -12,7 +12,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
-func NewUpdateIAMServiceAccountTask(clusterName string, sa *api.ClusterIAMServiceAccount, stackManager manager.StackManager, oidcManager *iamoidc.OpenIDConnectManager) (*tasks.TaskTree, error) {
+func NewUpdateIAMServiceAccountTask(stackName string, sa *api.ClusterIAMServiceAccount, stackManager manager.StackManager, oidcManager *iamoidc.OpenIDConnectManager) (*tasks.TaskTree, error) {
 
 	rs := builder.NewIAMRoleResourceSetForServiceAccount(sa, oidcManager)
 	err := rs.AddAllResources()
@@ -35,7 +35,7 @@ func NewUpdateIAMServiceAccountTask(clusterName string, sa *api.ClusterIAMServic
 			stackManager: stackManager,
 			templateData: templateData,
 			sa:           sa,
-			clusterName:  clusterName,
+			stackName:    stackName,
 		},
 	)
 	return taskTree, nil
@@ -45,14 +45,14 @@ type updateIAMServiceAccountTask struct {
 	sa           *api.ClusterIAMServiceAccount
 	stackManager manager.StackManager
 	templateData manager.TemplateData
-	clusterName  string
+	stackName    string
 	info         string
 }
 
 func (t *updateIAMServiceAccountTask) Describe() string { return t.info }
 
 func (t *updateIAMServiceAccountTask) Do(errorCh chan error) error {
-	stackName := makeIAMServiceAccountStackName(t.clusterName, t.sa.Namespace, t.sa.Name)
+	stackName := t.stackName
 	go func() {
 		errorCh <- nil
 	}()
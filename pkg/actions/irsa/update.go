@@ -22,18 +22,15 @@ func (a *Manager) UpdateIAMServiceAccounts(iamServiceAccounts []*api.ClusterIAMS
 		return err
 	}
 
-	existingIAMStacksMap := listToSet(existingIAMStacks)
-
 	for _, iamServiceAccount := range iamServiceAccounts {
-		stackName := makeIAMServiceAccountStackName(a.clusterName, iamServiceAccount.Namespace, iamServiceAccount.Name)
-
-		if _, ok := existingIAMStacksMap[stackName]; !ok {
+		stackName, ok := findIAMServiceAccountStack(existingIAMStacks, a.clusterName, iamServiceAccount)
+		if !ok {
 			logger.Info("cannot update IAMServiceAccount %s/%s as it does not exist", iamServiceAccount.Namespace, iamServiceAccount.Name)
 			nonExistingSAs = append(nonExistingSAs, fmt.Sprintf("%s/%s", iamServiceAccount.Namespace, iamServiceAccount.Name))
 			continue
 		}
 
-		taskTree, err := NewUpdateIAMServiceAccountTask(a.clusterName, iamServiceAccount, a.stackManager, a.oidcManager)
+		taskTree, err := NewUpdateIAMServiceAccountTask(stackName, iamServiceAccount, a.stackManager, a.oidcManager)
 		if err != nil {
 			return err
 		}
@@ -49,12 +46,17 @@ func (a *Manager) UpdateIAMServiceAccounts(iamServiceAccounts []*api.ClusterIAMS
 
 }
 
-func listToSet(stacks []*manager.Stack) map[string]struct{} {
-	stacksMap := make(map[string]struct{})
+// findIAMServiceAccountStack returns the name of the stack of an iamserviceaccount. Stacks of clusters with naming
+// have a prefix and suffix around the usual name, and are matched by the iamserviceaccount they are tagged with
+func findIAMServiceAccountStack(stacks []*manager.Stack, clusterName string, sa *api.ClusterIAMServiceAccount) (string, bool) {
+	stackName := makeIAMServiceAccountStackName(clusterName, sa.Namespace, sa.Name)
 	for _, stack := range stacks {
-		stacksMap[*stack.StackName] = struct{}{}
+		name := *stack.StackName
+		if name == stackName || strings.Contains(name, stackName) && manager.GetIAMServiceAccountName(stack) == sa.NameString() {
+			return name, true
+		}
 	}
-	return stacksMap
+	return "", false
 }
 func makeIAMServiceAccountStackName(clusterName, namespace, name string) string {
 	return fmt.Sprintf("eksctl-%s-addon-iamserviceaccount-%s-%s", clusterName, namespace, name)
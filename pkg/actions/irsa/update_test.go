@@ -67,6 +67,21 @@ var _ = Describe("Update", func() {
 			Expect(string(options.TemplateData.(manager.TemplateBody))).To(ContainSubstring(":sub\":\"system:serviceaccount:default:test-sa"))
 		})
 
+		It("updates the stack of a cluster with naming, matched by its tag", func() {
+			fakeStackManager.ListStacksMatchingReturns([]*cloudformation.Stack{
+				{
+					StackName: aws.String("acme-eksctl-my-cluster-addon-iamserviceaccount-default-test-sa-prod"),
+					Tags: []*cloudformation.Tag{
+						{Key: aws.String(api.IAMServiceAccountNameTag), Value: aws.String("default/test-sa")},
+					},
+				},
+			}, nil)
+
+			Expect(irsaManager.UpdateIAMServiceAccounts(serviceAccount, false)).To(Succeed())
+			Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+			Expect(fakeStackManager.UpdateStackArgsForCall(0).StackName).To(Equal("acme-eksctl-my-cluster-addon-iamserviceaccount-default-test-sa-prod"))
+		})
+
 		When("in plan mode", func() {
 			It("does not trigger an update", func() {
 				fakeStackManager.ListStacksMatchingReturns([]*cloudformation.Stack{
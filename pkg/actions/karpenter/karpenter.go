@@ -34,8 +34,13 @@ type WaitFunc func(name, msg string, acceptors []request.WaiterAcceptor, newRequ
 
 // NewInstaller creates a new Karpenter installer.
 func NewInstaller(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, clientSet kubeclient.Interface) (*Installer, error) {
+	clientGetter, err := ctl.NewRESTClientGetter(cfg, karpenter.DefaultNamespace)
+	if err != nil {
+		return nil, err
+	}
 	helmInstaller, err := helm.NewInstaller(helm.Options{
-		Namespace: karpenter.DefaultNamespace,
+		Namespace:        karpenter.DefaultNamespace,
+		RESTClientGetter: clientGetter,
 	})
 	if err != nil {
 		return nil, err
@@ -66,7 +66,7 @@ func (k *karpenterIAMRolesTask) createKarpenterIAMRolesTask(errs chan error) err
 
 // makeNodeGroupStackName generates the name of the Karpenter stack identified by its name, isolated by the cluster this StackCollection operates on
 func (k *karpenterIAMRolesTask) makeKarpenterStackName() string {
-	return fmt.Sprintf("eksctl-%s-karpenter", k.cfg.Metadata.Name)
+	return k.cfg.StackName(fmt.Sprintf("eksctl-%s-karpenter", k.cfg.Metadata.Name))
 }
 
 // ensureSubnetsHaveTags will check if the kubernetes.io/cluster tag is present on the subnets.
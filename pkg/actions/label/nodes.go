@@ -0,0 +1,60 @@
+package label
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// SetOnNodes adds or overwrites labels on the existing nodes of a managed nodegroup,
+// as updating the nodegroup only affects newly launched nodes
+func (m *Manager) SetOnNodes(clientSet kubernetes.Interface, nodeGroupName string, labels map[string]string) error {
+	patch := make(map[string]interface{}, len(labels))
+	for k, v := range labels {
+		patch[k] = v
+	}
+	return patchNodeLabels(clientSet, nodeGroupName, patch)
+}
+
+// UnsetOnNodes removes labels from the existing nodes of a managed nodegroup
+func (m *Manager) UnsetOnNodes(clientSet kubernetes.Interface, nodeGroupName string, labels []string) error {
+	patch := make(map[string]interface{}, len(labels))
+	for _, k := range labels {
+		patch[k] = nil
+	}
+	return patchNodeLabels(clientSet, nodeGroupName, patch)
+}
+
+func patchNodeLabels(clientSet kubernetes.Interface, nodeGroupName string, labels map[string]interface{}) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": labels,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", api.EKSNodeGroupNameLabel, nodeGroupName),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "listing nodes in nodegroup %q", nodeGroupName)
+	}
+
+	for _, node := range nodes.Items {
+		if _, err := clientSet.CoreV1().Nodes().Patch(context.TODO(), node.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return errors.Wrapf(err, "patching labels of node %q", node.Name)
+		}
+	}
+	logger.Info("updated label(s) on %d existing node(s) in nodegroup %s", len(nodes.Items), nodeGroupName)
+	return nil
+}
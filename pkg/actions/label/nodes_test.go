@@ -0,0 +1,154 @@
+package label_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/label"
+	"github.com/weaveworks/eksctl/pkg/actions/label/fakes"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Labels on existing nodes", func() {
+	var (
+		clientSet *fake.Clientset
+		manager   *label.Manager
+	)
+
+	newNode := func(name, nodeGroupName string, labels map[string]string) *corev1.Node {
+		nodeLabels := map[string]string{api.EKSNodeGroupNameLabel: nodeGroupName}
+		for k, v := range labels {
+			nodeLabels[k] = v
+		}
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: nodeLabels,
+			},
+		}
+	}
+
+	getLabels := func(name string) map[string]string {
+		node, err := clientSet.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return node.Labels
+	}
+
+	BeforeEach(func() {
+		clientSet = fake.NewSimpleClientset(
+			newNode("node-1", "bar", map[string]string{"old": "label"}),
+			newNode("node-2", "bar", nil),
+			newNode("node-3", "other", nil),
+		)
+		manager = label.New("foo", new(fakes.FakeService), mockprovider.NewMockProvider().EKS())
+	})
+
+	It("adds labels to the nodes of the nodegroup only", func() {
+		Expect(manager.SetOnNodes(clientSet, "bar", map[string]string{"k1": "v1"})).To(Succeed())
+
+		Expect(getLabels("node-1")).To(HaveKeyWithValue("k1", "v1"))
+		Expect(getLabels("node-1")).To(HaveKeyWithValue("old", "label"))
+		Expect(getLabels("node-2")).To(HaveKeyWithValue("k1", "v1"))
+		Expect(getLabels("node-3")).NotTo(HaveKey("k1"))
+	})
+
+	It("removes labels from the nodes of the nodegroup", func() {
+		Expect(manager.UnsetOnNodes(clientSet, "bar", []string{"old"})).To(Succeed())
+
+		Expect(getLabels("node-1")).NotTo(HaveKey("old"))
+		Expect(getLabels("node-1")).To(HaveKeyWithValue(api.EKSNodeGroupNameLabel, "bar"))
+	})
+})
+
+var _ = Describe("Taints", func() {
+	var (
+		clientSet    *fake.Clientset
+		mockProvider *mockprovider.MockProvider
+		manager      *label.Manager
+	)
+
+	getTaints := func(name string) []corev1.Taint {
+		node, err := clientSet.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return node.Spec.Taints
+	}
+
+	BeforeEach(func() {
+		newNode := func(name, nodeGroupName string, taints ...corev1.Taint) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{api.EKSNodeGroupNameLabel: nodeGroupName},
+				},
+				Spec: corev1.NodeSpec{Taints: taints},
+			}
+		}
+		clientSet = fake.NewSimpleClientset(
+			newNode("node-1", "bar", corev1.Taint{Key: "old", Value: "taint", Effect: corev1.TaintEffectNoSchedule}),
+			newNode("node-2", "bar"),
+			newNode("node-3", "other"),
+		)
+		mockProvider = mockprovider.NewMockProvider()
+		manager = label.New("foo", new(fakes.FakeService), mockProvider.EKS())
+	})
+
+	It("updates the taints of the nodegroup through the EKS API", func() {
+		mockProvider.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
+			ClusterName:   aws.String("foo"),
+			NodegroupName: aws.String("bar"),
+			Taints: &awseks.UpdateTaintsPayload{AddOrUpdateTaints: []*awseks.Taint{
+				{Key: aws.String("k1"), Value: aws.String("v1"), Effect: aws.String(awseks.TaintEffectNoExecute)},
+			}},
+		}).Return(&awseks.UpdateNodegroupConfigOutput{}, nil)
+
+		Expect(manager.SetTaints("bar", []corev1.Taint{{Key: "k1", Value: "v1", Effect: corev1.TaintEffectNoExecute}})).To(Succeed())
+		mockProvider.MockEKS().AssertExpectations(GinkgoT())
+	})
+
+	It("removes the taints of the nodegroup with the given keys", func() {
+		oldTaint := &awseks.Taint{Key: aws.String("old"), Effect: aws.String(awseks.TaintEffectNoSchedule)}
+		mockProvider.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &awseks.Nodegroup{Taints: []*awseks.Taint{
+				oldTaint,
+				{Key: aws.String("kept"), Effect: aws.String(awseks.TaintEffectNoSchedule)},
+			}},
+		}, nil)
+		mockProvider.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
+			ClusterName:   aws.String("foo"),
+			NodegroupName: aws.String("bar"),
+			Taints:        &awseks.UpdateTaintsPayload{RemoveTaints: []*awseks.Taint{oldTaint}},
+		}).Return(&awseks.UpdateNodegroupConfigOutput{}, nil)
+
+		Expect(manager.UnsetTaints("bar", []string{"old"})).To(Succeed())
+		mockProvider.MockEKS().AssertExpectations(GinkgoT())
+	})
+
+	It("adds and overwrites taints on the nodes of the nodegroup only", func() {
+		Expect(manager.SetTaintsOnNodes(clientSet, "bar", []corev1.Taint{
+			{Key: "old", Value: "new", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "k1", Effect: corev1.TaintEffectNoExecute},
+		})).To(Succeed())
+
+		Expect(getTaints("node-1")).To(ConsistOf(
+			corev1.Taint{Key: "old", Value: "new", Effect: corev1.TaintEffectNoSchedule},
+			corev1.Taint{Key: "k1", Effect: corev1.TaintEffectNoExecute},
+		))
+		Expect(getTaints("node-2")).To(HaveLen(2))
+		Expect(getTaints("node-3")).To(BeEmpty())
+	})
+
+	It("removes taints from the nodes of the nodegroup", func() {
+		Expect(manager.UnsetTaintsOnNodes(clientSet, "bar", []string{"old"})).To(Succeed())
+
+		Expect(getTaints("node-1")).To(BeEmpty())
+	})
+})
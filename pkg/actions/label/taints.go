@@ -0,0 +1,144 @@
+package label
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// SetTaints adds or overwrites taints on a managed nodegroup. Taints are updated through the EKS API for all
+// nodegroups, as the stack of a nodegroup only holds the taints it was created with
+func (m *Manager) SetTaints(nodeGroupName string, taints []corev1.Taint) error {
+	eksTaints, err := toEKSTaints(taints)
+	if err != nil {
+		return err
+	}
+	_, err = m.eksAPI.UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(m.clusterName),
+		NodegroupName: aws.String(nodeGroupName),
+		Taints:        &eks.UpdateTaintsPayload{AddOrUpdateTaints: eksTaints},
+	})
+	return err
+}
+
+// UnsetTaints removes the taints with the given keys from a managed nodegroup, whatever their effect
+func (m *Manager) UnsetTaints(nodeGroupName string, keys []string) error {
+	output, err := m.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(m.clusterName),
+		NodegroupName: aws.String(nodeGroupName),
+	})
+	if err != nil {
+		return err
+	}
+
+	removeKeys := sets.NewString(keys...)
+	var removeTaints []*eks.Taint
+	for _, t := range output.Nodegroup.Taints {
+		if removeKeys.Has(aws.StringValue(t.Key)) {
+			removeTaints = append(removeTaints, t)
+		}
+	}
+	if len(removeTaints) == 0 {
+		logger.Info("no taints to remove from nodegroup %s", nodeGroupName)
+		return nil
+	}
+
+	_, err = m.eksAPI.UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(m.clusterName),
+		NodegroupName: aws.String(nodeGroupName),
+		Taints:        &eks.UpdateTaintsPayload{RemoveTaints: removeTaints},
+	})
+	return err
+}
+
+// SetTaintsOnNodes adds or overwrites taints on the existing nodes of a managed nodegroup, as updating the nodegroup
+// only affects newly launched nodes. A taint overwrites the taint of a node with the same key and effect
+func (m *Manager) SetTaintsOnNodes(clientSet kubernetes.Interface, nodeGroupName string, taints []corev1.Taint) error {
+	return updateNodeTaints(clientSet, nodeGroupName, func(nodeTaints []corev1.Taint) []corev1.Taint {
+		for i := range taints {
+			taint := taints[i]
+			replaced := false
+			for j, nodeTaint := range nodeTaints {
+				if nodeTaint.MatchTaint(&taint) {
+					nodeTaints[j] = taint
+					replaced = true
+				}
+			}
+			if !replaced {
+				nodeTaints = append(nodeTaints, taint)
+			}
+		}
+		return nodeTaints
+	})
+}
+
+// UnsetTaintsOnNodes removes the taints with the given keys from the existing nodes of a managed nodegroup
+func (m *Manager) UnsetTaintsOnNodes(clientSet kubernetes.Interface, nodeGroupName string, keys []string) error {
+	removeKeys := sets.NewString(keys...)
+	return updateNodeTaints(clientSet, nodeGroupName, func(nodeTaints []corev1.Taint) []corev1.Taint {
+		var remaining []corev1.Taint
+		for _, nodeTaint := range nodeTaints {
+			if !removeKeys.Has(nodeTaint.Key) {
+				remaining = append(remaining, nodeTaint)
+			}
+		}
+		return remaining
+	})
+}
+
+// updateNodeTaints updates the taints of the nodes of a nodegroup. Unlike labels, taints are a list that a merge patch
+// would replace as a whole, so each node is read and updated
+func updateNodeTaints(clientSet kubernetes.Interface, nodeGroupName string, update func([]corev1.Taint) []corev1.Taint) error {
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", api.EKSNodeGroupNameLabel, nodeGroupName),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "listing nodes in nodegroup %q", nodeGroupName)
+	}
+
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		node.Spec.Taints = update(node.Spec.Taints)
+		if _, err := clientSet.CoreV1().Nodes().Update(context.TODO(), node, metav1.UpdateOptions{}); err != nil {
+			return errors.Wrapf(err, "updating taints of node %q", node.Name)
+		}
+	}
+	logger.Info("updated taint(s) on %d existing node(s) in nodegroup %s", len(nodes.Items), nodeGroupName)
+	return nil
+}
+
+func toEKSTaints(taints []corev1.Taint) ([]*eks.Taint, error) {
+	var eksTaints []*eks.Taint
+	for _, t := range taints {
+		var effect string
+		switch t.Effect {
+		case corev1.TaintEffectNoSchedule:
+			effect = eks.TaintEffectNoSchedule
+		case corev1.TaintEffectPreferNoSchedule:
+			effect = eks.TaintEffectPreferNoSchedule
+		case corev1.TaintEffectNoExecute:
+			effect = eks.TaintEffectNoExecute
+		default:
+			return nil, errors.Errorf("unexpected taint effect: %v", t.Effect)
+		}
+		eksTaint := &eks.Taint{
+			Key:    aws.String(t.Key),
+			Effect: aws.String(effect),
+		}
+		if t.Value != "" {
+			eksTaint.Value = aws.String(t.Value)
+		}
+		eksTaints = append(eksTaints, eksTaint)
+	}
+	return eksTaints, nil
+}
@@ -0,0 +1,130 @@
+package localcluster
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// maxNodesPerNodeGroup is the maximum number of node containers of a nodegroup, as local clusters are meant to test
+// manifests rather than capacity
+const maxNodesPerNodeGroup = 3
+
+// nodeImages are the kind node images of the supported Kubernetes versions
+var nodeImages = map[string]string{
+	api.Version1_18: "kindest/node:v1.18.19",
+	api.Version1_19: "kindest/node:v1.19.11",
+	api.Version1_20: "kindest/node:v1.20.7",
+	api.Version1_21: "kindest/node:v1.21.1",
+}
+
+// kindConfig is the configuration of a kind cluster, see https://kind.sigs.k8s.io/docs/user/configuration/
+type kindConfig struct {
+	Kind       string     `json:"kind"`
+	APIVersion string     `json:"apiVersion"`
+	Name       string     `json:"name"`
+	Nodes      []kindNode `json:"nodes"`
+}
+
+type kindNode struct {
+	Role                 string            `json:"role"`
+	Image                string            `json:"image,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty"`
+	KubeadmConfigPatches []string          `json:"kubeadmConfigPatches,omitempty"`
+}
+
+// joinConfiguration is the part of the kubeadm JoinConfiguration patched to register nodes with taints
+type joinConfiguration struct {
+	Kind             string `json:"kind"`
+	NodeRegistration struct {
+		Taints []corev1.Taint `json:"taints"`
+	} `json:"nodeRegistration"`
+}
+
+// RenderConfig renders the ClusterConfig into the configuration of a kind cluster, where the nodes of nodegroups
+// are node containers with the labels and taints of their nodegroup
+func RenderConfig(cfg *api.ClusterConfig) ([]byte, error) {
+	image, ok := nodeImages[cfg.Metadata.Version]
+	if !ok && cfg.Metadata.Version != "" {
+		logger.Warning("no kind node image known for Kubernetes version %s, using the default image of kind", cfg.Metadata.Version)
+	}
+
+	config := kindConfig{
+		Kind:       "Cluster",
+		APIVersion: "kind.x-k8s.io/v1alpha4",
+		Name:       cfg.Metadata.Name,
+		Nodes: []kindNode{
+			{Role: "control-plane", Image: image},
+		},
+	}
+
+	var nodePools []api.NodePool
+	for _, ng := range cfg.NodeGroups {
+		nodePools = append(nodePools, ng)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		nodePools = append(nodePools, ng)
+	}
+	for _, np := range nodePools {
+		ng := np.BaseNodeGroup()
+		node := kindNode{
+			Role:   "worker",
+			Image:  image,
+			Labels: map[string]string{api.NodeGroupNameLabel: ng.Name},
+		}
+		for k, v := range ng.Labels {
+			node.Labels[k] = v
+		}
+		if taints := np.NGTaints(); len(taints) > 0 {
+			patch, err := taintsPatch(taints)
+			if err != nil {
+				return nil, err
+			}
+			node.KubeadmConfigPatches = []string{patch}
+		}
+
+		count := nodeCount(ng)
+		if count > maxNodesPerNodeGroup {
+			logger.Info("creating %d instead of %d nodes for nodegroup %q", maxNodesPerNodeGroup, count, ng.Name)
+			count = maxNodesPerNodeGroup
+		}
+		for i := 0; i < count; i++ {
+			config.Nodes = append(config.Nodes, node)
+		}
+	}
+
+	return yaml.Marshal(config)
+}
+
+// nodeCount returns the desired capacity of a nodegroup, or its minimum size, with at least one node
+func nodeCount(ng *api.NodeGroupBase) int {
+	if ng.ScalingConfig != nil {
+		if ng.DesiredCapacity != nil && *ng.DesiredCapacity > 0 {
+			return *ng.DesiredCapacity
+		}
+		if ng.MinSize != nil && *ng.MinSize > 0 {
+			return *ng.MinSize
+		}
+	}
+	return 1
+}
+
+func taintsPatch(taints []api.NodeGroupTaint) (string, error) {
+	patch := joinConfiguration{Kind: "JoinConfiguration"}
+	for _, t := range taints {
+		patch.NodeRegistration.Taints = append(patch.NodeRegistration.Taints, corev1.Taint{
+			Key:    t.Key,
+			Value:  t.Value,
+			Effect: t.Effect,
+		})
+	}
+	data, err := yaml.Marshal(patch)
+	if err != nil {
+		return "", fmt.Errorf("rendering taints: %w", err)
+	}
+	return string(data), nil
+}
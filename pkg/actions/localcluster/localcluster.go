@@ -0,0 +1,176 @@
+// Package localcluster creates local clusters with kind from a ClusterConfig, to iterate on the bootstrap manifests
+// and hooks of a cluster before creating it on AWS.
+package localcluster
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kris-nova/logger"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/weaveworks/eksctl/pkg/actions/bootstrap"
+	"github.com/weaveworks/eksctl/pkg/actions/hooks"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+// Providers of clusters
+const (
+	// ProviderAWS creates EKS clusters, the default
+	ProviderAWS = "aws"
+	// ProviderKind creates local clusters with kind, using the node provider detected by kind
+	ProviderKind = "kind"
+	// ProviderDocker creates local clusters with kind, using docker containers as nodes
+	ProviderDocker = "docker"
+)
+
+// BinaryFileName is the name of the kind binary
+const BinaryFileName = "kind"
+
+// IsLocal returns whether the provider creates local clusters, it returns an error for unknown providers
+func IsLocal(provider string) (bool, error) {
+	switch provider {
+	case "", ProviderAWS:
+		return false, nil
+	case ProviderKind, ProviderDocker:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid provider %q, valid options: %s, %s, %s", provider, ProviderAWS, ProviderKind, ProviderDocker)
+	}
+}
+
+// Manager creates and deletes local clusters
+type Manager struct {
+	ClusterConfig  *api.ClusterConfig
+	Provider       string
+	KubeconfigPath string
+	NewExecutor    func(envVars executor.EnvVars) executor.Executor
+	NewRawClient   func(kubeconfigPath, contextName string) (bootstrap.RawClient, error)
+}
+
+// New creates a Manager for the cluster, writing its kubeconfig to kubeconfigPath
+func New(cfg *api.ClusterConfig, provider, kubeconfigPath string) *Manager {
+	return &Manager{
+		ClusterConfig:  cfg,
+		Provider:       provider,
+		KubeconfigPath: kubeconfigPath,
+		NewExecutor:    executor.NewShellExecutor,
+		NewRawClient:   newRawClient,
+	}
+}
+
+// Create creates the local cluster, applies its bootstrap manifests and runs its postCreate hooks
+func (m *Manager) Create() error {
+	meta := m.ClusterConfig.Metadata
+	config, err := RenderConfig(m.ClusterConfig)
+	if err != nil {
+		return err
+	}
+	configFile, err := os.CreateTemp("", "kind-"+meta.Name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(configFile.Name()); err != nil {
+			logger.Critical("failed to remove temporary kind config %s", configFile.Name())
+		}
+	}()
+	if _, err := configFile.Write(config); err != nil {
+		return err
+	}
+	if err := configFile.Close(); err != nil {
+		return err
+	}
+	logger.Debug("kind config = \\\n%s", string(config))
+
+	logger.Info("creating local cluster %q with %s", meta.Name, m.Provider)
+	if err := m.kind("create", "cluster", "--name", meta.Name, "--config", configFile.Name(), "--kubeconfig", m.KubeconfigPath, "--wait", "5m"); err != nil {
+		return fmt.Errorf("creating local cluster %q: %w", meta.Name, err)
+	}
+	logger.Success("local cluster %q is ready, saved kubeconfig as %q", meta.Name, m.KubeconfigPath)
+
+	if m.ClusterConfig.Bootstrap != nil && len(m.ClusterConfig.Bootstrap.Manifests) > 0 {
+		rawClient, err := m.NewRawClient(m.KubeconfigPath, m.contextName())
+		if err != nil {
+			return err
+		}
+		if err := bootstrap.New(rawClient).Apply(m.ClusterConfig.Bootstrap.Manifests); err != nil {
+			return err
+		}
+	}
+
+	if m.ClusterConfig.Hooks != nil && len(m.ClusterConfig.Hooks.PostCreate) > 0 {
+		hooksRunner, err := m.hooksRunner()
+		if err != nil {
+			return err
+		}
+		return hooksRunner.RunPostCreate()
+	}
+	return nil
+}
+
+// Delete runs the preDelete hooks of the local cluster, and deletes it
+func (m *Manager) Delete() error {
+	meta := m.ClusterConfig.Metadata
+	if m.ClusterConfig.Hooks != nil && len(m.ClusterConfig.Hooks.PreDelete) > 0 {
+		hooksRunner, err := m.hooksRunner()
+		if err != nil {
+			return err
+		}
+		if err := hooksRunner.RunPreDelete(); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("deleting local cluster %q", meta.Name)
+	if err := m.kind("delete", "cluster", "--name", meta.Name, "--kubeconfig", m.KubeconfigPath); err != nil {
+		return fmt.Errorf("deleting local cluster %q: %w", meta.Name, err)
+	}
+	logger.Success("local cluster %q has been deleted", meta.Name)
+	return nil
+}
+
+// contextName returns the name of the kubeconfig context of the cluster written by kind
+func (m *Manager) contextName() string {
+	return "kind-" + m.ClusterConfig.Metadata.Name
+}
+
+func (m *Manager) kind(args ...string) error {
+	envVars := executor.EnvVars{}
+	if m.Provider == ProviderDocker {
+		envVars["KIND_EXPERIMENTAL_PROVIDER"] = "docker"
+	}
+	return m.NewExecutor(envVars).Exec(BinaryFileName, args...)
+}
+
+// hooksRunner returns a runner of the hooks of the cluster using the kubeconfig written by kind
+func (m *Manager) hooksRunner() (*hooks.Runner, error) {
+	kubeconfig, err := clientcmd.LoadFromFile(m.KubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading kubeconfig of local cluster: %w", err)
+	}
+	kubeconfig.CurrentContext = m.contextName()
+	return &hooks.Runner{
+		ClusterConfig: m.ClusterConfig,
+		Kubeconfig:    kubeconfig,
+		NewExecutor:   m.NewExecutor,
+	}, nil
+}
+
+func newRawClient(kubeconfigPath, contextName string) (bootstrap.RawClient, error) {
+	restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfigPath},
+		&clientcmd.ConfigOverrides{CurrentContext: contextName},
+	).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("loading kubeconfig of local cluster: %w", err)
+	}
+	clientSet, err := kubeclient.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewRawClient(clientSet, restConfig)
+}
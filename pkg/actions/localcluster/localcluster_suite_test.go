@@ -0,0 +1,13 @@
+package localcluster_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLocalCluster(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Local Cluster Suite")
+}
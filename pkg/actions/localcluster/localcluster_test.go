@@ -0,0 +1,188 @@
+package localcluster_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/weaveworks/eksctl/pkg/actions/bootstrap"
+	bootstrapfakes "github.com/weaveworks/eksctl/pkg/actions/bootstrap/fakes"
+	"github.com/weaveworks/eksctl/pkg/actions/localcluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/executor/fakes"
+)
+
+const kindKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: kind-dev
+  cluster:
+    server: https://127.0.0.1:6443
+contexts:
+- name: kind-dev
+  context:
+    cluster: kind-dev
+    user: kind-dev
+current-context: kind-dev
+users:
+- name: kind-dev
+  user: {}
+`
+
+var _ = Describe("Local clusters", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "dev"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Version = api.Version1_21
+	})
+
+	Describe("IsLocal", func() {
+		It("tells local providers apart", func() {
+			for provider, expected := range map[string]bool{"": false, "aws": false, "kind": true, "docker": true} {
+				local, err := localcluster.IsLocal(provider)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(local).To(Equal(expected))
+			}
+			_, err := localcluster.IsLocal("minikube")
+			Expect(err).To(MatchError(`invalid provider "minikube", valid options: aws, kind, docker`))
+		})
+	})
+
+	Describe("RenderConfig", func() {
+		It("renders a node container per node of each nodegroup", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.DesiredCapacity = aws.Int(5)
+			ng.Labels = map[string]string{"role": "workers"}
+			mng := api.NewManagedNodeGroup()
+			mng.Name = "gpu"
+			mng.ScalingConfig = &api.ScalingConfig{MinSize: aws.Int(1)}
+			mng.Taints = []api.NodeGroupTaint{{Key: "nvidia.com/gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule}}
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+
+			config, err := localcluster.RenderConfig(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			worker := `- image: kindest/node:v1.21.1
+  labels:
+    alpha.eksctl.io/nodegroup-name: ng-1
+    role: workers
+  role: worker
+`
+			Expect(string(config)).To(Equal(`apiVersion: kind.x-k8s.io/v1alpha4
+kind: Cluster
+name: dev
+nodes:
+- image: kindest/node:v1.21.1
+  role: control-plane
+` + worker + worker + worker + `- image: kindest/node:v1.21.1
+  kubeadmConfigPatches:
+  - |
+    kind: JoinConfiguration
+    nodeRegistration:
+      taints:
+      - effect: NoSchedule
+        key: nvidia.com/gpu
+        value: "true"
+  labels:
+    alpha.eksctl.io/nodegroup-name: gpu
+  role: worker
+`))
+		})
+	})
+
+	Describe("Manager", func() {
+		var (
+			dir            string
+			kubeconfigPath string
+			fakeExecutor   *fakes.FakeExecutor
+			envVars        []executor.EnvVars
+			fakeRawClient  *bootstrapfakes.FakeRawClient
+			manager        *localcluster.Manager
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "localcluster")
+			Expect(err).NotTo(HaveOccurred())
+			kubeconfigPath = filepath.Join(dir, "config")
+
+			fakeExecutor = &fakes.FakeExecutor{}
+			fakeExecutor.ExecStub = func(command string, args ...string) error {
+				if command == "kind" && args[0] == "create" {
+					return os.WriteFile(kubeconfigPath, []byte(kindKubeconfig), 0600)
+				}
+				return nil
+			}
+			envVars = nil
+			fakeRawClient = &bootstrapfakes.FakeRawClient{}
+			manager = localcluster.New(cfg, localcluster.ProviderDocker, kubeconfigPath)
+			manager.NewExecutor = func(e executor.EnvVars) executor.Executor {
+				envVars = append(envVars, e)
+				return fakeExecutor
+			}
+			manager.NewRawClient = func(path, contextName string) (bootstrap.RawClient, error) {
+				Expect(path).To(Equal(kubeconfigPath))
+				Expect(contextName).To(Equal("kind-dev"))
+				return fakeRawClient, nil
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("creates the cluster with kind, applies the bootstrap manifests and runs the postCreate hooks", func() {
+			manifest := filepath.Join(dir, "manifest.yaml")
+			Expect(os.WriteFile(manifest, []byte("kind: Namespace"), 0600)).To(Succeed())
+			cfg.Bootstrap = &api.Bootstrap{Manifests: []string{manifest}}
+			cfg.Hooks = &api.Hooks{PostCreate: []string{"kubectl get nodes"}}
+
+			Expect(manager.Create()).To(Succeed())
+
+			Expect(fakeExecutor.ExecCallCount()).To(Equal(2))
+			command, args := fakeExecutor.ExecArgsForCall(0)
+			Expect(command).To(Equal("kind"))
+			Expect(args[:3]).To(Equal([]string{"create", "cluster", "--name"}))
+			Expect(args).To(ContainElements("dev", "--config", "--kubeconfig", kubeconfigPath))
+			Expect(envVars[0]).To(Equal(executor.EnvVars{"KIND_EXPERIMENTAL_PROVIDER": "docker"}))
+
+			Expect(fakeRawClient.CreateOrReplaceCallCount()).To(Equal(1))
+			applied, _ := fakeRawClient.CreateOrReplaceArgsForCall(0)
+			Expect(string(applied)).To(Equal("kind: Namespace"))
+
+			command, args = fakeExecutor.ExecArgsForCall(1)
+			Expect(command).To(Equal("sh"))
+			Expect(args).To(Equal([]string{"-c", "kubectl get nodes"}))
+		})
+
+		It("returns an error when kind fails", func() {
+			fakeExecutor.ExecReturns(errors.New("exit status 1"))
+			fakeExecutor.ExecStub = nil
+
+			Expect(manager.Create()).To(MatchError(`creating local cluster "dev": exit status 1`))
+		})
+
+		It("runs the preDelete hooks and deletes the cluster with kind", func() {
+			Expect(os.WriteFile(kubeconfigPath, []byte(kindKubeconfig), 0600)).To(Succeed())
+			cfg.Hooks = &api.Hooks{PreDelete: []string{"./deregister.sh"}}
+
+			Expect(manager.Delete()).To(Succeed())
+
+			Expect(fakeExecutor.ExecCallCount()).To(Equal(2))
+			_, args := fakeExecutor.ExecArgsForCall(0)
+			Expect(args).To(Equal([]string{"-c", "./deregister.sh"}))
+			command, args := fakeExecutor.ExecArgsForCall(1)
+			Expect(command).To(Equal("kind"))
+			Expect(args).To(Equal([]string{"delete", "cluster", "--name", "dev", "--kubeconfig", kubeconfigPath}))
+		})
+	})
+})
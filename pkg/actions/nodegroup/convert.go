@@ -0,0 +1,233 @@
+package nodegroup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// ConvertOptions controls the conversion of a nodegroup to the other nodegroup type
+type ConvertOptions struct {
+	NodeGroupName   string
+	NewName         string
+	ToManaged       bool
+	MaxGracePeriod  time.Duration
+	DisableEviction bool
+	ForceAfter      time.Duration
+}
+
+// Convert creates a nodegroup of the other type with the settings of an existing nodegroup,
+// drains the existing nodegroup so that its workloads are moved to the new one, and deletes it
+func (m *Manager) Convert(options ConvertOptions) error {
+	nodeGroupType, err := m.stackManager.GetNodeGroupStackType(options.NodeGroupName)
+	if err != nil {
+		return errors.Wrapf(err, "getting type of nodegroup %q", options.NodeGroupName)
+	}
+
+	var original eks.KubeNodeGroup
+	switch {
+	case options.ToManaged && nodeGroupType == api.NodeGroupTypeUnmanaged:
+		// labels, taints and most other settings of self-managed nodegroups are only part of their launch template
+		// and user data, so they are taken from the config the nodegroup was created with
+		var config *api.NodeGroup
+		for _, ng := range m.cfg.NodeGroups {
+			if ng.Name == options.NodeGroupName {
+				config = ng
+			}
+		}
+		if config == nil {
+			return fmt.Errorf("converting self-managed nodegroup %q requires its config, as its labels, taints and other settings cannot be read back from the cluster; use --config-file with the nodegroup in nodeGroups", options.NodeGroupName)
+		}
+		summary, err := m.Get(options.NodeGroupName)
+		if err != nil {
+			return errors.Wrapf(err, "getting nodegroup %q", options.NodeGroupName)
+		}
+		ng, err := newManagedNodeGroupFromNodeGroup(options.NewName, config, summary)
+		if err != nil {
+			return err
+		}
+		api.SetManagedNodeGroupDefaults(ng, m.cfg.Metadata)
+		if err := api.ValidateManagedNodeGroup(ng, 0); err != nil {
+			return err
+		}
+		m.cfg.NodeGroups = nil
+		m.cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{ng}
+		original = &api.NodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: options.NodeGroupName}}
+
+	case !options.ToManaged && nodeGroupType == api.NodeGroupTypeManaged:
+		output, err := m.ctl.Provider.EKS().DescribeNodegroup(&awseks.DescribeNodegroupInput{
+			ClusterName:   &m.cfg.Metadata.Name,
+			NodegroupName: &options.NodeGroupName,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "describing nodegroup %q", options.NodeGroupName)
+		}
+		ng := newNodeGroupFromManaged(options.NewName, output.Nodegroup)
+		if err := api.ValidateNodeGroup(0, ng); err != nil {
+			return err
+		}
+		api.SetNodeGroupDefaults(ng, m.cfg.Metadata)
+		m.cfg.NodeGroups = []*api.NodeGroup{ng}
+		m.cfg.ManagedNodeGroups = nil
+		original = &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: options.NodeGroupName}}
+
+	default:
+		return fmt.Errorf("nodegroup %q of type %s cannot be converted to a %s nodegroup", options.NodeGroupName, nodeGroupType, targetType(options.ToManaged))
+	}
+
+	logger.Info("creating %s nodegroup %q to replace nodegroup %q", targetType(options.ToManaged), options.NewName, options.NodeGroupName)
+	if err := m.Create(CreateOpts{UpdateAuthConfigMap: true}, filter.NewNodeGroupFilter()); err != nil {
+		return errors.Wrapf(err, "creating nodegroup %q", options.NewName)
+	}
+
+	logger.Info("draining nodegroup %q", options.NodeGroupName)
+	if err := m.Drain([]eks.KubeNodeGroup{original}, false, options.MaxGracePeriod, 0, false, options.DisableEviction, options.ForceAfter); err != nil {
+		return errors.Wrapf(err, "draining nodegroup %q; nodegroup %q has been created and can be used", options.NodeGroupName, options.NewName)
+	}
+
+	var nodeGroups []*api.NodeGroup
+	var managedNodeGroups []*api.ManagedNodeGroup
+	switch ng := original.(type) {
+	case *api.NodeGroup:
+		nodeGroups = append(nodeGroups, ng)
+	case *api.ManagedNodeGroup:
+		managedNodeGroups = append(managedNodeGroups, ng)
+	}
+	return m.Delete(nodeGroups, managedNodeGroups, true, false)
+}
+
+func targetType(toManaged bool) api.NodeGroupType {
+	if toManaged {
+		return api.NodeGroupTypeManaged
+	}
+	return api.NodeGroupTypeUnmanaged
+}
+
+// newManagedNodeGroupFromNodeGroup returns a managed nodegroup with the config of a self-managed nodegroup and its
+// current size. It fails for settings that managed nodegroups do not have, rather than dropping them
+func newManagedNodeGroupFromNodeGroup(name string, ng *api.NodeGroup, summary *manager.NodeGroupSummary) (*api.ManagedNodeGroup, error) {
+	var unsupported []string
+	for field, isSet := range map[string]bool{
+		"asgMetricsCollection":     len(ng.ASGMetricsCollection) > 0,
+		"cpuCredits":               ng.CPUCredits != nil,
+		"classicLoadBalancerNames": len(ng.ClassicLoadBalancerNames) > 0,
+		"targetGroupARNs":          len(ng.TargetGroupARNs) > 0,
+		"lifecycleHooks":           len(ng.LifecycleHooks) > 0,
+		"clusterDNS":               ng.ClusterDNS != "",
+		"kubeletExtraConfig":       ng.KubeletExtraConfig != nil,
+		"containerRuntime":         ng.ContainerRuntime != nil,
+		"ipv6Only":                 api.IsEnabled(ng.IPv6Only),
+		"accountRoleARN":           ng.AccountRoleARN != "",
+		"provisioner":              ng.Provisioner != "",
+	} {
+		if isSet {
+			unsupported = append(unsupported, field)
+		}
+	}
+
+	mng := api.NewManagedNodeGroup()
+	base := *ng.NodeGroupBase
+	base.Name = name
+	base.ScalingConfig = &api.ScalingConfig{
+		MinSize:         aws.Int(summary.MinSize),
+		MaxSize:         aws.Int(summary.MaxSize),
+		DesiredCapacity: aws.Int(summary.DesiredCapacity),
+	}
+	mng.NodeGroupBase = &base
+	mng.Taints = append(mng.Taints, ng.Taints...)
+	mng.UpdateConfig = ng.UpdateConfig
+
+	if d := ng.InstancesDistribution; d != nil {
+		mng.InstanceType = ""
+		mng.InstanceTypes = d.InstanceTypes
+		switch {
+		case aws.IntValue(d.OnDemandBaseCapacity) == 0 && d.OnDemandPercentageAboveBaseCapacity != nil && *d.OnDemandPercentageAboveBaseCapacity == 0:
+			mng.Spot = true
+		case d.OnDemandPercentageAboveBaseCapacity != nil && *d.OnDemandPercentageAboveBaseCapacity != 100:
+			unsupported = append(unsupported, "instancesDistribution mixing on-demand and spot instances")
+		}
+	} else if base.InstanceType == "" {
+		if instanceTypes := strings.Split(summary.InstanceType, ","); len(instanceTypes) > 1 {
+			mng.InstanceTypes = instanceTypes
+		} else {
+			base.InstanceType = summary.InstanceType
+		}
+	}
+
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return nil, fmt.Errorf("nodegroup %q cannot be converted to a managed nodegroup, as managed nodegroups do not support: %s", ng.Name, strings.Join(unsupported, ", "))
+	}
+	return mng, nil
+}
+
+// newNodeGroupFromManaged returns a self-managed nodegroup with the settings of a managed nodegroup
+func newNodeGroupFromManaged(name string, mng *awseks.Nodegroup) *api.NodeGroup {
+	ng := api.NewNodeGroup()
+	ng.Name = name
+
+	if sc := mng.ScalingConfig; sc != nil {
+		ng.ScalingConfig = &api.ScalingConfig{
+			MinSize:         aws.Int(int(aws.Int64Value(sc.MinSize))),
+			MaxSize:         aws.Int(int(aws.Int64Value(sc.MaxSize))),
+			DesiredCapacity: aws.Int(int(aws.Int64Value(sc.DesiredSize))),
+		}
+	}
+
+	instanceTypes := aws.StringValueSlice(mng.InstanceTypes)
+	isSpot := aws.StringValue(mng.CapacityType) == awseks.CapacityTypesSpot
+	if len(instanceTypes) > 1 || isSpot {
+		ng.InstanceType = "mixed"
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes: instanceTypes,
+		}
+		if isSpot {
+			ng.InstancesDistribution.OnDemandBaseCapacity = aws.Int(0)
+			ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = aws.Int(0)
+		}
+	} else if len(instanceTypes) == 1 {
+		ng.InstanceType = instanceTypes[0]
+	}
+
+	if mng.DiskSize != nil {
+		ng.VolumeSize = aws.Int(int(*mng.DiskSize))
+	}
+	if strings.HasPrefix(aws.StringValue(mng.AmiType), "BOTTLEROCKET") {
+		ng.AMIFamily = api.NodeImageFamilyBottlerocket
+	}
+	ng.Subnets = aws.StringValueSlice(mng.Subnets)
+
+	ng.Labels = aws.StringValueMap(mng.Labels)
+	for _, t := range mng.Taints {
+		ng.Taints = append(ng.Taints, api.NodeGroupTaint{
+			Key:    aws.StringValue(t.Key),
+			Value:  aws.StringValue(t.Value),
+			Effect: toTaintEffect(aws.StringValue(t.Effect)),
+		})
+	}
+	return ng
+}
+
+func toTaintEffect(effect string) corev1.TaintEffect {
+	switch effect {
+	case awseks.TaintEffectNoSchedule:
+		return corev1.TaintEffectNoSchedule
+	case awseks.TaintEffectPreferNoSchedule:
+		return corev1.TaintEffectPreferNoSchedule
+	case awseks.TaintEffectNoExecute:
+		return corev1.TaintEffectNoExecute
+	}
+	return corev1.TaintEffect(effect)
+}
@@ -0,0 +1,141 @@
+package nodegroup_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Convert", func() {
+	var (
+		m                *nodegroup.Manager
+		fakeStackManager *fakes.FakeStackManager
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: mockprovider.NewMockProvider()}, nil)
+		fakeStackManager = new(fakes.FakeStackManager)
+		m.SetStackManager(fakeStackManager)
+	})
+
+	It("fails when the nodegroup is already of the requested type", func() {
+		fakeStackManager.GetNodeGroupStackTypeReturns(api.NodeGroupTypeManaged, nil)
+
+		err := m.Convert(nodegroup.ConvertOptions{NodeGroupName: "ng", NewName: "ng-new", ToManaged: true})
+		Expect(err).To(MatchError(`nodegroup "ng" of type managed cannot be converted to a managed nodegroup`))
+	})
+
+	It("requires the config of a self-managed nodegroup to convert it", func() {
+		fakeStackManager.GetNodeGroupStackTypeReturns(api.NodeGroupTypeUnmanaged, nil)
+
+		err := m.Convert(nodegroup.ConvertOptions{NodeGroupName: "ng", NewName: "ng-new", ToManaged: true})
+		Expect(err).To(MatchError(ContainSubstring(`converting self-managed nodegroup "ng" requires its config`)))
+		Expect(fakeStackManager.CreateStackCallCount()).To(BeZero())
+	})
+
+	It("carries over the labels, taints and other settings of a self-managed nodegroup", func() {
+		ng := api.NewNodeGroup()
+		ng.Name = "ng"
+		ng.AMIFamily = api.NodeImageFamilyBottlerocket
+		ng.InstanceType = "m5.large"
+		ng.VolumeSize = aws.Int(80)
+		ng.VolumeType = aws.String(api.NodeVolumeTypeIO1)
+		ng.Labels = map[string]string{"role": "worker"}
+		ng.Tags = map[string]string{"team": "a"}
+		ng.SSH = &api.NodeGroupSSH{Allow: api.Enabled(), PublicKeyName: aws.String("key")}
+		ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "worker", Effect: corev1.TaintEffectNoSchedule}}
+
+		mng, err := nodegroup.NewManagedNodeGroupFromNodeGroup("ng-new", ng, &manager.NodeGroupSummary{
+			MinSize:         1,
+			MaxSize:         4,
+			DesiredCapacity: 3,
+			InstanceType:    "m5.large",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mng.Name).To(Equal("ng-new"))
+		Expect(ng.Name).To(Equal("ng"))
+		Expect(*mng.MinSize).To(Equal(1))
+		Expect(*mng.MaxSize).To(Equal(4))
+		Expect(*mng.DesiredCapacity).To(Equal(3))
+		Expect(mng.InstanceType).To(Equal("m5.large"))
+		Expect(mng.AMIFamily).To(Equal(api.NodeImageFamilyBottlerocket))
+		Expect(*mng.VolumeSize).To(Equal(80))
+		Expect(*mng.VolumeType).To(Equal(api.NodeVolumeTypeIO1))
+		Expect(mng.Labels).To(Equal(map[string]string{"role": "worker"}))
+		Expect(mng.Tags).To(HaveKeyWithValue("team", "a"))
+		Expect(*mng.SSH.PublicKeyName).To(Equal("key"))
+		Expect(mng.Taints).To(ConsistOf(api.NodeGroupTaint{Key: "dedicated", Value: "worker", Effect: corev1.TaintEffectNoSchedule}))
+	})
+
+	It("converts self-managed nodegroups of spot instances to managed spot nodegroups", func() {
+		ng := api.NewNodeGroup()
+		ng.Name = "ng"
+		ng.InstanceType = "mixed"
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes:                       []string{"m5.large", "m5a.large"},
+			OnDemandBaseCapacity:                aws.Int(0),
+			OnDemandPercentageAboveBaseCapacity: aws.Int(0),
+		}
+
+		mng, err := nodegroup.NewManagedNodeGroupFromNodeGroup("ng-new", ng, &manager.NodeGroupSummary{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mng.InstanceType).To(BeEmpty())
+		Expect(mng.InstanceTypes).To(Equal([]string{"m5.large", "m5a.large"}))
+		Expect(mng.Spot).To(BeTrue())
+	})
+
+	It("refuses to convert self-managed nodegroups with settings managed nodegroups do not have", func() {
+		ng := api.NewNodeGroup()
+		ng.Name = "ng"
+		ng.ClusterDNS = "169.254.20.10"
+		ng.TargetGroupARNs = []string{"arn:aws:elasticloadbalancing:us-west-2:122333:targetgroup/tg/1"}
+
+		_, err := nodegroup.NewManagedNodeGroupFromNodeGroup("ng-new", ng, &manager.NodeGroupSummary{})
+		Expect(err).To(MatchError(`nodegroup "ng" cannot be converted to a managed nodegroup, as managed nodegroups do not support: clusterDNS, targetGroupARNs`))
+	})
+
+	It("carries over the settings of a managed nodegroup", func() {
+		ng := nodegroup.NewNodeGroupFromManaged("ng-new", &awseks.Nodegroup{
+			NodegroupName: aws.String("ng"),
+			ScalingConfig: &awseks.NodegroupScalingConfig{
+				MinSize:     aws.Int64(1),
+				MaxSize:     aws.Int64(4),
+				DesiredSize: aws.Int64(2),
+			},
+			InstanceTypes: aws.StringSlice([]string{"m5.large", "m5a.large"}),
+			CapacityType:  aws.String(awseks.CapacityTypesSpot),
+			DiskSize:      aws.Int64(50),
+			AmiType:       aws.String(awseks.AMITypesBottlerocketX8664),
+			Labels:        aws.StringMap(map[string]string{"role": "worker"}),
+			Taints: []*awseks.Taint{{
+				Key:    aws.String("dedicated"),
+				Value:  aws.String("worker"),
+				Effect: aws.String(awseks.TaintEffectNoSchedule),
+			}},
+		})
+
+		Expect(ng.Name).To(Equal("ng-new"))
+		Expect(*ng.MinSize).To(Equal(1))
+		Expect(*ng.MaxSize).To(Equal(4))
+		Expect(*ng.DesiredCapacity).To(Equal(2))
+		Expect(ng.InstanceType).To(Equal("mixed"))
+		Expect(ng.InstancesDistribution.InstanceTypes).To(Equal([]string{"m5.large", "m5a.large"}))
+		Expect(*ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity).To(Equal(0))
+		Expect(*ng.VolumeSize).To(Equal(50))
+		Expect(ng.AMIFamily).To(Equal(api.NodeImageFamilyBottlerocket))
+		Expect(ng.Labels).To(Equal(map[string]string{"role": "worker"}))
+		Expect(ng.Taints).To(ConsistOf(api.NodeGroupTaint{Key: "dedicated", Value: "worker", Effect: corev1.TaintEffectNoSchedule}))
+		Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+	})
+})
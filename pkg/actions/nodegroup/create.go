@@ -1,6 +1,7 @@
 package nodegroup
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -8,7 +9,9 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
 	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
@@ -17,6 +20,7 @@ import (
 	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
+	"github.com/weaveworks/eksctl/pkg/windows"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
@@ -30,6 +34,8 @@ type CreateOpts struct {
 	DryRun                    bool
 	SkipOutdatedAddonsCheck   bool
 	ConfigFileProvided        bool
+	FixSecurityGroupRules     bool
+	IfNotExists               bool
 }
 
 // Create creates a new nodegroup with the given options.
@@ -87,6 +93,11 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodegroupFil
 		if err := m.init.Normalize(nodePools, cfg.Metadata); err != nil {
 			return err
 		}
+		if cfg.IPv6Enabled() {
+			if err := eks.ValidateIPv6InstanceTypes(ctl.Provider.EC2(), nodePools); err != nil {
+				return err
+			}
+		}
 	}
 
 	printer := printers.NewJSONPrinter()
@@ -104,6 +115,24 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodegroupFil
 		return err
 	}
 
+	if !options.DryRun {
+		if err := m.init.ValidateVolumeEncryption(nodePools, ctl.Provider); err != nil {
+			return err
+		}
+		if err := vpc.ValidateSecurityGroupRules(ctl.Provider.EC2(), cfg, options.FixSecurityGroupRules); err != nil {
+			return err
+		}
+	}
+
+	// the nodegroups of the config are matched before existing nodegroups are excluded, so that the existing
+	// ones can be converged once the missing ones are created
+	var configNodeGroups []*api.NodeGroup
+	for _, ng := range cfg.NodeGroups {
+		if nodegroupFilter.Match(ng.Name) {
+			configNodeGroups = append(configNodeGroups, ng)
+		}
+	}
+
 	if err := nodegroupFilter.SetOnlyLocal(m.ctl.Provider.EKS(), m.stackManager, cfg); err != nil {
 		return err
 	}
@@ -132,6 +161,31 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodegroupFil
 		return cmdutils.PrintNodeGroupDryRunConfig(clusterConfigCopy, os.Stdout)
 	}
 
+	if err := m.init.ValidateNameCollisions(cfg, ctl.Provider); err != nil {
+		return err
+	}
+
+	if cfg.HasWindowsNodeGroup() {
+		// Windows nodes join the cluster without pod networking unless Windows IPAM is enabled in the VPC CNI
+		windowsIPAM := windows.IPAM{
+			Clientset: m.clientSet,
+		}
+		if err := windowsIPAM.Enable(context.TODO()); err != nil {
+			return errors.Wrap(err, "enabling Windows IP address management")
+		}
+	}
+
+	if addon.UsesPrefixDelegation(cfg) {
+		// nodes must only join the cluster once the VPC CNI assigns prefixes to their network interfaces
+		addonManager, err := addon.New(cfg, ctl.Provider.EKS(), m.stackManager, false, nil, m.clientSet, ctl.Provider.WaitTimeout())
+		if err != nil {
+			return err
+		}
+		if err := addonManager.EnablePrefixDelegation(true); err != nil {
+			return errors.Wrap(err, "enabling prefix delegation")
+		}
+	}
+
 	if err := m.nodeCreationTasks(supportsManagedNodes, isOwnedCluster); err != nil {
 		return err
 	}
@@ -140,6 +194,12 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodegroupFil
 		return err
 	}
 
+	if options.IfNotExists && options.UpdateAuthConfigMap {
+		if err := m.authorizeExistingNodeGroups(configNodeGroups); err != nil {
+			return err
+		}
+	}
+
 	if err := m.init.ValidateExistingNodeGroupsForCompatibility(cfg, m.stackManager); err != nil {
 		logger.Critical("failed checking nodegroups", err.Error())
 	}
@@ -179,17 +239,34 @@ func (m *Manager) nodeCreationTasks(supportsManagedNodes, isOwnedCluster bool) e
 	allNodeGroupTasks := &tasks.TaskTree{
 		Parallel: true,
 	}
-	nodeGroupTasks := m.stackManager.NewUnmanagedNodeGroupTask(cfg.NodeGroups, !awsNodeUsesIRSA, vpcImporter)
+	nodeGroups, crossAccountNodeGroups := splitCrossAccountNodeGroups(cfg.NodeGroups)
+	nodeGroupTasks := m.stackManager.NewUnmanagedNodeGroupTask(nodeGroups, !awsNodeUsesIRSA, vpcImporter)
 	if nodeGroupTasks.Len() > 0 {
 		allNodeGroupTasks.Append(nodeGroupTasks)
 	}
+	if len(crossAccountNodeGroups) > 0 {
+		// stacks of another account cannot import the outputs of the cluster stack
+		crossAccountVPCImporter := vpc.NewSpecConfigImporter(*m.ctl.Status.ClusterInfo.Cluster.ResourcesVpcConfig.ClusterSecurityGroupId, cfg.VPC)
+		for _, ng := range crossAccountNodeGroups {
+			allNodeGroupTasks.Append(m.accountStackManager(ng.AccountRoleARN).NewUnmanagedNodeGroupTask([]*api.NodeGroup{ng}, !awsNodeUsesIRSA, crossAccountVPCImporter))
+		}
+	}
 	managedTasks := m.stackManager.NewManagedNodeGroupTask(cfg.ManagedNodeGroups, !awsNodeUsesIRSA, vpcImporter)
 	if managedTasks.Len() > 0 {
 		allNodeGroupTasks.Append(managedTasks)
 	}
 
 	taskTree.Append(allNodeGroupTasks)
-	return m.init.DoAllNodegroupStackTasks(taskTree, meta.Region, meta.Name)
+	if err := m.init.DoAllNodegroupStackTasks(taskTree, meta.Region, meta.Name); err != nil {
+		return err
+	}
+
+	for _, ng := range crossAccountNodeGroups {
+		if err := authorizeCrossAccountNodeGroup(m.ctl.Provider.EC2(), m.accountStackManager(ng.AccountRoleARN), cfg.VPC.SecurityGroup, ng); err != nil {
+			return errors.Wrapf(err, "authorizing nodegroup %q in the control plane security group", ng.Name)
+		}
+	}
+	return nil
 }
 
 func (m *Manager) postNodeCreationTasks(clientSet kubernetes.Interface, options CreateOpts) error {
@@ -229,6 +306,48 @@ func (m *Manager) postNodeCreationTasks(clientSet kubernetes.Interface, options
 	return nil
 }
 
+// authorizeExistingNodeGroups adds the IAM roles of the nodegroups of the config that existed before this run to
+// the auth ConfigMap, when they are missing from it
+func (m *Manager) authorizeExistingNodeGroups(configNodeGroups []*api.NodeGroup) error {
+	created := map[string]bool{}
+	for _, ng := range m.cfg.NodeGroups {
+		created[ng.Name] = true
+	}
+	var existingNodeGroups []*api.NodeGroup
+	for _, ng := range configNodeGroups {
+		if !created[ng.Name] {
+			existingNodeGroups = append(existingNodeGroups, ng)
+		}
+	}
+	if len(existingNodeGroups) == 0 {
+		return nil
+	}
+
+	summaries, err := m.stackManager.GetUnmanagedNodeGroupSummaries("")
+	if err != nil {
+		return errors.Wrap(err, "getting existing nodegroups")
+	}
+	roleARNs := map[string]string{}
+	for _, summary := range summaries {
+		roleARNs[summary.Name] = summary.NodeInstanceRoleARN
+	}
+
+	for _, ng := range existingNodeGroups {
+		roleARN := roleARNs[ng.Name]
+		if roleARN == "" {
+			continue
+		}
+		existing := *ng
+		existingBase := *ng.NodeGroupBase
+		existingBase.IAM = &api.NodeGroupIAM{InstanceRoleARN: roleARN}
+		existing.NodeGroupBase = &existingBase
+		if err := authconfigmap.AddNodeGroupIfNotPresent(m.clientSet, &existing); err != nil {
+			return errors.Wrapf(err, "authorizing existing nodegroup %q", ng.Name)
+		}
+	}
+	return nil
+}
+
 func checkVersion(ctl *eks.ClusterProvider, meta *api.ClusterMeta) error {
 	switch meta.Version {
 	case "auto":
@@ -1,16 +1,22 @@
 package nodegroup_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	cfnFakes "github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
 	utilFakes "github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter/fakes"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/eks/fakes"
@@ -168,6 +174,19 @@ var _ = DescribeTable("Create", func(t ngEntry) {
 		expErr: errors.New("err"),
 	}),
 
+	Entry("fails when resources with the names of the nodegroup resources exist", ngEntry{
+		mockCalls: func(k *fakes.FakeKubeProvider, init *fakes.FakeNodeGroupInitialiser, f *utilFakes.FakeNodegroupFilter) {
+			k.SupportsManagedNodesReturns(true, nil)
+			init.ValidateNameCollisionsReturns(errors.New("err"))
+		},
+		expectedCalls: func(k *fakes.FakeKubeProvider, init *fakes.FakeNodeGroupInitialiser, f *utilFakes.FakeNodegroupFilter) {
+			Expect(f.SetOnlyLocalCallCount()).To(Equal(1))
+			Expect(init.ValidateNameCollisionsCallCount()).To(Equal(1))
+			Expect(init.DoesAWSNodeUseIRSACallCount()).To(Equal(0))
+		},
+		expErr: errors.New("err"),
+	}),
+
 	Entry("fails to evaluate whether aws-node uses IRSA", ngEntry{
 		mockCalls: func(k *fakes.FakeKubeProvider, init *fakes.FakeNodeGroupInitialiser, f *utilFakes.FakeNodegroupFilter) {
 			k.SupportsManagedNodesReturns(true, nil)
@@ -299,6 +318,46 @@ var _ = DescribeTable("Create", func(t ngEntry) {
 	}),
 )
 
+var _ = Describe("Create with --if-not-exists", func() {
+	It("adds the missing IAM roles of the existing nodegroups to the auth ConfigMap", func() {
+		cfg := newClusterConfig()
+		newNodeGroup := cfg.NodeGroups[0]
+		existingNodeGroup := &api.NodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "existing-ng"}}
+
+		clientSet := fake.NewSimpleClientset()
+		m := nodegroup.New(cfg, &eks.ClusterProvider{Provider: mockprovider.NewMockProvider()}, clientSet)
+		fakeStackManager := &cfnFakes.FakeStackManager{}
+		fakeStackManager.GetUnmanagedNodeGroupSummariesReturns([]*manager.NodeGroupSummary{
+			{Name: "my-ng", NodeInstanceRoleARN: "arn:aws:iam::123456789012:role/new"},
+			{Name: "existing-ng", NodeInstanceRoleARN: "arn:aws:iam::123456789012:role/existing"},
+		}, nil)
+		m.SetStackManager(fakeStackManager)
+
+		configNodeGroups := []*api.NodeGroup{newNodeGroup, existingNodeGroup}
+		Expect(m.AuthorizeExistingNodeGroups(configNodeGroups)).To(Succeed())
+		Expect(m.AuthorizeExistingNodeGroups(configNodeGroups)).To(Succeed())
+
+		cm, err := clientSet.CoreV1().ConfigMaps(authconfigmap.ObjectNamespace).Get(context.TODO(), authconfigmap.ObjectName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		acm := authconfigmap.New(nil, cm)
+		identities, err := acm.GetIdentities()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identities).To(HaveLen(1))
+		Expect(identities[0].ARN()).To(Equal("arn:aws:iam::123456789012:role/existing"))
+		Expect(existingNodeGroup.IAM).To(BeNil())
+	})
+
+	It("does not look up existing nodegroups when all of them were created", func() {
+		cfg := newClusterConfig()
+		m := nodegroup.New(cfg, &eks.ClusterProvider{Provider: mockprovider.NewMockProvider()}, fake.NewSimpleClientset())
+		fakeStackManager := &cfnFakes.FakeStackManager{}
+		m.SetStackManager(fakeStackManager)
+
+		Expect(m.AuthorizeExistingNodeGroups(cfg.NodeGroups)).To(Succeed())
+		Expect(fakeStackManager.GetUnmanagedNodeGroupSummariesCallCount()).To(Equal(0))
+	})
+})
+
 func newClusterConfig() *api.ClusterConfig {
 	return &api.ClusterConfig{
 		TypeMeta: api.ClusterConfigTypeMeta(),
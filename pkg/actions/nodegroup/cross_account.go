@@ -0,0 +1,180 @@
+package nodegroup
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+func splitCrossAccountNodeGroups(nodeGroups []*api.NodeGroup) (local, crossAccount []*api.NodeGroup) {
+	for _, ng := range nodeGroups {
+		if ng.AccountRoleARN != "" {
+			crossAccount = append(crossAccount, ng)
+		} else {
+			local = append(local, ng)
+		}
+	}
+	return local, crossAccount
+}
+
+// crossAccountPermissions holds the rules of the control plane security group allowing it to communicate with a
+// nodegroup of another account
+type crossAccountPermissions struct {
+	accountID string
+	ingress   []*ec2.IpPermission
+	egress    []*ec2.IpPermission
+}
+
+// makeCrossAccountPermissions returns the rules of the control plane security group for the nodegroup, or nil if
+// the nodegroup has no local security group
+func makeCrossAccountPermissions(stackManager manager.StackManager, ng *api.NodeGroup) (*crossAccountPermissions, error) {
+	stack, err := stackManager.DescribeNodeGroupStack(ng.Name)
+	if err != nil {
+		return nil, err
+	}
+	var nodeGroupSecurityGroup string
+	for _, output := range stack.Outputs {
+		if aws.StringValue(output.OutputKey) == outputs.NodeGroupSecurityGroup {
+			nodeGroupSecurityGroup = aws.StringValue(output.OutputValue)
+		}
+	}
+	if nodeGroupSecurityGroup == "" {
+		return nil, nil
+	}
+
+	roleARN, err := arn.Parse(ng.AccountRoleARN)
+	if err != nil {
+		return nil, err
+	}
+	desc := "worker nodes in group " + ng.Name
+	nodeGroupPermission := func(fromPort, toPort int64, description string) *ec2.IpPermission {
+		return &ec2.IpPermission{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(fromPort),
+			ToPort:     aws.Int64(toPort),
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{{
+				GroupId:     aws.String(nodeGroupSecurityGroup),
+				UserId:      aws.String(roleARN.AccountID),
+				Description: aws.String(description),
+			}},
+		}
+	}
+	return &crossAccountPermissions{
+		accountID: roleARN.AccountID,
+		ingress: []*ec2.IpPermission{
+			nodeGroupPermission(443, 443, "Allow control plane to receive API requests from "+desc),
+		},
+		egress: []*ec2.IpPermission{
+			nodeGroupPermission(1025, 65535, "Allow control plane to communicate with "+desc+" (kubelet and workload TCP ports)"),
+			nodeGroupPermission(443, 443, "Allow control plane to communicate with "+desc+" (workloads using HTTPS port, commonly used with extension API servers)"),
+		},
+	}, nil
+}
+
+// authorizeCrossAccountNodeGroup adds the rules of the control plane security group allowing it to communicate
+// with a nodegroup of another account, as the stack of the nodegroup cannot update a security group of the
+// account of the cluster
+func authorizeCrossAccountNodeGroup(ec2API ec2iface.EC2API, stackManager manager.StackManager, controlPlaneSecurityGroup string, ng *api.NodeGroup) error {
+	permissions, err := makeCrossAccountPermissions(stackManager, ng)
+	if err != nil {
+		return err
+	}
+	if permissions == nil {
+		logger.Info("nodegroup %q has no local security group, the control plane security group must allow its nodes", ng.Name)
+		return nil
+	}
+
+	if _, err := ec2API.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:       aws.String(controlPlaneSecurityGroup),
+		IpPermissions: permissions.ingress,
+	}); err != nil && !isAWSErrorCode(err, "InvalidPermission.Duplicate") {
+		return err
+	}
+	if _, err := ec2API.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{
+		GroupId:       aws.String(controlPlaneSecurityGroup),
+		IpPermissions: permissions.egress,
+	}); err != nil && !isAWSErrorCode(err, "InvalidPermission.Duplicate") {
+		return err
+	}
+	logger.Info("allowed the control plane to communicate with worker nodes in group %s of account %s", ng.Name, permissions.accountID)
+	return nil
+}
+
+// revokeCrossAccountNodeGroup removes the rules added by authorizeCrossAccountNodeGroup, which must be done before
+// the security group of the nodegroup they refer to can be deleted
+func revokeCrossAccountNodeGroup(ec2API ec2iface.EC2API, stackManager manager.StackManager, controlPlaneSecurityGroup string, ng *api.NodeGroup) error {
+	permissions, err := makeCrossAccountPermissions(stackManager, ng)
+	if err != nil || permissions == nil {
+		return err
+	}
+
+	if _, err := ec2API.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+		GroupId:       aws.String(controlPlaneSecurityGroup),
+		IpPermissions: permissions.ingress,
+	}); err != nil && !isAWSErrorCode(err, "InvalidPermission.NotFound") {
+		return err
+	}
+	if _, err := ec2API.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+		GroupId:       aws.String(controlPlaneSecurityGroup),
+		IpPermissions: permissions.egress,
+	}); err != nil && !isAWSErrorCode(err, "InvalidPermission.NotFound") {
+		return err
+	}
+	logger.Info("removed the rules of the control plane security group for worker nodes in group %s of account %s", ng.Name, permissions.accountID)
+	return nil
+}
+
+// newTasksToDeleteCrossAccountNodeGroups defines the tasks revoking the rules of the control plane security group for
+// each nodegroup of another account, then deleting its stack in that account
+func (m *Manager) newTasksToDeleteCrossAccountNodeGroups(nodeGroups []*api.NodeGroup, wait bool) (*tasks.TaskTree, error) {
+	taskTree := &tasks.TaskTree{Parallel: true}
+	if len(nodeGroups) == 0 {
+		return taskTree, nil
+	}
+	if m.cfg.VPC == nil || m.cfg.VPC.SecurityGroup == "" {
+		if err := m.ctl.LoadClusterVPC(m.cfg, m.stackManager); err != nil {
+			return nil, err
+		}
+	}
+	controlPlaneSecurityGroup := m.cfg.VPC.SecurityGroup
+
+	for _, ng := range nodeGroups {
+		ng := ng
+		stackManager := m.accountStackManager(ng.AccountRoleARN)
+		deleteTasks, err := stackManager.NewTasksToDeleteNodeGroups(func(name string) bool {
+			return name == ng.Name
+		}, wait, nil)
+		if err != nil {
+			return nil, err
+		}
+		if deleteTasks.Len() == 0 {
+			logger.Warning("stack of nodegroup %q was not found in the account of role %q", ng.Name, ng.AccountRoleARN)
+			continue
+		}
+		ngTasks := &tasks.TaskTree{Parallel: false, IsSubTask: true}
+		ngTasks.Append(&tasks.GenericTask{
+			Description: fmt.Sprintf("revoke the rules of the control plane security group for nodegroup %q", ng.Name),
+			Doer: func() error {
+				return revokeCrossAccountNodeGroup(m.ctl.Provider.EC2(), stackManager, controlPlaneSecurityGroup, ng)
+			},
+		})
+		ngTasks.Append(deleteTasks)
+		taskTree.Append(ngTasks)
+	}
+	return taskTree, nil
+}
+
+func isAWSErrorCode(err error, code string) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == code
+}
@@ -0,0 +1,105 @@
+package nodegroup_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Cross-account nodegroups", func() {
+	var (
+		p                *mockprovider.MockProvider
+		fakeStackManager *fakes.FakeStackManager
+		ng               *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		fakeStackManager = &fakes.FakeStackManager{}
+		ng = &api.NodeGroup{
+			NodeGroupBase:  &api.NodeGroupBase{Name: "ng-1"},
+			AccountRoleARN: "arn:aws:iam::222222222222:role/eksctl-nodegroups",
+		}
+	})
+
+	withSecurityGroupOutput := func() {
+		fakeStackManager.DescribeNodeGroupStackReturns(&cloudformation.Stack{
+			Outputs: []*cloudformation.Output{{
+				OutputKey:   aws.String("SecurityGroup"),
+				OutputValue: aws.String("sg-nodegroup"),
+			}},
+		}, nil)
+	}
+
+	It("allows the control plane to communicate with the nodegroup security group", func() {
+		withSecurityGroupOutput()
+		matchesNodeGroup := func(permissions []*ec2.IpPermission) bool {
+			for _, permission := range permissions {
+				pair := permission.UserIdGroupPairs[0]
+				if aws.StringValue(pair.GroupId) != "sg-nodegroup" || aws.StringValue(pair.UserId) != "222222222222" {
+					return false
+				}
+			}
+			return true
+		}
+		p.MockEC2().On("AuthorizeSecurityGroupIngress", mock.MatchedBy(func(input *ec2.AuthorizeSecurityGroupIngressInput) bool {
+			return aws.StringValue(input.GroupId) == "sg-control-plane" && len(input.IpPermissions) == 1 &&
+				aws.Int64Value(input.IpPermissions[0].FromPort) == 443 && matchesNodeGroup(input.IpPermissions)
+		})).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+		p.MockEC2().On("AuthorizeSecurityGroupEgress", mock.MatchedBy(func(input *ec2.AuthorizeSecurityGroupEgressInput) bool {
+			return aws.StringValue(input.GroupId) == "sg-control-plane" && len(input.IpPermissions) == 2 && matchesNodeGroup(input.IpPermissions)
+		})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+
+		Expect(nodegroup.AuthorizeCrossAccountNodeGroup(p.EC2(), fakeStackManager, "sg-control-plane", ng)).To(Succeed())
+		Expect(fakeStackManager.DescribeNodeGroupStackArgsForCall(0)).To(Equal("ng-1"))
+		p.MockEC2().AssertExpectations(GinkgoT())
+	})
+
+	It("ignores rules that already exist", func() {
+		withSecurityGroupOutput()
+		duplicate := awserr.New("InvalidPermission.Duplicate", "the rule already exists", nil)
+		p.MockEC2().On("AuthorizeSecurityGroupIngress", mock.Anything).Return(nil, duplicate)
+		p.MockEC2().On("AuthorizeSecurityGroupEgress", mock.Anything).Return(nil, duplicate)
+
+		Expect(nodegroup.AuthorizeCrossAccountNodeGroup(p.EC2(), fakeStackManager, "sg-control-plane", ng)).To(Succeed())
+	})
+
+	It("does nothing when the nodegroup has no local security group", func() {
+		fakeStackManager.DescribeNodeGroupStackReturns(&cloudformation.Stack{}, nil)
+
+		Expect(nodegroup.AuthorizeCrossAccountNodeGroup(p.EC2(), fakeStackManager, "sg-control-plane", ng)).To(Succeed())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "AuthorizeSecurityGroupIngress", mock.Anything)
+	})
+
+	It("revokes the rules of the control plane security group for the nodegroup", func() {
+		withSecurityGroupOutput()
+		p.MockEC2().On("RevokeSecurityGroupIngress", mock.MatchedBy(func(input *ec2.RevokeSecurityGroupIngressInput) bool {
+			return aws.StringValue(input.GroupId) == "sg-control-plane" && len(input.IpPermissions) == 1 &&
+				aws.StringValue(input.IpPermissions[0].UserIdGroupPairs[0].GroupId) == "sg-nodegroup"
+		})).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil)
+		p.MockEC2().On("RevokeSecurityGroupEgress", mock.MatchedBy(func(input *ec2.RevokeSecurityGroupEgressInput) bool {
+			return aws.StringValue(input.GroupId) == "sg-control-plane" && len(input.IpPermissions) == 2
+		})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+
+		Expect(nodegroup.RevokeCrossAccountNodeGroup(p.EC2(), fakeStackManager, "sg-control-plane", ng)).To(Succeed())
+		p.MockEC2().AssertExpectations(GinkgoT())
+	})
+
+	It("ignores rules that were already revoked", func() {
+		withSecurityGroupOutput()
+		notFound := awserr.New("InvalidPermission.NotFound", "the rule does not exist", nil)
+		p.MockEC2().On("RevokeSecurityGroupIngress", mock.Anything).Return(nil, notFound)
+		p.MockEC2().On("RevokeSecurityGroupEgress", mock.Anything).Return(nil, notFound)
+
+		Expect(nodegroup.RevokeCrossAccountNodeGroup(p.EC2(), fakeStackManager, "sg-control-plane", ng)).To(Succeed())
+	})
+})
@@ -13,12 +13,21 @@ import (
 func (m *Manager) Delete(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup, wait, plan bool) error {
 	var nodeGroupsWithStacks []eks.KubeNodeGroup
 
+	nodeGroups, crossAccountNodeGroups := splitCrossAccountNodeGroups(nodeGroups)
 	for _, n := range nodeGroups {
 		nodeGroupsWithStacks = append(nodeGroupsWithStacks, n)
 	}
 
 	tasks := &tasks.TaskTree{Parallel: true}
 
+	crossAccountTasks, err := m.newTasksToDeleteCrossAccountNodeGroups(crossAccountNodeGroups, wait)
+	if err != nil {
+		return err
+	}
+	if crossAccountTasks.Len() > 0 {
+		tasks.Append(crossAccountTasks)
+	}
+
 	for _, n := range managedNodeGroups {
 		hasStacks, err := m.hasStacks(n.Name)
 		if err != nil {
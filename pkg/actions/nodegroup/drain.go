@@ -1,17 +1,21 @@
 package nodegroup
 
 import (
+	"context"
 	"time"
 
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/weaveworks/eksctl/pkg/eks"
 
 	"github.com/weaveworks/eksctl/pkg/drain"
 )
 
-func (m *Manager) Drain(nodeGroups []eks.KubeNodeGroup, plan bool, maxGracePeriod, nodeDrainWaitPeriod time.Duration, undo, disableEviction bool) error {
+func (m *Manager) Drain(nodeGroups []eks.KubeNodeGroup, plan bool, maxGracePeriod, nodeDrainWaitPeriod time.Duration, undo, disableEviction bool, forceAfter time.Duration) error {
 	if !plan {
 		for _, n := range nodeGroups {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, n, m.ctl.Provider.WaitTimeout(), maxGracePeriod, nodeDrainWaitPeriod, undo, disableEviction)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, n, m.ctl.Provider.WaitTimeout(), maxGracePeriod, nodeDrainWaitPeriod, undo, disableEviction, forceAfter)
 			if err := nodeGroupDrainer.Drain(); err != nil {
 				return err
 			}
@@ -19,3 +23,19 @@ func (m *Manager) Drain(nodeGroups []eks.KubeNodeGroup, plan bool, maxGracePerio
 	}
 	return nil
 }
+
+// FindCriticalPods returns the pods selected by selector which run on the nodes of nodeGroups
+func (m *Manager) FindCriticalPods(nodeGroups []eks.KubeNodeGroup, selector drain.CriticalPodSelector) ([]drain.CriticalPod, error) {
+	var nodes []corev1.Node
+	for _, n := range nodeGroups {
+		nodeList, err := m.clientSet.CoreV1().Nodes().List(context.TODO(), n.ListOptions())
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing nodes of nodegroup %q", n.NameString())
+		}
+		nodes = append(nodes, nodeList.Items...)
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	return drain.FindCriticalPods(m.clientSet, nodes, selector)
+}
@@ -1,8 +1,10 @@
 package nodegroup
 
 import (
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/nodeprovisioner"
 )
 
 func (m *Manager) SetWaiter(wait WaitFunc) {
@@ -22,3 +24,20 @@ func (m *Manager) MockKubeProvider(k eks.KubeProvider) {
 func (m *Manager) MockNodeGroupService(ngSvc eks.NodeGroupInitialiser) {
 	m.init = ngSvc
 }
+
+// MockNodeProvisioner can be used for passing a plugin that runs a fake executor.
+func (m *Manager) MockNodeProvisioner(newPlugin func(name string) *nodeprovisioner.Plugin) {
+	m.nodeProvisioner = newPlugin
+}
+
+func (m *Manager) AuthorizeExistingNodeGroups(configNodeGroups []*api.NodeGroup) error {
+	return m.authorizeExistingNodeGroups(configNodeGroups)
+}
+
+var NewNodeGroupFromManaged = newNodeGroupFromManaged
+
+var NewManagedNodeGroupFromNodeGroup = newManagedNodeGroupFromNodeGroup
+
+var AuthorizeCrossAccountNodeGroup = authorizeCrossAccountNodeGroup
+
+var RevokeCrossAccountNodeGroup = revokeCrossAccountNodeGroup
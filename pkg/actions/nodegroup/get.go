@@ -7,11 +7,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/eks"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
 )
 
@@ -30,15 +30,13 @@ func (m *Manager) GetAll() ([]*manager.NodeGroupSummary, error) {
 		}
 	}
 
-	managedNodeGroups, err := m.ctl.Provider.EKS().ListNodegroups(&eks.ListNodegroupsInput{
-		ClusterName: &m.cfg.Metadata.Name,
-	})
+	managedNodeGroups, err := eks.ListNodegroups(m.ctl.Provider.EKS(), m.cfg.Metadata.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, managedNodeGroup := range managedNodeGroups.Nodegroups {
-		describeOutput, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
+	for _, managedNodeGroup := range managedNodeGroups {
+		describeOutput, err := m.ctl.Provider.EKS().DescribeNodegroup(&awseks.DescribeNodegroupInput{
 			ClusterName:   &m.cfg.Metadata.Name,
 			NodegroupName: managedNodeGroup,
 		})
@@ -97,7 +95,7 @@ func (m *Manager) Get(name string) (*manager.NodeGroupSummary, error) {
 		return s, nil
 	}
 
-	describeOutput, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
+	describeOutput, err := m.ctl.Provider.EKS().DescribeNodegroup(&awseks.DescribeNodegroupInput{
 		ClusterName:   &m.cfg.Metadata.Name,
 		NodegroupName: &name,
 	})
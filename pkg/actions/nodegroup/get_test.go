@@ -47,18 +47,17 @@ var _ = Describe("Get", func() {
 
 	Describe("GetAll", func() {
 		BeforeEach(func() {
-			p.MockEKS().On("ListNodegroups", &awseks.ListNodegroupsInput{
+			p.MockEKS().On("ListNodegroupsPages", &awseks.ListNodegroupsInput{
 				ClusterName: aws.String(clusterName),
-			}).Run(func(args mock.Arguments) {
-				Expect(args).To(HaveLen(1))
-				Expect(args[0]).To(BeAssignableToTypeOf(&awseks.ListNodegroupsInput{
-					ClusterName: aws.String(clusterName),
-				}))
-			}).Return(&awseks.ListNodegroupsOutput{
-				Nodegroups: []*string{
-					aws.String(ngName),
-				},
-			}, nil)
+			}, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
+				consume := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+				consume(&awseks.ListNodegroupsOutput{
+					Nodegroups: []*string{
+						aws.String(ngName),
+					},
+				}, true)
+			}).Return(nil)
 		})
 
 		Context("when getting managed nodegroups", func() {
@@ -12,6 +12,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/nodeprovisioner"
 )
 
 type Manager struct {
@@ -23,6 +24,8 @@ type Manager struct {
 	init                  eks.NodeGroupInitialiser
 	kubeProvider          eks.KubeProvider
 	launchTemplateFetcher *builder.LaunchTemplateFetcher
+	accountStackManager   func(roleARN string) manager.StackManager
+	nodeProvisioner       func(name string) *nodeprovisioner.Plugin
 }
 
 type WaitFunc func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error
@@ -40,6 +43,10 @@ func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.
 		},
 		kubeProvider:          ctl,
 		launchTemplateFetcher: builder.NewLaunchTemplateFetcher(ctl.Provider.EC2()),
+		accountStackManager: func(roleARN string) manager.StackManager {
+			return ctl.NewStackManagerForRole(cfg, roleARN)
+		},
+		nodeProvisioner: nodeprovisioner.New,
 	}
 }
 
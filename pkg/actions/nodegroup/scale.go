@@ -2,16 +2,21 @@ package nodegroup
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/kris-nova/logger"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/managed"
+	"github.com/weaveworks/eksctl/pkg/nodeprovisioner"
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 
 	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
@@ -36,7 +41,11 @@ func (m *Manager) Scale(ng *api.NodeGroupBase) error {
 	}
 
 	if isUnmanagedNodegroup {
-		err = m.scaleUnmanagedNodeGroup(ng, stackInfo)
+		if provisioner := nodeprovisioner.ProvisionerName(stackInfo.Stack); provisioner != "" {
+			err = m.scaleProvisionedNodeGroup(ng, stackInfo, provisioner)
+		} else {
+			err = m.scaleUnmanagedNodeGroup(ng, stackInfo)
+		}
 	} else {
 		err = m.scaleManagedNodeGroup(ng)
 	}
@@ -86,6 +95,23 @@ func (m *Manager) scaleUnmanagedNodeGroup(ng *api.NodeGroupBase, stackInfo manag
 	return nil
 }
 
+// scaleProvisionedNodeGroup asks the node provisioner plugin of the nodegroup to scale it
+func (m *Manager) scaleProvisionedNodeGroup(ng *api.NodeGroupBase, stackInfo manager.StackInfo, provisioner string) error {
+	req, err := nodeprovisioner.NewRequest(m.cfg, ng.Name, stackInfo.Stack)
+	if err != nil {
+		return err
+	}
+	req.NodeGroup.MinSize = ng.MinSize
+	req.NodeGroup.MaxSize = ng.MaxSize
+	req.NodeGroup.DesiredCapacity = ng.DesiredCapacity
+	if err := m.nodeProvisioner(provisioner).Run(nodeprovisioner.ActionScale, req); err != nil {
+		return err
+	}
+	logger.Info("nodegroup successfully scaled")
+
+	return nil
+}
+
 func (m *Manager) scaleManagedNodeGroup(ng *api.NodeGroupBase) error {
 	scalingConfig := &eks.NodegroupScalingConfig{}
 
@@ -137,3 +163,132 @@ func (m *Manager) scaleManagedNodeGroup(ng *api.NodeGroupBase) error {
 	logger.Info("nodegroup successfully scaled")
 	return nil
 }
+
+// ScaleToZero scales a managed nodegroup down to zero nodes, leaving its maximum size untouched.
+// When preserve is set, the current scaling config is stored in a tag on the nodegroup
+// so that RestoreScale can put it back exactly
+func (m *Manager) ScaleToZero(ng *api.NodeGroupBase, preserve bool) error {
+	logger.Info("scaling nodegroup %q in cluster %s to zero", ng.Name, m.cfg.Metadata.Name)
+
+	nodegroup, err := m.describeManagedNodeGroup(ng.Name)
+	if err != nil {
+		return err
+	}
+
+	if preserve {
+		if err := m.preserveScalingConfig(nodegroup); err != nil {
+			return err
+		}
+	}
+
+	zero := &api.NodeGroupBase{
+		Name: ng.Name,
+		ScalingConfig: &api.ScalingConfig{
+			MinSize:         aws.Int(0),
+			DesiredCapacity: aws.Int(0),
+		},
+	}
+	if err := m.scaleManagedNodeGroup(zero); err != nil {
+		return fmt.Errorf("failed to scale nodegroup for cluster %q, error: %v", m.cfg.Metadata.Name, err)
+	}
+	return nil
+}
+
+// RestoreScale restores the scaling config of a managed nodegroup that was previously
+// scaled to zero with preserve set, and removes the tag holding it
+func (m *Manager) RestoreScale(ng *api.NodeGroupBase) error {
+	logger.Info("restoring scaling config of nodegroup %q in cluster %s", ng.Name, m.cfg.Metadata.Name)
+
+	nodegroup, err := m.describeManagedNodeGroup(ng.Name)
+	if err != nil {
+		return err
+	}
+
+	value, ok := nodegroup.Tags[api.NodeGroupPreservedScalingConfigTag]
+	if !ok || value == nil {
+		return fmt.Errorf("nodegroup %q has no preserved scaling config; it must first be scaled with --to-zero --preserve", ng.Name)
+	}
+
+	scalingConfig, err := parsePreservedScalingConfig(*value)
+	if err != nil {
+		return errors.Wrapf(err, "invalid value for tag %q on nodegroup %q", api.NodeGroupPreservedScalingConfigTag, ng.Name)
+	}
+
+	restored := &api.NodeGroupBase{
+		Name:          ng.Name,
+		ScalingConfig: scalingConfig,
+	}
+	if err := m.scaleManagedNodeGroup(restored); err != nil {
+		return fmt.Errorf("failed to scale nodegroup for cluster %q, error: %v", m.cfg.Metadata.Name, err)
+	}
+
+	_, err = m.ctl.Provider.EKS().UntagResource(&eks.UntagResourceInput{
+		ResourceArn: nodegroup.NodegroupArn,
+		TagKeys:     aws.StringSlice([]string{api.NodeGroupPreservedScalingConfigTag}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "removing tag %q from nodegroup %q", api.NodeGroupPreservedScalingConfigTag, ng.Name)
+	}
+	return nil
+}
+
+func (m *Manager) describeManagedNodeGroup(name string) (*eks.Nodegroup, error) {
+	output, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   &m.cfg.Metadata.Name,
+		NodegroupName: &name,
+	})
+	if err != nil {
+		if managed.IsNotFound(err) {
+			return nil, fmt.Errorf("preserving and restoring the scaling config is only supported for managed nodegroups; could not find one with name %q", name)
+		}
+		return nil, err
+	}
+	return output.Nodegroup, nil
+}
+
+func (m *Manager) preserveScalingConfig(nodegroup *eks.Nodegroup) error {
+	if _, ok := nodegroup.Tags[api.NodeGroupPreservedScalingConfigTag]; ok {
+		logger.Warning("nodegroup %q already has a preserved scaling config, it will not be overwritten", *nodegroup.NodegroupName)
+		return nil
+	}
+	if nodegroup.ScalingConfig == nil {
+		return fmt.Errorf("nodegroup %q has no scaling config to preserve", *nodegroup.NodegroupName)
+	}
+
+	value := formatPreservedScalingConfig(nodegroup.ScalingConfig)
+	logger.Info("preserving scaling config %q of nodegroup %q", value, *nodegroup.NodegroupName)
+	_, err := m.ctl.Provider.EKS().TagResource(&eks.TagResourceInput{
+		ResourceArn: nodegroup.NodegroupArn,
+		Tags: map[string]*string{
+			api.NodeGroupPreservedScalingConfigTag: aws.String(value),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "tagging nodegroup %q with its scaling config", *nodegroup.NodegroupName)
+	}
+	return nil
+}
+
+func formatPreservedScalingConfig(sc *eks.NodegroupScalingConfig) string {
+	return fmt.Sprintf("%d:%d:%d", aws.Int64Value(sc.MinSize), aws.Int64Value(sc.DesiredSize), aws.Int64Value(sc.MaxSize))
+}
+
+func parsePreservedScalingConfig(value string) (*api.ScalingConfig, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("expected format minSize:desiredCapacity:maxSize, got %q", value)
+	}
+	sizes := make([]int, len(parts))
+	for i, part := range parts {
+		size, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid size %q", part)
+		}
+		sizes[i] = size
+	}
+	return &api.ScalingConfig{
+		MinSize:         aws.Int(sizes[0]),
+		DesiredCapacity: aws.Int(sizes[1]),
+		MaxSize:         aws.Int(sizes[2]),
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -18,6 +19,9 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	executorfakes "github.com/weaveworks/eksctl/pkg/executor/fakes"
+	"github.com/weaveworks/eksctl/pkg/nodeprovisioner"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
 
@@ -183,4 +187,167 @@ var _ = Describe("Scale", func() {
 			})
 		})
 	})
+	Describe("Nodegroup provisioned by a plugin", func() {
+		var fakeExecutor *executorfakes.FakeExecutor
+
+		BeforeEach(func() {
+			nodegroups := make(map[string]manager.StackInfo)
+			nodegroups["my-ng"] = manager.StackInfo{
+				Stack: &manager.Stack{
+					StackName: aws.String("eksctl-my-cluster-nodegroup-my-ng"),
+					Tags: []*cloudformation.Tag{
+						{
+							Key:   aws.String(api.NodeGroupNameTag),
+							Value: aws.String("my-ng"),
+						},
+						{
+							Key:   aws.String(api.NodeGroupTypeTag),
+							Value: aws.String(string(api.NodeGroupTypeUnmanaged)),
+						},
+						{
+							Key:   aws.String(api.NodeProvisionerTag),
+							Value: aws.String("ocean"),
+						},
+					},
+					Outputs: []*cloudformation.Output{
+						{OutputKey: aws.String("LaunchTemplateID"), OutputValue: aws.String("lt-1234")},
+						{OutputKey: aws.String("LaunchTemplateVersion"), OutputValue: aws.String("1")},
+						{OutputKey: aws.String("SubnetIDs"), OutputValue: aws.String("subnet-1")},
+					},
+				},
+			}
+			fakeStackManager.DescribeNodeGroupStacksAndResourcesReturns(nodegroups, nil)
+
+			fakeExecutor = &executorfakes.FakeExecutor{}
+			m.MockNodeProvisioner(func(name string) *nodeprovisioner.Plugin {
+				Expect(name).To(Equal("ocean"))
+				return &nodeprovisioner.Plugin{
+					Name: name,
+					LookPath: func(file string) (string, error) {
+						return file, nil
+					},
+					NewExecutor: func(executor.EnvVars) executor.Executor {
+						return fakeExecutor
+					},
+				}
+			})
+		})
+
+		It("runs the scale action of the plugin instead of updating the ASG", func() {
+			Expect(m.Scale(ng)).To(Succeed())
+			Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+			command, args := fakeExecutor.ExecArgsForCall(0)
+			Expect(command).To(Equal("eksctl-provisioner-ocean"))
+			Expect(args[0]).To(Equal("scale"))
+			Expect(p.MockASG().AssertNotCalled(GinkgoT(), "UpdateAutoScalingGroup", mock.Anything)).To(BeTrue())
+		})
+
+		It("returns an error when the plugin fails", func() {
+			fakeExecutor.ExecReturns(fmt.Errorf("exit status 1"))
+			err := m.Scale(ng)
+			Expect(err).To(MatchError(ContainSubstring(`eksctl-provisioner-ocean scale failed for nodegroup "my-ng": exit status 1`)))
+		})
+	})
+
+	Describe("Managed NodeGroup scaled to zero", func() {
+		var ngARN string
+
+		BeforeEach(func() {
+			ngARN = "arn:aws:eks:us-west-2:123456789012:nodegroup/my-cluster/my-ng/1"
+
+			p.MockEKS().On("DescribeNodegroupRequest", &awseks.DescribeNodegroupInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			}).Return(&request.Request{}, nil)
+
+			m.SetWaiter(func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error {
+				return nil
+			})
+		})
+
+		mockDescribeNodegroup := func(tags map[string]*string) {
+			p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			}).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &awseks.Nodegroup{
+					NodegroupName: &ngName,
+					NodegroupArn:  &ngARN,
+					ScalingConfig: &awseks.NodegroupScalingConfig{
+						MinSize:     aws.Int64(1),
+						DesiredSize: aws.Int64(3),
+						MaxSize:     aws.Int64(5),
+					},
+					Tags: tags,
+				},
+			}, nil)
+		}
+
+		It("stores the current scaling config in a tag before scaling to zero", func() {
+			mockDescribeNodegroup(nil)
+			p.MockEKS().On("TagResource", &awseks.TagResourceInput{
+				ResourceArn: &ngARN,
+				Tags: map[string]*string{
+					api.NodeGroupPreservedScalingConfigTag: aws.String("1:3:5"),
+				},
+			}).Return(nil, nil)
+			p.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
+				ScalingConfig: &awseks.NodegroupScalingConfig{
+					MinSize:     aws.Int64(0),
+					DesiredSize: aws.Int64(0),
+				},
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			}).Return(nil, nil)
+
+			Expect(m.ScaleToZero(ng, true)).To(Succeed())
+			Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "TagResource", 1)).To(BeTrue())
+		})
+
+		It("does not overwrite an already preserved scaling config", func() {
+			mockDescribeNodegroup(map[string]*string{
+				api.NodeGroupPreservedScalingConfigTag: aws.String("2:2:4"),
+			})
+			p.MockEKS().On("UpdateNodegroupConfig", mock.Anything).Return(nil, nil)
+
+			Expect(m.ScaleToZero(ng, true)).To(Succeed())
+			Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "TagResource", mock.Anything)).To(BeTrue())
+		})
+
+		It("restores the preserved scaling config and removes the tag", func() {
+			mockDescribeNodegroup(map[string]*string{
+				api.NodeGroupPreservedScalingConfigTag: aws.String("2:2:4"),
+			})
+			p.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
+				ScalingConfig: &awseks.NodegroupScalingConfig{
+					MinSize:     aws.Int64(2),
+					DesiredSize: aws.Int64(2),
+					MaxSize:     aws.Int64(4),
+				},
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			}).Return(nil, nil)
+			p.MockEKS().On("UntagResource", &awseks.UntagResourceInput{
+				ResourceArn: &ngARN,
+				TagKeys:     aws.StringSlice([]string{api.NodeGroupPreservedScalingConfigTag}),
+			}).Return(nil, nil)
+
+			Expect(m.RestoreScale(ng)).To(Succeed())
+			Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "UntagResource", 1)).To(BeTrue())
+		})
+
+		It("fails to restore a nodegroup without a preserved scaling config", func() {
+			mockDescribeNodegroup(nil)
+
+			err := m.RestoreScale(ng)
+			Expect(err).To(MatchError(ContainSubstring("has no preserved scaling config")))
+		})
+
+		It("fails for nodegroups that are not managed", func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(nil, awserr.New(awseks.ErrCodeResourceNotFoundException, "not found", nil))
+
+			err := m.ScaleToZero(ng, true)
+			Expect(err).To(MatchError(ContainSubstring("only supported for managed nodegroups")))
+		})
+	})
 })
@@ -10,19 +10,19 @@ import (
 	"github.com/blang/semver"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
-	"github.com/weaveworks/goformation/v4"
-	"github.com/weaveworks/goformation/v4/cloudformation"
 
 	"github.com/weaveworks/eksctl/pkg/ami"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/goformation"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfneks "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/eks"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"github.com/weaveworks/eksctl/pkg/managed"
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 	"github.com/weaveworks/eksctl/pkg/version"
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfneks "github.com/weaveworks/goformation/v4/cloudformation/eks"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 )
 
 // UpgradeOptions contains options to configure nodegroup upgrades
@@ -40,6 +40,8 @@ type UpgradeOptions struct {
 	ReleaseVersion string
 	// Wait for the upgrade to finish
 	Wait bool
+	// UpdateConfig sets how many nodes are updated in parallel, before upgrading the nodegroup
+	UpdateConfig *api.NodeGroupUpdateConfig
 }
 
 func (m *Manager) Upgrade(options UpgradeOptions) error {
@@ -66,6 +68,12 @@ func (m *Manager) Upgrade(options UpgradeOptions) error {
 		return err
 	}
 
+	if options.UpdateConfig != nil {
+		if err := m.applyUpdateConfig(options); err != nil {
+			return err
+		}
+	}
+
 	if hasStacks {
 		return m.upgradeUsingStack(options, nodegroupOutput.Nodegroup)
 	}
@@ -127,6 +135,43 @@ func (m *Manager) upgradeUsingAPI(options UpgradeOptions, nodegroup *eks.Nodegro
 	return nil
 }
 
+// applyUpdateConfig updates the number of nodes updated in parallel, and waits for the update to complete as a
+// nodegroup cannot be upgraded while another update is in progress
+func (m *Manager) applyUpdateConfig(options UpgradeOptions) error {
+	ng := &api.ManagedNodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{Name: options.NodegroupName},
+		UpdateConfig:  options.UpdateConfig,
+	}
+	updateConfig, err := updateUpdateConfig(ng)
+	if err != nil {
+		return err
+	}
+	if _, err := m.ctl.Provider.EKS().UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+		UpdateConfig:  updateConfig,
+		ClusterName:   &m.cfg.Metadata.Name,
+		NodegroupName: &options.NodegroupName,
+	}); err != nil {
+		return fmt.Errorf("failed to update nodegroup %s: %w", options.NodegroupName, err)
+	}
+
+	newRequest := func() *request.Request {
+		req, _ := m.ctl.Provider.EKS().DescribeNodegroupRequest(&eks.DescribeNodegroupInput{
+			ClusterName:   &m.cfg.Metadata.Name,
+			NodegroupName: &options.NodegroupName,
+		})
+		return req
+	}
+	acceptors := waiters.MakeAcceptors(
+		"Nodegroup.Status",
+		eks.NodegroupStatusActive,
+		[]string{
+			eks.NodegroupStatusDegraded,
+		},
+	)
+	msg := fmt.Sprintf("waiting for update config of nodegroup %q to be updated", options.NodegroupName)
+	return m.wait(options.NodegroupName, msg, acceptors, newRequest, m.ctl.Provider.WaitTimeout(), nil)
+}
+
 func (m *Manager) waitForUpgrade(options UpgradeOptions) error {
 
 	newRequest := func() *request.Request {
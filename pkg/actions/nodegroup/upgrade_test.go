@@ -1,12 +1,16 @@
 package nodegroup_test
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
@@ -81,6 +85,26 @@ var _ = Describe("Upgrade", func() {
 				options.LaunchTemplateVersion = "v2"
 				Expect(m.Upgrade(options)).To(Succeed())
 			})
+
+			It("sets the update config and waits for it to be updated before upgrading the nodegroup", func() {
+				p.MockEKS().On("UpdateNodegroupConfig", &awseks.UpdateNodegroupConfigInput{
+					ClusterName:   aws.String(clusterName),
+					NodegroupName: aws.String(ngName),
+					UpdateConfig:  &awseks.NodegroupUpdateConfig{MaxUnavailablePercentage: aws.Int64(25)},
+				}).Return(&awseks.UpdateNodegroupConfigOutput{}, nil)
+				waited := false
+				m.SetWaiter(func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error {
+					p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupVersion", mock.Anything)
+					waited = true
+					return nil
+				})
+
+				options.LaunchTemplateVersion = "v2"
+				options.UpdateConfig = &api.NodeGroupUpdateConfig{MaxUnavailablePercentage: aws.Int(25)}
+				Expect(m.Upgrade(options)).To(Succeed())
+				Expect(waited).To(BeTrue())
+				p.MockEKS().AssertCalled(GinkgoT(), "UpdateNodegroupVersion", mock.Anything)
+			})
 		})
 
 		When("launchTemplate Name is set", func() {
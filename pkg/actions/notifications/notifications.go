@@ -0,0 +1,137 @@
+package notifications
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+// Source is the source of the events sent by eksctl
+const Source = "eksctl"
+
+// Detail types of the events sent by eksctl
+const (
+	DetailTypeClusterCreated         = "Cluster Created"
+	DetailTypeClusterDeletionStarted = "Cluster Deletion Started"
+	DetailTypeNodeGroupUpgraded      = "Nodegroup Upgraded"
+)
+
+// Detail is the detail of the events sent by eksctl
+type Detail struct {
+	ClusterName   string `json:"clusterName"`
+	Region        string `json:"region"`
+	EksctlVersion string `json:"eksctlVersion"`
+
+	KubernetesVersion     string `json:"kubernetesVersion,omitempty"`
+	NodeGroupName         string `json:"nodeGroupName,omitempty"`
+	ReleaseVersion        string `json:"releaseVersion,omitempty"`
+	LaunchTemplateVersion string `json:"launchTemplateVersion,omitempty"`
+}
+
+// NodeGroupUpgrade describes an upgrade of a nodegroup
+type NodeGroupUpgrade struct {
+	NodeGroupName         string
+	KubernetesVersion     string
+	ReleaseVersion        string
+	LaunchTemplateVersion string
+}
+
+// Notifier sends notifications about the operations on a cluster to the EventBridge event bus
+// and the webhook of the cluster notifications. It does nothing when notifications are not configured
+type Notifier struct {
+	cfg            *api.ClusterConfig
+	eventBridgeAPI eventbridgeiface.EventBridgeAPI
+	httpClient     *http.Client
+}
+
+// New creates a Notifier for the cluster.
+func New(cfg *api.ClusterConfig, eventBridgeAPI eventbridgeiface.EventBridgeAPI) *Notifier {
+	return &Notifier{
+		cfg:            cfg,
+		eventBridgeAPI: eventBridgeAPI,
+		httpClient:     &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// ClusterCreated sends an event once the cluster and its nodegroups are created.
+func (n *Notifier) ClusterCreated() {
+	detail := n.newDetail()
+	detail.KubernetesVersion = n.cfg.Metadata.Version
+	n.send(DetailTypeClusterCreated, detail)
+}
+
+// ClusterDeletionStarted sends an event before the resources of the cluster are deleted.
+func (n *Notifier) ClusterDeletionStarted() {
+	n.send(DetailTypeClusterDeletionStarted, n.newDetail())
+}
+
+// NodeGroupUpgraded sends an event once a nodegroup is upgraded.
+func (n *Notifier) NodeGroupUpgraded(upgrade NodeGroupUpgrade) {
+	detail := n.newDetail()
+	detail.NodeGroupName = upgrade.NodeGroupName
+	detail.KubernetesVersion = upgrade.KubernetesVersion
+	detail.ReleaseVersion = upgrade.ReleaseVersion
+	detail.LaunchTemplateVersion = upgrade.LaunchTemplateVersion
+	n.send(DetailTypeNodeGroupUpgraded, detail)
+}
+
+func (n *Notifier) newDetail() Detail {
+	return Detail{
+		ClusterName:   n.cfg.Metadata.Name,
+		Region:        n.cfg.Metadata.Region,
+		EksctlVersion: version.GetVersion(),
+	}
+}
+
+// send puts the event on the event bus, failing to do so is logged as the operation has already succeeded
+func (n *Notifier) send(detailType string, detail Detail) {
+	if n.cfg.Notifications == nil || n.cfg.Notifications.EventBusName == "" {
+		return
+	}
+	eventBusName := n.cfg.Notifications.EventBusName
+	if err := n.putEvent(eventBusName, detailType, detail); err != nil {
+		logger.Warning("failed to send %q event to event bus %q: %v", detailType, eventBusName, err)
+		return
+	}
+	logger.Info("sent %q event to event bus %q", detailType, eventBusName)
+}
+
+func (n *Notifier) putEvent(eventBusName, detailType string, detail Detail) error {
+	detailJSON, err := json.Marshal(detail)
+	if err != nil {
+		return err
+	}
+	entry := &eventbridge.PutEventsRequestEntry{
+		EventBusName: aws.String(eventBusName),
+		Source:       aws.String(Source),
+		DetailType:   aws.String(detailType),
+		Detail:       aws.String(string(detailJSON)),
+	}
+	if n.cfg.Status != nil && n.cfg.Status.ARN != "" {
+		entry.Resources = aws.StringSlice([]string{n.cfg.Status.ARN})
+	}
+
+	output, err := n.eventBridgeAPI.PutEvents(&eventbridge.PutEventsInput{
+		Entries: []*eventbridge.PutEventsRequestEntry{entry},
+	})
+	if err != nil {
+		return err
+	}
+	if aws.Int64Value(output.FailedEntryCount) > 0 {
+		for _, result := range output.Entries {
+			if result.ErrorCode != nil {
+				return fmt.Errorf("%s: %s", aws.StringValue(result.ErrorCode), aws.StringValue(result.ErrorMessage))
+			}
+		}
+		return fmt.Errorf("event was not accepted")
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package notifications_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/notifications"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Notifier", func() {
+	var (
+		provider *mockprovider.MockProvider
+		cfg      *api.ClusterConfig
+		notifier *notifications.Notifier
+		entries  []*eventbridge.PutEventsRequestEntry
+	)
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Version = "1.21"
+		cfg.Status = &api.ClusterStatus{ARN: "arn:aws:eks:us-west-2:123456789012:cluster/cluster-1"}
+		cfg.Notifications = &api.Notifications{EventBusName: "platform"}
+		notifier = notifications.New(cfg, provider.EventBridge())
+		entries = nil
+	})
+
+	mockPutEvents := func(output *eventbridge.PutEventsOutput, err error) {
+		provider.MockEventBridge().On("PutEvents", mock.Anything).Run(func(args mock.Arguments) {
+			entries = append(entries, args.Get(0).(*eventbridge.PutEventsInput).Entries...)
+		}).Return(output, err)
+	}
+
+	decodeDetail := func(entry *eventbridge.PutEventsRequestEntry) notifications.Detail {
+		var detail notifications.Detail
+		ExpectWithOffset(1, json.Unmarshal([]byte(aws.StringValue(entry.Detail)), &detail)).To(Succeed())
+		return detail
+	}
+
+	It("sends an event when the cluster is created", func() {
+		mockPutEvents(&eventbridge.PutEventsOutput{FailedEntryCount: aws.Int64(0)}, nil)
+		notifier.ClusterCreated()
+
+		Expect(entries).To(HaveLen(1))
+		Expect(aws.StringValue(entries[0].EventBusName)).To(Equal("platform"))
+		Expect(aws.StringValue(entries[0].Source)).To(Equal("eksctl"))
+		Expect(aws.StringValue(entries[0].DetailType)).To(Equal("Cluster Created"))
+		Expect(aws.StringValueSlice(entries[0].Resources)).To(Equal([]string{"arn:aws:eks:us-west-2:123456789012:cluster/cluster-1"}))
+		detail := decodeDetail(entries[0])
+		Expect(detail.ClusterName).To(Equal("cluster-1"))
+		Expect(detail.Region).To(Equal("us-west-2"))
+		Expect(detail.KubernetesVersion).To(Equal("1.21"))
+		Expect(detail.EksctlVersion).NotTo(BeEmpty())
+	})
+
+	It("sends an event when a nodegroup is upgraded", func() {
+		mockPutEvents(&eventbridge.PutEventsOutput{FailedEntryCount: aws.Int64(0)}, nil)
+		notifier.NodeGroupUpgraded(notifications.NodeGroupUpgrade{
+			NodeGroupName:     "ng-1",
+			KubernetesVersion: "1.22",
+		})
+
+		Expect(entries).To(HaveLen(1))
+		Expect(aws.StringValue(entries[0].DetailType)).To(Equal("Nodegroup Upgraded"))
+		detail := decodeDetail(entries[0])
+		Expect(detail.NodeGroupName).To(Equal("ng-1"))
+		Expect(detail.KubernetesVersion).To(Equal("1.22"))
+		Expect(detail.ReleaseVersion).To(BeEmpty())
+	})
+
+	It("sends an event when the deletion of the cluster starts", func() {
+		mockPutEvents(&eventbridge.PutEventsOutput{FailedEntryCount: aws.Int64(0)}, nil)
+		notifier.ClusterDeletionStarted()
+
+		Expect(entries).To(HaveLen(1))
+		Expect(aws.StringValue(entries[0].DetailType)).To(Equal("Cluster Deletion Started"))
+	})
+
+	It("does not send events when notifications are not configured", func() {
+		cfg.Notifications = nil
+		notifier.ClusterCreated()
+
+		provider.MockEventBridge().AssertNotCalled(GinkgoT(), "PutEvents", mock.Anything)
+	})
+
+	It("does not fail when the event is not sent", func() {
+		mockPutEvents(nil, errors.New("access denied"))
+		notifier.ClusterDeletionStarted()
+
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("does not fail when the event is rejected", func() {
+		mockPutEvents(&eventbridge.PutEventsOutput{
+			FailedEntryCount: aws.Int64(1),
+			Entries: []*eventbridge.PutEventsResultEntry{{
+				ErrorCode:    aws.String("InternalFailure"),
+				ErrorMessage: aws.String("internal failure"),
+			}},
+		}, nil)
+		notifier.ClusterCreated()
+
+		Expect(entries).To(HaveLen(1))
+	})
+})
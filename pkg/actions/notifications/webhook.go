@@ -0,0 +1,131 @@
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+)
+
+// Statuses of the operations posted to the webhook
+const (
+	StatusStarted   = "started"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+const (
+	webhookTimeout = 10 * time.Second
+
+	// maxErrorSummaryLength keeps the messages of failed operations readable in chat clients
+	maxErrorSummaryLength = 500
+)
+
+// WebhookMessage is the JSON message posted to the webhook
+type WebhookMessage struct {
+	// Text summarises the message, as expected by Slack incoming webhooks
+	Text string `json:"text"`
+
+	Operation     string `json:"operation"`
+	Status        string `json:"status"`
+	ClusterName   string `json:"clusterName"`
+	Region        string `json:"region"`
+	EksctlVersion string `json:"eksctlVersion"`
+
+	Duration        string  `json:"duration,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+	Error           string  `json:"error,omitempty"`
+}
+
+// Operation is an operation on the cluster whose start and outcome are posted to the webhook
+type Operation struct {
+	notifier  *Notifier
+	name      string
+	startTime time.Time
+}
+
+// StartOperation posts to the webhook that the operation has started, e.g. `creation`.
+func (n *Notifier) StartOperation(name string) *Operation {
+	operation := &Operation{
+		notifier:  n,
+		name:      name,
+		startTime: time.Now(),
+	}
+	operation.post(StatusStarted, nil)
+	return operation
+}
+
+// Done posts to the webhook whether the operation succeeded, with its duration.
+func (o *Operation) Done(err error) {
+	if err != nil {
+		o.post(StatusFailed, err)
+		return
+	}
+	o.post(StatusSucceeded, nil)
+}
+
+func (o *Operation) post(status string, operationErr error) {
+	n := o.notifier
+	if n.cfg.Notifications == nil || n.cfg.Notifications.WebhookURL == "" {
+		return
+	}
+
+	detail := n.newDetail()
+	message := WebhookMessage{
+		Operation:     o.name,
+		Status:        status,
+		ClusterName:   detail.ClusterName,
+		Region:        detail.Region,
+		EksctlVersion: detail.EksctlVersion,
+	}
+	message.Text = fmt.Sprintf("eksctl %s of cluster %q in %q %s", o.name, message.ClusterName, message.Region, status)
+	if status != StatusStarted {
+		duration := time.Since(o.startTime).Round(time.Second)
+		message.Duration = duration.String()
+		message.DurationSeconds = duration.Seconds()
+		message.Text += " after " + message.Duration
+	}
+	if operationErr != nil {
+		message.Error = summariseError(operationErr)
+		message.Text += ": " + message.Error
+	}
+
+	if err := n.postWebhook(message); err != nil {
+		logger.Warning("failed to notify webhook that %s %s: %v", o.name, status, err)
+	}
+}
+
+func (n *Notifier) postWebhook(message WebhookMessage) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	resp, err := n.httpClient.Post(n.cfg.Notifications.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		// do not log the URL, which usually holds a secret token
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return urlErr.Err
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// summariseError returns the first line of the error, truncated
+func summariseError(err error) string {
+	summary := strings.TrimSpace(strings.SplitN(err.Error(), "\n", 2)[0])
+	if len(summary) > maxErrorSummaryLength {
+		summary = summary[:maxErrorSummaryLength] + "..."
+	}
+	return summary
+}
@@ -0,0 +1,96 @@
+package notifications_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/notifications"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Webhook", func() {
+	var (
+		provider   *mockprovider.MockProvider
+		cfg        *api.ClusterConfig
+		notifier   *notifications.Notifier
+		server     *httptest.Server
+		statusCode int
+		messages   []notifications.WebhookMessage
+	)
+
+	BeforeEach(func() {
+		statusCode = http.StatusOK
+		messages = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			var message notifications.WebhookMessage
+			Expect(json.NewDecoder(r.Body).Decode(&message)).To(Succeed())
+			messages = append(messages, message)
+			w.WriteHeader(statusCode)
+		}))
+
+		provider = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Notifications = &api.Notifications{WebhookURL: server.URL}
+		notifier = notifications.New(cfg, provider.EventBridge())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("posts the start and the success of an operation", func() {
+		operation := notifier.StartOperation("creation")
+		operation.Done(nil)
+
+		Expect(messages).To(HaveLen(2))
+		Expect(messages[0].Status).To(Equal("started"))
+		Expect(messages[0].Operation).To(Equal("creation"))
+		Expect(messages[0].ClusterName).To(Equal("cluster-1"))
+		Expect(messages[0].Region).To(Equal("us-west-2"))
+		Expect(messages[0].Text).To(Equal(`eksctl creation of cluster "cluster-1" in "us-west-2" started`))
+		Expect(messages[0].Duration).To(BeEmpty())
+
+		Expect(messages[1].Status).To(Equal("succeeded"))
+		Expect(messages[1].Duration).To(Equal("0s"))
+		Expect(messages[1].Text).To(Equal(`eksctl creation of cluster "cluster-1" in "us-west-2" succeeded after 0s`))
+		Expect(messages[1].Error).To(BeEmpty())
+	})
+
+	It("posts the failure of an operation with a summary of the error", func() {
+		operation := notifier.StartOperation(`upgrade of nodegroup "ng-1"`)
+		operation.Done(errors.New("waiting for nodegroup upgrade: timed out\nsee the CloudFormation console"))
+
+		Expect(messages).To(HaveLen(2))
+		Expect(messages[1].Status).To(Equal("failed"))
+		Expect(messages[1].Error).To(Equal("waiting for nodegroup upgrade: timed out"))
+		Expect(messages[1].Text).To(HaveSuffix("failed after 0s: waiting for nodegroup upgrade: timed out"))
+	})
+
+	It("does not fail the operation when the webhook returns an error", func() {
+		statusCode = http.StatusInternalServerError
+		operation := notifier.StartOperation("deletion")
+		operation.Done(nil)
+
+		Expect(messages).To(HaveLen(2))
+	})
+
+	It("does not post when no webhook is configured", func() {
+		cfg.Notifications = &api.Notifications{EventBusName: "platform"}
+		notifier.StartOperation("deletion").Done(nil)
+
+		Expect(messages).To(BeEmpty())
+		provider.MockEventBridge().AssertNotCalled(GinkgoT(), "PutEvents", mock.Anything)
+	})
+})
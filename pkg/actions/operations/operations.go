@@ -0,0 +1,90 @@
+package operations
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/utils/operation"
+)
+
+// StackOperation is the change of a stack of the cluster by a run of an eksctl command, as recorded by the client
+// request token of the events of the stack
+type StackOperation struct {
+	ID        string    `json:"id"`
+	Command   string    `json:"command"`
+	StartedAt time.Time `json:"startedAt"`
+	StackName string    `json:"stackName"`
+	// Status is the latest status of the stack during the operation
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// StackDescriber describes the stacks of a cluster and their events
+type StackDescriber interface {
+	DescribeStacks() ([]*manager.Stack, error)
+	DescribeStackEvents(i *manager.Stack) ([]*cloudformation.StackEvent, error)
+}
+
+// List returns the operations of eksctl on the stacks of the cluster, the latest first. When id is set, only the
+// stack operations of that operation are returned
+func List(stackManager StackDescriber, id string) ([]StackOperation, error) {
+	stacks, err := stackManager.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	var stackOperations []StackOperation
+	for _, stack := range stacks {
+		events, err := stackManager.DescribeStackEvents(stack)
+		if err != nil {
+			return nil, err
+		}
+		stackOperations = append(stackOperations, fromEvents(aws.StringValue(stack.StackName), events, id)...)
+	}
+
+	sort.SliceStable(stackOperations, func(i, j int) bool {
+		a, b := stackOperations[i], stackOperations[j]
+		if !a.StartedAt.Equal(b.StartedAt) {
+			return a.StartedAt.After(b.StartedAt)
+		}
+		if a.ID != b.ID {
+			return a.ID > b.ID
+		}
+		return a.StackName < b.StackName
+	})
+	return stackOperations, nil
+}
+
+// fromEvents returns the operations of eksctl on a stack from its events, which CloudFormation returns the latest
+// first
+func fromEvents(stackName string, events []*cloudformation.StackEvent, id string) []StackOperation {
+	var stackOperations []StackOperation
+	seen := map[string]bool{}
+	for _, event := range events {
+		if aws.StringValue(event.LogicalResourceId) != stackName {
+			continue
+		}
+		op, ok := operation.ParseToken(aws.StringValue(event.ClientRequestToken))
+		if !ok || seen[op.ID] || (id != "" && op.ID != id) {
+			continue
+		}
+		seen[op.ID] = true
+		startedAt, err := op.StartedAt()
+		if err != nil {
+			continue
+		}
+		stackOperations = append(stackOperations, StackOperation{
+			ID:        op.ID,
+			Command:   op.Command,
+			StartedAt: startedAt,
+			StackName: stackName,
+			Status:    aws.StringValue(event.ResourceStatus),
+			UpdatedAt: aws.TimeValue(event.Timestamp),
+		})
+	}
+	return stackOperations
+}
@@ -0,0 +1,97 @@
+package operations_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/operations"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+)
+
+var _ = Describe("List", func() {
+	var (
+		stackManager *fakes.FakeStackManager
+		clusterAt    time.Time
+		nodegroupAt  time.Time
+	)
+
+	event := func(stackName, logicalID, status, token string, at time.Time) *cloudformation.StackEvent {
+		return &cloudformation.StackEvent{
+			StackName:          aws.String(stackName),
+			LogicalResourceId:  aws.String(logicalID),
+			ResourceStatus:     aws.String(status),
+			ClientRequestToken: aws.String(token),
+			Timestamp:          aws.Time(at),
+		}
+	}
+
+	BeforeEach(func() {
+		stackManager = &fakes.FakeStackManager{}
+		clusterAt = time.Date(2022, 1, 15, 10, 30, 0, 0, time.UTC)
+		nodegroupAt = time.Date(2022, 1, 16, 8, 0, 0, 0, time.UTC)
+
+		stackManager.DescribeStacksReturns([]*cloudformation.Stack{
+			{StackName: aws.String("eksctl-cluster-1-cluster")},
+			{StackName: aws.String("eksctl-cluster-1-nodegroup-ng-1")},
+		}, nil)
+		stackManager.DescribeStackEventsReturnsOnCall(0, []*cloudformation.StackEvent{
+			event("eksctl-cluster-1-cluster", "eksctl-cluster-1-cluster", "UPDATE_COMPLETE", "eksctl-20220116080000beef-1-create-nodegroup", nodegroupAt.Add(time.Minute)),
+			event("eksctl-cluster-1-cluster", "eksctl-cluster-1-cluster", "CREATE_COMPLETE", "eksctl-20220115103000abcd-1-create-cluster", clusterAt.Add(15*time.Minute)),
+			event("eksctl-cluster-1-cluster", "ControlPlane", "CREATE_COMPLETE", "eksctl-20220115103000abcd-1-create-cluster", clusterAt.Add(14*time.Minute)),
+			event("eksctl-cluster-1-cluster", "eksctl-cluster-1-cluster", "CREATE_IN_PROGRESS", "eksctl-20220115103000abcd-1-create-cluster", clusterAt),
+			event("eksctl-cluster-1-cluster", "eksctl-cluster-1-cluster", "UPDATE_COMPLETE", "Console-UpdateStack-1234", clusterAt.Add(-time.Hour)),
+		}, nil)
+		stackManager.DescribeStackEventsReturnsOnCall(1, []*cloudformation.StackEvent{
+			event("eksctl-cluster-1-nodegroup-ng-1", "eksctl-cluster-1-nodegroup-ng-1", "CREATE_FAILED", "eksctl-20220116080000beef-2-create-nodegroup", nodegroupAt.Add(5*time.Minute)),
+		}, nil)
+	})
+
+	It("returns the operations of eksctl on the stacks, the latest first", func() {
+		stackOperations, err := operations.List(stackManager, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stackOperations).To(Equal([]operations.StackOperation{
+			{
+				ID:        "20220116080000beef",
+				Command:   "create-nodegroup",
+				StartedAt: nodegroupAt,
+				StackName: "eksctl-cluster-1-cluster",
+				Status:    "UPDATE_COMPLETE",
+				UpdatedAt: nodegroupAt.Add(time.Minute),
+			},
+			{
+				ID:        "20220116080000beef",
+				Command:   "create-nodegroup",
+				StartedAt: nodegroupAt,
+				StackName: "eksctl-cluster-1-nodegroup-ng-1",
+				Status:    "CREATE_FAILED",
+				UpdatedAt: nodegroupAt.Add(5 * time.Minute),
+			},
+			{
+				ID:        "20220115103000abcd",
+				Command:   "create-cluster",
+				StartedAt: clusterAt,
+				StackName: "eksctl-cluster-1-cluster",
+				Status:    "CREATE_COMPLETE",
+				UpdatedAt: clusterAt.Add(15 * time.Minute),
+			},
+		}))
+	})
+
+	It("returns only the stack operations of the given operation", func() {
+		stackOperations, err := operations.List(stackManager, "20220115103000abcd")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stackOperations).To(HaveLen(1))
+		Expect(stackOperations[0].Command).To(Equal("create-cluster"))
+	})
+
+	It("returns errors describing the events of stacks", func() {
+		stackManager.DescribeStackEventsReturnsOnCall(1, nil, errors.New("throttled"))
+		_, err := operations.List(stackManager, "")
+		Expect(err).To(MatchError("throttled"))
+	})
+})
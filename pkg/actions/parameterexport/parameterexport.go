@@ -0,0 +1,116 @@
+package parameterexport
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Names of the parameters written under the path of parameterExport
+const (
+	ParameterEndpoint                    = "endpoint"
+	ParameterARN                         = "arn"
+	ParameterCertificateAuthorityData    = "certificate-authority-data"
+	ParameterOIDCIssuer                  = "oidc-issuer"
+	ParameterVPCID                       = "vpc-id"
+	ParameterClusterSecurityGroupID      = "cluster-security-group-id"
+	ParameterControlPlaneSecurityGroupID = "control-plane-security-group-id"
+	ParameterSharedNodeSecurityGroupID   = "shared-node-security-group-id"
+	ParameterPrivateSubnetIDs            = "private-subnet-ids"
+	ParameterPublicSubnetIDs             = "public-subnet-ids"
+)
+
+type parameter struct {
+	name          string
+	value         string
+	parameterType string
+}
+
+// Exporter writes the metadata of a cluster to SSM Parameter Store.
+type Exporter struct {
+	cfg    *api.ClusterConfig
+	ssmAPI ssmiface.SSMAPI
+}
+
+// New creates an Exporter for the cluster.
+func New(cfg *api.ClusterConfig, ssmAPI ssmiface.SSMAPI) *Exporter {
+	return &Exporter{
+		cfg:    cfg,
+		ssmAPI: ssmAPI,
+	}
+}
+
+// Export writes the metadata of the cluster to parameters under parameterExport.path, overwriting the
+// existing parameters. Metadata the cluster does not have, e.g. public subnets in a private-only VPC, is
+// not written. It does nothing when parameterExport is not set
+func (e *Exporter) Export(cluster *awseks.Cluster) error {
+	if e.cfg.ParameterExport == nil {
+		return nil
+	}
+	exportPath := e.cfg.ParameterExport.Path
+	for _, p := range e.parameters(cluster) {
+		name := path.Join(exportPath, p.name)
+		if _, err := e.ssmAPI.PutParameter(&ssm.PutParameterInput{
+			Name:        aws.String(name),
+			Value:       aws.String(p.value),
+			Type:        aws.String(p.parameterType),
+			Description: aws.String("Exported by eksctl for cluster " + e.cfg.Metadata.Name),
+			Overwrite:   aws.Bool(true),
+		}); err != nil {
+			return errors.Wrapf(err, "writing SSM parameter %q", name)
+		}
+	}
+	logger.Success("exported metadata of cluster %q to SSM parameters under %q", e.cfg.Metadata.Name, exportPath)
+	return nil
+}
+
+func (e *Exporter) parameters(cluster *awseks.Cluster) []parameter {
+	var parameters []parameter
+	add := func(name, value, parameterType string) {
+		if value != "" {
+			parameters = append(parameters, parameter{name: name, value: value, parameterType: parameterType})
+		}
+	}
+
+	add(ParameterEndpoint, aws.StringValue(cluster.Endpoint), ssm.ParameterTypeString)
+	add(ParameterARN, aws.StringValue(cluster.Arn), ssm.ParameterTypeString)
+	if cluster.CertificateAuthority != nil {
+		add(ParameterCertificateAuthorityData, aws.StringValue(cluster.CertificateAuthority.Data), ssm.ParameterTypeString)
+	}
+	if cluster.Identity != nil && cluster.Identity.Oidc != nil {
+		add(ParameterOIDCIssuer, aws.StringValue(cluster.Identity.Oidc.Issuer), ssm.ParameterTypeString)
+	}
+	if vpcConfig := cluster.ResourcesVpcConfig; vpcConfig != nil {
+		add(ParameterVPCID, aws.StringValue(vpcConfig.VpcId), ssm.ParameterTypeString)
+		add(ParameterClusterSecurityGroupID, aws.StringValue(vpcConfig.ClusterSecurityGroupId), ssm.ParameterTypeString)
+	}
+
+	vpc := e.cfg.VPC
+	add(ParameterControlPlaneSecurityGroupID, vpc.SecurityGroup, ssm.ParameterTypeString)
+	add(ParameterSharedNodeSecurityGroupID, vpc.SharedNodeSecurityGroup, ssm.ParameterTypeString)
+	if vpc.Subnets != nil {
+		add(ParameterPrivateSubnetIDs, subnetIDs(vpc.Subnets.Private), ssm.ParameterTypeStringList)
+		add(ParameterPublicSubnetIDs, subnetIDs(vpc.Subnets.Public), ssm.ParameterTypeStringList)
+	}
+	return parameters
+}
+
+func subnetIDs(subnets api.AZSubnetMapping) string {
+	var ids []string
+	for _, subnet := range subnets {
+		if subnet.ID != "" {
+			ids = append(ids, subnet.ID)
+		}
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
@@ -0,0 +1,13 @@
+package parameterexport_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestParameterExport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Parameter Export Suite")
+}
@@ -0,0 +1,100 @@
+package parameterexport_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/parameterexport"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Exporter", func() {
+	var (
+		provider *mockprovider.MockProvider
+		cfg      *api.ClusterConfig
+		cluster  *awseks.Cluster
+		inputs   map[string]*ssm.PutParameterInput
+	)
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.ParameterExport = &api.ParameterExport{Path: "/platform/eks"}
+		cfg.VPC.SecurityGroup = "sg-control-plane"
+		cfg.VPC.SharedNodeSecurityGroup = "sg-nodes"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2b": {ID: "subnet-private-b"},
+				"us-west-2a": {ID: "subnet-private-a"},
+			}),
+			Public: api.NewAZSubnetMapping(),
+		}
+		cluster = &awseks.Cluster{
+			Arn:                  aws.String("arn:aws:eks:us-west-2:123456789012:cluster/cluster-1"),
+			Endpoint:             aws.String("https://ABCDEF.gr7.us-west-2.eks.amazonaws.com"),
+			CertificateAuthority: &awseks.Certificate{Data: aws.String("Y2EtZGF0YQ==")},
+			Identity: &awseks.Identity{Oidc: &awseks.OIDC{
+				Issuer: aws.String("https://oidc.eks.us-west-2.amazonaws.com/id/ABCDEF"),
+			}},
+			ResourcesVpcConfig: &awseks.VpcConfigResponse{
+				VpcId:                  aws.String("vpc-1"),
+				ClusterSecurityGroupId: aws.String("sg-cluster"),
+			},
+		}
+		inputs = map[string]*ssm.PutParameterInput{}
+	})
+
+	mockPutParameter := func(err error) {
+		provider.MockSSM().On("PutParameter", mock.Anything).Run(func(args mock.Arguments) {
+			input := args.Get(0).(*ssm.PutParameterInput)
+			inputs[aws.StringValue(input.Name)] = input
+		}).Return(&ssm.PutParameterOutput{}, err)
+	}
+
+	value := func(name string) string {
+		ExpectWithOffset(1, inputs).To(HaveKey(name))
+		return aws.StringValue(inputs[name].Value)
+	}
+
+	It("writes the metadata of the cluster under the path", func() {
+		mockPutParameter(nil)
+		Expect(parameterexport.New(cfg, provider.SSM()).Export(cluster)).To(Succeed())
+
+		Expect(inputs).To(HaveLen(9))
+		Expect(value("/platform/eks/endpoint")).To(Equal("https://ABCDEF.gr7.us-west-2.eks.amazonaws.com"))
+		Expect(value("/platform/eks/arn")).To(Equal("arn:aws:eks:us-west-2:123456789012:cluster/cluster-1"))
+		Expect(value("/platform/eks/certificate-authority-data")).To(Equal("Y2EtZGF0YQ=="))
+		Expect(value("/platform/eks/oidc-issuer")).To(Equal("https://oidc.eks.us-west-2.amazonaws.com/id/ABCDEF"))
+		Expect(value("/platform/eks/vpc-id")).To(Equal("vpc-1"))
+		Expect(value("/platform/eks/cluster-security-group-id")).To(Equal("sg-cluster"))
+		Expect(value("/platform/eks/control-plane-security-group-id")).To(Equal("sg-control-plane"))
+		Expect(value("/platform/eks/shared-node-security-group-id")).To(Equal("sg-nodes"))
+		Expect(value("/platform/eks/private-subnet-ids")).To(Equal("subnet-private-a,subnet-private-b"))
+		Expect(inputs).NotTo(HaveKey("/platform/eks/public-subnet-ids"))
+
+		Expect(aws.StringValue(inputs["/platform/eks/private-subnet-ids"].Type)).To(Equal(ssm.ParameterTypeStringList))
+		Expect(aws.StringValue(inputs["/platform/eks/endpoint"].Type)).To(Equal(ssm.ParameterTypeString))
+		Expect(aws.BoolValue(inputs["/platform/eks/endpoint"].Overwrite)).To(BeTrue())
+	})
+
+	It("does nothing when parameterExport is not set", func() {
+		cfg.ParameterExport = nil
+		Expect(parameterexport.New(cfg, provider.SSM()).Export(cluster)).To(Succeed())
+		provider.MockSSM().AssertNotCalled(GinkgoT(), "PutParameter", mock.Anything)
+	})
+
+	It("returns an error when a parameter cannot be written", func() {
+		mockPutParameter(errors.New("access denied"))
+		err := parameterexport.New(cfg, provider.SSM()).Export(cluster)
+		Expect(err).To(MatchError(ContainSubstring("access denied")))
+		Expect(err).To(MatchError(ContainSubstring("writing SSM parameter")))
+	})
+})
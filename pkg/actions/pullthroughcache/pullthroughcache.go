@@ -0,0 +1,69 @@
+package pullthroughcache
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+type Manager struct {
+	ecrAPI ecriface.ECRAPI
+}
+
+func New(ecrAPI ecriface.ECRAPI) *Manager {
+	return &Manager{
+		ecrAPI: ecrAPI,
+	}
+}
+
+// Create creates the pull-through cache rules that do not exist yet in the private ECR registry of the account.
+// Existing rules are left as they are, unless their repository prefix caches a different upstream registry
+func (m *Manager) Create(cache *api.PullThroughCache) error {
+	existing, err := m.existingRules()
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range cache.Rules {
+		prefix := rule.ECRRepositoryPrefix
+		if prefix == "" {
+			prefix = api.DefaultECRRepositoryPrefix(rule.UpstreamRegistryURL)
+		}
+		if upstream, ok := existing[prefix]; ok {
+			if upstream != rule.UpstreamRegistryURL {
+				return fmt.Errorf("repository prefix %q already caches %q, not %q", prefix, upstream, rule.UpstreamRegistryURL)
+			}
+			logger.Info("pull-through cache rule for %q with prefix %q already exists", rule.UpstreamRegistryURL, prefix)
+			continue
+		}
+
+		if _, err := m.ecrAPI.CreatePullThroughCacheRule(&ecr.CreatePullThroughCacheRuleInput{
+			EcrRepositoryPrefix: aws.String(prefix),
+			UpstreamRegistryUrl: aws.String(rule.UpstreamRegistryURL),
+		}); err != nil {
+			return errors.Wrapf(err, "creating pull-through cache rule for %q", rule.UpstreamRegistryURL)
+		}
+		logger.Info("created pull-through cache rule for %q with prefix %q", rule.UpstreamRegistryURL, prefix)
+	}
+	return nil
+}
+
+// existingRules returns the upstream registries of the existing rules, by repository prefix
+func (m *Manager) existingRules() (map[string]string, error) {
+	rules := map[string]string{}
+	if err := m.ecrAPI.DescribePullThroughCacheRulesPages(&ecr.DescribePullThroughCacheRulesInput{}, func(output *ecr.DescribePullThroughCacheRulesOutput, _ bool) bool {
+		for _, rule := range output.PullThroughCacheRules {
+			rules[aws.StringValue(rule.EcrRepositoryPrefix)] = aws.StringValue(rule.UpstreamRegistryUrl)
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "describing pull-through cache rules")
+	}
+	return rules, nil
+}
@@ -0,0 +1,13 @@
+package pullthroughcache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPullThroughCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Pull-through Cache Suite")
+}
@@ -0,0 +1,74 @@
+package pullthroughcache_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/pullthroughcache"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Pull-through cache", func() {
+	var (
+		provider *mockprovider.MockProvider
+		manager  *pullthroughcache.Manager
+		cache    *api.PullThroughCache
+	)
+
+	mockExistingRules := func(rules ...*ecr.PullThroughCacheRule) {
+		provider.MockECR().On("DescribePullThroughCacheRulesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ecr.DescribePullThroughCacheRulesOutput, bool) bool)
+			consume(&ecr.DescribePullThroughCacheRulesOutput{PullThroughCacheRules: rules}, true)
+		}).Return(nil)
+	}
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+		manager = pullthroughcache.New(provider.ECR())
+		cache = &api.PullThroughCache{
+			Rules: []api.PullThroughCacheRule{
+				{UpstreamRegistryURL: "quay.io"},
+				{UpstreamRegistryURL: "ghcr.io", ECRRepositoryPrefix: "github"},
+			},
+		}
+	})
+
+	It("creates the rules that do not exist", func() {
+		mockExistingRules(&ecr.PullThroughCacheRule{
+			EcrRepositoryPrefix: aws.String("quay"),
+			UpstreamRegistryUrl: aws.String("quay.io"),
+		})
+		provider.MockECR().On("CreatePullThroughCacheRule", &ecr.CreatePullThroughCacheRuleInput{
+			EcrRepositoryPrefix: aws.String("github"),
+			UpstreamRegistryUrl: aws.String("ghcr.io"),
+		}).Return(&ecr.CreatePullThroughCacheRuleOutput{}, nil)
+
+		Expect(manager.Create(cache)).To(Succeed())
+		provider.MockECR().AssertNumberOfCalls(GinkgoT(), "CreatePullThroughCacheRule", 1)
+	})
+
+	It("errors when a prefix caches a different upstream registry", func() {
+		mockExistingRules(&ecr.PullThroughCacheRule{
+			EcrRepositoryPrefix: aws.String("quay"),
+			UpstreamRegistryUrl: aws.String("public.ecr.aws"),
+		})
+
+		err := manager.Create(cache)
+		Expect(err).To(MatchError(`repository prefix "quay" already caches "public.ecr.aws", not "quay.io"`))
+		provider.MockECR().AssertNotCalled(GinkgoT(), "CreatePullThroughCacheRule", mock.Anything)
+	})
+
+	It("returns an error when creating a rule fails", func() {
+		mockExistingRules()
+		provider.MockECR().On("CreatePullThroughCacheRule", mock.Anything).Return(nil, errors.New("access denied"))
+
+		err := manager.Create(cache)
+		Expect(err).To(MatchError(ContainSubstring(`creating pull-through cache rule for "quay.io": access denied`)))
+	})
+})
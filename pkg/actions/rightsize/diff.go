@@ -0,0 +1,52 @@
+package rightsize
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Diff returns the recommended changes to the nodegroups of the cluster config file, as a diff
+func Diff(recommendations []Recommendation) string {
+	var nodeGroups, managedNodeGroups []string
+	for _, r := range recommendations {
+		if !r.Changed() {
+			continue
+		}
+		lines := []string{fmt.Sprintf(" - name: %s", r.NodeGroup)}
+		if r.RecommendedInstanceType != r.CurrentInstanceType {
+			lines = append(lines,
+				fmt.Sprintf("-  instanceType: %s", r.CurrentInstanceType),
+				fmt.Sprintf("+  instanceType: %s", r.RecommendedInstanceType),
+			)
+		}
+		if r.RecommendedNodes != r.CurrentNodes {
+			lines = append(lines,
+				fmt.Sprintf("-  desiredCapacity: %d", r.CurrentNodes),
+				fmt.Sprintf("+  desiredCapacity: %d", r.RecommendedNodes),
+			)
+		}
+		if r.Managed {
+			managedNodeGroups = append(managedNodeGroups, lines...)
+		} else {
+			nodeGroups = append(nodeGroups, lines...)
+		}
+	}
+
+	var diff strings.Builder
+	for _, section := range []struct {
+		key   string
+		lines []string
+	}{
+		{key: "nodeGroups", lines: nodeGroups},
+		{key: "managedNodeGroups", lines: managedNodeGroups},
+	} {
+		if len(section.lines) == 0 {
+			continue
+		}
+		fmt.Fprintf(&diff, " %s:\n", section.key)
+		for _, line := range section.lines {
+			fmt.Fprintln(&diff, line)
+		}
+	}
+	return diff.String()
+}
@@ -0,0 +1,240 @@
+package rightsize
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const instanceTypeLabel = "node.kubernetes.io/instance-type"
+
+// Usage is the absolute amount of resources used by a node
+type Usage struct {
+	// CPU is the number of cores used
+	CPU float64
+	// Memory is the number of bytes used
+	Memory float64
+}
+
+// UtilizationSource returns the usage of nodes, by node name
+type UtilizationSource interface {
+	Usage(nodes []corev1.Node) (map[string]Usage, error)
+}
+
+// Options configures the recommendations
+type Options struct {
+	// TargetUtilization is the fraction of the CPU and memory of the nodes the recommendations aim to use
+	TargetUtilization float64
+	// MinNodes is the minimum number of nodes recommended per nodegroup
+	MinNodes int
+}
+
+// Recommendation is the recommended instance type and number of nodes of a nodegroup
+type Recommendation struct {
+	NodeGroup string
+	Managed   bool
+
+	CurrentInstanceType string
+	CurrentNodes        int
+	// CPUUtilization and MemoryUtilization are the fractions of the capacity of the current nodes in use
+	CPUUtilization    float64
+	MemoryUtilization float64
+
+	RecommendedInstanceType string
+	RecommendedNodes        int
+}
+
+// Changed returns whether the recommendation differs from the current nodegroup
+func (r Recommendation) Changed() bool {
+	return r.RecommendedInstanceType != r.CurrentInstanceType || r.RecommendedNodes != r.CurrentNodes
+}
+
+type instanceType struct {
+	name   string
+	vCPUs  float64
+	memory float64
+}
+
+// Recommender recommends instance types and numbers of nodes for the nodegroups of a cluster
+type Recommender struct {
+	ec2API  ec2iface.EC2API
+	source  UtilizationSource
+	options Options
+}
+
+// New creates a Recommender using the utilization of nodes from source.
+func New(ec2API ec2iface.EC2API, source UtilizationSource, options Options) *Recommender {
+	return &Recommender{
+		ec2API:  ec2API,
+		source:  source,
+		options: options,
+	}
+}
+
+// Recommend returns a recommendation for each nodegroup of the nodes, sorted by nodegroup name. Nodes that
+// are not part of nodegroups are ignored.
+func (r *Recommender) Recommend(nodes []corev1.Node) ([]Recommendation, error) {
+	nodeGroups := map[string][]corev1.Node{}
+	for _, node := range nodes {
+		if name := node.Labels[api.NodeGroupNameLabel]; name != "" {
+			nodeGroups[name] = append(nodeGroups[name], node)
+		} else if name := node.Labels[api.EKSNodeGroupNameLabel]; name != "" {
+			nodeGroups[name] = append(nodeGroups[name], node)
+		}
+	}
+	if len(nodeGroups) == 0 {
+		return nil, errors.New("no nodes of nodegroups found")
+	}
+
+	usage, err := r.source.Usage(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name := range nodeGroups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	families := map[string][]instanceType{}
+	var recommendations []Recommendation
+	for _, name := range names {
+		current := nodeGroups[name][0].Labels[instanceTypeLabel]
+		if !isSingleInstanceType(nodeGroups[name], current) {
+			logger.Warning("skipping nodegroup %q, which has nodes of several instance types", name)
+			continue
+		}
+		family := strings.SplitN(current, ".", 2)[0]
+		if _, ok := families[family]; !ok {
+			if families[family], err = r.describeFamily(family); err != nil {
+				return nil, err
+			}
+		}
+		recommendation, err := r.recommend(name, nodeGroups[name], usage, families[family])
+		if err != nil {
+			return nil, err
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+	return recommendations, nil
+}
+
+func (r *Recommender) recommend(name string, nodes []corev1.Node, usage map[string]Usage, family []instanceType) (Recommendation, error) {
+	current := nodes[0].Labels[instanceTypeLabel]
+	var currentType *instanceType
+	for i := range family {
+		if family[i].name == current {
+			currentType = &family[i]
+		}
+	}
+	if currentType == nil {
+		return Recommendation{}, fmt.Errorf("instance type %q of nodegroup %q not found", current, name)
+	}
+
+	var used Usage
+	for _, node := range nodes {
+		nodeUsage, ok := usage[node.Name]
+		if !ok {
+			return Recommendation{}, fmt.Errorf("no utilization found for node %q of nodegroup %q", node.Name, name)
+		}
+		used.CPU += nodeUsage.CPU
+		used.Memory += nodeUsage.Memory
+	}
+
+	_, isManaged := nodes[0].Labels[api.EKSNodeGroupNameLabel]
+	recommendation := Recommendation{
+		NodeGroup:               name,
+		Managed:                 isManaged,
+		CurrentInstanceType:     current,
+		CurrentNodes:            len(nodes),
+		CPUUtilization:          used.CPU / (currentType.vCPUs * float64(len(nodes))),
+		MemoryUtilization:       used.Memory / (currentType.memory * float64(len(nodes))),
+		RecommendedInstanceType: current,
+		RecommendedNodes:        len(nodes),
+	}
+
+	// within a family, the price of instance types is proportional to their number of vCPUs
+	bestCost := currentType.vCPUs * float64(len(nodes))
+	for _, candidate := range family {
+		count := r.nodesNeeded(used, candidate)
+		cost := candidate.vCPUs * float64(count)
+		if cost < bestCost || (cost == bestCost && candidate.name == current && count < recommendation.RecommendedNodes) {
+			bestCost = cost
+			recommendation.RecommendedInstanceType = candidate.name
+			recommendation.RecommendedNodes = count
+		}
+	}
+	return recommendation, nil
+}
+
+// nodesNeeded returns the number of nodes of the instance type needed to run at the target utilization
+func (r *Recommender) nodesNeeded(used Usage, candidate instanceType) int {
+	nodes := math.Max(
+		math.Ceil(used.CPU/(candidate.vCPUs*r.options.TargetUtilization)),
+		math.Ceil(used.Memory/(candidate.memory*r.options.TargetUtilization)),
+	)
+	if int(nodes) < r.options.MinNodes {
+		return r.options.MinNodes
+	}
+	return int(nodes)
+}
+
+func (r *Recommender) describeFamily(family string) ([]instanceType, error) {
+	var instanceTypes []instanceType
+	input := &ec2.DescribeInstanceTypesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-type"),
+				Values: aws.StringSlice([]string{family + ".*"}),
+			},
+		},
+	}
+	for {
+		output, err := r.ec2API.DescribeInstanceTypes(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing instance types of family %q", family)
+		}
+		for _, info := range output.InstanceTypes {
+			if info.VCpuInfo == nil || info.MemoryInfo == nil {
+				continue
+			}
+			instanceTypes = append(instanceTypes, instanceType{
+				name:   aws.StringValue(info.InstanceType),
+				vCPUs:  float64(aws.Int64Value(info.VCpuInfo.DefaultVCpus)),
+				memory: float64(aws.Int64Value(info.MemoryInfo.SizeInMiB)) * 1024 * 1024,
+			})
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	// among the instance types of equal cost, the smaller ones are recommended
+	sort.SliceStable(instanceTypes, func(i, j int) bool {
+		return instanceTypes[i].vCPUs < instanceTypes[j].vCPUs
+	})
+	return instanceTypes, nil
+}
+
+func isSingleInstanceType(nodes []corev1.Node, instanceType string) bool {
+	if instanceType == "" {
+		return false
+	}
+	for _, node := range nodes {
+		if node.Labels[instanceTypeLabel] != instanceType {
+			return false
+		}
+	}
+	return true
+}
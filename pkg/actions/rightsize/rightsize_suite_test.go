@@ -0,0 +1,13 @@
+package rightsize_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRightsize(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rightsize Suite")
+}
@@ -0,0 +1,181 @@
+package rightsize_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/actions/rightsize"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+)
+
+const gib = 1024 * 1024 * 1024
+
+type fakeSource map[string]rightsize.Usage
+
+func (s fakeSource) Usage(_ []corev1.Node) (map[string]rightsize.Usage, error) {
+	return s, nil
+}
+
+func newNode(name, nodeGroupLabel, nodeGroup, instanceType string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				nodeGroupLabel:                     nodeGroup,
+				"node.kubernetes.io/instance-type": instanceType,
+			},
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: "aws:///us-west-2a/i-" + name,
+		},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+		},
+	}
+}
+
+func instanceTypeInfo(name string, vCPUs, memoryGiB int64) *ec2.InstanceTypeInfo {
+	return &ec2.InstanceTypeInfo{
+		InstanceType: aws.String(name),
+		VCpuInfo:     &ec2.VCpuInfo{DefaultVCpus: aws.Int64(vCPUs)},
+		MemoryInfo:   &ec2.MemoryInfo{SizeInMiB: aws.Int64(memoryGiB * 1024)},
+	}
+}
+
+var _ = Describe("Rightsize", func() {
+	var ec2API *mocks.EC2API
+
+	BeforeEach(func() {
+		ec2API = &mocks.EC2API{}
+		ec2API.On("DescribeInstanceTypes", &ec2.DescribeInstanceTypesInput{
+			Filters: []*ec2.Filter{{Name: aws.String("instance-type"), Values: aws.StringSlice([]string{"m5.*"})}},
+		}).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{
+				instanceTypeInfo("m5.xlarge", 4, 16),
+				instanceTypeInfo("m5.large", 2, 8),
+			},
+			NextToken: aws.String("token"),
+		}, nil).Once()
+		ec2API.On("DescribeInstanceTypes", mock.MatchedBy(func(input *ec2.DescribeInstanceTypesInput) bool {
+			return aws.StringValue(input.NextToken) == "token"
+		})).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{
+				instanceTypeInfo("m5.2xlarge", 8, 32),
+			},
+		}, nil).Once()
+	})
+
+	It("recommends smaller instance types and fewer nodes", func() {
+		nodes := []corev1.Node{
+			newNode("a", api.EKSNodeGroupNameLabel, "managed-ng", "m5.2xlarge"),
+			newNode("b", api.EKSNodeGroupNameLabel, "managed-ng", "m5.2xlarge"),
+			newNode("c", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+			newNode("d", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+			newNode("e", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+			newNode("f", api.NodeGroupNameLabel, "busy-ng", "m5.xlarge"),
+			newNode("no-nodegroup", "other", "", "m5.xlarge"),
+		}
+		source := fakeSource{
+			"a": {CPU: 1, Memory: 4 * gib},
+			"b": {CPU: 1, Memory: 4 * gib},
+			"c": {CPU: 1, Memory: 4 * gib},
+			"d": {CPU: 1, Memory: 4 * gib},
+			"e": {CPU: 0.5, Memory: 2 * gib},
+			"f": {CPU: 3.5, Memory: 12 * gib},
+		}
+
+		recommendations, err := rightsize.New(ec2API, source, rightsize.Options{TargetUtilization: 0.7, MinNodes: 1}).Recommend(nodes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recommendations).To(Equal([]rightsize.Recommendation{
+			{
+				NodeGroup:               "busy-ng",
+				CurrentInstanceType:     "m5.xlarge",
+				CurrentNodes:            1,
+				CPUUtilization:          0.875,
+				MemoryUtilization:       0.75,
+				RecommendedInstanceType: "m5.xlarge",
+				RecommendedNodes:        1,
+			},
+			{
+				NodeGroup:               "managed-ng",
+				Managed:                 true,
+				CurrentInstanceType:     "m5.2xlarge",
+				CurrentNodes:            2,
+				CPUUtilization:          0.125,
+				MemoryUtilization:       0.125,
+				RecommendedInstanceType: "m5.large",
+				RecommendedNodes:        2,
+			},
+			{
+				NodeGroup:               "ng",
+				CurrentInstanceType:     "m5.xlarge",
+				CurrentNodes:            3,
+				CPUUtilization:          2.5 / 12,
+				MemoryUtilization:       10.0 / 48,
+				RecommendedInstanceType: "m5.xlarge",
+				RecommendedNodes:        1,
+			},
+		}))
+		ec2API.AssertNumberOfCalls(GinkgoT(), "DescribeInstanceTypes", 2)
+
+		Expect(rightsize.Diff(recommendations)).To(Equal(` nodeGroups:
+ - name: ng
+-  desiredCapacity: 3
++  desiredCapacity: 1
+ managedNodeGroups:
+ - name: managed-ng
+-  instanceType: m5.2xlarge
++  instanceType: m5.large
+`))
+	})
+
+	It("recommends at least the minimum number of nodes", func() {
+		nodes := []corev1.Node{
+			newNode("a", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+			newNode("b", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+			newNode("c", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+		}
+		source := fakeSource{
+			"a": {CPU: 0.1, Memory: gib},
+			"b": {CPU: 0.1, Memory: gib},
+			"c": {CPU: 0.1, Memory: gib},
+		}
+
+		recommendations, err := rightsize.New(ec2API, source, rightsize.Options{TargetUtilization: 0.7, MinNodes: 3}).Recommend(nodes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recommendations).To(HaveLen(1))
+		Expect(recommendations[0].RecommendedInstanceType).To(Equal("m5.large"))
+		Expect(recommendations[0].RecommendedNodes).To(Equal(3))
+	})
+
+	It("skips nodegroups with several instance types", func() {
+		nodes := []corev1.Node{
+			newNode("a", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+			newNode("b", api.NodeGroupNameLabel, "ng", "c5.xlarge"),
+		}
+
+		recommendations, err := rightsize.New(ec2API, fakeSource{}, rightsize.Options{TargetUtilization: 0.7, MinNodes: 1}).Recommend(nodes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recommendations).To(BeEmpty())
+		Expect(rightsize.Diff(recommendations)).To(BeEmpty())
+	})
+
+	It("fails without the utilization of a node", func() {
+		nodes := []corev1.Node{
+			newNode("a", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+		}
+
+		_, err := rightsize.New(ec2API, fakeSource{}, rightsize.Options{TargetUtilization: 0.7, MinNodes: 1}).Recommend(nodes)
+		Expect(err).To(MatchError(`no utilization found for node "a" of nodegroup "ng"`))
+	})
+})
@@ -0,0 +1,186 @@
+package rightsize
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/rest"
+)
+
+// Sources of the utilization of nodes
+const (
+	SourceMetricsServer = "metrics-server"
+	SourceCloudWatch    = "cloudwatch"
+)
+
+const (
+	nodeMetricsPath = "/apis/metrics.k8s.io/v1beta1/nodes"
+
+	containerInsightsNamespace = "ContainerInsights"
+	// maxMetricDataQueries is the maximum number of queries of a GetMetricData request
+	maxMetricDataQueries = 500
+)
+
+// nodeMetricsList is the subset of the NodeMetricsList of the metrics API used
+type nodeMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Usage map[corev1.ResourceName]string `json:"usage"`
+	} `json:"items"`
+}
+
+// MetricsServerSource returns the current usage of nodes from metrics-server
+type MetricsServerSource struct {
+	restClient rest.Interface
+}
+
+// NewMetricsServerSource creates a MetricsServerSource using a REST client of the cluster.
+func NewMetricsServerSource(restClient rest.Interface) *MetricsServerSource {
+	return &MetricsServerSource{restClient: restClient}
+}
+
+// Usage returns the current usage of the nodes.
+func (s *MetricsServerSource) Usage(_ []corev1.Node) (map[string]Usage, error) {
+	data, err := s.restClient.Get().AbsPath(nodeMetricsPath).DoRaw(context.TODO())
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("metrics API not available, install metrics-server or use --source=%s", SourceCloudWatch)
+		}
+		return nil, errors.Wrap(err, "getting node metrics")
+	}
+	var metrics nodeMetricsList
+	if err := json.Unmarshal(data, &metrics); err != nil {
+		return nil, errors.Wrap(err, "parsing node metrics")
+	}
+
+	usage := map[string]Usage{}
+	for _, item := range metrics.Items {
+		cpu, err := resource.ParseQuantity(item.Usage[corev1.ResourceCPU])
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing CPU usage of node %q", item.Metadata.Name)
+		}
+		memory, err := resource.ParseQuantity(item.Usage[corev1.ResourceMemory])
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing memory usage of node %q", item.Metadata.Name)
+		}
+		usage[item.Metadata.Name] = Usage{
+			CPU:    float64(cpu.MilliValue()) / 1000,
+			Memory: float64(memory.Value()),
+		}
+	}
+	return usage, nil
+}
+
+// CloudWatchSource returns the average usage of nodes over a period from the metrics of CloudWatch Container Insights
+type CloudWatchSource struct {
+	cloudWatchAPI cloudwatchiface.CloudWatchAPI
+	clusterName   string
+	period        time.Duration
+	now           func() time.Time
+}
+
+// NewCloudWatchSource creates a CloudWatchSource averaging the usage of nodes over the period.
+func NewCloudWatchSource(cloudWatchAPI cloudwatchiface.CloudWatchAPI, clusterName string, period time.Duration) *CloudWatchSource {
+	return &CloudWatchSource{
+		cloudWatchAPI: cloudWatchAPI,
+		clusterName:   clusterName,
+		period:        period,
+		now:           time.Now,
+	}
+}
+
+// Usage returns the average usage of the nodes over the period.
+func (s *CloudWatchSource) Usage(nodes []corev1.Node) (map[string]Usage, error) {
+	endTime := s.now().Truncate(time.Minute)
+	period := s.period.Truncate(time.Minute)
+	if period < time.Minute {
+		period = time.Minute
+	}
+
+	var queries []*cloudwatch.MetricDataQuery
+	for i, node := range nodes {
+		instanceID := instanceIDOf(node)
+		if instanceID == "" {
+			return nil, fmt.Errorf("unable to get the instance ID of node %q", node.Name)
+		}
+		for _, metric := range []string{"cpu", "memory"} {
+			queries = append(queries, &cloudwatch.MetricDataQuery{
+				Id: aws.String(fmt.Sprintf("%s%d", metric, i)),
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: &cloudwatch.Metric{
+						Namespace:  aws.String(containerInsightsNamespace),
+						MetricName: aws.String(fmt.Sprintf("node_%s_utilization", metric)),
+						Dimensions: []*cloudwatch.Dimension{
+							{Name: aws.String("ClusterName"), Value: aws.String(s.clusterName)},
+							{Name: aws.String("InstanceId"), Value: aws.String(instanceID)},
+							{Name: aws.String("NodeName"), Value: aws.String(node.Name)},
+						},
+					},
+					Period: aws.Int64(int64(period.Seconds())),
+					Stat:   aws.String(cloudwatch.StatisticAverage),
+				},
+			})
+		}
+	}
+
+	values := map[string]float64{}
+	for len(queries) > 0 {
+		batch := queries
+		if len(batch) > maxMetricDataQueries {
+			batch = batch[:maxMetricDataQueries]
+		}
+		queries = queries[len(batch):]
+
+		input := &cloudwatch.GetMetricDataInput{
+			StartTime:         aws.Time(endTime.Add(-period)),
+			EndTime:           aws.Time(endTime),
+			MetricDataQueries: batch,
+		}
+		if err := s.cloudWatchAPI.GetMetricDataPages(input, func(output *cloudwatch.GetMetricDataOutput, _ bool) bool {
+			for _, result := range output.MetricDataResults {
+				if len(result.Values) > 0 {
+					values[aws.StringValue(result.Id)] = aws.Float64Value(result.Values[0])
+				}
+			}
+			return true
+		}); err != nil {
+			return nil, errors.Wrap(err, "getting Container Insights metrics")
+		}
+	}
+
+	usage := map[string]Usage{}
+	for i, node := range nodes {
+		cpu, hasCPU := values[fmt.Sprintf("cpu%d", i)]
+		memory, hasMemory := values[fmt.Sprintf("memory%d", i)]
+		if !hasCPU || !hasMemory {
+			return nil, fmt.Errorf("no Container Insights metrics found for node %q, enable Container Insights on the cluster or use --source=%s", node.Name, SourceMetricsServer)
+		}
+		// the utilization metrics are percentages of the capacity of the nodes
+		usage[node.Name] = Usage{
+			CPU:    cpu / 100 * float64(node.Status.Capacity.Cpu().MilliValue()) / 1000,
+			Memory: memory / 100 * float64(node.Status.Capacity.Memory().Value()),
+		}
+	}
+	return usage, nil
+}
+
+// instanceIDOf returns the EC2 instance ID of the node, from its provider ID, e.g. aws:///us-west-2a/i-0123456789abcdef0
+func instanceIDOf(node corev1.Node) string {
+	if !strings.HasPrefix(node.Spec.ProviderID, "aws://") {
+		return ""
+	}
+	parts := strings.Split(node.Spec.ProviderID, "/")
+	return parts[len(parts)-1]
+}
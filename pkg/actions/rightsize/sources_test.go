@@ -0,0 +1,146 @@
+package rightsize_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/rightsize"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+)
+
+var _ = Describe("Utilization sources", func() {
+	nodes := []corev1.Node{
+		newNode("a", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+		newNode("b", api.NodeGroupNameLabel, "ng", "m5.xlarge"),
+	}
+
+	Describe("metrics-server", func() {
+		newRESTClient := func(statusCode int, body string) *fake.RESTClient {
+			return &fake.RESTClient{
+				NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+				Resp: &http.Response{
+					StatusCode: statusCode,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       ioutil.NopCloser(strings.NewReader(body)),
+				},
+			}
+		}
+
+		It("returns the current usage of nodes", func() {
+			restClient := newRESTClient(http.StatusOK, `{
+  "kind": "NodeMetricsList",
+  "apiVersion": "metrics.k8s.io/v1beta1",
+  "items": [
+    {"metadata": {"name": "a"}, "usage": {"cpu": "500m", "memory": "2Gi"}},
+    {"metadata": {"name": "b"}, "usage": {"cpu": "1250m", "memory": "1024Mi"}}
+  ]
+}`)
+
+			usage, err := rightsize.NewMetricsServerSource(restClient).Usage(nodes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(map[string]rightsize.Usage{
+				"a": {CPU: 0.5, Memory: 2 * gib},
+				"b": {CPU: 1.25, Memory: gib},
+			}))
+			Expect(restClient.Req.URL.Path).To(Equal("/apis/metrics.k8s.io/v1beta1/nodes"))
+		})
+
+		It("fails when metrics-server is not installed", func() {
+			restClient := newRESTClient(http.StatusNotFound, `{"kind": "Status", "apiVersion": "v1", "status": "Failure", "reason": "NotFound", "code": 404}`)
+
+			_, err := rightsize.NewMetricsServerSource(restClient).Usage(nodes)
+			Expect(err).To(MatchError("metrics API not available, install metrics-server or use --source=cloudwatch"))
+		})
+	})
+
+	Describe("CloudWatch", func() {
+		var cloudWatchAPI *mocks.CloudWatchAPI
+
+		BeforeEach(func() {
+			cloudWatchAPI = &mocks.CloudWatchAPI{}
+		})
+
+		It("returns the average usage of nodes from Container Insights", func() {
+			var input *cloudwatch.GetMetricDataInput
+			cloudWatchAPI.On("GetMetricDataPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				input = args.Get(0).(*cloudwatch.GetMetricDataInput)
+				fn := args.Get(1).(func(*cloudwatch.GetMetricDataOutput, bool) bool)
+				fn(&cloudwatch.GetMetricDataOutput{
+					MetricDataResults: []*cloudwatch.MetricDataResult{
+						{Id: aws.String("cpu0"), Values: aws.Float64Slice([]float64{25})},
+						{Id: aws.String("memory0"), Values: aws.Float64Slice([]float64{50})},
+					},
+				}, false)
+				fn(&cloudwatch.GetMetricDataOutput{
+					MetricDataResults: []*cloudwatch.MetricDataResult{
+						{Id: aws.String("cpu1"), Values: aws.Float64Slice([]float64{10})},
+						{Id: aws.String("memory1"), Values: aws.Float64Slice([]float64{12.5})},
+					},
+				}, true)
+			}).Return(nil)
+
+			usage, err := rightsize.NewCloudWatchSource(cloudWatchAPI, "my-cluster", 24*time.Hour).Usage(nodes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(map[string]rightsize.Usage{
+				"a": {CPU: 1, Memory: 8 * gib},
+				"b": {CPU: 0.4, Memory: 2 * gib},
+			}))
+
+			Expect(input.EndTime.Sub(*input.StartTime)).To(Equal(24 * time.Hour))
+			Expect(input.MetricDataQueries).To(HaveLen(4))
+			stat := input.MetricDataQueries[1].MetricStat
+			Expect(*stat.Metric.Namespace).To(Equal("ContainerInsights"))
+			Expect(*stat.Metric.MetricName).To(Equal("node_memory_utilization"))
+			Expect(stat.Metric.Dimensions).To(Equal([]*cloudwatch.Dimension{
+				{Name: aws.String("ClusterName"), Value: aws.String("my-cluster")},
+				{Name: aws.String("InstanceId"), Value: aws.String("i-a")},
+				{Name: aws.String("NodeName"), Value: aws.String("a")},
+			}))
+			Expect(*stat.Period).To(Equal(int64(24 * 60 * 60)))
+		})
+
+		It("splits the queries of many nodes into batches", func() {
+			var manyNodes []corev1.Node
+			for i := 0; i < 300; i++ {
+				manyNodes = append(manyNodes, newNode(fmt.Sprintf("node-%d", i), api.NodeGroupNameLabel, "ng", "m5.xlarge"))
+			}
+			var batchSizes []int
+			cloudWatchAPI.On("GetMetricDataPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				input := args.Get(0).(*cloudwatch.GetMetricDataInput)
+				batchSizes = append(batchSizes, len(input.MetricDataQueries))
+				var results []*cloudwatch.MetricDataResult
+				for _, query := range input.MetricDataQueries {
+					results = append(results, &cloudwatch.MetricDataResult{Id: query.Id, Values: aws.Float64Slice([]float64{50})})
+				}
+				fn := args.Get(1).(func(*cloudwatch.GetMetricDataOutput, bool) bool)
+				fn(&cloudwatch.GetMetricDataOutput{MetricDataResults: results}, true)
+			}).Return(nil)
+
+			usage, err := rightsize.NewCloudWatchSource(cloudWatchAPI, "my-cluster", 24*time.Hour).Usage(manyNodes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(HaveLen(300))
+			Expect(usage["node-299"]).To(Equal(rightsize.Usage{CPU: 2, Memory: 8 * gib}))
+			Expect(batchSizes).To(Equal([]int{500, 100}))
+		})
+
+		It("fails when Container Insights is not enabled", func() {
+			cloudWatchAPI.On("GetMetricDataPages", mock.Anything, mock.Anything).Return(nil)
+
+			_, err := rightsize.NewCloudWatchSource(cloudWatchAPI, "my-cluster", 24*time.Hour).Usage(nodes)
+			Expect(err).To(MatchError(ContainSubstring(`no Container Insights metrics found for node "a"`)))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package rolesanywhere
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	kubeclient "k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/iam"
+)
+
+// DefaultUsername maps the session name, which IAM Roles Anywhere sets to the serial number
+// of the certificate, to the Kubernetes username
+const DefaultUsername = "rolesanywhere:{{SessionName}}"
+
+// CreateOptions holds the settings of an IAM Roles Anywhere profile
+type CreateOptions struct {
+	builder.RolesAnywhereSpec
+
+	// Username is the Kubernetes username the role is mapped to
+	Username string
+	// Groups are the Kubernetes groups the role is mapped to
+	Groups []string
+}
+
+// Output holds the ARNs of the resources created for an IAM Roles Anywhere profile
+type Output struct {
+	RoleARN        string
+	TrustAnchorARN string
+	ProfileARN     string
+}
+
+type Manager struct {
+	clusterName  string
+	stackManager manager.StackManager
+	clientSet    kubeclient.Interface
+}
+
+func New(clusterName string, stackManager manager.StackManager, clientSet kubeclient.Interface) *Manager {
+	return &Manager{
+		clusterName:  clusterName,
+		stackManager: stackManager,
+		clientSet:    clientSet,
+	}
+}
+
+// Create creates an IAM Roles Anywhere trust anchor and profile vending credentials for an IAM role,
+// and maps that role to a Kubernetes user and groups so that workloads outside of AWS can access the
+// cluster with X.509 certificates instead of long-lived access keys
+func (m *Manager) Create(options CreateOptions) (*Output, error) {
+	resourceSet := builder.NewRolesAnywhereResourceSet(m.clusterName, options.RolesAnywhereSpec)
+	if err := resourceSet.AddAllResources(); err != nil {
+		return nil, err
+	}
+
+	stackName := m.makeStackName(options.Name)
+	logger.Info("creating IAM Roles Anywhere stack %q", stackName)
+	errs := make(chan error)
+	tags := map[string]string{
+		api.RolesAnywhereNameTag: options.Name,
+	}
+	if err := m.stackManager.CreateStack(stackName, resourceSet, tags, nil, errs); err != nil {
+		return nil, err
+	}
+	if err := <-errs; err != nil {
+		return nil, errors.Wrapf(err, "creating IAM Roles Anywhere stack %q", stackName)
+	}
+
+	username := options.Username
+	if username == "" {
+		username = DefaultUsername
+	}
+	identity, err := iam.NewIdentity(resourceSet.RoleARN, username, options.Groups)
+	if err != nil {
+		return nil, err
+	}
+	acm, err := authconfigmap.NewFromClientSet(m.clientSet)
+	if err != nil {
+		return nil, err
+	}
+	if err := acm.AddIdentity(identity); err != nil {
+		return nil, err
+	}
+	if err := acm.Save(); err != nil {
+		return nil, errors.Wrap(err, "saving the aws-auth ConfigMap")
+	}
+	logger.Info("mapped IAM role %q to user %q and groups %v", resourceSet.RoleARN, username, options.Groups)
+
+	return &Output{
+		RoleARN:        resourceSet.RoleARN,
+		TrustAnchorARN: resourceSet.TrustAnchorARN,
+		ProfileARN:     resourceSet.ProfileARN,
+	}, nil
+}
+
+func (m *Manager) makeStackName(name string) string {
+	return fmt.Sprintf("eksctl-%s-rolesanywhere-%s", m.clusterName, name)
+}
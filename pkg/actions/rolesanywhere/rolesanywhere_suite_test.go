@@ -0,0 +1,13 @@
+package rolesanywhere_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRolesAnywhere(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IAM Roles Anywhere Suite")
+}
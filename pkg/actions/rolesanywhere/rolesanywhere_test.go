@@ -0,0 +1,102 @@
+package rolesanywhere_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/rolesanywhere"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+)
+
+const (
+	roleARN        = "arn:aws:iam::123456789012:role/eksctl-my-cluster-rolesanywhere-Role-1"
+	trustAnchorARN = "arn:aws:rolesanywhere:us-west-2:123456789012:trust-anchor/abc"
+	profileARN     = "arn:aws:rolesanywhere:us-west-2:123456789012:profile/def"
+)
+
+var _ = Describe("IAM Roles Anywhere", func() {
+	var (
+		fakeStackManager *fakes.FakeStackManager
+		clientSet        *fake.Clientset
+		manager          *rolesanywhere.Manager
+		template         map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.CreateStackStub = func(_ string, rs builder.ResourceSet, _, _ map[string]string, errs chan error) error {
+			templateBody, err := rs.RenderJSON()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(json.Unmarshal(templateBody, &template)).To(Succeed())
+
+			go func() {
+				errs <- rs.GetAllOutputs(cfn.Stack{
+					Outputs: []*cfn.Output{
+						{OutputKey: aws.String("Role"), OutputValue: aws.String(roleARN)},
+						{OutputKey: aws.String("TrustAnchor"), OutputValue: aws.String(trustAnchorARN)},
+						{OutputKey: aws.String("Profile"), OutputValue: aws.String(profileARN)},
+					},
+				})
+			}()
+			return nil
+		}
+		clientSet = fake.NewSimpleClientset()
+		manager = rolesanywhere.New("my-cluster", fakeStackManager, clientSet)
+	})
+
+	It("creates the stack and maps the role in the aws-auth ConfigMap", func() {
+		output, err := manager.Create(rolesanywhere.CreateOptions{
+			RolesAnywhereSpec: builder.RolesAnywhereSpec{
+				Name:              "ci",
+				CertificateBundle: "-----BEGIN CERTIFICATE-----",
+				SessionDuration:   3600,
+			},
+			Groups: []string{"ci-deployers"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*output).To(Equal(rolesanywhere.Output{
+			RoleARN:        roleARN,
+			TrustAnchorARN: trustAnchorARN,
+			ProfileARN:     profileARN,
+		}))
+
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+		stackName, _, tags, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+		Expect(stackName).To(Equal("eksctl-my-cluster-rolesanywhere-ci"))
+		Expect(tags).To(HaveKeyWithValue(api.RolesAnywhereNameTag, "ci"))
+
+		resources := template["Resources"].(map[string]interface{})
+		Expect(resources).To(HaveKey("TrustAnchor"))
+		Expect(resources).To(HaveKey("Profile"))
+		Expect(resources).To(HaveKey("Role"))
+		trustAnchor := resources["TrustAnchor"].(map[string]interface{})["Properties"].(map[string]interface{})
+		Expect(trustAnchor["Source"]).To(Equal(map[string]interface{}{
+			"SourceType": "CERTIFICATE_BUNDLE",
+			"SourceData": map[string]interface{}{"X509CertificateData": "-----BEGIN CERTIFICATE-----"},
+		}))
+
+		cm, err := clientSet.CoreV1().ConfigMaps(authconfigmap.ObjectNamespace).Get(context.TODO(), authconfigmap.ObjectName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cm.Data["mapRoles"]).To(ContainSubstring(roleARN))
+		Expect(cm.Data["mapRoles"]).To(ContainSubstring("rolesanywhere:{{SessionName}}"))
+		Expect(cm.Data["mapRoles"]).To(ContainSubstring("ci-deployers"))
+	})
+
+	It("requires a trust anchor source", func() {
+		_, err := manager.Create(rolesanywhere.CreateOptions{
+			RolesAnywhereSpec: builder.RolesAnywhereSpec{Name: "ci"},
+		})
+		Expect(err).To(MatchError("either a certificate bundle or an ACM Private CA must be used as the trust anchor"))
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+	})
+})
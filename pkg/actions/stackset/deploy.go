@@ -0,0 +1,195 @@
+package stackset
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+)
+
+// operationPollInterval is the delay between checks of the status of StackSet operations
+const operationPollInterval = 15 * time.Second
+
+// Deployer deploys the prerequisites of a cluster as a CloudFormation StackSet with service-managed permissions, to
+// the accounts of AWS Organizations organizational units
+type Deployer struct {
+	provider     api.ClusterProvider
+	pollInterval time.Duration
+}
+
+// New creates a new Deployer
+func New(provider api.ClusterProvider) *Deployer {
+	return &Deployer{
+		provider:     provider,
+		pollInterval: operationPollInterval,
+	}
+}
+
+// Render returns the template of the StackSet
+func (d *Deployer) Render(cfg *api.ClusterConfig) ([]byte, error) {
+	rs := builder.NewPrerequisitesResourceSet(d.provider.EC2(), d.provider.Region(), cfg)
+	if err := rs.AddAllResources(); err != nil {
+		return nil, err
+	}
+	return rs.RenderJSON()
+}
+
+// Deploy creates or updates the StackSet, then deploys it to the accounts of the organizational units in the region
+// of the cluster. Accounts later added to the organizational units get the prerequisites automatically
+func (d *Deployer) Deploy(cfg *api.ClusterConfig) error {
+	stackSet := cfg.StackSet
+	templateBody, err := d.Render(cfg)
+	if err != nil {
+		return err
+	}
+
+	callAs := aws.String(cloudformation.CallAsSelf)
+	if api.IsEnabled(stackSet.DelegatedAdmin) {
+		callAs = aws.String(cloudformation.CallAsDelegatedAdmin)
+	}
+	capabilities := aws.StringSlice([]string{cloudformation.CapabilityCapabilityIam})
+	preferences := &cloudformation.StackSetOperationPreferences{
+		MaxConcurrentPercentage:    aws.Int64(int64(*stackSet.MaxConcurrentPercentage)),
+		FailureTolerancePercentage: aws.Int64(int64(*stackSet.FailureTolerancePercentage)),
+	}
+
+	exists, err := d.exists(stackSet.Name, callAs)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if _, err := d.provider.CloudFormation().CreateStackSet(&cloudformation.CreateStackSetInput{
+			StackSetName:    aws.String(stackSet.Name),
+			Description:     aws.String(fmt.Sprintf("prerequisites of EKS cluster %q", cfg.Metadata.Name)),
+			TemplateBody:    aws.String(string(templateBody)),
+			Capabilities:    capabilities,
+			PermissionModel: aws.String(cloudformation.PermissionModelsServiceManaged),
+			AutoDeployment: &cloudformation.AutoDeployment{
+				Enabled:                      aws.Bool(true),
+				RetainStacksOnAccountRemoval: aws.Bool(false),
+			},
+			CallAs: callAs,
+		}); err != nil {
+			return errors.Wrapf(err, "creating StackSet %q", stackSet.Name)
+		}
+		logger.Info("created StackSet %q", stackSet.Name)
+	} else {
+		logger.Info("updating the existing instances of StackSet %q", stackSet.Name)
+		output, err := d.provider.CloudFormation().UpdateStackSet(&cloudformation.UpdateStackSetInput{
+			StackSetName:         aws.String(stackSet.Name),
+			TemplateBody:         aws.String(string(templateBody)),
+			Capabilities:         capabilities,
+			OperationPreferences: preferences,
+			CallAs:               callAs,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "updating StackSet %q", stackSet.Name)
+		}
+		if err := d.waitForOperation(stackSet.Name, output.OperationId, callAs); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("deploying StackSet %q to the accounts of organizational units %s in %q", stackSet.Name, strings.Join(stackSet.OrganizationalUnitIDs, ", "), d.provider.Region())
+	output, err := d.provider.CloudFormation().CreateStackInstances(&cloudformation.CreateStackInstancesInput{
+		StackSetName: aws.String(stackSet.Name),
+		DeploymentTargets: &cloudformation.DeploymentTargets{
+			OrganizationalUnitIds: aws.StringSlice(stackSet.OrganizationalUnitIDs),
+		},
+		Regions:              aws.StringSlice([]string{d.provider.Region()}),
+		OperationPreferences: preferences,
+		CallAs:               callAs,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "creating instances of StackSet %q", stackSet.Name)
+	}
+	return d.waitForOperation(stackSet.Name, output.OperationId, callAs)
+}
+
+func (d *Deployer) exists(name string, callAs *string) (bool, error) {
+	_, err := d.provider.CloudFormation().DescribeStackSet(&cloudformation.DescribeStackSetInput{
+		StackSetName: aws.String(name),
+		CallAs:       callAs,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == cloudformation.ErrCodeStackSetNotFoundException {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "describing StackSet %q", name)
+	}
+	return true, nil
+}
+
+// waitForOperation waits for the StackSet operation to complete, reporting the accounts it failed in
+func (d *Deployer) waitForOperation(name string, operationID, callAs *string) error {
+	var status string
+	w := waiter.Waiter{
+		Operation: func() (bool, error) {
+			output, err := d.provider.CloudFormation().DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+				StackSetName: aws.String(name),
+				OperationId:  operationID,
+				CallAs:       callAs,
+			})
+			if err != nil {
+				return false, errors.Wrapf(err, "describing operation %q of StackSet %q", aws.StringValue(operationID), name)
+			}
+			status = aws.StringValue(output.StackSetOperation.Status)
+			logger.Debug("operation %q of StackSet %q is %s", aws.StringValue(operationID), name, status)
+			switch status {
+			case cloudformation.StackSetOperationStatusSucceeded, cloudformation.StackSetOperationStatusFailed, cloudformation.StackSetOperationStatusStopped:
+				return true, nil
+			default:
+				return false, nil
+			}
+		},
+		NextDelay: func(_ int) time.Duration {
+			return d.pollInterval
+		},
+	}
+	if err := w.WaitWithTimeout(d.provider.WaitTimeout()); err != nil {
+		if err == context.DeadlineExceeded {
+			return errors.Errorf("timed out waiting for operation %q of StackSet %q, it is %s", aws.StringValue(operationID), name, status)
+		}
+		return err
+	}
+	if status == cloudformation.StackSetOperationStatusSucceeded {
+		logger.Success("operation %q of StackSet %q succeeded", aws.StringValue(operationID), name)
+		return nil
+	}
+
+	failures, err := d.failedAccounts(name, operationID, callAs)
+	if err != nil {
+		return err
+	}
+	return errors.Errorf("operation %q of StackSet %q is %s: %s", aws.StringValue(operationID), name, status, strings.Join(failures, "; "))
+}
+
+func (d *Deployer) failedAccounts(name string, operationID, callAs *string) ([]string, error) {
+	var failures []string
+	input := &cloudformation.ListStackSetOperationResultsInput{
+		StackSetName: aws.String(name),
+		OperationId:  operationID,
+		CallAs:       callAs,
+	}
+	if err := d.provider.CloudFormation().ListStackSetOperationResultsPages(input, func(output *cloudformation.ListStackSetOperationResultsOutput, _ bool) bool {
+		for _, result := range output.Summaries {
+			if aws.StringValue(result.Status) != cloudformation.StackSetOperationResultStatusSucceeded {
+				failures = append(failures, fmt.Sprintf("account %s in %s is %s: %s", aws.StringValue(result.Account), aws.StringValue(result.Region), aws.StringValue(result.Status), aws.StringValue(result.StatusReason)))
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "listing results of operation %q of StackSet %q", aws.StringValue(operationID), name)
+	}
+	return failures, nil
+}
@@ -0,0 +1,124 @@
+package stackset_test
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/stackset"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("StackSet Deployer", func() {
+	var (
+		cfg      *api.ClusterConfig
+		p        *mockprovider.MockProvider
+		deployer *stackset.Deployer
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+		cfg.StackSet = &api.StackSet{
+			OrganizationalUnitIDs: []string{"ou-ab12-cdefgh34"},
+		}
+		api.SetClusterConfigDefaults(cfg)
+		Expect(vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones)).To(Succeed())
+
+		p = mockprovider.NewMockProvider()
+		deployer = stackset.New(p)
+		deployer.SetPollInterval(time.Millisecond)
+
+		p.MockCloudFormation().On("CreateStackInstances", mock.MatchedBy(func(input *cfn.CreateStackInstancesInput) bool {
+			return aws.StringValue(input.StackSetName) == "eksctl-my-cluster-prerequisites" &&
+				aws.StringValueSlice(input.DeploymentTargets.OrganizationalUnitIds)[0] == "ou-ab12-cdefgh34" &&
+				aws.StringValueSlice(input.Regions)[0] == p.Region() &&
+				aws.Int64Value(input.OperationPreferences.MaxConcurrentPercentage) == 25
+		})).Return(&cfn.CreateStackInstancesOutput{OperationId: aws.String("create-instances")}, nil)
+	})
+
+	mockOperation := func(operationID, status string) {
+		p.MockCloudFormation().On("DescribeStackSetOperation", mock.MatchedBy(func(input *cfn.DescribeStackSetOperationInput) bool {
+			return aws.StringValue(input.OperationId) == operationID
+		})).Return(&cfn.DescribeStackSetOperationOutput{
+			StackSetOperation: &cfn.StackSetOperation{Status: aws.String(status)},
+		}, nil)
+	}
+
+	It("renders the VPC and the service role", func() {
+		templateBody, err := deployer.Render(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(templateBody)).To(ContainSubstring(`"ServiceRole"`))
+		Expect(string(templateBody)).To(ContainSubstring(`"VPC"`))
+	})
+
+	It("creates the StackSet when it does not exist and deploys it to the organizational units", func() {
+		p.MockCloudFormation().On("DescribeStackSet", mock.Anything).
+			Return(nil, awserr.New(cfn.ErrCodeStackSetNotFoundException, "not found", nil))
+		p.MockCloudFormation().On("CreateStackSet", mock.MatchedBy(func(input *cfn.CreateStackSetInput) bool {
+			return aws.StringValue(input.StackSetName) == "eksctl-my-cluster-prerequisites" &&
+				aws.StringValue(input.PermissionModel) == cfn.PermissionModelsServiceManaged &&
+				aws.BoolValue(input.AutoDeployment.Enabled) &&
+				aws.StringValue(input.CallAs) == cfn.CallAsSelf
+		})).Return(&cfn.CreateStackSetOutput{}, nil)
+		mockOperation("create-instances", cfn.StackSetOperationStatusSucceeded)
+
+		Expect(deployer.Deploy(cfg)).To(Succeed())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "UpdateStackSet", mock.Anything)
+		p.MockCloudFormation().AssertExpectations(GinkgoT())
+	})
+
+	It("updates the StackSet when it exists as the delegated administrator", func() {
+		cfg.StackSet.DelegatedAdmin = api.Enabled()
+		p.MockCloudFormation().On("DescribeStackSet", mock.MatchedBy(func(input *cfn.DescribeStackSetInput) bool {
+			return aws.StringValue(input.CallAs) == cfn.CallAsDelegatedAdmin
+		})).Return(&cfn.DescribeStackSetOutput{}, nil)
+		p.MockCloudFormation().On("UpdateStackSet", mock.MatchedBy(func(input *cfn.UpdateStackSetInput) bool {
+			return aws.StringValue(input.StackSetName) == "eksctl-my-cluster-prerequisites" &&
+				aws.StringValue(input.CallAs) == cfn.CallAsDelegatedAdmin
+		})).Return(&cfn.UpdateStackSetOutput{OperationId: aws.String("update")}, nil)
+		mockOperation("update", cfn.StackSetOperationStatusSucceeded)
+		mockOperation("create-instances", cfn.StackSetOperationStatusSucceeded)
+
+		Expect(deployer.Deploy(cfg)).To(Succeed())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStackSet", mock.Anything)
+		p.MockCloudFormation().AssertExpectations(GinkgoT())
+	})
+
+	It("reports the accounts in which the deployment failed", func() {
+		p.MockCloudFormation().On("DescribeStackSet", mock.Anything).Return(&cfn.DescribeStackSetOutput{}, nil)
+		p.MockCloudFormation().On("UpdateStackSet", mock.Anything).Return(&cfn.UpdateStackSetOutput{OperationId: aws.String("update")}, nil)
+		mockOperation("update", cfn.StackSetOperationStatusSucceeded)
+		mockOperation("create-instances", cfn.StackSetOperationStatusFailed)
+		p.MockCloudFormation().On("ListStackSetOperationResultsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*cfn.ListStackSetOperationResultsOutput, bool) bool)
+			consume(&cfn.ListStackSetOperationResultsOutput{
+				Summaries: []*cfn.StackSetOperationResultSummary{
+					{
+						Account: aws.String("111111111111"),
+						Region:  aws.String("us-west-2"),
+						Status:  aws.String(cfn.StackSetOperationResultStatusSucceeded),
+					},
+					{
+						Account:      aws.String("222222222222"),
+						Region:       aws.String("us-west-2"),
+						Status:       aws.String(cfn.StackSetOperationResultStatusFailed),
+						StatusReason: aws.String("The maximum number of VPCs has been reached"),
+					},
+				},
+			}, true)
+		}).Return(nil)
+
+		err := deployer.Deploy(cfg)
+		Expect(err).To(MatchError(`operation "create-instances" of StackSet "eksctl-my-cluster-prerequisites" is FAILED: ` +
+			"account 222222222222 in us-west-2 is FAILED: The maximum number of VPCs has been reached"))
+	})
+})
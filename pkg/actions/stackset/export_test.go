@@ -0,0 +1,7 @@
+package stackset
+
+import "time"
+
+func (d *Deployer) SetPollInterval(interval time.Duration) {
+	d.pollInterval = interval
+}
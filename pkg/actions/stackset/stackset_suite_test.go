@@ -0,0 +1,13 @@
+package stackset_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestStackSet(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "StackSet Suite")
+}
@@ -0,0 +1,7 @@
+package tempaccess
+
+import "time"
+
+func (m *Manager) SetNow(now func() time.Time) {
+	m.now = now
+}
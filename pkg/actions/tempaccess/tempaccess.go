@@ -0,0 +1,256 @@
+package tempaccess
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// Namespace is the namespace of the service accounts of temporary access
+	Namespace = metav1.NamespaceSystem
+
+	// Label marks the service accounts and cluster role bindings of temporary access
+	Label = "eksctl.io/temporary-access"
+
+	// RecordAnnotation is the annotation of the service account holding the audit record of the access
+	RecordAnnotation = "eksctl.io/temporary-access"
+
+	// MinDuration is the shortest temporary access that can be granted, the API server does not issue
+	// tokens expiring sooner
+	MinDuration = 10 * time.Minute
+
+	// MaxDuration is the longest temporary access that can be granted
+	MaxDuration = 24 * time.Hour
+
+	namePrefix = "eksctl-temp-access-"
+)
+
+// Record is the audit record of temporary access
+type Record struct {
+	Name        string    `json:"name"`
+	ClusterRole string    `json:"clusterRole"`
+	GrantedBy   string    `json:"grantedBy,omitempty"`
+	GrantedAt   time.Time `json:"grantedAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// Manager grants temporary access to a cluster with tokens of service accounts bound to a cluster role. The tokens
+// expire with the access, so that the access ends without eksctl running; the service accounts and their bindings
+// are kept as audit records until they are revoked
+type Manager struct {
+	clientSet kubernetes.Interface
+	now       func() time.Time
+}
+
+// New creates a new Manager
+func New(clientSet kubernetes.Interface) *Manager {
+	return &Manager{
+		clientSet: clientSet,
+		now:       time.Now,
+	}
+}
+
+// Grant creates a service account bound to the cluster role, and returns a token for it expiring after duration
+func (m *Manager) Grant(clusterRole, grantedBy string, duration time.Duration) (Record, string, error) {
+	if duration < MinDuration || duration > MaxDuration {
+		return Record{}, "", fmt.Errorf("the duration of temporary access must be between %s and %s", MinDuration, MaxDuration)
+	}
+	ctx := context.TODO()
+	if _, err := m.clientSet.RbacV1().ClusterRoles().Get(ctx, clusterRole, metav1.GetOptions{}); err != nil {
+		return Record{}, "", errors.Wrapf(err, "getting cluster role %q", clusterRole)
+	}
+
+	now := m.now().UTC()
+	record := Record{
+		ClusterRole: clusterRole,
+		GrantedBy:   grantedBy,
+		GrantedAt:   now,
+		ExpiresAt:   now.Add(duration),
+	}
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: namePrefix,
+			Namespace:    Namespace,
+			Labels:       map[string]string{Label: "true"},
+		},
+	}
+	if err := setRecord(&serviceAccount.ObjectMeta, record); err != nil {
+		return Record{}, "", err
+	}
+	serviceAccount, err := m.clientSet.CoreV1().ServiceAccounts(Namespace).Create(ctx, serviceAccount, metav1.CreateOptions{})
+	if err != nil {
+		return Record{}, "", errors.Wrap(err, "creating service account")
+	}
+	record.Name = serviceAccount.Name
+
+	token, err := m.bind(ctx, serviceAccount, record, duration)
+	if err != nil {
+		if revokeErr := m.Revoke(record.Name); revokeErr != nil {
+			logger.Warning("failed to clean up temporary access %q: %v", record.Name, revokeErr)
+		}
+		return Record{}, "", err
+	}
+	expiresAt := token.Status.ExpirationTimestamp.Time.UTC()
+	if !expiresAt.Equal(record.ExpiresAt) {
+		// the API server may shorten the expiry of tokens
+		record.ExpiresAt = expiresAt
+		if err := setRecord(&serviceAccount.ObjectMeta, record); err != nil {
+			return Record{}, "", err
+		}
+		if _, err := m.clientSet.CoreV1().ServiceAccounts(Namespace).Update(ctx, serviceAccount, metav1.UpdateOptions{}); err != nil {
+			logger.Warning("failed to record the expiry of temporary access %q: %v", record.Name, err)
+		}
+	}
+	return record, token.Status.Token, nil
+}
+
+func (m *Manager) bind(ctx context.Context, serviceAccount *corev1.ServiceAccount, record Record, duration time.Duration) (*authenticationv1.TokenRequest, error) {
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   serviceAccount.Name,
+			Labels: map[string]string{Label: "true"},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     record.ClusterRole,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccount.Name,
+			Namespace: Namespace,
+		}},
+	}
+	if _, err := m.clientSet.RbacV1().ClusterRoleBindings().Create(ctx, binding, metav1.CreateOptions{}); err != nil {
+		return nil, errors.Wrap(err, "creating cluster role binding")
+	}
+
+	expirationSeconds := int64(duration.Seconds())
+	token, err := m.clientSet.CoreV1().ServiceAccounts(Namespace).CreateToken(ctx, serviceAccount.Name, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating token for service account %q", serviceAccount.Name)
+	}
+	return token, nil
+}
+
+// List returns the records of the temporary access that has not been revoked, ordered by the time it was granted
+func (m *Manager) List() ([]Record, error) {
+	serviceAccounts, err := m.clientSet.CoreV1().ServiceAccounts(Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: Label,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing service accounts of temporary access")
+	}
+	var records []Record
+	for _, serviceAccount := range serviceAccounts.Items {
+		record, err := recordOf(serviceAccount.ObjectMeta)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].GrantedAt.Before(records[j].GrantedAt)
+	})
+	return records, nil
+}
+
+// Revoke deletes the service account and the cluster role binding of the temporary access, which invalidates its
+// token if it has not expired yet. It refuses to delete objects that were not created by Grant
+func (m *Manager) Revoke(name string) error {
+	ctx := context.TODO()
+	if !strings.HasPrefix(name, namePrefix) {
+		return fmt.Errorf("%q is not the name of temporary access granted by eksctl", name)
+	}
+	binding, err := m.clientSet.RbacV1().ClusterRoleBindings().Get(ctx, name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "getting cluster role binding %q", name)
+	}
+	bindingExists := err == nil
+	if bindingExists && !isTemporaryAccess(binding.ObjectMeta) {
+		return fmt.Errorf("cluster role binding %q was not created by eksctl for temporary access", name)
+	}
+	serviceAccount, err := m.clientSet.CoreV1().ServiceAccounts(Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "getting service account %q", name)
+	}
+	serviceAccountExists := err == nil
+	if serviceAccountExists && !isTemporaryAccess(serviceAccount.ObjectMeta) {
+		return fmt.Errorf("service account %q was not created by eksctl for temporary access", name)
+	}
+
+	if bindingExists {
+		if err := m.clientSet.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "deleting cluster role binding %q", name)
+		}
+	}
+	if serviceAccountExists {
+		if err := m.clientSet.CoreV1().ServiceAccounts(Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "deleting service account %q", name)
+		}
+	}
+	return nil
+}
+
+func isTemporaryAccess(meta metav1.ObjectMeta) bool {
+	return strings.HasPrefix(meta.Name, namePrefix) && meta.Labels[Label] == "true"
+}
+
+// RevokeExpired revokes the temporary access that has expired, and returns its records
+func (m *Manager) RevokeExpired() ([]Record, error) {
+	records, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+	now := m.now()
+	var revoked []Record
+	for _, record := range records {
+		if now.Before(record.ExpiresAt) {
+			continue
+		}
+		if err := m.Revoke(record.Name); err != nil {
+			return revoked, err
+		}
+		logger.Info("revoked expired temporary access %q granted by %q (expired at %s)", record.Name, record.GrantedBy, record.ExpiresAt.Format(time.RFC3339))
+		revoked = append(revoked, record)
+	}
+	return revoked, nil
+}
+
+func setRecord(meta *metav1.ObjectMeta, record Record) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrapf(err, "marshalling annotation %q", RecordAnnotation)
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	meta.Annotations[RecordAnnotation] = string(value)
+	return nil
+}
+
+func recordOf(meta metav1.ObjectMeta) (Record, error) {
+	var record Record
+	if err := json.Unmarshal([]byte(meta.Annotations[RecordAnnotation]), &record); err != nil {
+		return Record{}, errors.Wrapf(err, "unmarshalling annotation %q of service account %q", RecordAnnotation, meta.Name)
+	}
+	record.Name = meta.Name
+	return record, nil
+}
@@ -0,0 +1,13 @@
+package tempaccess_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTempAccess(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Temporary Access Suite")
+}
@@ -0,0 +1,148 @@
+package tempaccess_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	"github.com/weaveworks/eksctl/pkg/actions/tempaccess"
+)
+
+var _ = Describe("Temporary access", func() {
+	var (
+		clientSet     *fake.Clientset
+		manager       *tempaccess.Manager
+		now           time.Time
+		tokenRequests []*authenticationv1.TokenRequest
+	)
+
+	BeforeEach(func() {
+		clientSet = fake.NewSimpleClientset(&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"}})
+		generated := 0
+		clientSet.PrependReactor("create", "serviceaccounts", func(action core.Action) (bool, runtime.Object, error) {
+			createAction := action.(core.CreateAction)
+			if action.GetSubresource() == "token" {
+				tokenRequest := createAction.GetObject().(*authenticationv1.TokenRequest)
+				tokenRequests = append(tokenRequests, tokenRequest)
+				tokenRequest.Status = authenticationv1.TokenRequestStatus{
+					Token:               "token",
+					ExpirationTimestamp: metav1.NewTime(now.Add(time.Duration(*tokenRequest.Spec.ExpirationSeconds) * time.Second)),
+				}
+				return true, tokenRequest, nil
+			}
+			serviceAccount := createAction.GetObject().(*corev1.ServiceAccount)
+			if serviceAccount.Name == "" {
+				generated++
+				serviceAccount.Name = fmt.Sprintf("%s%d", serviceAccount.GenerateName, generated)
+			}
+			return false, nil, nil
+		})
+		tokenRequests = nil
+		now = time.Date(2022, 2, 1, 10, 0, 0, 0, time.UTC)
+		manager = tempaccess.New(clientSet)
+		manager.SetNow(func() time.Time {
+			return now
+		})
+	})
+
+	It("grants access with a token of a service account bound to the cluster role, expiring with the access", func() {
+		record, token, err := manager.Grant("cluster-admin", "arn:aws:iam::122333:user/alice", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("token"))
+		Expect(record).To(Equal(tempaccess.Record{
+			Name:        "eksctl-temp-access-1",
+			ClusterRole: "cluster-admin",
+			GrantedBy:   "arn:aws:iam::122333:user/alice",
+			GrantedAt:   now,
+			ExpiresAt:   now.Add(time.Hour),
+		}))
+
+		Expect(tokenRequests).To(HaveLen(1))
+		Expect(*tokenRequests[0].Spec.ExpirationSeconds).To(Equal(int64(3600)))
+
+		binding, err := clientSet.RbacV1().ClusterRoleBindings().Get(context.Background(), "eksctl-temp-access-1", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(binding.RoleRef.Name).To(Equal("cluster-admin"))
+		Expect(binding.Subjects).To(ConsistOf(rbacv1.Subject{Kind: "ServiceAccount", Name: "eksctl-temp-access-1", Namespace: "kube-system"}))
+
+		records, err := manager.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(ConsistOf(record))
+	})
+
+	It("rejects durations for which no token can be issued", func() {
+		_, _, err := manager.Grant("cluster-admin", "", 5*time.Minute)
+		Expect(err).To(MatchError("the duration of temporary access must be between 10m0s and 24h0m0s"))
+		_, _, err = manager.Grant("cluster-admin", "", 25*time.Hour)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails for cluster roles that do not exist, without granting access", func() {
+		_, _, err := manager.Grant("admin", "", time.Hour)
+		Expect(err).To(MatchError(ContainSubstring(`getting cluster role "admin"`)))
+
+		records, err := manager.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(BeEmpty())
+	})
+
+	It("revokes the access that has expired", func() {
+		expiring, _, err := manager.Grant("cluster-admin", "", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		now = now.Add(30 * time.Minute)
+		active, _, err := manager.Grant("cluster-admin", "", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(45 * time.Minute)
+		revoked, err := manager.RevokeExpired()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revoked).To(ConsistOf(expiring))
+
+		records, err := manager.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(ConsistOf(active))
+		_, err = clientSet.RbacV1().ClusterRoleBindings().Get(context.Background(), expiring.Name, metav1.GetOptions{})
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+	})
+
+	It("revokes access before it expires", func() {
+		record, _, err := manager.Grant("cluster-admin", "", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(manager.Revoke(record.Name)).To(Succeed())
+		records, err := manager.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(BeEmpty())
+	})
+
+	It("refuses to revoke service accounts and cluster role bindings not created by eksctl", func() {
+		ctx := context.Background()
+		for _, name := range []string{"aws-node", "eksctl-temp-access-2"} {
+			_, err := clientSet.CoreV1().ServiceAccounts("kube-system").Create(ctx, &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kube-system"},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = clientSet.RbacV1().ClusterRoleBindings().Create(ctx, &rbacv1.ClusterRoleBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(manager.Revoke(name)).NotTo(Succeed())
+
+			_, err = clientSet.CoreV1().ServiceAccounts("kube-system").Get(ctx, name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = clientSet.RbacV1().ClusterRoleBindings().Get(ctx, name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+})
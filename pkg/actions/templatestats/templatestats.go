@@ -0,0 +1,144 @@
+package templatestats
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// CloudFormation quotas, see https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/cloudformation-limits.html
+const (
+	MaxResources  = 500
+	MaxOutputs    = 200
+	MaxParameters = 200
+	MaxMappings   = 200
+	// MaxTemplateSize is the size of templates that can be passed by URL
+	MaxTemplateSize = 1048576
+
+	// warningThreshold is the fraction of a quota from which templates are reported as close to it
+	warningThreshold = 0.8
+)
+
+// placeholderCertificateAuthorityData has the size of the certificates of EKS clusters, the user data of nodegroups
+// embeds it
+var placeholderCertificateAuthorityData = make([]byte, 1066)
+
+// StackStats are the size and numbers of resources, outputs, parameters and mappings of the template of a stack
+type StackStats struct {
+	StackName  string   `json:"stackName"`
+	Size       int      `json:"size"`
+	Resources  int      `json:"resources"`
+	Outputs    int      `json:"outputs"`
+	Parameters int      `json:"parameters"`
+	Mappings   int      `json:"mappings"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
+type template struct {
+	Resources  map[string]json.RawMessage `json:"Resources"`
+	Outputs    map[string]json.RawMessage `json:"Outputs"`
+	Parameters map[string]json.RawMessage `json:"Parameters"`
+	Mappings   map[string]json.RawMessage `json:"Mappings"`
+}
+
+// Compute returns the stats of the template of a stack, with warnings for the quotas it is close to or exceeds
+func Compute(stackName string, templateBody []byte) (StackStats, error) {
+	var t template
+	if err := json.Unmarshal(templateBody, &t); err != nil {
+		return StackStats{}, errors.Wrapf(err, "parsing the template of stack %q", stackName)
+	}
+	stats := StackStats{
+		StackName:  stackName,
+		Size:       len(templateBody),
+		Resources:  len(t.Resources),
+		Outputs:    len(t.Outputs),
+		Parameters: len(t.Parameters),
+		Mappings:   len(t.Mappings),
+	}
+
+	stats.checkQuota(stats.Resources, MaxResources, "resources")
+	stats.checkQuota(stats.Outputs, MaxOutputs, "outputs")
+	stats.checkQuota(stats.Parameters, MaxParameters, "parameters")
+	stats.checkQuota(stats.Mappings, MaxMappings, "mappings")
+	stats.checkQuota(stats.Size, MaxTemplateSize, "bytes")
+	return stats, nil
+}
+
+func (s *StackStats) checkQuota(value, quota int, unit string) {
+	switch {
+	case value > quota:
+		s.Warnings = append(s.Warnings, fmt.Sprintf("%d %s exceed the quota of %d %s per stack", value, unit, quota, unit))
+	case float64(value) >= warningThreshold*float64(quota):
+		s.Warnings = append(s.Warnings, fmt.Sprintf("%d %s are close to the quota of %d %s per stack", value, unit, quota, unit))
+	}
+}
+
+// UploadedToS3 is whether the template is too large to be passed inline and is uploaded to S3
+func (s StackStats) UploadedToS3() bool {
+	return s.Size > manager.MaxTemplateBodySize
+}
+
+// Render renders the templates of the cluster stack and of the stacks of the nodegroups of the config, as they are
+// created by eksctl, and returns their stats. When the cluster does not exist yet, the user data of nodegroups is
+// rendered with a placeholder endpoint and certificate of the same size as those of EKS clusters
+func Render(provider api.ClusterProvider, cfg *api.ClusterConfig) ([]StackStats, error) {
+	if cfg.Status == nil {
+		cfg.Status = &api.ClusterStatus{
+			Endpoint:                 fmt.Sprintf("https://%032X.gr7.%s.eks.amazonaws.com", 0, provider.Region()),
+			CertificateAuthorityData: placeholderCertificateAuthorityData,
+		}
+	}
+
+	clusterStackName := cfg.StackName(fmt.Sprintf("eksctl-%s-cluster", cfg.Metadata.Name))
+	var stats []StackStats
+	add := func(stackName string, rs builder.ResourceSet) error {
+		if err := rs.AddAllResources(); err != nil {
+			return errors.Wrapf(err, "rendering the template of stack %q", stackName)
+		}
+		templateBody, err := rs.RenderJSON()
+		if err != nil {
+			return errors.Wrapf(err, "rendering the template of stack %q", stackName)
+		}
+		s, err := Compute(stackName, templateBody)
+		if err != nil {
+			return err
+		}
+		stats = append(stats, s)
+		return nil
+	}
+
+	if err := add(clusterStackName, builder.NewClusterResourceSet(provider.EC2(), provider.Region(), cfg, true, nil)); err != nil {
+		return nil, err
+	}
+
+	vpcImporter := vpc.NewStackConfigImporter(clusterStackName)
+	for _, ng := range cfg.NodeGroups {
+		bootstrapper, err := nodebootstrap.NewBootstrapper(cfg, ng)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating the bootstrapper of nodegroup %q", ng.Name)
+		}
+		rs := builder.NewNodeGroupResourceSet(provider.EC2(), provider.IAM(), cfg, ng, bootstrapper, false, vpcImporter)
+		if err := add(nodeGroupStackName(cfg, ng.Name), rs); err != nil {
+			return nil, err
+		}
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		bootstrapper := nodebootstrap.NewManagedBootstrapper(cfg, ng)
+		rs := builder.NewManagedNodeGroup(provider.EC2(), cfg, ng, builder.NewLaunchTemplateFetcher(provider.EC2()), bootstrapper, false, vpcImporter)
+		if err := add(nodeGroupStackName(cfg, ng.Name), rs); err != nil {
+			return nil, err
+		}
+	}
+	return stats, nil
+}
+
+func nodeGroupStackName(cfg *api.ClusterConfig, name string) string {
+	return cfg.StackName(fmt.Sprintf("eksctl-%s-nodegroup-%s", cfg.Metadata.Name, name))
+}
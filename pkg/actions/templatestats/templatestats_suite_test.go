@@ -0,0 +1,13 @@
+package templatestats_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTemplateStats(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Template Stats Suite")
+}
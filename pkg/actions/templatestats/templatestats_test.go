@@ -0,0 +1,89 @@
+package templatestats_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/templatestats"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("Template stats", func() {
+	templateWithResources := func(n int) []byte {
+		resources := map[string]interface{}{}
+		for i := 0; i < n; i++ {
+			resources[fmt.Sprintf("Topic%d", i)] = map[string]interface{}{"Type": "AWS::SNS::Topic"}
+		}
+		data, err := json.Marshal(map[string]interface{}{
+			"Resources": resources,
+			"Outputs":   map[string]interface{}{"ARN": map[string]interface{}{"Value": "arn"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return data
+	}
+
+	It("counts the resources and outputs of templates", func() {
+		stats, err := templatestats.Compute("eksctl-cluster-cluster", templateWithResources(3))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats.Resources).To(Equal(3))
+		Expect(stats.Outputs).To(Equal(1))
+		Expect(stats.Parameters).To(Equal(0))
+		Expect(stats.Warnings).To(BeEmpty())
+		Expect(stats.UploadedToS3()).To(BeFalse())
+	})
+
+	It("warns about templates close to the quota of resources", func() {
+		stats, err := templatestats.Compute("eksctl-cluster-cluster", templateWithResources(420))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats.Warnings).To(ConsistOf("420 resources are close to the quota of 500 resources per stack"))
+	})
+
+	It("warns about templates exceeding the quota of resources", func() {
+		stats, err := templatestats.Compute("eksctl-cluster-cluster", templateWithResources(501))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats.Warnings).To(ConsistOf("501 resources exceed the quota of 500 resources per stack"))
+		Expect(stats.UploadedToS3()).To(BeFalse())
+	})
+
+	It("reports templates uploaded to S3", func() {
+		stats, err := templatestats.Compute("eksctl-cluster-cluster", []byte(`{"Description": "`+strings.Repeat("x", manager.MaxTemplateBodySize)+`"}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats.UploadedToS3()).To(BeTrue())
+		Expect(stats.Warnings).To(BeEmpty())
+	})
+
+	It("renders the stacks of the cluster and of its nodegroups", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster"
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng"
+		ng.InstanceType = "m5.large"
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng"
+		mng.InstanceType = "m5.large"
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+		api.SetClusterConfigDefaults(cfg)
+		api.SetClusterEndpointAccessDefaults(cfg.VPC)
+		api.SetNodeGroupDefaults(ng, cfg.Metadata)
+		api.SetManagedNodeGroupDefaults(mng, cfg.Metadata)
+		Expect(vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones)).To(Succeed())
+
+		stats, err := templatestats.Render(mockprovider.NewMockProvider(), cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats).To(HaveLen(3))
+		Expect(stats[0].StackName).To(Equal("eksctl-cluster-cluster"))
+		Expect(stats[0].Resources).To(BeNumerically(">", 10))
+		Expect(stats[1].StackName).To(Equal("eksctl-cluster-nodegroup-ng"))
+		Expect(stats[1].Resources).To(BeNumerically(">", 0))
+		Expect(stats[2].StackName).To(Equal("eksctl-cluster-nodegroup-mng"))
+		Expect(stats[2].Resources).To(BeNumerically(">", 0))
+	})
+})
@@ -0,0 +1,164 @@
+package validators
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/userconfig"
+)
+
+// ClusterConfigEnvVar is the environment variable holding the path of the ClusterConfig passed to validator commands
+const ClusterConfigEnvVar = "EKSCTL_CLUSTER_CONFIG"
+
+// regoQuery is the query of the messages of the deny rules of Rego policies
+const regoQuery = "data.eksctl.deny"
+
+// Runner runs the validators of the user config file against ClusterConfigs.
+type Runner struct {
+	Validators  []*userconfig.Validator
+	NewExecutor func(envVars executor.EnvVars) executor.Executor
+}
+
+// New creates a Runner for the validators.
+func New(validators []*userconfig.Validator) *Runner {
+	return &Runner{
+		Validators:  validators,
+		NewExecutor: executor.NewShellExecutor,
+	}
+}
+
+// Violation is a reason of a validator to reject a ClusterConfig.
+type Violation struct {
+	Validator string
+	Message   string
+}
+
+// RejectedError is returned when validators reject a ClusterConfig.
+type RejectedError struct {
+	Violations []Violation
+}
+
+func (e *RejectedError) Error() string {
+	var lines []string
+	for _, v := range e.Violations {
+		lines = append(lines, fmt.Sprintf("- %s: %s", v.Validator, v.Message))
+	}
+	return fmt.Sprintf("ClusterConfig rejected by validators:\n%s", strings.Join(lines, "\n"))
+}
+
+// Validate runs all validators against the ClusterConfig, and returns a *RejectedError listing the violations
+// of all of them if any rejects it.
+func (r *Runner) Validate(cfg *api.ClusterConfig) error {
+	if len(r.Validators) == 0 {
+		return nil
+	}
+
+	configFile, err := os.CreateTemp("", "eksctl-cluster-config-*.json")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(configFile.Name()); err != nil {
+			logger.Critical("failed to remove temporary ClusterConfig %s", configFile.Name())
+		}
+	}()
+	if err := json.NewEncoder(configFile).Encode(cfg); err != nil {
+		_ = configFile.Close()
+		return errors.Wrap(err, "writing ClusterConfig for validators")
+	}
+	if err := configFile.Close(); err != nil {
+		return err
+	}
+
+	shell := r.NewExecutor(executor.EnvVars{ClusterConfigEnvVar: configFile.Name()})
+	var violations []Violation
+	for _, v := range r.Validators {
+		logger.Debug("running validator %q", v.Name)
+		var (
+			messages []string
+			err      error
+		)
+		if v.Rego != "" {
+			messages, err = evalRego(shell, v.Rego, configFile.Name())
+		} else {
+			messages, err = runCommand(shell, v.Command)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "running validator %q", v.Name)
+		}
+		for _, m := range messages {
+			violations = append(violations, Violation{Validator: v.Name, Message: m})
+		}
+	}
+	if len(violations) > 0 {
+		return &RejectedError{Violations: violations}
+	}
+	return nil
+}
+
+// runCommand runs a validator command, a non-zero exit status rejects the ClusterConfig with the non-empty lines
+// of its output as messages
+func runCommand(shell executor.Executor, command string) ([]string, error) {
+	out, err := shell.ExecWithOut("sh", "-c", command)
+	if err == nil {
+		return nil, nil
+	}
+	exitErr, ok := err.(*exec.ExitError)
+	if !ok {
+		return nil, err
+	}
+	messages := nonEmptyLines(string(out) + "\n" + string(exitErr.Stderr))
+	if len(messages) == 0 {
+		messages = []string{err.Error()}
+	}
+	return messages, nil
+}
+
+// regoOutput is the JSON output of opa eval
+type regoOutput struct {
+	Result []struct {
+		Expressions []struct {
+			Value []string `json:"value"`
+		} `json:"expressions"`
+	} `json:"result"`
+}
+
+// evalRego evaluates the deny rules of a Rego policy with opa, with the ClusterConfig as input
+func evalRego(shell executor.Executor, policy, configPath string) ([]string, error) {
+	out, err := shell.ExecWithOut("opa", "eval", "--format", "json", "--data", policy, "--input", configPath, regoQuery)
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return nil, errors.Errorf("evaluating Rego policy %q: %s", policy, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, errors.Wrapf(err, "evaluating Rego policy %q, is opa installed?", policy)
+	}
+	var output regoOutput
+	if err := json.Unmarshal(out, &output); err != nil {
+		return nil, errors.Wrapf(err, "parsing the %s messages of Rego policy %q", regoQuery, policy)
+	}
+	var messages []string
+	for _, result := range output.Result {
+		for _, expression := range result.Expressions {
+			messages = append(messages, expression.Value...)
+		}
+	}
+	return messages, nil
+}
+
+func nonEmptyLines(s string) []string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,111 @@
+package validators_test
+
+import (
+	"errors"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/validators"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/executor/fakes"
+	"github.com/weaveworks/eksctl/pkg/userconfig"
+)
+
+var _ = Describe("Runner", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.Metadata.Region = "us-west-2"
+	})
+
+	It("accepts any ClusterConfig without validators", func() {
+		Expect(validators.New(nil).Validate(cfg)).To(Succeed())
+	})
+
+	Context("with commands", func() {
+		It("passes the ClusterConfig to the commands", func() {
+			runner := validators.New([]*userconfig.Validator{
+				{Name: "name", Command: `grep -q '"name":"cluster-1"' "$EKSCTL_CLUSTER_CONFIG"`},
+			})
+			Expect(runner.Validate(cfg)).To(Succeed())
+		})
+
+		It("rejects the ClusterConfig with the output of failing commands", func() {
+			runner := validators.New([]*userconfig.Validator{
+				{Name: "accept", Command: "true"},
+				{Name: "instance-types", Command: "echo 'm5.24xlarge is not allowed'; echo 'p3.16xlarge is not allowed' >&2; exit 1"},
+				{Name: "silent", Command: "exit 2"},
+			})
+			err := runner.Validate(cfg)
+			var rejectedErr *validators.RejectedError
+			Expect(errors.As(err, &rejectedErr)).To(BeTrue())
+			Expect(rejectedErr.Violations).To(Equal([]validators.Violation{
+				{Validator: "instance-types", Message: "m5.24xlarge is not allowed"},
+				{Validator: "instance-types", Message: "p3.16xlarge is not allowed"},
+				{Validator: "silent", Message: "exit status 2"},
+			}))
+			Expect(err).To(MatchError(ContainSubstring("- instance-types: m5.24xlarge is not allowed")))
+		})
+	})
+
+	Context("with Rego policies", func() {
+		var (
+			fakeExecutor *fakes.FakeExecutor
+			runner       *validators.Runner
+			configPath   string
+		)
+
+		BeforeEach(func() {
+			fakeExecutor = &fakes.FakeExecutor{}
+			runner = &validators.Runner{
+				Validators: []*userconfig.Validator{{Name: "org-policy", Rego: "policy.rego"}},
+				NewExecutor: func(e executor.EnvVars) executor.Executor {
+					configPath = e[validators.ClusterConfigEnvVar]
+					return fakeExecutor
+				},
+			}
+		})
+
+		It("evaluates the deny rules with opa", func() {
+			fakeExecutor.ExecWithOutStub = func(string, ...string) ([]byte, error) {
+				_, err := os.Stat(configPath)
+				Expect(err).NotTo(HaveOccurred())
+				return []byte(`{"result":[{"expressions":[{"value":[],"text":"data.eksctl.deny"}]}]}`), nil
+			}
+			Expect(runner.Validate(cfg)).To(Succeed())
+
+			Expect(fakeExecutor.ExecWithOutCallCount()).To(Equal(1))
+			command, args := fakeExecutor.ExecWithOutArgsForCall(0)
+			Expect(command).To(Equal("opa"))
+			Expect(args).To(Equal([]string{"eval", "--format", "json", "--data", "policy.rego", "--input", configPath, "data.eksctl.deny"}))
+			_, err := os.Stat(configPath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("rejects the ClusterConfig with the deny messages", func() {
+			fakeExecutor.ExecWithOutReturns([]byte(`{"result":[{"expressions":[{"value":["the API server endpoint must be private"],"text":"data.eksctl.deny"}]}]}`), nil)
+
+			err := runner.Validate(cfg)
+			Expect(err).To(MatchError("ClusterConfig rejected by validators:\n- org-policy: the API server endpoint must be private"))
+		})
+
+		It("accepts the ClusterConfig when the policy has no deny rules", func() {
+			fakeExecutor.ExecWithOutReturns([]byte(`{}`), nil)
+			Expect(runner.Validate(cfg)).To(Succeed())
+		})
+
+		It("fails when opa fails", func() {
+			fakeExecutor.ExecWithOutReturns(nil, errors.New(`exec: "opa": executable file not found in $PATH`))
+
+			err := runner.Validate(cfg)
+			Expect(err).To(MatchError(ContainSubstring(`running validator "org-policy": evaluating Rego policy "policy.rego", is opa installed?`)))
+			var rejectedErr *validators.RejectedError
+			Expect(errors.As(err, &rejectedErr)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,7 @@
+package wait
+
+import "time"
+
+func (w *Waiter) SetDelay(delay time.Duration) {
+	w.delay = delay
+}
@@ -0,0 +1,252 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+)
+
+// Conditions that can be waited for
+const (
+	ConditionClusterActive  = "cluster-active"
+	ConditionNodeGroupReady = "nodegroup-ready"
+	ConditionAddonActive    = "addon-active"
+)
+
+const defaultDelay = 15 * time.Second
+
+// Conditions returns the conditions that can be waited for
+func Conditions() []string {
+	return []string{ConditionClusterActive, ConditionNodeGroupReady, ConditionAddonActive}
+}
+
+// ClientSetFunc returns a Kubernetes client of the cluster, it is only called to wait for the nodes of
+// unmanaged nodegroups
+type ClientSetFunc func() (kubernetes.Interface, error)
+
+// Waiter blocks until the resources of a cluster reach a condition
+type Waiter struct {
+	clusterName  string
+	eksAPI       eksiface.EKSAPI
+	stackManager manager.StackManager
+	clientSet    ClientSetFunc
+	timeout      time.Duration
+	delay        time.Duration
+}
+
+// New creates a Waiter for the cluster.
+func New(clusterName string, eksAPI eksiface.EKSAPI, stackManager manager.StackManager, clientSet ClientSetFunc, timeout time.Duration) *Waiter {
+	return &Waiter{
+		clusterName:  clusterName,
+		eksAPI:       eksAPI,
+		stackManager: stackManager,
+		clientSet:    clientSet,
+		timeout:      timeout,
+		delay:        defaultDelay,
+	}
+}
+
+// For waits for the condition, name is the name of the nodegroup or addon.
+func (w *Waiter) For(condition, name string) error {
+	switch condition {
+	case ConditionClusterActive:
+		return w.ClusterActive()
+	case ConditionNodeGroupReady:
+		return w.NodeGroupReady(name)
+	case ConditionAddonActive:
+		return w.AddonActive(name)
+	default:
+		return fmt.Errorf("invalid condition %q, valid options: %s", condition, strings.Join(Conditions(), ", "))
+	}
+}
+
+// ClusterActive waits for the cluster to become active, including while the cluster does not exist yet.
+func (w *Waiter) ClusterActive() error {
+	resource := fmt.Sprintf("cluster %q", w.clusterName)
+	status := "not found"
+	return w.wait(resource, "active", &status, func() (bool, error) {
+		output, err := w.eksAPI.DescribeCluster(&awseks.DescribeClusterInput{
+			Name: aws.String(w.clusterName),
+		})
+		if err != nil {
+			if isNotFound(err) {
+				return false, nil
+			}
+			return false, errors.Wrapf(err, "describing %s", resource)
+		}
+		switch status = aws.StringValue(output.Cluster.Status); status {
+		case awseks.ClusterStatusActive:
+			return true, nil
+		case awseks.ClusterStatusFailed, awseks.ClusterStatusDeleting:
+			return false, fmt.Errorf("%s is %s", resource, status)
+		}
+		return false, nil
+	})
+}
+
+// AddonActive waits for the addon to become active, including while the addon does not exist yet.
+func (w *Waiter) AddonActive(name string) error {
+	if name == "" {
+		return errors.New("the name of the addon must be set")
+	}
+	resource := fmt.Sprintf("addon %q", name)
+	status := "not found"
+	return w.wait(resource, "active", &status, func() (bool, error) {
+		output, err := w.eksAPI.DescribeAddon(&awseks.DescribeAddonInput{
+			ClusterName: aws.String(w.clusterName),
+			AddonName:   aws.String(name),
+		})
+		if err != nil {
+			if isNotFound(err) {
+				return false, nil
+			}
+			return false, errors.Wrapf(err, "describing %s", resource)
+		}
+		switch status = aws.StringValue(output.Addon.Status); status {
+		case awseks.AddonStatusActive:
+			return true, nil
+		case awseks.AddonStatusCreateFailed, awseks.AddonStatusDeleting, awseks.AddonStatusDeleteFailed:
+			return false, fmt.Errorf("%s is %s", resource, status)
+		}
+		return false, nil
+	})
+}
+
+// NodeGroupReady waits for a managed nodegroup to become active, or for the desired number of nodes of an
+// unmanaged nodegroup to be ready, including while the nodegroup does not exist yet.
+func (w *Waiter) NodeGroupReady(name string) error {
+	if name == "" {
+		return errors.New("the name of the nodegroup must be set")
+	}
+	resource := fmt.Sprintf("nodegroup %q", name)
+	status := "not found"
+	return w.wait(resource, "ready", &status, func() (bool, error) {
+		output, err := w.eksAPI.DescribeNodegroup(&awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String(w.clusterName),
+			NodegroupName: aws.String(name),
+		})
+		if err == nil {
+			switch status = aws.StringValue(output.Nodegroup.Status); status {
+			case awseks.NodegroupStatusActive:
+				return true, nil
+			case awseks.NodegroupStatusCreateFailed, awseks.NodegroupStatusDeleting, awseks.NodegroupStatusDeleteFailed:
+				return false, fmt.Errorf("%s is %s", resource, status)
+			}
+			return false, nil
+		}
+		if !isNotFound(err) {
+			return false, errors.Wrapf(err, "describing %s", resource)
+		}
+		return w.unmanagedNodeGroupReady(name, &status)
+	})
+}
+
+func (w *Waiter) unmanagedNodeGroupReady(name string, status *string) (bool, error) {
+	stacks, err := w.stackManager.DescribeNodeGroupStacks()
+	if err != nil {
+		return false, errors.Wrap(err, "describing nodegroup stacks")
+	}
+	var stack *manager.Stack
+	for _, s := range stacks {
+		if w.stackManager.GetNodeGroupName(s) == name {
+			stack = s
+		}
+	}
+	if stack == nil {
+		return false, nil
+	}
+	*status = aws.StringValue(stack.StackStatus)
+	switch *status {
+	case cloudformation.StackStatusCreateComplete, cloudformation.StackStatusUpdateComplete, cloudformation.StackStatusUpdateRollbackComplete:
+	case cloudformation.StackStatusCreateFailed, cloudformation.StackStatusRollbackComplete, cloudformation.StackStatusRollbackFailed,
+		cloudformation.StackStatusDeleteInProgress, cloudformation.StackStatusDeleteFailed:
+		return false, fmt.Errorf("stack of nodegroup %q is %s", name, *status)
+	default:
+		return false, nil
+	}
+
+	summaries, err := w.stackManager.GetUnmanagedNodeGroupSummaries(name)
+	if err != nil {
+		return false, errors.Wrapf(err, "getting nodegroup %q", name)
+	}
+	if len(summaries) == 0 {
+		return false, nil
+	}
+	desiredCapacity := summaries[0].DesiredCapacity
+
+	clientSet, err := w.clientSet()
+	if err != nil {
+		return false, err
+	}
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", api.NodeGroupNameLabel, name),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "listing nodes of nodegroup %q", name)
+	}
+	readyNodes := 0
+	for _, node := range nodes.Items {
+		if isNodeReady(node) {
+			readyNodes++
+		}
+	}
+	*status = fmt.Sprintf("%d of %d nodes ready", readyNodes, desiredCapacity)
+	return readyNodes >= desiredCapacity, nil
+}
+
+func (w *Waiter) wait(resource, condition string, status *string, operation func() (bool, error)) error {
+	logger.Info("waiting for %s to become %s", resource, condition)
+	poller := waiter.Waiter{
+		Operation: func() (bool, error) {
+			done, err := operation()
+			if err == nil && !done {
+				logger.Debug("%s is not %s yet, status: %q", resource, condition, *status)
+			}
+			return done, err
+		},
+		NextDelay: func(attempts int) time.Duration {
+			if attempts == 1 {
+				return 0
+			}
+			return w.delay
+		},
+	}
+	if err := poller.WaitWithTimeout(w.timeout); err != nil {
+		if err == context.DeadlineExceeded {
+			return errors.Errorf("timed out waiting for %s to become %s after %s, status: %q", resource, condition, w.timeout, *status)
+		}
+		return err
+	}
+	logger.Success("%s is %s", resource, condition)
+	return nil
+}
+
+func isNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == awseks.ErrCodeResourceNotFoundException
+}
+
+func isNodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
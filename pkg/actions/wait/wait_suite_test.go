@@ -0,0 +1,13 @@
+package wait_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestWait(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Wait Suite")
+}
@@ -0,0 +1,155 @@
+package wait_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/wait"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+)
+
+var _ = Describe("Wait", func() {
+	var (
+		eksAPI           *mocks.EKSAPI
+		fakeStackManager *fakes.FakeStackManager
+		clientSet        *fake.Clientset
+		waiter           *wait.Waiter
+	)
+
+	notFound := awserr.New(awseks.ErrCodeResourceNotFoundException, "not found", nil)
+
+	BeforeEach(func() {
+		eksAPI = &mocks.EKSAPI{}
+		fakeStackManager = &fakes.FakeStackManager{}
+		clientSet = fake.NewSimpleClientset()
+		waiter = wait.New("my-cluster", eksAPI, fakeStackManager, func() (kubernetes.Interface, error) {
+			return clientSet, nil
+		}, time.Second)
+		waiter.SetDelay(time.Millisecond)
+	})
+
+	Describe("cluster-active", func() {
+		describeCluster := func(status string) *awseks.DescribeClusterOutput {
+			return &awseks.DescribeClusterOutput{Cluster: &awseks.Cluster{Status: aws.String(status)}}
+		}
+
+		It("waits for the cluster to exist and become active", func() {
+			eksAPI.On("DescribeCluster", &awseks.DescribeClusterInput{Name: aws.String("my-cluster")}).Return(nil, notFound).Once()
+			eksAPI.On("DescribeCluster", mock.Anything).Return(describeCluster(awseks.ClusterStatusCreating), nil).Once()
+			eksAPI.On("DescribeCluster", mock.Anything).Return(describeCluster(awseks.ClusterStatusActive), nil).Once()
+
+			Expect(waiter.For(wait.ConditionClusterActive, "")).To(Succeed())
+			eksAPI.AssertNumberOfCalls(GinkgoT(), "DescribeCluster", 3)
+		})
+
+		It("fails when the cluster failed", func() {
+			eksAPI.On("DescribeCluster", mock.Anything).Return(describeCluster(awseks.ClusterStatusFailed), nil)
+
+			Expect(waiter.ClusterActive()).To(MatchError(`cluster "my-cluster" is FAILED`))
+		})
+
+		It("times out", func() {
+			eksAPI.On("DescribeCluster", mock.Anything).Return(describeCluster(awseks.ClusterStatusUpdating), nil)
+
+			Expect(waiter.ClusterActive()).To(MatchError(`timed out waiting for cluster "my-cluster" to become active after 1s, status: "UPDATING"`))
+		})
+	})
+
+	Describe("addon-active", func() {
+		It("waits for the addon to become active", func() {
+			eksAPI.On("DescribeAddon", &awseks.DescribeAddonInput{
+				ClusterName: aws.String("my-cluster"),
+				AddonName:   aws.String("vpc-cni"),
+			}).Return(&awseks.DescribeAddonOutput{Addon: &awseks.Addon{Status: aws.String(awseks.AddonStatusUpdating)}}, nil).Once()
+			eksAPI.On("DescribeAddon", mock.Anything).Return(&awseks.DescribeAddonOutput{Addon: &awseks.Addon{Status: aws.String(awseks.AddonStatusActive)}}, nil).Once()
+
+			Expect(waiter.For(wait.ConditionAddonActive, "vpc-cni")).To(Succeed())
+		})
+
+		It("fails when the addon failed to be created", func() {
+			eksAPI.On("DescribeAddon", mock.Anything).Return(&awseks.DescribeAddonOutput{Addon: &awseks.Addon{Status: aws.String(awseks.AddonStatusCreateFailed)}}, nil)
+
+			Expect(waiter.AddonActive("vpc-cni")).To(MatchError(`addon "vpc-cni" is CREATE_FAILED`))
+		})
+	})
+
+	Describe("nodegroup-ready", func() {
+		It("waits for managed nodegroups to become active", func() {
+			eksAPI.On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				ClusterName:   aws.String("my-cluster"),
+				NodegroupName: aws.String("ng"),
+			}).Return(&awseks.DescribeNodegroupOutput{Nodegroup: &awseks.Nodegroup{Status: aws.String(awseks.NodegroupStatusCreating)}}, nil).Once()
+			eksAPI.On("DescribeNodegroup", mock.Anything).Return(&awseks.DescribeNodegroupOutput{Nodegroup: &awseks.Nodegroup{Status: aws.String(awseks.NodegroupStatusActive)}}, nil).Once()
+
+			Expect(waiter.For(wait.ConditionNodeGroupReady, "ng")).To(Succeed())
+			Expect(fakeStackManager.DescribeNodeGroupStacksCallCount()).To(Equal(0))
+		})
+
+		Context("unmanaged nodegroups", func() {
+			addNode := func(name string, ready corev1.ConditionStatus) {
+				_, err := clientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   name,
+						Labels: map[string]string{api.NodeGroupNameLabel: "ng"},
+					},
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+					},
+				}, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			BeforeEach(func() {
+				eksAPI.On("DescribeNodegroup", mock.Anything).Return(nil, notFound)
+				fakeStackManager.GetNodeGroupNameReturns("ng")
+				fakeStackManager.GetUnmanagedNodeGroupSummariesReturns([]*manager.NodeGroupSummary{{Name: "ng", DesiredCapacity: 2}}, nil)
+			})
+
+			It("waits for the stack to complete and the nodes to be ready", func() {
+				fakeStackManager.DescribeNodeGroupStacksReturnsOnCall(0, nil, nil)
+				fakeStackManager.DescribeNodeGroupStacksReturnsOnCall(1, []*manager.Stack{{StackStatus: aws.String(cloudformation.StackStatusCreateInProgress)}}, nil)
+				fakeStackManager.DescribeNodeGroupStacksReturns([]*manager.Stack{{StackStatus: aws.String(cloudformation.StackStatusCreateComplete)}}, nil)
+				addNode("node-1", corev1.ConditionTrue)
+				addNode("node-2", corev1.ConditionTrue)
+				addNode("node-3", corev1.ConditionFalse)
+
+				Expect(waiter.NodeGroupReady("ng")).To(Succeed())
+				Expect(fakeStackManager.DescribeNodeGroupStacksCallCount()).To(Equal(3))
+				Expect(fakeStackManager.GetUnmanagedNodeGroupSummariesArgsForCall(0)).To(Equal("ng"))
+			})
+
+			It("times out when too few nodes are ready", func() {
+				fakeStackManager.DescribeNodeGroupStacksReturns([]*manager.Stack{{StackStatus: aws.String(cloudformation.StackStatusCreateComplete)}}, nil)
+				addNode("node-1", corev1.ConditionTrue)
+				addNode("node-2", corev1.ConditionFalse)
+
+				Expect(waiter.NodeGroupReady("ng")).To(MatchError(`timed out waiting for nodegroup "ng" to become ready after 1s, status: "1 of 2 nodes ready"`))
+			})
+
+			It("fails when the stack failed", func() {
+				fakeStackManager.DescribeNodeGroupStacksReturns([]*manager.Stack{{StackStatus: aws.String(cloudformation.StackStatusRollbackComplete)}}, nil)
+
+				Expect(waiter.NodeGroupReady("ng")).To(MatchError(`stack of nodegroup "ng" is ROLLBACK_COMPLETE`))
+			})
+		})
+	})
+
+	It("fails on invalid conditions", func() {
+		Expect(waiter.For("cluster-ready", "")).To(MatchError("invalid condition \"cluster-ready\", valid options: cluster-active, nodegroup-ready, addon-active"))
+	})
+})
@@ -0,0 +1,58 @@
+package defaultaddons
+
+import (
+	"encoding/json"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	// ADOTCollectorNamespace is the namespace of the collectors deployed by the adot addon
+	ADOTCollectorNamespace = "opentelemetry-operator-system"
+	// ADOTCollectorServiceAccounts matches the service accounts of the collectors deployed by the adot addon
+	ADOTCollectorServiceAccounts = "adot-col-*"
+)
+
+// ADOTPolicyNames returns the names of the AWS managed policies the collectors need to export to the given pipelines
+func ADOTPolicyNames(pipelines api.ADOTPipelines) []string {
+	var policies []string
+	if pipelines.AMP != nil {
+		policies = append(policies, "AmazonPrometheusRemoteWriteAccess")
+	}
+	if pipelines.XRay != nil {
+		policies = append(policies, "AWSXrayWriteOnlyAccess")
+	}
+	if pipelines.CloudWatch != nil {
+		policies = append(policies, "CloudWatchAgentServerPolicy")
+	}
+	return policies
+}
+
+// MakeADOTConfigurationValues renders the configuration values of the adot addon enabling the collectors of the given
+// pipelines. The service accounts of the collectors use the given IAM role, if any
+func MakeADOTConfigurationValues(pipelines api.ADOTPipelines, roleARN string) (string, error) {
+	collector := map[string]interface{}{}
+	if p := pipelines.AMP; p != nil {
+		collector["amp"] = map[string]interface{}{
+			"enabled":             true,
+			"remoteWriteEndpoint": p.RemoteWriteEndpoint,
+		}
+	}
+	if pipelines.CloudWatch != nil {
+		collector["cloudwatch"] = map[string]interface{}{"enabled": true}
+	}
+	if pipelines.XRay != nil {
+		collector["xray"] = map[string]interface{}{"enabled": true}
+	}
+	if roleARN != "" {
+		collector["serviceAccount"] = map[string]interface{}{
+			"annotations": map[string]string{api.AnnotationEKSRoleARN: roleARN},
+		}
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"collector": collector})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,43 @@
+package defaultaddons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	da "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("ADOT", func() {
+	It("returns the policies needed by the pipelines", func() {
+		Expect(da.ADOTPolicyNames(api.ADOTPipelines{
+			AMP: &api.ADOTAMPPipeline{RemoteWriteEndpoint: "https://example.com"},
+		})).To(Equal([]string{"AmazonPrometheusRemoteWriteAccess"}))
+
+		Expect(da.ADOTPolicyNames(api.ADOTPipelines{
+			CloudWatch: &api.ADOTCloudWatchPipeline{},
+			XRay:       &api.ADOTXRayPipeline{},
+		})).To(Equal([]string{"AWSXrayWriteOnlyAccess", "CloudWatchAgentServerPolicy"}))
+	})
+
+	It("renders the configuration values enabling the collectors of the pipelines", func() {
+		values, err := da.MakeADOTConfigurationValues(api.ADOTPipelines{
+			AMP:  &api.ADOTAMPPipeline{RemoteWriteEndpoint: "https://example.com/remote_write"},
+			XRay: &api.ADOTXRayPipeline{},
+		}, "role-arn")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values).To(MatchJSON(`{
+			"collector": {
+				"amp": {"enabled": true, "remoteWriteEndpoint": "https://example.com/remote_write"},
+				"xray": {"enabled": true},
+				"serviceAccount": {"annotations": {"eks.amazonaws.com/role-arn": "role-arn"}}
+			}
+		}`))
+	})
+
+	It("does not annotate the service accounts of the collectors without a role", func() {
+		values, err := da.MakeADOTConfigurationValues(api.ADOTPipelines{CloudWatch: &api.ADOTCloudWatchPipeline{}}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values).To(MatchJSON(`{"collector": {"cloudwatch": {"enabled": true}}}`))
+	})
+})
@@ -0,0 +1,89 @@
+package defaultaddons
+
+import (
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	coreDNSMinHighlyAvailableReplicas = 2
+
+	zoneTopologyKey     = "topology.kubernetes.io/zone"
+	hostnameTopologyKey = "kubernetes.io/hostname"
+)
+
+// CoreDNSConfigurationValues returns the configuration values of the coredns addon setting its number of replicas,
+// its autoscaling, and, when it is highly available, spreading it across availability zones and nodes with a
+// PodDisruptionBudget allowing only one replica to be evicted at a time
+func CoreDNSConfigurationValues(config *api.CoreDNSAddonConfig) map[string]interface{} {
+	values := map[string]interface{}{}
+	switch {
+	case config.Replicas != nil:
+		values["replicaCount"] = *config.Replicas
+	case config.Autoscaling != nil:
+		autoScaling := map[string]interface{}{"enabled": true}
+		if config.Autoscaling.MinReplicas != nil {
+			autoScaling["minReplicas"] = *config.Autoscaling.MinReplicas
+		}
+		if config.Autoscaling.MaxReplicas != nil {
+			autoScaling["maxReplicas"] = *config.Autoscaling.MaxReplicas
+		}
+		values["autoScaling"] = autoScaling
+	}
+
+	if api.IsEnabled(config.HighlyAvailable) {
+		if config.Replicas == nil && config.Autoscaling == nil {
+			values["replicaCount"] = coreDNSMinHighlyAvailableReplicas
+		}
+		selector := map[string]interface{}{
+			"matchLabels": map[string]interface{}{"k8s-app": "kube-dns"},
+		}
+		// ScheduleAnyway keeps CoreDNS schedulable when the nodes are in a single availability zone
+		values["topologySpreadConstraints"] = []interface{}{
+			map[string]interface{}{
+				"maxSkew":           1,
+				"topologyKey":       zoneTopologyKey,
+				"whenUnsatisfiable": "ScheduleAnyway",
+				"labelSelector":     selector,
+			},
+		}
+		// the affinity replaces that of the addon, so its node affinity is kept
+		values["affinity"] = map[string]interface{}{
+			"nodeAffinity": map[string]interface{}{
+				"requiredDuringSchedulingIgnoredDuringExecution": map[string]interface{}{
+					"nodeSelectorTerms": []interface{}{
+						map[string]interface{}{
+							"matchExpressions": []interface{}{
+								map[string]interface{}{
+									"key":      "kubernetes.io/os",
+									"operator": "In",
+									"values":   []interface{}{"linux"},
+								},
+								map[string]interface{}{
+									"key":      "kubernetes.io/arch",
+									"operator": "In",
+									"values":   []interface{}{"amd64", "arm64"},
+								},
+							},
+						},
+					},
+				},
+			},
+			"podAntiAffinity": map[string]interface{}{
+				"preferredDuringSchedulingIgnoredDuringExecution": []interface{}{
+					map[string]interface{}{
+						"weight": 100,
+						"podAffinityTerm": map[string]interface{}{
+							"labelSelector": selector,
+							"topologyKey":   hostnameTopologyKey,
+						},
+					},
+				},
+			},
+		}
+		values["podDisruptionBudget"] = map[string]interface{}{
+			"enabled":        true,
+			"maxUnavailable": 1,
+		}
+	}
+	return values
+}
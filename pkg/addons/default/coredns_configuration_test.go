@@ -0,0 +1,80 @@
+package defaultaddons_test
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	da "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("CoreDNS configuration values", func() {
+	render := func(config *api.CoreDNSAddonConfig) string {
+		data, err := json.Marshal(da.CoreDNSConfigurationValues(config))
+		Expect(err).NotTo(HaveOccurred())
+		return string(data)
+	}
+
+	It("sets a fixed number of replicas", func() {
+		Expect(render(&api.CoreDNSAddonConfig{Replicas: aws.Int(3)})).To(MatchJSON(`{"replicaCount": 3}`))
+	})
+
+	It("enables autoscaling", func() {
+		Expect(render(&api.CoreDNSAddonConfig{
+			Autoscaling: &api.CoreDNSAutoscaling{MinReplicas: aws.Int(2), MaxReplicas: aws.Int(10)},
+		})).To(MatchJSON(`{"autoScaling": {"enabled": true, "minReplicas": 2, "maxReplicas": 10}}`))
+
+		Expect(render(&api.CoreDNSAddonConfig{
+			Autoscaling: &api.CoreDNSAutoscaling{},
+		})).To(MatchJSON(`{"autoScaling": {"enabled": true}}`))
+	})
+
+	It("spreads CoreDNS across availability zones and nodes with a PodDisruptionBudget when highly available", func() {
+		Expect(render(&api.CoreDNSAddonConfig{HighlyAvailable: api.Enabled()})).To(MatchJSON(`{
+			"replicaCount": 2,
+			"topologySpreadConstraints": [{
+				"maxSkew": 1,
+				"topologyKey": "topology.kubernetes.io/zone",
+				"whenUnsatisfiable": "ScheduleAnyway",
+				"labelSelector": {"matchLabels": {"k8s-app": "kube-dns"}}
+			}],
+			"affinity": {
+				"nodeAffinity": {
+					"requiredDuringSchedulingIgnoredDuringExecution": {
+						"nodeSelectorTerms": [{
+							"matchExpressions": [
+								{"key": "kubernetes.io/os", "operator": "In", "values": ["linux"]},
+								{"key": "kubernetes.io/arch", "operator": "In", "values": ["amd64", "arm64"]}
+							]
+						}]
+					}
+				},
+				"podAntiAffinity": {
+					"preferredDuringSchedulingIgnoredDuringExecution": [{
+						"weight": 100,
+						"podAffinityTerm": {
+							"labelSelector": {"matchLabels": {"k8s-app": "kube-dns"}},
+							"topologyKey": "kubernetes.io/hostname"
+						}
+					}]
+				}
+			},
+			"podDisruptionBudget": {"enabled": true, "maxUnavailable": 1}
+		}`))
+	})
+
+	It("keeps the number of replicas or the autoscaling when highly available", func() {
+		values := da.CoreDNSConfigurationValues(&api.CoreDNSAddonConfig{Replicas: aws.Int(4), HighlyAvailable: api.Enabled()})
+		Expect(values).To(HaveKeyWithValue("replicaCount", 4))
+
+		values = da.CoreDNSConfigurationValues(&api.CoreDNSAddonConfig{
+			Autoscaling:     &api.CoreDNSAutoscaling{MinReplicas: aws.Int(3)},
+			HighlyAvailable: api.Enabled(),
+		})
+		Expect(values).NotTo(HaveKey("replicaCount"))
+		Expect(values).To(HaveKey("autoScaling"))
+	})
+})
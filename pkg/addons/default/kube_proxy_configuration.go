@@ -0,0 +1,10 @@
+package defaultaddons
+
+import (
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// KubeProxyConfigurationValues returns the configuration values of the kube-proxy addon setting its proxy mode
+func KubeProxyConfigurationValues(config *api.KubeProxyAddonConfig) map[string]interface{} {
+	return map[string]interface{}{"mode": config.Mode}
+}
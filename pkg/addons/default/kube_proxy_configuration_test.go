@@ -0,0 +1,17 @@
+package defaultaddons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	da "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("KubeProxy configuration values", func() {
+	It("sets the proxy mode", func() {
+		Expect(da.KubeProxyConfigurationValues(&api.KubeProxyAddonConfig{Mode: "ipvs"})).To(Equal(map[string]interface{}{
+			"mode": "ipvs",
+		}))
+	})
+})
@@ -0,0 +1,89 @@
+package defaultaddons
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// PriorityExpanderConfigMap is the ConfigMap of the priorities of the priority expander of cluster-autoscaler
+	PriorityExpanderConfigMap = "cluster-autoscaler-priority-expander"
+	priorityExpanderKey       = "priorities"
+
+	spotPriority     = 20
+	onDemandPriority = 10
+)
+
+// ConfigureSpotFallbackPriorities adds the ASGs of the spot and on-demand nodegroups of the spot fallback groups
+// to the priorities of the priority expander of cluster-autoscaler, so that it scales up the on-demand nodegroup
+// of a group only when the spot one cannot be scaled up. Existing priorities are kept.
+func ConfigureSpotFallbackPriorities(clientSet kubernetes.Interface, groups []string) error {
+	configMaps := clientSet.CoreV1().ConfigMaps(metav1.NamespaceSystem)
+	configMap, err := configMaps.Get(context.TODO(), PriorityExpanderConfigMap, metav1.GetOptions{})
+	exists := err == nil
+	if err != nil {
+		if !apierrs.IsNotFound(err) {
+			return errors.Wrapf(err, "getting %q", PriorityExpanderConfigMap)
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      PriorityExpanderConfigMap,
+				Namespace: metav1.NamespaceSystem,
+			},
+		}
+	}
+
+	priorities := map[int][]string{}
+	if data := configMap.Data[priorityExpanderKey]; data != "" {
+		if err := yaml.Unmarshal([]byte(data), &priorities); err != nil {
+			return errors.Wrapf(err, "parsing priorities of %q", PriorityExpanderConfigMap)
+		}
+	}
+	for _, group := range groups {
+		priorities[spotPriority] = appendPattern(priorities[spotPriority], managedNodeGroupASGPattern(group+"-spot"))
+		priorities[onDemandPriority] = appendPattern(priorities[onDemandPriority], managedNodeGroupASGPattern(group+"-on-demand"))
+	}
+	data, err := yaml.Marshal(priorities)
+	if err != nil {
+		return err
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[priorityExpanderKey] = string(data)
+
+	if exists {
+		_, err = configMaps.Update(context.TODO(), configMap, metav1.UpdateOptions{})
+	} else {
+		_, err = configMaps.Create(context.TODO(), configMap, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "saving %q", PriorityExpanderConfigMap)
+	}
+	logger.Info("configured priorities of spot fallback nodegroups in %q, run cluster-autoscaler with --expander=priority to use them", PriorityExpanderConfigMap)
+	return nil
+}
+
+// managedNodeGroupASGPattern returns a pattern matching the name of the ASG of a managed nodegroup,
+// `eks-<nodegroup>-<uuid>`
+func managedNodeGroupASGPattern(nodeGroupName string) string {
+	return fmt.Sprintf("^eks-%s-[0-9a-f-]+$", regexp.QuoteMeta(nodeGroupName))
+}
+
+func appendPattern(patterns []string, pattern string) []string {
+	for _, p := range patterns {
+		if p == pattern {
+			return patterns
+		}
+	}
+	return append(patterns, pattern)
+}
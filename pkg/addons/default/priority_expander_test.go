@@ -0,0 +1,68 @@
+package defaultaddons_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	da "github.com/weaveworks/eksctl/pkg/addons/default"
+)
+
+var _ = Describe("Spot fallback priorities", func() {
+	getPriorities := func(clientSet *fake.Clientset) string {
+		configMap, err := clientSet.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(context.TODO(), da.PriorityExpanderConfigMap, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return configMap.Data["priorities"]
+	}
+
+	It("creates the priority expander ConfigMap", func() {
+		clientSet := fake.NewSimpleClientset()
+		Expect(da.ConfigureSpotFallbackPriorities(clientSet, []string{"workers"})).To(Succeed())
+
+		Expect(getPriorities(clientSet)).To(MatchYAML(`
+10:
+- ^eks-workers-on-demand-[0-9a-f-]+$
+20:
+- ^eks-workers-spot-[0-9a-f-]+$
+`))
+	})
+
+	It("keeps existing priorities", func() {
+		clientSet := fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      da.PriorityExpanderConfigMap,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Data: map[string]string{
+				"priorities": "20:\n- ^eks-workers-spot-[0-9a-f-]+$\n50:\n- .*gpu.*\n",
+			},
+		})
+		Expect(da.ConfigureSpotFallbackPriorities(clientSet, []string{"workers", "batch"})).To(Succeed())
+
+		Expect(getPriorities(clientSet)).To(MatchYAML(`
+10:
+- ^eks-workers-on-demand-[0-9a-f-]+$
+- ^eks-batch-on-demand-[0-9a-f-]+$
+20:
+- ^eks-workers-spot-[0-9a-f-]+$
+- ^eks-batch-spot-[0-9a-f-]+$
+50:
+- .*gpu.*
+`))
+	})
+
+	It("fails on invalid priorities", func() {
+		clientSet := fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      da.PriorityExpanderConfigMap,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Data: map[string]string{"priorities": "high: []"},
+		})
+		Expect(da.ConfigureSpotFallbackPriorities(clientSet, []string{"workers"})).To(MatchError(ContainSubstring("parsing priorities")))
+	})
+})
@@ -3,6 +3,8 @@ package v1alpha5
 import (
 	"fmt"
 	"strings"
+
+	"sigs.k8s.io/yaml"
 )
 
 // Addon holds the EKS addon configuration
@@ -28,10 +30,126 @@ type Addon struct {
 	// Each tag consists of a key and an optional value, both of which you define.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+	// ConfigurationValues of the addon, as a JSON or YAML document matching the configuration schema of the addon
+	// version. When not set, updates leave the configuration values of the addon unchanged
+	// +optional
+	ConfigurationValues string `json:"configurationValues,omitempty"`
+	// KubeProxy holds configuration shortcuts of the `kube-proxy` addon, rendered as its configuration values
+	// +optional
+	KubeProxy *KubeProxyAddonConfig `json:"kubeProxy,omitempty"`
+	// CoreDNS holds configuration shortcuts of the `coredns` addon, rendered as its configuration values
+	// +optional
+	CoreDNS *CoreDNSAddonConfig `json:"coreDNS,omitempty"`
+	// ADOT holds the pipelines of the collectors deployed by the `adot` addon, rendered as its configuration values
+	// +optional
+	ADOT *ADOTAddonConfig `json:"adot,omitempty"`
+	// PodIdentityAssociations grant IAM permissions to the service accounts of the addon through
+	// EKS Pod Identity, which requires the `eks-pod-identity-agent` addon
+	// +optional
+	PodIdentityAssociations []PodIdentityAssociation `json:"podIdentityAssociations,omitempty"`
 	// Force applies the add-on to overwrite an existing add-on
 	Force bool `json:"-"`
 }
 
+// PodIdentityAssociation associates an IAM role with a service account through EKS Pod Identity
+type PodIdentityAssociation struct {
+	// +required
+	Namespace string `json:"namespace"`
+	// +required
+	ServiceAccountName string `json:"serviceAccountName"`
+	// ARN of an existing IAM role to associate, a role is created from the permission policies otherwise
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+	// list of ARNs of the IAM policies to attach to the created role
+	// +optional
+	PermissionPolicyARNs []string `json:"permissionPolicyARNs,omitempty"`
+	// PermissionPolicy holds a policy document to attach to the created role
+	// +optional
+	PermissionPolicy InlineDocument `json:"permissionPolicy,omitempty"`
+	// WellKnownPolicies for attaching common IAM policies to the created role
+	// +optional
+	WellKnownPolicies WellKnownPolicies `json:"wellKnownPolicies,omitempty"`
+	// ARN of the permissions' boundary of the created role
+	// +optional
+	PermissionsBoundaryARN string `json:"permissionsBoundaryARN,omitempty"`
+}
+
+// HasPolicies returns whether any permission policy is set on the association
+func (p PodIdentityAssociation) HasPolicies() bool {
+	return len(p.PermissionPolicyARNs) > 0 || p.PermissionPolicy != nil || p.WellKnownPolicies.HasPolicy()
+}
+
+// KubeProxyAddonConfig holds the configuration of the kube-proxy addon
+type KubeProxyAddonConfig struct {
+	// Mode is the proxy mode of kube-proxy, valid variants are `KubeProxyMode` constants
+	// +optional
+	Mode string `json:"mode,omitempty"`
+}
+
+// Values for `KubeProxyMode`
+const (
+	KubeProxyModeIPTables = "iptables"
+	KubeProxyModeIPVS     = "ipvs"
+)
+
+// CoreDNSAddonConfig holds the configuration of the coredns addon
+type CoreDNSAddonConfig struct {
+	// Replicas is the number of replicas of the CoreDNS deployment
+	// +optional
+	Replicas *int `json:"replicas,omitempty"`
+	// Autoscaling enables the autoscaling of CoreDNS by EKS with the size of the cluster
+	// +optional
+	Autoscaling *CoreDNSAutoscaling `json:"autoscaling,omitempty"`
+	// HighlyAvailable runs at least two replicas of CoreDNS spread across availability zones and nodes, and
+	// limits voluntary disruptions to one replica at a time with a PodDisruptionBudget
+	// +optional
+	HighlyAvailable *bool `json:"highlyAvailable,omitempty"`
+}
+
+// CoreDNSAutoscaling holds the bounds of the autoscaling of CoreDNS
+type CoreDNSAutoscaling struct {
+	// MinReplicas is the minimum number of CoreDNS replicas
+	// Defaults to `2`
+	// +optional
+	MinReplicas *int `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of CoreDNS replicas
+	// +optional
+	MaxReplicas *int `json:"maxReplicas,omitempty"`
+}
+
+// ADOTAddonConfig holds the configuration of the collectors deployed by the adot addon
+type ADOTAddonConfig struct {
+	// Pipelines the collectors export telemetry to, at least one must be set
+	// +required
+	Pipelines ADOTPipelines `json:"pipelines"`
+}
+
+// ADOTPipelines holds the pipelines of the collectors
+type ADOTPipelines struct {
+	// AMP exports metrics to Amazon Managed Service for Prometheus
+	// +optional
+	AMP *ADOTAMPPipeline `json:"amp,omitempty"`
+	// CloudWatch exports metrics to CloudWatch
+	// +optional
+	CloudWatch *ADOTCloudWatchPipeline `json:"cloudWatch,omitempty"`
+	// XRay exports traces to AWS X-Ray
+	// +optional
+	XRay *ADOTXRayPipeline `json:"xRay,omitempty"`
+}
+
+// ADOTAMPPipeline holds the settings of the Amazon Managed Service for Prometheus pipeline
+type ADOTAMPPipeline struct {
+	// RemoteWriteEndpoint is the remote write URL of the workspace
+	// +required
+	RemoteWriteEndpoint string `json:"remoteWriteEndpoint"`
+}
+
+// ADOTCloudWatchPipeline holds the settings of the CloudWatch pipeline
+type ADOTCloudWatchPipeline struct{}
+
+// ADOTXRayPipeline holds the settings of the X-Ray pipeline
+type ADOTXRayPipeline struct{}
+
 func (a Addon) CanonicalName() string {
 	return strings.ToLower(a.Name)
 }
@@ -41,29 +159,130 @@ func (a Addon) Validate() error {
 		return fmt.Errorf("name required")
 	}
 
-	return a.checkOnlyOnePolicyProviderIsSet()
+	if err := a.validateConfiguration(); err != nil {
+		return err
+	}
+
+	if err := a.validatePodIdentityAssociations(); err != nil {
+		return err
+	}
+
+	return a.checkRoleIsNotSetWithPolicies()
 }
 
-func (a Addon) checkOnlyOnePolicyProviderIsSet() error {
-	setPolicyProviders := 0
-	if a.AttachPolicy != nil {
-		setPolicyProviders++
+func (a Addon) validateConfiguration() error {
+	if a.ConfigurationValues != "" {
+		var values map[string]interface{}
+		if err := yaml.Unmarshal([]byte(a.ConfigurationValues), &values); err != nil {
+			return fmt.Errorf("configurationValues must be a JSON or YAML object: %w", err)
+		}
 	}
 
-	if a.AttachPolicyARNs != nil && len(a.AttachPolicyARNs) > 0 {
-		setPolicyProviders++
+	if a.KubeProxy != nil {
+		if a.CanonicalName() != "kube-proxy" {
+			return fmt.Errorf("kubeProxy can only be set for the kube-proxy addon")
+		}
+		switch a.KubeProxy.Mode {
+		case KubeProxyModeIPTables, KubeProxyModeIPVS:
+		default:
+			return fmt.Errorf("invalid value %q for kubeProxy.mode, must be one of: %s, %s", a.KubeProxy.Mode, KubeProxyModeIPTables, KubeProxyModeIPVS)
+		}
 	}
 
-	if a.ServiceAccountRoleARN != "" {
-		setPolicyProviders++
+	if c := a.ADOT; c != nil {
+		if a.CanonicalName() != "adot" {
+			return fmt.Errorf("adot can only be set for the adot addon")
+		}
+		if a.ConfigurationValues != "" {
+			return fmt.Errorf("adot and configurationValues are mutually exclusive")
+		}
+		p := c.Pipelines
+		if p.AMP == nil && p.CloudWatch == nil && p.XRay == nil {
+			return fmt.Errorf("at least one of adot.pipelines.amp, cloudWatch or xRay must be set")
+		}
+		if p.AMP != nil && p.AMP.RemoteWriteEndpoint == "" {
+			return fmt.Errorf("adot.pipelines.amp.remoteWriteEndpoint must be set")
+		}
 	}
 
-	if a.WellKnownPolicies.HasPolicy() {
-		setPolicyProviders++
+	if c := a.CoreDNS; c != nil {
+		if a.CanonicalName() != "coredns" {
+			return fmt.Errorf("coreDNS can only be set for the coredns addon")
+		}
+		if c.Replicas != nil && c.Autoscaling != nil {
+			return fmt.Errorf("coreDNS.replicas and coreDNS.autoscaling are mutually exclusive")
+		}
+		if c.Replicas != nil && *c.Replicas < 1 {
+			return fmt.Errorf("coreDNS.replicas must be at least 1")
+		}
+		if as := c.Autoscaling; as != nil {
+			for _, field := range []struct {
+				name  string
+				value *int
+			}{
+				{"minReplicas", as.MinReplicas},
+				{"maxReplicas", as.MaxReplicas},
+			} {
+				if field.value != nil && *field.value < 1 {
+					return fmt.Errorf("coreDNS.autoscaling.%s must be at least 1", field.name)
+				}
+			}
+			if as.MinReplicas != nil && as.MaxReplicas != nil && *as.MinReplicas > *as.MaxReplicas {
+				return fmt.Errorf("coreDNS.autoscaling.minReplicas cannot be greater than maxReplicas")
+			}
+		}
+		if IsEnabled(c.HighlyAvailable) {
+			if c.Replicas != nil && *c.Replicas < 2 {
+				return fmt.Errorf("coreDNS.replicas must be at least 2 when coreDNS.highlyAvailable is enabled")
+			}
+			if c.Autoscaling != nil && c.Autoscaling.MinReplicas != nil && *c.Autoscaling.MinReplicas < 2 {
+				return fmt.Errorf("coreDNS.autoscaling.minReplicas must be at least 2 when coreDNS.highlyAvailable is enabled")
+			}
+		}
 	}
+	return nil
+}
 
-	if setPolicyProviders > 1 {
-		return fmt.Errorf("at most one of wellKnownPolicies, serviceAccountRoleARN, attachPolicyARNs and attachPolicy can be specified")
+func (a Addon) validatePodIdentityAssociations() error {
+	if len(a.PodIdentityAssociations) == 0 {
+		return nil
+	}
+	if a.ServiceAccountRoleARN != "" || a.AttachPolicy != nil || len(a.AttachPolicyARNs) > 0 || a.WellKnownPolicies.HasPolicy() {
+		return fmt.Errorf("podIdentityAssociations cannot be specified with serviceAccountRoleARN, wellKnownPolicies, attachPolicyARNs or attachPolicy")
+	}
+
+	seen := make(map[string]bool)
+	for i, p := range a.PodIdentityAssociations {
+		path := fmt.Sprintf("podIdentityAssociations[%d]", i)
+		if p.Namespace == "" {
+			return fmt.Errorf("%s.namespace must be set", path)
+		}
+		if p.ServiceAccountName == "" {
+			return fmt.Errorf("%s.serviceAccountName must be set", path)
+		}
+		key := p.Namespace + "/" + p.ServiceAccountName
+		if seen[key] {
+			return fmt.Errorf("%s: service account %q is associated more than once", path, key)
+		}
+		seen[key] = true
+
+		if p.RoleARN != "" {
+			if p.HasPolicies() || p.PermissionsBoundaryARN != "" {
+				return fmt.Errorf("%s.roleARN cannot be specified with permissionPolicyARNs, permissionPolicy, wellKnownPolicies or permissionsBoundaryARN", path)
+			}
+		} else if !p.HasPolicies() {
+			return fmt.Errorf("%s: at least one of roleARN, permissionPolicyARNs, permissionPolicy or wellKnownPolicies must be set", path)
+		}
+	}
+	return nil
+}
+
+func (a Addon) checkRoleIsNotSetWithPolicies() error {
+	if a.ServiceAccountRoleARN == "" {
+		return nil
+	}
+	if a.AttachPolicy != nil || len(a.AttachPolicyARNs) > 0 || a.WellKnownPolicies.HasPolicy() {
+		return fmt.Errorf("serviceAccountRoleARN cannot be specified with wellKnownPolicies, attachPolicyARNs or attachPolicy")
 	}
 	return nil
 }
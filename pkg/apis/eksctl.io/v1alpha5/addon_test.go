@@ -1,6 +1,7 @@
 package v1alpha5_test
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -15,7 +16,7 @@ var _ = Describe("Addon", func() {
 			})
 		})
 
-		When("specifying more than one of serviceAccountRoleARN, attachPolicyARNs, attachPolicy", func() {
+		When("specifying serviceAccountRoleARN with attachPolicyARNs, attachPolicy or wellKnownPolicies", func() {
 			It("errors", func() {
 				err := v1alpha5.Addon{
 					Name:                  "name",
@@ -23,39 +24,275 @@ var _ = Describe("Addon", func() {
 					ServiceAccountRoleARN: "foo",
 					AttachPolicyARNs:      []string{"arn"},
 				}.Validate()
-				Expect(err).To(MatchError("at most one of wellKnownPolicies, serviceAccountRoleARN, attachPolicyARNs and attachPolicy can be specified"))
+				Expect(err).To(MatchError("serviceAccountRoleARN cannot be specified with wellKnownPolicies, attachPolicyARNs or attachPolicy"))
 
 				err = v1alpha5.Addon{
-					Name:    "name",
-					Version: "version",
+					Name:                  "name",
+					Version:               "version",
+					ServiceAccountRoleARN: "foo",
 					AttachPolicy: map[string]interface{}{
 						"foo": "bar",
 					},
-					AttachPolicyARNs: []string{"arn"},
 				}.Validate()
-				Expect(err).To(MatchError("at most one of wellKnownPolicies, serviceAccountRoleARN, attachPolicyARNs and attachPolicy can be specified"))
+				Expect(err).To(MatchError("serviceAccountRoleARN cannot be specified with wellKnownPolicies, attachPolicyARNs or attachPolicy"))
 
 				err = v1alpha5.Addon{
 					Name:                  "name",
 					Version:               "version",
 					ServiceAccountRoleARN: "foo",
+					WellKnownPolicies: v1alpha5.WellKnownPolicies{
+						AutoScaler: true,
+					},
+				}.Validate()
+				Expect(err).To(MatchError("serviceAccountRoleARN cannot be specified with wellKnownPolicies, attachPolicyARNs or attachPolicy"))
+			})
+		})
+
+		When("specifying attachPolicyARNs, attachPolicy and wellKnownPolicies together", func() {
+			It("does not error", func() {
+				err := v1alpha5.Addon{
+					Name:             "name",
+					Version:          "version",
+					AttachPolicyARNs: []string{"arn"},
 					AttachPolicy: map[string]interface{}{
 						"foo": "bar",
 					},
+					WellKnownPolicies: v1alpha5.WellKnownPolicies{
+						AutoScaler: true,
+					},
+				}.Validate()
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("adot is set", func() {
+			It("accepts pipelines", func() {
+				Expect(v1alpha5.Addon{
+					Name: "adot",
+					ADOT: &v1alpha5.ADOTAddonConfig{
+						Pipelines: v1alpha5.ADOTPipelines{
+							AMP:  &v1alpha5.ADOTAMPPipeline{RemoteWriteEndpoint: "https://aps-workspaces.us-west-2.amazonaws.com/workspaces/ws-1/api/v1/remote_write"},
+							XRay: &v1alpha5.ADOTXRayPipeline{},
+						},
+					},
+				}.Validate()).To(Succeed())
+			})
+
+			It("errors when no pipeline is set", func() {
+				err := v1alpha5.Addon{
+					Name: "adot",
+					ADOT: &v1alpha5.ADOTAddonConfig{},
 				}.Validate()
-				Expect(err).To(MatchError("at most one of wellKnownPolicies, serviceAccountRoleARN, attachPolicyARNs and attachPolicy can be specified"))
+				Expect(err).To(MatchError("at least one of adot.pipelines.amp, cloudWatch or xRay must be set"))
+			})
+
+			It("errors when a pipeline endpoint is missing", func() {
+				err := v1alpha5.Addon{
+					Name: "adot",
+					ADOT: &v1alpha5.ADOTAddonConfig{
+						Pipelines: v1alpha5.ADOTPipelines{AMP: &v1alpha5.ADOTAMPPipeline{}},
+					},
+				}.Validate()
+				Expect(err).To(MatchError("adot.pipelines.amp.remoteWriteEndpoint must be set"))
+			})
+
+			It("errors when configurationValues are set", func() {
+				err := v1alpha5.Addon{
+					Name:                "adot",
+					ConfigurationValues: `{"collector": {}}`,
+					ADOT: &v1alpha5.ADOTAddonConfig{
+						Pipelines: v1alpha5.ADOTPipelines{XRay: &v1alpha5.ADOTXRayPipeline{}},
+					},
+				}.Validate()
+				Expect(err).To(MatchError("adot and configurationValues are mutually exclusive"))
+			})
+
+			It("errors for other addons", func() {
+				err := v1alpha5.Addon{
+					Name: "coredns",
+					ADOT: &v1alpha5.ADOTAddonConfig{
+						Pipelines: v1alpha5.ADOTPipelines{XRay: &v1alpha5.ADOTXRayPipeline{}},
+					},
+				}.Validate()
+				Expect(err).To(MatchError("adot can only be set for the adot addon"))
+			})
+		})
+
+		When("podIdentityAssociations are set", func() {
+			It("accepts a role or policies", func() {
+				Expect(v1alpha5.Addon{
+					Name: "name",
+					PodIdentityAssociations: []v1alpha5.PodIdentityAssociation{
+						{Namespace: "kube-system", ServiceAccountName: "sa-1", RoleARN: "arn"},
+						{Namespace: "kube-system", ServiceAccountName: "sa-2", PermissionPolicyARNs: []string{"arn"}},
+					},
+				}.Validate()).To(Succeed())
+			})
+
+			It("errors when the service account is not set", func() {
+				err := v1alpha5.Addon{
+					Name:                    "name",
+					PodIdentityAssociations: []v1alpha5.PodIdentityAssociation{{Namespace: "kube-system", RoleARN: "arn"}},
+				}.Validate()
+				Expect(err).To(MatchError("podIdentityAssociations[0].serviceAccountName must be set"))
+			})
+
+			It("errors when a service account is associated twice", func() {
+				err := v1alpha5.Addon{
+					Name: "name",
+					PodIdentityAssociations: []v1alpha5.PodIdentityAssociation{
+						{Namespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn"},
+						{Namespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn"},
+					},
+				}.Validate()
+				Expect(err).To(MatchError(`podIdentityAssociations[1]: service account "kube-system/sa" is associated more than once`))
+			})
+
+			It("errors when neither a role nor policies are set, or both are", func() {
+				err := v1alpha5.Addon{
+					Name:                    "name",
+					PodIdentityAssociations: []v1alpha5.PodIdentityAssociation{{Namespace: "kube-system", ServiceAccountName: "sa"}},
+				}.Validate()
+				Expect(err).To(MatchError("podIdentityAssociations[0]: at least one of roleARN, permissionPolicyARNs, permissionPolicy or wellKnownPolicies must be set"))
 
 				err = v1alpha5.Addon{
-					Name:    "name",
-					Version: "version",
-					WellKnownPolicies: v1alpha5.WellKnownPolicies{
-						AutoScaler: true,
+					Name: "name",
+					PodIdentityAssociations: []v1alpha5.PodIdentityAssociation{{
+						Namespace:          "kube-system",
+						ServiceAccountName: "sa",
+						RoleARN:            "arn",
+						WellKnownPolicies:  v1alpha5.WellKnownPolicies{AutoScaler: true},
+					}},
+				}.Validate()
+				Expect(err).To(MatchError("podIdentityAssociations[0].roleARN cannot be specified with permissionPolicyARNs, permissionPolicy, wellKnownPolicies or permissionsBoundaryARN"))
+			})
+
+			It("errors when the addon IAM settings are set too", func() {
+				err := v1alpha5.Addon{
+					Name:                    "name",
+					ServiceAccountRoleARN:   "arn",
+					PodIdentityAssociations: []v1alpha5.PodIdentityAssociation{{Namespace: "kube-system", ServiceAccountName: "sa", RoleARN: "arn"}},
+				}.Validate()
+				Expect(err).To(MatchError("podIdentityAssociations cannot be specified with serviceAccountRoleARN, wellKnownPolicies, attachPolicyARNs or attachPolicy"))
+			})
+		})
+
+		When("configurationValues are set", func() {
+			It("accepts JSON and YAML objects", func() {
+				for _, values := range []string{`{"replicaCount": 3}`, "replicaCount: 3\nresources:\n  limits:\n    memory: 256Mi"} {
+					err := v1alpha5.Addon{
+						Name:                "coredns",
+						ConfigurationValues: values,
+					}.Validate()
+					Expect(err).NotTo(HaveOccurred())
+				}
+			})
+
+			It("errors for values that are not objects", func() {
+				err := v1alpha5.Addon{
+					Name:                "coredns",
+					ConfigurationValues: "- replicaCount",
+				}.Validate()
+				Expect(err).To(MatchError(ContainSubstring("configurationValues must be a JSON or YAML object")))
+			})
+		})
+
+		When("kubeProxy is set", func() {
+			It("accepts valid modes for kube-proxy", func() {
+				err := v1alpha5.Addon{
+					Name:      "kube-proxy",
+					KubeProxy: &v1alpha5.KubeProxyAddonConfig{Mode: "ipvs"},
+				}.Validate()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("errors for invalid modes", func() {
+				err := v1alpha5.Addon{
+					Name:      "kube-proxy",
+					KubeProxy: &v1alpha5.KubeProxyAddonConfig{Mode: "userspace"},
+				}.Validate()
+				Expect(err).To(MatchError(`invalid value "userspace" for kubeProxy.mode, must be one of: iptables, ipvs`))
+			})
+
+			It("errors for other addons", func() {
+				err := v1alpha5.Addon{
+					Name:      "coredns",
+					KubeProxy: &v1alpha5.KubeProxyAddonConfig{Mode: "ipvs"},
+				}.Validate()
+				Expect(err).To(MatchError("kubeProxy can only be set for the kube-proxy addon"))
+			})
+		})
+
+		When("coreDNS is set", func() {
+			It("accepts replicas or autoscaling", func() {
+				Expect(v1alpha5.Addon{
+					Name:    "coredns",
+					CoreDNS: &v1alpha5.CoreDNSAddonConfig{Replicas: aws.Int(3)},
+				}.Validate()).To(Succeed())
+
+				Expect(v1alpha5.Addon{
+					Name: "coredns",
+					CoreDNS: &v1alpha5.CoreDNSAddonConfig{
+						Autoscaling: &v1alpha5.CoreDNSAutoscaling{MinReplicas: aws.Int(2), MaxReplicas: aws.Int(10)},
 					},
-					AttachPolicy: map[string]interface{}{
-						"foo": "bar",
+				}.Validate()).To(Succeed())
+			})
+
+			It("errors when both replicas and autoscaling are set", func() {
+				err := v1alpha5.Addon{
+					Name: "coredns",
+					CoreDNS: &v1alpha5.CoreDNSAddonConfig{
+						Replicas:    aws.Int(3),
+						Autoscaling: &v1alpha5.CoreDNSAutoscaling{},
+					},
+				}.Validate()
+				Expect(err).To(MatchError("coreDNS.replicas and coreDNS.autoscaling are mutually exclusive"))
+			})
+
+			It("errors for invalid autoscaling parameters", func() {
+				err := v1alpha5.Addon{
+					Name: "coredns",
+					CoreDNS: &v1alpha5.CoreDNSAddonConfig{
+						Autoscaling: &v1alpha5.CoreDNSAutoscaling{MaxReplicas: aws.Int(0)},
+					},
+				}.Validate()
+				Expect(err).To(MatchError("coreDNS.autoscaling.maxReplicas must be at least 1"))
+
+				err = v1alpha5.Addon{
+					Name: "coredns",
+					CoreDNS: &v1alpha5.CoreDNSAddonConfig{
+						Autoscaling: &v1alpha5.CoreDNSAutoscaling{MinReplicas: aws.Int(5), MaxReplicas: aws.Int(2)},
+					},
+				}.Validate()
+				Expect(err).To(MatchError("coreDNS.autoscaling.minReplicas cannot be greater than maxReplicas"))
+			})
+
+			It("errors when highlyAvailable is enabled with less than 2 replicas", func() {
+				err := v1alpha5.Addon{
+					Name: "coredns",
+					CoreDNS: &v1alpha5.CoreDNSAddonConfig{
+						Replicas:        aws.Int(1),
+						HighlyAvailable: v1alpha5.Enabled(),
+					},
+				}.Validate()
+				Expect(err).To(MatchError("coreDNS.replicas must be at least 2 when coreDNS.highlyAvailable is enabled"))
+
+				err = v1alpha5.Addon{
+					Name: "coredns",
+					CoreDNS: &v1alpha5.CoreDNSAddonConfig{
+						Autoscaling:     &v1alpha5.CoreDNSAutoscaling{MinReplicas: aws.Int(1)},
+						HighlyAvailable: v1alpha5.Enabled(),
 					},
 				}.Validate()
-				Expect(err).To(MatchError("at most one of wellKnownPolicies, serviceAccountRoleARN, attachPolicyARNs and attachPolicy can be specified"))
+				Expect(err).To(MatchError("coreDNS.autoscaling.minReplicas must be at least 2 when coreDNS.highlyAvailable is enabled"))
+			})
+
+			It("errors for other addons", func() {
+				err := v1alpha5.Addon{
+					Name:    "vpc-cni",
+					CoreDNS: &v1alpha5.CoreDNSAddonConfig{Replicas: aws.Int(3)},
+				}.Validate()
+				Expect(err).To(MatchError("coreDNS can only be set for the coredns addon"))
 			})
 		})
 	})
@@ -0,0 +1,172 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Default values of the alarms settings
+const (
+	defaultNodeCountAlarmMissingNodes                = 1
+	defaultNodeCountAlarmEvaluationMinutes           = 10
+	defaultNATGatewayBandwidthAlarmEvaluationMinutes = 5
+	defaultAPIErrorRateAlarmThresholdPercent         = 5
+	defaultAPIErrorRateAlarmEvaluationMinutes        = 5
+)
+
+// ClusterAlarms holds the CloudWatch alarms created for the cluster in a dedicated stack.
+// The alarms notify an SNS topic, which is created unless an existing one is used
+type ClusterAlarms struct {
+	// SNSTopicARN is the ARN of an existing SNS topic the alarms notify. When not set, a topic
+	// is created in the alarms stack
+	// +optional
+	SNSTopicARN string `json:"snsTopicARN,omitempty"`
+	// Emails are subscribed to the SNS topic created for the alarms
+	// +optional
+	Emails []string `json:"emails,omitempty"`
+
+	// NodeCount raises an alarm when a nodegroup has fewer nodes in service than its desired capacity
+	// +optional
+	NodeCount *NodeCountAlarm `json:"nodeCount,omitempty"`
+	// NATGatewayBandwidth raises an alarm when a NAT gateway of the cluster VPC sends more data than a threshold
+	// +optional
+	NATGatewayBandwidth *NATGatewayBandwidthAlarm `json:"natGatewayBandwidth,omitempty"`
+	// APIErrorRate raises an alarm when the percentage of requests to the API server failing with a server
+	// error exceeds a threshold. It is computed from the audit logs, which must be enabled
+	// +optional
+	APIErrorRate *APIErrorRateAlarm `json:"apiErrorRate,omitempty"`
+}
+
+// NodeCountAlarm compares the number of nodes in service of each nodegroup to its desired capacity
+type NodeCountAlarm struct {
+	// MissingNodes is the number of nodes below the desired capacity that raises the alarm.
+	// Defaults to `1`
+	// +optional
+	MissingNodes *int `json:"missingNodes,omitempty"`
+	// EvaluationMinutes is the number of minutes nodes must be missing for before the alarm is raised.
+	// Defaults to `10`
+	// +optional
+	EvaluationMinutes *int `json:"evaluationMinutes,omitempty"`
+}
+
+// NATGatewayBandwidthAlarm monitors the data sent by each NAT gateway of the cluster VPC
+type NATGatewayBandwidthAlarm struct {
+	// BytesPerMinute is the number of bytes sent to destinations per minute that raises the alarm
+	// +required
+	BytesPerMinute int64 `json:"bytesPerMinute"`
+	// EvaluationMinutes is the number of consecutive minutes the threshold must be exceeded for.
+	// Defaults to `5`
+	// +optional
+	EvaluationMinutes *int `json:"evaluationMinutes,omitempty"`
+}
+
+// APIErrorRateAlarm monitors the percentage of API server requests failing with a 5xx status code
+type APIErrorRateAlarm struct {
+	// ThresholdPercent is the percentage of failed requests that raises the alarm.
+	// Defaults to `5`
+	// +optional
+	ThresholdPercent *int `json:"thresholdPercent,omitempty"`
+	// EvaluationMinutes is the number of consecutive minutes the threshold must be exceeded for.
+	// Defaults to `5`
+	// +optional
+	EvaluationMinutes *int `json:"evaluationMinutes,omitempty"`
+}
+
+func setAlarmsDefaults(alarms *ClusterAlarms) {
+	if alarms == nil {
+		return
+	}
+	setDefault := func(value **int, defaultValue int) {
+		if *value == nil {
+			*value = &defaultValue
+		}
+	}
+	if a := alarms.NodeCount; a != nil {
+		setDefault(&a.MissingNodes, defaultNodeCountAlarmMissingNodes)
+		setDefault(&a.EvaluationMinutes, defaultNodeCountAlarmEvaluationMinutes)
+	}
+	if a := alarms.NATGatewayBandwidth; a != nil {
+		setDefault(&a.EvaluationMinutes, defaultNATGatewayBandwidthAlarmEvaluationMinutes)
+	}
+	if a := alarms.APIErrorRate; a != nil {
+		setDefault(&a.ThresholdPercent, defaultAPIErrorRateAlarmThresholdPercent)
+		setDefault(&a.EvaluationMinutes, defaultAPIErrorRateAlarmEvaluationMinutes)
+	}
+}
+
+// ValidateClusterAlarms validates the alarms of the cluster
+func ValidateClusterAlarms(cfg *ClusterConfig) error {
+	alarms := cfg.Alarms
+	if alarms == nil {
+		return nil
+	}
+	if alarms.NodeCount == nil && alarms.NATGatewayBandwidth == nil && alarms.APIErrorRate == nil {
+		return fmt.Errorf("at least one of alarms.nodeCount, alarms.natGatewayBandwidth and alarms.apiErrorRate must be set")
+	}
+
+	if alarms.SNSTopicARN != "" {
+		if !arn.IsARN(alarms.SNSTopicARN) {
+			return fmt.Errorf("alarms.snsTopicARN %q is not a valid ARN", alarms.SNSTopicARN)
+		}
+		if len(alarms.Emails) > 0 {
+			return fmt.Errorf("alarms.emails cannot be set with alarms.snsTopicARN, subscribe them to the existing topic instead")
+		}
+	}
+	for i, email := range alarms.Emails {
+		if !strings.Contains(email, "@") {
+			return fmt.Errorf("alarms.emails[%d]: %q is not a valid email address", i, email)
+		}
+	}
+
+	validateEvaluationMinutes := func(path string, minutes *int) error {
+		if minutes != nil && (*minutes < 1 || *minutes > 1440) {
+			return fmt.Errorf("%s.evaluationMinutes must be between 1 and 1440", path)
+		}
+		return nil
+	}
+
+	if a := alarms.NodeCount; a != nil {
+		if a.MissingNodes != nil && *a.MissingNodes < 1 {
+			return fmt.Errorf("alarms.nodeCount.missingNodes must be at least 1")
+		}
+		if err := validateEvaluationMinutes("alarms.nodeCount", a.EvaluationMinutes); err != nil {
+			return err
+		}
+	}
+
+	if a := alarms.NATGatewayBandwidth; a != nil {
+		if a.BytesPerMinute <= 0 {
+			return fmt.Errorf("alarms.natGatewayBandwidth.bytesPerMinute must be set to a positive value")
+		}
+		if err := validateEvaluationMinutes("alarms.natGatewayBandwidth", a.EvaluationMinutes); err != nil {
+			return err
+		}
+	}
+
+	if a := alarms.APIErrorRate; a != nil {
+		if a.ThresholdPercent != nil && (*a.ThresholdPercent < 1 || *a.ThresholdPercent > 100) {
+			return fmt.Errorf("alarms.apiErrorRate.thresholdPercent must be between 1 and 100")
+		}
+		if err := validateEvaluationMinutes("alarms.apiErrorRate", a.EvaluationMinutes); err != nil {
+			return err
+		}
+		if !cfg.hasClusterLogType(auditLogging) {
+			return fmt.Errorf("alarms.apiErrorRate requires %q to be in cloudWatch.clusterLogging.enableTypes", auditLogging)
+		}
+	}
+	return nil
+}
+
+func (c *ClusterConfig) hasClusterLogType(logType string) bool {
+	if !c.HasClusterCloudWatchLogging() {
+		return false
+	}
+	for _, t := range c.CloudWatch.ClusterLogging.EnableTypes {
+		if t == logType || t == allLogging || t == wildcardLogging {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package v1alpha5
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/pkg/errors"
+)
+
+// CloudFormationOverrides is an escape hatch for what eksctl does not model: resources added to
+// and [JSON patches](https://datatracker.ietf.org/doc/html/rfc6902) applied to the CloudFormation
+// template rendered by eksctl, before the stack is created
+type CloudFormationOverrides struct {
+	// ExtraResources are CloudFormation resources added to the template, keyed by logical ID.
+	// They can refer to the resources and parameters of the template
+	// +optional
+	ExtraResources map[string]InlineDocument `json:"extraResources,omitempty"`
+	// Patches are JSON patch operations applied to the template, after ExtraResources are added,
+	// e.g. `{"op": "add", "path": "/Resources/ControlPlane/Properties/Tags/-", "value": {...}}`
+	// +optional
+	Patches []InlineDocument `json:"patches,omitempty"`
+}
+
+var cloudFormationLogicalIDPattern = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+// validateCloudFormationOverrides validates the extra resources and JSON patches under path
+func validateCloudFormationOverrides(overrides *CloudFormationOverrides, path string) error {
+	if overrides == nil {
+		return nil
+	}
+	for logicalID, resource := range overrides.ExtraResources {
+		if !cloudFormationLogicalIDPattern.MatchString(logicalID) {
+			return fmt.Errorf("%s.extraResources: logical ID %q must be alphanumeric", path, logicalID)
+		}
+		if resourceType, ok := resource["Type"].(string); !ok || resourceType == "" {
+			return fmt.Errorf("%s.extraResources.%s.Type must be set", path, logicalID)
+		}
+	}
+	if len(overrides.Patches) > 0 {
+		if _, err := overrides.DecodePatches(); err != nil {
+			return errors.Wrapf(err, "%s.patches", path)
+		}
+	}
+	return nil
+}
+
+// DecodePatches decodes the JSON patch operations of Patches
+func (o *CloudFormationOverrides) DecodePatches() (jsonpatch.Patch, error) {
+	for i, op := range o.Patches {
+		if _, ok := op["op"].(string); !ok {
+			return nil, fmt.Errorf("[%d].op must be set", i)
+		}
+		if _, ok := op["path"].(string); !ok {
+			return nil, fmt.Errorf("[%d].path must be set", i)
+		}
+	}
+	data, err := json.Marshal(o.Patches)
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.DecodePatch(data)
+}
@@ -45,8 +45,19 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 		if sa.Namespace == "" {
 			sa.Namespace = metav1.NamespaceDefault
 		}
+		setServiceAccountTokenAnnotations(sa, cfg.IAM)
 	}
 
+	for _, r := range cfg.HelmReleases {
+		if r.Namespace == "" {
+			r.Namespace = metav1.NamespaceDefault
+		}
+	}
+
+	setPullThroughCacheDefaults(cfg.PullThroughCache)
+	setAlarmsDefaults(cfg.Alarms)
+	setStackSetDefaults(cfg.StackSet, cfg.Metadata)
+
 	if cfg.HasClusterCloudWatchLogging() && cfg.ContainsWildcardCloudWatchLogging() {
 		cfg.CloudWatch.ClusterLogging.EnableTypes = SupportedCloudWatchClusterLogTypes()
 	}
@@ -59,11 +70,46 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 		cfg.VPC.ManageSharedNodeSecurityGroupRules = Enabled()
 	}
 
+	if cfg.VPC != nil && cfg.VPC.CloudFormation != nil {
+		setVPCStackOutputsDefaults(cfg.VPC.CloudFormation)
+	}
+
+	if cfg.IPv6Native() {
+		// nodes in the IPv6-only private subnets must be bootstrapped for IPv6
+		for _, ng := range cfg.NodeGroups {
+			if ng.PrivateNetworking && len(ng.Subnets) == 0 && ng.IPv6Only == nil &&
+				(ng.AMIFamily == "" || ng.AMIFamily == NodeImageFamilyAmazonLinux2) {
+				ng.IPv6Only = Enabled()
+			}
+		}
+	}
+
+	if cfg.ParameterExport != nil && cfg.ParameterExport.Path == "" {
+		cfg.ParameterExport.Path = "/eksctl/" + cfg.Metadata.Name
+	}
+
 	if cfg.Karpenter != nil && cfg.Karpenter.CreateServiceAccount == nil {
 		cfg.Karpenter.CreateServiceAccount = Disabled()
 	}
 }
 
+// SetIPv6Defaults enables the configuration an IPv6 cluster requires when it is not set: OIDC, for the VPC CNI to
+// use IRSA, and the core addons. It returns the names of the addons it adds
+func SetIPv6Defaults(cfg *ClusterConfig) []string {
+	if cfg.IAM == nil {
+		cfg.IAM = &ClusterIAM{}
+	}
+	if cfg.IAM.WithOIDC == nil {
+		cfg.IAM.WithOIDC = Enabled()
+	}
+
+	missing := cfg.addonContainsManagedAddons([]string{VPCCNIAddon, CoreDNSAddon, KubeProxyAddon})
+	for _, name := range missing {
+		cfg.Addons = append(cfg.Addons, &Addon{Name: name})
+	}
+	return missing
+}
+
 // IAMServiceAccountsWithImplicitServiceAccounts adds implicitly created
 // IAM SAs that need to be explicitly deleted.
 func IAMServiceAccountsWithImplicitServiceAccounts(cfg *ClusterConfig) []*ClusterIAMServiceAccount {
@@ -116,10 +162,22 @@ func SetNodeGroupDefaults(ng *NodeGroup, meta *ClusterMeta) {
 		ng.SecurityGroups.WithLocal = Enabled()
 	}
 	if ng.SecurityGroups.WithShared == nil {
+		// the shared security group belongs to the account of the cluster and cannot be attached to instances of
+		// another account
 		ng.SecurityGroups.WithShared = Enabled()
+		if ng.AccountRoleARN != "" {
+			ng.SecurityGroups.WithShared = Disabled()
+		}
 	}
 
 	setContainerRuntimeDefault(ng)
+
+	for i := range ng.LifecycleHooks {
+		// instances paused by a hook that no one completes are terminated with ABANDON
+		if ng.LifecycleHooks[i].DefaultResult == "" {
+			ng.LifecycleHooks[i].DefaultResult = LifecycleDefaultResultContinue
+		}
+	}
 }
 
 // SetManagedNodeGroupDefaults sets default values for a ManagedNodeGroup
@@ -284,6 +342,22 @@ func setBottlerocketNodeGroupDefaults(ng *NodeGroupBase) {
 	}
 }
 
+// setVPCStackOutputsDefaults sets the default names of the outputs of an existing VPC stack
+func setVPCStackOutputsDefaults(stack *VPCCloudFormation) {
+	if stack.Outputs == nil {
+		stack.Outputs = &VPCStackOutputs{}
+	}
+	if stack.Outputs.VPC == "" {
+		stack.Outputs.VPC = "VPC"
+	}
+	if stack.Outputs.PrivateSubnets == "" {
+		stack.Outputs.PrivateSubnets = "Subnets" + string(SubnetTopologyPrivate)
+	}
+	if stack.Outputs.PublicSubnets == "" {
+		stack.Outputs.PublicSubnets = "Subnets" + string(SubnetTopologyPublic)
+	}
+}
+
 // DefaultClusterNAT will set the default value for Cluster NAT mode
 func DefaultClusterNAT() *ClusterNAT {
 	def := ClusterNATDefault
@@ -329,3 +403,22 @@ func (c *ClusterConfig) SetDefaultFargateProfile() {
 		},
 	}
 }
+
+// setServiceAccountTokenAnnotations sets the annotations configuring the token and STS endpoint used by pods,
+// unless they are already set on the service account
+func setServiceAccountTokenAnnotations(sa *ClusterIAMServiceAccount, iam *ClusterIAM) {
+	setIfUnset := func(key, value string) {
+		if sa.Annotations == nil {
+			sa.Annotations = make(map[string]string)
+		}
+		if _, ok := sa.Annotations[key]; !ok {
+			sa.Annotations[key] = value
+		}
+	}
+	if iam.ServiceAccountAudience != "" {
+		setIfUnset(AnnotationEKSAudience, iam.ServiceAccountAudience)
+	}
+	if IsEnabled(iam.STSRegionalEndpoints) {
+		setIfUnset(AnnotationEKSSTSRegionalEndpoints, "true")
+	}
+}
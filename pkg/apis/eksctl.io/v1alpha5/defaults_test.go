@@ -194,6 +194,77 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Context("Lifecycle hook settings", func() {
+		It("defaults the result of lifecycle hooks to CONTINUE", func() {
+			testNodeGroup := NodeGroup{
+				NodeGroupBase: &NodeGroupBase{},
+				LifecycleHooks: []LifecycleHook{
+					{Name: "drain", Transition: LifecycleTransitionTerminate},
+					{Name: "warm-up", Transition: LifecycleTransitionLaunch, DefaultResult: LifecycleDefaultResultAbandon},
+				},
+			}
+			SetNodeGroupDefaults(&testNodeGroup, &ClusterMeta{})
+			Expect(testNodeGroup.LifecycleHooks[0].DefaultResult).To(Equal(LifecycleDefaultResultContinue))
+			Expect(testNodeGroup.LifecycleHooks[1].DefaultResult).To(Equal(LifecycleDefaultResultAbandon))
+		})
+	})
+
+	Describe("IAM service account token settings", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.IAM.ServiceAccounts = []*ClusterIAMServiceAccount{
+				{ClusterIAMMeta: ClusterIAMMeta{Name: "sa-1"}},
+				{ClusterIAMMeta: ClusterIAMMeta{Name: "sa-2", Annotations: map[string]string{AnnotationEKSAudience: "sa-audience"}}},
+			}
+		})
+
+		It("does not annotate service accounts by default", func() {
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.IAM.ServiceAccounts[0].Annotations).To(BeEmpty())
+			Expect(cfg.IAM.ServiceAccountAudiences()).To(Equal([]string{"sa-audience"}))
+		})
+
+		It("annotates service accounts with the audience and STS regional endpoints", func() {
+			cfg.IAM.ServiceAccountAudience = "cluster-audience"
+			cfg.IAM.STSRegionalEndpoints = Enabled()
+			SetClusterConfigDefaults(cfg)
+
+			Expect(cfg.IAM.ServiceAccounts[0].Annotations).To(Equal(map[string]string{
+				AnnotationEKSAudience:             "cluster-audience",
+				AnnotationEKSSTSRegionalEndpoints: "true",
+			}))
+			Expect(cfg.IAM.ServiceAccounts[1].Annotations).To(Equal(map[string]string{
+				AnnotationEKSAudience:             "sa-audience",
+				AnnotationEKSSTSRegionalEndpoints: "true",
+			}))
+			Expect(cfg.IAM.ServiceAccountAudiences()).To(Equal([]string{"cluster-audience", "sa-audience"}))
+		})
+	})
+
+	Describe("IPv6 settings", func() {
+		It("should add the missing core addons and enable OIDC", func() {
+			cfg := NewClusterConfig()
+			cfg.IAM.WithOIDC = nil
+			cfg.Addons = []*Addon{{Name: VPCCNIAddon, Version: "1.10.0"}}
+
+			added := SetIPv6Defaults(cfg)
+			Expect(added).To(Equal([]string{CoreDNSAddon, KubeProxyAddon}))
+			Expect(cfg.Addons).To(HaveLen(3))
+			Expect(cfg.Addons[0].Version).To(Equal("1.10.0"))
+			Expect(*cfg.IAM.WithOIDC).To(BeTrue())
+		})
+
+		It("should not enable OIDC when it is explicitly disabled", func() {
+			cfg := NewClusterConfig()
+			cfg.IAM.WithOIDC = Disabled()
+
+			SetIPv6Defaults(cfg)
+			Expect(*cfg.IAM.WithOIDC).To(BeFalse())
+		})
+	})
+
 	Describe("Cluster Managed Shared Node Security Group settings", func() {
 		var (
 			cfg *ClusterConfig
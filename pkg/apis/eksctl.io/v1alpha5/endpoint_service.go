@@ -1,6 +1,8 @@
 package v1alpha5
 
 import (
+	"regexp"
+
 	"github.com/pkg/errors"
 )
 
@@ -19,6 +21,9 @@ const (
 	EndpointServiceCloudFormation = "cloudformation"
 	EndpointServiceAutoscaling    = "autoscaling"
 	EndpointServiceCloudWatch     = "logs"
+	EndpointServiceSSM            = "ssm"
+	EndpointServiceSSMMessages    = "ssmmessages"
+	EndpointServiceEC2Messages    = "ec2messages"
 )
 
 // RequiredEndpointServices returns a list of endpoint services that are required for a fully-private cluster
@@ -32,12 +37,19 @@ func RequiredEndpointServices() []string {
 	}
 }
 
+// EndpointServices returns the required, additional and custom endpoint services of a fully-private cluster
+func (p *PrivateCluster) EndpointServices() []string {
+	services := append(RequiredEndpointServices(), p.AdditionalEndpointServices...)
+	return append(services, p.CustomEndpointServices...)
+}
+
 // ValidateAdditionalEndpointServices validates support for the specified additional endpoint services
 func ValidateAdditionalEndpointServices(services []string) error {
 	seen := make(map[string]struct{})
 	for _, service := range services {
 		switch service {
-		case EndpointServiceCloudFormation, EndpointServiceAutoscaling, EndpointServiceCloudWatch:
+		case EndpointServiceCloudFormation, EndpointServiceAutoscaling, EndpointServiceCloudWatch,
+			EndpointServiceSSM, EndpointServiceSSMMessages, EndpointServiceEC2Messages:
 			if _, ok := seen[service]; ok {
 				return errors.Errorf("found duplicate endpoint service: %q", service)
 			}
@@ -48,3 +60,42 @@ func ValidateAdditionalEndpointServices(services []string) error {
 	}
 	return nil
 }
+
+var (
+	endpointServiceNameRE = regexp.MustCompile(`^[a-z0-9-]+(\.[a-z0-9-]+)*$`)
+	endpointIDRE          = regexp.MustCompile(`^vpce-[0-9a-f]+$`)
+)
+
+// ValidateCustomEndpointServices validates the names of the specified custom endpoint services, which must not be
+// required or additional endpoint services
+func ValidateCustomEndpointServices(services []string) error {
+	seen := make(map[string]struct{})
+	for _, service := range services {
+		if !endpointServiceNameRE.MatchString(service) {
+			return errors.Errorf("invalid endpoint service name %q", service)
+		}
+		if err := ValidateAdditionalEndpointServices([]string{service}); err == nil {
+			return errors.Errorf("endpoint service %q must be set in privateCluster.additionalEndpointServices", service)
+		}
+		for _, required := range RequiredEndpointServices() {
+			if service == required {
+				return errors.Errorf("endpoint service %q is always created", service)
+			}
+		}
+		if _, ok := seen[service]; ok {
+			return errors.Errorf("found duplicate endpoint service: %q", service)
+		}
+		seen[service] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateExistingEndpointIDs validates the format of the specified VPC endpoint IDs
+func ValidateExistingEndpointIDs(ids []string) error {
+	for _, id := range ids {
+		if !endpointIDRE.MatchString(id) {
+			return errors.Errorf("invalid VPC endpoint ID %q", id)
+		}
+	}
+	return nil
+}
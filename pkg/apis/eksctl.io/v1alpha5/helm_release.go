@@ -0,0 +1,106 @@
+package v1alpha5
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HelmRelease holds the configuration of a Helm chart to install in the cluster
+type HelmRelease struct {
+	// Name of the release
+	// +required
+	Name string `json:"name"`
+	// Chart is the name of the chart in the repository
+	// +required
+	Chart string `json:"chart"`
+	// Repository is the URL of the chart repository
+	// +required
+	Repository string `json:"repository"`
+	// Version of the chart
+	// +required
+	Version string `json:"version"`
+	// Namespace to install the release in, it is created if it does not exist.
+	// Defaults to default
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Values of the chart
+	// +optional
+	Values InlineDocument `json:"values,omitempty"`
+	// ServiceAccount is the name of a service account of `iam.serviceAccounts`, in the
+	// namespace of the release, used by the chart. It sets the `serviceAccount.create`
+	// and `serviceAccount.name` values, unless they are set in `values`
+	// +optional
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+// ChartValues returns the values of the chart, including the values binding it to its service account
+func (r *HelmRelease) ChartValues() map[string]interface{} {
+	values := map[string]interface{}{}
+	for k, v := range r.Values {
+		values[k] = v
+	}
+	if r.ServiceAccount == "" {
+		return values
+	}
+	serviceAccount := map[string]interface{}{}
+	if existing, ok := values["serviceAccount"].(map[string]interface{}); ok {
+		for k, v := range existing {
+			serviceAccount[k] = v
+		}
+	}
+	if _, ok := serviceAccount["create"]; !ok {
+		serviceAccount["create"] = false
+	}
+	if _, ok := serviceAccount["name"]; !ok {
+		serviceAccount["name"] = r.ServiceAccount
+	}
+	values["serviceAccount"] = serviceAccount
+	return values
+}
+
+func validateHelmReleases(cfg *ClusterConfig) error {
+	names := nameSet{}
+	for i, r := range cfg.HelmReleases {
+		path := fmt.Sprintf("helmReleases[%d]", i)
+		for _, field := range []struct {
+			name  string
+			value string
+		}{
+			{"name", r.Name},
+			{"chart", r.Chart},
+			{"repository", r.Repository},
+			{"version", r.Version},
+		} {
+			if field.value == "" {
+				return fmt.Errorf("%s.%s must be set", path, field.name)
+			}
+		}
+		if ok, err := names.checkUnique("<namespace>/<name> of "+path, namespaceOrDefault(r.Namespace)+"/"+r.Name); !ok {
+			return err
+		}
+		if r.ServiceAccount != "" && !hasIAMServiceAccount(cfg, r.Namespace, r.ServiceAccount) {
+			return fmt.Errorf("%s.serviceAccount: %s/%s not found in iam.serviceAccounts", path, namespaceOrDefault(r.Namespace), r.ServiceAccount)
+		}
+	}
+	return nil
+}
+
+func hasIAMServiceAccount(cfg *ClusterConfig, namespace, name string) bool {
+	if cfg.IAM == nil {
+		return false
+	}
+	for _, sa := range cfg.IAM.ServiceAccounts {
+		if namespaceOrDefault(sa.Namespace) == namespaceOrDefault(namespace) && sa.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func namespaceOrDefault(namespace string) string {
+	if namespace == "" {
+		return metav1.NamespaceDefault
+	}
+	return namespace
+}
@@ -0,0 +1,92 @@
+package v1alpha5_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("HelmRelease", func() {
+	newRelease := func() *api.HelmRelease {
+		return &api.HelmRelease{
+			Name:       "external-dns",
+			Chart:      "external-dns",
+			Repository: "https://kubernetes-sigs.github.io/external-dns/",
+			Version:    "1.7.1",
+			Namespace:  "kube-system",
+		}
+	}
+
+	Describe("ChartValues", func() {
+		It("returns the values when no service account is set", func() {
+			r := newRelease()
+			r.Values = api.InlineDocument{"policy": "sync"}
+			Expect(r.ChartValues()).To(Equal(map[string]interface{}{"policy": "sync"}))
+		})
+
+		It("binds the chart to the service account", func() {
+			r := newRelease()
+			r.ServiceAccount = "external-dns"
+			r.Values = api.InlineDocument{
+				"serviceAccount": map[string]interface{}{"annotations": map[string]interface{}{"foo": "bar"}},
+			}
+			Expect(r.ChartValues()).To(Equal(map[string]interface{}{
+				"serviceAccount": map[string]interface{}{
+					"annotations": map[string]interface{}{"foo": "bar"},
+					"create":      false,
+					"name":        "external-dns",
+				},
+			}))
+			Expect(r.Values["serviceAccount"]).NotTo(HaveKey("name"))
+		})
+
+		It("does not override values set by the user", func() {
+			r := newRelease()
+			r.ServiceAccount = "external-dns"
+			r.Values = api.InlineDocument{
+				"serviceAccount": map[string]interface{}{"name": "other"},
+			}
+			Expect(r.ChartValues()["serviceAccount"]).To(Equal(map[string]interface{}{
+				"create": false,
+				"name":   "other",
+			}))
+		})
+	})
+
+	Describe("validation", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.IAM.WithOIDC = api.Enabled()
+			cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{
+				{
+					ClusterIAMMeta:    api.ClusterIAMMeta{Name: "external-dns", Namespace: "kube-system"},
+					WellKnownPolicies: api.WellKnownPolicies{ExternalDNS: true},
+				},
+			}
+			cfg.HelmReleases = []*api.HelmRelease{newRelease()}
+		})
+
+		It("accepts a valid release", func() {
+			cfg.HelmReleases[0].ServiceAccount = "external-dns"
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("requires the version", func() {
+			cfg.HelmReleases[0].Version = ""
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("helmReleases[0].version must be set"))
+		})
+
+		It("requires unique releases", func() {
+			cfg.HelmReleases = append(cfg.HelmReleases, newRelease())
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`<namespace>/<name> of helmReleases[1] "kube-system/external-dns" is not unique`)))
+		})
+
+		It("requires the service account to be defined", func() {
+			cfg.HelmReleases[0].ServiceAccount = "other"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("helmReleases[0].serviceAccount: kube-system/other not found in iam.serviceAccounts"))
+		})
+	})
+})
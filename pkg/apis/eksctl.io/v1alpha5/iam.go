@@ -10,6 +10,10 @@ import (
 // Commonly-used constants
 const (
 	AnnotationEKSRoleARN = "eks.amazonaws.com/role-arn"
+	// AnnotationEKSAudience sets the audience of the token projected into pods using the service account
+	AnnotationEKSAudience = "eks.amazonaws.com/audience"
+	// AnnotationEKSSTSRegionalEndpoints sets AWS_STS_REGIONAL_ENDPOINTS in pods using the service account
+	AnnotationEKSSTSRegionalEndpoints = "eks.amazonaws.com/sts-regional-endpoints"
 )
 
 // ClusterIAM holds all IAM attributes of a cluster
@@ -40,6 +44,24 @@ type ClusterIAM struct {
 	// +optional
 	ServiceAccounts []*ClusterIAMServiceAccount `json:"serviceAccounts,omitempty"`
 
+	// ServiceAccountAudience is the audience of the tokens used by service accounts to assume
+	// their IAM role. It is added to the client IDs of the IAM OIDC provider, and can be overridden
+	// per service account with the `eks.amazonaws.com/audience` annotation
+	// Defaults to the audience of the STS service, sts.amazonaws.com
+	// +optional
+	ServiceAccountAudience string `json:"serviceAccountAudience,omitempty"`
+
+	// STSRegionalEndpoints configures pods using service accounts to use the regional STS endpoint,
+	// instead of the global one, by setting the `eks.amazonaws.com/sts-regional-endpoints` annotation
+	// +optional
+	STSRegionalEndpoints *bool `json:"stsRegionalEndpoints,omitempty"`
+
+	// WellKnownPolicyRegistry is the path to a file of named policies that can be
+	// used in `wellKnownPolicies.custom` of service accounts and addons. A relative
+	// path is resolved from the directory of the config file
+	// +optional
+	WellKnownPolicyRegistry string `json:"wellKnownPolicyRegistry,omitempty"`
+
 	// VPCResourceControllerPolicy attaches the IAM policy
 	// necessary to run the VPC controller in the control plane
 	// Defaults to `true`
@@ -104,6 +126,12 @@ type ClusterIAMServiceAccount struct {
 	// +optional
 	RoleOnly *bool `json:"roleOnly,omitempty"`
 
+	// AdoptExisting creates the role for a service account that already exists in the cluster,
+	// and updates its annotations and labels, instead of excluding it as
+	// `--override-existing-serviceaccounts` would do for all service accounts
+	// +optional
+	AdoptExisting *bool `json:"adoptExisting,omitempty"`
+
 	// AWS tags for the service account
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
@@ -143,3 +171,20 @@ func (sa *ClusterIAMServiceAccount) SetAnnotations() {
 		sa.Annotations[AnnotationEKSRoleARN] = *sa.Status.RoleARN
 	}
 }
+
+// ServiceAccountAudiences returns the audiences, other than the default one, used by the service accounts of the cluster
+func (c *ClusterIAM) ServiceAccountAudiences() []string {
+	var audiences []string
+	seen := map[string]bool{}
+	add := func(audience string) {
+		if audience != "" && !seen[audience] {
+			seen[audience] = true
+			audiences = append(audiences, audience)
+		}
+	}
+	add(c.ServiceAccountAudience)
+	for _, sa := range c.ServiceAccounts {
+		add(sa.Annotations[AnnotationEKSAudience])
+	}
+	return audiences
+}
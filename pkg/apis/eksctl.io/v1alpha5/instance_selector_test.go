@@ -1,6 +1,7 @@
 package v1alpha5
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -79,6 +80,51 @@ var _ = Describe("Instance Selector Validation", func() {
 				},
 			},
 		}),
+		Entry("valid GPU, price and allow and deny list options", &instanceSelectorCase{
+			ng: &NodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					InstanceSelector: &InstanceSelector{
+						GPUs:             aws.Int(1),
+						GPUType:          "T4",
+						ExcludeBurstable: true,
+						MaxPricePerVCPU:  aws.Float64(0.2),
+						AllowList:        "^g4dn",
+						DenyList:         "metal$",
+					},
+				},
+			},
+		}),
+		Entry("gpuType with no GPUs", &instanceSelectorCase{
+			ng: &NodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					InstanceSelector: &InstanceSelector{
+						GPUs:    aws.Int(0),
+						GPUType: "T4",
+					},
+				},
+			},
+			errMsg: "nodeGroups[0].instanceSelector.gpuType cannot be set when nodeGroups[0].instanceSelector.gpus is 0",
+		}),
+		Entry("non-positive maxPricePerVCPU", &instanceSelectorCase{
+			ng: &NodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					InstanceSelector: &InstanceSelector{
+						MaxPricePerVCPU: aws.Float64(0),
+					},
+				},
+			},
+			errMsg: "nodeGroups[0].instanceSelector.maxPricePerVCPU must be greater than 0",
+		}),
+		Entry("invalid denyList", &instanceSelectorCase{
+			ng: &NodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					InstanceSelector: &InstanceSelector{
+						DenyList: "(metal",
+					},
+				},
+			},
+			errMsg: "invalid nodeGroups[0].instanceSelector.denyList",
+		}),
 	)
 
 })
@@ -92,6 +92,25 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 				},
 			},
 		}),
+		Entry("launchTemplate with $Latest version", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{},
+				LaunchTemplate: &LaunchTemplate{
+					ID:      "lt-custom",
+					Version: aws.String("$Latest"),
+				},
+			},
+		}),
+		Entry("launchTemplate with unsupported version alias", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{},
+				LaunchTemplate: &LaunchTemplate{
+					ID:      "lt-custom",
+					Version: aws.String("latest"),
+				},
+			},
+			errMsg: "invalid launch template version, must be a version number, $Latest or $Default",
+		}),
 		Entry("launchTemplate with instanceTypes", &nodeGroupCase{
 			ng: &ManagedNodeGroup{
 				NodeGroupBase: &NodeGroupBase{},
@@ -124,6 +143,63 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 			},
 			errMsg: "cannot set instanceType when instanceSelector is specified",
 		}),
+		Entry("labels in the kubernetes.io namespace", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					Labels: map[string]string{"node.kubernetes.io/lifecycle": "normal"},
+				},
+			},
+		}),
+		Entry("labels in the kubernetes.io namespace not allowed by kubelet", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					Labels: map[string]string{"node-role.kubernetes.io/worker": ""},
+				},
+			},
+			errMsg: "unknown 'kubernetes.io' or 'k8s.io' labels were specified",
+		}),
+		Entry("labels in the kubernetes.io namespace with a launch template", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					Labels: map[string]string{"node.kubernetes.io/lifecycle": "normal"},
+				},
+				LaunchTemplate: &LaunchTemplate{
+					ID: "lt-custom",
+				},
+			},
+			errMsg: "cannot be used with a custom AMI or a launch template",
+		}),
+		Entry("labels in the kubernetes.io namespace with overrideBootstrapCommand", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					Labels:                   map[string]string{"node.kubernetes.io/lifecycle": "normal"},
+					OverrideBootstrapCommand: aws.String("/etc/eks/bootstrap.sh cluster"),
+				},
+			},
+			errMsg: "cannot be used with overrideBootstrapCommand, pass them to kubelet in the command instead",
+		}),
+		Entry("labels in the kubernetes.io namespace with Ubuntu", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					AMIFamily: NodeImageFamilyUbuntu2004,
+					Labels:    map[string]string{"node.kubernetes.io/lifecycle": "normal"},
+				},
+			},
+		}),
+		Entry("taints in the kubernetes.io namespace with Bottlerocket", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					AMIFamily: NodeImageFamilyBottlerocket,
+				},
+				Taints: []NodeGroupTaint{
+					{
+						Key:    "node.kubernetes.io/special",
+						Effect: "NoSchedule",
+					},
+				},
+			},
+			errMsg: "cannot be used with the Bottlerocket AMI family",
+		}),
 	)
 
 	DescribeTable("User-supplied launch template with unsupported fields", func(ngBase *NodeGroupBase) {
@@ -137,7 +213,7 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 		err := ValidateManagedNodeGroup(mng, 0)
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("cannot set instanceType, ami, ssh.allow, ssh.enableSSM, ssh.sourceSecurityGroupIds, securityGroups, " +
-			"volumeSize, instanceName, instancePrefix, maxPodsPerNode, disableIMDSv1, disablePodIMDS, preBootstrapCommands, overrideBootstrapCommand, placement in managedNodeGroup when a launch template is supplied"))
+			"volumeSize, instanceName, instancePrefix, maxPodsPerNode, maxPodsCalculation, disableIMDSv1, disablePodIMDS, preBootstrapCommands, overrideBootstrapCommand, placement, enclaveEnabled, cpuOptions in managedNodeGroup when a launch template is supplied"))
 	},
 		Entry("instanceType", &NodeGroupBase{
 			InstanceType: "m5.xlarge",
@@ -164,6 +240,14 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 				AttachIDs: []string{"sg-custom"},
 			},
 		}),
+		Entry("enclaveEnabled", &NodeGroupBase{
+			EnclaveEnabled: Enabled(),
+		}),
+		Entry("cpuOptions", &NodeGroupBase{
+			CPUOptions: &CPUOptions{
+				ThreadsPerCore: aws.Int(1),
+			},
+		}),
 	)
 
 	type updateConfigEntry struct {
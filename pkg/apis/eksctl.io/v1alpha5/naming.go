@@ -0,0 +1,89 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Placeholders expanded in the prefix and suffix of Naming
+const (
+	NamingPlaceholderEnv     = "{env}"
+	NamingPlaceholderRegion  = "{region}"
+	NamingPlaceholderCluster = "{cluster}"
+)
+
+const (
+	maxIAMRoleNameLength = 64
+	maxStackNameLength   = 128
+)
+
+var (
+	namingPlaceholderRE = regexp.MustCompile(`\{[^}]*\}`)
+	iamRoleNameRE       = regexp.MustCompile(`^[\w+=,.@-]+$`)
+	stackNameRE         = regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]*$`)
+)
+
+// Naming holds the prefix and suffix added to the names of the CloudFormation stacks and IAM roles eksctl creates.
+// As eksctl finds the stacks of a cluster by their names, the same naming must be used for the whole lifetime of
+// the cluster
+type Naming struct {
+	// Prefix added to names, which may contain the placeholders `{env}`, `{region}` and `{cluster}`,
+	// e.g. `acme-{env}-`
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Suffix added to names, which may contain the same placeholders as the prefix, e.g. `-{region}`
+	// +optional
+	Suffix string `json:"suffix,omitempty"`
+
+	// Env is the value of the `{env}` placeholder, e.g. `prod`
+	// +optional
+	Env string `json:"env,omitempty"`
+}
+
+// ServiceRoleName returns the name of the service role of the cluster, or an empty string if naming is not set,
+// to let CloudFormation generate it
+func (c *ClusterConfig) ServiceRoleName() string {
+	return c.resourceName(c.Metadata.Name + "-ServiceRole")
+}
+
+// NodeGroupInstanceRoleName returns the name of the instance role of a nodegroup, or an empty string if naming is
+// not set
+func (c *ClusterConfig) NodeGroupInstanceRoleName(nodeGroupName string) string {
+	return c.resourceName(fmt.Sprintf("%s-%s-NodeInstanceRole", c.Metadata.Name, nodeGroupName))
+}
+
+// StackName returns the name of a stack with the prefix and suffix of naming, if set
+func (c *ClusterConfig) StackName(name string) string {
+	if c.Naming == nil {
+		return name
+	}
+	return c.resourceName(name)
+}
+
+// StackNameRegex returns a regular expression matching the names of stacks matched by nameRegex with the prefix and
+// suffix of naming, if set. nameRegex must be anchored with ^ and $
+func (c *ClusterConfig) StackNameRegex(nameRegex string) string {
+	if c.Naming == nil {
+		return nameRegex
+	}
+	return "^" + regexp.QuoteMeta(c.expandNamingPlaceholders(c.Naming.Prefix)) +
+		strings.TrimSuffix(strings.TrimPrefix(nameRegex, "^"), "$") +
+		regexp.QuoteMeta(c.expandNamingPlaceholders(c.Naming.Suffix)) + "$"
+}
+
+func (c *ClusterConfig) resourceName(name string) string {
+	if c.Naming == nil {
+		return ""
+	}
+	return c.expandNamingPlaceholders(c.Naming.Prefix) + name + c.expandNamingPlaceholders(c.Naming.Suffix)
+}
+
+func (c *ClusterConfig) expandNamingPlaceholders(s string) string {
+	return strings.NewReplacer(
+		NamingPlaceholderEnv, c.Naming.Env,
+		NamingPlaceholderRegion, c.Metadata.Region,
+		NamingPlaceholderCluster, c.Metadata.Name,
+	).Replace(s)
+}
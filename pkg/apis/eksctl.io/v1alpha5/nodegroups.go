@@ -1,6 +1,12 @@
 package v1alpha5
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+)
 
 // HasInstanceType returns whether some node in the group fulfils the type check
 func HasInstanceType(nodeGroup *NodeGroup, hasType func(string) bool) bool {
@@ -95,12 +101,176 @@ func (c *ClusterConfig) AllNodeGroups() []*NodeGroupBase {
 	return baseNodeGroups
 }
 
-// HasWindowsNodeGroup returns true if an unmanaged Windows nodegroup exists.
+// ApplyNodeGroupDefaults copies the settings in NodeGroupDefaults to all nodegroups that don't set them
+func (c *ClusterConfig) ApplyNodeGroupDefaults() {
+	if c.NodeGroupDefaults == nil {
+		return
+	}
+	for _, ng := range c.AllNodeGroups() {
+		c.NodeGroupDefaults.applyTo(ng)
+	}
+}
+
+func (d *NodeGroupDefaults) applyTo(ng *NodeGroupBase) {
+	if ng.AMIFamily == "" {
+		ng.AMIFamily = d.AMIFamily
+	}
+	if ng.InstanceType == "" {
+		ng.InstanceType = d.InstanceType
+	}
+	if ng.VolumeSize == nil && d.VolumeSize != nil {
+		ng.VolumeSize = aws.Int(*d.VolumeSize)
+	}
+	if ng.VolumeType == nil && d.VolumeType != nil {
+		ng.VolumeType = aws.String(*d.VolumeType)
+	}
+	if ng.SSH == nil && d.SSH != nil {
+		ng.SSH = d.SSH.DeepCopy()
+	}
+	if ng.IAM == nil && d.IAM != nil {
+		ng.IAM = d.IAM.DeepCopy()
+	}
+	ng.Labels = mergeDefaultMap(d.Labels, ng.Labels)
+	ng.Tags = mergeDefaultMap(d.Tags, ng.Tags)
+	if len(d.PreBootstrapCommands) > 0 {
+		ng.PreBootstrapCommands = append(append([]string{}, d.PreBootstrapCommands...), ng.PreBootstrapCommands...)
+	}
+}
+
+func mergeDefaultMap(defaults, values map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return values
+	}
+	merged := make(map[string]string, len(defaults)+len(values))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+	return merged
+}
+
+// ExpandSpotFallbackNodeGroups replaces each managed nodegroup with spotFallback by a spot nodegroup with its
+// scaling config and an on-demand nodegroup with no nodes, both labelled with SpotFallbackGroupLabel
+func (c *ClusterConfig) ExpandSpotFallbackNodeGroups() error {
+	var managedNodeGroups []*ManagedNodeGroup
+	for _, ng := range c.ManagedNodeGroups {
+		if !ng.SpotFallback {
+			managedNodeGroups = append(managedNodeGroups, ng)
+			continue
+		}
+		if ng.Spot {
+			return fmt.Errorf("managedNodeGroups[%s]: spot and spotFallback cannot be used together", ng.Name)
+		}
+		spot := newSpotFallbackNodeGroup(ng, "spot")
+		spot.Spot = true
+
+		onDemand := newSpotFallbackNodeGroup(ng, "on-demand")
+		onDemand.ScalingConfig = &ScalingConfig{
+			MinSize:         aws.Int(0),
+			DesiredCapacity: aws.Int(0),
+			MaxSize:         aws.Int(spotFallbackMaxSize(ng.ScalingConfig)),
+		}
+		managedNodeGroups = append(managedNodeGroups, spot, onDemand)
+	}
+	if c.Karpenter != nil && len(managedNodeGroups) > len(c.ManagedNodeGroups) {
+		logger.Warning("spotFallback only applies to managed nodegroups, nodes launched by Karpenter fall back to on-demand capacity only if its provisioners allow both the spot and on-demand capacity types")
+	}
+	c.ManagedNodeGroups = managedNodeGroups
+	return nil
+}
+
+func newSpotFallbackNodeGroup(ng *ManagedNodeGroup, capacityType string) *ManagedNodeGroup {
+	copied := ng.DeepCopy()
+	copied.Name = fmt.Sprintf("%s-%s", ng.Name, capacityType)
+	copied.SpotFallback = false
+	if copied.Labels == nil {
+		copied.Labels = map[string]string{}
+	}
+	copied.Labels[SpotFallbackGroupLabel] = ng.Name
+	return copied
+}
+
+// spotFallbackMaxSize returns the maximum size of the on-demand nodegroup, which replaces all the nodes of
+// the spot nodegroup at most
+func spotFallbackMaxSize(scaling *ScalingConfig) int {
+	switch {
+	case scaling == nil:
+		return DefaultNodeCount
+	case scaling.MaxSize != nil:
+		return *scaling.MaxSize
+	case scaling.DesiredCapacity != nil:
+		return *scaling.DesiredCapacity
+	case scaling.MinSize != nil:
+		return *scaling.MinSize
+	default:
+		return DefaultNodeCount
+	}
+}
+
+// SpotFallbackGroups returns the names of the spot fallback groups of the managed nodegroups
+func (c *ClusterConfig) SpotFallbackGroups() []string {
+	var groups []string
+	seen := map[string]bool{}
+	for _, ng := range c.ManagedNodeGroups {
+		if group := ng.Labels[SpotFallbackGroupLabel]; group != "" && !seen[group] {
+			seen[group] = true
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// HasWindowsNodeGroup returns true if a Windows nodegroup, unmanaged or managed, exists.
 func (c *ClusterConfig) HasWindowsNodeGroup() bool {
 	for _, ng := range c.NodeGroups {
 		if IsWindowsImage(ng.AMIFamily) {
 			return true
 		}
 	}
+	for _, ng := range c.ManagedNodeGroups {
+		if IsWindowsImage(ng.AMIFamily) {
+			return true
+		}
+	}
 	return false
 }
+
+// SplitLabelsForEKS splits the labels of a managed nodegroup into those that can be set through the EKS API
+// and those in the `kubernetes.io` and `k8s.io` namespaces, which EKS rejects and which are instead passed
+// to kubelet through the launch template user data
+func (m *ManagedNodeGroup) SplitLabelsForEKS() (eksLabels, kubeletLabels map[string]string) {
+	for k, v := range m.Labels {
+		if isKubernetesLabelKey(k) {
+			if kubeletLabels == nil {
+				kubeletLabels = map[string]string{}
+			}
+			kubeletLabels[k] = v
+			continue
+		}
+		if eksLabels == nil {
+			eksLabels = map[string]string{}
+		}
+		eksLabels[k] = v
+	}
+	return eksLabels, kubeletLabels
+}
+
+// SplitTaintsForEKS splits the taints of a managed nodegroup into those that can be set through the EKS API
+// and those that are instead registered by kubelet, see SplitLabelsForEKS
+func (m *ManagedNodeGroup) SplitTaintsForEKS() (eksTaints, kubeletTaints []NodeGroupTaint) {
+	for _, t := range m.Taints {
+		if isKubernetesLabelKey(t.Key) {
+			kubeletTaints = append(kubeletTaints, t)
+			continue
+		}
+		eksTaints = append(eksTaints, t)
+	}
+	return eksTaints, kubeletTaints
+}
+
+func isKubernetesLabelKey(key string) bool {
+	parts := strings.Split(key, "/")
+	return len(parts) == 2 && isKubernetesLabel(parts[0])
+}
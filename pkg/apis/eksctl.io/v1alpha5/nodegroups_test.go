@@ -0,0 +1,173 @@
+package v1alpha5
+
+import (
+	"bytes"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NodeGroupDefaults", func() {
+	var cfg *ClusterConfig
+
+	BeforeEach(func() {
+		cfg = NewClusterConfig()
+		cfg.NodeGroupDefaults = &NodeGroupDefaults{
+			AMIFamily:            NodeImageFamilyBottlerocket,
+			VolumeSize:           aws.Int(100),
+			SSH:                  &NodeGroupSSH{Allow: aws.Bool(true), PublicKeyName: aws.String("key")},
+			IAM:                  &NodeGroupIAM{InstanceRoleARN: "arn:aws:iam::123:role/nodes"},
+			Labels:               map[string]string{"team": "platform", "tier": "default"},
+			Tags:                 map[string]string{"cost-center": "123"},
+			PreBootstrapCommands: []string{"echo defaults"},
+		}
+	})
+
+	It("is inherited by nodegroups that don't set the fields", func() {
+		ng := &NodeGroup{NodeGroupBase: &NodeGroupBase{Name: "ng"}}
+		mng := &ManagedNodeGroup{NodeGroupBase: &NodeGroupBase{Name: "mng"}}
+		cfg.NodeGroups = append(cfg.NodeGroups, ng)
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, mng)
+
+		cfg.ApplyNodeGroupDefaults()
+
+		for _, base := range []*NodeGroupBase{ng.NodeGroupBase, mng.NodeGroupBase} {
+			Expect(base.AMIFamily).To(Equal(NodeImageFamilyBottlerocket))
+			Expect(*base.VolumeSize).To(Equal(100))
+			Expect(*base.SSH.PublicKeyName).To(Equal("key"))
+			Expect(base.IAM.InstanceRoleARN).To(Equal("arn:aws:iam::123:role/nodes"))
+			Expect(base.Labels).To(Equal(map[string]string{"team": "platform", "tier": "default"}))
+			Expect(base.Tags).To(Equal(map[string]string{"cost-center": "123"}))
+			Expect(base.PreBootstrapCommands).To(Equal([]string{"echo defaults"}))
+		}
+		Expect(ng.SSH).NotTo(BeIdenticalTo(mng.SSH))
+	})
+
+	It("does not override settings of the nodegroup", func() {
+		ng := &NodeGroup{
+			NodeGroupBase: &NodeGroupBase{
+				Name:                 "ng",
+				AMIFamily:            NodeImageFamilyAmazonLinux2,
+				VolumeSize:           aws.Int(20),
+				Labels:               map[string]string{"tier": "gpu"},
+				PreBootstrapCommands: []string{"echo nodegroup"},
+			},
+		}
+		cfg.NodeGroups = append(cfg.NodeGroups, ng)
+
+		cfg.ApplyNodeGroupDefaults()
+
+		Expect(ng.AMIFamily).To(Equal(NodeImageFamilyAmazonLinux2))
+		Expect(*ng.VolumeSize).To(Equal(20))
+		Expect(ng.Labels).To(Equal(map[string]string{"team": "platform", "tier": "gpu"}))
+		Expect(ng.PreBootstrapCommands).To(Equal([]string{"echo defaults", "echo nodegroup"}))
+	})
+})
+
+var _ = Describe("ExpandSpotFallbackNodeGroups", func() {
+	var cfg *ClusterConfig
+
+	BeforeEach(func() {
+		cfg = NewClusterConfig()
+	})
+
+	It("replaces nodegroups with spotFallback by a spot and an on-demand nodegroup", func() {
+		cfg.ManagedNodeGroups = []*ManagedNodeGroup{
+			{
+				NodeGroupBase: &NodeGroupBase{
+					Name:          "workers",
+					Labels:        map[string]string{"role": "worker"},
+					ScalingConfig: &ScalingConfig{MinSize: aws.Int(2), DesiredCapacity: aws.Int(3), MaxSize: aws.Int(6)},
+				},
+				InstanceTypes: []string{"m5.large", "m5a.large"},
+				SpotFallback:  true,
+			},
+			{
+				NodeGroupBase: &NodeGroupBase{Name: "system"},
+			},
+		}
+
+		Expect(cfg.ExpandSpotFallbackNodeGroups()).To(Succeed())
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(3))
+
+		spot, onDemand := cfg.ManagedNodeGroups[0], cfg.ManagedNodeGroups[1]
+		Expect(spot.Name).To(Equal("workers-spot"))
+		Expect(spot.Spot).To(BeTrue())
+		Expect(spot.SpotFallback).To(BeFalse())
+		Expect(spot.InstanceTypes).To(Equal([]string{"m5.large", "m5a.large"}))
+		Expect(*spot.ScalingConfig).To(Equal(ScalingConfig{MinSize: aws.Int(2), DesiredCapacity: aws.Int(3), MaxSize: aws.Int(6)}))
+		Expect(spot.Labels).To(Equal(map[string]string{"role": "worker", SpotFallbackGroupLabel: "workers"}))
+
+		Expect(onDemand.Name).To(Equal("workers-on-demand"))
+		Expect(onDemand.Spot).To(BeFalse())
+		Expect(*onDemand.ScalingConfig).To(Equal(ScalingConfig{MinSize: aws.Int(0), DesiredCapacity: aws.Int(0), MaxSize: aws.Int(6)}))
+		Expect(onDemand.Labels).To(Equal(map[string]string{"role": "worker", SpotFallbackGroupLabel: "workers"}))
+
+		Expect(cfg.ManagedNodeGroups[2].Name).To(Equal("system"))
+		Expect(cfg.SpotFallbackGroups()).To(Equal([]string{"workers"}))
+	})
+
+	It("defaults the maximum size of the on-demand nodegroup", func() {
+		cfg.ManagedNodeGroups = []*ManagedNodeGroup{
+			{
+				NodeGroupBase: &NodeGroupBase{Name: "workers"},
+				SpotFallback:  true,
+			},
+		}
+
+		Expect(cfg.ExpandSpotFallbackNodeGroups()).To(Succeed())
+		Expect(*cfg.ManagedNodeGroups[1].MaxSize).To(Equal(DefaultNodeCount))
+	})
+
+	It("rejects spot nodegroups", func() {
+		cfg.ManagedNodeGroups = []*ManagedNodeGroup{
+			{
+				NodeGroupBase: &NodeGroupBase{Name: "workers"},
+				Spot:          true,
+				SpotFallback:  true,
+			},
+		}
+
+		Expect(cfg.ExpandSpotFallbackNodeGroups()).To(MatchError("managedNodeGroups[workers]: spot and spotFallback cannot be used together"))
+	})
+
+	It("warns that Karpenter nodes are not covered", func() {
+		output := &bytes.Buffer{}
+		originalWriter := logger.Writer
+		logger.Writer = output
+		defer func() { logger.Writer = originalWriter }()
+
+		cfg.Karpenter = &Karpenter{Version: "0.6.0"}
+		cfg.ManagedNodeGroups = []*ManagedNodeGroup{
+			{
+				NodeGroupBase: &NodeGroupBase{Name: "workers"},
+				SpotFallback:  true,
+			},
+		}
+
+		Expect(cfg.ExpandSpotFallbackNodeGroups()).To(Succeed())
+		Expect(output.String()).To(ContainSubstring("spotFallback only applies to managed nodegroups"))
+	})
+})
+
+var _ = Describe("HasWindowsNodeGroup", func() {
+	It("finds unmanaged and managed Windows nodegroups", func() {
+		cfg := NewClusterConfig()
+		cfg.NodeGroups = []*NodeGroup{{NodeGroupBase: &NodeGroupBase{Name: "linux", AMIFamily: NodeImageFamilyAmazonLinux2}}}
+		cfg.ManagedNodeGroups = []*ManagedNodeGroup{{NodeGroupBase: &NodeGroupBase{Name: "managed-linux", AMIFamily: NodeImageFamilyAmazonLinux2}}}
+		Expect(cfg.HasWindowsNodeGroup()).To(BeFalse())
+
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, &ManagedNodeGroup{
+			NodeGroupBase: &NodeGroupBase{Name: "managed-windows", AMIFamily: NodeImageFamilyWindowsServer2019CoreContainer},
+		})
+		Expect(cfg.HasWindowsNodeGroup()).To(BeTrue())
+
+		cfg.ManagedNodeGroups = nil
+		cfg.NodeGroups = append(cfg.NodeGroups, &NodeGroup{
+			NodeGroupBase: &NodeGroupBase{Name: "windows", AMIFamily: NodeImageFamilyWindowsServer2019FullContainer},
+		})
+		Expect(cfg.HasWindowsNodeGroup()).To(BeTrue())
+	})
+})
@@ -0,0 +1,115 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PullThroughCache holds the ECR pull-through cache rules created for the cluster. Nodes using containerd
+// are configured to pull images of the upstream registries through them, so that clusters without
+// internet access can use public images
+type PullThroughCache struct {
+	// Rules are the pull-through cache rules, one per upstream registry
+	// +required
+	Rules []PullThroughCacheRule `json:"rules"`
+}
+
+// PullThroughCacheRule maps an upstream registry to a repository prefix of the private ECR registry
+type PullThroughCacheRule struct {
+	// UpstreamRegistryURL is the upstream registry, e.g. `quay.io`, `ghcr.io` or `registry.k8s.io`
+	// +required
+	UpstreamRegistryURL string `json:"upstreamRegistryURL"`
+	// ECRRepositoryPrefix is the prefix of the repositories caching the upstream images.
+	// Defaults to a name derived from the upstream registry, e.g. `quay` for `quay.io`
+	// +optional
+	ECRRepositoryPrefix string `json:"ecrRepositoryPrefix,omitempty"`
+}
+
+// wellKnownPullThroughCachePrefixes are the default repository prefixes of common upstream registries
+var wellKnownPullThroughCachePrefixes = map[string]string{
+	"quay.io":         "quay",
+	"ghcr.io":         "ghcr",
+	"registry.k8s.io": "registry-k8s-io",
+	"public.ecr.aws":  "ecr-public",
+}
+
+// DefaultECRRepositoryPrefix returns the default repository prefix of the given upstream registry
+func DefaultECRRepositoryPrefix(upstreamRegistryURL string) string {
+	if prefix, ok := wellKnownPullThroughCachePrefixes[upstreamRegistryURL]; ok {
+		return prefix
+	}
+	return strings.ReplaceAll(upstreamRegistryURL, ".", "-")
+}
+
+func setPullThroughCacheDefaults(cache *PullThroughCache) {
+	if cache == nil {
+		return
+	}
+	for i, rule := range cache.Rules {
+		if rule.ECRRepositoryPrefix == "" {
+			cache.Rules[i].ECRRepositoryPrefix = DefaultECRRepositoryPrefix(rule.UpstreamRegistryURL)
+		}
+	}
+}
+
+// ValidatePullThroughCache validates the pull-through cache rules
+func ValidatePullThroughCache(cache *PullThroughCache) error {
+	if cache == nil {
+		return nil
+	}
+	if len(cache.Rules) == 0 {
+		return setNonEmpty("pullThroughCache.rules")
+	}
+	prefixes, upstreams := nameSet{}, nameSet{}
+	for i, rule := range cache.Rules {
+		path := fmt.Sprintf("pullThroughCache.rules[%d]", i)
+		if rule.UpstreamRegistryURL == "" {
+			return fmt.Errorf("%s.upstreamRegistryURL must be set", path)
+		}
+		if strings.Contains(rule.UpstreamRegistryURL, "/") {
+			return fmt.Errorf("%s.upstreamRegistryURL must be a registry host name, got %q", path, rule.UpstreamRegistryURL)
+		}
+		if ok, err := upstreams.checkUnique(path+".upstreamRegistryURL", rule.UpstreamRegistryURL); !ok {
+			return err
+		}
+		prefix := rule.ECRRepositoryPrefix
+		if prefix == "" {
+			prefix = DefaultECRRepositoryPrefix(rule.UpstreamRegistryURL)
+		}
+		if len(prefix) < 2 || len(prefix) > 20 {
+			return fmt.Errorf("%s.ecrRepositoryPrefix %q must be between 2 and 20 characters long", path, prefix)
+		}
+		if ok, err := prefixes.checkUnique(path+".ecrRepositoryPrefix", prefix); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePullThroughCacheNodeGroups validates that the nodes of all nodegroups pull images through the cache, which
+// is only configured for containerd of the self-managed AmazonLinux2 nodegroups eksctl bootstraps, as managed
+// nodegroups use dockerd and the other AMI families do not support refreshing the ECR credentials of mirrors
+func validatePullThroughCacheNodeGroups(cfg *ClusterConfig) error {
+	if cfg.PullThroughCache == nil {
+		return nil
+	}
+	unsupported := func(name, reason string) error {
+		return fmt.Errorf("pullThroughCache is only supported for self-managed %s nodegroups using %s, "+
+			"nodegroup %q %s; reference the images of its pods with the ECR registry instead, after creating the rules with eksctl utils create-pull-through-cache",
+			NodeImageFamilyAmazonLinux2, ContainerRuntimeContainerD, name, reason)
+	}
+	for _, ng := range cfg.NodeGroups {
+		switch {
+		case ng.AMIFamily != "" && ng.AMIFamily != NodeImageFamilyAmazonLinux2:
+			return unsupported(ng.Name, "uses the "+ng.AMIFamily+" AMI family")
+		case ng.GetContainerRuntime() != ContainerRuntimeContainerD:
+			return unsupported(ng.Name, "does not set containerRuntime: "+ContainerRuntimeContainerD)
+		case ng.OverrideBootstrapCommand != nil:
+			return unsupported(ng.Name, "sets overrideBootstrapCommand")
+		}
+	}
+	if len(cfg.ManagedNodeGroups) > 0 {
+		return unsupported(cfg.ManagedNodeGroups[0].Name, "is a managed nodegroup")
+	}
+	return nil
+}
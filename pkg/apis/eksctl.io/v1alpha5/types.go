@@ -8,8 +8,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/savingsplans/savingsplansiface"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
@@ -21,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/pkg/errors"
@@ -28,6 +35,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/utils/taints"
 )
 
@@ -223,6 +231,13 @@ const (
 	// NodeGroupTypeTag defines the nodegroup type as managed or unmanaged
 	NodeGroupTypeTag = "alpha.eksctl.io/nodegroup-type"
 
+	// NodeProvisionerTag defines the plugin provisioning the nodes of an unmanaged nodegroup
+	NodeProvisionerTag = "alpha.eksctl.io/node-provisioner"
+
+	// NodeGroupPreservedScalingConfigTag stores the scaling config of a managed nodegroup
+	// that was scaled to zero with `--preserve`, in the form `minSize:desiredCapacity:maxSize`
+	NodeGroupPreservedScalingConfigTag = "alpha.eksctl.io/preserved-scaling-config"
+
 	// OldNodeGroupNameTag defines the tag of the nodegroup name
 	OldNodeGroupNameTag = "eksctl.io/v1alpha2/nodegroup-name"
 
@@ -235,6 +250,9 @@ const (
 	// AddonNameTag defines the tag of the IAM service account name
 	AddonNameTag = "alpha.eksctl.io/addon-name"
 
+	// RolesAnywhereNameTag defines the tag of the IAM Roles Anywhere profile name
+	RolesAnywhereNameTag = "alpha.eksctl.io/rolesanywhere-name"
+
 	// ClusterNameLabel defines the tag of the cluster name
 	ClusterNameLabel = "alpha.eksctl.io/cluster-name"
 
@@ -249,6 +267,10 @@ const (
 
 	EKSNodeGroupNameLabel = "eks.amazonaws.com/nodegroup"
 
+	// SpotFallbackGroupLabel defines the label linking the spot and on-demand nodegroups created from a
+	// nodegroup with spotFallback
+	SpotFallbackGroupLabel = "alpha.eksctl.io/spot-fallback-group"
+
 	// SpotAllocationStrategyLowestPrice defines the ASG spot allocation strategy of lowest-price
 	SpotAllocationStrategyLowestPrice = "lowest-price"
 
@@ -339,6 +361,10 @@ const (
 	KubeProxyAddon              = "kube-proxy"
 	CoreDNSAddon                = "coredns"
 	minimumVPCCNIVersionForIPv6 = "1.10.0"
+
+	// the CoreDNS and kube-proxy builds supporting IPv6 are pre-releases of the same version in semver
+	minimumCoreDNSVersionForIPv6   = "1.8.4-eksbuild.1"
+	minimumKubeProxyVersionForIPv6 = "1.21.2-eksbuild.2"
 )
 
 var (
@@ -575,12 +601,29 @@ type KubernetesNetworkConfig struct {
 	IPFamily string `json:"ipFamily,omitempty"`
 	// ServiceIPv4CIDR is the CIDR range from where `ClusterIP`s are assigned
 	ServiceIPv4CIDR string `json:"serviceIPv4CIDR,omitempty"`
+	// ServiceIPv6CIDR is the CIDR range from where `ClusterIP`s are assigned in IPv6 clusters.
+	// It is assigned by EKS and only set in the status of the cluster
+	// +optional
+	ServiceIPv6CIDR string `json:"serviceIPv6CIDR,omitempty"`
 }
 
 func (k *KubernetesNetworkConfig) IPv6Enabled() bool {
 	return strings.EqualFold(k.IPFamily, IPV6Family)
 }
 
+// IPv6Enabled returns whether the cluster uses IPv6, as configured or, for existing clusters, as described by EKS
+func (c *ClusterConfig) IPv6Enabled() bool {
+	if c.KubernetesNetworkConfig != nil && c.KubernetesNetworkConfig.IPv6Enabled() {
+		return true
+	}
+	return c.Status != nil && c.Status.KubernetesNetworkConfig != nil && c.Status.KubernetesNetworkConfig.IPv6Enabled()
+}
+
+// IPv6Native returns whether the private subnets of the VPC created for the cluster are IPv6-only
+func (c *ClusterConfig) IPv6Native() bool {
+	return c.VPC != nil && strings.EqualFold(c.VPC.IPFamily, IPV6Family)
+}
+
 type EKSCTLCreated string
 
 // ClusterStatus holds read-only attributes of a cluster
@@ -645,16 +688,24 @@ type ClusterProvider interface {
 	CloudFormation() cloudformationiface.CloudFormationAPI
 	CloudFormationRoleARN() string
 	CloudFormationDisableRollback() bool
+	CloudFormationTemplateBucket() string
 	ASG() autoscalingiface.AutoScalingAPI
 	EKS() eksiface.EKSAPI
 	EC2() ec2iface.EC2API
 	ELB() elbiface.ELBAPI
 	ELBV2() elbv2iface.ELBV2API
 	STS() stsiface.STSAPI
+	STSV2() awsapi.STS
 	SSM() ssmiface.SSMAPI
 	IAM() iamiface.IAMAPI
 	CloudTrail() cloudtrailiface.CloudTrailAPI
 	CloudWatchLogs() cloudwatchlogsiface.CloudWatchLogsAPI
+	CloudWatch() cloudwatchiface.CloudWatchAPI
+	ECR() ecriface.ECRAPI
+	EventBridge() eventbridgeiface.EventBridgeAPI
+	S3() s3iface.S3API
+	KMS() kmsiface.KMSAPI
+	SavingsPlans() savingsplansiface.SavingsPlansAPI
 	Region() string
 	Profile() string
 	WaitTimeout() time.Duration
@@ -666,10 +717,15 @@ type ClusterProvider interface {
 type ProviderConfig struct {
 	CloudFormationRoleARN         string
 	CloudFormationDisableRollback bool
+	CloudFormationTemplateBucket  string
 
 	Region      string
 	Profile     string
 	WaitTimeout time.Duration
+
+	// SessionName and SourceIdentity are set on the roles assumed by eksctl
+	SessionName    string
+	SourceIdentity string
 }
 
 // +genclient
@@ -703,6 +759,11 @@ type ClusterConfig struct {
 	// +optional
 	PrivateCluster *PrivateCluster `json:"privateCluster,omitempty"`
 
+	// NodeGroupDefaults holds settings inherited by all nodegroups and managed nodegroups,
+	// see [nodegroup defaults](/usage/managing-nodegroups/#nodegroup-defaults)
+	// +optional
+	NodeGroupDefaults *NodeGroupDefaults `json:"nodeGroupDefaults,omitempty"`
+
 	// NodeGroups For information and examples see [nodegroups](/usage/managing-nodegroups)
 	// +optional
 	NodeGroups []*NodeGroup `json:"nodeGroups,omitempty"`
@@ -734,6 +795,152 @@ type ClusterConfig struct {
 	// Karpenter specific configuration options.
 	// +optional
 	Karpenter *Karpenter `json:"karpenter,omitempty"`
+
+	// HelmReleases are Helm charts installed after the cluster is created.
+	// See [Helm releases](/usage/helm-releases/)
+	// +optional
+	HelmReleases []*HelmRelease `json:"helmReleases,omitempty"`
+
+	// Bootstrap holds Kubernetes manifests applied after the cluster is created.
+	// See [Bootstrap manifests](/usage/bootstrap-manifests/)
+	// +optional
+	Bootstrap *Bootstrap `json:"bootstrap,omitempty"`
+
+	// Hooks are shell commands run at points of the cluster lifecycle.
+	// See [Lifecycle hooks](/usage/lifecycle-hooks/)
+	// +optional
+	Hooks *Hooks `json:"hooks,omitempty"`
+
+	// PullThroughCache holds ECR pull-through cache rules nodes pull public images through.
+	// See [ECR pull-through cache](/usage/pull-through-cache/)
+	// +optional
+	PullThroughCache *PullThroughCache `json:"pullThroughCache,omitempty"`
+
+	// Alarms are CloudWatch alarms monitoring the health of the cluster.
+	// See [CloudWatch alarms](/usage/cloudwatch-alarms/)
+	// +optional
+	Alarms *ClusterAlarms `json:"alarms,omitempty"`
+
+	// Notifications configures where eksctl sends notifications about operations on the cluster.
+	// See [Notifications](/usage/notifications/)
+	// +optional
+	Notifications *Notifications `json:"notifications,omitempty"`
+
+	// CloudFormation adds resources to and patches the cluster stack template rendered by eksctl.
+	// See [CloudFormation overrides](/usage/cloudformation-overrides/)
+	// +optional
+	CloudFormation *CloudFormationOverrides `json:"cloudFormation,omitempty"`
+
+	// StackSet deploys the VPC and the service role of the cluster to the accounts of organizational units.
+	// See [Multi-account prerequisites](/usage/stackset-prerequisites/)
+	// +optional
+	StackSet *StackSet `json:"stackSet,omitempty"`
+
+	// MaintenanceWindow is the window upgrades scheduled with `eksctl upgrade cluster --schedule` run in.
+	// See [Cluster upgrades](/usage/cluster-upgrade/)
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// ParameterExport writes the metadata of the cluster to SSM Parameter Store once it is created,
+	// for other infrastructure as code to read.
+	// See [Exporting cluster metadata](/usage/parameter-export/)
+	// +optional
+	ParameterExport *ParameterExport `json:"parameterExport,omitempty"`
+
+	// Naming adds a prefix and suffix to the names of the CloudFormation stacks and IAM roles eksctl creates,
+	// to follow naming conventions.
+	// See [Naming conventions](/usage/naming/)
+	// +optional
+	Naming *Naming `json:"naming,omitempty"`
+
+	// UpgradePolicy controls whether the cluster enters extended support once the standard support of its
+	// Kubernetes version ends, which is billed at a higher rate.
+	// See [Kubernetes version support](/usage/support-type/)
+	// +optional
+	UpgradePolicy *UpgradePolicy `json:"upgradePolicy,omitempty"`
+}
+
+// Bootstrap holds Kubernetes manifests applied to the cluster, given as local paths
+// or `http(s)` URLs. Relative paths are resolved from the directory of the config file
+type Bootstrap struct {
+	// Manifests are applied in order once the cluster, its nodegroups and its addons are ready
+	// +optional
+	Manifests []string `json:"manifests,omitempty"`
+	// PreDeleteManifests are manifests whose resources are deleted, in reverse order,
+	// before the cluster is deleted, e.g. services of type `LoadBalancer`
+	// +optional
+	PreDeleteManifests []string `json:"preDeleteManifests,omitempty"`
+}
+
+// Hooks holds shell commands run with `sh -c`, in order, with `KUBECONFIG` set to a kubeconfig
+// for the cluster and `EKSCTL_CLUSTER_NAME`, `EKSCTL_CLUSTER_REGION`, `EKSCTL_CLUSTER_VERSION`
+// and `EKSCTL_CLUSTER_ENDPOINT` set to the cluster metadata
+type Hooks struct {
+	// PostCreate commands are run once the cluster is created and bootstrapped
+	// +optional
+	PostCreate []string `json:"postCreate,omitempty"`
+	// PreDelete commands are run before the cluster is deleted
+	// +optional
+	PreDelete []string `json:"preDelete,omitempty"`
+}
+
+// Notifications holds where eksctl sends notifications about operations on the cluster to.
+// Sending notifications is best-effort and does not fail the operation
+type Notifications struct {
+	// EventBusName is the name or ARN of an EventBridge event bus events are sent to when the
+	// cluster is created, when its deletion starts and when a nodegroup is upgraded
+	// +optional
+	EventBusName string `json:"eventBusName,omitempty"`
+	// WebhookURL is an `http(s)` URL notified with a JSON message when the creation, upgrade or
+	// deletion of the cluster, or the upgrade of a nodegroup, starts, succeeds and fails. The
+	// message is compatible with Slack incoming webhooks
+	// +optional
+	WebhookURL string `json:"webhookURL,omitempty"`
+}
+
+// MaintenanceWindow is an SSM maintenance window the upgrade of the control plane runs in, with an SSM Automation
+// running with ServiceRoleARN
+type MaintenanceWindow struct {
+	// Schedule of the window, a cron or rate expression, or `at(<time>)` to run the upgrade once,
+	// e.g. `cron(0 2 ? * SUN *)` or `at(2022-03-06T02:00:00)`
+	// +required
+	Schedule string `json:"schedule"`
+	// Timezone of the schedule, in the IANA format, e.g. `Europe/Paris`.
+	// Defaults to UTC
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+	// Duration of the window in hours, from 1 to 24.
+	// Defaults to `2`
+	// +optional
+	Duration *int `json:"duration,omitempty"`
+	// ServiceRoleARN is the ARN of the role the upgrade runs with. It must be assumable by `ssm.amazonaws.com`
+	// and allow `eks:UpdateClusterVersion`, `eks:DescribeUpdate` and `ssm:DeleteMaintenanceWindow`
+	// +required
+	ServiceRoleARN string `json:"serviceRoleARN"`
+}
+
+// ParameterExport holds where the metadata of the cluster is written to in SSM Parameter Store.
+// Parameters are overwritten when they exist
+type ParameterExport struct {
+	// Path the parameters are written under, e.g. `/platform/prod/eks`.
+	// Defaults to `"/eksctl/<cluster name>"`
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// Values for `UpgradePolicy.SupportType`
+const (
+	// SupportTypeStandard makes EKS upgrade the control plane automatically once standard support ends
+	SupportTypeStandard = "STANDARD"
+	// SupportTypeExtended keeps the cluster on its Kubernetes version in extended support
+	SupportTypeExtended = "EXTENDED"
+)
+
+// UpgradePolicy holds the support policy of the cluster
+type UpgradePolicy struct {
+	// SupportType is either `STANDARD` or `EXTENDED`. EKS defaults it to `EXTENDED` when it is not set
+	// +optional
+	SupportType string `json:"supportType,omitempty"`
 }
 
 // Karpenter provides configuration opti
@@ -821,9 +1028,11 @@ func (c *ClusterConfig) AppendAvailabilityZone(newAZ string) {
 
 // SetClusterStatus populates ClusterStatus using *eks.Cluster.
 func (c *ClusterConfig) SetClusterStatus(cluster *eks.Cluster) error {
-	if networkConfig := cluster.KubernetesNetworkConfig; networkConfig != nil && networkConfig.ServiceIpv4Cidr != nil {
+	if networkConfig := cluster.KubernetesNetworkConfig; networkConfig != nil && (networkConfig.ServiceIpv4Cidr != nil || networkConfig.ServiceIpv6Cidr != nil) {
 		c.Status.KubernetesNetworkConfig = &KubernetesNetworkConfig{
-			ServiceIPv4CIDR: *networkConfig.ServiceIpv4Cidr,
+			IPFamily:        aws.StringValue(networkConfig.IpFamily),
+			ServiceIPv4CIDR: aws.StringValue(networkConfig.ServiceIpv4Cidr),
+			ServiceIPv6CIDR: aws.StringValue(networkConfig.ServiceIpv6Cidr),
 		}
 	}
 	data, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
@@ -947,6 +1156,12 @@ type NodeGroup struct {
 	// +optional
 	TargetGroupARNs []string `json:"targetGroupARNs,omitempty"`
 
+	// LifecycleHooks are the [lifecycle
+	// hooks](https://docs.aws.amazon.com/autoscaling/ec2/userguide/lifecycle-hooks.html) of the auto scaling
+	// group, e.g. to let a node drainer drain nodes before they are terminated
+	// +optional
+	LifecycleHooks []LifecycleHook `json:"lifecycleHooks,omitempty"`
+
 	// Taints taints to apply to the nodegroup
 	// +optional
 	Taints taintsWrapper `json:"taints,omitempty"`
@@ -968,6 +1183,24 @@ type NodeGroup struct {
 	// ContainerRuntime defines the runtime (CRI) to use for containers on the node
 	// +optional
 	ContainerRuntime *string `json:"containerRuntime,omitempty"`
+
+	// IPv6Only launches the nodes in the IPv6-only subnets set in `subnets`, with the kubelet using the IPv6
+	// address of the node and AWS services reached through their dualstack endpoints. Requires an IPv6 cluster
+	// and the `AmazonLinux2` AMI family, see [IPv6-only subnets](/usage/vpc-ip-family/#ipv6-only-subnets)
+	// +optional
+	IPv6Only *bool `json:"ipv6Only,omitempty"`
+
+	// AccountRoleARN is the ARN of an IAM role of another account the subnets of the cluster VPC are
+	// shared with. The role is assumed to create the nodegroup in that account,
+	// see [cross-account nodegroups](/usage/nodegroup-cross-account/)
+	// +optional
+	AccountRoleARN string `json:"accountRoleARN,omitempty"`
+
+	// Provisioner is the name of a plugin provisioning the nodes of the nodegroup instead of an auto scaling group,
+	// e.g. `ocean` for the `eksctl-provisioner-ocean` binary. eksctl still creates the IAM role, the security groups
+	// and the launch template bootstrapping the nodes, see [node provisioner plugins](/usage/node-provisioner-plugins/)
+	// +optional
+	Provisioner string `json:"provisioner,omitempty"`
 }
 
 // GetContainerRuntime returns the container runtime.
@@ -1188,6 +1421,53 @@ type MetricsCollection struct {
 	Metrics []string `json:"metrics,omitempty"`
 }
 
+// Values for `LifecycleHook.Transition`
+const (
+	// LifecycleTransitionLaunch pauses instances when they are launched
+	LifecycleTransitionLaunch = "launch"
+	// LifecycleTransitionTerminate pauses instances before they are terminated
+	LifecycleTransitionTerminate = "terminate"
+)
+
+// Values for `LifecycleHook.DefaultResult`
+const (
+	// LifecycleDefaultResultContinue lets instances continue their transition when the heartbeat timeout expires
+	LifecycleDefaultResultContinue = "CONTINUE"
+	// LifecycleDefaultResultAbandon terminates instances when the heartbeat timeout expires
+	LifecycleDefaultResultAbandon = "ABANDON"
+)
+
+// LifecycleHook is an auto scaling group lifecycle hook, see [cloudformation
+// docs](https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-autoscaling-autoscalinggroup-lifecyclehookspecification.html)
+type LifecycleHook struct {
+	// +required
+	Name string `json:"name"`
+	// Transition is the lifecycle transition the hook pauses instances at,
+	// valid variants are `"launch"` and `"terminate"`
+	// +required
+	Transition string `json:"transition"`
+	// NotificationTargetARN is the ARN of the SNS topic or SQS queue notified when an instance
+	// is paused. Notifications are also sent to EventBridge
+	// +optional
+	NotificationTargetARN string `json:"notificationTargetARN,omitempty"`
+	// RoleARN is the ARN of the IAM role allowing the auto scaling group to publish to
+	// `notificationTargetARN`, it is required when `notificationTargetARN` is set
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+	// HeartbeatTimeout is how long in seconds instances stay paused before `defaultResult` is applied,
+	// between 30 and 7200. Defaults to `3600`
+	// +optional
+	HeartbeatTimeout *int `json:"heartbeatTimeout,omitempty"`
+	// DefaultResult is applied when the heartbeat timeout expires,
+	// valid variants are `"CONTINUE"` and `"ABANDON"`. Defaults to `"CONTINUE"`, so that instances are not
+	// terminated when no one completes the hook
+	// +optional
+	DefaultResult string `json:"defaultResult,omitempty"`
+	// NotificationMetadata is sent along with the notifications
+	// +optional
+	NotificationMetadata string `json:"notificationMetadata,omitempty"`
+}
+
 // ScalingConfig defines the scaling config
 type ScalingConfig struct {
 	// +optional
@@ -1209,6 +1489,34 @@ type NodePool interface {
 	NGTaints() []NodeGroupTaint
 }
 
+// NodeGroupDefaults holds settings that are inherited by every nodegroup and managed nodegroup
+// in the config, unless the nodegroup sets them itself
+type NodeGroupDefaults struct {
+	// Valid variants are `NodeAMIFamily` constants
+	// +optional
+	AMIFamily string `json:"amiFamily,omitempty"`
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+	// +optional
+	VolumeSize *int `json:"volumeSize,omitempty"`
+	// Valid variants are `VolumeType` constants
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+	// +optional
+	SSH *NodeGroupSSH `json:"ssh,omitempty"`
+	// +optional
+	IAM *NodeGroupIAM `json:"iam,omitempty"`
+	// Merged with the labels of each nodegroup, which take precedence
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Merged with the tags of each nodegroup, which take precedence
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// Executed before the pre-bootstrap commands of each nodegroup
+	// +optional
+	PreBootstrapCommands []string `json:"preBootstrapCommands,omitempty"`
+}
+
 // NodeGroupBase represents the base nodegroup config for self-managed and managed nodegroups
 type NodeGroupBase struct {
 	// +required
@@ -1266,6 +1574,13 @@ type NodeGroupBase struct {
 	// +optional
 	MaxPodsPerNode int `json:"maxPodsPerNode,omitempty"`
 
+	// MaxPodsCalculation computes maxPodsPerNode from the instance types of the
+	// nodegroup, one of `auto`, `eni` or `prefix-delegation`. `prefix-delegation`
+	// enables prefix delegation in the `vpc-cni` addon, and `auto` uses it for
+	// instance types built on the Nitro System. Not supported for Windows nodegroups
+	// +optional
+	MaxPodsCalculation string `json:"maxPodsCalculation,omitempty"`
+
 	// See [relevant AWS
 	// docs](https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-attribute-updatepolicy.html#cfn-attributes-updatepolicy-rollingupdate-suspendprocesses)
 	// +optional
@@ -1338,6 +1653,37 @@ type NodeGroupBase struct {
 	// Enable EC2 detailed monitoring
 	// +optional
 	EnableDetailedMonitoring *bool `json:"enableDetailedMonitoring,omitempty"`
+
+	// EnclaveEnabled enables [AWS Nitro
+	// Enclaves](https://docs.aws.amazon.com/enclaves/latest/user/nitro-enclave.html)
+	// on the instances of the nodegroup
+	// Defaults to `false`
+	// +optional
+	EnclaveEnabled *bool `json:"enclaveEnabled,omitempty"`
+
+	// CPUOptions configures the number of CPU cores and threads per core of the instances
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+
+	// CloudFormation adds resources to and patches the nodegroup stack template rendered by eksctl.
+	// See [CloudFormation overrides](/usage/cloudformation-overrides/)
+	// +optional
+	CloudFormation *CloudFormationOverrides `json:"cloudFormation,omitempty"`
+}
+
+// CPUOptions specifies the [CPU
+// options](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-optimize-cpu.html)
+// of the instances
+type CPUOptions struct {
+	// CoreCount is the number of CPU cores
+	// Defaults to the default number of cores of the instance type
+	// +optional
+	CoreCount *int `json:"coreCount,omitempty"`
+
+	// ThreadsPerCore is the number of threads per CPU core, set it to `1` to disable multithreading
+	// Defaults to the default number of threads per core of the instance type
+	// +optional
+	ThreadsPerCore *int `json:"threadsPerCore,omitempty"`
 }
 
 // Placement specifies placement group information
@@ -1374,13 +1720,29 @@ type LaunchTemplate struct {
 	// Launch template ID
 	// +required
 	ID string `json:"id,omitempty"`
-	// Launch template version
+	// Launch template version, either a version number, `$Latest` or `$Default`
 	// Defaults to the default launch template version
-	// TODO support $Default, $Latest
 	Version *string `json:"version,omitempty"`
 	// TODO support Name?
 }
 
+const (
+	// LaunchTemplateVersionLatest refers to the latest version of a launch template
+	LaunchTemplateVersionLatest = "$Latest"
+	// LaunchTemplateVersionDefault refers to the default version of a launch template
+	LaunchTemplateVersionDefault = "$Default"
+)
+
+// Values of `MaxPodsCalculation`
+const (
+	// MaxPodsCalculationAuto uses prefix delegation for instance types built on the Nitro System, and ENI limits otherwise
+	MaxPodsCalculationAuto = "auto"
+	// MaxPodsCalculationENI computes the maximum number of pods from the ENI and IP address limits of the instance type
+	MaxPodsCalculationENI = "eni"
+	// MaxPodsCalculationPrefixDelegation computes the maximum number of pods for prefix delegation, and enables it in the VPC CNI
+	MaxPodsCalculationPrefixDelegation = "prefix-delegation"
+)
+
 // NodeGroupTaint represents a Kubernetes taint
 type NodeGroupTaint struct {
 	Key    string             `json:"key,omitempty"`
@@ -1399,6 +1761,12 @@ type ManagedNodeGroup struct {
 	// Spot creates a spot nodegroup
 	Spot bool `json:"spot,omitempty"`
 
+	// SpotFallback creates a pair of nodegroups from this spec: a spot nodegroup named `<name>-spot`, and an
+	// on-demand nodegroup named `<name>-on-demand` that starts with no nodes and that cluster-autoscaler
+	// scales up when spot capacity is unavailable. Nodes launched by Karpenter are not covered
+	// +optional
+	SpotFallback bool `json:"spotFallback,omitempty"`
+
 	// Taints taints to apply to the nodegroup
 	Taints []NodeGroupTaint `json:"taints,omitempty"`
 
@@ -1539,6 +1907,21 @@ type PrivateCluster struct {
 	// must be enabled for private access.
 	// Valid entries are `AdditionalEndpointServices` constants
 	AdditionalEndpointServices []string `json:"additionalEndpointServices,omitempty"`
+
+	// CustomEndpointServices specifies the services, other than `AdditionalEndpointServices`,
+	// to create interface endpoints for, e.g. `kms` or `elasticfilesystem`.
+	// See [fully-private clusters](/usage/eks-private-cluster/#configuring-the-vpc-endpoints)
+	CustomEndpointServices []string `json:"customEndpointServices,omitempty"`
+
+	// ExistingEndpointIDs specifies the IDs of VPC endpoints that already exist in the
+	// pre-existing VPC, e.g. `vpce-0123456789abcdef0`. No endpoints are created for their services
+	ExistingEndpointIDs []string `json:"existingEndpointIDs,omitempty"`
+
+	// EndpointPolicies specifies the IAM policy documents of the VPC endpoints,
+	// keyed by endpoint service (e.g. `s3`), to restrict the actions and resources
+	// that can be accessed through them.
+	// The endpoints of services without a policy allow full access
+	EndpointPolicies map[string]InlineDocument `json:"endpointPolicies,omitempty"`
 }
 
 // InstanceSelector holds EC2 instance selector options
@@ -1557,6 +1940,25 @@ type InstanceSelector struct {
 	// `"amd64"`
 	// `"arm64"`
 	CPUArchitecture string `json:"cpuArchitecture,omitempty"`
+	// GPUType selects instance types with GPUs of the model or manufacturer,
+	// e.g. `"T4"`, `"A10G"` or `"NVIDIA"`
+	GPUType string `json:"gpuType,omitempty"`
+	// ExcludeBurstable excludes burstable performance instance types,
+	// such as the T family
+	ExcludeBurstable bool `json:"excludeBurstable,omitempty"`
+	// MaxPricePerVCPU is the maximum on-demand price per hour and vCPU, in USD,
+	// of the instance types to select
+	MaxPricePerVCPU *float64 `json:"maxPricePerVCPU,omitempty"`
+	// AllowList is a regular expression of the names of the instance types to select,
+	// e.g. `"^(m5|c5)"`
+	AllowList string `json:"allowList,omitempty"`
+	// DenyList is a regular expression of the names of the instance types to exclude,
+	// e.g. `"^(d|h|i)[0-9]"`
+	DenyList string `json:"denyList,omitempty"`
+	// PreferReserved orders the instance types of the families covered by the active
+	// Reserved Instances and EC2 Instance Savings Plans of the account first,
+	// so that on-demand instances are launched with them first
+	PreferReserved bool `json:"preferReserved,omitempty"`
 }
 
 // IsZero returns true if all fields hold a zero value
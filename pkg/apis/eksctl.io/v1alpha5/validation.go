@@ -3,9 +3,11 @@ package v1alpha5
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
 
@@ -46,6 +48,9 @@ var (
 		"updates to some AWS resources.  See: " +
 		"https://docs.aws.amazon.com/eks/latest/userguide/cluster-endpoint.html " +
 		"for more details")
+
+	// eventBusNamePattern matches the names of EventBridge event buses
+	eventBusNamePattern = regexp.MustCompile(`^[/\.\-_A-Za-z0-9]+$`)
 )
 
 // NOTE: we don't use k8s.io/apimachinery/pkg/util/sets here to keep API package free of dependencies
@@ -59,6 +64,285 @@ func (s nameSet) checkUnique(path, name string) (bool, error) {
 	return true, nil
 }
 
+func validateBootstrap(bootstrap *Bootstrap) error {
+	if bootstrap == nil {
+		return nil
+	}
+	for field, manifests := range map[string][]string{
+		"manifests":          bootstrap.Manifests,
+		"preDeleteManifests": bootstrap.PreDeleteManifests,
+	} {
+		for i, manifest := range manifests {
+			if manifest == "" {
+				return fmt.Errorf("bootstrap.%s[%d] must be set", field, i)
+			}
+		}
+	}
+	return nil
+}
+
+func validateHooks(hooks *Hooks) error {
+	if hooks == nil {
+		return nil
+	}
+	for field, commands := range map[string][]string{
+		"postCreate": hooks.PostCreate,
+		"preDelete":  hooks.PreDelete,
+	} {
+		for i, command := range commands {
+			if strings.TrimSpace(command) == "" {
+				return fmt.Errorf("hooks.%s[%d] must be set", field, i)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateNotifications validates the notifications, which are also set with the --event-bus and --notify-webhook flags
+func ValidateNotifications(notifications *Notifications) error {
+	if notifications == nil {
+		return nil
+	}
+	if notifications.EventBusName == "" && notifications.WebhookURL == "" {
+		return fmt.Errorf("at least one of notifications.eventBusName and notifications.webhookURL must be set")
+	}
+	if err := validateEventBusName(notifications.EventBusName); err != nil {
+		return err
+	}
+	if webhookURL := notifications.WebhookURL; webhookURL != "" {
+		u, err := url.Parse(webhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("notifications.webhookURL %q is not a valid http(s) URL", webhookURL)
+		}
+	}
+	return nil
+}
+
+func validateParameterExport(export *ParameterExport) error {
+	if export == nil || export.Path == "" {
+		return nil
+	}
+	path := export.Path
+	if !strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") || strings.Contains(path, "//") {
+		return fmt.Errorf("parameterExport.path %q must start with a / and not end with one", path)
+	}
+	if lower := strings.ToLower(path); strings.HasPrefix(lower, "/aws") || strings.HasPrefix(lower, "/ssm") {
+		return fmt.Errorf("parameterExport.path %q cannot start with /aws or /ssm, which are reserved", path)
+	}
+	return nil
+}
+
+func validateNaming(cfg *ClusterConfig) error {
+	naming := cfg.Naming
+	if naming == nil {
+		return nil
+	}
+	for _, template := range []struct{ path, value string }{{"naming.prefix", naming.Prefix}, {"naming.suffix", naming.Suffix}} {
+		path := template.path
+		for _, placeholder := range namingPlaceholderRE.FindAllString(template.value, -1) {
+			switch placeholder {
+			case NamingPlaceholderRegion, NamingPlaceholderCluster:
+			case NamingPlaceholderEnv:
+				if naming.Env == "" {
+					return fmt.Errorf("naming.env must be set to use %s in %s", NamingPlaceholderEnv, path)
+				}
+			default:
+				return fmt.Errorf("%s contains unknown placeholder %s, valid placeholders are %s, %s and %s", path, placeholder, NamingPlaceholderEnv, NamingPlaceholderRegion, NamingPlaceholderCluster)
+			}
+		}
+	}
+
+	validateRoleName := func(name, path string) error {
+		if len(name) > maxIAMRoleNameLength {
+			return fmt.Errorf("the name %q of %s is %d characters long, IAM role names cannot be longer than %d characters", name, path, len(name), maxIAMRoleNameLength)
+		}
+		if !iamRoleNameRE.MatchString(name) {
+			return fmt.Errorf("the name %q of %s contains characters not allowed in IAM role names", name, path)
+		}
+		return nil
+	}
+
+	validateStackName := func(name, path string) error {
+		if len(name) > maxStackNameLength {
+			return fmt.Errorf("the name %q of %s is %d characters long, stack names cannot be longer than %d characters", name, path, len(name), maxStackNameLength)
+		}
+		if !stackNameRE.MatchString(name) {
+			return fmt.Errorf("the name %q of %s must start with a letter and contain only letters, numbers and hyphens", name, path)
+		}
+		return nil
+	}
+
+	if err := validateStackName(cfg.StackName(fmt.Sprintf("eksctl-%s-cluster", cfg.Metadata.Name)), "the cluster stack"); err != nil {
+		return err
+	}
+	if cfg.IAM == nil || !IsSetAndNonEmptyString(cfg.IAM.ServiceRoleARN) {
+		if err := validateRoleName(cfg.ServiceRoleName(), "the cluster service role"); err != nil {
+			return err
+		}
+	}
+	for i, ng := range cfg.NodeGroups {
+		path := fmt.Sprintf("nodeGroups[%d]", i)
+		if err := validateStackName(cfg.StackName(fmt.Sprintf("eksctl-%s-nodegroup-%s", cfg.Metadata.Name, ng.Name)), path+" stack"); err != nil {
+			return err
+		}
+		if ng.IAM == nil || ng.IAM.InstanceRoleName == "" && ng.IAM.InstanceRoleARN == "" && ng.IAM.InstanceProfileARN == "" {
+			if err := validateRoleName(cfg.NodeGroupInstanceRoleName(ng.Name), path+" instance role"); err != nil {
+				return err
+			}
+		}
+	}
+	for i, ng := range cfg.ManagedNodeGroups {
+		path := fmt.Sprintf("managedNodeGroups[%d]", i)
+		if err := validateStackName(cfg.StackName(fmt.Sprintf("eksctl-%s-nodegroup-%s", cfg.Metadata.Name, ng.Name)), path+" stack"); err != nil {
+			return err
+		}
+		if ng.IAM == nil || ng.IAM.InstanceRoleName == "" && ng.IAM.InstanceRoleARN == "" {
+			if err := validateRoleName(cfg.NodeGroupInstanceRoleName(ng.Name), path+" instance role"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateUpgradePolicy validates the support type of the cluster
+func ValidateUpgradePolicy(policy *UpgradePolicy) error {
+	if policy == nil {
+		return nil
+	}
+	switch policy.SupportType {
+	case SupportTypeStandard, SupportTypeExtended:
+		return nil
+	case "":
+		return fmt.Errorf("upgradePolicy.supportType must be set")
+	default:
+		return fmt.Errorf("invalid value %q for upgradePolicy.supportType, must be one of %s, %s", policy.SupportType, SupportTypeStandard, SupportTypeExtended)
+	}
+}
+
+func validateMaintenanceWindow(window *MaintenanceWindow) error {
+	if window == nil {
+		return nil
+	}
+	schedule := window.Schedule
+	if !strings.HasPrefix(schedule, "cron(") && !strings.HasPrefix(schedule, "rate(") && !strings.HasPrefix(schedule, "at(") || !strings.HasSuffix(schedule, ")") {
+		return fmt.Errorf("maintenanceWindow.schedule %q must be a cron(), rate() or at() expression", schedule)
+	}
+	if window.Timezone != "" {
+		if _, err := time.LoadLocation(window.Timezone); err != nil {
+			return fmt.Errorf("maintenanceWindow.timezone %q is not a valid IANA timezone", window.Timezone)
+		}
+	}
+	if window.Duration != nil && (*window.Duration < 1 || *window.Duration > 24) {
+		return fmt.Errorf("maintenanceWindow.duration must be between 1 and 24 hours, got %d", *window.Duration)
+	}
+	if window.ServiceRoleARN == "" {
+		return fmt.Errorf("maintenanceWindow.serviceRoleARN must be set")
+	}
+	if !arn.IsARN(window.ServiceRoleARN) {
+		return fmt.Errorf("maintenanceWindow.serviceRoleARN %q is not a valid ARN", window.ServiceRoleARN)
+	}
+	return nil
+}
+
+func validateEventBusName(eventBusName string) error {
+	if eventBusName == "" {
+		return nil
+	}
+	if strings.HasPrefix(eventBusName, "arn:") {
+		if !arn.IsARN(eventBusName) {
+			return fmt.Errorf("notifications.eventBusName %q is not a valid ARN", eventBusName)
+		}
+		return nil
+	}
+	if len(eventBusName) > 256 || !eventBusNamePattern.MatchString(eventBusName) {
+		return fmt.Errorf("notifications.eventBusName %q is not a valid event bus name", eventBusName)
+	}
+	return nil
+}
+
+func validateAPIServerDNS(vpc *ClusterVPC) error {
+	if vpc == nil || vpc.APIServerDNS == nil {
+		return nil
+	}
+	name := vpc.APIServerDNS.Name
+	if name == "" {
+		return setNonEmpty("vpc.apiServerDNS.name")
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("vpc.apiServerDNS.name %q is invalid: %s", name, strings.Join(errs, ", "))
+	}
+	if vpc.APIServerDNS.HostedZoneID == "" && !strings.Contains(name, ".") {
+		return fmt.Errorf("vpc.apiServerDNS.name %q must have a parent domain to create a hosted zone for", name)
+	}
+	return nil
+}
+
+func validateDNSResolver(vpc *ClusterVPC) error {
+	if vpc == nil || vpc.DNSResolver == nil {
+		return nil
+	}
+	resolver := vpc.DNSResolver
+	if resolver.QueryLogDestinationARN == "" && len(resolver.RuleIDs) == 0 {
+		return errors.New("at least one of vpc.dnsResolver.queryLogDestinationARN and vpc.dnsResolver.ruleIDs must be set")
+	}
+	if destination := resolver.QueryLogDestinationARN; destination != "" {
+		parsed, err := arn.Parse(destination)
+		if err != nil {
+			return errors.Wrapf(err, "vpc.dnsResolver.queryLogDestinationARN %q is invalid", destination)
+		}
+		switch parsed.Service {
+		case "logs", "s3", "firehose":
+		default:
+			return fmt.Errorf("vpc.dnsResolver.queryLogDestinationARN %q must be the ARN of a CloudWatch Logs log group, S3 bucket or Kinesis Data Firehose delivery stream", destination)
+		}
+	}
+	seen := map[string]bool{}
+	for _, ruleID := range resolver.RuleIDs {
+		if !strings.HasPrefix(ruleID, "rslvr-rr-") {
+			return fmt.Errorf("vpc.dnsResolver.ruleIDs: %q is not the ID of a resolver rule", ruleID)
+		}
+		if seen[ruleID] {
+			return fmt.Errorf("vpc.dnsResolver.ruleIDs: found duplicate rule %q", ruleID)
+		}
+		seen[ruleID] = true
+	}
+	return nil
+}
+
+func validateClusterTags(vpc *ClusterVPC) error {
+	if vpc == nil || vpc.ClusterTags == nil {
+		return nil
+	}
+	tags := vpc.ClusterTags
+	for _, f := range []struct{ field, value string }{{"subnets", tags.Subnets}, {"securityGroups", tags.SecurityGroups}} {
+		field, value := f.field, f.value
+		switch value {
+		case "", ClusterTagShared, ClusterTagNone:
+		case ClusterTagOwned:
+			if IsEnabled(tags.SharedVPC) {
+				return fmt.Errorf("vpc.clusterTags.%s cannot be %q when vpc.clusterTags.sharedVPC is enabled", field, ClusterTagOwned)
+			}
+		default:
+			return fmt.Errorf("invalid value %q for vpc.clusterTags.%s, valid options: %s, %s, %s", value, field, ClusterTagOwned, ClusterTagShared, ClusterTagNone)
+		}
+	}
+	return nil
+}
+
+func validateVPCCloudFormation(vpc *ClusterVPC) error {
+	if vpc == nil || vpc.CloudFormation == nil {
+		return nil
+	}
+	if vpc.CloudFormation.StackName == "" {
+		return setNonEmpty("vpc.cloudFormation.stackName")
+	}
+	if vpc.ID != "" || (vpc.Subnets != nil && len(vpc.Subnets.Private)+len(vpc.Subnets.Public) != 0) {
+		return errors.New("vpc.id and vpc.subnets cannot be set when vpc.cloudFormation is set, they are read from the stack outputs")
+	}
+	return nil
+}
+
 func setNonEmpty(field string) error {
 	return fmt.Errorf("%s must be set and non-empty", field)
 }
@@ -87,6 +371,80 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		return err
 	}
 
+	for i, addon := range cfg.Addons {
+		if err := addon.validateConfiguration(); err != nil {
+			return fmt.Errorf("addons[%d]: %w", i, err)
+		}
+	}
+
+	if err := validateHelmReleases(cfg); err != nil {
+		return err
+	}
+
+	if err := validateBootstrap(cfg.Bootstrap); err != nil {
+		return err
+	}
+
+	if err := validateHooks(cfg.Hooks); err != nil {
+		return err
+	}
+
+	if err := ValidatePullThroughCache(cfg.PullThroughCache); err != nil {
+		return err
+	}
+
+	if err := validatePullThroughCacheNodeGroups(cfg); err != nil {
+		return err
+	}
+
+	if err := validateAPIServerDNS(cfg.VPC); err != nil {
+		return err
+	}
+
+	if err := validateDNSResolver(cfg.VPC); err != nil {
+		return err
+	}
+
+	if err := validateClusterTags(cfg.VPC); err != nil {
+		return err
+	}
+
+	if err := validateVPCCloudFormation(cfg.VPC); err != nil {
+		return err
+	}
+
+	if err := ValidateClusterAlarms(cfg); err != nil {
+		return err
+	}
+
+	if err := ValidateNotifications(cfg.Notifications); err != nil {
+		return err
+	}
+
+	if err := validateMaintenanceWindow(cfg.MaintenanceWindow); err != nil {
+		return err
+	}
+
+	if err := validateParameterExport(cfg.ParameterExport); err != nil {
+		return err
+	}
+
+	if err := validateNaming(cfg); err != nil {
+		return err
+	}
+
+	if err := ValidateUpgradePolicy(cfg.UpgradePolicy); err != nil {
+		return err
+	}
+
+	if err := validateCloudFormationOverrides(cfg.CloudFormation, "cloudFormation"); err != nil {
+		return err
+	}
+
+	if err := ValidateStackSet(cfg.StackSet); err != nil {
+		return err
+	}
+
 	// names must be unique across both managed and unmanaged nodegroups
 	ngNames := nameSet{}
 	validateNg := func(ng *NodeGroupBase, path string) error {
@@ -111,6 +469,12 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		if err := validateNg(ng.NodeGroupBase, path); err != nil {
 			return err
 		}
+		if IsEnabled(ng.IPv6Only) && len(ng.Subnets) == 0 && !cfg.IPv6Native() {
+			return fmt.Errorf("%s.subnets must be set to the IPv6-only subnets of the nodegroup when %s.ipv6Only is enabled, unless vpc.ipFamily is %s", path, path, IPV6Family)
+		}
+		if cfg.IPv6Native() && ng.PrivateNetworking && len(ng.Subnets) == 0 && !IsEnabled(ng.IPv6Only) {
+			return fmt.Errorf("%s.ipv6Only must be enabled for nodegroups in the IPv6-only private subnets of vpc.ipFamily %s", path, IPV6Family)
+		}
 	}
 
 	for i, ng := range cfg.ManagedNodeGroups {
@@ -221,6 +585,14 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 		}
 	}
 
+	if err := c.validateVPCIPFamily(); err != nil {
+		return err
+	}
+
+	if err := c.validateVPCSecondaryCIDRs(); err != nil {
+		return err
+	}
+
 	if c.KubernetesNetworkConfig != nil && c.KubernetesNetworkConfig.IPv6Enabled() {
 		if IsEnabled(c.VPC.AutoAllocateIPv6) {
 			return fmt.Errorf("auto allocate ipv6 is not supported with IPv6")
@@ -240,6 +612,126 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 	return nil
 }
 
+// validateVPCIPFamily validates an IPv6-native VPC, whose IPv6-only private subnets are created by eksctl
+func (c *ClusterConfig) validateVPCIPFamily() error {
+	if c.VPC.IPFamily == "" {
+		return nil
+	}
+	if !strings.EqualFold(c.VPC.IPFamily, IPV4Family) && !strings.EqualFold(c.VPC.IPFamily, IPV6Family) {
+		return fmt.Errorf("invalid value %q for vpc.ipFamily, must be one of %s, %s", c.VPC.IPFamily, IPV4Family, IPV6Family)
+	}
+	if !c.IPv6Native() {
+		return nil
+	}
+	if c.KubernetesNetworkConfig == nil || !c.KubernetesNetworkConfig.IPv6Enabled() {
+		return fmt.Errorf("vpc.ipFamily %s requires kubernetesNetworkConfig.ipFamily to be %s", IPV6Family, IPV6Family)
+	}
+	if c.VPC.ID != "" || c.VPC.Subnets != nil || c.VPC.CloudFormation != nil {
+		return fmt.Errorf("vpc.ipFamily %s is only supported when eksctl creates the VPC", IPV6Family)
+	}
+	if c.PrivateCluster != nil && c.PrivateCluster.Enabled {
+		return fmt.Errorf("vpc.ipFamily %s is not supported with fully-private clusters, as the control plane and the NAT gateway require the dual-stack public subnets", IPV6Family)
+	}
+	for i, ng := range c.ManagedNodeGroups {
+		if ng.PrivateNetworking {
+			return fmt.Errorf("managedNodeGroups[%d].privateNetworking is not supported with vpc.ipFamily %s, as managed nodegroups cannot be launched in IPv6-only subnets", i, IPV6Family)
+		}
+	}
+	return nil
+}
+
+// validateVPCSecondaryCIDRs validates the secondary IPv4 CIDR blocks of the VPC eksctl creates
+func (c *ClusterConfig) validateVPCSecondaryCIDRs() error {
+	if len(c.VPC.SecondaryCIDRs) == 0 {
+		return nil
+	}
+	if c.VPC.ID != "" || c.VPC.CloudFormation != nil {
+		return errors.New("vpc.secondaryCIDRs is only supported when eksctl creates the VPC")
+	}
+	if c.KubernetesNetworkConfig != nil && c.KubernetesNetworkConfig.IPv6Enabled() {
+		return errors.New("vpc.secondaryCIDRs is not supported with IPv6")
+	}
+	cidrs, err := validateCIDRs(c.VPC.SecondaryCIDRs)
+	if err != nil {
+		return err
+	}
+
+	var networks []*net.IPNet
+	if c.VPC.CIDR != nil {
+		networks = append(networks, &c.VPC.CIDR.IPNet)
+	}
+	for _, cidr := range cidrs {
+		_, network, _ := net.ParseCIDR(cidr)
+		if network.IP.To4() == nil {
+			return fmt.Errorf("invalid vpc.secondaryCIDRs %q, must be an IPv4 CIDR", cidr)
+		}
+		if prefix, _ := network.Mask.Size(); prefix < 16 || prefix > 24 {
+			return fmt.Errorf("invalid vpc.secondaryCIDRs %q, prefix must be between /16 and /24", cidr)
+		}
+		for _, n := range networks {
+			if n.Contains(network.IP) || network.Contains(n.IP) {
+				return fmt.Errorf("vpc.secondaryCIDRs %q overlaps with %q", cidr, n.String())
+			}
+		}
+		networks = append(networks, network)
+	}
+	c.VPC.SecondaryCIDRs = cidrs
+	if c.VPC.Subnets != nil {
+		return c.validateVPCSecondaryCIDRSubnets(networks)
+	}
+	return nil
+}
+
+// validateVPCSecondaryCIDRSubnets validates the subnets eksctl creates in the VPC CIDR blocks, which must be keyed by
+// their availability zone, with a private and a public subnet per zone unless the cluster is fully-private
+func (c *ClusterConfig) validateVPCSecondaryCIDRSubnets(networks []*net.IPNet) error {
+	for topology, subnets := range map[string]AZSubnetMapping{
+		"private": c.VPC.Subnets.Private,
+		"public":  c.VPC.Subnets.Public,
+	} {
+		for name, subnet := range subnets {
+			path := fmt.Sprintf("vpc.subnets.%s.%s", topology, name)
+			if subnet.ID != "" {
+				return fmt.Errorf("%s.id cannot be set with vpc.secondaryCIDRs, as eksctl creates the subnets", path)
+			}
+			if subnet.AZ != name {
+				return fmt.Errorf("%s must be keyed by its availability zone %q with vpc.secondaryCIDRs", path, subnet.AZ)
+			}
+			if subnet.CIDR == nil {
+				return fmt.Errorf("%s.cidr must be set with vpc.secondaryCIDRs", path)
+			}
+			if !cidrWithin(&subnet.CIDR.IPNet, networks) {
+				return fmt.Errorf("%s.cidr %q must be within vpc.cidr or vpc.secondaryCIDRs", path, subnet.CIDR.String())
+			}
+		}
+	}
+	if c.PrivateCluster != nil && c.PrivateCluster.Enabled {
+		return nil
+	}
+	for zone := range c.VPC.Subnets.Private {
+		if _, ok := c.VPC.Subnets.Public[zone]; !ok {
+			return fmt.Errorf("vpc.subnets.public must have a subnet in %q, like vpc.subnets.private, with vpc.secondaryCIDRs", zone)
+		}
+	}
+	for zone := range c.VPC.Subnets.Public {
+		if _, ok := c.VPC.Subnets.Private[zone]; !ok {
+			return fmt.Errorf("vpc.subnets.private must have a subnet in %q, like vpc.subnets.public, with vpc.secondaryCIDRs", zone)
+		}
+	}
+	return nil
+}
+
+// cidrWithin returns whether the CIDR is contained in one of the networks
+func cidrWithin(cidr *net.IPNet, networks []*net.IPNet) bool {
+	prefix, _ := cidr.Mask.Size()
+	for _, n := range networks {
+		if networkPrefix, _ := n.Mask.Size(); n.Contains(cidr.IP) && prefix >= networkPrefix {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *ClusterConfig) unsupportedVPCCNIAddonVersion() (bool, error) {
 	for _, addon := range c.Addons {
 		if addon.Name == VPCCNIAddon {
@@ -257,6 +749,81 @@ func (c *ClusterConfig) unsupportedVPCCNIAddonVersion() (bool, error) {
 	return false, nil
 }
 
+func (c *ClusterConfig) validateIPv6AddonVersions() error {
+	minimumVersions := map[string]string{
+		CoreDNSAddon:   minimumCoreDNSVersionForIPv6,
+		KubeProxyAddon: minimumKubeProxyVersionForIPv6,
+	}
+	for _, addon := range c.Addons {
+		minimumVersion, ok := minimumVersions[strings.ToLower(addon.Name)]
+		if !ok || addon.Version == "" || addon.Version == "latest" {
+			continue
+		}
+		unsupported, err := versionLessThan(addon.Version, minimumVersion)
+		if err != nil {
+			return err
+		}
+		if unsupported {
+			return fmt.Errorf("%s version must be at least version %s for IPv6", addon.Name, minimumVersion)
+		}
+	}
+	return nil
+}
+
+// validateIPv6VPCCNIPolicy checks that the policies of the VPC CNI addon allow assigning IPv6 addresses, which
+// the AmazonEKS_CNI_Policy managed policy does not
+func (c *ClusterConfig) validateIPv6VPCCNIPolicy() error {
+	for _, addon := range c.Addons {
+		if strings.ToLower(addon.Name) != VPCCNIAddon || addon.ServiceAccountRoleARN != "" {
+			continue
+		}
+		if len(addon.AttachPolicy) > 0 {
+			if !policyAllowsAction(addon.AttachPolicy, "ec2:AssignIpv6Addresses") {
+				return fmt.Errorf("%s attachPolicy must allow ec2:AssignIpv6Addresses for IPv6", VPCCNIAddon)
+			}
+			continue
+		}
+		if len(addon.AttachPolicyARNs) == 1 && strings.HasSuffix(addon.AttachPolicyARNs[0], ":policy/"+IAMPolicyAmazonEKSCNIPolicy) {
+			return fmt.Errorf("%s does not allow ec2:AssignIpv6Addresses required for IPv6; remove %s attachPolicyARNs to use the IPv6 policy created by eksctl, or set attachPolicy",
+				IAMPolicyAmazonEKSCNIPolicy, VPCCNIAddon)
+		}
+	}
+	return nil
+}
+
+// policyAllowsAction returns true when a statement of the policy document allows the given action,
+// or a wildcard matching it
+func policyAllowsAction(policy InlineDocument, action string) bool {
+	var statements []interface{}
+	switch s := policy["Statement"].(type) {
+	case []interface{}:
+		statements = s
+	case map[string]interface{}:
+		statements = []interface{}{s}
+	}
+
+	service := strings.SplitN(action, ":", 2)[0]
+	for _, s := range statements {
+		statement, ok := s.(map[string]interface{})
+		if !ok || statement["Effect"] != "Allow" {
+			continue
+		}
+		var actions []interface{}
+		switch a := statement["Action"].(type) {
+		case string:
+			actions = []interface{}{a}
+		case []interface{}:
+			actions = a
+		}
+		for _, a := range actions {
+			if a == "*" || a == service+":*" || strings.EqualFold(fmt.Sprint(a), action) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func versionLessThan(v1, v2 string) (bool, error) {
 	v1Version, err := parseVersion(v1)
 	if err != nil {
@@ -337,6 +904,36 @@ func (c *ClusterConfig) ValidatePrivateCluster() error {
 			}
 		}
 
+		if customEndpoints := c.PrivateCluster.CustomEndpointServices; len(customEndpoints) > 0 {
+			if c.PrivateCluster.SkipEndpointCreation {
+				return errors.New("privateCluster.customEndpointServices cannot be set when privateCluster.skipEndpointCreation is true")
+			}
+			if err := ValidateCustomEndpointServices(customEndpoints); err != nil {
+				return errors.Wrap(err, "invalid value in privateCluster.customEndpointServices")
+			}
+		}
+
+		if existingEndpoints := c.PrivateCluster.ExistingEndpointIDs; len(existingEndpoints) > 0 {
+			if c.PrivateCluster.SkipEndpointCreation {
+				return errors.New("privateCluster.existingEndpointIDs cannot be set when privateCluster.skipEndpointCreation is true")
+			}
+			if c.VPC == nil || c.VPC.ID == "" {
+				return errors.New("privateCluster.existingEndpointIDs can only be set when a pre-existing VPC is supplied")
+			}
+			if err := ValidateExistingEndpointIDs(existingEndpoints); err != nil {
+				return errors.Wrap(err, "invalid value in privateCluster.existingEndpointIDs")
+			}
+		}
+
+		if endpointPolicies := c.PrivateCluster.EndpointPolicies; len(endpointPolicies) > 0 {
+			if c.PrivateCluster.SkipEndpointCreation {
+				return errors.New("privateCluster.endpointPolicies cannot be set when privateCluster.skipEndpointCreation is true")
+			}
+			if err := c.validateEndpointPolicies(endpointPolicies); err != nil {
+				return errors.Wrap(err, "invalid value in privateCluster.endpointPolicies")
+			}
+		}
+
 		if c.VPC != nil && c.VPC.ClusterEndpoints == nil {
 			c.VPC.ClusterEndpoints = &ClusterEndpoints{}
 		}
@@ -347,11 +944,37 @@ func (c *ClusterConfig) ValidatePrivateCluster() error {
 	return nil
 }
 
+func (c *ClusterConfig) validateEndpointPolicies(endpointPolicies map[string]InlineDocument) error {
+	endpointServices := c.PrivateCluster.EndpointServices()
+	if c.HasClusterCloudWatchLogging() {
+		endpointServices = append(endpointServices, EndpointServiceCloudWatch)
+	}
+	for service, policy := range endpointPolicies {
+		found := false
+		for _, s := range endpointServices {
+			if s == service {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("no VPC endpoint is created for endpoint service %q", service)
+		}
+		if _, ok := policy["Statement"]; !ok {
+			return errors.Errorf("policy of endpoint service %q must have a Statement", service)
+		}
+	}
+	return nil
+}
+
 // validateKubernetesNetworkConfig validates the k8s network config
 func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
 	if c.KubernetesNetworkConfig == nil {
 		return nil
 	}
+	if c.KubernetesNetworkConfig.ServiceIPv6CIDR != "" {
+		return errors.New("kubernetesNetworkConfig.serviceIPv6CIDR is assigned by EKS and cannot be set")
+	}
 	if c.KubernetesNetworkConfig.ServiceIPv4CIDR != "" {
 		if c.KubernetesNetworkConfig.IPv6Enabled() {
 			return fmt.Errorf("service ipv4 cidr is not supported with IPv6")
@@ -378,6 +1001,14 @@ func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
 			return fmt.Errorf("%s version must be at least version %s for IPv6", VPCCNIAddon, minimumVPCCNIVersionForIPv6)
 		}
 
+		if err := c.validateIPv6AddonVersions(); err != nil {
+			return err
+		}
+
+		if err := c.validateIPv6VPCCNIPolicy(); err != nil {
+			return err
+		}
+
 		if c.IAM == nil || c.IAM != nil && IsDisabled(c.IAM.WithOIDC) {
 			return fmt.Errorf("oidc needs to be enabled if IPv6 is set")
 		}
@@ -406,6 +1037,10 @@ func PrivateOnly(ces *ClusterEndpoints) bool {
 
 func validateNodeGroupBase(np NodePool, path string) error {
 	ng := np.BaseNodeGroup()
+	if err := validateCloudFormationOverrides(ng.CloudFormation, path+".cloudFormation"); err != nil {
+		return err
+	}
+
 	if ng.VolumeSize == nil {
 		errCantSet := func(field string) error {
 			return fmt.Errorf("%s.%s cannot be set without %s.volumeSize", path, field, path)
@@ -425,13 +1060,19 @@ func validateNodeGroupBase(np NodePool, path string) error {
 		return err
 	}
 
+	if ng.InstanceSelector != nil {
+		if err := validateInstanceSelector(ng.InstanceSelector, path+".instanceSelector"); err != nil {
+			return err
+		}
+	}
+
 	if ng.VolumeEncrypted == nil || IsDisabled(ng.VolumeEncrypted) {
 		if IsSetAndNonEmptyString(ng.VolumeKmsKeyID) {
 			return fmt.Errorf("%s.volumeKmsKeyID can not be set without %s.volumeEncrypted enabled explicitly", path, path)
 		}
 	}
-	if ng.MaxPodsPerNode < 0 {
-		return fmt.Errorf("%s.maxPodsPerNode cannot be negative", path)
+	if err := validateMaxPodsPerNode(ng, path); err != nil {
+		return err
 	}
 
 	if IsEnabled(ng.DisablePodIMDS) && ng.IAM != nil {
@@ -459,6 +1100,10 @@ func validateNodeGroupBase(np NodePool, path string) error {
 		}
 	}
 
+	if err := validateCPUOptions(np, path); err != nil {
+		return err
+	}
+
 	if ng.AMIFamily != "" && !isSupportedAMIFamily(ng.AMIFamily) {
 		return fmt.Errorf("AMI Family %s is not supported - use one of: %s", ng.AMIFamily, strings.Join(supportedAMIFamilies(), ", "))
 	}
@@ -479,6 +1124,72 @@ func validateNodeGroupBase(np NodePool, path string) error {
 	return nil
 }
 
+func validateMaxPodsPerNode(ng *NodeGroupBase, path string) error {
+	if ng.MaxPodsPerNode < 0 {
+		return fmt.Errorf("%s.maxPodsPerNode cannot be negative", path)
+	}
+	if ng.MaxPodsCalculation == "" {
+		return nil
+	}
+	if ng.MaxPodsPerNode != 0 {
+		return fmt.Errorf("%s.maxPodsPerNode and %s.maxPodsCalculation are mutually exclusive", path, path)
+	}
+	switch ng.MaxPodsCalculation {
+	case MaxPodsCalculationAuto, MaxPodsCalculationENI, MaxPodsCalculationPrefixDelegation:
+	default:
+		return fmt.Errorf("%s.maxPodsCalculation must be one of: %s, %s, %s", path, MaxPodsCalculationAuto, MaxPodsCalculationENI, MaxPodsCalculationPrefixDelegation)
+	}
+	if IsWindowsImage(ng.AMIFamily) {
+		return fmt.Errorf("%s.maxPodsCalculation is not supported for Windows nodegroups", path)
+	}
+	return nil
+}
+
+func validateCPUOptions(np NodePool, path string) error {
+	ng := np.BaseNodeGroup()
+	if ng.CPUOptions == nil {
+		return nil
+	}
+	if ng.CPUOptions.CoreCount == nil && ng.CPUOptions.ThreadsPerCore == nil {
+		return fmt.Errorf("at least one of %[1]s.cpuOptions.coreCount or %[1]s.cpuOptions.threadsPerCore must be set", path)
+	}
+	if coreCount := ng.CPUOptions.CoreCount; coreCount != nil && *coreCount < 1 {
+		return fmt.Errorf("%s.cpuOptions.coreCount must be at least 1", path)
+	}
+	if threadsPerCore := ng.CPUOptions.ThreadsPerCore; threadsPerCore != nil && *threadsPerCore != 1 && *threadsPerCore != 2 {
+		return fmt.Errorf("%s.cpuOptions.threadsPerCore must be either 1 or 2", path)
+	}
+	var instanceTypes []string
+	switch ng := np.(type) {
+	case *NodeGroup:
+		if ng.InstancesDistribution != nil {
+			instanceTypes = ng.InstancesDistribution.InstanceTypes
+		}
+	case *ManagedNodeGroup:
+		instanceTypes = ng.InstanceTypes
+	}
+	if len(instanceTypes) > 1 || (ng.InstanceSelector != nil && !ng.InstanceSelector.IsZero()) {
+		return fmt.Errorf("%s.cpuOptions can only be set for nodegroups with a single instance type", path)
+	}
+	return nil
+}
+
+func validateInstanceSelector(is *InstanceSelector, path string) error {
+	if is.GPUType != "" && is.GPUs != nil && *is.GPUs == 0 {
+		return fmt.Errorf("%[1]s.gpuType cannot be set when %[1]s.gpus is 0", path)
+	}
+	if is.MaxPricePerVCPU != nil && *is.MaxPricePerVCPU <= 0 {
+		return fmt.Errorf("%s.maxPricePerVCPU must be greater than 0", path)
+	}
+	if _, err := regexp.Compile(is.AllowList); err != nil {
+		return errors.Wrapf(err, "invalid %s.allowList", path)
+	}
+	if _, err := regexp.Compile(is.DenyList); err != nil {
+		return errors.Wrapf(err, "invalid %s.denyList", path)
+	}
+	return nil
+}
+
 func validateVolumeOpts(ng *NodeGroupBase, path string) error {
 	if ng.VolumeType != nil {
 		if ng.VolumeIOPS != nil && !(*ng.VolumeType == NodeVolumeTypeIO1 || *ng.VolumeType == NodeVolumeTypeGP3) {
@@ -591,6 +1302,15 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		}
 	}
 
+	if ng.AccountRoleARN != "" {
+		if _, err := arn.Parse(ng.AccountRoleARN); err != nil {
+			return errors.Wrapf(err, "invalid ARN %q in %s.accountRoleARN", ng.AccountRoleARN, path)
+		}
+		if ng.SecurityGroups != nil && IsEnabled(ng.SecurityGroups.WithShared) {
+			return fmt.Errorf("%s.securityGroups.withShared cannot be enabled with accountRoleARN, as the shared security group belongs to the account of the cluster", path)
+		}
+	}
+
 	if err := validateTaints(ng.Taints); err != nil {
 		return err
 	}
@@ -652,6 +1372,10 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		return err
 	}
 
+	if err := validateLifecycleHooks(ng.LifecycleHooks, path); err != nil {
+		return err
+	}
+
 	if ng.ContainerRuntime != nil {
 		if *ng.ContainerRuntime == ContainerRuntimeContainerD && ng.AMIFamily != NodeImageFamilyAmazonLinux2 {
 			// check if it's dockerd or containerd
@@ -662,6 +1386,102 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		}
 	}
 
+	if IsEnabled(ng.IPv6Only) {
+		if err := validateIPv6Only(ng, path); err != nil {
+			return err
+		}
+	}
+
+	if ng.Provisioner != "" {
+		if err := validateProvisioner(ng, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var provisionerNameRE = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// validateProvisioner validates a nodegroup whose nodes are provisioned by a plugin, which has no auto scaling group
+func validateProvisioner(ng *NodeGroup, path string) error {
+	if !provisionerNameRE.MatchString(ng.Provisioner) {
+		return fmt.Errorf("invalid %s.provisioner %q, must consist of lowercase alphanumeric characters and hyphens", path, ng.Provisioner)
+	}
+	for _, field := range []struct {
+		name string
+		set  bool
+	}{
+		{"instancesDistribution", ng.InstancesDistribution != nil},
+		{"asgMetricsCollection", len(ng.ASGMetricsCollection) > 0},
+		{"asgSuspendProcesses", len(ng.ASGSuspendProcesses) > 0},
+		{"classicLoadBalancerNames", len(ng.ClassicLoadBalancerNames) > 0},
+		{"targetGroupARNs", len(ng.TargetGroupARNs) > 0},
+		{"lifecycleHooks", len(ng.LifecycleHooks) > 0},
+	} {
+		if field.set {
+			return fmt.Errorf("%s.%s cannot be set with %s.provisioner, as the nodes are not launched by an auto scaling group of eksctl", path, field.name, path)
+		}
+	}
+	return nil
+}
+
+// validateIPv6Only validates a nodegroup launched in IPv6-only subnets, which are either existing subnets or the private
+// subnets of an IPv6-native VPC, as the other subnets created by eksctl are dual-stack
+func validateIPv6Only(ng *NodeGroup, path string) error {
+	// an unset amiFamily defaults to AmazonLinux2
+	if ng.AMIFamily != "" && ng.AMIFamily != NodeImageFamilyAmazonLinux2 {
+		return fmt.Errorf("%s.ipv6Only is only supported with amiFamily %q", path, NodeImageFamilyAmazonLinux2)
+	}
+	if len(ng.Subnets) == 0 && !ng.PrivateNetworking {
+		return fmt.Errorf("%s.subnets must be set to the IPv6-only subnets of the nodegroup, or %s.privateNetworking enabled in an IPv6-native VPC, when %s.ipv6Only is enabled", path, path, path)
+	}
+	if ng.OverrideBootstrapCommand != nil {
+		return fmt.Errorf("%s.overrideBootstrapCommand cannot be set when %s.ipv6Only is enabled, as the node is bootstrapped for IPv6 by eksctl", path, path)
+	}
+	return nil
+}
+
+func validateLifecycleHooks(hooks []LifecycleHook, path string) error {
+	names := map[string]bool{}
+	for i, hook := range hooks {
+		hookPath := fmt.Sprintf("%s.lifecycleHooks[%d]", path, i)
+		if hook.Name == "" {
+			return fmt.Errorf("%s.name must be set", hookPath)
+		}
+		if names[hook.Name] {
+			return fmt.Errorf("%s.name %q is not unique", hookPath, hook.Name)
+		}
+		names[hook.Name] = true
+
+		if hook.Transition != LifecycleTransitionLaunch && hook.Transition != LifecycleTransitionTerminate {
+			return fmt.Errorf("%s.transition must be one of %q or %q", hookPath, LifecycleTransitionLaunch, LifecycleTransitionTerminate)
+		}
+		if hook.HeartbeatTimeout != nil && (*hook.HeartbeatTimeout < 30 || *hook.HeartbeatTimeout > 7200) {
+			return fmt.Errorf("%s.heartbeatTimeout must be between 30 and 7200", hookPath)
+		}
+		if hook.DefaultResult != "" && hook.DefaultResult != LifecycleDefaultResultContinue && hook.DefaultResult != LifecycleDefaultResultAbandon {
+			return fmt.Errorf("%s.defaultResult must be one of %q or %q", hookPath, LifecycleDefaultResultContinue, LifecycleDefaultResultAbandon)
+		}
+
+		if hook.NotificationTargetARN != "" {
+			target, err := arn.Parse(hook.NotificationTargetARN)
+			if err != nil {
+				return errors.Wrapf(err, "invalid ARN %q in %s.notificationTargetARN", hook.NotificationTargetARN, hookPath)
+			}
+			if target.Service != "sns" && target.Service != "sqs" {
+				return fmt.Errorf("%s.notificationTargetARN must be the ARN of an SNS topic or SQS queue", hookPath)
+			}
+			if hook.RoleARN == "" {
+				return fmt.Errorf("%s.roleARN must be set when notificationTargetARN is set", hookPath)
+			}
+		}
+		if hook.RoleARN != "" {
+			if _, err := arn.Parse(hook.RoleARN); err != nil {
+				return errors.Wrapf(err, "invalid ARN %q in %s.roleARN", hook.RoleARN, hookPath)
+			}
+		}
+	}
 	return nil
 }
 
@@ -782,6 +1602,30 @@ func validateNodeGroupIAM(iam *NodeGroupIAM, value, fieldName, path string) erro
 	return nil
 }
 
+// validateKubeletLabelsAndTaints validates that the labels and taints of a managed nodegroup rejected by the EKS API
+// are applied by kubelet, which is configured through the user data of the AmazonLinux2 and Ubuntu nodegroups eksctl
+// creates the launch template of
+func validateKubeletLabelsAndTaints(ng *ManagedNodeGroup, path string) error {
+	_, kubeletLabels := ng.SplitLabelsForEKS()
+	_, kubeletTaints := ng.SplitTaintsForEKS()
+	if len(kubeletLabels) == 0 && len(kubeletTaints) == 0 {
+		return nil
+	}
+	const prefix = "labels and taints in the kubernetes.io and k8s.io namespaces are passed to kubelet through user data"
+	switch {
+	case ng.LaunchTemplate != nil || ng.AMI != "":
+		return errors.Errorf("%s, and cannot be used with a custom AMI or a launch template (%s)", prefix, path)
+	case ng.OverrideBootstrapCommand != nil:
+		return errors.Errorf("%s, and cannot be used with overrideBootstrapCommand, pass them to kubelet in the command instead (%s)", prefix, path)
+	}
+	switch ng.AMIFamily {
+	case "", NodeImageFamilyAmazonLinux2, NodeImageFamilyUbuntu2004, NodeImageFamilyUbuntu1804:
+		return nil
+	default:
+		return errors.Errorf("%s, and cannot be used with the %s AMI family (%s)", prefix, ng.AMIFamily, path)
+	}
+}
+
 // ValidateManagedNodeGroup validates a ManagedNodeGroup and sets some defaults
 func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 	switch ng.AMIFamily {
@@ -885,17 +1729,24 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		return err
 	}
 
+	if err := validateNodeGroupLabels(ng.Labels); err != nil {
+		return err
+	}
+
+	if err := validateKubeletLabelsAndTaints(ng, path); err != nil {
+		return err
+	}
+
 	switch {
 	case ng.LaunchTemplate != nil:
 		if ng.LaunchTemplate.ID == "" {
 			return errors.Errorf("launchTemplate.id is required if launchTemplate is set (%s.%s)", path, "launchTemplate")
 		}
 
-		if ng.LaunchTemplate.Version != nil {
-			// TODO support `latest` and `default`
-			versionNumber, err := strconv.ParseInt(*ng.LaunchTemplate.Version, 10, 64)
+		if version := ng.LaunchTemplate.Version; version != nil && *version != LaunchTemplateVersionLatest && *version != LaunchTemplateVersionDefault {
+			versionNumber, err := strconv.ParseInt(*version, 10, 64)
 			if err != nil {
-				return errors.Wrap(err, "invalid launch template version")
+				return errors.Wrapf(err, "invalid launch template version, must be a version number, %s or %s", LaunchTemplateVersionLatest, LaunchTemplateVersionDefault)
 			}
 			if versionNumber < 1 {
 				return errors.Errorf("launchTemplate.version must be >= 1 (%s.%s)", path, "launchTemplate.version")
@@ -904,13 +1755,13 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 
 		if ng.InstanceType != "" || ng.AMI != "" || IsEnabled(ng.SSH.Allow) || IsEnabled(ng.SSH.EnableSSM) || len(ng.SSH.SourceSecurityGroupIDs) > 0 ||
 			ng.VolumeSize != nil || len(ng.PreBootstrapCommands) > 0 || ng.OverrideBootstrapCommand != nil ||
-			len(ng.SecurityGroups.AttachIDs) > 0 || ng.InstanceName != "" || ng.InstancePrefix != "" || ng.MaxPodsPerNode != 0 ||
-			IsEnabled(ng.DisableIMDSv1) || IsEnabled(ng.DisablePodIMDS) || ng.Placement != nil {
+			len(ng.SecurityGroups.AttachIDs) > 0 || ng.InstanceName != "" || ng.InstancePrefix != "" || ng.MaxPodsPerNode != 0 || ng.MaxPodsCalculation != "" ||
+			IsEnabled(ng.DisableIMDSv1) || IsEnabled(ng.DisablePodIMDS) || ng.Placement != nil || IsEnabled(ng.EnclaveEnabled) || ng.CPUOptions != nil {
 
 			incompatibleFields := []string{
 				"instanceType", "ami", "ssh.allow", "ssh.enableSSM", "ssh.sourceSecurityGroupIds", "securityGroups",
-				"volumeSize", "instanceName", "instancePrefix", "maxPodsPerNode", "disableIMDSv1",
-				"disablePodIMDS", "preBootstrapCommands", "overrideBootstrapCommand", "placement",
+				"volumeSize", "instanceName", "instancePrefix", "maxPodsPerNode", "maxPodsCalculation", "disableIMDSv1",
+				"disablePodIMDS", "preBootstrapCommands", "overrideBootstrapCommand", "placement", "enclaveEnabled", "cpuOptions",
 			}
 			return errors.Errorf("cannot set %s in managedNodeGroup when a launch template is supplied", strings.Join(incompatibleFields, ", "))
 		}
@@ -931,6 +1782,9 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		if ng.MaxPodsPerNode != 0 {
 			return notSupportedWithCustomAMIErr("maxPodsPerNode")
 		}
+		if ng.MaxPodsCalculation != "" {
+			return notSupportedWithCustomAMIErr("maxPodsCalculation")
+		}
 		if ng.SSH != nil && IsEnabled(ng.SSH.EnableSSM) {
 			return notSupportedWithCustomAMIErr("enableSSM")
 		}
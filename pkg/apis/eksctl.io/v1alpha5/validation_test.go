@@ -10,6 +10,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 	"github.com/weaveworks/eksctl/pkg/utils/strings"
 )
 
@@ -105,6 +106,348 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("nodeGroups[*].maxPodsCalculation validation", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewClusterConfig().NewNodeGroup()
+			ng.Name = "node-group"
+		})
+
+		It("accepts a calculation", func() {
+			ng.MaxPodsCalculation = api.MaxPodsCalculationPrefixDelegation
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects an invalid calculation", func() {
+			ng.MaxPodsCalculation = "invalid"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].maxPodsCalculation must be one of: auto, eni, prefix-delegation"))
+		})
+
+		It("rejects a calculation with maxPodsPerNode", func() {
+			ng.MaxPodsCalculation = api.MaxPodsCalculationAuto
+			ng.MaxPodsPerNode = 20
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].maxPodsPerNode and nodeGroups[0].maxPodsCalculation are mutually exclusive"))
+		})
+
+		It("rejects a calculation for Windows nodegroups", func() {
+			ng.MaxPodsCalculation = api.MaxPodsCalculationAuto
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].maxPodsCalculation is not supported for Windows nodegroups"))
+		})
+	})
+
+	Describe("nodeGroups[*].lifecycleHooks validation", func() {
+		var ng0 *api.NodeGroup
+
+		BeforeEach(func() {
+			ng0 = api.NewClusterConfig().NewNodeGroup()
+			ng0.Name = "node-group"
+			ng0.LifecycleHooks = []api.LifecycleHook{
+				{
+					Name:                  "drain",
+					Transition:            api.LifecycleTransitionTerminate,
+					NotificationTargetARN: "arn:aws:sns:us-west-2:123456789012:node-drainer",
+					RoleARN:               "arn:aws:iam::123456789012:role/node-drainer",
+					HeartbeatTimeout:      aws.Int(300),
+					DefaultResult:         "CONTINUE",
+				},
+			}
+		})
+
+		It("should accept valid lifecycle hooks", func() {
+			Expect(api.ValidateNodeGroup(0, ng0)).To(Succeed())
+		})
+
+		It("should accept lifecycle hooks without a notification target", func() {
+			ng0.LifecycleHooks[0].NotificationTargetARN = ""
+			ng0.LifecycleHooks[0].RoleARN = ""
+			Expect(api.ValidateNodeGroup(0, ng0)).To(Succeed())
+		})
+
+		DescribeTable("invalid lifecycle hooks", func(update func(*api.LifecycleHook), expectedErr string) {
+			update(&ng0.LifecycleHooks[0])
+			Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("missing name", func(h *api.LifecycleHook) {
+				h.Name = ""
+			}, "nodeGroups[0].lifecycleHooks[0].name must be set"),
+			Entry("invalid transition", func(h *api.LifecycleHook) {
+				h.Transition = "autoscaling:EC2_INSTANCE_TERMINATING"
+			}, `nodeGroups[0].lifecycleHooks[0].transition must be one of "launch" or "terminate"`),
+			Entry("heartbeat timeout out of range", func(h *api.LifecycleHook) {
+				h.HeartbeatTimeout = aws.Int(10)
+			}, "nodeGroups[0].lifecycleHooks[0].heartbeatTimeout must be between 30 and 7200"),
+			Entry("invalid default result", func(h *api.LifecycleHook) {
+				h.DefaultResult = "RETRY"
+			}, `nodeGroups[0].lifecycleHooks[0].defaultResult must be one of "CONTINUE" or "ABANDON"`),
+			Entry("notification target that is not an SNS topic or SQS queue", func(h *api.LifecycleHook) {
+				h.NotificationTargetARN = "arn:aws:lambda:us-west-2:123456789012:function:node-drainer"
+			}, "nodeGroups[0].lifecycleHooks[0].notificationTargetARN must be the ARN of an SNS topic or SQS queue"),
+			Entry("notification target without a role", func(h *api.LifecycleHook) {
+				h.RoleARN = ""
+			}, "nodeGroups[0].lifecycleHooks[0].roleARN must be set when notificationTargetARN is set"),
+		)
+
+		It("should reject duplicate names", func() {
+			ng0.LifecycleHooks = append(ng0.LifecycleHooks, api.LifecycleHook{Name: "drain", Transition: api.LifecycleTransitionLaunch})
+			Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError(ContainSubstring(`nodeGroups[0].lifecycleHooks[1].name "drain" is not unique`)))
+		})
+	})
+
+	Describe("nodeGroups[*].provisioner validation", func() {
+		var ng0 *api.NodeGroup
+
+		BeforeEach(func() {
+			ng0 = api.NewClusterConfig().NewNodeGroup()
+			ng0.Name = "node-group"
+			ng0.Provisioner = "ocean"
+		})
+
+		It("should accept nodegroups provisioned by a plugin", func() {
+			Expect(api.ValidateNodeGroup(0, ng0)).To(Succeed())
+		})
+
+		DescribeTable("invalid nodegroups provisioned by a plugin", func(update func(*api.NodeGroup), expectedErr string) {
+			update(ng0)
+			Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("invalid name", func(ng *api.NodeGroup) {
+				ng.Provisioner = "../ocean"
+			}, `invalid nodeGroups[0].provisioner "../ocean"`),
+			Entry("instances distribution", func(ng *api.NodeGroup) {
+				ng.InstanceType = "mixed"
+				ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{InstanceTypes: []string{"t3.large", "t3a.large"}}
+			}, "nodeGroups[0].instancesDistribution cannot be set with nodeGroups[0].provisioner"),
+			Entry("target groups", func(ng *api.NodeGroup) {
+				ng.TargetGroupARNs = []string{"arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/tg/1234"}
+			}, "nodeGroups[0].targetGroupARNs cannot be set with nodeGroups[0].provisioner"),
+			Entry("lifecycle hooks", func(ng *api.NodeGroup) {
+				ng.LifecycleHooks = []api.LifecycleHook{{Name: "drain", Transition: api.LifecycleTransitionTerminate}}
+			}, "nodeGroups[0].lifecycleHooks cannot be set with nodeGroups[0].provisioner"),
+		)
+	})
+
+	Describe("nodeGroups[*].ipv6Only validation", func() {
+		var ng0 *api.NodeGroup
+
+		BeforeEach(func() {
+			ng0 = api.NewClusterConfig().NewNodeGroup()
+			ng0.Name = "node-group"
+			ng0.AMIFamily = api.NodeImageFamilyAmazonLinux2
+			ng0.IPv6Only = api.Enabled()
+			ng0.Subnets = []string{"subnet-ipv6-only"}
+		})
+
+		It("should accept AmazonLinux2 nodegroups in IPv6-only subnets", func() {
+			Expect(api.ValidateNodeGroup(0, ng0)).To(Succeed())
+		})
+
+		DescribeTable("invalid nodegroups in IPv6-only subnets", func(update func(*api.NodeGroup), expectedErr string) {
+			update(ng0)
+			Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("unsupported AMI family", func(ng *api.NodeGroup) {
+				ng.AMIFamily = api.NodeImageFamilyUbuntu2004
+			}, `nodeGroups[0].ipv6Only is only supported with amiFamily "AmazonLinux2"`),
+			Entry("without subnets", func(ng *api.NodeGroup) {
+				ng.Subnets = nil
+			}, "nodeGroups[0].subnets must be set to the IPv6-only subnets of the nodegroup"),
+			Entry("without subnets or private networking", func(ng *api.NodeGroup) {
+				ng.Subnets = nil
+				ng.PrivateNetworking = false
+			}, "nodeGroups[0].subnets must be set to the IPv6-only subnets of the nodegroup, or nodeGroups[0].privateNetworking enabled"),
+			Entry("with a bootstrap command", func(ng *api.NodeGroup) {
+				ng.OverrideBootstrapCommand = aws.String("/etc/eks/bootstrap.sh cluster")
+			}, "nodeGroups[0].overrideBootstrapCommand cannot be set when nodeGroups[0].ipv6Only is enabled"),
+		)
+
+		It("should reject private nodegroups without subnets outside an IPv6-native VPC", func() {
+			cfg := api.NewClusterConfig()
+			ng := cfg.NewNodeGroup()
+			ng.Name = "node-group"
+			ng.IPv6Only = api.Enabled()
+			ng.PrivateNetworking = true
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("nodeGroups[0].subnets must be set to the IPv6-only subnets of the nodegroup when nodeGroups[0].ipv6Only is enabled, unless vpc.ipFamily is IPv6")))
+		})
+
+		It("should reject a service IPv6 CIDR set in the config", func() {
+			cfg := api.NewClusterConfig()
+			cfg.KubernetesNetworkConfig.ServiceIPv6CIDR = "fd30:1c53:5f8a::/108"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("kubernetesNetworkConfig.serviceIPv6CIDR is assigned by EKS and cannot be set"))
+		})
+	})
+
+	Describe("cloudFormation validation", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.CloudFormation = &api.CloudFormationOverrides{
+				ExtraResources: map[string]api.InlineDocument{
+					"AuditTopic": {"Type": "AWS::SNS::Topic"},
+				},
+				Patches: []api.InlineDocument{
+					{"op": "remove", "path": "/Outputs/ARN"},
+				},
+			}
+		})
+
+		It("should accept extra resources and patches", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should reject logical IDs that are not alphanumeric", func() {
+			cfg.CloudFormation.ExtraResources["audit-topic"] = api.InlineDocument{"Type": "AWS::SNS::Topic"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`cloudFormation.extraResources: logical ID "audit-topic" must be alphanumeric`))
+		})
+
+		It("should reject extra resources without a type", func() {
+			cfg.CloudFormation.ExtraResources["AuditTopic"] = api.InlineDocument{"Properties": map[string]interface{}{}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("cloudFormation.extraResources.AuditTopic.Type must be set"))
+		})
+
+		It("should reject patches without a path", func() {
+			cfg.CloudFormation.Patches = []api.InlineDocument{{"op": "remove"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("cloudFormation.patches: [0].path must be set"))
+		})
+
+		It("should validate the overrides of nodegroups", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.CloudFormation = &api.CloudFormationOverrides{
+				Patches: []api.InlineDocument{{"path": "/Resources/NodeGroup"}},
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].cloudFormation.patches: [0].op must be set"))
+		})
+	})
+
+	Describe("stackSet validation", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.StackSet = &api.StackSet{
+				OrganizationalUnitIDs: []string{"ou-ab12-cdefgh34", "r-ab12"},
+			}
+		})
+
+		It("should accept organizational units and roots", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should require organizational units", func() {
+			cfg.StackSet.OrganizationalUnitIDs = nil
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("stackSet.organizationalUnitIDs must be set and non-empty"))
+		})
+
+		It("should reject IDs that are not of organizational units", func() {
+			cfg.StackSet.OrganizationalUnitIDs = []string{"123456789012"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`stackSet.organizationalUnitIDs: "123456789012" is not the ID of an organizational unit or of a root`))
+		})
+
+		It("should reject a maxConcurrentPercentage of 0", func() {
+			cfg.StackSet.MaxConcurrentPercentage = aws.Int(0)
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("stackSet.maxConcurrentPercentage must be between 1 and 100"))
+		})
+
+		It("should default the name and the operation preferences", func() {
+			cfg.Metadata.Name = "my-cluster"
+			api.SetClusterConfigDefaults(cfg)
+			Expect(cfg.StackSet.Name).To(Equal("eksctl-my-cluster-prerequisites"))
+			Expect(*cfg.StackSet.MaxConcurrentPercentage).To(Equal(25))
+			Expect(*cfg.StackSet.FailureTolerancePercentage).To(Equal(0))
+		})
+	})
+
+	Describe("maintenanceWindow validation", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.MaintenanceWindow = &api.MaintenanceWindow{
+				Schedule:       "cron(0 2 ? * SUN *)",
+				Timezone:       "Europe/Paris",
+				ServiceRoleARN: "arn:aws:iam::123456789012:role/eksctl-upgrade",
+			}
+		})
+
+		It("should accept cron, rate and at expressions", func() {
+			for _, schedule := range []string{"cron(0 2 ? * SUN *)", "rate(7 days)", "at(2022-03-06T02:00:00)"} {
+				cfg.MaintenanceWindow.Schedule = schedule
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			}
+		})
+
+		It("should reject other schedules", func() {
+			cfg.MaintenanceWindow.Schedule = "0 2 * * 0"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`maintenanceWindow.schedule "0 2 * * 0" must be a cron(), rate() or at() expression`))
+		})
+
+		It("should reject unknown timezones", func() {
+			cfg.MaintenanceWindow.Timezone = "Mars/Olympus"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`maintenanceWindow.timezone "Mars/Olympus" is not a valid IANA timezone`))
+		})
+
+		It("should reject durations longer than a day", func() {
+			cfg.MaintenanceWindow.Duration = aws.Int(25)
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("maintenanceWindow.duration must be between 1 and 24 hours, got 25"))
+		})
+
+		It("should require the service role", func() {
+			cfg.MaintenanceWindow.ServiceRoleARN = ""
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("maintenanceWindow.serviceRoleARN must be set"))
+		})
+	})
+
+	Describe("upgradePolicy validation", func() {
+		It("should accept the standard and extended support types", func() {
+			cfg := api.NewClusterConfig()
+			for _, supportType := range []string{api.SupportTypeStandard, api.SupportTypeExtended} {
+				cfg.UpgradePolicy = &api.UpgradePolicy{SupportType: supportType}
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			}
+		})
+
+		It("should reject other support types", func() {
+			cfg := api.NewClusterConfig()
+			cfg.UpgradePolicy = &api.UpgradePolicy{SupportType: "standard"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid value "standard" for upgradePolicy.supportType, must be one of STANDARD, EXTENDED`))
+			cfg.UpgradePolicy.SupportType = ""
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("upgradePolicy.supportType must be set"))
+		})
+	})
+
+	Describe("nodeGroups[*].accountRoleARN validation", func() {
+		var ng0 *api.NodeGroup
+
+		BeforeEach(func() {
+			ng0 = api.NewClusterConfig().NewNodeGroup()
+			ng0.Name = "node-group"
+			ng0.AccountRoleARN = "arn:aws:iam::222222222222:role/eksctl-nodegroups"
+			ng0.SecurityGroups.WithShared = nil
+		})
+
+		It("should accept a role ARN", func() {
+			Expect(api.ValidateNodeGroup(0, ng0)).To(Succeed())
+		})
+
+		It("should reject an invalid ARN", func() {
+			ng0.AccountRoleARN = "eksctl-nodegroups"
+			Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError(ContainSubstring(`invalid ARN "eksctl-nodegroups" in nodeGroups[0].accountRoleARN`)))
+		})
+
+		It("should reject the shared security group", func() {
+			ng0.SecurityGroups.WithShared = api.Enabled()
+			Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError(ContainSubstring("nodeGroups[0].securityGroups.withShared cannot be enabled with accountRoleARN")))
+		})
+
+		It("should disable the shared security group by default", func() {
+			api.SetNodeGroupDefaults(ng0, &api.ClusterMeta{})
+			Expect(api.IsDisabled(ng0.SecurityGroups.WithShared)).To(BeTrue())
+		})
+	})
+
 	Describe("nodeGroups[*].volumeX", func() {
 		var (
 			cfg *api.ClusterConfig
@@ -851,6 +1194,85 @@ var _ = Describe("ClusterConfig validation", func() {
 					})
 				})
 
+				When("the coredns or kube-proxy version is configured", func() {
+					BeforeEach(func() {
+						cfg.IAM = &api.ClusterIAM{
+							WithOIDC: api.Enabled(),
+						}
+						cfg.VPC.NAT = nil
+					})
+
+					It("returns an error when the version is too low", func() {
+						cfg.Addons = append(cfg.Addons,
+							&api.Addon{Name: api.KubeProxyAddon, Version: "v1.21.2-eksbuild.2"},
+							&api.Addon{Name: api.CoreDNSAddon, Version: "v1.8.3-eksbuild.1"},
+							&api.Addon{Name: api.VPCCNIAddon},
+						)
+						err = api.ValidateClusterConfig(cfg)
+						Expect(err).To(MatchError("coredns version must be at least version 1.8.4-eksbuild.1 for IPv6"))
+					})
+
+					It("does not error when the version is supported", func() {
+						cfg.Addons = append(cfg.Addons,
+							&api.Addon{Name: api.KubeProxyAddon, Version: "v1.22.6-eksbuild.1"},
+							&api.Addon{Name: api.CoreDNSAddon, Version: "v1.8.4-eksbuild.1"},
+							&api.Addon{Name: api.VPCCNIAddon},
+						)
+						Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+					})
+				})
+
+				When("the vpc-cni policies are configured", func() {
+					BeforeEach(func() {
+						cfg.IAM = &api.ClusterIAM{
+							WithOIDC: api.Enabled(),
+						}
+						cfg.VPC.NAT = nil
+						cfg.Addons = append(cfg.Addons,
+							&api.Addon{Name: api.KubeProxyAddon},
+							&api.Addon{Name: api.CoreDNSAddon},
+						)
+					})
+
+					It("returns an error when only AmazonEKS_CNI_Policy is attached", func() {
+						cfg.Addons = append(cfg.Addons, &api.Addon{
+							Name:             api.VPCCNIAddon,
+							AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"},
+						})
+						err = api.ValidateClusterConfig(cfg)
+						Expect(err).To(MatchError(ContainSubstring("AmazonEKS_CNI_Policy does not allow ec2:AssignIpv6Addresses required for IPv6")))
+					})
+
+					It("returns an error when the inline policy does not allow ec2:AssignIpv6Addresses", func() {
+						cfg.Addons = append(cfg.Addons, &api.Addon{
+							Name: api.VPCCNIAddon,
+							AttachPolicy: api.InlineDocument{
+								"Statement": []interface{}{
+									map[string]interface{}{
+										"Effect": "Allow",
+										"Action": []interface{}{"ec2:DescribeInstances"},
+									},
+								},
+							},
+						})
+						err = api.ValidateClusterConfig(cfg)
+						Expect(err).To(MatchError("vpc-cni attachPolicy must allow ec2:AssignIpv6Addresses for IPv6"))
+					})
+
+					It("does not error when the inline policy allows ec2:AssignIpv6Addresses", func() {
+						cfg.Addons = append(cfg.Addons, &api.Addon{
+							Name: api.VPCCNIAddon,
+							AttachPolicy: api.InlineDocument{
+								"Statement": map[string]interface{}{
+									"Effect": "Allow",
+									"Action": "ec2:AssignIpv6Addresses",
+								},
+							},
+						})
+						Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+					})
+				})
+
 				When("iam is not set", func() {
 					It("returns an error", func() {
 						cfg.Addons = append(cfg.Addons,
@@ -933,6 +1355,152 @@ var _ = Describe("ClusterConfig validation", func() {
 			})
 		})
 
+		Context("vpc.ipFamily", func() {
+			BeforeEach(func() {
+				cfg.VPC.IPFamily = api.IPV6Family
+				cfg.KubernetesNetworkConfig.IPFamily = api.IPV6Family
+				cfg.VPC.NAT = nil
+			})
+
+			It("accepts an IPv6-native VPC created by eksctl", func() {
+				Expect(cfg.ValidateVPCConfig()).To(Succeed())
+			})
+
+			DescribeTable("invalid IPv6-native VPCs", func(update func(*api.ClusterConfig), expectedErr string) {
+				update(cfg)
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring(expectedErr)))
+			},
+				Entry("unknown IP family", func(c *api.ClusterConfig) {
+					c.VPC.IPFamily = "dual"
+				}, `invalid value "dual" for vpc.ipFamily, must be one of IPv4, IPv6`),
+				Entry("IPv4 cluster", func(c *api.ClusterConfig) {
+					c.KubernetesNetworkConfig.IPFamily = api.IPV4Family
+				}, "vpc.ipFamily IPv6 requires kubernetesNetworkConfig.ipFamily to be IPv6"),
+				Entry("existing VPC", func(c *api.ClusterConfig) {
+					c.VPC.ID = "vpc-1234"
+				}, "vpc.ipFamily IPv6 is only supported when eksctl creates the VPC"),
+				Entry("fully-private cluster", func(c *api.ClusterConfig) {
+					c.PrivateCluster = &api.PrivateCluster{Enabled: true}
+				}, "vpc.ipFamily IPv6 is not supported with fully-private clusters"),
+				Entry("managed nodegroup with private networking", func(c *api.ClusterConfig) {
+					mng := api.NewManagedNodeGroup()
+					mng.PrivateNetworking = true
+					c.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+				}, "managedNodeGroups[0].privateNetworking is not supported with vpc.ipFamily IPv6"),
+			)
+
+			It("enables ipv6Only for the private nodegroups", func() {
+				ng := cfg.NewNodeGroup()
+				ng.PrivateNetworking = true
+				ubuntuNg := cfg.NewNodeGroup()
+				ubuntuNg.PrivateNetworking = true
+				ubuntuNg.AMIFamily = api.NodeImageFamilyUbuntu2004
+				publicNg := cfg.NewNodeGroup()
+				api.SetClusterConfigDefaults(cfg)
+				Expect(api.IsEnabled(ng.IPv6Only)).To(BeTrue())
+				Expect(ubuntuNg.IPv6Only).To(BeNil())
+				Expect(publicNg.IPv6Only).To(BeNil())
+				Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+
+			It("rejects private nodegroups that are not IPv6-only", func() {
+				ng := cfg.NewNodeGroup()
+				ng.Name = "node-group"
+				ng.PrivateNetworking = true
+				ng.IPv6Only = api.Disabled()
+				cfg.Metadata.Version = api.Version1_22
+				cfg.IAM = &api.ClusterIAM{
+					WithOIDC: api.Enabled(),
+				}
+				cfg.Addons = append(cfg.Addons,
+					&api.Addon{Name: api.KubeProxyAddon},
+					&api.Addon{Name: api.CoreDNSAddon},
+					&api.Addon{Name: api.VPCCNIAddon},
+				)
+				Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("nodeGroups[0].ipv6Only must be enabled for nodegroups in the IPv6-only private subnets of vpc.ipFamily IPv6")))
+			})
+		})
+
+		Context("vpc.secondaryCIDRs", func() {
+			BeforeEach(func() {
+				cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/16", "100.65.0.0/16"}
+			})
+
+			It("accepts secondary CIDRs of the VPC created by eksctl", func() {
+				Expect(cfg.ValidateVPCConfig()).To(Succeed())
+			})
+
+			It("accepts subnets in the secondary CIDRs", func() {
+				cfg.VPC.Subnets = &api.ClusterSubnets{
+					Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+						"us-west-2a": {CIDR: ipnet.MustParseCIDR("100.64.0.0/17")},
+						"us-west-2b": {CIDR: ipnet.MustParseCIDR("100.64.128.0/17")},
+					}),
+					Public: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+						"us-west-2a": {CIDR: ipnet.MustParseCIDR("192.168.0.0/24")},
+						"us-west-2b": {CIDR: ipnet.MustParseCIDR("192.168.1.0/24")},
+					}),
+				}
+				Expect(cfg.ValidateVPCConfig()).To(Succeed())
+			})
+
+			DescribeTable("invalid secondary CIDRs", func(update func(*api.ClusterConfig), expectedErr string) {
+				update(cfg)
+				Expect(cfg.ValidateVPCConfig()).To(MatchError(ContainSubstring(expectedErr)))
+			},
+				Entry("existing VPC", func(c *api.ClusterConfig) {
+					c.VPC.ID = "vpc-1234"
+				}, "vpc.secondaryCIDRs is only supported when eksctl creates the VPC"),
+				Entry("IPv6 cluster", func(c *api.ClusterConfig) {
+					c.KubernetesNetworkConfig.IPFamily = api.IPV6Family
+					c.VPC.NAT = nil
+				}, "vpc.secondaryCIDRs is not supported with IPv6"),
+				Entry("invalid CIDR", func(c *api.ClusterConfig) {
+					c.VPC.SecondaryCIDRs = []string{"not-a-cidr"}
+				}, "invalid CIDR address: not-a-cidr"),
+				Entry("IPv6 CIDR", func(c *api.ClusterConfig) {
+					c.VPC.SecondaryCIDRs = []string{"2002::/56"}
+				}, `invalid vpc.secondaryCIDRs "2002::/56", must be an IPv4 CIDR`),
+				Entry("prefix too large", func(c *api.ClusterConfig) {
+					c.VPC.SecondaryCIDRs = []string{"100.64.0.0/28"}
+				}, `invalid vpc.secondaryCIDRs "100.64.0.0/28", prefix must be between /16 and /24`),
+				Entry("overlapping with the VPC CIDR", func(c *api.ClusterConfig) {
+					c.VPC.SecondaryCIDRs = []string{"192.168.128.0/17"}
+				}, `vpc.secondaryCIDRs "192.168.128.0/17" overlaps with "192.168.0.0/16"`),
+				Entry("overlapping with each other", func(c *api.ClusterConfig) {
+					c.VPC.SecondaryCIDRs = []string{"100.64.0.0/16", "100.64.0.0/24"}
+				}, `vpc.secondaryCIDRs "100.64.0.0/24" overlaps with "100.64.0.0/16"`),
+				Entry("existing subnets", func(c *api.ClusterConfig) {
+					c.VPC.Subnets = &api.ClusterSubnets{
+						Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+							"us-west-2a": {ID: "subnet-1"},
+						}),
+					}
+				}, "vpc.subnets.private.us-west-2a.id cannot be set with vpc.secondaryCIDRs, as eksctl creates the subnets"),
+				Entry("subnets outside of the VPC CIDRs", func(c *api.ClusterConfig) {
+					c.VPC.Subnets = &api.ClusterSubnets{
+						Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+							"us-west-2a": {CIDR: ipnet.MustParseCIDR("100.66.0.0/17")},
+						}),
+					}
+				}, `vpc.subnets.private.us-west-2a.cidr "100.66.0.0/17" must be within vpc.cidr or vpc.secondaryCIDRs`),
+				Entry("subnets larger than the VPC CIDRs", func(c *api.ClusterConfig) {
+					c.VPC.Subnets = &api.ClusterSubnets{
+						Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+							"us-west-2a": {CIDR: ipnet.MustParseCIDR("100.64.0.0/15")},
+						}),
+					}
+				}, `vpc.subnets.private.us-west-2a.cidr "100.64.0.0/15" must be within vpc.cidr or vpc.secondaryCIDRs`),
+				Entry("private subnets without public subnets", func(c *api.ClusterConfig) {
+					c.VPC.Subnets = &api.ClusterSubnets{
+						Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+							"us-west-2a": {CIDR: ipnet.MustParseCIDR("100.64.0.0/17")},
+						}),
+					}
+				}, `vpc.subnets.public must have a subnet in "us-west-2a", like vpc.subnets.private, with vpc.secondaryCIDRs`),
+			)
+		})
+
 		Context("extraCIDRs", func() {
 			It("validates cidrs", func() {
 				cfg.VPC.ExtraCIDRs = []string{"192.168.0.0/24"}
@@ -1118,6 +1686,89 @@ var _ = Describe("ClusterConfig validation", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+		When("endpoint policies are defined", func() {
+			policy := api.InlineDocument{"Statement": []interface{}{}}
+
+			It("validates the policies of required and additional endpoints", func() {
+				cfg.PrivateCluster.AdditionalEndpointServices = []string{api.EndpointServiceCloudFormation}
+				cfg.PrivateCluster.EndpointPolicies = map[string]api.InlineDocument{
+					api.EndpointServiceS3:             policy,
+					api.EndpointServiceCloudFormation: policy,
+				}
+				Expect(cfg.ValidatePrivateCluster()).To(Succeed())
+			})
+			It("fails the validation for an endpoint that is not created", func() {
+				cfg.PrivateCluster.EndpointPolicies = map[string]api.InlineDocument{api.EndpointServiceAutoscaling: policy}
+				err := cfg.ValidatePrivateCluster()
+				Expect(err).To(MatchError(`invalid value in privateCluster.endpointPolicies: no VPC endpoint is created for endpoint service "autoscaling"`))
+			})
+			It("fails the validation for a policy without statement", func() {
+				cfg.PrivateCluster.EndpointPolicies = map[string]api.InlineDocument{api.EndpointServiceS3: {"Version": "2012-10-17"}}
+				err := cfg.ValidatePrivateCluster()
+				Expect(err).To(MatchError(ContainSubstring(`policy of endpoint service "s3" must have a Statement`)))
+			})
+			It("fails the validation with skip endpoints", func() {
+				cfg.PrivateCluster.EndpointPolicies = map[string]api.InlineDocument{api.EndpointServiceS3: policy}
+				cfg.PrivateCluster.SkipEndpointCreation = true
+				err := cfg.ValidatePrivateCluster()
+				Expect(err).To(MatchError(ContainSubstring("privateCluster.endpointPolicies cannot be set when privateCluster.skipEndpointCreation is true")))
+			})
+		})
+		When("custom endpoints are defined", func() {
+			It("validates the endpoint configuration", func() {
+				cfg.PrivateCluster.CustomEndpointServices = []string{"kms", "elasticfilesystem"}
+				cfg.PrivateCluster.EndpointPolicies = map[string]api.InlineDocument{"kms": {"Statement": []interface{}{}}}
+				Expect(cfg.ValidatePrivateCluster()).To(Succeed())
+			})
+			It("fails the validation with skip endpoints", func() {
+				cfg.PrivateCluster.CustomEndpointServices = []string{"kms"}
+				cfg.PrivateCluster.SkipEndpointCreation = true
+				err := cfg.ValidatePrivateCluster()
+				Expect(err).To(MatchError(ContainSubstring("privateCluster.customEndpointServices cannot be set when privateCluster.skipEndpointCreation is true")))
+			})
+			DescribeTable("invalid custom endpoints", func(services []string, expectedErr string) {
+				cfg.PrivateCluster.CustomEndpointServices = services
+				err := cfg.ValidatePrivateCluster()
+				Expect(err).To(MatchError(ContainSubstring("invalid value in privateCluster.customEndpointServices")))
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			},
+				Entry("invalid name", []string{"KMS!"}, `invalid endpoint service name "KMS!"`),
+				Entry("additional endpoint service", []string{api.EndpointServiceCloudFormation}, `endpoint service "cloudformation" must be set in privateCluster.additionalEndpointServices`),
+				Entry("required endpoint service", []string{api.EndpointServiceS3}, `endpoint service "s3" is always created`),
+				Entry("duplicate", []string{"kms", "kms"}, `found duplicate endpoint service: "kms"`),
+			)
+		})
+		When("existing endpoints are defined", func() {
+			BeforeEach(func() {
+				cfg.VPC.ID = "vpc-1234"
+				cfg.VPC.Subnets = &api.ClusterSubnets{
+					Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+						"us-west-2a": {ID: "subnet-1234"},
+					}),
+				}
+			})
+			It("validates the endpoint configuration", func() {
+				cfg.PrivateCluster.ExistingEndpointIDs = []string{"vpce-0123456789abcdef0"}
+				Expect(cfg.ValidatePrivateCluster()).To(Succeed())
+			})
+			It("fails the validation without a pre-existing VPC", func() {
+				cfg.VPC.ID = ""
+				cfg.PrivateCluster.ExistingEndpointIDs = []string{"vpce-0123456789abcdef0"}
+				err := cfg.ValidatePrivateCluster()
+				Expect(err).To(MatchError(ContainSubstring("privateCluster.existingEndpointIDs can only be set when a pre-existing VPC is supplied")))
+			})
+			It("fails the validation with skip endpoints", func() {
+				cfg.PrivateCluster.ExistingEndpointIDs = []string{"vpce-0123456789abcdef0"}
+				cfg.PrivateCluster.SkipEndpointCreation = true
+				err := cfg.ValidatePrivateCluster()
+				Expect(err).To(MatchError(ContainSubstring("privateCluster.existingEndpointIDs cannot be set when privateCluster.skipEndpointCreation is true")))
+			})
+			It("fails the validation for an invalid endpoint ID", func() {
+				cfg.PrivateCluster.ExistingEndpointIDs = []string{"endpoint-1"}
+				err := cfg.ValidatePrivateCluster()
+				Expect(err).To(MatchError(`invalid value in privateCluster.existingEndpointIDs: invalid VPC endpoint ID "endpoint-1"`))
+			})
+		})
 	})
 
 	Describe("cpuCredits", func() {
@@ -1158,6 +1809,51 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	type cpuOptionsEntry struct {
+		cpuOptions            *api.CPUOptions
+		instancesDistribution *api.NodeGroupInstancesDistribution
+		expectedErr           string
+	}
+
+	DescribeTable("cpuOptions", func(e cpuOptionsEntry) {
+		ng := api.NewNodeGroup()
+		ng.InstanceType = "c5.xlarge"
+		ng.CPUOptions = e.cpuOptions
+		ng.InstancesDistribution = e.instancesDistribution
+		err := api.ValidateNodeGroup(0, ng)
+		if e.expectedErr == "" {
+			Expect(err).NotTo(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(e.expectedErr)))
+		}
+	},
+		Entry("threads per core only", cpuOptionsEntry{
+			cpuOptions: &api.CPUOptions{ThreadsPerCore: aws.Int(1)},
+		}),
+		Entry("core count and threads per core", cpuOptionsEntry{
+			cpuOptions: &api.CPUOptions{CoreCount: aws.Int(2), ThreadsPerCore: aws.Int(2)},
+		}),
+		Entry("no options", cpuOptionsEntry{
+			cpuOptions:  &api.CPUOptions{},
+			expectedErr: "at least one of nodeGroups[0].cpuOptions.coreCount or nodeGroups[0].cpuOptions.threadsPerCore must be set",
+		}),
+		Entry("invalid threads per core", cpuOptionsEntry{
+			cpuOptions:  &api.CPUOptions{ThreadsPerCore: aws.Int(4)},
+			expectedErr: "nodeGroups[0].cpuOptions.threadsPerCore must be either 1 or 2",
+		}),
+		Entry("invalid core count", cpuOptionsEntry{
+			cpuOptions:  &api.CPUOptions{CoreCount: aws.Int(0)},
+			expectedErr: "nodeGroups[0].cpuOptions.coreCount must be at least 1",
+		}),
+		Entry("multiple instance types", cpuOptionsEntry{
+			cpuOptions: &api.CPUOptions{ThreadsPerCore: aws.Int(1)},
+			instancesDistribution: &api.NodeGroupInstancesDistribution{
+				InstanceTypes: []string{"c5.xlarge", "c5a.xlarge"},
+			},
+			expectedErr: "nodeGroups[0].cpuOptions can only be set for nodegroups with a single instance type",
+		}),
+	)
+
 	Describe("ssh flags", func() {
 		var (
 			testKeyPath = "some/path/to/file.pub"
@@ -1644,6 +2340,411 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("Bootstrap", func() {
+		It("returns an error when a manifest is empty", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Bootstrap = &api.Bootstrap{
+				Manifests:          []string{"namespaces.yaml"},
+				PreDeleteManifests: []string{""},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("bootstrap.preDeleteManifests[0] must be set"))
+		})
+	})
+
+	Describe("Hooks", func() {
+		It("returns an error when a command is empty", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Hooks = &api.Hooks{
+				PostCreate: []string{"./register.sh", " "},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("hooks.postCreate[1] must be set"))
+		})
+	})
+
+	Describe("PullThroughCache", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		It("accepts rules with default prefixes", func() {
+			cfg.PullThroughCache = &api.PullThroughCache{
+				Rules: []api.PullThroughCacheRule{
+					{UpstreamRegistryURL: "quay.io"},
+					{UpstreamRegistryURL: "registry.k8s.io"},
+				},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("returns an error when there are no rules", func() {
+			cfg.PullThroughCache = &api.PullThroughCache{}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("pullThroughCache.rules must be set and non-empty"))
+		})
+
+		It("returns an error when the upstream registry is not a host name", func() {
+			cfg.PullThroughCache = &api.PullThroughCache{
+				Rules: []api.PullThroughCacheRule{{UpstreamRegistryURL: "https://quay.io"}},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("must be a registry host name")))
+		})
+
+		It("returns an error when a prefix is too long", func() {
+			cfg.PullThroughCache = &api.PullThroughCache{
+				Rules: []api.PullThroughCacheRule{{UpstreamRegistryURL: "registry.gitlab.example.com"}},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("must be between 2 and 20 characters long")))
+		})
+
+		It("returns an error when prefixes are not unique", func() {
+			cfg.PullThroughCache = &api.PullThroughCache{
+				Rules: []api.PullThroughCacheRule{
+					{UpstreamRegistryURL: "quay.io"},
+					{UpstreamRegistryURL: "ghcr.io", ECRRepositoryPrefix: "quay"},
+				},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`pullThroughCache.rules[1].ecrRepositoryPrefix "quay" is not unique`))
+		})
+
+		Context("nodegroups", func() {
+			BeforeEach(func() {
+				cfg.PullThroughCache = &api.PullThroughCache{
+					Rules: []api.PullThroughCacheRule{{UpstreamRegistryURL: "quay.io"}},
+				}
+			})
+
+			It("accepts AmazonLinux2 nodegroups using containerd", func() {
+				ng := cfg.NewNodeGroup()
+				ng.Name = "ng"
+				ng.ContainerRuntime = aws.String(api.ContainerRuntimeContainerD)
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			})
+
+			It("rejects nodegroups using dockerd", func() {
+				ng := cfg.NewNodeGroup()
+				ng.Name = "ng"
+				ng.ContainerRuntime = aws.String(api.ContainerRuntimeDockerD)
+				Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`nodegroup "ng" does not set containerRuntime: containerd`)))
+			})
+
+			It("rejects nodegroups of other AMI families", func() {
+				ng := cfg.NewNodeGroup()
+				ng.Name = "ng"
+				ng.AMIFamily = api.NodeImageFamilyBottlerocket
+				Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`nodegroup "ng" uses the Bottlerocket AMI family`)))
+			})
+
+			It("rejects managed nodegroups", func() {
+				cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{{NodeGroupBase: &api.NodeGroupBase{Name: "mng"}}}
+				Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`nodegroup "mng" is a managed nodegroup`)))
+			})
+		})
+	})
+
+	Describe("Alarms", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		It("accepts alarms notifying a new topic", func() {
+			cfg.Alarms = &api.ClusterAlarms{
+				Emails:              []string{"ops@example.com"},
+				NodeCount:           &api.NodeCountAlarm{},
+				NATGatewayBandwidth: &api.NATGatewayBandwidthAlarm{BytesPerMinute: 1 << 30},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("returns an error when no alarm is set", func() {
+			cfg.Alarms = &api.ClusterAlarms{Emails: []string{"ops@example.com"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("at least one of alarms.nodeCount")))
+		})
+
+		It("returns an error when emails are set with an existing topic", func() {
+			cfg.Alarms = &api.ClusterAlarms{
+				SNSTopicARN: "arn:aws:sns:us-west-2:123456789012:ops",
+				Emails:      []string{"ops@example.com"},
+				NodeCount:   &api.NodeCountAlarm{},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("alarms.emails cannot be set with alarms.snsTopicARN")))
+		})
+
+		It("returns an error when the NAT gateway threshold is not set", func() {
+			cfg.Alarms = &api.ClusterAlarms{NATGatewayBandwidth: &api.NATGatewayBandwidthAlarm{}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("alarms.natGatewayBandwidth.bytesPerMinute must be set to a positive value"))
+		})
+
+		It("returns an error when the API error rate alarm is set without audit logs", func() {
+			cfg.Alarms = &api.ClusterAlarms{APIErrorRate: &api.APIErrorRateAlarm{}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`alarms.apiErrorRate requires "audit" to be in cloudWatch.clusterLogging.enableTypes`))
+
+			cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"api", "audit"}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("returns an error when the API error rate threshold is out of range", func() {
+			cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"*"}
+			threshold := 120
+			cfg.Alarms = &api.ClusterAlarms{APIErrorRate: &api.APIErrorRateAlarm{ThresholdPercent: &threshold}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("alarms.apiErrorRate.thresholdPercent must be between 1 and 100"))
+		})
+	})
+
+	Describe("Notifications", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		DescribeTable("event bus", func(eventBusName, expectedErr string) {
+			cfg.Notifications = &api.Notifications{EventBusName: eventBusName}
+			err := api.ValidateClusterConfig(cfg)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+			Entry("name", "default", ""),
+			Entry("name of a partner event bus", "aws.partner/example.com/123/events", ""),
+			Entry("ARN", "arn:aws:events:us-west-2:123456789012:event-bus/platform", ""),
+			Entry("invalid name", "platform bus", `notifications.eventBusName "platform bus" is not a valid event bus name`),
+			Entry("invalid ARN", "arn:aws:events", `notifications.eventBusName "arn:aws:events" is not a valid ARN`),
+		)
+
+		DescribeTable("webhook", func(webhookURL, expectedErr string) {
+			cfg.Notifications = &api.Notifications{WebhookURL: webhookURL}
+			err := api.ValidateClusterConfig(cfg)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+			Entry("https URL", "https://hooks.slack.com/services/T000/B000/XXXX", ""),
+			Entry("http URL", "http://notifier.internal:8080/eksctl", ""),
+			Entry("not a URL", "hooks.slack.com/services", `notifications.webhookURL "hooks.slack.com/services" is not a valid http(s) URL`),
+			Entry("unsupported scheme", "ftp://example.com", `notifications.webhookURL "ftp://example.com" is not a valid http(s) URL`),
+		)
+
+		It("requires an event bus or a webhook", func() {
+			cfg.Notifications = &api.Notifications{}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("at least one of notifications.eventBusName and notifications.webhookURL must be set"))
+		})
+	})
+
+	Describe("APIServerDNS", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		It("accepts a name with a parent domain", func() {
+			cfg.VPC.APIServerDNS = &api.APIServerDNS{Name: "api.mycluster.internal"}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.VPC.APIServerDNS.HostedZoneName()).To(Equal("mycluster.internal"))
+		})
+
+		It("returns an error for an invalid name", func() {
+			cfg.VPC.APIServerDNS = &api.APIServerDNS{Name: "API_server.internal"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`vpc.apiServerDNS.name "API_server.internal" is invalid`)))
+		})
+
+		It("returns an error when a hosted zone cannot be created for the name", func() {
+			cfg.VPC.APIServerDNS = &api.APIServerDNS{Name: "api"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`vpc.apiServerDNS.name "api" must have a parent domain to create a hosted zone for`))
+
+			cfg.VPC.APIServerDNS.HostedZoneID = "Z0123456789"
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+	})
+
+	Describe("DNSResolver", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		DescribeTable("validates the query logging destination and the resolver rules", func(resolver *api.DNSResolver, expectedErr string) {
+			cfg.VPC.DNSResolver = resolver
+			err := api.ValidateClusterConfig(cfg)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("log group and rules", &api.DNSResolver{
+				QueryLogDestinationARN: "arn:aws:logs:us-west-2:123456789012:log-group:dns-queries",
+				RuleIDs:                []string{"rslvr-rr-0123456789abcdef0"},
+			}, ""),
+			Entry("S3 bucket", &api.DNSResolver{QueryLogDestinationARN: "arn:aws:s3:::dns-queries"}, ""),
+			Entry("nothing set", &api.DNSResolver{}, "at least one of vpc.dnsResolver.queryLogDestinationARN and vpc.dnsResolver.ruleIDs must be set"),
+			Entry("invalid ARN", &api.DNSResolver{QueryLogDestinationARN: "dns-queries"}, `vpc.dnsResolver.queryLogDestinationARN "dns-queries" is invalid`),
+			Entry("unsupported destination", &api.DNSResolver{QueryLogDestinationARN: "arn:aws:sns:us-west-2:123456789012:dns-queries"}, "must be the ARN of a CloudWatch Logs log group, S3 bucket or Kinesis Data Firehose delivery stream"),
+			Entry("invalid rule ID", &api.DNSResolver{RuleIDs: []string{"rslvr-fw-rg-1"}}, `vpc.dnsResolver.ruleIDs: "rslvr-fw-rg-1" is not the ID of a resolver rule`),
+			Entry("duplicate rule ID", &api.DNSResolver{RuleIDs: []string{"rslvr-rr-1", "rslvr-rr-1"}}, `vpc.dnsResolver.ruleIDs: found duplicate rule "rslvr-rr-1"`),
+		)
+	})
+
+	Describe("ClusterTags", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "cluster"
+		})
+
+		DescribeTable("validates the tag values", func(clusterTags *api.ClusterTags, expectedErr string) {
+			cfg.VPC.ClusterTags = clusterTags
+			err := api.ValidateClusterConfig(cfg)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("defaults", &api.ClusterTags{}, ""),
+			Entry("shared subnets and untagged SGs", &api.ClusterTags{Subnets: api.ClusterTagShared, SecurityGroups: api.ClusterTagNone}, ""),
+			Entry("shared VPC", &api.ClusterTags{SharedVPC: api.Enabled(), Subnets: api.ClusterTagShared}, ""),
+			Entry("invalid value", &api.ClusterTags{Subnets: "yes"}, `invalid value "yes" for vpc.clusterTags.subnets, valid options: owned, shared, none`),
+			Entry("owned in a shared VPC", &api.ClusterTags{SharedVPC: api.Enabled(), SecurityGroups: api.ClusterTagOwned}, `vpc.clusterTags.securityGroups cannot be "owned" when vpc.clusterTags.sharedVPC is enabled`),
+		)
+
+		It("returns the values of the tags", func() {
+			Expect(cfg.ClusterTagKey()).To(Equal("kubernetes.io/cluster/cluster"))
+			Expect(cfg.SubnetClusterTag()).To(BeEmpty())
+			Expect(cfg.SecurityGroupClusterTag()).To(Equal(api.ClusterTagOwned))
+			Expect(cfg.InstanceClusterTag()).To(Equal(api.ClusterTagOwned))
+
+			cfg.VPC.ClusterTags = &api.ClusterTags{SharedVPC: api.Enabled(), Subnets: api.ClusterTagShared}
+			Expect(cfg.SubnetClusterTag()).To(Equal(api.ClusterTagShared))
+			Expect(cfg.SecurityGroupClusterTag()).To(Equal(api.ClusterTagShared))
+			Expect(cfg.InstanceClusterTag()).To(Equal(api.ClusterTagShared))
+		})
+	})
+
+	Describe("ParameterExport", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "cluster-1"
+		})
+
+		It("defaults the path to the cluster name", func() {
+			cfg.ParameterExport = &api.ParameterExport{}
+			api.SetClusterConfigDefaults(cfg)
+			Expect(cfg.ParameterExport.Path).To(Equal("/eksctl/cluster-1"))
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		DescribeTable("validates the path", func(path, expectedErr string) {
+			cfg.ParameterExport = &api.ParameterExport{Path: path}
+			err := api.ValidateClusterConfig(cfg)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("valid path", "/platform/prod/eks", ""),
+			Entry("relative path", "platform/eks", `parameterExport.path "platform/eks" must start with a / and not end with one`),
+			Entry("trailing slash", "/platform/eks/", `must start with a / and not end with one`),
+			Entry("reserved prefix", "/aws/eks", `parameterExport.path "/aws/eks" cannot start with /aws or /ssm`),
+		)
+	})
+
+	Describe("Naming", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "cluster-1"
+			cfg.Metadata.Region = "us-west-2"
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+		})
+
+		It("expands the placeholders in the names", func() {
+			cfg.Naming = &api.Naming{Prefix: "acme-{env}-", Suffix: "-{region}", Env: "prod"}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.ServiceRoleName()).To(Equal("acme-prod-cluster-1-ServiceRole-us-west-2"))
+			Expect(cfg.NodeGroupInstanceRoleName("ng-1")).To(Equal("acme-prod-cluster-1-ng-1-NodeInstanceRole-us-west-2"))
+			Expect(cfg.StackName("eksctl-cluster-1-cluster")).To(Equal("acme-prod-eksctl-cluster-1-cluster-us-west-2"))
+			Expect(cfg.StackNameRegex("^eksctl-cluster-1-(cluster|fargate)$")).To(Equal("^acme-prod-eksctl-cluster-1-(cluster|fargate)-us-west-2$"))
+		})
+
+		It("returns empty role names and unchanged stack names when naming is not set", func() {
+			Expect(cfg.ServiceRoleName()).To(BeEmpty())
+			Expect(cfg.NodeGroupInstanceRoleName("ng-1")).To(BeEmpty())
+			Expect(cfg.StackName("eksctl-cluster-1-cluster")).To(Equal("eksctl-cluster-1-cluster"))
+			Expect(cfg.StackNameRegex("^eksctl-cluster-1-cluster$")).To(Equal("^eksctl-cluster-1-cluster$"))
+		})
+
+		DescribeTable("validates the names", func(naming api.Naming, expectedErr string) {
+			cfg.Naming = &naming
+			err := api.ValidateClusterConfig(cfg)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("valid prefix", api.Naming{Prefix: "team-a-{cluster}-"}, ""),
+			Entry("unknown placeholder", api.Naming{Prefix: "{team}-"}, "naming.prefix contains unknown placeholder {team}"),
+			Entry("env not set", api.Naming{Suffix: "-{env}"}, "naming.env must be set to use {env} in naming.suffix"),
+			Entry("invalid characters", api.Naming{Prefix: "acme/"}, `the name "acme/eksctl-cluster-1-cluster" of the cluster stack must start with a letter and contain only letters, numbers and hyphens`),
+			Entry("stack name too long", api.Naming{Suffix: fmt.Sprintf("-%0110d", 0)}, "stack names cannot be longer than 128 characters"),
+			Entry("role name too long", api.Naming{Prefix: "a-very-long-prefix-that-exceeds-the-limit-"}, "IAM role names cannot be longer than 64 characters"),
+		)
+
+		It("does not validate the names of roles that are not created", func() {
+			cfg.Naming = &api.Naming{Prefix: "a-very-long-prefix-that-exceeds-the-limit-"}
+			cfg.IAM.ServiceRoleARN = aws.String("arn:aws:iam::123456789012:role/service-role")
+			cfg.NodeGroups[0].IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/node-role"
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+	})
+
+	Describe("VPC CloudFormation stack", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.VPC.CloudFormation = &api.VPCCloudFormation{StackName: "network"}
+		})
+
+		It("accepts a stack name", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("requires a stack name", func() {
+			cfg.VPC.CloudFormation.StackName = ""
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpc.cloudFormation.stackName must be set and non-empty"))
+		})
+
+		It("rejects a VPC ID or subnets", func() {
+			cfg.VPC.ID = "vpc-1"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("vpc.id and vpc.subnets cannot be set when vpc.cloudFormation is set")))
+		})
+
+		It("defaults the outputs to those of eksctl stacks", func() {
+			api.SetClusterConfigDefaults(cfg)
+			Expect(*cfg.VPC.CloudFormation.Outputs).To(Equal(api.VPCStackOutputs{
+				VPC:            "VPC",
+				PrivateSubnets: "SubnetsPrivate",
+				PublicSubnets:  "SubnetsPublic",
+			}))
+		})
+	})
+
 	type labelsTaintsEntry struct {
 		labels map[string]string
 		taints []api.NodeGroupTaint
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -26,6 +27,18 @@ const (
 	ClusterNATDefault = ClusterSingleNAT
 )
 
+// Values for `ClusterTags`
+const (
+	// ClusterTagOwned tags resources as used by the cluster only, letting its controllers manage and delete them
+	ClusterTagOwned = "owned"
+
+	// ClusterTagShared tags resources as shared with other clusters
+	ClusterTagShared = "shared"
+
+	// ClusterTagNone does not tag resources
+	ClusterTagNone = "none"
+)
+
 // AZSubnetMapping holds subnet to AZ mappings.
 // If the key is an AZ, that also becomes the name of the subnet
 // otherwise use the key to refer to this subnet.
@@ -142,6 +155,12 @@ type (
 		// private subnets or any ad-hoc subnets
 		// +optional
 		ExtraIPv6CIDRs []string `json:"extraIPv6CIDRs,omitempty"`
+		// SecondaryCIDRs are additional IPv4 CIDR blocks associated with the VPC eksctl creates, e.g.
+		// `100.64.0.0/16`. The private subnets are carved from the first of them, leaving the primary
+		// CIDR to the public subnets, so that clusters with large pod counts don't exhaust the primary CIDR.
+		// See [secondary CIDR blocks](/usage/vpc-configuration/#secondary-cidr-blocks)
+		// +optional
+		SecondaryCIDRs []string `json:"secondaryCIDRs,omitempty"`
 		// for pre-defined shared node SG
 		SharedNodeSecurityGroup string `json:"sharedNodeSecurityGroup,omitempty"`
 		// Automatically add security group rules to and from the default
@@ -155,6 +174,12 @@ type (
 		// AutoAllocateIPV6 requests an IPv6 CIDR block with /56 prefix for the VPC
 		// +optional
 		AutoAllocateIPv6 *bool `json:"autoAllocateIPv6,omitempty"`
+		// IPFamily of the private subnets eksctl creates in the VPC of an IPv6 cluster. With `IPv6`, the
+		// private subnets are IPv6-only, and only the public subnets, used by the control plane and the NAT
+		// gateway, are assigned IPv4 CIDRs. Valid variants are `IPFamily` constants.
+		// See [IPv6-native VPC](/usage/vpc-ip-family/#ipv6-native-vpc)
+		// +optional
+		IPFamily string `json:"ipFamily,omitempty"`
 		// +optional
 		NAT *ClusterNAT `json:"nat,omitempty"`
 		// See [managing access to API](/usage/vpc-networking/#managing-access-to-the-kubernetes-api-server-endpoints)
@@ -164,6 +189,24 @@ type (
 		// k8s API endpoint
 		// +optional
 		PublicAccessCIDRs []string `json:"publicAccessCIDRs,omitempty"`
+		// APIServerDNS is a DNS name resolving to the API server endpoint from the VPC.
+		// See [private DNS name](/usage/vpc-cluster-access/#private-dns-name-for-the-api-server)
+		// +optional
+		APIServerDNS *APIServerDNS `json:"apiServerDNS,omitempty"`
+		// DNSResolver configures the Route 53 Resolver of the VPC.
+		// See [DNS query logging and resolver rules](/usage/vpc-cluster-access/#dns-query-logging-and-resolver-rules)
+		// +optional
+		DNSResolver *DNSResolver `json:"dnsResolver,omitempty"`
+		// ClusterTags configures the `kubernetes.io/cluster/<name>` tags of the subnets and security groups
+		// eksctl creates, used by Kubernetes controllers to discover them.
+		// See [cluster tags](/usage/vpc-subnet-settings/#cluster-tags)
+		// +optional
+		ClusterTags *ClusterTags `json:"clusterTags,omitempty"`
+		// CloudFormation references an existing CloudFormation stack whose outputs hold the IDs
+		// of the VPC and subnets to use, resolved when the cluster is created.
+		// See [using a VPC from a CloudFormation stack](/usage/vpc-configuration/#use-existing-vpc-from-a-cloudformation-stack)
+		// +optional
+		CloudFormation *VPCCloudFormation `json:"cloudFormation,omitempty"`
 	}
 	// ClusterSubnets holds private and public subnets
 	ClusterSubnets struct {
@@ -198,6 +241,75 @@ type (
 		Gateway *string `json:"gateway,omitempty"`
 	}
 
+	// APIServerDNS holds a DNS name, managed with the cluster stack, that is a CNAME of the API server endpoint
+	APIServerDNS struct {
+		// Name is the DNS name, e.g. `api.mycluster.internal`
+		// +required
+		Name string `json:"name"`
+		// HostedZoneID is the ID of an existing private hosted zone, associated with the VPC, to create the
+		// record in. A private hosted zone for the parent domain of `name` is created otherwise
+		// +optional
+		HostedZoneID string `json:"hostedZoneID,omitempty"`
+	}
+
+	// DNSResolver holds the Route 53 Resolver query logging and rules, managed with the cluster stack, of the VPC
+	DNSResolver struct {
+		// QueryLogDestinationARN is the ARN of the CloudWatch Logs log group, S3 bucket or Kinesis Data Firehose
+		// delivery stream to log the DNS queries made from the VPC to
+		// +optional
+		QueryLogDestinationARN string `json:"queryLogDestinationARN,omitempty"`
+		// RuleIDs are the IDs of existing resolver rules to associate with the VPC, e.g. rules forwarding
+		// the queries for on-premises domains to on-premises DNS servers
+		// +optional
+		RuleIDs []string `json:"ruleIDs,omitempty"`
+	}
+
+	// VPCCloudFormation references the outputs of a CloudFormation stack that created a VPC
+	VPCCloudFormation struct {
+		// StackName is the name of the stack
+		// +required
+		StackName string `json:"stackName"`
+		// Outputs holds the names of the stack outputs to read the IDs from
+		// +optional
+		Outputs *VPCStackOutputs `json:"outputs,omitempty"`
+	}
+
+	// VPCStackOutputs holds the names of the outputs of a VPC stack, the defaults are the outputs
+	// of the stacks eksctl creates
+	VPCStackOutputs struct {
+		// VPC is the output holding the VPC ID.
+		// Defaults to `"VPC"`
+		// +optional
+		VPC string `json:"vpc,omitempty"`
+		// PrivateSubnets is the output holding a comma-separated list of private subnet IDs.
+		// Defaults to `"SubnetsPrivate"`
+		// +optional
+		PrivateSubnets string `json:"privateSubnets,omitempty"`
+		// PublicSubnets is the output holding a comma-separated list of public subnet IDs.
+		// Defaults to `"SubnetsPublic"`
+		// +optional
+		PublicSubnets string `json:"publicSubnets,omitempty"`
+	}
+
+	// ClusterTags holds the values of the `kubernetes.io/cluster/<name>` tags of the resources eksctl creates
+	ClusterTags struct {
+		// Subnets is the value of the tag of the subnets created by eksctl.
+		// Defaults to `"none"`
+		// Valid variants are `ClusterTags` constants
+		// +optional
+		Subnets string `json:"subnets,omitempty"`
+		// SecurityGroups is the value of the tag of the security groups eksctl creates for nodegroups.
+		// Defaults to `"owned"`
+		// Valid variants are `ClusterTags` constants
+		// +optional
+		SecurityGroups string `json:"securityGroups,omitempty"`
+		// SharedVPC marks the VPC as shared with other clusters, eksctl then never applies
+		// `owned` tags, which would let the controllers of this cluster modify or delete
+		// resources other clusters use, and uses `shared` instead
+		// +optional
+		SharedVPC *bool `json:"sharedVPC,omitempty"`
+	}
+
 	// ClusterEndpoints holds cluster api server endpoint access information
 	ClusterEndpoints struct {
 		PrivateAccess *bool `json:"privateAccess,omitempty"`
@@ -417,3 +529,52 @@ func (c *ClusterConfig) HasPrivateEndpointAccess() bool {
 		c.VPC.ClusterEndpoints.PrivateAccess != nil &&
 		*c.VPC.ClusterEndpoints.PrivateAccess
 }
+
+// ClusterTagKey returns the key of the tag of the resources used by the cluster
+func (c *ClusterConfig) ClusterTagKey() string {
+	return "kubernetes.io/cluster/" + c.Metadata.Name
+}
+
+// SubnetClusterTag returns the value of the cluster tag of the subnets created by eksctl, or an empty string when
+// they are not tagged
+func (c *ClusterConfig) SubnetClusterTag() string {
+	if c.VPC == nil || c.VPC.ClusterTags == nil {
+		return ""
+	}
+	return c.VPC.ClusterTags.value(c.VPC.ClusterTags.Subnets, ClusterTagNone)
+}
+
+// SecurityGroupClusterTag returns the value of the cluster tag of the security groups created for nodegroups, or an
+// empty string when they are not tagged
+func (c *ClusterConfig) SecurityGroupClusterTag() string {
+	if c.VPC == nil || c.VPC.ClusterTags == nil {
+		return ClusterTagOwned
+	}
+	return c.VPC.ClusterTags.value(c.VPC.ClusterTags.SecurityGroups, ClusterTagOwned)
+}
+
+// InstanceClusterTag returns the value of the cluster tag of the instances of nodegroups
+func (c *ClusterConfig) InstanceClusterTag() string {
+	if c.VPC == nil || c.VPC.ClusterTags == nil {
+		return ClusterTagOwned
+	}
+	return c.VPC.ClusterTags.value(ClusterTagOwned, ClusterTagOwned)
+}
+
+func (t *ClusterTags) value(value, defaultValue string) string {
+	if value == "" {
+		value = defaultValue
+	}
+	switch {
+	case value == ClusterTagNone:
+		return ""
+	case value == ClusterTagOwned && IsEnabled(t.SharedVPC):
+		return ClusterTagShared
+	}
+	return value
+}
+
+// HostedZoneName returns the name of the private hosted zone created for the DNS name, its parent domain
+func (d *APIServerDNS) HostedZoneName() string {
+	return d.Name[strings.Index(d.Name, ".")+1:]
+}
@@ -1,5 +1,7 @@
 package v1alpha5
 
+import "fmt"
+
 // WellKnownPolicies for attaching common IAM policies
 type WellKnownPolicies struct {
 	// ImageBuilder allows for full ECR (Elastic Container Registry) access.
@@ -26,8 +28,90 @@ type WellKnownPolicies struct {
 	// efs-csi-controller. See [aws-efs-csi-driver
 	// docs](https://aws.amazon.com/blogs/containers/introducing-efs-csi-dynamic-provisioning).
 	EFSCSIController bool `json:"efsCSIController,inline"`
+	// ExternalSecrets adds policies for reading secrets from AWS Secrets
+	// Manager and SSM Parameter Store. See [external-secrets
+	// docs](https://external-secrets.io/latest/provider/aws-secrets-manager).
+	ExternalSecrets bool `json:"externalSecrets,inline"`
+	// FluentBit adds policies for shipping logs to CloudWatch Logs, Kinesis
+	// and Firehose. See [aws-for-fluent-bit
+	// docs](https://github.com/aws/aws-for-fluent-bit).
+	FluentBit bool `json:"fluentBit,inline"`
+	// Velero adds policies for backing up volumes to EBS snapshots and
+	// resources to S3. See [velero-plugin-for-aws
+	// docs](https://github.com/vmware-tanzu/velero-plugin-for-aws#set-permissions-for-velero).
+	Velero bool `json:"velero,inline"`
+	// Crossplane adds policies for managing the networking, storage,
+	// database and messaging resources commonly composed with the AWS
+	// provider of Crossplane. See [crossplane
+	// docs](https://github.com/crossplane/provider-aws/blob/master/AUTHENTICATION.md).
+	Crossplane bool `json:"crossplane,inline"`
+	// Karpenter adds policies for the karpenter controller. See [karpenter
+	// docs](https://karpenter.sh/docs/getting-started/).
+	Karpenter bool `json:"karpenter,inline"`
+	// Custom lists names of policies defined in the registry file
+	// referenced by `iam.wellKnownPolicyRegistry`
+	// +optional
+	Custom []string `json:"custom,omitempty"`
+
+	// CustomPolicies holds the policies of the registry listed in Custom,
+	// they are set when loading the config
+	CustomPolicies map[string]CustomWellKnownPolicy `json:"-"`
+}
+
+// CustomWellKnownPolicy is a named policy of a well-known policy registry
+type CustomWellKnownPolicy struct {
+	// +optional
+	AttachPolicyARNs []string `json:"attachPolicyARNs,omitempty"`
+	// +optional
+	AttachPolicy InlineDocument `json:"attachPolicy,omitempty"`
+}
+
+// WellKnownPolicyRegistry holds named policies that can be referenced from
+// `wellKnownPolicies.custom`
+type WellKnownPolicyRegistry struct {
+	Policies map[string]CustomWellKnownPolicy `json:"policies"`
 }
 
 func (p *WellKnownPolicies) HasPolicy() bool {
-	return p.ImageBuilder || p.AutoScaler || p.AWSLoadBalancerController || p.ExternalDNS || p.CertManager || p.EBSCSIController || p.EFSCSIController
+	return p.ImageBuilder || p.AutoScaler || p.AWSLoadBalancerController || p.ExternalDNS || p.CertManager || p.EBSCSIController || p.EFSCSIController ||
+		p.ExternalSecrets || p.FluentBit || p.Velero || p.Crossplane || p.Karpenter || len(p.Custom) > 0
+}
+
+// SetCustomPolicies sets the policies listed in Custom from the registry
+func (p *WellKnownPolicies) SetCustomPolicies(registry *WellKnownPolicyRegistry) error {
+	if len(p.Custom) == 0 {
+		return nil
+	}
+	if registry == nil {
+		return fmt.Errorf("custom well-known policies require iam.wellKnownPolicyRegistry to be set")
+	}
+	p.CustomPolicies = map[string]CustomWellKnownPolicy{}
+	for _, name := range p.Custom {
+		policy, ok := registry.Policies[name]
+		if !ok {
+			return fmt.Errorf("custom well-known policy %q not found in the registry", name)
+		}
+		if len(policy.AttachPolicyARNs) == 0 && len(policy.AttachPolicy) == 0 {
+			return fmt.Errorf("custom well-known policy %q must set attachPolicyARNs or attachPolicy", name)
+		}
+		p.CustomPolicies[name] = policy
+	}
+	return nil
+}
+
+// SetCustomWellKnownPolicies sets the custom well-known policies of service accounts and addons from the registry
+func (c *ClusterConfig) SetCustomWellKnownPolicies(registry *WellKnownPolicyRegistry) error {
+	if c.IAM != nil {
+		for i, sa := range c.IAM.ServiceAccounts {
+			if err := sa.WellKnownPolicies.SetCustomPolicies(registry); err != nil {
+				return fmt.Errorf("iam.serviceAccounts[%d].wellKnownPolicies: %w", i, err)
+			}
+		}
+	}
+	for i, addon := range c.Addons {
+		if err := addon.WellKnownPolicies.SetCustomPolicies(registry); err != nil {
+			return fmt.Errorf("addons[%d].wellKnownPolicies: %w", i, err)
+		}
+	}
+	return nil
 }
@@ -25,6 +25,144 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ADOTAMPPipeline) DeepCopyInto(out *ADOTAMPPipeline) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ADOTAMPPipeline.
+func (in *ADOTAMPPipeline) DeepCopy() *ADOTAMPPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(ADOTAMPPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ADOTAddonConfig) DeepCopyInto(out *ADOTAddonConfig) {
+	*out = *in
+	in.Pipelines.DeepCopyInto(&out.Pipelines)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ADOTAddonConfig.
+func (in *ADOTAddonConfig) DeepCopy() *ADOTAddonConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ADOTAddonConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ADOTCloudWatchPipeline) DeepCopyInto(out *ADOTCloudWatchPipeline) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ADOTCloudWatchPipeline.
+func (in *ADOTCloudWatchPipeline) DeepCopy() *ADOTCloudWatchPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(ADOTCloudWatchPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ADOTPipelines) DeepCopyInto(out *ADOTPipelines) {
+	*out = *in
+	if in.AMP != nil {
+		in, out := &in.AMP, &out.AMP
+		*out = new(ADOTAMPPipeline)
+		**out = **in
+	}
+	if in.CloudWatch != nil {
+		in, out := &in.CloudWatch, &out.CloudWatch
+		*out = new(ADOTCloudWatchPipeline)
+		**out = **in
+	}
+	if in.XRay != nil {
+		in, out := &in.XRay, &out.XRay
+		*out = new(ADOTXRayPipeline)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ADOTPipelines.
+func (in *ADOTPipelines) DeepCopy() *ADOTPipelines {
+	if in == nil {
+		return nil
+	}
+	out := new(ADOTPipelines)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ADOTXRayPipeline) DeepCopyInto(out *ADOTXRayPipeline) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ADOTXRayPipeline.
+func (in *ADOTXRayPipeline) DeepCopy() *ADOTXRayPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(ADOTXRayPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIErrorRateAlarm) DeepCopyInto(out *APIErrorRateAlarm) {
+	*out = *in
+	if in.ThresholdPercent != nil {
+		in, out := &in.ThresholdPercent, &out.ThresholdPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.EvaluationMinutes != nil {
+		in, out := &in.EvaluationMinutes, &out.EvaluationMinutes
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIErrorRateAlarm.
+func (in *APIErrorRateAlarm) DeepCopy() *APIErrorRateAlarm {
+	if in == nil {
+		return nil
+	}
+	out := new(APIErrorRateAlarm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerDNS) DeepCopyInto(out *APIServerDNS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerDNS.
+func (in *APIServerDNS) DeepCopy() *APIServerDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in AZSubnetMapping) DeepCopyInto(out *AZSubnetMapping) {
 	{
@@ -76,7 +214,7 @@ func (in *Addon) DeepCopyInto(out *Addon) {
 		copy(*out, *in)
 	}
 	in.AttachPolicy.DeepCopyInto(&out.AttachPolicy)
-	out.WellKnownPolicies = in.WellKnownPolicies
+	in.WellKnownPolicies.DeepCopyInto(&out.WellKnownPolicies)
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -84,6 +222,28 @@ func (in *Addon) DeepCopyInto(out *Addon) {
 			(*out)[key] = val
 		}
 	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(KubeProxyAddonConfig)
+		**out = **in
+	}
+	if in.CoreDNS != nil {
+		in, out := &in.CoreDNS, &out.CoreDNS
+		*out = new(CoreDNSAddonConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ADOT != nil {
+		in, out := &in.ADOT, &out.ADOT
+		*out = new(ADOTAddonConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodIdentityAssociations != nil {
+		in, out := &in.PodIdentityAssociations, &out.PodIdentityAssociations
+		*out = make([]PodIdentityAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -97,6 +257,124 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bootstrap) DeepCopyInto(out *Bootstrap) {
+	*out = *in
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreDeleteManifests != nil {
+		in, out := &in.PreDeleteManifests, &out.PreDeleteManifests
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bootstrap.
+func (in *Bootstrap) DeepCopy() *Bootstrap {
+	if in == nil {
+		return nil
+	}
+	out := new(Bootstrap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUOptions) DeepCopyInto(out *CPUOptions) {
+	*out = *in
+	if in.CoreCount != nil {
+		in, out := &in.CoreCount, &out.CoreCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.ThreadsPerCore != nil {
+		in, out := &in.ThreadsPerCore, &out.ThreadsPerCore
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUOptions.
+func (in *CPUOptions) DeepCopy() *CPUOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudFormationOverrides) DeepCopyInto(out *CloudFormationOverrides) {
+	*out = *in
+	if in.ExtraResources != nil {
+		in, out := &in.ExtraResources, &out.ExtraResources
+		*out = make(map[string]InlineDocument, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]InlineDocument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFormationOverrides.
+func (in *CloudFormationOverrides) DeepCopy() *CloudFormationOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudFormationOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAlarms) DeepCopyInto(out *ClusterAlarms) {
+	*out = *in
+	if in.Emails != nil {
+		in, out := &in.Emails, &out.Emails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeCount != nil {
+		in, out := &in.NodeCount, &out.NodeCount
+		*out = new(NodeCountAlarm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NATGatewayBandwidth != nil {
+		in, out := &in.NATGatewayBandwidth, &out.NATGatewayBandwidth
+		*out = new(NATGatewayBandwidthAlarm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIErrorRate != nil {
+		in, out := &in.APIErrorRate, &out.APIErrorRate
+		*out = new(APIErrorRateAlarm)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlarms.
+func (in *ClusterAlarms) DeepCopy() *ClusterAlarms {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAlarms)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterCloudWatch) DeepCopyInto(out *ClusterCloudWatch) {
 	*out = *in
@@ -186,6 +464,11 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(PrivateCluster)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeGroupDefaults != nil {
+		in, out := &in.NodeGroupDefaults, &out.NodeGroupDefaults
+		*out = new(NodeGroupDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeGroups != nil {
 		in, out := &in.NodeGroups, &out.NodeGroups
 		*out = make([]*NodeGroup, len(*in))
@@ -249,6 +532,72 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(Karpenter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HelmReleases != nil {
+		in, out := &in.HelmReleases, &out.HelmReleases
+		*out = make([]*HelmRelease, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(HelmRelease)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(Bootstrap)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(Hooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PullThroughCache != nil {
+		in, out := &in.PullThroughCache, &out.PullThroughCache
+		*out = new(PullThroughCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alarms != nil {
+		in, out := &in.Alarms, &out.Alarms
+		*out = new(ClusterAlarms)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(Notifications)
+		**out = **in
+	}
+	if in.CloudFormation != nil {
+		in, out := &in.CloudFormation, &out.CloudFormation
+		*out = new(CloudFormationOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StackSet != nil {
+		in, out := &in.StackSet, &out.StackSet
+		*out = new(StackSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParameterExport != nil {
+		in, out := &in.ParameterExport, &out.ParameterExport
+		*out = new(ParameterExport)
+		**out = **in
+	}
+	if in.Naming != nil {
+		in, out := &in.Naming, &out.Naming
+		*out = new(Naming)
+		**out = **in
+	}
+	if in.UpgradePolicy != nil {
+		in, out := &in.UpgradePolicy, &out.UpgradePolicy
+		*out = new(UpgradePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -368,6 +717,11 @@ func (in *ClusterIAM) DeepCopyInto(out *ClusterIAM) {
 			}
 		}
 	}
+	if in.STSRegionalEndpoints != nil {
+		in, out := &in.STSRegionalEndpoints, &out.STSRegionalEndpoints
+		*out = new(bool)
+		**out = **in
+	}
 	if in.VPCResourceControllerPolicy != nil {
 		in, out := &in.VPCResourceControllerPolicy, &out.VPCResourceControllerPolicy
 		*out = new(bool)
@@ -425,7 +779,7 @@ func (in *ClusterIAMServiceAccount) DeepCopyInto(out *ClusterIAMServiceAccount)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	out.WellKnownPolicies = in.WellKnownPolicies
+	in.WellKnownPolicies.DeepCopyInto(&out.WellKnownPolicies)
 	in.AttachPolicy.DeepCopyInto(&out.AttachPolicy)
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
@@ -437,6 +791,11 @@ func (in *ClusterIAMServiceAccount) DeepCopyInto(out *ClusterIAMServiceAccount)
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AdoptExisting != nil {
+		in, out := &in.AdoptExisting, &out.AdoptExisting
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -585,6 +944,27 @@ func (in *ClusterSubnets) DeepCopy() *ClusterSubnets {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterTags) DeepCopyInto(out *ClusterTags) {
+	*out = *in
+	if in.SharedVPC != nil {
+		in, out := &in.SharedVPC, &out.SharedVPC
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterTags.
+func (in *ClusterTags) DeepCopy() *ClusterTags {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterTags)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterVPC) DeepCopyInto(out *ClusterVPC) {
 	*out = *in
@@ -604,6 +984,11 @@ func (in *ClusterVPC) DeepCopyInto(out *ClusterVPC) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecondaryCIDRs != nil {
+		in, out := &in.SecondaryCIDRs, &out.SecondaryCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ManageSharedNodeSecurityGroupRules != nil {
 		in, out := &in.ManageSharedNodeSecurityGroupRules, &out.ManageSharedNodeSecurityGroupRules
 		*out = new(bool)
@@ -629,6 +1014,26 @@ func (in *ClusterVPC) DeepCopyInto(out *ClusterVPC) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.APIServerDNS != nil {
+		in, out := &in.APIServerDNS, &out.APIServerDNS
+		*out = new(APIServerDNS)
+		**out = **in
+	}
+	if in.DNSResolver != nil {
+		in, out := &in.DNSResolver, &out.DNSResolver
+		*out = new(DNSResolver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterTags != nil {
+		in, out := &in.ClusterTags, &out.ClusterTags
+		*out = new(ClusterTags)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudFormation != nil {
+		in, out := &in.CloudFormation, &out.CloudFormation
+		*out = new(VPCCloudFormation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -642,6 +1047,106 @@ func (in *ClusterVPC) DeepCopy() *ClusterVPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSAddonConfig) DeepCopyInto(out *CoreDNSAddonConfig) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(CoreDNSAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HighlyAvailable != nil {
+		in, out := &in.HighlyAvailable, &out.HighlyAvailable
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSAddonConfig.
+func (in *CoreDNSAddonConfig) DeepCopy() *CoreDNSAddonConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSAddonConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSAutoscaling) DeepCopyInto(out *CoreDNSAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSAutoscaling.
+func (in *CoreDNSAutoscaling) DeepCopy() *CoreDNSAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomWellKnownPolicy) DeepCopyInto(out *CustomWellKnownPolicy) {
+	*out = *in
+	if in.AttachPolicyARNs != nil {
+		in, out := &in.AttachPolicyARNs, &out.AttachPolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.AttachPolicy.DeepCopyInto(&out.AttachPolicy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomWellKnownPolicy.
+func (in *CustomWellKnownPolicy) DeepCopy() *CustomWellKnownPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomWellKnownPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSResolver) DeepCopyInto(out *DNSResolver) {
+	*out = *in
+	if in.RuleIDs != nil {
+		in, out := &in.RuleIDs, &out.RuleIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSResolver.
+func (in *DNSResolver) DeepCopy() *DNSResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSResolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FargateProfile) DeepCopyInto(out *FargateProfile) {
 	*out = *in
@@ -766,6 +1271,49 @@ func (in *GitOps) DeepCopy() *GitOps {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmRelease) DeepCopyInto(out *HelmRelease) {
+	*out = *in
+	in.Values.DeepCopyInto(&out.Values)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmRelease.
+func (in *HelmRelease) DeepCopy() *HelmRelease {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmRelease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hooks) DeepCopyInto(out *Hooks) {
+	*out = *in
+	if in.PostCreate != nil {
+		in, out := &in.PostCreate, &out.PostCreate
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreDelete != nil {
+		in, out := &in.PreDelete, &out.PreDelete
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hooks.
+func (in *Hooks) DeepCopy() *Hooks {
+	if in == nil {
+		return nil
+	}
+	out := new(Hooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdentityProvider) DeepCopyInto(out *IdentityProvider) {
 	*out = *in
@@ -803,6 +1351,11 @@ func (in *InstanceSelector) DeepCopyInto(out *InstanceSelector) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.MaxPricePerVCPU != nil {
+		in, out := &in.MaxPricePerVCPU, &out.MaxPricePerVCPU
+		*out = new(float64)
+		**out = **in
+	}
 	return
 }
 
@@ -837,6 +1390,22 @@ func (in *Karpenter) DeepCopy() *Karpenter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyAddonConfig) DeepCopyInto(out *KubeProxyAddonConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyAddonConfig.
+func (in *KubeProxyAddonConfig) DeepCopy() *KubeProxyAddonConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyAddonConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesNetworkConfig) DeepCopyInto(out *KubernetesNetworkConfig) {
 	*out = *in
@@ -874,6 +1443,48 @@ func (in *LaunchTemplate) DeepCopy() *LaunchTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleHook) DeepCopyInto(out *LifecycleHook) {
+	*out = *in
+	if in.HeartbeatTimeout != nil {
+		in, out := &in.HeartbeatTimeout, &out.HeartbeatTimeout
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleHook.
+func (in *LifecycleHook) DeepCopy() *LifecycleHook {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedNodeGroup) DeepCopyInto(out *ManagedNodeGroup) {
 	*out = *in
@@ -905,33 +1516,70 @@ func (in *ManagedNodeGroup) DeepCopyInto(out *ManagedNodeGroup) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNodeGroup.
-func (in *ManagedNodeGroup) DeepCopy() *ManagedNodeGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNodeGroup.
+func (in *ManagedNodeGroup) DeepCopy() *ManagedNodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedNodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsCollection) DeepCopyInto(out *MetricsCollection) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsCollection.
+func (in *MetricsCollection) DeepCopy() *MetricsCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayBandwidthAlarm) DeepCopyInto(out *NATGatewayBandwidthAlarm) {
+	*out = *in
+	if in.EvaluationMinutes != nil {
+		in, out := &in.EvaluationMinutes, &out.EvaluationMinutes
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayBandwidthAlarm.
+func (in *NATGatewayBandwidthAlarm) DeepCopy() *NATGatewayBandwidthAlarm {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedNodeGroup)
+	out := new(NATGatewayBandwidthAlarm)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetricsCollection) DeepCopyInto(out *MetricsCollection) {
+func (in *Naming) DeepCopyInto(out *Naming) {
 	*out = *in
-	if in.Metrics != nil {
-		in, out := &in.Metrics, &out.Metrics
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsCollection.
-func (in *MetricsCollection) DeepCopy() *MetricsCollection {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Naming.
+func (in *Naming) DeepCopy() *Naming {
 	if in == nil {
 		return nil
 	}
-	out := new(MetricsCollection)
+	out := new(Naming)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -956,6 +1604,32 @@ func (in *Network) DeepCopy() *Network {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeCountAlarm) DeepCopyInto(out *NodeCountAlarm) {
+	*out = *in
+	if in.MissingNodes != nil {
+		in, out := &in.MissingNodes, &out.MissingNodes
+		*out = new(int)
+		**out = **in
+	}
+	if in.EvaluationMinutes != nil {
+		in, out := &in.EvaluationMinutes, &out.EvaluationMinutes
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeCountAlarm.
+func (in *NodeCountAlarm) DeepCopy() *NodeCountAlarm {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeCountAlarm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 	*out = *in
@@ -991,6 +1665,13 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LifecycleHooks != nil {
+		in, out := &in.LifecycleHooks, &out.LifecycleHooks
+		*out = make([]LifecycleHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
 		*out = make(taintsWrapper, len(*in))
@@ -1010,6 +1691,11 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv6Only != nil {
+		in, out := &in.IPv6Only, &out.IPv6Only
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1160,6 +1846,21 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EnclaveEnabled != nil {
+		in, out := &in.EnclaveEnabled, &out.EnclaveEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudFormation != nil {
+		in, out := &in.CloudFormation, &out.CloudFormation
+		*out = new(CloudFormationOverrides)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1198,6 +1899,61 @@ func (in *NodeGroupBottlerocket) DeepCopy() *NodeGroupBottlerocket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupDefaults) DeepCopyInto(out *NodeGroupDefaults) {
+	*out = *in
+	if in.VolumeSize != nil {
+		in, out := &in.VolumeSize, &out.VolumeSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSH != nil {
+		in, out := &in.SSH, &out.SSH
+		*out = new(NodeGroupSSH)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IAM != nil {
+		in, out := &in.IAM, &out.IAM
+		*out = new(NodeGroupIAM)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PreBootstrapCommands != nil {
+		in, out := &in.PreBootstrapCommands, &out.PreBootstrapCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupDefaults.
+func (in *NodeGroupDefaults) DeepCopy() *NodeGroupDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroupIAM) DeepCopyInto(out *NodeGroupIAM) {
 	*out = *in
@@ -1462,6 +2218,22 @@ func (in *NodeGroupUpdateConfig) DeepCopy() *NodeGroupUpdateConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notifications) DeepCopyInto(out *Notifications) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notifications.
+func (in *Notifications) DeepCopy() *Notifications {
+	if in == nil {
+		return nil
+	}
+	out := new(Notifications)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCIdentityProvider) DeepCopyInto(out *OIDCIdentityProvider) {
 	*out = *in
@@ -1492,6 +2264,22 @@ func (in *OIDCIdentityProvider) DeepCopy() *OIDCIdentityProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterExport) DeepCopyInto(out *ParameterExport) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterExport.
+func (in *ParameterExport) DeepCopy() *ParameterExport {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Placement) DeepCopyInto(out *Placement) {
 	*out = *in
@@ -1508,6 +2296,29 @@ func (in *Placement) DeepCopy() *Placement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociation) DeepCopyInto(out *PodIdentityAssociation) {
+	*out = *in
+	if in.PermissionPolicyARNs != nil {
+		in, out := &in.PermissionPolicyARNs, &out.PermissionPolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.PermissionPolicy.DeepCopyInto(&out.PermissionPolicy)
+	in.WellKnownPolicies.DeepCopyInto(&out.WellKnownPolicies)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociation.
+func (in *PodIdentityAssociation) DeepCopy() *PodIdentityAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateCluster) DeepCopyInto(out *PrivateCluster) {
 	*out = *in
@@ -1516,6 +2327,23 @@ func (in *PrivateCluster) DeepCopyInto(out *PrivateCluster) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CustomEndpointServices != nil {
+		in, out := &in.CustomEndpointServices, &out.CustomEndpointServices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExistingEndpointIDs != nil {
+		in, out := &in.ExistingEndpointIDs, &out.ExistingEndpointIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EndpointPolicies != nil {
+		in, out := &in.EndpointPolicies, &out.EndpointPolicies
+		*out = make(map[string]InlineDocument, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -1545,6 +2373,43 @@ func (in *ProviderConfig) DeepCopy() *ProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullThroughCache) DeepCopyInto(out *PullThroughCache) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PullThroughCacheRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullThroughCache.
+func (in *PullThroughCache) DeepCopy() *PullThroughCache {
+	if in == nil {
+		return nil
+	}
+	out := new(PullThroughCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullThroughCacheRule) DeepCopyInto(out *PullThroughCacheRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullThroughCacheRule.
+func (in *PullThroughCacheRule) DeepCopy() *PullThroughCacheRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PullThroughCacheRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingConfig) DeepCopyInto(out *ScalingConfig) {
 	*out = *in
@@ -1592,9 +2457,110 @@ func (in *SecretsEncryption) DeepCopy() *SecretsEncryption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackSet) DeepCopyInto(out *StackSet) {
+	*out = *in
+	if in.OrganizationalUnitIDs != nil {
+		in, out := &in.OrganizationalUnitIDs, &out.OrganizationalUnitIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DelegatedAdmin != nil {
+		in, out := &in.DelegatedAdmin, &out.DelegatedAdmin
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxConcurrentPercentage != nil {
+		in, out := &in.MaxConcurrentPercentage, &out.MaxConcurrentPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.FailureTolerancePercentage != nil {
+		in, out := &in.FailureTolerancePercentage, &out.FailureTolerancePercentage
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackSet.
+func (in *StackSet) DeepCopy() *StackSet {
+	if in == nil {
+		return nil
+	}
+	out := new(StackSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePolicy) DeepCopyInto(out *UpgradePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePolicy.
+func (in *UpgradePolicy) DeepCopy() *UpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCCloudFormation) DeepCopyInto(out *VPCCloudFormation) {
+	*out = *in
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = new(VPCStackOutputs)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCloudFormation.
+func (in *VPCCloudFormation) DeepCopy() *VPCCloudFormation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCCloudFormation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCStackOutputs) DeepCopyInto(out *VPCStackOutputs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCStackOutputs.
+func (in *VPCStackOutputs) DeepCopy() *VPCStackOutputs {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCStackOutputs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WellKnownPolicies) DeepCopyInto(out *WellKnownPolicies) {
 	*out = *in
+	if in.Custom != nil {
+		in, out := &in.Custom, &out.Custom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomPolicies != nil {
+		in, out := &in.CustomPolicies, &out.CustomPolicies
+		*out = make(map[string]CustomWellKnownPolicy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -1607,3 +2573,26 @@ func (in *WellKnownPolicies) DeepCopy() *WellKnownPolicies {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WellKnownPolicyRegistry) DeepCopyInto(out *WellKnownPolicyRegistry) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make(map[string]CustomWellKnownPolicy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WellKnownPolicyRegistry.
+func (in *WellKnownPolicyRegistry) DeepCopy() *WellKnownPolicyRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(WellKnownPolicyRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
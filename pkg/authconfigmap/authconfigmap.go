@@ -289,16 +289,45 @@ func AddNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
 		return err
 	}
 
-	nodeGroupRoles := RoleNodeGroupGroups
-	if api.IsWindowsImage(ng.AMIFamily) {
-		nodeGroupRoles = append([]string{roleNodeGroupWindows}, nodeGroupRoles...)
+	identity, err := nodeGroupIdentity(ng)
+	if err != nil {
+		return err
 	}
 
-	identity, err := iam.NewIdentity(ng.IAM.InstanceRoleARN, RoleNodeGroupUsername, nodeGroupRoles)
+	if err := acm.AddIdentity(identity); err != nil {
+		return errors.Wrap(err, "adding nodegroup to auth ConfigMap")
+	}
+	if err := acm.Save(); err != nil {
+		return errors.Wrap(err, "saving auth ConfigMap")
+	}
+	logger.Debug("saved auth ConfigMap for %q", ng.Name)
+	return nil
+}
+
+// AddNodeGroupIfNotPresent adds a nodegroup IAM role in the auth ConfigMap
+// for the given nodegroup, unless the role is already mapped.
+func AddNodeGroupIfNotPresent(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
+	acm, err := NewFromClientSet(clientSet)
 	if err != nil {
 		return err
 	}
 
+	identity, err := nodeGroupIdentity(ng)
+	if err != nil {
+		return err
+	}
+
+	identities, err := acm.GetIdentities()
+	if err != nil {
+		return err
+	}
+	for _, idt := range identities {
+		if idt.Type() == identity.Type() && idt.ARN() == identity.ARN() {
+			logger.Debug("nodegroup %q is already in auth ConfigMap", ng.Name)
+			return nil
+		}
+	}
+
 	if err := acm.AddIdentity(identity); err != nil {
 		return errors.Wrap(err, "adding nodegroup to auth ConfigMap")
 	}
@@ -309,6 +338,15 @@ func AddNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
 	return nil
 }
 
+func nodeGroupIdentity(ng *api.NodeGroup) (iam.Identity, error) {
+	nodeGroupRoles := RoleNodeGroupGroups
+	if api.IsWindowsImage(ng.AMIFamily) {
+		nodeGroupRoles = append([]string{roleNodeGroupWindows}, nodeGroupRoles...)
+	}
+
+	return iam.NewIdentity(ng.IAM.InstanceRoleARN, RoleNodeGroupUsername, nodeGroupRoles)
+}
+
 // RemoveNodeGroup removes a nodegroup from the ConfigMap and
 // does a client update.
 func RemoveNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
@@ -9,11 +9,13 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	. "github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/iam"
 )
@@ -575,4 +577,22 @@ var _ = Describe("AuthConfigMap{}", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+	Describe("AddNodeGroupIfNotPresent()", func() {
+		It("should add the role of a nodegroup once", func() {
+			clientSet := fake.NewSimpleClientset()
+			ng := &api.NodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name: "ng1",
+					IAM:  &api.NodeGroupIAM{InstanceRoleARN: roleA},
+				},
+			}
+
+			Expect(AddNodeGroupIfNotPresent(clientSet, ng)).To(Succeed())
+			Expect(AddNodeGroupIfNotPresent(clientSet, ng)).To(Succeed())
+
+			cm, err := clientSet.CoreV1().ConfigMaps(ObjectNamespace).Get(context.TODO(), ObjectName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cm.Data["mapRoles"]).To(MatchYAML(expectedRoleA))
+		})
+	})
 })
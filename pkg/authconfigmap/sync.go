@@ -0,0 +1,131 @@
+package authconfigmap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/eksctl/pkg/iam"
+)
+
+// nodesGroup is the group of the identities used by nodes
+const nodesGroup = "system:nodes"
+
+// IdentityMapping is an entry of a file of identity mappings, it maps either an IAM role or user,
+// or an account
+type IdentityMapping struct {
+	ARN      string   `json:"arn,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+	Account  string   `json:"account,omitempty"`
+}
+
+// IdentityMappings is the content of a file of identity mappings
+type IdentityMappings struct {
+	Mappings []IdentityMapping `json:"mappings"`
+}
+
+// ParseIdentityMappings parses a file of identity mappings and returns the identities and accounts it contains
+func ParseIdentityMappings(data []byte) ([]iam.Identity, []string, error) {
+	var mappings IdentityMappings
+	if err := yaml.UnmarshalStrict(data, &mappings); err != nil {
+		return nil, nil, errors.Wrap(err, "unmarshalling identity mappings")
+	}
+
+	var (
+		identities []iam.Identity
+		accounts   []string
+	)
+	for i, m := range mappings.Mappings {
+		if m.Account != "" {
+			if m.ARN != "" || m.Username != "" || len(m.Groups) > 0 {
+				return nil, nil, fmt.Errorf("mappings[%d]: account can only be set alone", i)
+			}
+			accounts = append(accounts, m.Account)
+			continue
+		}
+		id, err := iam.NewIdentity(m.ARN, m.Username, m.Groups)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "mappings[%d]", i)
+		}
+		identities = append(identities, id)
+	}
+	return identities, accounts, nil
+}
+
+// SyncIdentities makes the roles, users and accounts of the ConfigMap match the given identities and accounts.
+// Identities used by nodes are always kept, as removing them would make nodes unable to join the cluster
+func (a *AuthConfigMap) SyncIdentities(identities []iam.Identity, accounts []string) error {
+	existing, err := a.GetIdentities()
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string]bool)
+	for _, id := range identities {
+		desired[id.ARN()] = true
+	}
+
+	var synced []iam.Identity
+	for _, id := range existing {
+		if id.Type() == iam.ResourceTypeAccount {
+			continue
+		}
+		switch {
+		case desired[id.ARN()]:
+			// replaced by the identity from the mappings below
+		case isNodeIdentity(id):
+			logger.Info("keeping identity %q used by nodes in auth ConfigMap", id.ARN())
+			synced = append(synced, id)
+		default:
+			logger.Info("removing identity %q from auth ConfigMap (username = %q, groups = %q)", id.ARN(), id.Username(), id.Groups())
+		}
+	}
+	for _, id := range identities {
+		logger.Info("adding identity %q to auth ConfigMap", id.ARN())
+		synced = append(synced, id)
+	}
+
+	if err := a.setIdentities(synced); err != nil {
+		return err
+	}
+	return a.setAccounts(sets.NewString(accounts...).List())
+}
+
+func isNodeIdentity(id iam.Identity) bool {
+	for _, group := range id.Groups() {
+		if group == nodesGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// Backup saves a copy of the ConfigMap as it currently exists in the cluster, and returns its name
+func (a *AuthConfigMap) Backup() (string, error) {
+	if a.cm.UID == "" {
+		return "", nil
+	}
+	current, err := a.client.Get(context.TODO(), ObjectName, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "getting auth ConfigMap")
+	}
+	backup := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-backup-%d", ObjectName, time.Now().Unix()),
+			Namespace: ObjectNamespace,
+		},
+		Data: current.Data,
+	}
+	if _, err := a.client.Create(context.TODO(), backup, metav1.CreateOptions{}); err != nil {
+		return "", errors.Wrap(err, "creating backup of auth ConfigMap")
+	}
+	return backup.Name, nil
+}
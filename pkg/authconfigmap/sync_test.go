@@ -0,0 +1,135 @@
+package authconfigmap_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/iam"
+)
+
+var _ = Describe("AuthConfigMap sync", func() {
+	Describe("ParseIdentityMappings()", func() {
+		It("should parse roles, users and accounts", func() {
+			identities, accounts, err := ParseIdentityMappings([]byte(`
+mappings:
+- arn: ` + roleB + `
+  groups: [foo]
+- arn: ` + userA + `
+  username: alice
+- account: "` + accountA + `"
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(identities).To(HaveLen(2))
+			Expect(identities[0].Type()).To(Equal(iam.ResourceTypeRole))
+			Expect(identities[0].Groups()).To(Equal([]string{groupB}))
+			Expect(identities[1].Type()).To(Equal(iam.ResourceTypeUser))
+			Expect(identities[1].Username()).To(Equal(userAUsername))
+			Expect(accounts).To(Equal([]string{accountA}))
+		})
+
+		It("should reject invalid mappings", func() {
+			_, _, err := ParseIdentityMappings([]byte(`
+mappings:
+- arn: ` + roleB + `
+`))
+			Expect(err).To(MatchError(ContainSubstring("mappings[0]")))
+
+			_, _, err = ParseIdentityMappings([]byte(`
+mappings:
+- account: "` + accountA + `"
+  username: alice
+`))
+			Expect(err).To(MatchError("mappings[0]: account can only be set alone"))
+
+			_, _, err = ParseIdentityMappings([]byte(`
+mappings:
+- role: ` + roleB + `
+`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("SyncIdentities()", func() {
+		var (
+			client *mockClient
+			acm    *AuthConfigMap
+		)
+
+		BeforeEach(func() {
+			existing := &corev1.ConfigMap{
+				ObjectMeta: ObjectMeta(),
+				Data: map[string]string{
+					"mapRoles":    expectedRoleA + expectedRoleB,
+					"mapUsers":    expectedUserA,
+					"mapAccounts": makeExpectedAccounts(accountA),
+				},
+			}
+			existing.UID = "123456"
+			client = &mockClient{}
+			acm = New(client, existing)
+		})
+
+		It("should make the ConfigMap match the mappings and keep node roles", func() {
+			userBIdentity, err := iam.NewIdentity(userB, userBUsername, userBGroups)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(acm.SyncIdentities([]iam.Identity{userBIdentity}, []string{accountB})).To(Succeed())
+			Expect(acm.Save()).To(Succeed())
+
+			Expect(client.updated.Data["mapRoles"]).To(MatchYAML(expectedRoleA))
+			Expect(client.updated.Data["mapUsers"]).To(MatchYAML(expectedUserB))
+			Expect(client.updated.Data["mapAccounts"]).To(MatchYAML(makeExpectedAccounts(accountB)))
+		})
+
+		It("should replace an existing identity with the one from the mappings", func() {
+			roleAIdentity, err := iam.NewIdentity(roleA, "", []string{groupB})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(acm.SyncIdentities([]iam.Identity{roleAIdentity}, nil)).To(Succeed())
+			Expect(acm.Save()).To(Succeed())
+
+			identities, err := acm.GetIdentities()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(identities).To(HaveLen(1))
+			Expect(identities[0].ARN()).To(Equal(roleA))
+			Expect(identities[0].Groups()).To(Equal([]string{groupB}))
+		})
+	})
+
+	Describe("Backup()", func() {
+		It("should save a copy of the existing ConfigMap", func() {
+			existing := &corev1.ConfigMap{
+				ObjectMeta: ObjectMeta(),
+				Data:       map[string]string{"mapRoles": expectedRoleA},
+			}
+			existing.UID = "123456"
+			clientSet := fake.NewSimpleClientset(existing)
+
+			acm, err := NewFromClientSet(clientSet)
+			Expect(err).NotTo(HaveOccurred())
+
+			name, err := acm.Backup()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(HavePrefix("aws-auth-backup-"))
+
+			backup, err := clientSet.CoreV1().ConfigMaps(ObjectNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(backup.Data).To(Equal(existing.Data))
+		})
+
+		It("should not save a copy when the ConfigMap does not exist", func() {
+			acm, err := NewFromClientSet(fake.NewSimpleClientset())
+			Expect(err).NotTo(HaveOccurred())
+
+			name, err := acm.Backup()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(BeEmpty())
+		})
+	})
+})
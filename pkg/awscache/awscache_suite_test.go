@@ -0,0 +1,13 @@
+package awscache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAWSCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AWS Cache Suite")
+}
@@ -0,0 +1,202 @@
+// Package awscache caches the responses of AWS describe calls whose results rarely change, such as the subnets of a
+// VPC or the instance types of a region, within a command, and optionally across eksctl commands.
+package awscache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+)
+
+const (
+	// EnableDiskCacheEnvName defines an environment property to also cache the responses on disk, across commands.
+	EnableDiskCacheEnvName = "EKSCTL_ENABLE_DESCRIBE_CACHE"
+	// CacheDirEnvName defines an environment property to configure where the cache on disk should live.
+	CacheDirEnvName = "EKSCTL_DESCRIBE_CACHE_DIR"
+	// CacheTTLEnvName defines an environment property to configure how long responses are cached, 0 disables caching.
+	CacheTTLEnvName = "EKSCTL_DESCRIBE_CACHE_TTL"
+
+	// DefaultTTL is how long responses are cached by default
+	DefaultTTL = 5 * time.Minute
+)
+
+type entry struct {
+	Data       json.RawMessage `json:"data"`
+	Expiration time.Time       `json:"expiration"`
+}
+
+// Cache holds JSON encoded responses in memory, and optionally in files of a directory, until they expire.
+type Cache struct {
+	ttl   time.Duration
+	dir   string
+	clock func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+// New creates a Cache keeping responses for ttl, in memory and in dir if it is not empty.
+func New(ttl time.Duration, dir string) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		dir:     dir,
+		clock:   time.Now,
+		entries: map[string]entry{},
+	}
+}
+
+// NewFromEnv creates a Cache configured by the environment, which caches responses in memory for DefaultTTL, and on
+// disk if enabled. The cache in memory is meant to be created for each command, so that long-running processes do
+// not keep responses between the commands they run.
+func NewFromEnv() *Cache {
+	ttl := DefaultTTL
+	if value := os.Getenv(CacheTTLEnvName); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			logger.Warning("ignoring invalid %s %q: %v", CacheTTLEnvName, value, err)
+		} else {
+			ttl = parsed
+		}
+	}
+	var dir string
+	if enabled, _ := strconv.ParseBool(os.Getenv(EnableDiskCacheEnvName)); enabled {
+		var err error
+		if dir, err = cacheDir(); err != nil {
+			logger.Warning("not caching AWS describe calls on disk: %v", err)
+		}
+	}
+	return New(ttl, dir)
+}
+
+func cacheDir() (string, error) {
+	if dir := os.Getenv(CacheDirEnvName); dir != "" {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".eksctl", "cache", "describe"), nil
+}
+
+// Get decodes the response cached with the key into out, and returns whether it was cached and not expired
+func (c *Cache) Get(key string, out interface{}) bool {
+	if c.ttl <= 0 {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok && c.dir != "" {
+		e, ok = c.readFile(key)
+	}
+	if !ok || !c.clock().Before(e.Expiration) {
+		return false
+	}
+	if err := json.Unmarshal(e.Data, out); err != nil {
+		logger.Debug("ignoring cached response %s: %v", key, err)
+		return false
+	}
+	c.entries[key] = e
+	return true
+}
+
+// Set caches the response with the key
+func (c *Cache) Set(key string, value interface{}) {
+	if c.ttl <= 0 {
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		logger.Debug("not caching response %s: %v", key, err)
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := entry{Data: data, Expiration: c.clock().Add(c.ttl)}
+	c.entries[key] = e
+	if c.dir != "" {
+		c.writeFile(key, e)
+	}
+}
+
+// Clear drops all cached responses, in memory and on disk
+func (c *Cache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]entry{}
+	if c.dir == "" {
+		return
+	}
+	files, err := filepath.Glob(filepath.Join(c.dir, "*.json"))
+	if err != nil {
+		logger.Debug("not clearing cached responses on disk: %v", err)
+		return
+	}
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			logger.Debug("not clearing cached response on disk: %v", err)
+		}
+	}
+}
+
+// filename returns the file of the key, keys are hashed as they may hold identifiers of the account
+func (c *Cache) filename(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(hash[:])+".json")
+}
+
+func (c *Cache) readFile(key string) (entry, bool) {
+	var e entry
+	data, err := os.ReadFile(c.filename(key))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Debug("reading cached response: %v", err)
+		}
+		return e, false
+	}
+	if err := json.Unmarshal(data, &e); err != nil {
+		logger.Debug("ignoring cached response file: %v", err)
+		return e, false
+	}
+	return e, true
+}
+
+func (c *Cache) writeFile(key string, e entry) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		logger.Debug("not caching response on disk: %v", err)
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		logger.Debug("not caching response on disk: %v", err)
+		return
+	}
+	// responses are written to a temporary file first, so that concurrent commands never read partial files
+	f, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		logger.Debug("not caching response on disk: %v", err)
+		return
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), c.filename(key))
+	}
+	if err != nil {
+		logger.Debug("not caching response on disk: %v", err)
+	}
+}
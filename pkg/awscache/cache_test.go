@@ -0,0 +1,147 @@
+package awscache_test
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/awscache"
+)
+
+type response struct {
+	Names []string
+}
+
+var _ = Describe("Cache", func() {
+	var now time.Time
+
+	newCache := func(ttl time.Duration, dir string) *awscache.Cache {
+		cache := awscache.New(ttl, dir)
+		cache.SetClock(func() time.Time { return now })
+		return cache
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	})
+
+	It("returns cached responses until they expire", func() {
+		cache := newCache(time.Minute, "")
+		cache.Set("key", response{Names: []string{"a"}})
+
+		var cached response
+		Expect(cache.Get("key", &cached)).To(BeTrue())
+		Expect(cached.Names).To(Equal([]string{"a"}))
+		Expect(cache.Get("other", &cached)).To(BeFalse())
+
+		now = now.Add(time.Minute)
+		Expect(cache.Get("key", &cached)).To(BeFalse())
+	})
+
+	It("does not cache responses with a zero TTL", func() {
+		cache := newCache(0, "")
+		cache.Set("key", response{Names: []string{"a"}})
+
+		var cached response
+		Expect(cache.Get("key", &cached)).To(BeFalse())
+	})
+
+	It("returns copies of the cached responses", func() {
+		cache := newCache(time.Minute, "")
+		cache.Set("key", &response{Names: []string{"a"}})
+
+		var cached response
+		Expect(cache.Get("key", &cached)).To(BeTrue())
+		cached.Names[0] = "b"
+		Expect(cache.Get("key", &cached)).To(BeTrue())
+		Expect(cached.Names).To(Equal([]string{"a"}))
+	})
+
+	Context("on disk", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "describe-cache")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("shares responses between caches until they expire", func() {
+			newCache(time.Minute, dir).Set("key", response{Names: []string{"a"}})
+
+			files, err := os.ReadDir(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			Expect(files[0].Name()).NotTo(ContainSubstring("key"))
+			info, err := files[0].Info()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			var cached response
+			Expect(newCache(time.Minute, dir).Get("key", &cached)).To(BeTrue())
+			Expect(cached.Names).To(Equal([]string{"a"}))
+
+			now = now.Add(2 * time.Minute)
+			Expect(newCache(time.Minute, dir).Get("key", &cached)).To(BeFalse())
+		})
+
+		It("drops the responses in memory and on disk when cleared", func() {
+			cache := newCache(time.Minute, dir)
+			cache.Set("key", response{Names: []string{"a"}})
+			cache.Clear()
+
+			var cached response
+			Expect(cache.Get("key", &cached)).To(BeFalse())
+			Expect(newCache(time.Minute, dir).Get("key", &cached)).To(BeFalse())
+		})
+
+		It("ignores invalid files", func() {
+			cache := newCache(time.Minute, dir)
+			cache.Set("key", response{Names: []string{"a"}})
+			files, err := os.ReadDir(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.WriteFile(dir+"/"+files[0].Name(), []byte("{"), 0600)).To(Succeed())
+
+			var cached response
+			Expect(newCache(time.Minute, dir).Get("key", &cached)).To(BeFalse())
+		})
+	})
+
+	Describe("NewFromEnv", func() {
+		AfterEach(func() {
+			os.Unsetenv(awscache.CacheTTLEnvName)
+			os.Unsetenv(awscache.EnableDiskCacheEnvName)
+			os.Unsetenv(awscache.CacheDirEnvName)
+		})
+
+		It("caches on disk when enabled", func() {
+			dir, err := os.MkdirTemp("", "describe-cache")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+			os.Setenv(awscache.EnableDiskCacheEnvName, "true")
+			os.Setenv(awscache.CacheDirEnvName, dir)
+
+			awscache.NewFromEnv().Set("key", response{Names: []string{"a"}})
+
+			files, err := os.ReadDir(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+		})
+
+		It("disables caching with a zero TTL", func() {
+			os.Setenv(awscache.CacheTTLEnvName, "0s")
+
+			cache := awscache.NewFromEnv()
+			cache.Set("key", response{Names: []string{"a"}})
+
+			var cached response
+			Expect(cache.Get("key", &cached)).To(BeFalse())
+		})
+	})
+})
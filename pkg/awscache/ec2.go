@@ -0,0 +1,103 @@
+package awscache
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/kris-nova/logger"
+)
+
+// Invalidator is implemented by APIs caching responses that operations of eksctl may make stale, e.g. by creating or
+// deleting a VPC and its subnets
+type Invalidator interface {
+	// Invalidate drops the cached responses
+	Invalidate()
+}
+
+// EC2 is an EC2API caching the responses of DescribeSubnets, DescribeRouteTables and DescribeInstanceTypes.
+type EC2 struct {
+	ec2iface.EC2API
+	cache       *Cache
+	region      string
+	credentials *credentials.Credentials
+}
+
+// NewEC2 wraps the EC2API of the region, called with the credentials, to cache its describe calls. Responses are
+// cached per region and access key, so that they are not shared between accounts.
+func NewEC2(ec2API ec2iface.EC2API, cache *Cache, region string, creds *credentials.Credentials) *EC2 {
+	return &EC2{
+		EC2API:      ec2API,
+		cache:       cache,
+		region:      region,
+		credentials: creds,
+	}
+}
+
+// Invalidate drops the cached responses, e.g. once a stack creating or deleting subnets or route tables is done
+func (c *EC2) Invalidate() {
+	c.cache.Clear()
+}
+
+// key returns the key of the response of the operation with the input, or false if the response must not be cached
+func (c *EC2) key(operation string, input interface{}) (string, bool) {
+	if c.credentials == nil {
+		return "", false
+	}
+	value, err := c.credentials.Get()
+	if err != nil {
+		return "", false
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%s/%s/ec2.%s/%s", value.AccessKeyID, c.region, operation, data), true
+}
+
+// DescribeSubnets returns the cached response if any, or calls EC2 and caches it
+func (c *EC2) DescribeSubnets(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+	key, ok := c.key("DescribeSubnets", input)
+	var output ec2.DescribeSubnetsOutput
+	if ok && c.cache.Get(key, &output) {
+		logger.Debug("using cached DescribeSubnets response")
+		return &output, nil
+	}
+	response, err := c.EC2API.DescribeSubnets(input)
+	if err == nil && ok {
+		c.cache.Set(key, response)
+	}
+	return response, err
+}
+
+// DescribeRouteTables returns the cached response if any, or calls EC2 and caches it
+func (c *EC2) DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error) {
+	key, ok := c.key("DescribeRouteTables", input)
+	var output ec2.DescribeRouteTablesOutput
+	if ok && c.cache.Get(key, &output) {
+		logger.Debug("using cached DescribeRouteTables response")
+		return &output, nil
+	}
+	response, err := c.EC2API.DescribeRouteTables(input)
+	if err == nil && ok {
+		c.cache.Set(key, response)
+	}
+	return response, err
+}
+
+// DescribeInstanceTypes returns the cached response if any, or calls EC2 and caches it
+func (c *EC2) DescribeInstanceTypes(input *ec2.DescribeInstanceTypesInput) (*ec2.DescribeInstanceTypesOutput, error) {
+	key, ok := c.key("DescribeInstanceTypes", input)
+	var output ec2.DescribeInstanceTypesOutput
+	if ok && c.cache.Get(key, &output) {
+		logger.Debug("using cached DescribeInstanceTypes response")
+		return &output, nil
+	}
+	response, err := c.EC2API.DescribeInstanceTypes(input)
+	if err == nil && ok {
+		c.cache.Set(key, response)
+	}
+	return response, err
+}
@@ -0,0 +1,136 @@
+package awscache_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/awscache"
+	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+)
+
+var _ = Describe("EC2", func() {
+	var (
+		ec2API *mocks.EC2API
+		cache  *awscache.Cache
+	)
+
+	newEC2 := func(region, accessKeyID string) *awscache.EC2 {
+		return awscache.NewEC2(ec2API, cache, region, credentials.NewStaticCredentials(accessKeyID, "secret", ""))
+	}
+
+	subnetsInput := func(vpcID string) *ec2.DescribeSubnetsInput {
+		return &ec2.DescribeSubnetsInput{
+			Filters: []*ec2.Filter{{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{vpcID})}},
+		}
+	}
+
+	BeforeEach(func() {
+		ec2API = &mocks.EC2API{}
+		cache = awscache.New(time.Minute, "")
+	})
+
+	It("caches the responses of DescribeSubnets", func() {
+		ec2API.On("DescribeSubnets", mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{{SubnetId: aws.String("subnet-1")}},
+		}, nil).Once()
+
+		api := newEC2("us-west-2", "AKID")
+		for i := 0; i < 2; i++ {
+			output, err := api.DescribeSubnets(subnetsInput("vpc-1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*output.Subnets[0].SubnetId).To(Equal("subnet-1"))
+		}
+		ec2API.AssertNumberOfCalls(GinkgoT(), "DescribeSubnets", 1)
+	})
+
+	It("calls EC2 again once invalidated", func() {
+		ec2API.On("DescribeSubnets", mock.Anything).Return(&ec2.DescribeSubnetsOutput{}, nil)
+
+		api := newEC2("us-west-2", "AKID")
+		_, err := api.DescribeSubnets(subnetsInput("vpc-1"))
+		Expect(err).NotTo(HaveOccurred())
+		api.Invalidate()
+		_, err = api.DescribeSubnets(subnetsInput("vpc-1"))
+		Expect(err).NotTo(HaveOccurred())
+		ec2API.AssertNumberOfCalls(GinkgoT(), "DescribeSubnets", 2)
+	})
+
+	It("caches responses per input, region and access key", func() {
+		ec2API.On("DescribeSubnets", mock.Anything).Return(&ec2.DescribeSubnetsOutput{}, nil)
+
+		_, err := newEC2("us-west-2", "AKID").DescribeSubnets(subnetsInput("vpc-1"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = newEC2("us-west-2", "AKID").DescribeSubnets(subnetsInput("vpc-2"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = newEC2("eu-west-1", "AKID").DescribeSubnets(subnetsInput("vpc-1"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = newEC2("us-west-2", "OTHER").DescribeSubnets(subnetsInput("vpc-1"))
+		Expect(err).NotTo(HaveOccurred())
+		ec2API.AssertNumberOfCalls(GinkgoT(), "DescribeSubnets", 4)
+	})
+
+	It("does not cache errors", func() {
+		ec2API.On("DescribeRouteTables", mock.Anything).Return(nil, errors.New("throttled")).Once()
+		ec2API.On("DescribeRouteTables", mock.Anything).Return(&ec2.DescribeRouteTablesOutput{
+			RouteTables: []*ec2.RouteTable{{RouteTableId: aws.String("rtb-1")}},
+		}, nil).Once()
+
+		api := newEC2("us-west-2", "AKID")
+		input := &ec2.DescribeRouteTablesInput{RouteTableIds: aws.StringSlice([]string{"rtb-1"})}
+		_, err := api.DescribeRouteTables(input)
+		Expect(err).To(MatchError("throttled"))
+		output, err := api.DescribeRouteTables(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*output.RouteTables[0].RouteTableId).To(Equal("rtb-1"))
+		output, err = api.DescribeRouteTables(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*output.RouteTables[0].RouteTableId).To(Equal("rtb-1"))
+		ec2API.AssertNumberOfCalls(GinkgoT(), "DescribeRouteTables", 2)
+	})
+
+	It("caches the pages of DescribeInstanceTypes", func() {
+		ec2API.On("DescribeInstanceTypes", &ec2.DescribeInstanceTypesInput{}).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{{InstanceType: aws.String("m5.large")}},
+			NextToken:     aws.String("token"),
+		}, nil).Once()
+		ec2API.On("DescribeInstanceTypes", &ec2.DescribeInstanceTypesInput{NextToken: aws.String("token")}).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{{InstanceType: aws.String("m5.xlarge")}},
+		}, nil).Once()
+
+		api := newEC2("us-west-2", "AKID")
+		for i := 0; i < 2; i++ {
+			var instanceTypes []string
+			var token *string
+			for {
+				output, err := api.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{NextToken: token})
+				Expect(err).NotTo(HaveOccurred())
+				for _, it := range output.InstanceTypes {
+					instanceTypes = append(instanceTypes, *it.InstanceType)
+				}
+				if token = output.NextToken; token == nil {
+					break
+				}
+			}
+			Expect(instanceTypes).To(Equal([]string{"m5.large", "m5.xlarge"}))
+		}
+		ec2API.AssertNumberOfCalls(GinkgoT(), "DescribeInstanceTypes", 2)
+	})
+
+	It("passes other calls through", func() {
+		ec2API.On("DescribeVpcs", mock.Anything).Return(&ec2.DescribeVpcsOutput{}, nil)
+
+		api := newEC2("us-west-2", "AKID")
+		for i := 0; i < 2; i++ {
+			_, err := api.DescribeVpcs(&ec2.DescribeVpcsInput{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		ec2API.AssertNumberOfCalls(GinkgoT(), "DescribeVpcs", 2)
+	})
+})
@@ -0,0 +1,7 @@
+package awscache
+
+import "time"
+
+func (c *Cache) SetClock(clock func() time.Time) {
+	c.clock = clock
+}
@@ -0,0 +1,237 @@
+package builder
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	gfncloudwatch "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudwatch"
+	gfnlogs "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/logs"
+	gfnsns "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/sns"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
+)
+
+const (
+	alarmsTemplateDescription = "CloudWatch alarms"
+	alarmsTopic               = "AlarmsTopic"
+
+	// APIServerRequestsMetric is the metric counting the completed requests to the API server
+	APIServerRequestsMetric = "APIServerRequests"
+	// APIServerErrorsMetric is the metric counting the requests to the API server that failed with a server error
+	APIServerErrorsMetric = "APIServerErrors"
+
+	alarmPeriod = 60
+)
+
+// NodeGroupAutoScalingGroup is an Auto Scaling group of a nodegroup
+type NodeGroupAutoScalingGroup struct {
+	NodeGroup string
+	Name      string
+}
+
+// AlarmTargets holds the resources of the cluster monitored by the alarms
+type AlarmTargets struct {
+	// AutoScalingGroups are the Auto Scaling groups of the nodegroups
+	AutoScalingGroups []NodeGroupAutoScalingGroup
+	// NATGatewayIDs are the IDs of the NAT gateways of the cluster VPC
+	NATGatewayIDs []string
+}
+
+// AlarmsResourceSet holds the CloudWatch alarms stack build-time information
+type AlarmsResourceSet struct {
+	rs          *resourceSet
+	clusterName string
+	alarms      *api.ClusterAlarms
+	targets     AlarmTargets
+	logicalIDs  map[string]struct{}
+
+	// TopicARN is the ARN of the SNS topic the alarms notify
+	TopicARN string
+}
+
+// NewAlarmsResourceSet returns a resource set for the alarms of a cluster, and the SNS topic they notify
+func NewAlarmsResourceSet(clusterName string, alarms *api.ClusterAlarms, targets AlarmTargets) *AlarmsResourceSet {
+	return &AlarmsResourceSet{
+		rs:          newResourceSet(),
+		clusterName: clusterName,
+		alarms:      alarms,
+		targets:     targets,
+		logicalIDs:  map[string]struct{}{},
+	}
+}
+
+// APIServerMetricsNamespace returns the namespace of the metrics extracted from the audit logs of the cluster
+func APIServerMetricsNamespace(clusterName string) string {
+	return fmt.Sprintf("eksctl/%s", clusterName)
+}
+
+// WithIAM returns false
+func (*AlarmsResourceSet) WithIAM() bool { return false }
+
+// WithNamedIAM returns false
+func (*AlarmsResourceSet) WithNamedIAM() bool { return false }
+
+// AddAllResources adds all resources for the stack
+func (a *AlarmsResourceSet) AddAllResources() error {
+	a.rs.template.Description = fmt.Sprintf("%s for cluster %q %s", alarmsTemplateDescription, a.clusterName, templateDescriptionSuffix)
+
+	var topic *gfnt.Value
+	if a.alarms.SNSTopicARN != "" {
+		topic = gfnt.NewString(a.alarms.SNSTopicARN)
+	} else {
+		var subscriptions []gfnsns.Topic_Subscription
+		for _, email := range a.alarms.Emails {
+			subscriptions = append(subscriptions, gfnsns.Topic_Subscription{
+				Protocol: gfnt.NewString("email"),
+				Endpoint: gfnt.NewString(email),
+			})
+		}
+		topic = a.rs.newResource(alarmsTopic, &gfnsns.Topic{
+			DisplayName:  gfnt.NewString(fmt.Sprintf("eksctl alarms for cluster %s", a.clusterName)),
+			Subscription: subscriptions,
+		})
+	}
+	a.rs.defineOutput(outputs.AlarmsTopicARN, topic, false, func(v string) error {
+		a.TopicARN = v
+		return nil
+	})
+
+	if alarm := a.alarms.NodeCount; alarm != nil {
+		for _, asg := range a.targets.AutoScalingGroups {
+			a.addNodeCountAlarm(alarm, asg, topic)
+		}
+	}
+	if alarm := a.alarms.NATGatewayBandwidth; alarm != nil {
+		for _, natGatewayID := range a.targets.NATGatewayIDs {
+			a.addNATGatewayBandwidthAlarm(alarm, natGatewayID, topic)
+		}
+	}
+	if alarm := a.alarms.APIErrorRate; alarm != nil {
+		a.addAPIErrorRateAlarm(alarm, topic)
+	}
+	return nil
+}
+
+func (a *AlarmsResourceSet) addNodeCountAlarm(alarm *api.NodeCountAlarm, asg NodeGroupAutoScalingGroup, topic *gfnt.Value) {
+	dimensions := []gfncloudwatch.Alarm_Dimension{{
+		Name:  gfnt.NewString("AutoScalingGroupName"),
+		Value: gfnt.NewString(asg.Name),
+	}}
+	a.rs.newResource(a.uniqueLogicalID("NodeCountAlarm"+nonAlphanumeric.ReplaceAllString(asg.NodeGroup, "")), &gfncloudwatch.Alarm{
+		AlarmDescription:   gfnt.NewString(fmt.Sprintf("Nodegroup %q of cluster %q has fewer nodes in service than desired", asg.NodeGroup, a.clusterName)),
+		ComparisonOperator: gfnt.NewString("GreaterThanOrEqualToThreshold"),
+		Threshold:          gfnt.NewDouble(float64(*alarm.MissingNodes)),
+		EvaluationPeriods:  gfnt.NewInteger(*alarm.EvaluationMinutes),
+		TreatMissingData:   gfnt.NewString("notBreaching"),
+		Metrics: []gfncloudwatch.Alarm_MetricDataQuery{
+			makeMetricQuery("desired", "AWS/AutoScaling", "GroupDesiredCapacity", "Average", dimensions),
+			makeMetricQuery("inService", "AWS/AutoScaling", "GroupInServiceInstances", "Average", dimensions),
+			{
+				Id:         gfnt.NewString("missing"),
+				Label:      gfnt.NewString("Missing nodes"),
+				Expression: gfnt.NewString("desired - inService"),
+				ReturnData: gfnt.True(),
+			},
+		},
+		AlarmActions: gfnt.NewSlice(topic),
+		OKActions:    gfnt.NewSlice(topic),
+	})
+}
+
+func (a *AlarmsResourceSet) addNATGatewayBandwidthAlarm(alarm *api.NATGatewayBandwidthAlarm, natGatewayID string, topic *gfnt.Value) {
+	a.rs.newResource(a.uniqueLogicalID("NATGatewayBandwidthAlarm"+nonAlphanumeric.ReplaceAllString(natGatewayID, "")), &gfncloudwatch.Alarm{
+		AlarmDescription:   gfnt.NewString(fmt.Sprintf("NAT gateway %q of cluster %q sends more than %d bytes per minute", natGatewayID, a.clusterName, alarm.BytesPerMinute)),
+		Namespace:          gfnt.NewString("AWS/NATGateway"),
+		MetricName:         gfnt.NewString("BytesOutToDestination"),
+		Dimensions:         []gfncloudwatch.Alarm_Dimension{{Name: gfnt.NewString("NatGatewayId"), Value: gfnt.NewString(natGatewayID)}},
+		Statistic:          gfnt.NewString("Sum"),
+		Period:             gfnt.NewInteger(alarmPeriod),
+		ComparisonOperator: gfnt.NewString("GreaterThanThreshold"),
+		Threshold:          gfnt.NewDouble(float64(alarm.BytesPerMinute)),
+		EvaluationPeriods:  gfnt.NewInteger(*alarm.EvaluationMinutes),
+		TreatMissingData:   gfnt.NewString("notBreaching"),
+		AlarmActions:       gfnt.NewSlice(topic),
+		OKActions:          gfnt.NewSlice(topic),
+	})
+}
+
+func (a *AlarmsResourceSet) addAPIErrorRateAlarm(alarm *api.APIErrorRateAlarm, topic *gfnt.Value) {
+	namespace := APIServerMetricsNamespace(a.clusterName)
+	logGroupName := gfnt.NewString(fmt.Sprintf("/aws/eks/%s/cluster", a.clusterName))
+	for _, filter := range []struct{ metricName, pattern string }{
+		{APIServerRequestsMetric, `{ $.stage = "ResponseComplete" }`},
+		{APIServerErrorsMetric, `{ $.stage = "ResponseComplete" && $.responseStatus.code >= 500 }`},
+	} {
+		a.rs.newResource(filter.metricName+"MetricFilter", &gfnlogs.MetricFilter{
+			LogGroupName:  logGroupName,
+			FilterPattern: gfnt.NewString(filter.pattern),
+			MetricTransformations: []gfnlogs.MetricFilter_MetricTransformation{{
+				MetricNamespace: gfnt.NewString(namespace),
+				MetricName:      gfnt.NewString(filter.metricName),
+				MetricValue:     gfnt.NewString("1"),
+				DefaultValue:    gfnt.NewDouble(0),
+			}},
+		})
+	}
+
+	a.rs.newResource("APIErrorRateAlarm", &gfncloudwatch.Alarm{
+		AlarmDescription:   gfnt.NewString(fmt.Sprintf("More than %d%% of the requests to the API server of cluster %q fail with a server error", *alarm.ThresholdPercent, a.clusterName)),
+		ComparisonOperator: gfnt.NewString("GreaterThanThreshold"),
+		Threshold:          gfnt.NewDouble(float64(*alarm.ThresholdPercent)),
+		EvaluationPeriods:  gfnt.NewInteger(*alarm.EvaluationMinutes),
+		TreatMissingData:   gfnt.NewString("notBreaching"),
+		Metrics: []gfncloudwatch.Alarm_MetricDataQuery{
+			makeMetricQuery("requests", namespace, APIServerRequestsMetric, "Sum", nil),
+			makeMetricQuery("errors", namespace, APIServerErrorsMetric, "Sum", nil),
+			{
+				Id:         gfnt.NewString("errorRate"),
+				Label:      gfnt.NewString("API server error rate (%)"),
+				Expression: gfnt.NewString("IF(requests > 0, 100 * errors / requests, 0)"),
+				ReturnData: gfnt.True(),
+			},
+		},
+		AlarmActions: gfnt.NewSlice(topic),
+		OKActions:    gfnt.NewSlice(topic),
+	})
+}
+
+// uniqueLogicalID returns the given logical ID, with a numeric suffix when it is already in use
+func (a *AlarmsResourceSet) uniqueLogicalID(logicalID string) string {
+	id := logicalID
+	for i := 2; ; i++ {
+		if _, ok := a.logicalIDs[id]; !ok {
+			break
+		}
+		id = fmt.Sprintf("%s%d", logicalID, i)
+	}
+	a.logicalIDs[id] = struct{}{}
+	return id
+}
+
+func makeMetricQuery(id, namespace, metricName, stat string, dimensions []gfncloudwatch.Alarm_Dimension) gfncloudwatch.Alarm_MetricDataQuery {
+	return gfncloudwatch.Alarm_MetricDataQuery{
+		Id: gfnt.NewString(id),
+		MetricStat: &gfncloudwatch.Alarm_MetricStat{
+			Metric: &gfncloudwatch.Alarm_Metric{
+				Namespace:  gfnt.NewString(namespace),
+				MetricName: gfnt.NewString(metricName),
+				Dimensions: dimensions,
+			},
+			Period: gfnt.NewInteger(alarmPeriod),
+			Stat:   gfnt.NewString(stat),
+		},
+		ReturnData: gfnt.False(),
+	}
+}
+
+// RenderJSON will render the alarms stack as JSON
+func (a *AlarmsResourceSet) RenderJSON() ([]byte, error) {
+	return a.rs.renderJSON()
+}
+
+// GetAllOutputs will get all outputs from the alarms stack
+func (a *AlarmsResourceSet) GetAllOutputs(stack cfn.Stack) error {
+	return a.rs.GetAllOutputs(stack)
+}
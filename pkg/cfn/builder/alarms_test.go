@@ -0,0 +1,93 @@
+package builder_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("alarms stack", func() {
+	var (
+		cfg     *api.ClusterConfig
+		targets builder.AlarmTargets
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"audit"}
+		cfg.Alarms = &api.ClusterAlarms{
+			Emails:              []string{"ops@example.com"},
+			NodeCount:           &api.NodeCountAlarm{},
+			NATGatewayBandwidth: &api.NATGatewayBandwidthAlarm{BytesPerMinute: 1000},
+			APIErrorRate:        &api.APIErrorRateAlarm{},
+		}
+		api.SetClusterConfigDefaults(cfg)
+		targets = builder.AlarmTargets{
+			AutoScalingGroups: []builder.NodeGroupAutoScalingGroup{
+				{NodeGroup: "ng-1", Name: "eksctl-my-cluster-nodegroup-ng-1-NodeGroup-ABC"},
+				{NodeGroup: "ng1", Name: "eks-ng1-123"},
+			},
+			NATGatewayIDs: []string{"nat-0123"},
+		}
+	})
+
+	renderResources := func() map[string]interface{} {
+		rs := builder.NewAlarmsResourceSet(cfg.Metadata.Name, cfg.Alarms, targets)
+		Expect(rs.AddAllResources()).To(Succeed())
+		templateBody, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		var template map[string]interface{}
+		Expect(json.Unmarshal(templateBody, &template)).To(Succeed())
+		return template["Resources"].(map[string]interface{})
+	}
+
+	properties := func(resources map[string]interface{}, name string) map[string]interface{} {
+		ExpectWithOffset(1, resources).To(HaveKey(name))
+		return resources[name].(map[string]interface{})["Properties"].(map[string]interface{})
+	}
+
+	It("creates a topic with email subscriptions, and alarms notifying it", func() {
+		resources := renderResources()
+		Expect(resources).To(HaveLen(7))
+
+		topic := properties(resources, "AlarmsTopic")
+		Expect(topic["Subscription"]).To(Equal([]interface{}{
+			map[string]interface{}{"Endpoint": "ops@example.com", "Protocol": "email"},
+		}))
+
+		nodeCount := properties(resources, "NodeCountAlarmng1")
+		Expect(nodeCount["Threshold"]).To(Equal(float64(1)))
+		Expect(nodeCount["EvaluationPeriods"]).To(Equal(float64(10)))
+		Expect(nodeCount["AlarmActions"]).To(Equal([]interface{}{map[string]interface{}{"Ref": "AlarmsTopic"}}))
+		metrics := nodeCount["Metrics"].([]interface{})
+		Expect(metrics).To(HaveLen(3))
+		Expect(metrics[2].(map[string]interface{})["Expression"]).To(Equal("desired - inService"))
+		Expect(properties(resources, "NodeCountAlarmng12")["AlarmDescription"]).To(ContainSubstring(`"ng1"`))
+
+		natGateway := properties(resources, "NATGatewayBandwidthAlarmnat0123")
+		Expect(natGateway["Dimensions"]).To(Equal([]interface{}{
+			map[string]interface{}{"Name": "NatGatewayId", "Value": "nat-0123"},
+		}))
+		Expect(natGateway["Threshold"]).To(Equal(float64(1000)))
+
+		errorsFilter := properties(resources, "APIServerErrorsMetricFilter")
+		Expect(errorsFilter["LogGroupName"]).To(Equal("/aws/eks/my-cluster/cluster"))
+		Expect(errorsFilter["FilterPattern"]).To(ContainSubstring("$.responseStatus.code >= 500"))
+		Expect(resources).To(HaveKey("APIServerRequestsMetricFilter"))
+		Expect(properties(resources, "APIErrorRateAlarm")["Threshold"]).To(Equal(float64(5)))
+	})
+
+	It("notifies an existing topic", func() {
+		cfg.Alarms.Emails = nil
+		cfg.Alarms.SNSTopicARN = "arn:aws:sns:us-west-2:123456789012:ops"
+		resources := renderResources()
+
+		Expect(resources).NotTo(HaveKey("AlarmsTopic"))
+		Expect(properties(resources, "APIErrorRateAlarm")["AlarmActions"]).To(Equal([]interface{}{"arn:aws:sns:us-west-2:123456789012:ops"}))
+	})
+})
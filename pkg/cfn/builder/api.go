@@ -6,9 +6,9 @@ import (
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
-	gfn "github.com/weaveworks/goformation/v4/cloudformation"
-	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	gfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation"
+	gfncfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudformation"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 const (
@@ -7,9 +7,9 @@ import (
 	. "github.com/onsi/gomega"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"github.com/weaveworks/eksctl/pkg/testutils"
 	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 )
 
 func TestCfnBuilder(t *testing.T) {
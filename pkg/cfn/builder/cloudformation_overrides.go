@@ -0,0 +1,50 @@
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// applyCloudFormationOverrides adds the extra resources to the rendered template, then applies the JSON patches
+func applyCloudFormationOverrides(templateBody []byte, overrides *api.CloudFormationOverrides) ([]byte, error) {
+	if overrides == nil {
+		return templateBody, nil
+	}
+
+	if len(overrides.ExtraResources) > 0 {
+		var template map[string]interface{}
+		if err := json.Unmarshal(templateBody, &template); err != nil {
+			return nil, errors.Wrap(err, "parsing rendered template")
+		}
+		resources, ok := template["Resources"].(map[string]interface{})
+		if !ok {
+			resources = map[string]interface{}{}
+			template["Resources"] = resources
+		}
+		for logicalID, resource := range overrides.ExtraResources {
+			if _, exists := resources[logicalID]; exists {
+				return nil, fmt.Errorf("extra resource %q conflicts with a resource of the template, use a patch to change it", logicalID)
+			}
+			resources[logicalID] = map[string]interface{}(resource)
+		}
+		var err error
+		if templateBody, err = json.Marshal(template); err != nil {
+			return nil, errors.Wrap(err, "rendering template with extra resources")
+		}
+	}
+
+	if len(overrides.Patches) > 0 {
+		patch, err := overrides.DecodePatches()
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding CloudFormation patches")
+		}
+		if templateBody, err = patch.Apply(templateBody); err != nil {
+			return nil, errors.Wrap(err, "applying CloudFormation patches")
+		}
+	}
+	return templateBody, nil
+}
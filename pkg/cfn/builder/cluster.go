@@ -10,14 +10,16 @@ import (
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
-	gfn "github.com/weaveworks/goformation/v4/cloudformation"
-	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfneks "github.com/weaveworks/goformation/v4/cloudformation/eks"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	gfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation"
+	gfncfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudformation"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfneks "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/eks"
+	gfnroute53 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/route53"
+	gfnroute53resolver "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/route53resolver"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 // ClusterResourceSet stores the resource information of the cluster
@@ -78,6 +80,14 @@ func (c *ClusterResourceSet) AddAllResources() error {
 	c.addResourcesForIAM()
 	c.addResourcesForControlPlane(subnetDetails)
 
+	if c.spec.VPC.APIServerDNS != nil {
+		c.addResourcesForAPIServerDNS(vpcID)
+	}
+
+	if c.spec.VPC.DNSResolver != nil {
+		c.addResourcesForDNSResolver(vpcID)
+	}
+
 	if len(c.spec.FargateProfiles) > 0 {
 		c.addResourcesForFargate()
 	}
@@ -201,7 +211,11 @@ func (c *ClusterResourceSet) addResourcesForSecurityGroups(vpcID *gfnt.Value) *c
 
 // RenderJSON returns the rendered JSON
 func (c *ClusterResourceSet) RenderJSON() ([]byte, error) {
-	return c.rs.renderJSON()
+	templateBody, err := c.rs.renderJSON()
+	if err != nil {
+		return nil, err
+	}
+	return applyCloudFormationOverrides(templateBody, c.spec.CloudFormation)
 }
 
 // Template returns the CloudFormation template
@@ -245,7 +259,12 @@ func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *SubnetDe
 		PublicAccessCidrs:     gfnt.NewStringSlice(c.spec.VPC.PublicAccessCIDRs...),
 	}
 
-	clusterVPC.SubnetIds = gfnt.NewSlice(append(subnetDetails.PublicSubnetRefs(), subnetDetails.PrivateSubnetRefs()...)...)
+	if c.spec.IPv6Native() {
+		// the control plane ENIs require IPv4 addresses, which the IPv6-only private subnets do not have
+		clusterVPC.SubnetIds = gfnt.NewSlice(subnetDetails.PublicSubnetRefs()...)
+	} else {
+		clusterVPC.SubnetIds = gfnt.NewSlice(append(subnetDetails.PublicSubnetRefs(), subnetDetails.PrivateSubnetRefs()...)...)
+	}
 
 	serviceRoleARN := gfnt.MakeFnGetAttString("ServiceRole", "Arn")
 	if api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRoleARN) {
@@ -288,6 +307,12 @@ func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *SubnetDe
 	}
 	cluster.KubernetesNetworkConfig = kubernetesNetworkConfig
 
+	if c.spec.UpgradePolicy != nil {
+		cluster.UpgradePolicy = &gfneks.Cluster_UpgradePolicy{
+			SupportType: gfnt.NewString(c.spec.UpgradePolicy.SupportType),
+		}
+	}
+
 	c.newResource("ControlPlane", &cluster)
 
 	if c.spec.Status == nil {
@@ -323,6 +348,59 @@ func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *SubnetDe
 	}
 }
 
+// addResourcesForAPIServerDNS adds a CNAME of the API server endpoint, in a private hosted zone associated with the VPC
+func (c *ClusterResourceSet) addResourcesForAPIServerDNS(vpcID *gfnt.Value) {
+	dns := c.spec.VPC.APIServerDNS
+
+	hostedZoneID := gfnt.NewString(dns.HostedZoneID)
+	if dns.HostedZoneID == "" {
+		hostedZoneID = c.newResource("APIServerHostedZone", &gfnroute53.HostedZone{
+			Name: gfnt.NewString(dns.HostedZoneName()),
+			HostedZoneConfig: &gfnroute53.HostedZone_HostedZoneConfig{
+				Comment: gfnt.NewString(fmt.Sprintf("API server of EKS cluster %q", c.spec.Metadata.Name)),
+			},
+			VPCs: []gfnroute53.HostedZone_VPC{
+				{
+					VPCId:     vpcID,
+					VPCRegion: gfnt.RefRegion,
+				},
+			},
+		})
+	}
+
+	// the endpoint attribute of the cluster is a URL, e.g. https://ABCDEF.gr7.us-west-2.eks.amazonaws.com
+	endpointHost := gfnt.MakeFnSelect(gfnt.NewInteger(1), gfnt.MakeFnSplit("//", gfnt.MakeFnGetAttString("ControlPlane", "Endpoint")))
+	c.newResource("APIServerRecordSet", &gfnroute53.RecordSet{
+		HostedZoneId:    hostedZoneID,
+		Name:            gfnt.NewString(dns.Name),
+		Type:            gfnt.NewString("CNAME"),
+		TTL:             gfnt.NewString("300"),
+		ResourceRecords: gfnt.NewSlice(endpointHost),
+	})
+}
+
+func (c *ClusterResourceSet) addResourcesForDNSResolver(vpcID *gfnt.Value) {
+	resolver := c.spec.VPC.DNSResolver
+
+	if resolver.QueryLogDestinationARN != "" {
+		queryLogConfigID := c.newResource("ResolverQueryLoggingConfig", &gfnroute53resolver.ResolverQueryLoggingConfig{
+			Name:           gfnt.NewString(c.spec.Metadata.Name),
+			DestinationArn: gfnt.NewString(resolver.QueryLogDestinationARN),
+		})
+		c.newResource("ResolverQueryLoggingConfigAssociation", &gfnroute53resolver.ResolverQueryLoggingConfigAssociation{
+			ResolverQueryLogConfigId: queryLogConfigID,
+			ResourceId:               vpcID,
+		})
+	}
+
+	for i, ruleID := range resolver.RuleIDs {
+		c.newResource(fmt.Sprintf("ResolverRuleAssociation%d", i), &gfnroute53resolver.ResolverRuleAssociation{
+			ResolverRuleId: gfnt.NewString(ruleID),
+			VPCId:          vpcID,
+		})
+	}
+}
+
 func makeCFNTags(clusterConfig *api.ClusterConfig) []gfncfn.Tag {
 	var tags []gfncfn.Tag
 	for k, v := range clusterConfig.Metadata.Tags {
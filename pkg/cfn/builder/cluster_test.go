@@ -2,6 +2,7 @@ package builder_test
 
 import (
 	"encoding/json"
+	"fmt"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -75,6 +76,17 @@ var _ = Describe("Cluster Template Builder", func() {
 			Expect(clusterTemplate.Resources["ControlPlane"].Properties.EncryptionConfig).To(BeNil())
 			Expect(clusterTemplate.Resources["ControlPlane"].Properties.KubernetesNetworkConfig.ServiceIPv4CIDR).To(Equal("131.10.55.70/18"))
 			Expect(clusterTemplate.Resources["ControlPlane"].Properties.KubernetesNetworkConfig.IPFamily).To(Equal("ipv4"))
+			Expect(clusterTemplate.Resources["ControlPlane"].Properties.UpgradePolicy).To(BeNil())
+		})
+
+		Context("when upgradePolicy is set", func() {
+			BeforeEach(func() {
+				cfg.UpgradePolicy = &api.UpgradePolicy{SupportType: api.SupportTypeStandard}
+			})
+
+			It("should set the support type of the control plane", func() {
+				Expect(clusterTemplate.Resources["ControlPlane"].Properties.UpgradePolicy.SupportType).To(Equal("STANDARD"))
+			})
 		})
 
 		It("should add vpc resources", func() {
@@ -138,6 +150,19 @@ var _ = Describe("Cluster Template Builder", func() {
 				Expect(clusterTemplate.Resources).To(HaveKey(builder.PrivateRouteTableAssociation + azAFormatted))
 				Expect(clusterTemplate.Resources).To(HaveKey(builder.PrivateRouteTableAssociation + azBFormatted))
 			})
+
+			Context("when the VPC is IPv6-native", func() {
+				BeforeEach(func() {
+					cfg.VPC.IPFamily = api.IPV6Family
+				})
+
+				It("should launch the control plane in the public subnets only", func() {
+					Expect(clusterTemplate.Resources["ControlPlane"].Properties.ResourcesVpcConfig.SubnetIds).To(ConsistOf(
+						map[string]interface{}{"Ref": builder.PublicSubnetKey + azAFormatted},
+						map[string]interface{}{"Ref": builder.PublicSubnetKey + azBFormatted},
+					))
+				})
+			})
 		})
 
 		Context("when AutoAllocateIPv6 is enabled", func() {
@@ -317,6 +342,19 @@ var _ = Describe("Cluster Template Builder", func() {
 			})
 		})
 
+		Context("when naming is set", func() {
+			BeforeEach(func() {
+				cfg.Metadata.Name = "bonsai"
+				cfg.Metadata.Region = "us-west-2"
+				cfg.Naming = &api.Naming{Prefix: "acme-", Suffix: "-{region}"}
+			})
+
+			It("names the service role", func() {
+				Expect(crs.WithNamedIAM()).To(BeTrue())
+				Expect(clusterTemplate.Resources["ServiceRole"].Properties.RoleName).To(Equal("acme-bonsai-ServiceRole-us-west-2"))
+			})
+		})
+
 		Context("when VPCResourceControllerPolicy is disabled", func() {
 			BeforeEach(func() {
 				policy := false
@@ -412,7 +450,7 @@ var _ = Describe("Cluster Template Builder", func() {
 					Expect(clusterTemplate.Resources).To(HaveKey(builder.IPv6CIDRBlockKey))
 					Expect(clusterTemplate.Resources).NotTo(HaveKey(builder.IGWKey))
 					Expect(clusterTemplate.Resources).NotTo(HaveKey(builder.GAKey))
-					Expect(clusterTemplate.Resources).NotTo(HaveKey(builder.EgressOnlyInternetGatewayKey))
+					Expect(clusterTemplate.Resources).To(HaveKey(builder.EgressOnlyInternetGatewayKey))
 					Expect(clusterTemplate.Resources).NotTo(HaveKey(builder.NATGatewayKey))
 					Expect(clusterTemplate.Resources).NotTo(HaveKey(builder.ElasticIPKey))
 					Expect(clusterTemplate.Resources).NotTo(HaveKey(builder.PubRouteTableKey))
@@ -427,9 +465,9 @@ var _ = Describe("Cluster Template Builder", func() {
 					privateRouteB := builder.PrivateSubnetRouteKey + azBFormatted
 					Expect(clusterTemplate.Resources).NotTo(HaveKey(privateRouteB))
 					privateRouteA = builder.PrivateSubnetIpv6RouteKey + azAFormatted
-					Expect(clusterTemplate.Resources).NotTo(HaveKey(privateRouteA))
+					Expect(clusterTemplate.Resources).To(HaveKey(privateRouteA))
 					privateRouteB = builder.PrivateSubnetIpv6RouteKey + azBFormatted
-					Expect(clusterTemplate.Resources).NotTo(HaveKey(privateRouteB))
+					Expect(clusterTemplate.Resources).To(HaveKey(privateRouteB))
 					Expect(clusterTemplate.Resources).NotTo(HaveKey(builder.PublicSubnetKey + azAFormatted))
 					Expect(clusterTemplate.Resources).NotTo(HaveKey(builder.PublicSubnetKey + azBFormatted))
 					Expect(clusterTemplate.Resources).To(HaveKey(builder.PrivateSubnetKey + azAFormatted))
@@ -439,6 +477,10 @@ var _ = Describe("Cluster Template Builder", func() {
 					Expect(clusterTemplate.Resources).To(HaveKey(builder.PrivateRouteTableAssociation + azAFormatted))
 					Expect(clusterTemplate.Resources).To(HaveKey(builder.PrivateRouteTableAssociation + azBFormatted))
 				})
+
+				It("adds the VPC endpoints", func() {
+					Expect(clusterTemplate.Resources).To(HaveKey("VPCEndpointEC2"))
+				})
 			})
 
 			When("skip endpoint creation is set", func() {
@@ -570,6 +612,78 @@ var _ = Describe("Cluster Template Builder", func() {
 			})
 		})
 
+		Context("when apiServerDNS is set", func() {
+			BeforeEach(func() {
+				cfg.VPC.APIServerDNS = &api.APIServerDNS{Name: "api.mycluster.internal"}
+			})
+
+			It("should add a private hosted zone with a CNAME of the endpoint", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("APIServerHostedZone"))
+				zone := clusterTemplate.Resources["APIServerHostedZone"].Properties
+				Expect(zone.Name).To(Equal("mycluster.internal"))
+				Expect(zone.VPCs).To(HaveLen(1))
+				Expect(zone.VPCs[0].VPCId).To(Equal(map[string]interface{}{"Ref": "VPC"}))
+
+				Expect(clusterTemplate.Resources).To(HaveKey("APIServerRecordSet"))
+				record := clusterTemplate.Resources["APIServerRecordSet"].Properties
+				Expect(record.Name).To(Equal("api.mycluster.internal"))
+				Expect(record.HostedZoneID).To(Equal(map[string]interface{}{"Ref": "APIServerHostedZone"}))
+				Expect(record.ResourceRecords).To(ConsistOf(map[string]interface{}{
+					"Fn::Select": []interface{}{
+						float64(1),
+						map[string]interface{}{"Fn::Split": []interface{}{"//", map[string]interface{}{"Fn::GetAtt": []interface{}{"ControlPlane", "Endpoint"}}}},
+					},
+				}))
+			})
+
+			Context("with an existing hosted zone", func() {
+				BeforeEach(func() {
+					cfg.VPC.APIServerDNS.HostedZoneID = "Z0123456789"
+				})
+
+				It("should only add the record", func() {
+					Expect(clusterTemplate.Resources).NotTo(HaveKey("APIServerHostedZone"))
+					Expect(clusterTemplate.Resources["APIServerRecordSet"].Properties.HostedZoneID).To(Equal("Z0123456789"))
+				})
+			})
+		})
+
+		Context("when dnsResolver is set", func() {
+			BeforeEach(func() {
+				cfg.VPC.DNSResolver = &api.DNSResolver{
+					QueryLogDestinationARN: "arn:aws:logs:us-west-2:123456789012:log-group:dns-queries",
+					RuleIDs:                []string{"rslvr-rr-1", "rslvr-rr-2"},
+				}
+			})
+
+			It("should log the DNS queries of the VPC and associate the resolver rules with it", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("ResolverQueryLoggingConfig"))
+				Expect(clusterTemplate.Resources["ResolverQueryLoggingConfig"].Properties.DestinationArn).To(Equal("arn:aws:logs:us-west-2:123456789012:log-group:dns-queries"))
+				association := clusterTemplate.Resources["ResolverQueryLoggingConfigAssociation"].Properties
+				Expect(association.ResolverQueryLogConfigID).To(Equal(map[string]interface{}{"Ref": "ResolverQueryLoggingConfig"}))
+				Expect(association.ResourceID).To(Equal(map[string]interface{}{"Ref": "VPC"}))
+
+				for i, ruleID := range []string{"rslvr-rr-1", "rslvr-rr-2"} {
+					ruleAssociation := clusterTemplate.Resources[fmt.Sprintf("ResolverRuleAssociation%d", i)]
+					Expect(ruleAssociation.Type).To(Equal("AWS::Route53Resolver::ResolverRuleAssociation"))
+					Expect(ruleAssociation.Properties.ResolverRuleID).To(Equal(ruleID))
+					Expect(ruleAssociation.Properties.VpcID).To(Equal(map[string]interface{}{"Ref": "VPC"}))
+				}
+			})
+
+			Context("without query logging", func() {
+				BeforeEach(func() {
+					cfg.VPC.DNSResolver.QueryLogDestinationARN = ""
+				})
+
+				It("should only associate the resolver rules", func() {
+					Expect(clusterTemplate.Resources).NotTo(HaveKey("ResolverQueryLoggingConfig"))
+					Expect(clusterTemplate.Resources).NotTo(HaveKey("ResolverQueryLoggingConfigAssociation"))
+					Expect(clusterTemplate.Resources).To(HaveKey("ResolverRuleAssociation1"))
+				})
+			})
+		})
+
 		Context("when the spec has insufficient subnets", func() {
 			BeforeEach(func() {
 				cfg.VPC.Subnets = &api.ClusterSubnets{}
@@ -608,6 +722,61 @@ var _ = Describe("Cluster Template Builder", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result).To(ContainSubstring(vpcResourceKey))
 		})
+
+		Context("when cloudFormation overrides are set", func() {
+			BeforeEach(func() {
+				cfg.CloudFormation = &api.CloudFormationOverrides{
+					ExtraResources: map[string]api.InlineDocument{
+						"AuditBucket": {
+							"Type": "AWS::S3::Bucket",
+						},
+					},
+					Patches: []api.InlineDocument{
+						{
+							"op":    "add",
+							"path":  "/Resources/ControlPlane/Properties/Tags/-",
+							"value": map[string]interface{}{"Key": "cost-center", "Value": "platform"},
+						},
+						{
+							"op":    "add",
+							"path":  "/Resources/AuditBucket/Properties",
+							"value": map[string]interface{}{"VersioningConfiguration": map[string]interface{}{"Status": "Enabled"}},
+						},
+					},
+				}
+			})
+
+			It("adds the extra resources and applies the patches", func() {
+				Expect(crs.AddAllResources()).To(Succeed())
+				result, err := crs.RenderJSON()
+				Expect(err).NotTo(HaveOccurred())
+
+				clusterTemplate := &fakes.FakeTemplate{}
+				Expect(json.Unmarshal(result, clusterTemplate)).To(Succeed())
+				Expect(clusterTemplate.Resources).To(HaveKey("AuditBucket"))
+				Expect(clusterTemplate.Resources["AuditBucket"].Type).To(Equal("AWS::S3::Bucket"))
+				Expect(clusterTemplate.Resources["ControlPlane"].Properties.Tags).To(ContainElement(fakes.Tag{Key: "cost-center", Value: "platform"}))
+				Expect(string(result)).To(ContainSubstring(`"VersioningConfiguration":{"Status":"Enabled"}`))
+			})
+
+			It("errors when an extra resource conflicts with a resource of the template", func() {
+				cfg.CloudFormation.ExtraResources = map[string]api.InlineDocument{
+					"ControlPlane": {"Type": "AWS::EKS::Cluster"},
+				}
+				Expect(crs.AddAllResources()).To(Succeed())
+				_, err := crs.RenderJSON()
+				Expect(err).To(MatchError(ContainSubstring(`extra resource "ControlPlane" conflicts with a resource of the template`)))
+			})
+
+			It("errors when a patch cannot be applied", func() {
+				cfg.CloudFormation.Patches = []api.InlineDocument{
+					{"op": "remove", "path": "/Resources/DoesNotExist"},
+				}
+				Expect(crs.AddAllResources()).To(Succeed())
+				_, err := crs.RenderJSON()
+				Expect(err).To(MatchError(ContainSubstring("applying CloudFormation patches")))
+			})
+		})
 	})
 
 	Describe("Template", func() {
@@ -0,0 +1,45 @@
+package builder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
+)
+
+// makeCPUOptions returns the CPU options of the launch template. EC2 requires both the core count
+// and the threads per core to be set, so any value not set in cpuOptions is defaulted from the instance type
+func makeCPUOptions(cpuOptions *api.CPUOptions, instanceType string, ec2API ec2iface.EC2API) (*gfnec2.LaunchTemplate_CpuOptions, error) {
+	if cpuOptions == nil {
+		return nil, nil
+	}
+
+	coreCount, threadsPerCore := cpuOptions.CoreCount, cpuOptions.ThreadsPerCore
+	if coreCount == nil || threadsPerCore == nil {
+		output, err := ec2API.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+			InstanceTypes: aws.StringSlice([]string{instanceType}),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't retrieve instance type description for %s", instanceType)
+		}
+		if len(output.InstanceTypes) != 1 || output.InstanceTypes[0].VCpuInfo == nil {
+			return nil, errors.Errorf("couldn't retrieve the CPU information of instance type %s", instanceType)
+		}
+		vCPUInfo := output.InstanceTypes[0].VCpuInfo
+		if coreCount == nil {
+			coreCount = aws.Int(int(aws.Int64Value(vCPUInfo.DefaultCores)))
+		}
+		if threadsPerCore == nil {
+			threadsPerCore = aws.Int(int(aws.Int64Value(vCPUInfo.DefaultThreadsPerCore)))
+		}
+	}
+
+	return &gfnec2.LaunchTemplate_CpuOptions{
+		CoreCount:      gfnt.NewInteger(*coreCount),
+		ThreadsPerCore: gfnt.NewInteger(*threadsPerCore),
+	}, nil
+}
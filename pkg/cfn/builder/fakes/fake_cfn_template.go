@@ -55,6 +55,7 @@ type Properties struct {
 	LoadBalancerNames                 []string
 	MetricsCollection                 []map[string]interface{}
 	TargetGroupARNs                   []string
+	LifecycleHookSpecificationList    []map[string]interface{}
 	DesiredCapacity, MinSize, MaxSize string
 
 	CidrIP, CidrIPv6, IPProtocol string
@@ -66,15 +67,27 @@ type Properties struct {
 	DestinationCidrBlock, DestinationIpv6CidrBlock          interface{}
 	MapPublicIPOnLaunch                                     bool
 	AssignIpv6AddressOnCreation                             *bool
+	Ipv6Native, EnableDNS64                                 bool
+	PrivateDNSNameOptionsOnLaunch                           map[string]interface{}
 
 	Ipv6CidrBlock           interface{}
 	Ipv6Pool                string
 	CidrBlock               interface{}
 	KubernetesNetworkConfig KubernetesNetworkConfig
+	UpgradePolicy           *struct {
+		SupportType string
+	}
 
 	AmazonProvidedIpv6CidrBlock bool
 	AvailabilityZone, Domain    string
 
+	DestinationArn, ResolverQueryLogConfigID, ResourceID, ResolverRuleID interface{}
+	HostedZoneID                                                         interface{}
+	ResourceRecords                                                      []interface{}
+	VPCs                                                                 []struct {
+		VPCId, VPCRegion interface{}
+	}
+
 	Name, Version      string
 	RoleArn            interface{}
 	ResourcesVpcConfig struct {
@@ -148,6 +161,8 @@ type LaunchTemplateData struct {
 	BlockDeviceMappings             []BlockDeviceMappings
 	EbsOptimized                    *bool
 	Monitoring                      *Monitoring
+	EnclaveOptions                  *EnclaveOptions
+	CPUOptions                      *CPUOptions `json:"CpuOptions"`
 	NetworkInterfaces               []NetworkInterface
 	InstanceMarketOptions           *struct {
 		MarketType  string
@@ -177,6 +192,7 @@ type BlockDeviceMappings struct {
 type MetadataOptions struct {
 	HTTPPutResponseHopLimit float64
 	HTTPTokens              string
+	HTTPProtocolIPv6        string
 }
 
 type TagSpecification struct {
@@ -191,6 +207,15 @@ type NetworkInterface struct {
 	InterfaceType            string
 }
 
+type CPUOptions struct {
+	CoreCount      float64
+	ThreadsPerCore float64
+}
+
+type EnclaveOptions struct {
+	Enabled bool
+}
+
 type Monitoring struct {
 	Enabled bool
 }
@@ -7,12 +7,12 @@ import (
 	"github.com/weaveworks/eksctl/pkg/iam"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
-	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	gfniam "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/iam"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 )
 
@@ -94,6 +94,11 @@ func (c *ClusterResourceSet) addResourcesForIAM() {
 	if api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRolePermissionsBoundary) {
 		role.PermissionsBoundary = gfnt.NewString(*c.spec.IAM.ServiceRolePermissionsBoundary)
 	}
+	if roleName := c.spec.ServiceRoleName(); roleName != "" {
+		role.RoleName = gfnt.NewString(roleName)
+		// setting role name requires additional capabilities
+		c.rs.withNamedIAM = true
+	}
 	refSR := c.newResource("ServiceRole", role)
 	c.rs.attachAllowPolicy("PolicyCloudWatchMetrics", refSR, cloudWatchMetricsStatements())
 	// These are potentially required for creating load balancers but aren't included in the
@@ -160,12 +165,13 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() error {
 
 	// if neither role nor profile is given - create both
 
-	if n.spec.IAM.InstanceRoleName != "" {
+	roleName := instanceRoleName(n.clusterSpec, n.spec.NodeGroupBase)
+	if roleName != "" {
 		// setting role name requires additional capabilities
 		n.rs.withNamedIAM = true
 	}
 
-	if err := createRole(n.rs, n.clusterSpec.IAM, n.spec.IAM, false, n.forceAddCNIPolicy); err != nil {
+	if err := createRole(n.rs, n.clusterSpec, n.spec.IAM, roleName, false, n.forceAddCNIPolicy); err != nil {
 		return err
 	}
 
@@ -187,6 +193,9 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() error {
 }
 
 func NewIAMRoleResourceSetForServiceAccount(spec *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) *IAMRoleResourceSet {
+	if audience := spec.Annotations[api.AnnotationEKSAudience]; audience != "" {
+		oidc = oidc.WithAudience(audience)
+	}
 	return &IAMRoleResourceSet{
 		template:            cft.NewTemplate(),
 		attachPolicy:        spec.AttachPolicy,
@@ -243,6 +252,11 @@ func NewIAMRoleResourceSetWithWellKnownPolicies(name, namespace, serviceAccount,
 	return newIAMRoleResourceSet(name, namespace, serviceAccount, permissionsBoundary, nil, nil, wellKnownPolicies, oidc)
 }
 
+// NewIAMRoleResourceSetWithPolicies builds IAM Role stack from the given policies, any of which can be empty
+func NewIAMRoleResourceSetWithPolicies(name, namespace, serviceAccount, permissionsBoundary string, attachPolicy api.InlineDocument, attachPolicyARNs []string, wellKnownPolicies api.WellKnownPolicies, oidc *iamoidc.OpenIDConnectManager) *IAMRoleResourceSet {
+	return newIAMRoleResourceSet(name, namespace, serviceAccount, permissionsBoundary, attachPolicy, attachPolicyARNs, wellKnownPolicies, oidc)
+}
+
 // NewIAMRoleResourceSetForServiceAccount builds IAM Role stack from the give spec
 func newIAMRoleResourceSet(name, namespace, serviceAccount, permissionsBoundary string, attachPolicy api.InlineDocument, attachPolicyARNs []string, wellKnownPolicies api.WellKnownPolicies, oidc *iamoidc.OpenIDConnectManager) *IAMRoleResourceSet {
 	rs := &IAMRoleResourceSet{
@@ -302,6 +316,9 @@ func (rs *IAMRoleResourceSet) AddAllResources() error {
 		role.ManagedPolicyArns = append(role.ManagedPolicyArns, makePolicyARN(p.name))
 	}
 
+	customPolicyARNs, customPolicyDocuments := createCustomWellKnownPolicies(rs.wellKnownPolicies)
+	role.ManagedPolicyArns = append(role.ManagedPolicyArns, customPolicyARNs...)
+
 	roleRef := rs.template.NewResource("Role1", role)
 
 	for _, p := range customPolicies {
@@ -309,6 +326,10 @@ func (rs *IAMRoleResourceSet) AddAllResources() error {
 		rs.template.AttachPolicy(p.Name, roleRef, doc)
 	}
 
+	for _, p := range customPolicyDocuments {
+		rs.template.AttachPolicy(p.name, roleRef, p.document)
+	}
+
 	rs.template.Outputs["Role1"] = cft.Output{
 		Value: cft.MakeFnGetAttString("Role1.Arn"),
 	}
@@ -2,14 +2,15 @@ package builder
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
-	gfn "github.com/weaveworks/goformation/v4/cloudformation"
-	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	gfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation"
+	gfniam "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/iam"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -73,12 +74,75 @@ func createWellKnownPolicies(wellKnownPolicies api.WellKnownPolicies) ([]managed
 			customPolicyForRole{Name: "PolicyEFSCSIController", Statements: efsCSIControllerStatements()},
 		)
 	}
+	if wellKnownPolicies.ExternalSecrets {
+		customPolicies = append(customPolicies,
+			customPolicyForRole{Name: "PolicyExternalSecrets", Statements: externalSecretsStatements()},
+		)
+	}
+	if wellKnownPolicies.FluentBit {
+		customPolicies = append(customPolicies,
+			customPolicyForRole{Name: "PolicyFluentBit", Statements: fluentBitStatements()},
+		)
+	}
+	if wellKnownPolicies.Velero {
+		customPolicies = append(customPolicies,
+			customPolicyForRole{Name: "PolicyVelero", Statements: veleroStatements()},
+		)
+	}
+	if wellKnownPolicies.Crossplane {
+		customPolicies = append(customPolicies,
+			customPolicyForRole{Name: "PolicyCrossplane", Statements: crossplaneStatements()},
+		)
+	}
+	if wellKnownPolicies.Karpenter {
+		customPolicies = append(customPolicies,
+			customPolicyForRole{Name: "PolicyKarpenter", Statements: karpenterStatements()},
+		)
+	}
 	return managedPolicies, customPolicies
 }
 
+type customPolicyDocumentForRole struct {
+	name     string
+	document api.InlineDocument
+}
+
+var nonAlphanumeric = regexp.MustCompile("[^a-zA-Z0-9]")
+
+// createCustomWellKnownPolicies returns the policy ARNs and documents of the custom well-known policies,
+// in the order they are listed
+func createCustomWellKnownPolicies(wellKnownPolicies api.WellKnownPolicies) ([]interface{}, []customPolicyDocumentForRole) {
+	var (
+		policyARNs []interface{}
+		documents  []customPolicyDocumentForRole
+	)
+	for i, name := range wellKnownPolicies.Custom {
+		policy := wellKnownPolicies.CustomPolicies[name]
+		for _, arn := range policy.AttachPolicyARNs {
+			policyARNs = append(policyARNs, arn)
+		}
+		if len(policy.AttachPolicy) != 0 {
+			documents = append(documents, customPolicyDocumentForRole{
+				name:     fmt.Sprintf("PolicyCustom%d%s", i, nonAlphanumeric.ReplaceAllString(name, "")),
+				document: policy.AttachPolicy,
+			})
+		}
+	}
+	return policyARNs, documents
+}
+
+// instanceRoleName returns the name of the instance role created for a nodegroup, which is iam.instanceRoleName if set
+// and otherwise follows the naming of the cluster. It is empty to let CloudFormation generate the name
+func instanceRoleName(clusterSpec *api.ClusterConfig, ng *api.NodeGroupBase) string {
+	if ng.IAM.InstanceRoleName != "" {
+		return ng.IAM.InstanceRoleName
+	}
+	return clusterSpec.NodeGroupInstanceRoleName(ng.Name)
+}
+
 // createRole creates an IAM role with policies required for the worker nodes and addons
-func createRole(cfnTemplate cfnTemplate, clusterIAMConfig *api.ClusterIAM, iamConfig *api.NodeGroupIAM, managed, forceAddCNIPolicy bool) error {
-	managedPolicyARNs, err := makeManagedPolicies(clusterIAMConfig, iamConfig, managed, forceAddCNIPolicy)
+func createRole(cfnTemplate cfnTemplate, clusterSpec *api.ClusterConfig, iamConfig *api.NodeGroupIAM, roleName string, managed, forceAddCNIPolicy bool) error {
+	managedPolicyARNs, err := makeManagedPolicies(clusterSpec.IAM, iamConfig, managed, forceAddCNIPolicy)
 	if err != nil {
 		return err
 	}
@@ -88,8 +152,8 @@ func createRole(cfnTemplate cfnTemplate, clusterIAMConfig *api.ClusterIAM, iamCo
 		ManagedPolicyArns:        managedPolicyARNs,
 	}
 
-	if iamConfig.InstanceRoleName != "" {
-		role.RoleName = gfnt.NewString(iamConfig.InstanceRoleName)
+	if roleName != "" {
+		role.RoleName = gfnt.NewString(roleName)
 	}
 
 	if iamConfig.InstanceRolePermissionsBoundary != "" {
@@ -146,6 +210,10 @@ func createRole(cfnTemplate cfnTemplate, clusterIAMConfig *api.ClusterIAM, iamCo
 		cfnTemplate.attachAllowPolicy("PolicyXRay", refIR, xRayStatements())
 	}
 
+	if clusterSpec.PullThroughCache != nil {
+		cfnTemplate.attachAllowPolicy("PolicyPullThroughCache", refIR, pullThroughCacheStatements(clusterSpec.PullThroughCache))
+	}
+
 	return nil
 }
 
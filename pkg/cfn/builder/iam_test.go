@@ -1,6 +1,8 @@
 package builder_test
 
 import (
+	"strings"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -66,6 +68,23 @@ var _ = Describe("template builder for IAM", func() {
 			Expect(t).To(HaveOutputWithValue("Role1", `{ "Fn::GetAtt": "Role1.Arn" }`))
 		})
 
+		It("uses the audience annotation of the service account in the trust policy", func() {
+			serviceAccount := &api.ClusterIAMServiceAccount{}
+			serviceAccount.Name = "sa-1"
+			serviceAccount.Annotations = map[string]string{api.AnnotationEKSAudience: "sts.custom.example.com"}
+			serviceAccount.AttachPolicyARNs = []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}
+			appendServiceAccountToClusterConfig(cfg, serviceAccount)
+
+			rs := builder.NewIAMRoleResourceSetForServiceAccount(serviceAccount, oidc)
+			templateBody := []byte{}
+			Expect(rs).To(RenderWithoutErrors(&templateBody))
+			t := cft.NewTemplate()
+			Expect(t).To(LoadBytesWithoutErrors(templateBody))
+
+			Expect(t).To(HaveResourceWithPropertyValue("Role1", "AssumeRolePolicyDocument", strings.Replace(expectedServiceAccountAssumeRolePolicyDocument,
+				`"sts.amazonaws.com"`, `"sts.custom.example.com"`, 1)))
+		})
+
 		It("can construct an iamserviceaccount addon template with one inline policy", func() {
 			serviceAccount := &api.ClusterIAMServiceAccount{}
 
@@ -266,6 +285,11 @@ var _ = Describe("template builder for IAM", func() {
 				CertManager:               true,
 				EBSCSIController:          true,
 				EFSCSIController:          true,
+				ExternalSecrets:           true,
+				FluentBit:                 true,
+				Velero:                    true,
+				Crossplane:                true,
+				Karpenter:                 true,
 			}
 
 			appendServiceAccountToClusterConfig(cfg, serviceAccount)
@@ -282,7 +306,7 @@ var _ = Describe("template builder for IAM", func() {
 
 			Expect(t.Description).To(Equal("IAM role for serviceaccount \"default/sa-1\" [created and managed by eksctl]"))
 
-			Expect(t.Resources).To(HaveLen(10))
+			Expect(t.Resources).To(HaveLen(15))
 			Expect(t.Outputs).To(HaveLen(1))
 
 			Expect(t).To(HaveResource("Role1", "AWS::IAM::Role"))
@@ -295,6 +319,69 @@ var _ = Describe("template builder for IAM", func() {
             ]`))
 			Expect(t).To(HaveOutputWithValue("Role1", `{ "Fn::GetAtt": "Role1.Arn" }`))
 			Expect(t).To(HaveResourceWithPropertyValue("PolicyEBSCSIController", "PolicyDocument", expectedEbsPolicyDocument))
+			for _, policy := range []string{"PolicyExternalSecrets", "PolicyFluentBit", "PolicyVelero", "PolicyCrossplane", "PolicyKarpenter"} {
+				Expect(t).To(HaveResource(policy, "AWS::IAM::Policy"))
+			}
+		})
+
+		It("can construct an iamserviceaccount addon template with custom wellKnownPolicies", func() {
+			serviceAccount := &api.ClusterIAMServiceAccount{}
+
+			serviceAccount.Name = "sa-1"
+
+			serviceAccount.WellKnownPolicies = api.WellKnownPolicies{
+				Custom: []string{"bucket-reader", "queue-consumer"},
+			}
+			Expect(serviceAccount.WellKnownPolicies.SetCustomPolicies(&api.WellKnownPolicyRegistry{
+				Policies: map[string]api.CustomWellKnownPolicy{
+					"bucket-reader": {
+						AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+					},
+					"queue-consumer": {
+						AttachPolicy: api.InlineDocument{
+							"Version": "2012-10-17",
+							"Statement": []interface{}{
+								map[string]interface{}{
+									"Effect":   "Allow",
+									"Action":   []string{"sqs:ReceiveMessage", "sqs:DeleteMessage"},
+									"Resource": "*",
+								},
+							},
+						},
+					},
+					"unused": {
+						AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AdministratorAccess"},
+					},
+				},
+			})).To(Succeed())
+
+			appendServiceAccountToClusterConfig(cfg, serviceAccount)
+
+			rs := builder.NewIAMRoleResourceSetForServiceAccount(serviceAccount, oidc)
+
+			templateBody := []byte{}
+
+			Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+			t := cft.NewTemplate()
+
+			Expect(t).To(LoadBytesWithoutErrors(templateBody))
+
+			Expect(t.Resources).To(HaveLen(2))
+			Expect(t).To(HaveResourceWithPropertyValue("Role1", "ManagedPolicyArns", `[
+                "arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"
+            ]`))
+			Expect(t).To(HaveResource("PolicyCustom1queueconsumer", "AWS::IAM::Policy"))
+			Expect(t).To(HaveResourceWithPropertyValue("PolicyCustom1queueconsumer", "PolicyDocument", `{
+				"Version": "2012-10-17",
+				"Statement": [
+					{
+						"Effect": "Allow",
+						"Action": ["sqs:ReceiveMessage", "sqs:DeleteMessage"],
+						"Resource": "*"
+					}
+				]
+			}`))
 		})
 
 		It("can parse an iamserviceaccount addon template", func() {
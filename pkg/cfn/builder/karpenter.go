@@ -4,13 +4,13 @@ import (
 	"fmt"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
-	gfn "github.com/weaveworks/goformation/v4/cloudformation"
-	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	gfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation"
+	gfniam "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/iam"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 const (
@@ -104,34 +104,39 @@ func (k *KarpenterResourceSet) addResourcesForKarpenter() error {
 	k.newResource(karpenterNodeInstanceProfile, &instanceProfile)
 
 	managedPolicyName := gfnt.MakeFnSubString(fmt.Sprintf("eksctl-%s-%s", KarpenterManagedPolicy, k.clusterSpec.Metadata.Name))
-	statements := cft.MapOfInterfaces{
-		"Effect":   effectAllow,
-		"Resource": resourceAll,
-		"Action": []string{
-			ec2CreateFleet,
-			ec2CreateLaunchTemplate,
-			ec2CreateTags,
-			ec2DescribeAvailabilityZones,
-			ec2DescribeInstanceTypeOfferings,
-			ec2DescribeInstanceTypes,
-			ec2DescribeInstances,
-			ec2DescribeLaunchTemplates,
-			ec2DescribeSecurityGroups,
-			ec2DescribeSubnets,
-			ec2RunInstances,
-			ec2TerminateInstances,
-			iamPassRole,
-			ssmGetParameter,
-		},
-	}
 	managedPolicy := gfniam.ManagedPolicy{
 		ManagedPolicyName: managedPolicyName,
-		PolicyDocument:    cft.MakePolicyDocument(statements),
+		PolicyDocument:    cft.MakePolicyDocument(karpenterStatements()...),
 	}
 	k.newResource(KarpenterManagedPolicy, &managedPolicy)
 	return nil
 }
 
+func karpenterStatements() []cft.MapOfInterfaces {
+	return []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				ec2CreateFleet,
+				ec2CreateLaunchTemplate,
+				ec2CreateTags,
+				ec2DescribeAvailabilityZones,
+				ec2DescribeInstanceTypeOfferings,
+				ec2DescribeInstanceTypes,
+				ec2DescribeInstances,
+				ec2DescribeLaunchTemplates,
+				ec2DescribeSecurityGroups,
+				ec2DescribeSubnets,
+				ec2RunInstances,
+				ec2TerminateInstances,
+				iamPassRole,
+				ssmGetParameter,
+			},
+		},
+	}
+}
+
 // WithIAM implements the ResourceSet interface
 func (k *KarpenterResourceSet) WithIAM() bool {
 	// eksctl does not support passing pre-created IAM instance roles to Managed Nodes,
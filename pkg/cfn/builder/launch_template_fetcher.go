@@ -23,13 +23,22 @@ func NewLaunchTemplateFetcher(fetcher launchTemplateFetcher) *LaunchTemplateFetc
 
 // Fetch fetches the specified launch template
 func (l *LaunchTemplateFetcher) Fetch(launchTemplate *api.LaunchTemplate) (*ec2.ResponseLaunchTemplateData, error) {
+	launchTemplateVersion, err := l.FetchVersion(launchTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return launchTemplateVersion.LaunchTemplateData, nil
+}
+
+// FetchVersion fetches the specified launch template version, resolving `$Latest` and `$Default`
+func (l *LaunchTemplateFetcher) FetchVersion(launchTemplate *api.LaunchTemplate) (*ec2.LaunchTemplateVersion, error) {
 	input := &ec2.DescribeLaunchTemplateVersionsInput{
 		LaunchTemplateId: aws.String(launchTemplate.ID),
 	}
 	if version := launchTemplate.Version; version != nil {
 		input.Versions = []*string{version}
 	} else {
-		input.Versions = []*string{aws.String("$Default")}
+		input.Versions = []*string{aws.String(api.LaunchTemplateVersionDefault)}
 	}
 
 	output, err := l.fetcher.DescribeLaunchTemplateVersions(input)
@@ -40,5 +49,5 @@ func (l *LaunchTemplateFetcher) Fetch(launchTemplate *api.LaunchTemplate) (*ec2.
 		return nil, errors.Errorf("failed to find launch template with ID %q", launchTemplate.ID)
 	}
 
-	return output.LaunchTemplateVersions[0].LaunchTemplateData, nil
+	return output.LaunchTemplateVersions[0], nil
 }
@@ -5,9 +5,9 @@ import (
 
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
-	"github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudformation"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData() (*gfnec2.LaunchTemplate_LaunchTemplateData, error) {
@@ -53,7 +53,7 @@ func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData() (*gfnec2.LaunchTe
 		// we don't want to touch the network interfaces at all if we have a
 		// managed nodegroup, unless EFA is enabled
 		desc := "worker nodes in group " + m.nodeGroup.Name
-		efaSG := m.addEFASecurityGroup(m.vpcImporter.VPC(), m.clusterConfig.Metadata.Name, desc)
+		efaSG := m.addEFASecurityGroup(m.vpcImporter.VPC(), m.clusterConfig, desc)
 		securityGroupIDs = append(securityGroupIDs, efaSG)
 		if err := buildNetworkInterfaces(launchTemplateData, mng.InstanceTypeList(), true, securityGroupIDs, m.ec2API); err != nil {
 			return nil, errors.Wrap(err, "couldn't build network interfaces for launch template data")
@@ -86,6 +86,18 @@ func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData() (*gfnec2.LaunchTe
 		}
 	}
 
+	cpuOptions, err := makeCPUOptions(mng.CPUOptions, api.SelectInstanceType(mng), m.ec2API)
+	if err != nil {
+		return nil, err
+	}
+	launchTemplateData.CpuOptions = cpuOptions
+
+	if api.IsEnabled(mng.EnclaveEnabled) {
+		launchTemplateData.EnclaveOptions = &gfnec2.LaunchTemplate_EnclaveOptions{
+			Enabled: gfnt.True(),
+		}
+	}
+
 	launchTemplateData.BlockDeviceMappings = makeBlockDeviceMappings(mng.NodeGroupBase)
 
 	return launchTemplateData, nil
@@ -18,10 +18,10 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/goformation"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 	vpcfakes "github.com/weaveworks/eksctl/pkg/vpc/fakes"
-	"github.com/weaveworks/goformation/v4"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 )
 
 type mngCase struct {
@@ -153,6 +153,37 @@ API_SERVER_URL=https://test.com
 			resourcesFilename: "launch_template_custom_ami.json",
 		}),
 
+		Entry("Launch Template with $Latest version", &mngCase{
+			ng: &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name: "template-custom-ami",
+				},
+				LaunchTemplate: &api.LaunchTemplate{
+					ID:      "lt-1234",
+					Version: aws.String("$Latest"),
+				},
+			},
+			mockFetcherFn: func(provider *mockprovider.MockProvider) {
+				provider.MockEC2().On("DescribeLaunchTemplateVersions", mock.MatchedBy(func(input *ec2.DescribeLaunchTemplateVersionsInput) bool {
+					return *input.LaunchTemplateId == "lt-1234" && *input.Versions[0] == "$Latest"
+				})).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+						{
+							VersionNumber: aws.Int64(5),
+							LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+								ImageId:      aws.String("ami-1234"),
+								InstanceType: aws.String("t2.medium"),
+								KeyName:      aws.String("key-name"),
+								UserData:     aws.String("bootstrap.sh"),
+							},
+						},
+					},
+				}, nil)
+			},
+
+			resourcesFilename: "launch_template_latest_version.json",
+		}),
+
 		Entry("SSH enabled", &mngCase{
 			ng: &api.ManagedNodeGroup{
 				NodeGroupBase: &api.NodeGroupBase{
@@ -2,17 +2,18 @@ package builder
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/pkg/errors"
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfneks "github.com/weaveworks/goformation/v4/cloudformation/eks"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 	corev1 "k8s.io/api/core/v1"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfneks "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/eks"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
 	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
 	"github.com/weaveworks/eksctl/pkg/vpc"
@@ -58,7 +59,8 @@ func (m *ManagedNodeGroupResourceSet) AddAllResources() error {
 
 	var nodeRole *gfnt.Value
 	if m.nodeGroup.IAM.InstanceRoleARN == "" {
-		if err := createRole(m.resourceSet, m.clusterConfig.IAM, m.nodeGroup.IAM, true, m.forceAddCNIPolicy); err != nil {
+		roleName := instanceRoleName(m.clusterConfig, m.nodeGroup.NodeGroupBase)
+		if err := createRole(m.resourceSet, m.clusterConfig, m.nodeGroup.IAM, roleName, true, m.forceAddCNIPolicy); err != nil {
 			return err
 		}
 		nodeRole = gfnt.MakeFnGetAttString(cfnIAMInstanceRoleName, "Arn")
@@ -88,7 +90,10 @@ func (m *ManagedNodeGroupResourceSet) AddAllResources() error {
 		}
 	}
 
-	taints, err := mapTaints(m.nodeGroup.Taints)
+	// labels and taints rejected by the EKS API are passed to kubelet through the launch template user data
+	eksLabels, _ := m.nodeGroup.SplitLabelsForEKS()
+	eksTaints, _ := m.nodeGroup.SplitTaintsForEKS()
+	taints, err := mapTaints(eksTaints)
 	if err != nil {
 		return err
 	}
@@ -99,7 +104,7 @@ func (m *ManagedNodeGroupResourceSet) AddAllResources() error {
 		ScalingConfig: &scalingConfig,
 		Subnets:       subnets,
 		NodeRole:      nodeRole,
-		Labels:        m.nodeGroup.Labels,
+		Labels:        eksLabels,
 		Tags:          m.nodeGroup.Tags,
 		Taints:        taints,
 	}
@@ -133,10 +138,11 @@ func (m *ManagedNodeGroupResourceSet) AddAllResources() error {
 	var launchTemplate *gfneks.Nodegroup_LaunchTemplateSpecification
 
 	if m.nodeGroup.LaunchTemplate != nil {
-		launchTemplateData, err := m.launchTemplateFetcher.Fetch(m.nodeGroup.LaunchTemplate)
+		launchTemplateVersion, err := m.launchTemplateFetcher.FetchVersion(m.nodeGroup.LaunchTemplate)
 		if err != nil {
 			return err
 		}
+		launchTemplateData := launchTemplateVersion.LaunchTemplateData
 		if err := validateLaunchTemplate(launchTemplateData, m.nodeGroup); err != nil {
 			return err
 		}
@@ -145,7 +151,13 @@ func (m *ManagedNodeGroupResourceSet) AddAllResources() error {
 			Id: gfnt.NewString(m.nodeGroup.LaunchTemplate.ID),
 		}
 		if version := m.nodeGroup.LaunchTemplate.Version; version != nil {
-			launchTemplate.Version = gfnt.NewString(*version)
+			// `$Latest` and `$Default` are pinned to the version they currently refer to,
+			// so that changes to the launch template are only rolled out by an explicit upgrade
+			if (*version == api.LaunchTemplateVersionLatest || *version == api.LaunchTemplateVersionDefault) && launchTemplateVersion.VersionNumber != nil {
+				launchTemplate.Version = gfnt.NewString(strconv.FormatInt(*launchTemplateVersion.VersionNumber, 10))
+			} else {
+				launchTemplate.Version = gfnt.NewString(*version)
+			}
 		}
 
 		if launchTemplateData.ImageId == nil {
@@ -294,7 +306,11 @@ func getAMIType(ng *api.ManagedNodeGroup, instanceType string) string {
 
 // RenderJSON implements the ResourceSet interface
 func (m *ManagedNodeGroupResourceSet) RenderJSON() ([]byte, error) {
-	return m.resourceSet.renderJSON()
+	templateBody, err := m.resourceSet.renderJSON()
+	if err != nil {
+		return nil, err
+	}
+	return applyCloudFormationOverrides(templateBody, m.nodeGroup.CloudFormation)
 }
 
 // WithIAM implements the ResourceSet interface
@@ -306,5 +322,5 @@ func (m *ManagedNodeGroupResourceSet) WithIAM() bool {
 
 // WithNamedIAM implements the ResourceSet interface
 func (m *ManagedNodeGroupResourceSet) WithNamedIAM() bool {
-	return m.nodeGroup.IAM.InstanceRoleName != ""
+	return m.nodeGroup.IAM.InstanceRoleName != "" || m.nodeGroup.IAM.InstanceRoleARN == "" && instanceRoleName(m.clusterConfig, m.nodeGroup.NodeGroupBase) != ""
 }
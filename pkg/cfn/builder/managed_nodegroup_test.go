@@ -8,13 +8,13 @@ import (
 	"github.com/stretchr/testify/require"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	"github.com/weaveworks/eksctl/pkg/goformation"
+	gfneks "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/eks"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap/fakes"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 	vpcfakes "github.com/weaveworks/eksctl/pkg/vpc/fakes"
-	"github.com/weaveworks/goformation/v4"
-	gfneks "github.com/weaveworks/goformation/v4/cloudformation/eks"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 )
 
 func TestManagedPolicyResources(t *testing.T) {
@@ -245,3 +245,39 @@ func subs(ss []string) []*gfnt.Value {
 	}
 	return subs
 }
+
+func TestManagedNodeGroupKubeletLabelsAndTaints(t *testing.T) {
+	require := require.New(t)
+	clusterConfig := api.NewClusterConfig()
+	ng := &api.ManagedNodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name: "ng",
+			Labels: map[string]string{
+				"role":                      "worker",
+				"node.kubernetes.io/worker": "",
+			},
+		},
+		Taints: []api.NodeGroupTaint{
+			{Key: "dedicated", Value: "worker", Effect: "NoSchedule"},
+			{Key: "node.kubernetes.io/worker", Effect: "NoExecute"},
+		},
+	}
+	api.SetManagedNodeGroupDefaults(ng, clusterConfig.Metadata)
+	p := mockprovider.NewMockProvider()
+	fakeVPCImporter := new(vpcfakes.FakeImporter)
+	bootstrapper := nodebootstrap.NewManagedBootstrapper(clusterConfig, ng)
+	stack := NewManagedNodeGroup(p.EC2(), clusterConfig, ng, nil, bootstrapper, false, fakeVPCImporter)
+	require.NoError(stack.AddAllResources())
+
+	bytes, err := stack.RenderJSON()
+	require.NoError(err)
+
+	template, err := goformation.ParseJSON(bytes)
+	require.NoError(err)
+	ngResource, ok := template.Resources[ManagedNodeGroupResourceName].(*gfneks.Nodegroup)
+	require.True(ok)
+	require.Contains(ngResource.Labels, "role")
+	require.NotContains(ngResource.Labels, "node.kubernetes.io/worker")
+	require.Len(ngResource.Taints, 1)
+	require.Equal(gfnt.NewString("dedicated"), ngResource.Taints[0].Key)
+}
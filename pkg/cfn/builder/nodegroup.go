@@ -2,22 +2,26 @@ package builder
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/pkg/errors"
-	gfn "github.com/weaveworks/goformation/v4/cloudformation"
-	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	"github.com/kris-nova/logger"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	gfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
@@ -53,8 +57,12 @@ func NewNodeGroupResourceSet(ec2API ec2iface.EC2API, iamAPI iamiface.IAMAPI, spe
 // AddAllResources adds all the information about the nodegroup to the resource set
 func (n *NodeGroupResourceSet) AddAllResources() error {
 
-	if n.clusterSpec.KubernetesNetworkConfig != nil && n.clusterSpec.KubernetesNetworkConfig.IPv6Enabled() {
-		return errors.New("unmanaged nodegroups are not supported with IPv6 clusters")
+	if n.clusterSpec.IPv6Enabled() {
+		if !api.IsEnabled(n.spec.IPv6Only) {
+			return errors.New("unmanaged nodegroups are only supported with IPv6 clusters in IPv6-only subnets, see ipv6Only")
+		}
+	} else if api.IsEnabled(n.spec.IPv6Only) {
+		return fmt.Errorf("nodegroup %q sets ipv6Only, which requires an IPv6 cluster", n.spec.Name)
 	}
 
 	n.rs.template.Description = fmt.Sprintf(
@@ -135,22 +143,26 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 	refControlPlaneSG := n.vpcImporter.ControlPlaneSecurityGroup()
 
 	refNodeGroupLocalSG := n.newResource("SG", &gfnec2.SecurityGroup{
-		VpcId:            vpcID,
-		GroupDescription: gfnt.NewString("Communication between the control plane and " + desc),
-		Tags: []gfncfn.Tag{{
-			Key:   gfnt.NewString("kubernetes.io/cluster/" + n.clusterSpec.Metadata.Name),
-			Value: gfnt.NewString("owned"),
-		}},
+		VpcId:                vpcID,
+		GroupDescription:     gfnt.NewString("Communication between the control plane and " + desc),
+		Tags:                 makeClusterTags(n.clusterSpec, n.clusterSpec.SecurityGroupClusterTag()),
 		SecurityGroupIngress: makeNodeIngressRules(n.spec.NodeGroupBase, refControlPlaneSG, n.clusterSpec.VPC.CIDR.String(), desc),
 	})
 
 	n.securityGroups = append(n.securityGroups, refNodeGroupLocalSG)
 
 	if api.IsEnabled(n.spec.EFAEnabled) {
-		efaSG := n.rs.addEFASecurityGroup(vpcID, n.clusterSpec.Metadata.Name, desc)
+		efaSG := n.rs.addEFASecurityGroup(vpcID, n.clusterSpec, desc)
 		n.securityGroups = append(n.securityGroups, efaSG)
 	}
 
+	if n.spec.AccountRoleARN != "" {
+		// the control plane security group belongs to the account of the cluster, its rules for the nodegroup
+		// are added with the credentials of that account once the stack is created
+		n.rs.defineOutputWithoutCollector(outputs.NodeGroupSecurityGroup, refNodeGroupLocalSG, false)
+		return
+	}
+
 	n.newResource("EgressInterCluster", &gfnec2.SecurityGroupEgress{
 		GroupId:                    refControlPlaneSG,
 		DestinationSecurityGroupId: refNodeGroupLocalSG,
@@ -200,7 +212,11 @@ func makeNodeIngressRules(ng *api.NodeGroupBase, controlPlaneSG *gfnt.Value, vpc
 
 // RenderJSON returns the rendered JSON
 func (n *NodeGroupResourceSet) RenderJSON() ([]byte, error) {
-	return n.rs.renderJSON()
+	templateBody, err := n.rs.renderJSON()
+	if err != nil {
+		return nil, err
+	}
+	return applyCloudFormationOverrides(templateBody, n.spec.CloudFormation)
 }
 
 // Template returns the CloudFormation template
@@ -225,7 +241,7 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 
 	launchTemplateData.BlockDeviceMappings = makeBlockDeviceMappings(n.spec.NodeGroupBase)
 
-	n.newResource("NodeGroupLaunchTemplate", &gfnec2.LaunchTemplate{
+	launchTemplate := n.newResource("NodeGroupLaunchTemplate", &gfnec2.LaunchTemplate{
 		LaunchTemplateName: launchTemplateName,
 		LaunchTemplateData: launchTemplateData,
 	})
@@ -235,6 +251,14 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 		return err
 	}
 
+	if n.spec.Provisioner != "" {
+		// the nodes are launched by the provisioner plugin, with the launch template and in the subnets of the nodegroup
+		n.rs.defineOutputWithoutCollector(outputs.NodeGroupLaunchTemplateID, launchTemplate, false)
+		n.rs.defineOutputWithoutCollector(outputs.NodeGroupLaunchTemplateVersion, gfnt.MakeFnGetAttString("NodeGroupLaunchTemplate", "LatestVersionNumber"), false)
+		n.rs.defineOutputWithoutCollector(outputs.NodeGroupSubnetIDs, gfnt.MakeIntrinsic(gfnt.FnJoin, []interface{}{",", vpcZoneIdentifier}), false)
+		return nil
+	}
+
 	tags := []map[string]interface{}{
 		{
 			"Key":               "Name",
@@ -242,8 +266,8 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 			"PropagateAtLaunch": "true",
 		},
 		{
-			"Key":               "kubernetes.io/cluster/" + n.clusterSpec.Metadata.Name,
-			"Value":             "owned",
+			"Key":               n.clusterSpec.ClusterTagKey(),
+			"Value":             n.clusterSpec.InstanceClusterTag(),
 			"PropagateAtLaunch": "true",
 		},
 	}
@@ -260,6 +284,11 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 				"PropagateAtLaunch": "true",
 			},
 		)
+		nodeTemplateTags, err := makeNodeTemplateTags(n.spec, n.ec2API)
+		if err != nil {
+			return err
+		}
+		tags = append(tags, nodeTemplateTags...)
 	}
 
 	asg := nodeGroupResource(launchTemplateName, vpcZoneIdentifier, tags, n.spec)
@@ -268,6 +297,85 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 	return nil
 }
 
+// makeNodeTemplateTags returns the ASG tags cluster-autoscaler uses to build a template node
+// when scaling a nodegroup from zero, based on the labels, taints and GPUs of the nodegroup;
+// they are only needed on the ASG and are not propagated to the instances. Tags already set by the user take precedence
+func makeNodeTemplateTags(ng *api.NodeGroup, ec2API ec2iface.EC2API) ([]map[string]interface{}, error) {
+	const nodeTemplatePrefix = "k8s.io/cluster-autoscaler/node-template/"
+
+	var tags []map[string]interface{}
+	addTag := func(key, value string) {
+		key = nodeTemplatePrefix + key
+		if _, exists := ng.Tags[key]; exists {
+			return
+		}
+		tags = append(tags, map[string]interface{}{
+			"Key":               key,
+			"Value":             value,
+			"PropagateAtLaunch": "false",
+		})
+	}
+
+	labelKeys := make([]string, 0, len(ng.Labels))
+	for k := range ng.Labels {
+		labelKeys = append(labelKeys, k)
+	}
+	sort.Strings(labelKeys)
+	for _, k := range labelKeys {
+		addTag("label/"+k, ng.Labels[k])
+	}
+
+	for _, t := range ng.Taints {
+		addTag("taint/"+t.Key, fmt.Sprintf("%s:%s", t.Value, t.Effect))
+	}
+
+	gpus, err := nodeGroupGPUs(ng, ec2API)
+	if err != nil {
+		return nil, err
+	}
+	if gpus > 0 {
+		addTag("resources/nvidia.com/gpu", strconv.Itoa(gpus))
+	}
+	return tags, nil
+}
+
+// nodeGroupGPUs returns the number of NVIDIA GPUs every instance of the nodegroup has, from the instance selector
+// or else from the instance types, or 0 when some instance types have none
+func nodeGroupGPUs(ng *api.NodeGroup, ec2API ec2iface.EC2API) (int, error) {
+	if ng.InstanceSelector != nil && ng.InstanceSelector.GPUs != nil {
+		return *ng.InstanceSelector.GPUs, nil
+	}
+
+	instanceTypes := ng.InstanceTypeList()
+	for _, instanceType := range instanceTypes {
+		if !instanceutils.IsGPUInstanceType(instanceType) || instanceutils.IsInferentiaInstanceType(instanceType) {
+			return 0, nil
+		}
+	}
+	output, err := ec2API.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice(instanceTypes),
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "couldn't retrieve the GPU information of instance types %v", instanceTypes)
+	}
+
+	minGPUs := 0
+	for i, it := range output.InstanceTypes {
+		gpus := 0
+		if it.GpuInfo != nil {
+			for _, gpu := range it.GpuInfo.Gpus {
+				if aws.StringValue(gpu.Manufacturer) == "NVIDIA" {
+					gpus += int(aws.Int64Value(gpu.Count))
+				}
+			}
+		}
+		if i == 0 || gpus < minGPUs {
+			minGPUs = gpus
+		}
+	}
+	return minGPUs, nil
+}
+
 // generateNodeName formulates the name based on the configuration in input
 func generateNodeName(ng *api.NodeGroupBase, meta *api.ClusterMeta) string {
 	var nameParts []string
@@ -334,6 +442,11 @@ func newLaunchTemplateData(n *NodeGroupResourceSet) (*gfnec2.LaunchTemplate_Laun
 		TagSpecifications: makeTags(n.spec.NodeGroupBase, n.clusterSpec.Metadata),
 	}
 
+	if api.IsEnabled(n.spec.IPv6Only) {
+		// pods using the IPv6 address of the node reach IMDS at its IPv6 endpoint
+		launchTemplateData.MetadataOptions.HttpProtocolIpv6 = gfnt.NewString("enabled")
+	}
+
 	if err := buildNetworkInterfaces(launchTemplateData, n.spec.InstanceTypeList(), api.IsEnabled(n.spec.EFAEnabled), n.securityGroups, n.ec2API); err != nil {
 		return nil, errors.Wrap(err, "couldn't build network interfaces for launch template data")
 	}
@@ -374,6 +487,18 @@ func newLaunchTemplateData(n *NodeGroupResourceSet) (*gfnec2.LaunchTemplate_Laun
 		}
 	}
 
+	cpuOptions, err := makeCPUOptions(n.spec.CPUOptions, api.SelectInstanceType(n.spec), n.ec2API)
+	if err != nil {
+		return nil, err
+	}
+	launchTemplateData.CpuOptions = cpuOptions
+
+	if api.IsEnabled(n.spec.EnclaveEnabled) {
+		launchTemplateData.EnclaveOptions = &gfnec2.LaunchTemplate_EnclaveOptions{
+			Enabled: gfnt.True(),
+		}
+	}
+
 	return launchTemplateData, nil
 }
 
@@ -420,6 +545,9 @@ func nodeGroupResource(launchTemplateName *gfnt.Value, vpcZoneIdentifier interfa
 	if len(ng.TargetGroupARNs) > 0 {
 		ngProps["TargetGroupARNs"] = ng.TargetGroupARNs
 	}
+	if len(ng.LifecycleHooks) > 0 {
+		ngProps["LifecycleHookSpecificationList"] = lifecycleHookSpecifications(ng.LifecycleHooks)
+	}
 	if api.HasMixedInstances(ng) {
 		ngProps["MixedInstancesPolicy"] = *mixedInstancesPolicy(launchTemplateName, ng)
 	} else {
@@ -502,3 +630,34 @@ func metricsCollectionResource(asgMetricsCollection []api.MetricsCollection) []m
 	}
 	return metricsCollections
 }
+
+func lifecycleHookSpecifications(hooks []api.LifecycleHook) []map[string]interface{} {
+	transitions := map[string]string{
+		api.LifecycleTransitionLaunch:    "autoscaling:EC2_INSTANCE_LAUNCHING",
+		api.LifecycleTransitionTerminate: "autoscaling:EC2_INSTANCE_TERMINATING",
+	}
+	var specifications []map[string]interface{}
+	for _, hook := range hooks {
+		specification := map[string]interface{}{
+			"LifecycleHookName":   hook.Name,
+			"LifecycleTransition": transitions[hook.Transition],
+		}
+		if hook.NotificationTargetARN != "" {
+			specification["NotificationTargetARN"] = hook.NotificationTargetARN
+		}
+		if hook.RoleARN != "" {
+			specification["RoleARN"] = hook.RoleARN
+		}
+		if hook.HeartbeatTimeout != nil {
+			specification["HeartbeatTimeout"] = *hook.HeartbeatTimeout
+		}
+		if hook.DefaultResult != "" {
+			specification["DefaultResult"] = hook.DefaultResult
+		}
+		if hook.NotificationMetadata != "" {
+			specification["NotificationMetadata"] = hook.NotificationMetadata
+		}
+		specifications = append(specifications, specification)
+	}
+	return specifications
+}
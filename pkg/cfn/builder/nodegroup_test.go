@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
@@ -16,6 +15,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
 	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	bootstrapfakes "github.com/weaveworks/eksctl/pkg/nodebootstrap/fakes"
 	vpcfakes "github.com/weaveworks/eksctl/pkg/vpc/fakes"
 )
@@ -75,6 +75,31 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 			Expect(ngTemplate.Outputs).To(HaveKey(outputs.NodeGroupFeatureLocalSecurityGroup))
 		})
 
+		Context("the nodegroup is provisioned by a plugin", func() {
+			BeforeEach(func() {
+				ng.Provisioner = "ocean"
+				fakeVPCImporter.SubnetsPublicReturns(gfnt.NewStringSlice("subnet-1", "subnet-2"))
+			})
+
+			It("adds the launch template but no auto scaling group", func() {
+				Expect(addErr).NotTo(HaveOccurred())
+				Expect(ngTemplate.Resources).To(HaveKey("NodeGroupLaunchTemplate"))
+				Expect(ngTemplate.Resources).NotTo(HaveKey("NodeGroup"))
+			})
+
+			It("adds the outputs the plugin launches nodes with", func() {
+				Expect(ngTemplate.Outputs).To(HaveKeyWithValue(outputs.NodeGroupLaunchTemplateID, map[string]interface{}{
+					"Value": map[string]interface{}{"Ref": "NodeGroupLaunchTemplate"},
+				}))
+				Expect(ngTemplate.Outputs).To(HaveKeyWithValue(outputs.NodeGroupLaunchTemplateVersion, map[string]interface{}{
+					"Value": map[string]interface{}{"Fn::GetAtt": []interface{}{"NodeGroupLaunchTemplate", "LatestVersionNumber"}},
+				}))
+				Expect(ngTemplate.Outputs).To(HaveKeyWithValue(outputs.NodeGroupSubnetIDs, map[string]interface{}{
+					"Value": map[string]interface{}{"Fn::Join": []interface{}{",", []interface{}{"subnet-1", "subnet-2"}}},
+				}))
+			})
+		})
+
 		Context("ipv6 cluster", func() {
 			BeforeEach(func() {
 				cfg.KubernetesNetworkConfig.IPFamily = api.IPV6Family
@@ -85,9 +110,31 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 
 			When("an unmanaged nodegroup is created", func() {
 				It("returns an error", func() {
-					Expect(addErr).To(MatchError(ContainSubstring("unmanaged nodegroups are not supported with IPv6 clusters")))
+					Expect(addErr).To(MatchError(ContainSubstring("unmanaged nodegroups are only supported with IPv6 clusters in IPv6-only subnets")))
 				})
 			})
+
+			When("the nodegroup is in IPv6-only subnets", func() {
+				BeforeEach(func() {
+					ng.IPv6Only = api.Enabled()
+				})
+
+				It("enables the IPv6 endpoint of IMDS", func() {
+					Expect(addErr).NotTo(HaveOccurred())
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.MetadataOptions.HTTPProtocolIPv6).To(Equal("enabled"))
+				})
+			})
+		})
+
+		Context("ipv6Only is set in an IPv4 cluster", func() {
+			BeforeEach(func() {
+				ng.IPv6Only = api.Enabled()
+			})
+
+			It("returns an error", func() {
+				Expect(addErr).To(MatchError(`nodegroup "ng-abcd1234" sets ipv6Only, which requires an IPv6 cluster`))
+			})
 		})
 
 		Context("if ng.MinSize is nil", func() {
@@ -230,6 +277,28 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("naming is set", func() {
+				BeforeEach(func() {
+					cfg.Naming = &api.Naming{Prefix: "acme-{env}-", Suffix: "-{cluster}", Env: "prod"}
+				})
+
+				It("names the role without setting the name in the nodegroup config", func() {
+					Expect(ngrs.WithNamedIAM()).To(BeTrue())
+					Expect(ngTemplate.Resources["NodeInstanceRole"].Properties.RoleName).To(Equal("acme-prod-bonsai-ng-abcd1234-NodeInstanceRole-bonsai"))
+					Expect(ng.IAM.InstanceRoleName).To(BeEmpty())
+				})
+
+				Context("ng.InstanceRoleName is set", func() {
+					BeforeEach(func() {
+						ng.IAM.InstanceRoleName = "custom-role"
+					})
+
+					It("does not override the name of the role", func() {
+						Expect(ngTemplate.Resources["NodeInstanceRole"].Properties.RoleName).To(Equal("custom-role"))
+					})
+				})
+			})
+
 			Context("ng.InstanceRolePermissionsBoundary is set", func() {
 				BeforeEach(func() {
 					ng.IAM.InstanceRolePermissionsBoundary = "shall-not-pass"
@@ -496,6 +565,26 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 					Expect(isRefTo(ngTemplate.Resources["PolicyXRay"].Properties.Roles[0], "NodeInstanceRole")).To(BeTrue())
 				})
 			})
+
+			Context("cfg.PullThroughCache is set", func() {
+				BeforeEach(func() {
+					cfg.PullThroughCache = &api.PullThroughCache{
+						Rules: []api.PullThroughCacheRule{{UpstreamRegistryURL: "quay.io"}},
+					}
+				})
+
+				It("adds PolicyPullThroughCache to the role", func() {
+					Expect(ngTemplate.Resources).To(HaveKey("PolicyPullThroughCache"))
+
+					policy := ngTemplate.Resources["PolicyPullThroughCache"].Properties
+					Expect(policy.Roles).To(HaveLen(1))
+					Expect(isRefTo(policy.Roles[0], "NodeInstanceRole")).To(BeTrue())
+					Expect(policy.PolicyDocument.Statement[0].Action).To(ConsistOf("ecr:CreateRepository", "ecr:BatchImportUpstreamImage"))
+					Expect(policy.PolicyDocument.Statement[0].Resource).To(ConsistOf(map[string]interface{}{
+						"Fn::Sub": "arn:${AWS::Partition}:ecr:${AWS::Region}:${AWS::AccountId}:repository/quay/*",
+					}))
+				})
+			})
 			// TODO end
 		})
 
@@ -539,6 +628,33 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				Expect(properties.SecurityGroupIngress[1].ToPort).To(Equal(float64(443)))
 			})
 
+			Context("vpc.clusterTags.sharedVPC is enabled", func() {
+				BeforeEach(func() {
+					cfg.VPC.ClusterTags = &api.ClusterTags{SharedVPC: api.Enabled()}
+				})
+
+				It("tags the SG and the instances as shared", func() {
+					properties := ngTemplate.Resources["SG"].Properties
+					Expect(properties.Tags[0].Key).To(Equal("kubernetes.io/cluster/bonsai"))
+					Expect(properties.Tags[0].Value).To(Equal("shared"))
+					tags := ngTemplate.Resources["NodeGroup"].Properties.Tags
+					Expect(tags[1].Key).To(Equal("kubernetes.io/cluster/bonsai"))
+					Expect(tags[1].Value).To(Equal("shared"))
+				})
+			})
+
+			Context("vpc.clusterTags.securityGroups is none", func() {
+				BeforeEach(func() {
+					cfg.VPC.ClusterTags = &api.ClusterTags{SecurityGroups: api.ClusterTagNone}
+				})
+
+				It("does not tag the SG", func() {
+					for _, tag := range ngTemplate.Resources["SG"].Properties.Tags {
+						Expect(tag.Key).NotTo(Equal("kubernetes.io/cluster/bonsai"))
+					}
+				})
+			})
+
 			It("the EgressInterCluster resource is added", func() {
 				Expect(ngTemplate.Resources).To(HaveKey("EgressInterCluster"))
 				properties := ngTemplate.Resources["EgressInterCluster"].Properties
@@ -572,6 +688,23 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				Expect(properties.ToPort).To(Equal(443))
 			})
 
+			Context("ng.AccountRoleARN is set", func() {
+				BeforeEach(func() {
+					ng.AccountRoleARN = "arn:aws:iam::222222222222:role/eksctl-nodegroups"
+				})
+
+				It("does not add rules to the control plane security group", func() {
+					Expect(ngTemplate.Resources).To(HaveKey("SG"))
+					Expect(ngTemplate.Resources).NotTo(HaveKey("EgressInterCluster"))
+					Expect(ngTemplate.Resources).NotTo(HaveKey("EgressInterClusterAPI"))
+					Expect(ngTemplate.Resources).NotTo(HaveKey("IngressInterClusterCP"))
+				})
+
+				It("adds the SecurityGroup output", func() {
+					Expect(ngTemplate.Outputs).To(HaveKey(outputs.NodeGroupSecurityGroup))
+				})
+			})
+
 			Context("ng.EFA is enabled", func() {
 				BeforeEach(func() {
 					ng.EFAEnabled = aws.Bool(true)
@@ -866,6 +999,42 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("ng.LifecycleHooks are set", func() {
+				BeforeEach(func() {
+					ng.LifecycleHooks = []api.LifecycleHook{
+						{
+							Name:                  "drain",
+							Transition:            api.LifecycleTransitionTerminate,
+							NotificationTargetARN: "arn:aws:sqs:us-west-2:123456789012:node-drainer",
+							RoleARN:               "arn:aws:iam::123456789012:role/node-drainer",
+							HeartbeatTimeout:      aws.Int(300),
+							DefaultResult:         "CONTINUE",
+						},
+						{
+							Name:       "warm-up",
+							Transition: api.LifecycleTransitionLaunch,
+						},
+					}
+				})
+
+				It("adds the lifecycle hooks to the resource", func() {
+					Expect(ngTemplate.Resources["NodeGroup"].Properties.LifecycleHookSpecificationList).To(Equal([]map[string]interface{}{
+						{
+							"LifecycleHookName":     "drain",
+							"LifecycleTransition":   "autoscaling:EC2_INSTANCE_TERMINATING",
+							"NotificationTargetARN": "arn:aws:sqs:us-west-2:123456789012:node-drainer",
+							"RoleARN":               "arn:aws:iam::123456789012:role/node-drainer",
+							"HeartbeatTimeout":      float64(300),
+							"DefaultResult":         "CONTINUE",
+						},
+						{
+							"LifecycleHookName":   "warm-up",
+							"LifecycleTransition": "autoscaling:EC2_INSTANCE_LAUNCHING",
+						},
+					}))
+				})
+			})
+
 			Context("has mixed instances", func() {
 				BeforeEach(func() {
 					ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
@@ -962,6 +1131,62 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 					Expect(tags[3].Value).To(Equal("owned"))
 					Expect(tags[3].PropagateAtLaunch).To(Equal("true"))
 				})
+
+				Context("the nodegroup declares labels, taints and GPUs", func() {
+					BeforeEach(func() {
+						ng.Labels = map[string]string{"role": "gpu", "app": "ml"}
+						ng.Taints = []api.NodeGroupTaint{{Key: "nvidia.com/gpu", Value: "true", Effect: "NoSchedule"}}
+						ng.InstanceSelector = &api.InstanceSelector{GPUs: aws.Int(4)}
+					})
+
+					It("appends node template tags for scaling from zero", func() {
+						tags := ngTemplate.Resources["NodeGroup"].Properties.Tags
+						Expect(tags).To(HaveLen(8))
+						Expect(tags[4].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/label/app"))
+						Expect(tags[4].Value).To(Equal("ml"))
+						Expect(tags[4].PropagateAtLaunch).To(Equal("false"))
+						Expect(tags[5].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/label/role"))
+						Expect(tags[5].Value).To(Equal("gpu"))
+						Expect(tags[6].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/taint/nvidia.com/gpu"))
+						Expect(tags[6].Value).To(Equal("true:NoSchedule"))
+						Expect(tags[7].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/resources/nvidia.com/gpu"))
+						Expect(tags[7].Value).To(Equal("4"))
+					})
+				})
+
+				Context("the nodegroup uses GPU instance types", func() {
+					BeforeEach(func() {
+						ng.InstanceType = "mixed"
+						ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+							InstanceTypes: []string{"p3.8xlarge", "g4dn.12xlarge"},
+						}
+						mockEC2.On("DescribeInstanceTypes", &ec2.DescribeInstanceTypesInput{
+							InstanceTypes: aws.StringSlice([]string{"p3.8xlarge", "g4dn.12xlarge"}),
+						}).Return(&ec2.DescribeInstanceTypesOutput{
+							InstanceTypes: []*ec2.InstanceTypeInfo{
+								{
+									InstanceType: aws.String("p3.8xlarge"),
+									GpuInfo: &ec2.GpuInfo{Gpus: []*ec2.GpuDeviceInfo{
+										{Manufacturer: aws.String("NVIDIA"), Count: aws.Int64(4)},
+									}},
+								},
+								{
+									InstanceType: aws.String("g4dn.12xlarge"),
+									GpuInfo: &ec2.GpuInfo{Gpus: []*ec2.GpuDeviceInfo{
+										{Manufacturer: aws.String("NVIDIA"), Count: aws.Int64(4)},
+									}},
+								},
+							},
+						}, nil)
+					})
+
+					It("tags the number of GPUs of the instance types", func() {
+						tags := ngTemplate.Resources["NodeGroup"].Properties.Tags
+						Expect(tags).To(HaveLen(5))
+						Expect(tags[4].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/resources/nvidia.com/gpu"))
+						Expect(tags[4].Value).To(Equal("4"))
+					})
+				})
 			})
 
 			Context("ng.SSH.PublicKeyName", func() {
@@ -1084,6 +1309,71 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 					Expect(properties.LaunchTemplateData.Monitoring.Enabled).To(Equal(true))
 				})
 			})
+
+			Context("ng.EnclaveEnabled is true", func() {
+				BeforeEach(func() {
+					ng.EnclaveEnabled = aws.Bool(true)
+				})
+
+				It("enables Nitro Enclaves on the launch template", func() {
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.EnclaveOptions.Enabled).To(BeTrue())
+				})
+			})
+
+			Context("ng.CPUOptions is set", func() {
+				BeforeEach(func() {
+					ng.CPUOptions = &api.CPUOptions{
+						CoreCount:      aws.Int(2),
+						ThreadsPerCore: aws.Int(1),
+					}
+				})
+
+				It("sets the CPU options on the launch template", func() {
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.CPUOptions.CoreCount).To(Equal(float64(2)))
+					Expect(properties.LaunchTemplateData.CPUOptions.ThreadsPerCore).To(Equal(float64(1)))
+				})
+			})
+
+			Context("ng.CPUOptions only sets threadsPerCore", func() {
+				BeforeEach(func() {
+					ng.CPUOptions = &api.CPUOptions{
+						ThreadsPerCore: aws.Int(1),
+					}
+					mockEC2 = &mocks.EC2API{}
+					mockEC2.On("DescribeInstanceTypes",
+						&ec2.DescribeInstanceTypesInput{
+							InstanceTypes: aws.StringSlice([]string{"m5.large"}),
+						},
+					).Return(
+						&ec2.DescribeInstanceTypesOutput{
+							InstanceTypes: []*ec2.InstanceTypeInfo{
+								{
+									InstanceType: aws.String("m5.large"),
+									VCpuInfo: &ec2.VCpuInfo{
+										DefaultCores:          aws.Int64(1),
+										DefaultThreadsPerCore: aws.Int64(2),
+									},
+								},
+							},
+						}, nil,
+					)
+				})
+
+				It("defaults the core count to the core count of the instance type", func() {
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.CPUOptions.CoreCount).To(Equal(float64(1)))
+					Expect(properties.LaunchTemplateData.CPUOptions.ThreadsPerCore).To(Equal(float64(1)))
+				})
+			})
+
+			Context("ng.EnclaveEnabled is not set", func() {
+				It("does not set enclave options on the launch template", func() {
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.EnclaveOptions).To(BeNil())
+				})
+			})
 		})
 	})
 
@@ -4,7 +4,7 @@ import (
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/kris-nova/logger"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 func (r *resourceSet) defineOutput(name string, value interface{}, export bool, fn outputs.Collector) {
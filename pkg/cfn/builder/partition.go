@@ -3,7 +3,7 @@ package builder
 import (
 	"fmt"
 
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 var servicePrincipalPartitionMappings = map[string]map[string]string{
@@ -0,0 +1,99 @@
+package builder
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+// PodIdentityAssociationResourceSet holds the EKS Pod Identity associations stack build-time information
+type PodIdentityAssociationResourceSet struct {
+	template     *cft.Template
+	outputs      *outputs.CollectorSet
+	clusterName  string
+	name         string
+	associations []api.PodIdentityAssociation
+}
+
+// NewPodIdentityAssociationResourceSet returns a resource set for the given EKS Pod Identity associations,
+// and the IAM roles of the associations that don't use an existing role
+func NewPodIdentityAssociationResourceSet(clusterName, name string, associations []api.PodIdentityAssociation) *PodIdentityAssociationResourceSet {
+	return &PodIdentityAssociationResourceSet{
+		template:     cft.NewTemplate(),
+		clusterName:  clusterName,
+		name:         name,
+		associations: associations,
+	}
+}
+
+// WithIAM returns true
+func (*PodIdentityAssociationResourceSet) WithIAM() bool { return true }
+
+// WithNamedIAM returns false
+func (*PodIdentityAssociationResourceSet) WithNamedIAM() bool { return false }
+
+// AddAllResources adds all resources for the stack
+func (rs *PodIdentityAssociationResourceSet) AddAllResources() error {
+	rs.template.Description = fmt.Sprintf("EKS Pod Identity associations for %q %s", rs.name, templateDescriptionSuffix)
+
+	for i, a := range rs.associations {
+		roleARN := cft.NewString(a.RoleARN)
+		if a.RoleARN == "" {
+			roleName := fmt.Sprintf("Role%d", i+1)
+			rs.addRole(roleName, a)
+			roleARN = cft.MakeFnGetAttString(roleName + ".Arn")
+		}
+		rs.template.NewResource(fmt.Sprintf("PodIdentityAssociation%d", i+1), &cft.EKSPodIdentityAssociation{
+			ClusterName:    rs.clusterName,
+			Namespace:      a.Namespace,
+			ServiceAccount: a.ServiceAccountName,
+			RoleArn:        roleARN,
+		})
+	}
+
+	rs.outputs = outputs.NewCollectorSet(nil)
+	return nil
+}
+
+func (rs *PodIdentityAssociationResourceSet) addRole(name string, a api.PodIdentityAssociation) {
+	role := &cft.IAMRole{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForPodIdentity(),
+		PermissionsBoundary:      a.PermissionsBoundaryARN,
+	}
+	for _, arn := range a.PermissionPolicyARNs {
+		role.ManagedPolicyArns = append(role.ManagedPolicyArns, arn)
+	}
+
+	managedPolicies, customPolicies := createWellKnownPolicies(a.WellKnownPolicies)
+	for _, p := range managedPolicies {
+		role.ManagedPolicyArns = append(role.ManagedPolicyArns, makePolicyARN(p.name))
+	}
+	customPolicyARNs, customPolicyDocuments := createCustomWellKnownPolicies(a.WellKnownPolicies)
+	role.ManagedPolicyArns = append(role.ManagedPolicyArns, customPolicyARNs...)
+
+	roleRef := rs.template.NewResource(name, role)
+
+	for _, p := range customPolicies {
+		rs.template.AttachPolicy(name+p.Name, roleRef, cft.MakePolicyDocument(p.Statements...))
+	}
+	for _, p := range customPolicyDocuments {
+		rs.template.AttachPolicy(name+p.name, roleRef, p.document)
+	}
+	if len(a.PermissionPolicy) != 0 {
+		rs.template.AttachPolicy(name+"Policy", roleRef, a.PermissionPolicy)
+	}
+}
+
+// RenderJSON will render the EKS Pod Identity associations stack as JSON
+func (rs *PodIdentityAssociationResourceSet) RenderJSON() ([]byte, error) {
+	return rs.template.RenderJSON()
+}
+
+// GetAllOutputs will get all outputs from the EKS Pod Identity associations stack
+func (rs *PodIdentityAssociationResourceSet) GetAllOutputs(stack cfn.Stack) error {
+	return rs.outputs.MustCollect(stack)
+}
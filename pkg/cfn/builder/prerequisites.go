@@ -0,0 +1,78 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	gfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation"
+)
+
+const prerequisitesTemplateDescription = "EKS cluster prerequisites"
+
+// PrerequisitesResourceSet stores the resources a cluster depends on that can be created ahead of it, possibly in
+// other accounts: its VPC and its service role
+type PrerequisitesResourceSet struct {
+	cluster *ClusterResourceSet
+}
+
+// NewPrerequisitesResourceSet returns a resource set for the prerequisites of a new cluster
+func NewPrerequisitesResourceSet(ec2API ec2iface.EC2API, region string, spec *api.ClusterConfig) *PrerequisitesResourceSet {
+	return &PrerequisitesResourceSet{
+		cluster: NewClusterResourceSet(ec2API, region, spec, false, nil),
+	}
+}
+
+// AddAllResources adds the VPC, unless vpc.id is set, and the service role, unless iam.serviceRoleARN is set
+func (p *PrerequisitesResourceSet) AddAllResources() error {
+	c := p.cluster
+	dedicatedVPC := c.spec.VPC.ID == ""
+	if !dedicatedVPC && api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRoleARN) {
+		return errors.New("no prerequisites to create, as both vpc.id and iam.serviceRoleARN are set")
+	}
+
+	if dedicatedVPC {
+		if err := c.spec.HasSufficientSubnets(); err != nil {
+			return err
+		}
+		if _, _, err := c.vpcResourceSet.CreateTemplate(); err != nil {
+			return errors.Wrap(err, "error adding VPC resources")
+		}
+	}
+
+	if !api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRoleARN) {
+		c.addResourcesForIAM()
+		c.Template().Mappings[servicePrincipalPartitionMapName] = servicePrincipalPartitionMappings
+	}
+
+	c.rs.template.Description = fmt.Sprintf(
+		"%s (dedicated VPC: %v, dedicated IAM: %v) %s",
+		prerequisitesTemplateDescription,
+		dedicatedVPC,
+		c.rs.withIAM,
+		templateDescriptionSuffix,
+	)
+	return nil
+}
+
+// WithIAM states, if IAM roles will be created or not
+func (p *PrerequisitesResourceSet) WithIAM() bool {
+	return p.cluster.WithIAM()
+}
+
+// WithNamedIAM states, if specifically named IAM roles will be created or not
+func (p *PrerequisitesResourceSet) WithNamedIAM() bool {
+	return p.cluster.WithNamedIAM()
+}
+
+// RenderJSON returns the rendered JSON
+func (p *PrerequisitesResourceSet) RenderJSON() ([]byte, error) {
+	return p.cluster.rs.renderJSON()
+}
+
+// Template returns the CloudFormation template
+func (p *PrerequisitesResourceSet) Template() gfn.Template {
+	return p.cluster.Template()
+}
@@ -0,0 +1,70 @@
+package builder_test
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Prerequisites Template Builder", func() {
+	var (
+		cfg      *api.ClusterConfig
+		provider *mockprovider.MockProvider
+	)
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.VPC = vpcConfig()
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+		cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+			IPFamily: api.IPV4Family,
+		}
+	})
+
+	render := func() (*fakes.FakeTemplate, error) {
+		rs := builder.NewPrerequisitesResourceSet(provider.EC2(), provider.Region(), cfg)
+		if err := rs.AddAllResources(); err != nil {
+			return nil, err
+		}
+		templateBody, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		template := &fakes.FakeTemplate{}
+		Expect(json.Unmarshal(templateBody, template)).To(Succeed())
+		return template, nil
+	}
+
+	It("adds the VPC and the service role, but not the control plane", func() {
+		template, err := render()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(template.Description).To(Equal("EKS cluster prerequisites (dedicated VPC: true, dedicated IAM: true) [created and managed by eksctl]"))
+		Expect(template.Resources).To(HaveKey("VPC"))
+		Expect(template.Resources).To(HaveKey("ServiceRole"))
+		Expect(template.Resources).NotTo(HaveKey("ControlPlane"))
+		Expect(template.Resources).NotTo(HaveKey("ControlPlaneSecurityGroup"))
+	})
+
+	It("only adds the service role when vpc.id is set", func() {
+		cfg.VPC.ID = "vpc-123"
+		template, err := render()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(template.Description).To(Equal("EKS cluster prerequisites (dedicated VPC: false, dedicated IAM: true) [created and managed by eksctl]"))
+		Expect(template.Resources).NotTo(HaveKey("VPC"))
+		Expect(template.Resources).To(HaveKey("ServiceRole"))
+	})
+
+	It("errors when both vpc.id and iam.serviceRoleARN are set", func() {
+		cfg.VPC.ID = "vpc-123"
+		cfg.IAM.ServiceRoleARN = aws.String("arn:aws:iam::123456789012:role/eks-service-role")
+		_, err := render()
+		Expect(err).To(MatchError("no prerequisites to create, as both vpc.id and iam.serviceRoleARN are set"))
+	})
+})
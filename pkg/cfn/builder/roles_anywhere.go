@@ -0,0 +1,144 @@
+package builder
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	rolesAnywhereTrustAnchor = "TrustAnchor"
+	rolesAnywhereProfile     = "Profile"
+	rolesAnywhereRole        = "Role"
+)
+
+// RolesAnywhereSpec holds the settings of the IAM Roles Anywhere resources
+type RolesAnywhereSpec struct {
+	// Name of the trust anchor and profile
+	Name string
+	// CertificateBundle is the PEM-encoded CA certificate bundle used as the trust anchor
+	CertificateBundle string
+	// ACMPCAARN is the ARN of an ACM Private CA used as the trust anchor
+	ACMPCAARN string
+	// SessionDuration is the duration, in seconds, of the sessions vended by the profile
+	SessionDuration int
+}
+
+// RolesAnywhereResourceSet holds the IAM Roles Anywhere stack build-time information
+type RolesAnywhereResourceSet struct {
+	template    *cft.Template
+	outputs     *outputs.CollectorSet
+	clusterName string
+	spec        RolesAnywhereSpec
+
+	// RoleARN is the ARN of the IAM role assumed by workloads authenticating with a certificate
+	RoleARN string
+	// TrustAnchorARN is the ARN of the trust anchor
+	TrustAnchorARN string
+	// ProfileARN is the ARN of the profile
+	ProfileARN string
+}
+
+// NewRolesAnywhereResourceSet returns a resource set for an IAM Roles Anywhere trust anchor and profile,
+// and the IAM role they vend credentials for
+func NewRolesAnywhereResourceSet(clusterName string, spec RolesAnywhereSpec) *RolesAnywhereResourceSet {
+	return &RolesAnywhereResourceSet{
+		template:    cft.NewTemplate(),
+		clusterName: clusterName,
+		spec:        spec,
+	}
+}
+
+// WithIAM returns true
+func (*RolesAnywhereResourceSet) WithIAM() bool { return true }
+
+// WithNamedIAM returns false
+func (*RolesAnywhereResourceSet) WithNamedIAM() bool { return false }
+
+// AddAllResources adds all resources for the stack
+func (rs *RolesAnywhereResourceSet) AddAllResources() error {
+	rs.template.Description = fmt.Sprintf("IAM Roles Anywhere profile %q for cluster %q %s", rs.spec.Name, rs.clusterName, templateDescriptionSuffix)
+
+	trustAnchor := &cft.RolesAnywhereTrustAnchor{
+		Name:    rs.spec.Name,
+		Enabled: true,
+	}
+	switch {
+	case rs.spec.ACMPCAARN != "" && rs.spec.CertificateBundle != "":
+		return fmt.Errorf("only one of a certificate bundle and an ACM Private CA can be used as the trust anchor")
+	case rs.spec.ACMPCAARN != "":
+		trustAnchor.Source = cft.RolesAnywhereSource{
+			SourceType: "AWS_ACM_PCA",
+			SourceData: cft.MapOfInterfaces{"AcmPcaArn": rs.spec.ACMPCAARN},
+		}
+	case rs.spec.CertificateBundle != "":
+		trustAnchor.Source = cft.RolesAnywhereSource{
+			SourceType: "CERTIFICATE_BUNDLE",
+			SourceData: cft.MapOfInterfaces{"X509CertificateData": rs.spec.CertificateBundle},
+		}
+	default:
+		return fmt.Errorf("either a certificate bundle or an ACM Private CA must be used as the trust anchor")
+	}
+	rs.template.NewResource(rolesAnywhereTrustAnchor, trustAnchor)
+	trustAnchorARN := cft.MakeFnGetAttString(rolesAnywhereTrustAnchor + ".TrustAnchorArn")
+
+	roleRef := rs.template.NewResource(rolesAnywhereRole, &cft.IAMRole{
+		AssumeRolePolicyDocument: cft.MakePolicyDocument(cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Action": []string{"sts:AssumeRole", "sts:TagSession", "sts:SetSourceIdentity"},
+			"Principal": map[string]string{
+				"Service": "rolesanywhere.amazonaws.com",
+			},
+			"Condition": cft.MapOfInterfaces{
+				"ArnEquals": cft.MapOfInterfaces{
+					"aws:SourceArn": trustAnchorARN,
+				},
+			},
+		}),
+	})
+	// the role only needs to discover the cluster, access to the Kubernetes API is granted by the aws-auth ConfigMap
+	rs.template.AttachPolicy("PolicyDescribeCluster", roleRef, cft.MakePolicyDocument(cft.MapOfInterfaces{
+		"Effect":   "Allow",
+		"Action":   []string{"eks:DescribeCluster"},
+		"Resource": cft.MakeFnSubString(fmt.Sprintf("arn:${AWS::Partition}:eks:${AWS::Region}:${AWS::AccountId}:cluster/%s", rs.clusterName)),
+	}))
+
+	rs.template.NewResource(rolesAnywhereProfile, &cft.RolesAnywhereProfile{
+		Name:            rs.spec.Name,
+		Enabled:         true,
+		RoleArns:        cft.MakeSlice(cft.MakeFnGetAttString(rolesAnywhereRole + ".Arn")),
+		DurationSeconds: rs.spec.SessionDuration,
+	})
+
+	rs.template.Outputs[rolesAnywhereRole] = cft.Output{Value: cft.MakeFnGetAttString(rolesAnywhereRole + ".Arn")}
+	rs.template.Outputs[rolesAnywhereTrustAnchor] = cft.Output{Value: trustAnchorARN}
+	rs.template.Outputs[rolesAnywhereProfile] = cft.Output{Value: cft.MakeFnGetAttString(rolesAnywhereProfile + ".ProfileArn")}
+	rs.outputs = outputs.NewCollectorSet(map[string]outputs.Collector{
+		rolesAnywhereRole: func(v string) error {
+			rs.RoleARN = v
+			return nil
+		},
+		rolesAnywhereTrustAnchor: func(v string) error {
+			rs.TrustAnchorARN = v
+			return nil
+		},
+		rolesAnywhereProfile: func(v string) error {
+			rs.ProfileARN = v
+			return nil
+		},
+	})
+	return nil
+}
+
+// RenderJSON will render the IAM Roles Anywhere stack as JSON
+func (rs *RolesAnywhereResourceSet) RenderJSON() ([]byte, error) {
+	return rs.template.RenderJSON()
+}
+
+// GetAllOutputs will get all outputs from the IAM Roles Anywhere stack
+func (rs *RolesAnywhereResourceSet) GetAllOutputs(stack cfn.Stack) error {
+	return rs.outputs.MustCollect(stack)
+}
@@ -1,9 +1,11 @@
 package builder
 
 import (
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	"fmt"
 
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 const (
@@ -550,3 +552,149 @@ func efsCSIControllerStatements() []cft.MapOfInterfaces {
 		},
 	}
 }
+
+func externalSecretsStatements() []cft.MapOfInterfaces {
+	return []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"secretsmanager:GetResourcePolicy",
+				"secretsmanager:GetSecretValue",
+				"secretsmanager:DescribeSecret",
+				"secretsmanager:ListSecretVersionIds",
+				"ssm:GetParameter",
+				"ssm:GetParameters",
+				"ssm:GetParametersByPath",
+			},
+		},
+	}
+}
+
+func fluentBitStatements() []cft.MapOfInterfaces {
+	return []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"logs:CreateLogGroup",
+				"logs:CreateLogStream",
+				"logs:DescribeLogGroups",
+				"logs:DescribeLogStreams",
+				"logs:PutLogEvents",
+				"logs:PutRetentionPolicy",
+				"firehose:PutRecordBatch",
+				"kinesis:PutRecords",
+			},
+		},
+	}
+}
+
+func veleroStatements() []cft.MapOfInterfaces {
+	return []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"ec2:DescribeVolumes",
+				"ec2:DescribeSnapshots",
+				"ec2:CreateTags",
+				"ec2:CreateVolume",
+				"ec2:CreateSnapshot",
+				"ec2:DeleteSnapshot",
+			},
+		},
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"s3:GetObject",
+				"s3:DeleteObject",
+				"s3:PutObject",
+				"s3:AbortMultipartUpload",
+				"s3:ListMultipartUploadParts",
+				"s3:ListBucket",
+			},
+		},
+	}
+}
+
+func crossplaneStatements() []cft.MapOfInterfaces {
+	return []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"ec2:Describe*",
+				"ec2:CreateTags",
+				"ec2:DeleteTags",
+				"ec2:CreateVpc",
+				"ec2:DeleteVpc",
+				"ec2:ModifyVpcAttribute",
+				"ec2:CreateSubnet",
+				"ec2:DeleteSubnet",
+				"ec2:ModifySubnetAttribute",
+				"ec2:CreateSecurityGroup",
+				"ec2:DeleteSecurityGroup",
+				"ec2:AuthorizeSecurityGroupIngress",
+				"ec2:AuthorizeSecurityGroupEgress",
+				"ec2:RevokeSecurityGroupIngress",
+				"ec2:RevokeSecurityGroupEgress",
+				"ec2:CreateRouteTable",
+				"ec2:DeleteRouteTable",
+				"ec2:CreateRoute",
+				"ec2:DeleteRoute",
+				"ec2:AssociateRouteTable",
+				"ec2:DisassociateRouteTable",
+				"ec2:CreateInternetGateway",
+				"ec2:DeleteInternetGateway",
+				"ec2:AttachInternetGateway",
+				"ec2:DetachInternetGateway",
+			},
+		},
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"s3:*",
+				"rds:*",
+				"elasticache:*",
+				"dynamodb:*",
+				"sqs:*",
+				"sns:*",
+				"ecr:*",
+				"route53:*",
+				"secretsmanager:*",
+			},
+		},
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"kms:DescribeKey",
+				"kms:CreateGrant",
+			},
+		},
+	}
+}
+
+func pullThroughCacheStatements(cache *api.PullThroughCache) []cft.MapOfInterfaces {
+	var repositories []*gfnt.Value
+	for _, rule := range cache.Rules {
+		prefix := rule.ECRRepositoryPrefix
+		if prefix == "" {
+			prefix = api.DefaultECRRepositoryPrefix(rule.UpstreamRegistryURL)
+		}
+		repositories = append(repositories, addARNPartitionPrefix(fmt.Sprintf("ecr:${%s}:${%s}:repository/%s/*", gfnt.Region, gfnt.AccountID, prefix)))
+	}
+	return []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": repositories,
+			"Action": []string{
+				"ecr:CreateRepository",
+				"ecr:BatchImportUpstreamImage",
+			},
+		},
+	}
+}
@@ -3,9 +3,10 @@ package builder
 import (
 	"strings"
 
-	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	gfncfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudformation"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 const (
@@ -22,6 +23,7 @@ const (
 	IPv6CIDRBlockKey = "IPv6CidrBlock"
 	InternetCIDR     = "0.0.0.0/0"
 	InternetIPv6CIDR = "::/0"
+	NAT64CIDR        = "64:ff9b::/96"
 
 	// Routing
 	PubRouteTableKey             = "PublicRouteTable"
@@ -32,6 +34,7 @@ const (
 	PubSubIPv6RouteKey           = "PublicSubnetIPv6DefaultRoute"
 	PrivateSubnetRouteKey        = "PrivateSubnetDefaultRoute"
 	PrivateSubnetIpv6RouteKey    = "PrivateSubnetDefaultIpv6Route"
+	PrivateSubnetNAT64RouteKey   = "PrivateSubnetNAT64Route"
 
 	// Subnets
 	PublicSubnetKey         = "PublicSubnet"
@@ -70,14 +73,22 @@ func getSubnetIPv4CIDRBlock(cidrPartitions int) *gfnt.Value {
 	return refSubnetSlices
 }
 
-func (rs *resourceSet) addEFASecurityGroup(vpcID *gfnt.Value, clusterName, desc string) *gfnt.Value {
+// makeClusterTags returns the kubernetes.io/cluster/<name> tag with the value, or no tags when the value is empty
+func makeClusterTags(clusterConfig *api.ClusterConfig, value string) []gfncfn.Tag {
+	if value == "" {
+		return nil
+	}
+	return []gfncfn.Tag{{
+		Key:   gfnt.NewString(clusterConfig.ClusterTagKey()),
+		Value: gfnt.NewString(value),
+	}}
+}
+
+func (rs *resourceSet) addEFASecurityGroup(vpcID *gfnt.Value, clusterConfig *api.ClusterConfig, desc string) *gfnt.Value {
 	efaSG := rs.newResource("EFASG", &gfnec2.SecurityGroup{
 		VpcId:            vpcID,
 		GroupDescription: gfnt.NewString("EFA-enabled security group"),
-		Tags: []gfncfn.Tag{{
-			Key:   gfnt.NewString("kubernetes.io/cluster/" + clusterName),
-			Value: gfnt.NewString("owned"),
-		}},
+		Tags:             makeClusterTags(clusterConfig, clusterConfig.SecurityGroupClusterTag()),
 	})
 	rs.newResource("EFAIngressSelf", &gfnec2.SecurityGroupIngress{
 		GroupId:               efaSG,
@@ -8,12 +8,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
-
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 // A VPCEndpointResourceSet represents the resources required for VPC endpoints
@@ -50,14 +50,23 @@ type VPCEndpointServiceDetails struct {
 
 // AddResources adds resources for VPC endpoints
 func (e *VPCEndpointResourceSet) AddResources() error {
-	endpointServices := append(api.RequiredEndpointServices(), e.clusterConfig.PrivateCluster.AdditionalEndpointServices...)
+	endpointServices := e.clusterConfig.PrivateCluster.EndpointServices()
 	if e.clusterConfig.HasClusterCloudWatchLogging() && !e.hasEndpoint(api.EndpointServiceCloudWatch) {
 		endpointServices = append(endpointServices, api.EndpointServiceCloudWatch)
 	}
+	existingServices, err := e.existingEndpointServices()
+	if err != nil {
+		return err
+	}
+	endpointServices = withoutServices(endpointServices, existingServices)
+
 	endpointServiceDetails, err := buildVPCEndpointServices(e.ec2API, e.region, endpointServices)
 	if err != nil {
 		return errors.Wrap(err, "error building endpoint service details")
 	}
+	if err := checkCustomEndpointServices(withoutServices(e.clusterConfig.PrivateCluster.CustomEndpointServices, existingServices), endpointServiceDetails, e.region); err != nil {
+		return err
+	}
 
 	for _, endpointDetail := range endpointServiceDetails {
 		endpoint := &gfnec2.VPCEndpoint{
@@ -74,16 +83,75 @@ func (e *VPCEndpointResourceSet) AddResources() error {
 			endpoint.SecurityGroupIds = gfnt.NewSlice(e.clusterSharedSG)
 		}
 
+		if policy, ok := e.clusterConfig.PrivateCluster.EndpointPolicies[endpointDetail.ServiceReadableName]; ok {
+			endpoint.PolicyDocument = policy
+		}
+
 		resourceName := fmt.Sprintf("VPCEndpoint%s", strings.ToUpper(
 			strings.ReplaceAll(endpointDetail.ServiceReadableName, ".", ""),
 		))
 
-		// TODO attach policy document
 		e.rs.newResource(resourceName, endpoint)
 	}
 	return nil
 }
 
+// existingEndpointServices returns the services of the existing VPC endpoints to use instead of creating endpoints
+func (e *VPCEndpointResourceSet) existingEndpointServices() (map[string]bool, error) {
+	ids := e.clusterConfig.PrivateCluster.ExistingEndpointIDs
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	output, err := e.ec2API.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+		VpcEndpointIds: aws.StringSlice(ids),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error describing existing VPC endpoints")
+	}
+
+	services := make(map[string]bool)
+	for _, endpoint := range output.VpcEndpoints {
+		if vpcID := aws.StringValue(endpoint.VpcId); vpcID != e.clusterConfig.VPC.ID {
+			return nil, errors.Errorf("VPC endpoint %q belongs to VPC %q, not to the cluster VPC %q", *endpoint.VpcEndpointId, vpcID, e.clusterConfig.VPC.ID)
+		}
+		service, err := readableServiceName(*endpoint.ServiceName, e.region)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("using existing VPC endpoint %q for endpoint service %q", *endpoint.VpcEndpointId, service)
+		services[service] = true
+	}
+	return services, nil
+}
+
+// withoutServices returns the endpoint services that are not in the excluded set
+func withoutServices(services []string, excluded map[string]bool) []string {
+	var ret []string
+	for _, service := range services {
+		if !excluded[service] {
+			ret = append(ret, service)
+		}
+	}
+	return ret
+}
+
+// checkCustomEndpointServices checks that the custom endpoint services have interface endpoints in the region
+func checkCustomEndpointServices(services []string, details []VPCEndpointServiceDetails, region string) error {
+	for _, service := range services {
+		found := false
+		for _, d := range details {
+			if d.ServiceReadableName == service {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("endpoint service %q has no interface endpoints in region %q", service, region)
+		}
+	}
+	return nil
+}
+
 func (e *VPCEndpointResourceSet) subnetsForAZs(azs []string) []*gfnt.Value {
 	var subnetRefs []*gfnt.Value
 	for _, az := range azs {
@@ -134,13 +202,8 @@ var chinaPartitionServiceHasChinaPrefix = map[string]bool{
 // buildVPCEndpointServices builds a slice of VPCEndpointServiceDetails for the specified endpoint names
 func buildVPCEndpointServices(ec2API ec2iface.EC2API, region string, endpoints []string) ([]VPCEndpointServiceDetails, error) {
 	serviceNames := make([]string, len(endpoints))
-	serviceDomain := fmt.Sprintf("com.amazonaws.%s", region)
 	for i, endpoint := range endpoints {
-		serviceName, err := makeServiceName(region, endpoint)
-		if err != nil {
-			return nil, err
-		}
-		serviceNames[i] = serviceName
+		serviceNames[i] = makeServiceName(region, endpoint)
 	}
 
 	var serviceDetails []*ec2.ServiceDetail
@@ -175,10 +238,7 @@ func buildVPCEndpointServices(ec2API ec2iface.EC2API, region string, endpoints [
 	}
 
 	var ret []VPCEndpointServiceDetails
-	s3EndpointName, err := makeServiceName(region, api.EndpointServiceS3)
-	if err != nil {
-		return nil, err
-	}
+	s3EndpointName := makeServiceName(region, api.EndpointServiceS3)
 
 	for _, sd := range serviceDetails {
 		if len(sd.ServiceType) > 1 {
@@ -190,12 +250,10 @@ func buildVPCEndpointServices(ec2API ec2iface.EC2API, region string, endpoints [
 			continue
 		}
 
-		// Trim the domain (potentially with a partition-specific part) from the `ServiceName`
-		parts := strings.Split(*sd.ServiceName, fmt.Sprintf("%s.", serviceDomain))
-		if len(parts) != 2 {
-			return nil, errors.Errorf("error parsing service name %s %s", *sd.ServiceName, serviceDomain)
+		readableName, err := readableServiceName(*sd.ServiceName, region)
+		if err != nil {
+			return nil, err
 		}
-		readableName := parts[1]
 
 		ret = append(ret, VPCEndpointServiceDetails{
 			ServiceName:         *sd.ServiceName,
@@ -208,6 +266,16 @@ func buildVPCEndpointServices(ec2API ec2iface.EC2API, region string, endpoints [
 	return ret, nil
 }
 
+// readableServiceName trims the domain (potentially with a partition-specific part) from the service name
+func readableServiceName(serviceName, region string) (string, error) {
+	serviceDomain := fmt.Sprintf("com.amazonaws.%s", region)
+	parts := strings.Split(serviceName, fmt.Sprintf("%s.", serviceDomain))
+	if len(parts) != 2 {
+		return "", errors.Errorf("error parsing service name %s %s", serviceName, serviceDomain)
+	}
+	return parts[1], nil
+}
+
 // serviceEndpointTypeExpected returns true if the endpoint service is expected to use the specified endpoint type
 func serviceEndpointTypeExpected(serviceName, endpointType, s3EndpointName string) bool {
 	if serviceName == s3EndpointName {
@@ -216,14 +284,11 @@ func serviceEndpointTypeExpected(serviceName, endpointType, s3EndpointName strin
 	return endpointType == ec2.VpcEndpointTypeInterface
 }
 
-func makeServiceName(region, endpoint string) (string, error) {
+func makeServiceName(region, endpoint string) string {
 	serviceName := fmt.Sprintf("com.amazonaws.%s.%s", region, endpoint)
-	hasChinaPrefix, ok := chinaPartitionServiceHasChinaPrefix[endpoint]
-	if !ok {
-		return "", errors.Errorf("couldn't determine endpoint domain for service %s", endpoint)
-	}
-	if api.Partition(region) == api.PartitionChina && hasChinaPrefix {
+	// services without a China prefix, e.g. custom endpoint services, use the same service name in all partitions
+	if api.Partition(region) == api.PartitionChina && chinaPartitionServiceHasChinaPrefix[endpoint] {
 		serviceName = "cn." + serviceName
 	}
-	return serviceName, nil
+	return serviceName
 }
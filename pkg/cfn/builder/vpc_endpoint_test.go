@@ -16,9 +16,9 @@ import (
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"github.com/weaveworks/eksctl/pkg/vpc"
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 )
 
 type vpcResourceSetCase struct {
@@ -275,6 +275,151 @@ var _ = Describe("VPC Endpoint Builder", func() {
 			err: "subnets must be associated with a non-main route table",
 		}),
 	)
+
+	It("renders the endpoint policies into the endpoint resources", func() {
+		s3Policy := api.InlineDocument{
+			"Version": "2012-10-17",
+			"Statement": []interface{}{
+				map[string]interface{}{
+					"Effect":    "Allow",
+					"Principal": "*",
+					"Action":    []interface{}{"s3:GetObject"},
+					"Resource":  []interface{}{"arn:aws:s3:::prod-us-west-2-starport-layer-bucket/*"},
+				},
+			},
+		}
+		clusterConfig := &api.ClusterConfig{
+			Metadata: &api.ClusterMeta{
+				Region: "us-west-2",
+			},
+			VPC:               api.NewClusterVPC(),
+			AvailabilityZones: []string{"us-west-2a", "us-west-2b"},
+			PrivateCluster: &api.PrivateCluster{
+				Enabled: true,
+				EndpointPolicies: map[string]api.InlineDocument{
+					api.EndpointServiceS3: s3Policy,
+				},
+			},
+		}
+		api.SetClusterConfigDefaults(clusterConfig)
+		Expect(vpc.SetSubnets(clusterConfig.VPC, clusterConfig.AvailabilityZones)).To(Succeed())
+
+		provider := mockprovider.NewMockProvider()
+		mockDescribeVPCEndpoints(provider, false)
+
+		rs := newResourceSet()
+		vpcID, subnetDetails, err := NewIPv4VPCResourceSet(rs, clusterConfig, provider.EC2()).CreateTemplate()
+		Expect(err).NotTo(HaveOccurred())
+		vpcEndpointResourceSet := NewVPCEndpointResourceSet(provider.EC2(), provider.Region(), rs, clusterConfig, vpcID, subnetDetails.Private, gfnt.NewString("sg-test"))
+		Expect(vpcEndpointResourceSet.AddResources()).To(Succeed())
+
+		Expect(rs.template.Resources["VPCEndpointS3"].(*gfnec2.VPCEndpoint).PolicyDocument).To(Equal(s3Policy))
+		Expect(rs.template.Resources["VPCEndpointEC2"].(*gfnec2.VPCEndpoint).PolicyDocument).To(BeNil())
+	})
+
+	Context("custom and existing endpoints", func() {
+		var (
+			clusterConfig *api.ClusterConfig
+			provider      *mockprovider.MockProvider
+		)
+
+		BeforeEach(func() {
+			clusterConfig = &api.ClusterConfig{
+				Metadata: &api.ClusterMeta{
+					Region: "us-west-2",
+				},
+				VPC: &api.ClusterVPC{
+					Network: api.Network{
+						ID: "vpc-custom",
+					},
+					Subnets: &api.ClusterSubnets{
+						Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+							"us-west-2a": {
+								ID: "subnet-custom1",
+							},
+							"us-west-2b": {
+								ID: "subnet-custom2",
+							},
+						}),
+					},
+				},
+				PrivateCluster: &api.PrivateCluster{
+					Enabled:                true,
+					CustomEndpointServices: []string{"kms"},
+					ExistingEndpointIDs:    []string{"vpce-1234"},
+				},
+			}
+			api.SetClusterConfigDefaults(clusterConfig)
+
+			provider = mockprovider.NewMockProvider()
+			mockDescribeVPC(provider)
+			mockDescribeRouteTables(provider, []string{"subnet-custom1", "subnet-custom2"})
+
+			var output *ec2.DescribeVpcEndpointServicesOutput
+			Expect(json.Unmarshal([]byte(serviceDetailsJSON), &output)).To(Succeed())
+			var serviceDetails []*ec2.ServiceDetail
+			for _, sd := range output.ServiceDetails {
+				if *sd.ServiceName != "com.amazonaws.us-west-2.ecr.api" {
+					serviceDetails = append(serviceDetails, sd)
+				}
+			}
+			output.ServiceDetails = append(serviceDetails, &ec2.ServiceDetail{
+				ServiceName:       aws.String("com.amazonaws.us-west-2.kms"),
+				ServiceType:       []*ec2.ServiceTypeDetail{{ServiceType: aws.String(ec2.VpcEndpointTypeInterface)}},
+				AvailabilityZones: aws.StringSlice([]string{"us-west-2a", "us-west-2b"}),
+			})
+			provider.MockEC2().On("DescribeVpcEndpointServices", mock.MatchedBy(func(input *ec2.DescribeVpcEndpointServicesInput) bool {
+				return len(input.ServiceNames) == 5
+			})).Return(output, nil)
+		})
+
+		addResources := func() (*resourceSet, error) {
+			rs := newResourceSet()
+			vpcID, subnetDetails, err := NewExistingVPCResourceSet(rs, clusterConfig, provider.EC2()).CreateTemplate()
+			Expect(err).NotTo(HaveOccurred())
+			return rs, NewVPCEndpointResourceSet(provider.EC2(), provider.Region(), rs, clusterConfig, vpcID, subnetDetails.Private, gfnt.NewString("sg-test")).AddResources()
+		}
+
+		mockDescribeExistingEndpoint := func(vpcID string) {
+			provider.MockEC2().On("DescribeVpcEndpoints", &ec2.DescribeVpcEndpointsInput{
+				VpcEndpointIds: aws.StringSlice([]string{"vpce-1234"}),
+			}).Return(&ec2.DescribeVpcEndpointsOutput{
+				VpcEndpoints: []*ec2.VpcEndpoint{
+					{
+						VpcEndpointId: aws.String("vpce-1234"),
+						VpcId:         aws.String(vpcID),
+						ServiceName:   aws.String("com.amazonaws.us-west-2.ecr.api"),
+					},
+				},
+			}, nil)
+		}
+
+		It("creates the custom endpoints, and no endpoints for the services of the existing ones", func() {
+			mockDescribeExistingEndpoint("vpc-custom")
+			rs, err := addResources()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rs.template.Resources).To(HaveKey("VPCEndpointKMS"))
+			kmsEndpoint := rs.template.Resources["VPCEndpointKMS"].(*gfnec2.VPCEndpoint)
+			Expect(kmsEndpoint.ServiceName).To(Equal(gfnt.NewString("com.amazonaws.us-west-2.kms")))
+			Expect(kmsEndpoint.VpcEndpointType).To(Equal(gfnt.NewString(ec2.VpcEndpointTypeInterface)))
+			Expect(rs.template.Resources).To(HaveKey("VPCEndpointECRDKR"))
+			Expect(rs.template.Resources).NotTo(HaveKey("VPCEndpointECRAPI"))
+		})
+
+		It("fails when an existing endpoint is not in the cluster VPC", func() {
+			mockDescribeExistingEndpoint("vpc-other")
+			_, err := addResources()
+			Expect(err).To(MatchError(`VPC endpoint "vpce-1234" belongs to VPC "vpc-other", not to the cluster VPC "vpc-custom"`))
+		})
+
+		It("fails when a custom endpoint service has no interface endpoints", func() {
+			mockDescribeExistingEndpoint("vpc-custom")
+			clusterConfig.PrivateCluster.CustomEndpointServices = []string{"elasticfilesystem"}
+			_, err := addResources()
+			Expect(err).To(MatchError(`endpoint service "elasticfilesystem" has no interface endpoints in region "us-west-2"`))
+		})
+	})
 })
 
 var serviceDetailsJSON = `
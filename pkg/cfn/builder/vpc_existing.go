@@ -11,8 +11,8 @@ import (
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"github.com/weaveworks/eksctl/pkg/vpc"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 )
 
 type ExistingVPCResourceSet struct {
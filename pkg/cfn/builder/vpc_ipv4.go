@@ -2,15 +2,17 @@ package builder
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
-	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	gfncfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudformation"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
@@ -68,6 +70,8 @@ func (v *IPv4VPCResourceSet) addResources() error {
 		EnableDnsHostnames: gfnt.True(),
 	})
 
+	v.addSecondaryCIDRs()
+
 	if api.IsEnabled(vpc.AutoAllocateIPv6) {
 		v.rs.newResource("AutoAllocatedCIDRv6", &gfnec2.VPCCidrBlock{
 			VpcId:                       v.vpcID,
@@ -177,12 +181,12 @@ func (v *IPv4VPCResourceSet) addSubnets(refRT *gfnt.Value, topology api.SubnetTo
 
 	var subnetResources []SubnetResource
 
-	for name, subnet := range subnets {
-		az := subnet.AZ
+	for name, spec := range subnets {
+		az := spec.AZ
 		nameAlias := strings.ToUpper(strings.Join(strings.Split(name, "-"), ""))
 		subnet := &gfnec2.Subnet{
 			AvailabilityZone: gfnt.NewString(az),
-			CidrBlock:        gfnt.NewString(subnet.CIDR.String()),
+			CidrBlock:        gfnt.NewString(spec.CIDR.String()),
 			VpcId:            v.vpcID,
 		}
 
@@ -201,6 +205,10 @@ func (v *IPv4VPCResourceSet) addSubnets(refRT *gfnt.Value, topology api.SubnetTo
 			}}
 			subnet.MapPublicIpOnLaunch = gfnt.True()
 		}
+		subnet.Tags = append(subnet.Tags, makeClusterTags(v.clusterConfig, v.clusterConfig.SubnetClusterTag())...)
+		if secondaryCIDR := v.secondaryCIDRResource(spec.CIDR); secondaryCIDR != "" {
+			subnet.AWSCloudFormationDependsOn = []string{secondaryCIDR}
+		}
 		subnetAlias := string(topology) + nameAlias
 		refSubnet := v.rs.newResource("Subnet"+subnetAlias, subnet)
 		v.rs.newResource("RouteTableAssociation"+subnetAlias, &gfnec2.SubnetRouteTableAssociation{
@@ -226,6 +234,35 @@ func (v *IPv4VPCResourceSet) addSubnets(refRT *gfnt.Value, topology api.SubnetTo
 	return subnetResources
 }
 
+// addSecondaryCIDRs associates the secondary IPv4 CIDR blocks with the VPC
+func (v *IPv4VPCResourceSet) addSecondaryCIDRs() {
+	for i, cidr := range v.clusterConfig.VPC.SecondaryCIDRs {
+		v.rs.newResource(secondaryCIDRResourceName(i), &gfnec2.VPCCidrBlock{
+			VpcId:     v.vpcID,
+			CidrBlock: gfnt.NewString(cidr),
+		})
+	}
+}
+
+// secondaryCIDRResource returns the name of the resource associating the secondary CIDR block
+// the subnet is carved from, which must be created before the subnet
+func (v *IPv4VPCResourceSet) secondaryCIDRResource(subnetCIDR *ipnet.IPNet) string {
+	if subnetCIDR == nil {
+		return ""
+	}
+	for i, cidr := range v.clusterConfig.VPC.SecondaryCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err == nil && network.Contains(subnetCIDR.IP) {
+			return secondaryCIDRResourceName(i)
+		}
+	}
+	return ""
+}
+
+func secondaryCIDRResourceName(index int) string {
+	return fmt.Sprintf("SecondaryCIDR%d", index)
+}
+
 func (v *IPv4VPCResourceSet) addNATGateways() error {
 	switch *v.clusterConfig.VPC.NAT.Gateway {
 	case api.ClusterHighlyAvailableNAT:
@@ -11,7 +11,8 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder/fakes"
 	"github.com/weaveworks/eksctl/pkg/eks/mocks"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 )
 
 var _ = Describe("VPC Template Builder", func() {
@@ -163,6 +164,26 @@ var _ = Describe("VPC Template Builder", func() {
 			Expect(vpcTemplate.Resources[rtaPrivateB].Properties.RouteTableID).To(Equal(makeRef(privRouteTableB)))
 		})
 
+		It("does not tag the subnets with the cluster tag by default", func() {
+			Expect(vpcTemplate.Resources[privateSubnetRef1].Properties.Tags).To(HaveLen(2))
+		})
+
+		Context("vpc.clusterTags.subnets is set", func() {
+			BeforeEach(func() {
+				cfg.Metadata.Name = "my-cluster"
+				cfg.VPC.ClusterTags = &api.ClusterTags{Subnets: api.ClusterTagShared}
+			})
+
+			It("tags the subnets with the cluster tag", func() {
+				for _, subnetRef := range []string{publicSubnetRef1, privateSubnetRef1} {
+					Expect(vpcTemplate.Resources[subnetRef].Properties.Tags).To(ContainElement(fakes.Tag{
+						Key:   "kubernetes.io/cluster/my-cluster",
+						Value: "shared",
+					}))
+				}
+			})
+		})
+
 		Context("highly available nat is set", func() {
 			BeforeEach(func() {
 				*cfg.VPC.NAT.Gateway = api.ClusterHighlyAvailableNAT
@@ -288,6 +309,32 @@ var _ = Describe("VPC Template Builder", func() {
 			})
 		})
 
+		Context("when secondary CIDRs are set", func() {
+			BeforeEach(func() {
+				cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/16", "100.65.0.0/16"}
+				cfg.VPC.Subnets.Private = api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+					azA: {CIDR: ipnet.MustParseCIDR("100.64.0.0/19")},
+					azB: {CIDR: ipnet.MustParseCIDR("100.64.32.0/19")},
+				})
+			})
+
+			It("associates the secondary CIDR blocks with the VPC", func() {
+				Expect(vpcTemplate.Resources).To(HaveKey("SecondaryCIDR0"))
+				Expect(vpcTemplate.Resources["SecondaryCIDR0"].Type).To(Equal("AWS::EC2::VPCCidrBlock"))
+				Expect(vpcTemplate.Resources["SecondaryCIDR0"].Properties.CidrBlock).To(Equal("100.64.0.0/16"))
+				Expect(vpcTemplate.Resources["SecondaryCIDR0"].Properties.VpcID).To(Equal(makeRef(vpcResourceKey)))
+				Expect(vpcTemplate.Resources).To(HaveKey("SecondaryCIDR1"))
+				Expect(vpcTemplate.Resources["SecondaryCIDR1"].Properties.CidrBlock).To(Equal("100.65.0.0/16"))
+			})
+
+			It("creates the subnets carved from a secondary CIDR after its association", func() {
+				Expect(vpcTemplate.Resources[privateSubnetRef1].Properties.CidrBlock).To(Equal("100.64.0.0/19"))
+				Expect(vpcTemplate.Resources[privateSubnetRef1].DependsOn).To(ConsistOf("SecondaryCIDR0"))
+				Expect(vpcTemplate.Resources[privateSubnetRef2].DependsOn).To(ConsistOf("SecondaryCIDR0"))
+				Expect(vpcTemplate.Resources[publicSubnetRef1].DependsOn).To(BeEmpty())
+			})
+		})
+
 		Context("when the vpc is fully private", func() {
 			BeforeEach(func() {
 				cfg.PrivateCluster.Enabled = true
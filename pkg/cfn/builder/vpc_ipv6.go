@@ -3,13 +3,14 @@ package builder
 import (
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
-	"github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
-	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudformation"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
@@ -72,6 +73,10 @@ func (v *IPv6VPCResourceSet) CreateTemplate() (*gfnt.Value, *SubnetDetails, erro
 	}
 	addSubnetOutput(privateSubnetResourceRefs, api.SubnetTopologyPrivate, outputs.ClusterSubnetsPrivate)
 
+	// IPv6 traffic of the private subnets egresses through an egress-only internet gateway, which does not allow
+	// inbound connections, so it is also used by fully-private clusters, whose IPv4 traffic never leaves the VPC
+	v.addEgressOnlyIPv6Routes()
+
 	if v.isFullyPrivate() {
 		return vpcResourceRef, &SubnetDetails{
 			Private: privateSubnets,
@@ -120,9 +125,6 @@ func (v *IPv6VPCResourceSet) CreateTemplate() (*gfnt.Value, *SubnetDetails, erro
 		RouteTableId:               gfnt.MakeRef(PubRouteTableKey),
 	})
 
-	v.rs.newResource(EgressOnlyInternetGatewayKey, &gfnec2.EgressOnlyInternetGateway{
-		VpcId: gfnt.MakeRef(VPCResourceKey),
-	})
 	var publicSubnets []SubnetResource
 	for i, az := range v.clusterConfig.AvailabilityZones {
 		azFormatted := formatAZ(az)
@@ -136,12 +138,16 @@ func (v *IPv6VPCResourceSet) CreateTemplate() (*gfnt.Value, *SubnetDetails, erro
 			SubnetId:     gfnt.MakeRef(PublicSubnetKey + azFormatted),
 		})
 
-		v.rs.newResource(PrivateSubnetIpv6RouteKey+azFormatted, &gfnec2.Route{
-			DestinationIpv6CidrBlock:    gfnt.NewString(InternetIPv6CIDR),
-			EgressOnlyInternetGatewayId: gfnt.MakeRef(EgressOnlyInternetGatewayKey),
-			RouteTableId:                gfnt.MakeRef(PrivateRouteTableKey + azFormatted),
-		})
-
+		if v.clusterConfig.IPv6Native() {
+			// IPv6-only nodes reach IPv4 destinations through DNS64 and the NAT64 translation of the NAT gateway
+			v.rs.newResource(PrivateSubnetNAT64RouteKey+azFormatted, &gfnec2.Route{
+				AWSCloudFormationDependsOn: []string{NATGatewayKey, GAKey},
+				DestinationIpv6CidrBlock:   gfnt.NewString(NAT64CIDR),
+				NatGatewayId:               gfnt.MakeRef(NATGatewayKey),
+				RouteTableId:               gfnt.MakeRef(PrivateRouteTableKey + azFormatted),
+			})
+			continue
+		}
 		v.rs.newResource(PrivateSubnetRouteKey+azFormatted, &gfnec2.Route{
 			AWSCloudFormationDependsOn: []string{NATGatewayKey, GAKey},
 			DestinationCidrBlock:       gfnt.NewString(InternetCIDR),
@@ -157,6 +163,21 @@ func (v *IPv6VPCResourceSet) CreateTemplate() (*gfnt.Value, *SubnetDetails, erro
 	}, nil
 }
 
+func (v *IPv6VPCResourceSet) addEgressOnlyIPv6Routes() {
+	v.rs.newResource(EgressOnlyInternetGatewayKey, &gfnec2.EgressOnlyInternetGateway{
+		VpcId: gfnt.MakeRef(VPCResourceKey),
+	})
+
+	for _, az := range v.clusterConfig.AvailabilityZones {
+		azFormatted := formatAZ(az)
+		v.rs.newResource(PrivateSubnetIpv6RouteKey+azFormatted, &gfnec2.Route{
+			DestinationIpv6CidrBlock:    gfnt.NewString(InternetIPv6CIDR),
+			EgressOnlyInternetGatewayId: gfnt.MakeRef(EgressOnlyInternetGatewayKey),
+			RouteTableId:                gfnt.MakeRef(PrivateRouteTableKey + azFormatted),
+		})
+	}
+}
+
 func (v *IPv6VPCResourceSet) addIpv6CidrBlock() {
 	if v.clusterConfig.VPC.IPv6Cidr != "" {
 		v.rs.newResource(IPv6CIDRBlockKey, &gfnec2.VPCCidrBlock{
@@ -195,7 +216,7 @@ func (v *IPv6VPCResourceSet) createSubnet(az, azFormatted string, i, cidrPartiti
 		elbTagKey = "kubernetes.io/role/internal-elb"
 	}
 
-	return v.rs.newResource(subnetKey, &gfnec2.Subnet{
+	subnet := &gfnec2.Subnet{
 		AWSCloudFormationDependsOn:  []string{IPv6CIDRBlockKey},
 		AvailabilityZone:            gfnt.NewString(az),
 		CidrBlock:                   gfnt.MakeFnSelect(gfnt.NewInteger(i), getSubnetIPv4CIDRBlock(cidrPartitions)),
@@ -203,9 +224,21 @@ func (v *IPv6VPCResourceSet) createSubnet(az, azFormatted string, i, cidrPartiti
 		MapPublicIpOnLaunch:         mapPublicIPOnLaunch,
 		AssignIpv6AddressOnCreation: assignIpv6AddressOnCreation,
 		VpcId:                       gfnt.MakeRef(VPCResourceKey),
-		Tags: []cloudformation.Tag{{
+		Tags: append([]cloudformation.Tag{{
 			Key:   gfnt.NewString(elbTagKey),
 			Value: gfnt.NewString("1"),
-		}},
-	})
+		}}, makeClusterTags(v.clusterConfig, v.clusterConfig.SubnetClusterTag())...),
+	}
+
+	if private && v.clusterConfig.IPv6Native() {
+		subnet.CidrBlock = nil
+		subnet.Ipv6Native = gfnt.True()
+		subnet.EnableDns64 = gfnt.True()
+		subnet.PrivateDnsNameOptionsOnLaunch = &gfnec2.Subnet_PrivateDnsNameOptionsOnLaunch{
+			HostnameType:                    gfnt.NewString(ec2.HostnameTypeResourceName),
+			EnableResourceNameDnsAAAARecord: gfnt.True(),
+		}
+	}
+
+	return v.rs.newResource(subnetKey, subnet)
 }
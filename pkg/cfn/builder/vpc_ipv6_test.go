@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -333,7 +334,7 @@ var _ = Describe("IPv6 VPC builder", func() {
 	})
 
 	When("private cluster is enabled", func() {
-		It("creates only private IPv6 resources", func() {
+		It("creates only private IPv6 resources, with IPv6 egress and no NAT", func() {
 			cfg := cfg.DeepCopy()
 			cfg.PrivateCluster = &api.PrivateCluster{
 				Enabled: true,
@@ -390,8 +391,9 @@ var _ = Describe("IPv6 VPC builder", func() {
 			By("creating a VPC gateway attachment to associate the IGW with the VPC")
 			Expect(vpcTemplate.Resources).ToNot(HaveKey(builder.GAKey))
 
-			By("creating a VPC gateway attachment to associate the IGW with the VPC")
-			Expect(vpcTemplate.Resources).ToNot(HaveKey(builder.EgressOnlyInternetGatewayKey))
+			By("creating the egress only internet gateway")
+			Expect(vpcTemplate.Resources).To(HaveKey(builder.EgressOnlyInternetGatewayKey))
+			Expect(vpcTemplate.Resources[builder.EgressOnlyInternetGatewayKey].Type).To(Equal("AWS::EC2::EgressOnlyInternetGateway"))
 
 			By("creating the NAT gateway")
 			Expect(vpcTemplate.Resources).ToNot(HaveKey(builder.NATGatewayKey))
@@ -441,10 +443,17 @@ var _ = Describe("IPv6 VPC builder", func() {
 			privateRouteB := builder.PrivateSubnetRouteKey + azBFormatted
 			Expect(vpcTemplate.Resources).NotTo(HaveKey(privateRouteB))
 
-			By("creating a ipv6 route to the ingress only internet gateway for each private subnet in the AZs")
-			privateRouteA = builder.PrivateSubnetIpv6RouteKey + azAFormatted
-			Expect(vpcTemplate.Resources).NotTo(HaveKey(privateRouteA))
-			Expect(vpcTemplate.Resources).NotTo(HaveKey(privateRouteB))
+			By("creating a ipv6 route to the egress only internet gateway for each private subnet in the AZs")
+			for _, privateRouteTable := range []string{privateRouteTableA, privateRouteTableB} {
+				privateRoute := builder.PrivateSubnetIpv6RouteKey + strings.TrimPrefix(privateRouteTable, builder.PrivateRouteTableKey)
+				Expect(vpcTemplate.Resources).To(HaveKey(privateRoute))
+				Expect(vpcTemplate.Resources[privateRoute].Type).To(Equal("AWS::EC2::Route"))
+				Expect(vpcTemplate.Resources[privateRoute].Properties).To(Equal(fakes.Properties{
+					DestinationIpv6CidrBlock:    builder.InternetIPv6CIDR,
+					EgressOnlyInternetGatewayID: map[string]interface{}{"Ref": builder.EgressOnlyInternetGatewayKey},
+					RouteTableID:                map[string]interface{}{"Ref": privateRouteTable},
+				}))
+			}
 
 			By("creating a private subnet for each AZ")
 			assertSubnetSet := func(az, subnetKey, kubernetesTag string, cidrBlockIndex float64, mapPublicIpOnLaunch bool) {
@@ -528,6 +537,47 @@ var _ = Describe("IPv6 VPC builder", func() {
 		})
 	})
 
+	When("the VPC is IPv6-native", func() {
+		BeforeEach(func() {
+			cfg.VPC.IPFamily = api.IPV6Family
+		})
+
+		It("creates IPv6-only private subnets with DNS64 and a NAT64 route", func() {
+			vpcRs := builder.NewIPv6VPCResourceSet(builder.NewRS(), cfg, nil)
+			vpcTemplate, err := createAndRenderTemplate(vpcRs)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, azFormatted := range []string{azAFormatted, azBFormatted} {
+				privateSubnet := vpcTemplate.Resources[builder.PrivateSubnetKey+azFormatted].Properties
+				Expect(privateSubnet.CidrBlock).To(BeNil())
+				Expect(privateSubnet.Ipv6CidrBlock).NotTo(BeNil())
+				Expect(privateSubnet.Ipv6Native).To(BeTrue())
+				Expect(privateSubnet.EnableDNS64).To(BeTrue())
+				Expect(*privateSubnet.AssignIpv6AddressOnCreation).To(BeTrue())
+				Expect(privateSubnet.PrivateDNSNameOptionsOnLaunch).To(Equal(map[string]interface{}{
+					"EnableResourceNameDnsAAAARecord": true,
+					"HostnameType":                    "resource-name",
+				}))
+
+				By("keeping the public subnets dual-stack")
+				publicSubnet := vpcTemplate.Resources[builder.PublicSubnetKey+azFormatted].Properties
+				Expect(publicSubnet.CidrBlock).NotTo(BeNil())
+				Expect(publicSubnet.Ipv6Native).To(BeFalse())
+
+				By("routing NAT64 traffic through the NAT gateway instead of IPv4 traffic")
+				Expect(vpcTemplate.Resources).NotTo(HaveKey(builder.PrivateSubnetRouteKey + azFormatted))
+				Expect(vpcTemplate.Resources).To(HaveKey(builder.PrivateSubnetNAT64RouteKey + azFormatted))
+				route := vpcTemplate.Resources[builder.PrivateSubnetNAT64RouteKey+azFormatted]
+				Expect(route.Type).To(Equal("AWS::EC2::Route"))
+				Expect(route.Properties.DestinationIpv6CidrBlock).To(Equal(builder.NAT64CIDR))
+				Expect(route.Properties.NatGatewayID).To(Equal(map[string]interface{}{"Ref": builder.NATGatewayKey}))
+				Expect(route.Properties.RouteTableID).To(Equal(map[string]interface{}{"Ref": builder.PrivateRouteTableKey + azFormatted}))
+
+				Expect(vpcTemplate.Resources).To(HaveKey(builder.PrivateSubnetIpv6RouteKey + azFormatted))
+			}
+		})
+	})
+
 	Context("when there are 3 AZs", func() {
 		BeforeEach(func() {
 			cfg.AvailabilityZones = []string{azA, azB, azC}
@@ -0,0 +1,29 @@
+package manager
+
+import (
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// MakeAlarmsStackName returns the name of the stack holding the CloudWatch alarms of the cluster
+func (c *StackCollection) MakeAlarmsStackName() string {
+	return c.spec.StackName("eksctl-" + c.spec.Metadata.Name + "-alarms")
+}
+
+// GetAlarmsStack returns the stack holding the CloudWatch alarms of the cluster, if any
+func (c *StackCollection) GetAlarmsStack() (*Stack, error) {
+	stacks, err := c.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range stacks {
+		if *s.StackStatus == cfn.StackStatusDeleteComplete {
+			continue
+		}
+		if *s.StackName == c.MakeAlarmsStackName() {
+			return s, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -15,12 +15,15 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+	"github.com/weaveworks/eksctl/pkg/utils/operation"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -73,12 +76,16 @@ type StackCollection struct {
 	iamAPI            iamiface.IAMAPI
 	cloudTrailAPI     cloudtrailiface.CloudTrailAPI
 	asgAPI            autoscalingiface.AutoScalingAPI
+	s3API             s3iface.S3API
+	stsAPI            awsapi.STS
 	spec              *api.ClusterConfig
 	disableRollback   bool
 	roleARN           string
+	templateBucket    string
 	region            string
 	waitTimeout       time.Duration
 	sharedTags        []*cloudformation.Tag
+	changeSetApprover ChangeSetApprover
 }
 
 func newTag(key, value string) *cloudformation.Tag {
@@ -104,24 +111,39 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) *
 		iamAPI:            provider.IAM(),
 		cloudTrailAPI:     provider.CloudTrail(),
 		asgAPI:            provider.ASG(),
+		s3API:             provider.S3(),
+		stsAPI:            provider.STSV2(),
 		disableRollback:   provider.CloudFormationDisableRollback(),
 		roleARN:           provider.CloudFormationRoleARN(),
+		templateBucket:    provider.CloudFormationTemplateBucket(),
 		region:            provider.Region(),
 		waitTimeout:       provider.WaitTimeout(),
+		changeSetApprover: DefaultChangeSetApprover,
 	}
 }
 
+// SetChangeSetApprover sets what decides whether the change sets of stack updates are executed, by default
+// DefaultChangeSetApprover
+func (c *StackCollection) SetChangeSetApprover(approver ChangeSetApprover) {
+	c.changeSetApprover = approver
+}
+
 // DoCreateStackRequest requests the creation of a CloudFormation stack
 func (c *StackCollection) DoCreateStackRequest(i *Stack, templateData TemplateData, tags, parameters map[string]string, withIAM bool, withNamedIAM bool) error {
 	input := &cloudformation.CreateStackInput{
-		StackName:       i.StackName,
-		DisableRollback: aws.Bool(c.disableRollback),
+		StackName:          i.StackName,
+		DisableRollback:    aws.Bool(c.disableRollback),
+		ClientRequestToken: operation.NextToken(),
 	}
 	input.Tags = append(input.Tags, c.sharedTags...)
 	for k, v := range tags {
 		input.Tags = append(input.Tags, newTag(k, v))
 	}
 
+	templateData, err := c.templateDataFor(*i.StackName, templateData)
+	if err != nil {
+		return err
+	}
 	switch data := templateData.(type) {
 	case TemplateBody:
 		input.SetTemplateBody(string(data))
@@ -143,6 +165,8 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateData TemplateDa
 		input = input.SetRoleARN(cfnRole)
 	}
 
+	input.StackPolicyBody = c.stackPolicyFor(*i.StackName)
+
 	for k, v := range parameters {
 		p := &cloudformation.Parameter{
 			ParameterKey:   aws.String(k),
@@ -156,6 +180,7 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateData TemplateDa
 	if err != nil {
 		return errors.Wrapf(err, "creating CloudFormation stack %q", *i.StackName)
 	}
+	c.invalidateDescribeCache()
 	i.StackId = s.StackId
 	return nil
 }
@@ -261,6 +286,30 @@ func (c *StackCollection) UpdateStack(options UpdateStackOptions) error {
 		return err
 	}
 	logger.Debug("changes = %#v", changeSet.Changes)
+	policy := c.stackPolicyFor(options.StackName)
+	approved, err := reviewChangeSet(options.StackName, changeSet, policy != nil, c.changeSetApprover)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return fmt.Errorf("changes to stack %q were not approved, change set %q is left for review; use --auto-approve to execute change sets without confirmation", options.StackName, options.ChangeSetName)
+	}
+	if policy != nil {
+		// change sets cannot override the stack policy during the update, so it is lifted until the update is done;
+		// this also attaches it to stacks created before it was introduced
+		duringUpdate := *policy
+		if AllowReplacement {
+			duringUpdate = allowAllStackPolicy()
+			defer func() {
+				if err := c.setStackPolicy(options.StackName, *policy); err != nil {
+					logger.Warning("failed to restore the stack policy protecting the resources of stack %q: %v", options.StackName, err)
+				}
+			}()
+		}
+		if err := c.setStackPolicy(options.StackName, duringUpdate); err != nil {
+			return err
+		}
+	}
 	if err := c.doExecuteChangeSet(options.StackName, options.ChangeSetName); err != nil {
 		logger.Warning("error executing Cloudformation changeSet %s in stack %s. Check the Cloudformation console for further details", options.ChangeSetName, options.StackName)
 		return err
@@ -320,14 +369,24 @@ func (c *StackCollection) UpdateNodeGroupStack(nodeGroupName, template string, w
 
 // ListStacksMatching gets all of CloudFormation stacks with names matching nameRegex.
 func (c *StackCollection) ListStacksMatching(nameRegex string, statusFilters ...string) ([]*Stack, error) {
-	var (
-		subErr error
-		stack  *Stack
-	)
+	stacks := []*Stack{}
+	err := c.forEachStackMatching(nameRegex, func(stack *Stack) error {
+		stacks = append(stacks, stack)
+		return nil
+	}, statusFilters...)
+	if err != nil {
+		return nil, err
+	}
+	return stacks, nil
+}
 
+// forEachStackMatching describes the CloudFormation stacks with names matching nameRegex one page of the list of
+// stacks at a time, and calls fn with each, so that callers keeping only some of them do not hold all stacks of
+// large clusters in memory. It stops at the first error of fn.
+func (c *StackCollection) forEachStackMatching(nameRegex string, fn func(*Stack) error, statusFilters ...string) error {
 	re, err := regexp.Compile(nameRegex)
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot list stacks")
+		return errors.Wrap(err, "cannot list stacks")
 	}
 	input := &cloudformation.ListStacksInput{
 		StackStatusFilter: defaultStackStatusFilter(),
@@ -335,27 +394,27 @@ func (c *StackCollection) ListStacksMatching(nameRegex string, statusFilters ...
 	if len(statusFilters) > 0 {
 		input.StackStatusFilter = aws.StringSlice(statusFilters)
 	}
-	stacks := []*Stack{}
 
+	var subErr error
 	pager := func(p *cloudformation.ListStacksOutput, _ bool) bool {
 		for _, s := range p.StackSummaries {
-			if re.MatchString(*s.StackName) {
-				stack, subErr = c.DescribeStack(&Stack{StackName: s.StackName, StackId: s.StackId})
-				if subErr != nil {
-					return false
-				}
-				stacks = append(stacks, stack)
+			if !re.MatchString(*s.StackName) {
+				continue
+			}
+			var stack *Stack
+			if stack, subErr = c.DescribeStack(&Stack{StackName: s.StackName, StackId: s.StackId}); subErr != nil {
+				return false
+			}
+			if subErr = fn(stack); subErr != nil {
+				return false
 			}
 		}
 		return true
 	}
 	if err := c.cloudformationAPI.ListStacksPages(input, pager); err != nil {
-		return nil, err
-	}
-	if subErr != nil {
-		return nil, subErr
+		return err
 	}
-	return stacks, nil
+	return subErr
 }
 
 // ListStackNamesMatching gets all stack names matching regex
@@ -386,7 +445,7 @@ func (c *StackCollection) ListClusterStackNames() ([]string, error) {
 
 // ListStacks gets all of CloudFormation stacks
 func (c *StackCollection) ListStacks(statusFilters ...string) ([]*Stack, error) {
-	return c.ListStacksMatching(fmtStacksRegexForCluster(c.spec.Metadata.Name), statusFilters...)
+	return c.ListStacksMatching(fmtStacksRegexForCluster(c.spec), statusFilters...)
 }
 
 // StackStatusIsNotTransitional will return true when stack status is non-transitional
@@ -498,7 +557,8 @@ func (c *StackCollection) DeleteStackBySpec(s *Stack) (*Stack, error) {
 	for _, tag := range s.Tags {
 		if matchesClusterName(*tag.Key, *tag.Value, c.spec.Metadata.Name) {
 			input := &cloudformation.DeleteStackInput{
-				StackName: s.StackId,
+				StackName:          s.StackId,
+				ClientRequestToken: operation.NextToken(),
 			}
 
 			if cfnRole := c.roleARN; cfnRole != "" {
@@ -508,6 +568,7 @@ func (c *StackCollection) DeleteStackBySpec(s *Stack) (*Stack, error) {
 			if _, err := c.cloudformationAPI.DeleteStack(input); err != nil {
 				return nil, errors.Wrapf(err, "not able to delete stack %q", *s.StackName)
 			}
+			c.invalidateDescribeCache()
 			logger.Info("will delete stack %q", *s.StackName)
 			return s, nil
 		}
@@ -545,8 +606,8 @@ func (c *StackCollection) DeleteStackBySpecSync(s *Stack, errs chan error) error
 	return nil
 }
 
-func fmtStacksRegexForCluster(name string) string {
-	return fmt.Sprintf(ourStackRegexFmt, name)
+func fmtStacksRegexForCluster(spec *api.ClusterConfig) string {
+	return spec.StackNameRegex(fmt.Sprintf(ourStackRegexFmt, spec.Metadata.Name))
 }
 
 // DescribeStacks describes the existing stacks
@@ -647,6 +708,10 @@ func (c *StackCollection) doCreateChangeSetRequest(stackName, changeSetName, des
 
 	input.SetChangeSetType(cloudformation.ChangeSetTypeUpdate)
 
+	templateData, err := c.templateDataFor(stackName, templateData)
+	if err != nil {
+		return err
+	}
 	switch data := templateData.(type) {
 	case TemplateBody:
 		input.SetTemplateBody(string(data))
@@ -680,8 +745,9 @@ func (c *StackCollection) doCreateChangeSetRequest(stackName, changeSetName, des
 
 func (c *StackCollection) doExecuteChangeSet(stackName string, changeSetName string) error {
 	input := &cloudformation.ExecuteChangeSetInput{
-		ChangeSetName: &changeSetName,
-		StackName:     &stackName,
+		ChangeSetName:      &changeSetName,
+		StackName:          &stackName,
+		ClientRequestToken: operation.NextToken(),
 	}
 
 	logger.Debug("executing changeSet, input = %#v", input)
@@ -689,6 +755,7 @@ func (c *StackCollection) doExecuteChangeSet(stackName string, changeSetName str
 	if _, err := c.cloudformationAPI.ExecuteChangeSet(input); err != nil {
 		return errors.Wrapf(err, "executing CloudFormation ChangeSet %q for stack %q", changeSetName, stackName)
 	}
+	c.invalidateDescribeCache()
 	return nil
 }
 
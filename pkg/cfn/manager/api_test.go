@@ -5,6 +5,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
@@ -57,6 +58,44 @@ var _ = Describe("StackCollection", func() {
 		})
 	})
 
+	Context("with naming", func() {
+		It("names and lists the stacks of the cluster with the prefix and suffix", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "my-cluster"
+			cfg.Metadata.Region = "us-west-2"
+			cfg.Naming = &api.Naming{Prefix: "acme-", Suffix: "-{region}"}
+
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				consume(&cfn.ListStacksOutput{StackSummaries: []*cfn.StackSummary{
+					{StackName: aws.String("acme-eksctl-my-cluster-cluster-us-west-2")},
+					{StackName: aws.String("acme-eksctl-my-cluster-nodegroup-ng-1-us-west-2")},
+					{StackName: aws.String("eksctl-my-cluster-cluster")},
+				}}, true)
+			}).Return(nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+				return &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+					StackName:   input.StackName,
+					StackStatus: aws.String(cfn.StackStatusCreateComplete),
+					Tags:        []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("my-cluster")}},
+				}}}
+			}, nil)
+
+			sm := NewStackCollection(p, cfg)
+			Expect(sm.MakeClusterStackName()).To(Equal("acme-eksctl-my-cluster-cluster-us-west-2"))
+			Expect(sm.makeNodeGroupStackName("ng-1")).To(Equal("acme-eksctl-my-cluster-nodegroup-ng-1-us-west-2"))
+
+			stacks, err := sm.ListStacks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stacks).To(HaveLen(2))
+
+			clusterStack, err := sm.DescribeClusterStack()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(clusterStack.StackName).To(Equal(aws.String("acme-eksctl-my-cluster-cluster-us-west-2")))
+		})
+	})
+
 	It("updates tags (existing + metadata + auto)", func() {
 		// Order of execution
 		// 1) DescribeStacks
@@ -111,6 +150,7 @@ var _ = Describe("StackCollection", func() {
 		spec.Metadata.Name = clusterName
 		spec.Metadata.Tags = map[string]string{"meta": "data"}
 		sm := NewStackCollection(p, spec)
+		sm.SetChangeSetApprover(AutoApproveChangeSets)
 		err := sm.UpdateStack(UpdateStackOptions{
 			StackName:     stackName,
 			ChangeSetName: changeSetName,
@@ -170,6 +210,7 @@ var _ = Describe("StackCollection", func() {
 			spec.Metadata.Name = clusterName
 			spec.Metadata.Tags = map[string]string{"meta": "data"}
 			sm := NewStackCollection(p, spec)
+			sm.SetChangeSetApprover(AutoApproveChangeSets)
 			err := sm.UpdateStack(UpdateStackOptions{
 				StackName:     stackName,
 				ChangeSetName: changeSetName,
@@ -190,4 +231,85 @@ var _ = Describe("StackCollection", func() {
 			Expect(createChangeSetInput.Tags).To(ContainElement(&cfn.Tag{Key: aws.String("meta"), Value: aws.String("data")}))
 		})
 	})
+
+	When("the changes are not approved", func() {
+		It("does not execute the change set", func() {
+			stackName := "eksctl-stack"
+			changeSetName := "eksctl-changeset"
+			describeChangeSetCreateCompleteOutput := &cfn.DescribeChangeSetOutput{
+				StackName:     &stackName,
+				ChangeSetName: &changeSetName,
+				Status:        aws.String(cfn.ChangeSetStatusCreateComplete),
+				Changes: []*cfn.Change{
+					{
+						ResourceChange: &cfn.ResourceChange{
+							Action:            aws.String(cfn.ChangeActionModify),
+							LogicalResourceId: aws.String("NodeGroup"),
+							ResourceType:      aws.String("AWS::AutoScaling::AutoScalingGroup"),
+							Replacement:       aws.String(cfn.ReplacementFalse),
+						},
+					},
+				},
+			}
+
+			var approvedChangeSet *ChangeSet
+			approve := func(name string, changeSet *ChangeSet) (bool, error) {
+				Expect(name).To(Equal(stackName))
+				approvedChangeSet = changeSet
+				return false, nil
+			}
+
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   &stackName,
+				StackStatus: aws.String(cfn.StackStatusCreateComplete),
+			}}}, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeChangeSetCreateCompleteOutput)
+			p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).Return(req, describeChangeSetCreateCompleteOutput)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(describeChangeSetCreateCompleteOutput, nil)
+
+			sm := NewStackCollection(p, api.NewClusterConfig())
+			sm.SetChangeSetApprover(approve)
+			err := sm.UpdateStack(UpdateStackOptions{
+				StackName:     stackName,
+				ChangeSetName: changeSetName,
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+				Wait:          true,
+			})
+			Expect(err).To(MatchError(ContainSubstring(`changes to stack "eksctl-stack" were not approved`)))
+			Expect(approvedChangeSet.Changes).To(HaveLen(1))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything)
+		})
+	})
+
+	It("invalidates the cached EC2 descriptions when a stack is deleted without waiting", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster"
+		stack := &Stack{
+			StackName: aws.String("eksctl-cluster-nodegroup-ng"),
+			StackId:   aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/eksctl-cluster-nodegroup-ng/1"),
+			Tags:      []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("cluster")}},
+		}
+
+		p := mockprovider.NewMockProvider()
+		p.MockCloudFormation().On("DeleteStack", mock.Anything).Return(&cfn.DeleteStackOutput{}, nil)
+		ec2API := &invalidatingEC2API{EC2API: p.EC2()}
+
+		sm := NewStackCollection(p, cfg)
+		sm.ec2API = ec2API
+		_, err := sm.DeleteStackBySpec(stack)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ec2API.invalidations).To(Equal(1))
+	})
 })
+
+type invalidatingEC2API struct {
+	ec2iface.EC2API
+	invalidations int
+}
+
+func (e *invalidatingEC2API) Invalidate() {
+	e.invalidations++
+}
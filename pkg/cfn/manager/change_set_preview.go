@@ -0,0 +1,105 @@
+package manager
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"golang.org/x/term"
+)
+
+// ChangeSetApprover decides whether the change set of a stack update is executed once its preview is printed
+type ChangeSetApprover func(stackName string, changeSet *ChangeSet) (bool, error)
+
+// DefaultChangeSetApprover prompts for the approval of change sets when stdin is a terminal, and fails otherwise,
+// e.g. in CI, where nobody could answer the prompt, like --non-interactive does
+func DefaultChangeSetApprover(stackName string, changeSet *ChangeSet) (bool, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return false, fmt.Errorf("changes to stack %q must be approved, but stdin is not a terminal; use --approve or --auto-approve", stackName)
+	}
+	return StdinChangeSetApprover(stackName, changeSet)
+}
+
+// StdinChangeSetApprover prompts for the approval of the change set of the stack on stdin, like the MFA token
+// provider of the AWS SDK; anything but "y" or "yes", including the end of stdin, rejects the change set
+func StdinChangeSetApprover(stackName string, _ *ChangeSet) (bool, error) {
+	var answer string
+	fmt.Fprintf(os.Stderr, "execute the changes to stack %q? [y/N]: ", stackName)
+	_, _ = fmt.Scanln(&answer)
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// changeSetReviewLock serializes the previews and approvals of change sets, as stacks are updated by parallel tasks
+// and the answer to a prompt must approve the change set printed just before it
+var changeSetReviewLock sync.Mutex
+
+// reviewChangeSet prints the preview of the change set, checks that it does not change resources protected by the
+// stack policy, if any, and returns whether it is approved by approve
+func reviewChangeSet(stackName string, changeSet *ChangeSet, protected bool, approve ChangeSetApprover) (bool, error) {
+	changeSetReviewLock.Lock()
+	defer changeSetReviewLock.Unlock()
+
+	logChangeSetPreview(stackName, changeSet)
+	if protected {
+		if err := checkProtectedChanges(stackName, changeSet); err != nil {
+			return false, err
+		}
+	}
+	return approve(stackName, changeSet)
+}
+
+// AutoApproveChangeSets approves all change sets, it is used with --auto-approve
+func AutoApproveChangeSets(_ string, _ *ChangeSet) (bool, error) {
+	return true, nil
+}
+
+// logChangeSetPreview prints the changes of the change set, one line per resource
+func logChangeSetPreview(stackName string, changeSet *ChangeSet) {
+	logger.Info("%d change(s) to stack %q:", len(changeSet.Changes), stackName)
+	for _, change := range changeSet.Changes {
+		if change.ResourceChange == nil {
+			continue
+		}
+		logger.Info("    %s", formatResourceChange(change.ResourceChange))
+	}
+}
+
+// formatResourceChange formats a change like `Modify NodeGroup (AWS::AutoScaling::AutoScalingGroup), replacement: False`
+func formatResourceChange(change *cloudformation.ResourceChange) string {
+	line := fmt.Sprintf("%s %s (%s)", aws.StringValue(change.Action), aws.StringValue(change.LogicalResourceId), aws.StringValue(change.ResourceType))
+	if aws.StringValue(change.Action) != cloudformation.ChangeActionModify {
+		return line
+	}
+	line += fmt.Sprintf(", replacement: %s", aws.StringValue(change.Replacement))
+	var properties []string
+	for _, detail := range change.Details {
+		if detail.Target != nil && detail.Target.Name != nil {
+			properties = append(properties, aws.StringValue(detail.Target.Name))
+		}
+	}
+	if len(properties) > 0 {
+		line += fmt.Sprintf(", properties: %s", strings.Join(uniqueStrings(properties), ", "))
+	}
+	return line
+}
+
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,73 @@
+package manager
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Change set preview", func() {
+	DescribeTable("formatResourceChange", func(change *cfn.ResourceChange, expected string) {
+		Expect(formatResourceChange(change)).To(Equal(expected))
+	},
+		Entry("added resource", &cfn.ResourceChange{
+			Action:            aws.String(cfn.ChangeActionAdd),
+			LogicalResourceId: aws.String("PolicyEBS"),
+			ResourceType:      aws.String("AWS::IAM::Policy"),
+		}, "Add PolicyEBS (AWS::IAM::Policy)"),
+		Entry("modified resource", &cfn.ResourceChange{
+			Action:            aws.String(cfn.ChangeActionModify),
+			LogicalResourceId: aws.String("NodeGroupLaunchTemplate"),
+			ResourceType:      aws.String("AWS::EC2::LaunchTemplate"),
+			Replacement:       aws.String(cfn.ReplacementConditional),
+			Details: []*cfn.ResourceChangeDetail{
+				{Target: &cfn.ResourceTargetDefinition{Name: aws.String("LaunchTemplateData")}},
+				{Target: &cfn.ResourceTargetDefinition{Name: aws.String("LaunchTemplateData")}},
+				{Target: &cfn.ResourceTargetDefinition{Name: aws.String("TagSpecifications")}},
+			},
+		}, "Modify NodeGroupLaunchTemplate (AWS::EC2::LaunchTemplate), replacement: Conditional, properties: LaunchTemplateData, TagSpecifications"),
+		Entry("removed resource", &cfn.ResourceChange{
+			Action:            aws.String(cfn.ChangeActionRemove),
+			LogicalResourceId: aws.String("NATGateway"),
+			ResourceType:      aws.String("AWS::EC2::NatGateway"),
+		}, "Remove NATGateway (AWS::EC2::NatGateway)"),
+	)
+
+	It("reviews the change sets of parallel stack updates one at a time", func() {
+		var reviewing, overlaps int32
+		approve := func(string, *ChangeSet) (bool, error) {
+			if atomic.AddInt32(&reviewing, 1) > 1 {
+				atomic.AddInt32(&overlaps, 1)
+			}
+			defer atomic.AddInt32(&reviewing, -1)
+			time.Sleep(time.Millisecond)
+			return true, nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				approved, err := reviewChangeSet("eksctl-cluster-addon-iamserviceaccount-default-sa", &ChangeSet{}, false, approve)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(approved).To(BeTrue())
+			}()
+		}
+		wg.Wait()
+		Expect(overlaps).To(BeZero())
+	})
+
+	It("fails instead of prompting for the approval of change sets when stdin is not a terminal", func() {
+		approved, err := DefaultChangeSetApprover("eksctl-cluster-cluster", &ChangeSet{})
+		Expect(err).To(MatchError(`changes to stack "eksctl-cluster-cluster" must be approved, but stdin is not a terminal; use --approve or --auto-approve`))
+		Expect(approved).To(BeFalse())
+	})
+})
@@ -23,7 +23,7 @@ func (c *StackCollection) MakeChangeSetName(action string) string {
 }
 
 func (c *StackCollection) MakeClusterStackName() string {
-	return "eksctl-" + c.spec.Metadata.Name + "-cluster"
+	return c.spec.StackName("eksctl-" + c.spec.Metadata.Name + "-cluster")
 }
 
 // createClusterTask creates the cluster
@@ -52,7 +52,7 @@ func (c *StackCollection) DescribeClusterStack() (*Stack, error) {
 		if *s.StackStatus == cfn.StackStatusDeleteComplete {
 			continue
 		}
-		if getClusterName(s) != "" {
+		if getClusterName(s) != "" || *s.StackName == c.MakeClusterStackName() && getClusterNameTag(s) != "" {
 			return s, nil
 		}
 	}
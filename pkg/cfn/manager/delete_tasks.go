@@ -15,6 +15,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/nodeprovisioner"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
@@ -25,6 +26,8 @@ func deleteAll(_ string) bool { return true }
 func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider bool, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, wait bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error) {
 	taskTree := &tasks.TaskTree{Parallel: false}
 
+	// the stacks of each step are deleted at the same time, but the steps run one after the other: the nodes must be
+	// gone before the OIDC provider and the addon IAM roles, e.g. of the VPC CNI, which they still use while draining
 	nodeGroupTasks, err := c.NewTasksToDeleteNodeGroups(deleteAll, true, cleanup)
 
 	if err != nil {
@@ -57,6 +60,18 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvid
 		taskTree.Append(deleteAddonIAMtasks)
 	}
 
+	alarmsStack, err := c.GetAlarmsStack()
+	if err != nil {
+		return nil, err
+	}
+	if alarmsStack != nil {
+		taskTree.Append(&taskWithStackSpec{
+			info:  "delete CloudWatch alarms",
+			stack: alarmsStack,
+			call:  c.DeleteStackBySpecSync,
+		})
+	}
+
 	clusterStack, err := c.DescribeClusterStack()
 	if err != nil {
 		return nil, err
@@ -99,31 +114,57 @@ func (c *StackCollection) NewTasksToDeleteNodeGroups(shouldDelete func(string) b
 			continue
 		}
 
+		// the cleanup of a nodegroup whose deletion failed must complete before its stack is deleted again
+		ngTasks := &tasks.TaskTree{Parallel: false, IsSubTask: true}
 		if *s.StackStatus == cloudformation.StackStatusDeleteFailed && cleanup != nil {
-			taskTree.Append(&tasks.TaskWithNameParam{
+			ngTasks.Append(&tasks.TaskWithNameParam{
 				Info: fmt.Sprintf("cleanup for nodegroup %q", name),
 				Call: cleanup,
 			})
 		}
+		if provisioner := nodeprovisioner.ProvisionerName(s); provisioner != "" {
+			stack := s
+			ngTasks.Append(&asyncTaskWithoutParams{
+				info: fmt.Sprintf("delete nodes of nodegroup %q with node provisioner %q", name, provisioner),
+				call: func() error {
+					return c.deleteNodeProvisionerNodes(stack, name, provisioner)
+				},
+			})
+		}
 		info := fmt.Sprintf("delete nodegroup %q", name)
 		if wait {
-			taskTree.Append(&taskWithStackSpec{
+			ngTasks.Append(&taskWithStackSpec{
 				info:  info,
 				stack: s,
 				call:  c.DeleteStackBySpecSync,
 			})
 		} else {
-			taskTree.Append(&asyncTaskWithStackSpec{
+			ngTasks.Append(&asyncTaskWithStackSpec{
 				info:  info,
 				stack: s,
 				call:  c.DeleteStackBySpec,
 			})
 		}
+		if ngTasks.Len() == 1 {
+			taskTree.Append(ngTasks.Tasks[0])
+		} else {
+			taskTree.Append(ngTasks)
+		}
 	}
 
 	return taskTree, nil
 }
 
+// deleteNodeProvisionerNodes runs the plugin that provisioned the nodes of the nodegroup, so that it
+// terminates them before the stack, and with it the instance role, is deleted
+func (c *StackCollection) deleteNodeProvisionerNodes(s *Stack, nodeGroupName, provisioner string) error {
+	req, err := nodeprovisioner.NewRequest(c.spec, nodeGroupName, s)
+	if err != nil {
+		return err
+	}
+	return nodeprovisioner.New(provisioner).Run(nodeprovisioner.ActionDelete, req)
+}
+
 type DeleteWaitCondition struct {
 	Condition func() (bool, error)
 	Timeout   time.Duration
@@ -0,0 +1,99 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("StackCollection delete tasks", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	newStack := func(name string, tags map[string]string) *Stack {
+		s := &Stack{
+			StackName:   aws.String(name),
+			StackId:     aws.String(name + "-id"),
+			StackStatus: aws.String(cfn.StackStatusCreateComplete),
+		}
+		for k, v := range tags {
+			s.Tags = append(s.Tags, &cfn.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		return s
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		sc = NewStackCollection(p, cfg)
+
+		stacks := []*Stack{
+			newStack("eksctl-test-cluster-cluster", map[string]string{api.ClusterNameTag: "test-cluster"}),
+			newStack("eksctl-test-cluster-nodegroup-ng-1", map[string]string{api.NodeGroupNameTag: "ng-1"}),
+			newStack("eksctl-test-cluster-nodegroup-ng-2", map[string]string{api.NodeGroupNameTag: "ng-2"}),
+			newStack("eksctl-test-cluster-nodegroup-ng-3", map[string]string{api.NodeGroupNameTag: "ng-3", api.NodeProvisionerTag: "ocean"}),
+			newStack("eksctl-test-cluster-addon-vpc-cni", map[string]string{api.AddonNameTag: "vpc-cni"}),
+		}
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+			out := &cfn.ListStacksOutput{}
+			for _, s := range stacks {
+				out.StackSummaries = append(out.StackSummaries, &cfn.StackSummary{StackName: s.StackName, StackId: s.StackId})
+			}
+			consume(out, true)
+		}).Return(nil)
+		for _, s := range stacks {
+			stack := s
+			p.MockCloudFormation().On("DescribeStacks", mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
+				return *input.StackName == *stack.StackName || *input.StackName == *stack.StackId
+			})).Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{stack}}, nil)
+		}
+	})
+
+	Describe("NewTasksToDeleteClusterWithNodeGroups", func() {
+		It("deletes the nodegroups before the addon IAM roles, and both before the control plane", func() {
+			taskTree, err := sc.NewTasksToDeleteClusterWithNodeGroups(false, nil, nil, true, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taskTree.Parallel).To(BeFalse())
+			Expect(taskTree.Tasks).To(HaveLen(3))
+
+			nodeGroupTasks, ok := taskTree.Tasks[0].(*tasks.TaskTree)
+			Expect(ok).To(BeTrue())
+			Expect(nodeGroupTasks.Parallel).To(BeTrue())
+			Expect(nodeGroupTasks.Describe()).To(ContainSubstring(`delete nodegroup "ng-1"`))
+			Expect(nodeGroupTasks.Describe()).To(ContainSubstring(`delete nodegroup "ng-2"`))
+
+			addonIAMTasks, ok := taskTree.Tasks[1].(*tasks.TaskTree)
+			Expect(ok).To(BeTrue())
+			Expect(addonIAMTasks.Parallel).To(BeTrue())
+			Expect(addonIAMTasks.Describe()).To(ContainSubstring(`delete addon IAM "eksctl-test-cluster-addon-vpc-cni"`))
+
+			Expect(taskTree.Tasks[2].Describe()).To(Equal(`delete cluster control plane "test-cluster"`))
+		})
+	})
+
+	Describe("NewTasksToDeleteNodeGroups", func() {
+		It("deletes the nodes of a nodegroup provisioned by a plugin before its stack", func() {
+			taskTree, err := sc.NewTasksToDeleteNodeGroups(func(name string) bool { return name == "ng-3" }, true, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taskTree.Tasks).To(HaveLen(1))
+
+			ngTasks, ok := taskTree.Tasks[0].(*tasks.TaskTree)
+			Expect(ok).To(BeTrue())
+			Expect(ngTasks.Parallel).To(BeFalse())
+			Expect(ngTasks.Tasks).To(HaveLen(2))
+			Expect(ngTasks.Tasks[0].Describe()).To(Equal(`delete nodes of nodegroup "ng-3" with node provisioner "ocean"`))
+			Expect(ngTasks.Tasks[1].Describe()).To(Equal(`delete nodegroup "ng-3"`))
+		})
+	})
+})
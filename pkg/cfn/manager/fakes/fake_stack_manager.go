@@ -267,6 +267,18 @@ type FakeStackManager struct {
 	fixClusterCompatibilityReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetAlarmsStackStub        func() (*cloudformation.Stack, error)
+	getAlarmsStackMutex       sync.RWMutex
+	getAlarmsStackArgsForCall []struct {
+	}
+	getAlarmsStackReturns struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}
+	getAlarmsStackReturnsOnCall map[int]struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}
 	GetAutoScalingGroupNameStub        func(*cloudformation.Stack) (string, error)
 	getAutoScalingGroupNameMutex       sync.RWMutex
 	getAutoScalingGroupNameArgsForCall []struct {
@@ -491,6 +503,16 @@ type FakeStackManager struct {
 		result1 []*cloudtrail.Event
 		result2 error
 	}
+	MakeAlarmsStackNameStub        func() string
+	makeAlarmsStackNameMutex       sync.RWMutex
+	makeAlarmsStackNameArgsForCall []struct {
+	}
+	makeAlarmsStackNameReturns struct {
+		result1 string
+	}
+	makeAlarmsStackNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	MakeChangeSetNameStub        func(string) string
 	makeChangeSetNameMutex       sync.RWMutex
 	makeChangeSetNameArgsForCall []struct {
@@ -1930,6 +1952,62 @@ func (fake *FakeStackManager) FixClusterCompatibilityReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakeStackManager) GetAlarmsStack() (*cloudformation.Stack, error) {
+	fake.getAlarmsStackMutex.Lock()
+	ret, specificReturn := fake.getAlarmsStackReturnsOnCall[len(fake.getAlarmsStackArgsForCall)]
+	fake.getAlarmsStackArgsForCall = append(fake.getAlarmsStackArgsForCall, struct {
+	}{})
+	stub := fake.GetAlarmsStackStub
+	fakeReturns := fake.getAlarmsStackReturns
+	fake.recordInvocation("GetAlarmsStack", []interface{}{})
+	fake.getAlarmsStackMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetAlarmsStackCallCount() int {
+	fake.getAlarmsStackMutex.RLock()
+	defer fake.getAlarmsStackMutex.RUnlock()
+	return len(fake.getAlarmsStackArgsForCall)
+}
+
+func (fake *FakeStackManager) GetAlarmsStackCalls(stub func() (*cloudformation.Stack, error)) {
+	fake.getAlarmsStackMutex.Lock()
+	defer fake.getAlarmsStackMutex.Unlock()
+	fake.GetAlarmsStackStub = stub
+}
+
+func (fake *FakeStackManager) GetAlarmsStackReturns(result1 *cloudformation.Stack, result2 error) {
+	fake.getAlarmsStackMutex.Lock()
+	defer fake.getAlarmsStackMutex.Unlock()
+	fake.GetAlarmsStackStub = nil
+	fake.getAlarmsStackReturns = struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetAlarmsStackReturnsOnCall(i int, result1 *cloudformation.Stack, result2 error) {
+	fake.getAlarmsStackMutex.Lock()
+	defer fake.getAlarmsStackMutex.Unlock()
+	fake.GetAlarmsStackStub = nil
+	if fake.getAlarmsStackReturnsOnCall == nil {
+		fake.getAlarmsStackReturnsOnCall = make(map[int]struct {
+			result1 *cloudformation.Stack
+			result2 error
+		})
+	}
+	fake.getAlarmsStackReturnsOnCall[i] = struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) GetAutoScalingGroupName(arg1 *cloudformation.Stack) (string, error) {
 	fake.getAutoScalingGroupNameMutex.Lock()
 	ret, specificReturn := fake.getAutoScalingGroupNameReturnsOnCall[len(fake.getAutoScalingGroupNameArgsForCall)]
@@ -3026,6 +3104,59 @@ func (fake *FakeStackManager) LookupCloudTrailEventsReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) MakeAlarmsStackName() string {
+	fake.makeAlarmsStackNameMutex.Lock()
+	ret, specificReturn := fake.makeAlarmsStackNameReturnsOnCall[len(fake.makeAlarmsStackNameArgsForCall)]
+	fake.makeAlarmsStackNameArgsForCall = append(fake.makeAlarmsStackNameArgsForCall, struct {
+	}{})
+	stub := fake.MakeAlarmsStackNameStub
+	fakeReturns := fake.makeAlarmsStackNameReturns
+	fake.recordInvocation("MakeAlarmsStackName", []interface{}{})
+	fake.makeAlarmsStackNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) MakeAlarmsStackNameCallCount() int {
+	fake.makeAlarmsStackNameMutex.RLock()
+	defer fake.makeAlarmsStackNameMutex.RUnlock()
+	return len(fake.makeAlarmsStackNameArgsForCall)
+}
+
+func (fake *FakeStackManager) MakeAlarmsStackNameCalls(stub func() string) {
+	fake.makeAlarmsStackNameMutex.Lock()
+	defer fake.makeAlarmsStackNameMutex.Unlock()
+	fake.MakeAlarmsStackNameStub = stub
+}
+
+func (fake *FakeStackManager) MakeAlarmsStackNameReturns(result1 string) {
+	fake.makeAlarmsStackNameMutex.Lock()
+	defer fake.makeAlarmsStackNameMutex.Unlock()
+	fake.MakeAlarmsStackNameStub = nil
+	fake.makeAlarmsStackNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) MakeAlarmsStackNameReturnsOnCall(i int, result1 string) {
+	fake.makeAlarmsStackNameMutex.Lock()
+	defer fake.makeAlarmsStackNameMutex.Unlock()
+	fake.MakeAlarmsStackNameStub = nil
+	if fake.makeAlarmsStackNameReturnsOnCall == nil {
+		fake.makeAlarmsStackNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.makeAlarmsStackNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeStackManager) MakeChangeSetName(arg1 string) string {
 	fake.makeChangeSetNameMutex.Lock()
 	ret, specificReturn := fake.makeChangeSetNameReturnsOnCall[len(fake.makeChangeSetNameArgsForCall)]
@@ -4211,6 +4342,8 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.RUnlock()
 	fake.fixClusterCompatibilityMutex.RLock()
 	defer fake.fixClusterCompatibilityMutex.RUnlock()
+	fake.getAlarmsStackMutex.RLock()
+	defer fake.getAlarmsStackMutex.RUnlock()
 	fake.getAutoScalingGroupNameMutex.RLock()
 	defer fake.getAutoScalingGroupNameMutex.RUnlock()
 	fake.getClusterStackIfExistsMutex.RLock()
@@ -4247,6 +4380,8 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.listStacksMatchingMutex.RUnlock()
 	fake.lookupCloudTrailEventsMutex.RLock()
 	defer fake.lookupCloudTrailEventsMutex.RUnlock()
+	fake.makeAlarmsStackNameMutex.RLock()
+	defer fake.makeAlarmsStackNameMutex.RUnlock()
 	fake.makeChangeSetNameMutex.RLock()
 	defer fake.makeChangeSetNameMutex.RUnlock()
 	fake.makeClusterStackNameMutex.RLock()
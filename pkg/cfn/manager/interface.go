@@ -60,6 +60,8 @@ type StackManager interface {
 	RefreshFargatePodExecutionRoleARN() error
 	AppendNewClusterStackResource(plan, supportsManagedNodes bool) (bool, error)
 	GetFargateStack() (*Stack, error)
+	MakeAlarmsStackName() string
+	GetAlarmsStack() (*Stack, error)
 	GetStackTemplate(stackName string) (string, error)
 	MakeClusterStackName() string
 	NewTasksToCreateClusterWithNodeGroups(nodeGroups []*v1alpha5.NodeGroup,
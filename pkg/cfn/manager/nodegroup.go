@@ -15,6 +15,7 @@ import (
 	"github.com/tidwall/gjson"
 
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	"github.com/weaveworks/eksctl/pkg/nodeprovisioner"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
@@ -52,7 +53,7 @@ type NodeGroupStack struct {
 
 // makeNodeGroupStackName generates the name of the nodegroup stack identified by its name, isolated by the cluster this StackCollection operates on
 func (c *StackCollection) makeNodeGroupStackName(name string) string {
-	return fmt.Sprintf("eksctl-%s-nodegroup-%s", c.spec.Metadata.Name, name)
+	return c.spec.StackName(fmt.Sprintf("eksctl-%s-nodegroup-%s", c.spec.Metadata.Name, name))
 }
 
 // createNodeGroupTask creates the nodegroup
@@ -75,8 +76,41 @@ func (c *StackCollection) createNodeGroupTask(errs chan error, ng *api.NodeGroup
 	ng.Tags[api.NodeGroupNameTag] = ng.Name
 	ng.Tags[api.OldNodeGroupNameTag] = ng.Name
 	ng.Tags[api.NodeGroupTypeTag] = string(api.NodeGroupTypeUnmanaged)
+	if ng.Provisioner == "" {
+		return c.CreateStack(name, stack, ng.Tags, nil, errs)
+	}
 
-	return c.CreateStack(name, stack, ng.Tags, nil, errs)
+	ng.Tags[api.NodeProvisionerTag] = ng.Provisioner
+	stackErrs := make(chan error)
+	if err := c.CreateStack(name, stack, ng.Tags, nil, stackErrs); err != nil {
+		return err
+	}
+	go func() {
+		defer close(errs)
+		if err := <-stackErrs; err != nil {
+			errs <- err
+			return
+		}
+		errs <- c.runNodeProvisioner(name, ng)
+	}()
+	return nil
+}
+
+// runNodeProvisioner runs the plugin that launches the nodes of ng, once its stack has been created
+func (c *StackCollection) runNodeProvisioner(stackName string, ng *api.NodeGroup) error {
+	stack, err := c.DescribeStack(&Stack{StackName: &stackName})
+	if err != nil {
+		return err
+	}
+	req, err := nodeprovisioner.NewRequest(c.spec, ng.Name, stack)
+	if err != nil {
+		return err
+	}
+	req.NodeGroup.Config = ng
+	req.NodeGroup.MinSize = ng.MinSize
+	req.NodeGroup.MaxSize = ng.MaxSize
+	req.NodeGroup.DesiredCapacity = ng.DesiredCapacity
+	return nodeprovisioner.New(ng.Provisioner).Run(nodeprovisioner.ActionCreate, req)
 }
 
 func (c *StackCollection) createManagedNodeGroupTask(errorCh chan error, ng *api.ManagedNodeGroup, forceAddCNIPolicy bool, vpcImporter vpc.Importer) error {
@@ -100,48 +134,56 @@ func (c *StackCollection) createManagedNodeGroupTask(errorCh chan error, ng *api
 
 // DescribeNodeGroupStacks calls DescribeStacks and filters out nodegroups
 func (c *StackCollection) DescribeNodeGroupStacks() ([]*Stack, error) {
-	stacks, err := c.DescribeStacks()
+	nodeGroupStacks := []*Stack{}
+	err := c.forEachNodeGroupStack(func(s *Stack) error {
+		nodeGroupStacks = append(nodeGroupStacks, s)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	if len(stacks) == 0 {
+	if len(nodeGroupStacks) == 0 {
 		return nil, nil
 	}
+	logger.Debug("nodegroups = %v", nodeGroupStacks)
+	return nodeGroupStacks, nil
+}
 
-	nodeGroupStacks := []*Stack{}
-	for _, s := range stacks {
+// forEachNodeGroupStack calls fn with each nodegroup stack of the cluster, without holding all stacks of the cluster
+// in memory
+func (c *StackCollection) forEachNodeGroupStack(fn func(*Stack) error) error {
+	err := c.forEachStackMatching(fmtStacksRegexForCluster(c.spec), func(s *Stack) error {
 		switch *s.StackStatus {
 		case cfn.StackStatusDeleteComplete:
-			continue
+			return nil
 		case cfn.StackStatusDeleteFailed:
 			logger.Warning("stack's status of nodegroup named %s is %s", *s.StackName, *s.StackStatus)
-			continue
+			return nil
 		}
-		if c.GetNodeGroupName(s) != "" {
-			nodeGroupStacks = append(nodeGroupStacks, s)
+		if c.GetNodeGroupName(s) == "" {
+			return nil
 		}
-	}
-	logger.Debug("nodegroups = %v", nodeGroupStacks)
-	return nodeGroupStacks, nil
+		return fn(s)
+	})
+	return errors.Wrapf(err, "describing CloudFormation stacks for %q", c.spec.Metadata.Name)
 }
 
 // ListNodeGroupStacks returns a list of NodeGroupStacks
 func (c *StackCollection) ListNodeGroupStacks() ([]NodeGroupStack, error) {
-	stacks, err := c.DescribeNodeGroupStacks()
-	if err != nil {
-		return nil, err
-	}
 	var nodeGroupStacks []NodeGroupStack
-	for _, stack := range stacks {
+	err := c.forEachNodeGroupStack(func(stack *Stack) error {
 		nodeGroupType, err := GetNodeGroupType(stack.Tags)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		nodeGroupStacks = append(nodeGroupStacks, NodeGroupStack{
 			NodeGroupName: c.GetNodeGroupName(stack),
 			Type:          nodeGroupType,
 		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return nodeGroupStacks, nil
 }
@@ -205,6 +247,14 @@ func (c *StackCollection) GetUnmanagedNodeGroupSummaries(name string) ([]*NodeGr
 				return nil, errors.Wrap(err, "mapping stack to nodegroup summary")
 			}
 
+			// the nodes of a nodegroup provisioned by a plugin are not launched by an auto scaling group
+			if nodeprovisioner.ProvisionerName(s) != "" {
+				if name == "" || summary.Name == name {
+					summaries = append(summaries, summary)
+				}
+				continue
+			}
+
 			asgName, err := c.getUnmanagedNodeGroupAutoScalingGroupName(s)
 			if err != nil {
 				return nil, errors.Wrap(err, "getting autoscalinggroupname")
@@ -0,0 +1,235 @@
+package manager
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// launchDiagnosisInterval is how often the instance launches of a nodegroup are checked while its stack is created
+var launchDiagnosisInterval = 30 * time.Second
+
+// NodeGroupLaunchError is a failure to launch the instances of a nodegroup, with how to remediate it
+type NodeGroupLaunchError struct {
+	NodeGroupName string
+	// Cause is the cause of the failure, in the terms of eksctl
+	Cause string
+	// Remediation is how to fix the config of the nodegroup, or the account, for the instances to launch
+	Remediation string
+	// Reason is the reason given by EC2 Auto Scaling, EC2 or EKS
+	Reason string
+}
+
+func (e *NodeGroupLaunchError) Error() string {
+	return fmt.Sprintf("nodegroup %q failed to launch instances: %s; %s (reason: %s)", e.NodeGroupName, e.Cause, e.Remediation, e.Reason)
+}
+
+// launchFailure matches the reasons of a kind of launch failure
+type launchFailure struct {
+	pattern     *regexp.Regexp
+	cause       func(match []string) string
+	remediation func(match []string) string
+}
+
+// launchFailures are the common causes of failures to launch instances, matched in order
+var launchFailures = []launchFailure{
+	{
+		pattern: regexp.MustCompile(`instance type \((\S+)\) is not supported in your requested Availability Zone \((\S+)\)`),
+		cause: func(m []string) string {
+			return fmt.Sprintf("instance type %s is not offered in availability zone %s", m[1], m[2])
+		},
+		remediation: func(m []string) string {
+			return fmt.Sprintf("set availabilityZones of the nodegroup to zones offering it, listed by `aws ec2 describe-instance-type-offerings --location-type availability-zone --filters Name=instance-type,Values=%s`, or use another instance type", m[1])
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`InsufficientInstanceCapacity|do not have sufficient (\S+) capacity`),
+		cause: func(m []string) string {
+			if m[1] != "" {
+				return fmt.Sprintf("there is not enough %s capacity in the availability zones of the nodegroup", m[1])
+			}
+			return "there is not enough capacity of the instance type in the availability zones of the nodegroup"
+		},
+		remediation: func([]string) string {
+			return "use more availability zones, or more instance types with instancesDistribution, or retry later"
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`VcpuLimitExceeded|more vCPU capacity than your current vCPU limit`),
+		cause: func([]string) string {
+			return "the instances would exceed the vCPU limit of the account"
+		},
+		remediation: func([]string) string {
+			return "request an increase of the Running On-Demand Instances quota in the Service Quotas console, or use fewer or smaller instances"
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`Client\.InternalError|Client error on launch|KMS key|kms:`),
+		cause: func([]string) string {
+			return "instances were terminated on launch, as their EBS volumes are encrypted with a KMS key that EC2 Auto Scaling cannot use"
+		},
+		remediation: func([]string) string {
+			return "allow the AWSServiceRoleForAutoScaling service-linked role to use the KMS key of the AMI or of volumeKmsKeyID in the key policy, or with `aws kms create-grant`, see https://docs.aws.amazon.com/autoscaling/ec2/userguide/key-policy-requirements-EBS-encryption.html"
+		},
+	},
+}
+
+// classifyLaunchFailure returns the launch failure the reason is of, or nil when it is not a known failure
+func classifyLaunchFailure(nodeGroupName, reason string) *NodeGroupLaunchError {
+	for _, f := range launchFailures {
+		if m := f.pattern.FindStringSubmatch(reason); m != nil {
+			return &NodeGroupLaunchError{
+				NodeGroupName: nodeGroupName,
+				Cause:         f.cause(m),
+				Remediation:   f.remediation(m),
+				Reason:        reason,
+			}
+		}
+	}
+	return nil
+}
+
+// diagnoseNodeGroupLaunch returns the failure to launch the instances of the nodegroup of the stack, from the
+// health issues of managed nodegroups, the failed scaling activities of its Auto Scaling groups and the state
+// reasons of their terminated instances, or nil when no known failure is found
+func (c *StackCollection) diagnoseNodeGroupLaunch(s *Stack) *NodeGroupLaunchError {
+	nodeGroupName := c.GetNodeGroupName(s)
+	reasons, asgNames := c.nodeGroupLaunchReasons(s, nodeGroupName)
+	for _, asgName := range asgNames {
+		reasons = append(reasons, c.scalingActivityReasons(asgName)...)
+		reasons = append(reasons, c.instanceStateReasons(asgName)...)
+	}
+	for _, reason := range reasons {
+		if err := classifyLaunchFailure(nodeGroupName, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodeGroupLaunchReasons returns the health issues of a managed nodegroup, and the Auto Scaling groups of the nodegroup
+func (c *StackCollection) nodeGroupLaunchReasons(s *Stack, nodeGroupName string) ([]string, []string) {
+	nodeGroupType, err := GetNodeGroupType(s.Tags)
+	if err != nil {
+		logger.Debug("unable to diagnose launch of nodegroup %q: %v", nodeGroupName, err)
+		return nil, nil
+	}
+
+	if nodeGroupType != api.NodeGroupTypeManaged {
+		output, err := c.cloudformationAPI.DescribeStackResource(&cfn.DescribeStackResourceInput{
+			StackName:         s.StackName,
+			LogicalResourceId: aws.String("NodeGroup"),
+		})
+		if err != nil || output.StackResourceDetail == nil || aws.StringValue(output.StackResourceDetail.PhysicalResourceId) == "" {
+			return nil, nil
+		}
+		return nil, []string{*output.StackResourceDetail.PhysicalResourceId}
+	}
+
+	output, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(nodeGroupName),
+	})
+	if err != nil {
+		logger.Debug("unable to describe nodegroup %q to diagnose its launch: %v", nodeGroupName, err)
+		return nil, nil
+	}
+	var reasons, asgNames []string
+	if health := output.Nodegroup.Health; health != nil {
+		for _, issue := range health.Issues {
+			reasons = append(reasons, aws.StringValue(issue.Message))
+		}
+	}
+	if resources := output.Nodegroup.Resources; resources != nil {
+		for _, asg := range resources.AutoScalingGroups {
+			asgNames = append(asgNames, aws.StringValue(asg.Name))
+		}
+	}
+	return reasons, asgNames
+}
+
+func (c *StackCollection) scalingActivityReasons(asgName string) []string {
+	output, err := c.asgAPI.DescribeScalingActivities(&autoscaling.DescribeScalingActivitiesInput{
+		AutoScalingGroupName: aws.String(asgName),
+		MaxRecords:           aws.Int64(10),
+	})
+	if err != nil {
+		logger.Debug("unable to describe scaling activities of %q: %v", asgName, err)
+		return nil
+	}
+	var reasons []string
+	for _, activity := range output.Activities {
+		switch aws.StringValue(activity.StatusCode) {
+		case autoscaling.ScalingActivityStatusCodeFailed, autoscaling.ScalingActivityStatusCodeCancelled:
+			reasons = append(reasons, aws.StringValue(activity.StatusMessage))
+		}
+		// instances terminated on launch are reported in the description of successful activities
+		if strings.Contains(aws.StringValue(activity.Description), "Status Reason") {
+			reasons = append(reasons, aws.StringValue(activity.Description))
+		}
+	}
+	return reasons
+}
+
+func (c *StackCollection) instanceStateReasons(asgName string) []string {
+	output, err := c.ec2API.DescribeInstances(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("tag:aws:autoscaling:groupName"), Values: aws.StringSlice([]string{asgName})},
+			{Name: aws.String("instance-state-name"), Values: aws.StringSlice([]string{ec2.InstanceStateNameShuttingDown, ec2.InstanceStateNameTerminated})},
+		},
+	})
+	if err != nil {
+		logger.Debug("unable to describe instances of %q: %v", asgName, err)
+		return nil
+	}
+	var reasons []string
+	for _, reservation := range output.Reservations {
+		for _, instance := range reservation.Instances {
+			if instance.StateReason != nil {
+				reasons = append(reasons, aws.StringValue(instance.StateReason.Message))
+			}
+		}
+	}
+	return reasons
+}
+
+// watchNodeGroupLaunch logs the failures to launch the instances of the nodegroup of the stack while it is created,
+// as instances failing to launch otherwise only fail the stack once it times out. It returns a function stopping
+// the watch
+func (c *StackCollection) watchNodeGroupLaunch(s *Stack) func() {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(launchDiagnosisInterval)
+		defer ticker.Stop()
+		reported := map[string]bool{}
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := c.diagnoseNodeGroupLaunch(s); err != nil && !reported[err.Cause] {
+					reported[err.Cause] = true
+					logger.Warning("%s", err.Error())
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
@@ -0,0 +1,116 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Nodegroup launch diagnosis", func() {
+	DescribeTable("classifies launch failures",
+		func(reason, expectedCause string) {
+			err := classifyLaunchFailure("ng-1", reason)
+			if expectedCause == "" {
+				Expect(err).To(BeNil())
+				return
+			}
+			Expect(err).NotTo(BeNil())
+			Expect(err.Cause).To(Equal(expectedCause))
+			Expect(err.Reason).To(Equal(reason))
+		},
+		Entry("unsupported instance type",
+			"Your requested instance type (p3.2xlarge) is not supported in your requested Availability Zone (us-east-1e). Please retry your request by not specifying an Availability Zone or choosing us-east-1a, us-east-1b.",
+			"instance type p3.2xlarge is not offered in availability zone us-east-1e"),
+		Entry("insufficient capacity",
+			"We currently do not have sufficient g4dn.xlarge capacity in the Availability Zone you requested (us-west-2d).",
+			"there is not enough g4dn.xlarge capacity in the availability zones of the nodegroup"),
+		Entry("vCPU limit",
+			"You have requested more vCPU capacity than your current vCPU limit of 32 allows for the instance bucket that the specified instance type belongs to.",
+			"the instances would exceed the vCPU limit of the account"),
+		Entry("encrypted AMI without KMS grant",
+			"Client.InternalError: Client error on launch",
+			"instances were terminated on launch, as their EBS volumes are encrypted with a KMS key that EC2 Auto Scaling cannot use"),
+		Entry("unknown failure", "Launching a new EC2 instance: i-0123. Status: Successful", ""),
+	)
+
+	Describe("diagnoseNodeGroupLaunch", func() {
+		var (
+			p  *mockprovider.MockProvider
+			sc *StackCollection
+		)
+
+		newStack := func(nodeGroupType api.NodeGroupType) *Stack {
+			return &Stack{
+				StackName: aws.String("eksctl-cluster-nodegroup-ng-1"),
+				Tags: []*cfn.Tag{
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(nodeGroupType))},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "cluster"
+			sc = NewStackCollection(p, cfg)
+		})
+
+		It("diagnoses failed scaling activities of unmanaged nodegroups", func() {
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-1")},
+			}, nil)
+			p.MockASG().On("DescribeScalingActivities", mock.MatchedBy(func(input *autoscaling.DescribeScalingActivitiesInput) bool {
+				return *input.AutoScalingGroupName == "asg-1"
+			})).Return(&autoscaling.DescribeScalingActivitiesOutput{
+				Activities: []*autoscaling.Activity{{
+					StatusCode:    aws.String(autoscaling.ScalingActivityStatusCodeFailed),
+					StatusMessage: aws.String("Your requested instance type (p3.2xlarge) is not supported in your requested Availability Zone (us-east-1e)."),
+				}},
+			}, nil)
+			p.MockEC2().On("DescribeInstances", mock.Anything).Return(&ec2.DescribeInstancesOutput{}, nil)
+
+			err := sc.diagnoseNodeGroupLaunch(newStack(api.NodeGroupTypeUnmanaged))
+			Expect(err).To(MatchError(ContainSubstring(`nodegroup "ng-1" failed to launch instances: instance type p3.2xlarge is not offered in availability zone us-east-1e; set availabilityZones of the nodegroup`)))
+		})
+
+		It("diagnoses instances of managed nodegroups terminated on launch", func() {
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("cluster"),
+				NodegroupName: aws.String("ng-1"),
+			}).Return(&eks.DescribeNodegroupOutput{Nodegroup: &eks.Nodegroup{
+				Health: &eks.NodegroupHealth{Issues: []*eks.Issue{{Message: aws.String("Instances failed to join the kubernetes cluster")}}},
+				Resources: &eks.NodegroupResources{
+					AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("eks-ng-1")}},
+				},
+			}}, nil)
+			p.MockASG().On("DescribeScalingActivities", mock.Anything).Return(&autoscaling.DescribeScalingActivitiesOutput{}, nil)
+			p.MockEC2().On("DescribeInstances", mock.Anything).Return(&ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{
+					StateReason: &ec2.StateReason{Message: aws.String("Client.InternalError: Client error on launch")},
+				}}}},
+			}, nil)
+
+			err := sc.diagnoseNodeGroupLaunch(newStack(api.NodeGroupTypeManaged))
+			Expect(err).NotTo(BeNil())
+			Expect(err.Remediation).To(ContainSubstring("AWSServiceRoleForAutoScaling"))
+		})
+
+		It("returns nil when the Auto Scaling group is not created yet", func() {
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{},
+			}, nil)
+
+			Expect(sc.diagnoseNodeGroupLaunch(newStack(api.NodeGroupTypeUnmanaged))).To(BeNil())
+		})
+	})
+})
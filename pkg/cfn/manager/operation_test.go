@@ -0,0 +1,74 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/operation"
+)
+
+var _ = Describe("Operation tokens", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sm *StackCollection
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		spec := api.NewClusterConfig()
+		spec.Metadata.Name = "cluster"
+		sm = NewStackCollection(p, spec)
+	})
+
+	AfterEach(func() {
+		operation.Stop()
+	})
+
+	It("marks the creation, update and deletion of stacks with tokens of the operation", func() {
+		op := operation.Start("eksctl create nodegroup", time.Now())
+
+		var tokens []string
+		p.MockCloudFormation().On("CreateStack", mock.Anything).Run(func(args mock.Arguments) {
+			tokens = append(tokens, aws.StringValue(args[0].(*cfn.CreateStackInput).ClientRequestToken))
+		}).Return(&cfn.CreateStackOutput{StackId: aws.String("id")}, nil)
+		p.MockCloudFormation().On("ExecuteChangeSet", mock.Anything).Run(func(args mock.Arguments) {
+			tokens = append(tokens, aws.StringValue(args[0].(*cfn.ExecuteChangeSetInput).ClientRequestToken))
+		}).Return(nil, nil)
+		p.MockCloudFormation().On("DeleteStack", mock.Anything).Run(func(args mock.Arguments) {
+			tokens = append(tokens, aws.StringValue(args[0].(*cfn.DeleteStackInput).ClientRequestToken))
+		}).Return(nil, nil)
+
+		Expect(sm.DoCreateStackRequest(&Stack{StackName: aws.String("eksctl-cluster-nodegroup-ng")}, TemplateBody("{}"), nil, nil, false, false)).To(Succeed())
+		Expect(sm.doExecuteChangeSet("eksctl-cluster-nodegroup-ng", "eksctl-update")).To(Succeed())
+		_, err := sm.DeleteStackBySpec(&Stack{
+			StackName: aws.String("eksctl-cluster-nodegroup-ng"),
+			StackId:   aws.String("id"),
+			Tags:      []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("cluster")}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(tokens).To(HaveLen(3))
+		Expect(tokens[0]).NotTo(Equal(tokens[1]))
+		for _, token := range tokens {
+			parsed, ok := operation.ParseToken(token)
+			Expect(ok).To(BeTrue())
+			Expect(parsed).To(Equal(op))
+		}
+	})
+
+	It("does not mark stack operations outside of an operation", func() {
+		p.MockCloudFormation().On("ExecuteChangeSet", &cfn.ExecuteChangeSetInput{
+			ChangeSetName: aws.String("eksctl-update"),
+			StackName:     aws.String("eksctl-cluster-nodegroup-ng"),
+		}).Return(nil, nil)
+
+		Expect(sm.doExecuteChangeSet("eksctl-cluster-nodegroup-ng", "eksctl-update")).To(Succeed())
+	})
+})
@@ -0,0 +1,139 @@
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+// AllowReplacement lets stack updates replace or remove the protected resources of the cluster stack, it is set
+// with --allow-replacement
+var AllowReplacement = false
+
+// protectedResourceTypes are the types of the resources of the cluster stack whose replacement or removal destroys
+// the cluster or the networking of its nodes
+var protectedResourceTypes = []string{
+	"AWS::EC2::VPC",
+	"AWS::EC2::Subnet",
+	"AWS::EKS::Cluster",
+}
+
+type stackPolicyStatement struct {
+	Effect    string                 `json:"Effect"`
+	Action    []string               `json:"Action"`
+	Principal string                 `json:"Principal"`
+	Resource  string                 `json:"Resource"`
+	Condition map[string]interface{} `json:"Condition,omitempty"`
+}
+
+type stackPolicy struct {
+	Statement []stackPolicyStatement `json:"Statement"`
+}
+
+var allowAllStatement = stackPolicyStatement{
+	Effect:    "Allow",
+	Action:    []string{"Update:*"},
+	Principal: "*",
+	Resource:  "*",
+}
+
+// clusterStackPolicy denies the replacement and removal of the protected resources of the cluster stack
+func clusterStackPolicy() string {
+	return mustMarshalStackPolicy(stackPolicy{
+		Statement: []stackPolicyStatement{
+			allowAllStatement,
+			{
+				Effect:    "Deny",
+				Action:    []string{"Update:Replace", "Update:Delete"},
+				Principal: "*",
+				Resource:  "*",
+				Condition: map[string]interface{}{
+					"StringEquals": map[string]interface{}{
+						"ResourceType": protectedResourceTypes,
+					},
+				},
+			},
+		},
+	})
+}
+
+func allowAllStackPolicy() string {
+	return mustMarshalStackPolicy(stackPolicy{
+		Statement: []stackPolicyStatement{allowAllStatement},
+	})
+}
+
+func mustMarshalStackPolicy(policy stackPolicy) string {
+	data, err := json.Marshal(policy)
+	if err != nil {
+		panic(errors.Wrap(err, "marshalling stack policy"))
+	}
+	return string(data)
+}
+
+// stackPolicyFor returns the stack policy protecting the resources of the stack, if any; only the cluster stack,
+// holding the VPC, the subnets and the control plane, is protected
+func (c *StackCollection) stackPolicyFor(stackName string) *string {
+	if stackName != c.MakeClusterStackName() {
+		return nil
+	}
+	return aws.String(clusterStackPolicy())
+}
+
+func (c *StackCollection) setStackPolicy(stackName, policy string) error {
+	if _, err := c.cloudformationAPI.SetStackPolicy(&cloudformation.SetStackPolicyInput{
+		StackName:       aws.String(stackName),
+		StackPolicyBody: aws.String(policy),
+	}); err != nil {
+		return errors.Wrapf(err, "setting the stack policy of stack %q", stackName)
+	}
+	return nil
+}
+
+// checkProtectedChanges fails when the change set replaces or removes protected resources, unless replacement is
+// allowed, instead of letting CloudFormation fail and roll back the update
+func checkProtectedChanges(stackName string, changeSet *ChangeSet) error {
+	var replaced, conditional []string
+	for _, change := range changeSet.Changes {
+		rc := change.ResourceChange
+		if rc == nil || !isProtectedResourceType(aws.StringValue(rc.ResourceType)) {
+			continue
+		}
+		resource := fmt.Sprintf("%s (%s)", aws.StringValue(rc.LogicalResourceId), aws.StringValue(rc.ResourceType))
+		switch {
+		case aws.StringValue(rc.Action) == cloudformation.ChangeActionRemove,
+			aws.StringValue(rc.Replacement) == cloudformation.ReplacementTrue:
+			replaced = append(replaced, resource)
+		case aws.StringValue(rc.Replacement) == cloudformation.ReplacementConditional:
+			conditional = append(conditional, resource)
+		}
+	}
+
+	if AllowReplacement {
+		if len(replaced) > 0 {
+			logger.Warning("changes to stack %q replace or remove %s, as allowed by --allow-replacement", stackName, strings.Join(replaced, ", "))
+		}
+		return nil
+	}
+	if len(conditional) > 0 {
+		logger.Warning("changes to stack %q may replace %s, which the stack policy denies; the update fails if they do, use --allow-replacement to allow it", stackName, strings.Join(conditional, ", "))
+	}
+	if len(replaced) > 0 {
+		return fmt.Errorf("changes to stack %q replace or remove %s, which the stack policy denies; use --allow-replacement to allow it", stackName, strings.Join(replaced, ", "))
+	}
+	return nil
+}
+
+func isProtectedResourceType(resourceType string) bool {
+	for _, t := range protectedResourceTypes {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package manager
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Stack policy", func() {
+	AfterEach(func() {
+		AllowReplacement = false
+	})
+
+	resourceChange := func(action, logicalID, resourceType, replacement string) *cfn.Change {
+		return &cfn.Change{
+			ResourceChange: &cfn.ResourceChange{
+				Action:            aws.String(action),
+				LogicalResourceId: aws.String(logicalID),
+				ResourceType:      aws.String(resourceType),
+				Replacement:       aws.String(replacement),
+			},
+		}
+	}
+
+	It("denies the replacement and removal of the VPC, the subnets and the control plane", func() {
+		var policy stackPolicy
+		Expect(json.Unmarshal([]byte(clusterStackPolicy()), &policy)).To(Succeed())
+		Expect(policy.Statement).To(HaveLen(2))
+		Expect(policy.Statement[0].Effect).To(Equal("Allow"))
+		deny := policy.Statement[1]
+		Expect(deny.Effect).To(Equal("Deny"))
+		Expect(deny.Action).To(ConsistOf("Update:Replace", "Update:Delete"))
+		Expect(deny.Condition).To(HaveKeyWithValue("StringEquals", map[string]interface{}{
+			"ResourceType": []interface{}{"AWS::EC2::VPC", "AWS::EC2::Subnet", "AWS::EKS::Cluster"},
+		}))
+	})
+
+	DescribeTable("checkProtectedChanges", func(allowReplacement bool, change *cfn.Change, expectedErr string) {
+		AllowReplacement = allowReplacement
+		err := checkProtectedChanges("eksctl-cluster-cluster", &ChangeSet{Changes: []*cfn.Change{change}})
+		if expectedErr == "" {
+			Expect(err).NotTo(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		}
+	},
+		Entry("replacement of the VPC", false, resourceChange(cfn.ChangeActionModify, "VPC", "AWS::EC2::VPC", cfn.ReplacementTrue),
+			`changes to stack "eksctl-cluster-cluster" replace or remove VPC (AWS::EC2::VPC), which the stack policy denies`),
+		Entry("removal of a subnet", false, resourceChange(cfn.ChangeActionRemove, "SubnetPublicUSWEST2A", "AWS::EC2::Subnet", ""),
+			"replace or remove SubnetPublicUSWEST2A (AWS::EC2::Subnet)"),
+		Entry("conditional replacement of the control plane", false, resourceChange(cfn.ChangeActionModify, "ControlPlane", "AWS::EKS::Cluster", cfn.ReplacementConditional), ""),
+		Entry("replacement of other resources", false, resourceChange(cfn.ChangeActionModify, "ControlPlaneSecurityGroup", "AWS::EC2::SecurityGroup", cfn.ReplacementTrue), ""),
+		Entry("replacement allowed by --allow-replacement", true, resourceChange(cfn.ChangeActionModify, "VPC", "AWS::EC2::VPC", cfn.ReplacementTrue), ""),
+	)
+
+	Context("updating the cluster stack", func() {
+		var (
+			p         *mockprovider.MockProvider
+			sm        *StackCollection
+			stackName = "eksctl-cluster-cluster"
+			policies  []string
+		)
+
+		BeforeEach(func() {
+			policies = nil
+
+			describeChangeSetOutput := &cfn.DescribeChangeSetOutput{
+				StackName:     &stackName,
+				ChangeSetName: aws.String("eksctl-changeset"),
+				Status:        aws.String(cfn.ChangeSetStatusCreateComplete),
+				Changes:       []*cfn.Change{resourceChange(cfn.ChangeActionModify, "SubnetPublicUSWEST2A", "AWS::EC2::Subnet", cfn.ReplacementTrue)},
+			}
+			describeStacksUpdateCompleteOutput := &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   &stackName,
+				StackStatus: aws.String(cfn.StackStatusUpdateComplete),
+			}}}
+
+			p = mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   &stackName,
+				StackStatus: aws.String(cfn.StackStatusCreateComplete),
+			}}}, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeChangeSetOutput)
+			p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).Return(req, describeChangeSetOutput)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(describeChangeSetOutput, nil)
+			p.MockCloudFormation().On("SetStackPolicy", mock.Anything).Run(func(args mock.Arguments) {
+				input := args[0].(*cfn.SetStackPolicyInput)
+				Expect(aws.StringValue(input.StackName)).To(Equal(stackName))
+				policies = append(policies, aws.StringValue(input.StackPolicyBody))
+			}).Return(&cfn.SetStackPolicyOutput{}, nil)
+			p.MockCloudFormation().On("ExecuteChangeSet", mock.Anything).Return(nil, nil)
+			req = awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeStacksUpdateCompleteOutput)
+			p.MockCloudFormation().On("DescribeStacksRequest", mock.Anything).Return(req, describeStacksUpdateCompleteOutput)
+
+			spec := api.NewClusterConfig()
+			spec.Metadata.Name = "cluster"
+			sm = NewStackCollection(p, spec)
+			sm.SetChangeSetApprover(AutoApproveChangeSets)
+		})
+
+		update := func() error {
+			return sm.UpdateStack(UpdateStackOptions{
+				StackName:     stackName,
+				ChangeSetName: "eksctl-changeset",
+				Description:   "description",
+				TemplateData:  TemplateBody(""),
+				Wait:          true,
+			})
+		}
+
+		It("fails before executing changes replacing protected resources", func() {
+			Expect(update()).To(MatchError(ContainSubstring("use --allow-replacement to allow it")))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "SetStackPolicy", mock.Anything)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything)
+		})
+
+		It("lifts the stack policy during the update with --allow-replacement and restores it", func() {
+			AllowReplacement = true
+			Expect(update()).To(Succeed())
+			Expect(policies).To(Equal([]string{allowAllStackPolicy(), clusterStackPolicy()}))
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything)
+		})
+	})
+
+	It("attaches the stack policy to the cluster stack only when creating stacks", func() {
+		p := mockprovider.NewMockProvider()
+		var inputs []*cfn.CreateStackInput
+		p.MockCloudFormation().On("CreateStack", mock.Anything).Run(func(args mock.Arguments) {
+			inputs = append(inputs, args[0].(*cfn.CreateStackInput))
+		}).Return(&cfn.CreateStackOutput{StackId: aws.String("id")}, nil)
+
+		spec := api.NewClusterConfig()
+		spec.Metadata.Name = "cluster"
+		sm := NewStackCollection(p, spec)
+		Expect(sm.DoCreateStackRequest(&Stack{StackName: aws.String("eksctl-cluster-cluster")}, TemplateBody("{}"), nil, nil, false, false)).To(Succeed())
+		Expect(sm.DoCreateStackRequest(&Stack{StackName: aws.String("eksctl-cluster-nodegroup-ng")}, TemplateBody("{}"), nil, nil, false, false)).To(Succeed())
+
+		Expect(inputs).To(HaveLen(2))
+		Expect(aws.StringValue(inputs[0].StackPolicyBody)).To(Equal(clusterStackPolicy()))
+		Expect(inputs[1].StackPolicyBody).To(BeNil())
+	})
+})
@@ -4,7 +4,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/pkg/errors"
-	"github.com/weaveworks/goformation/v4"
+
+	"github.com/weaveworks/eksctl/pkg/goformation"
 )
 
 // GetStackTemplate gets the Cloudformation template for a stack
@@ -0,0 +1,145 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxTemplateBodySize is the maximum size of templates passed inline to the CloudFormation API, larger templates
+	// are uploaded to S3
+	MaxTemplateBodySize = 51200
+
+	// templateExpirationDays is how long uploaded templates are kept in buckets created by eksctl, CloudFormation
+	// only reads them when stacks and change sets are created
+	templateExpirationDays = 7
+)
+
+// templateDataFor returns templateData, or the URL of the template once uploaded to S3 when it is too large to be
+// passed inline, e.g. for clusters with many subnets or security group rules
+func (c *StackCollection) templateDataFor(stackName string, templateData TemplateData) (TemplateData, error) {
+	body, ok := templateData.(TemplateBody)
+	if !ok || len(body) <= MaxTemplateBodySize {
+		return templateData, nil
+	}
+
+	// buckets are expected to be owned by the account of the caller, so that templates are not uploaded to a bucket
+	// another account created with the name eksctl uses
+	identity, err := c.stsAPI.GetCallerIdentity(context.TODO(), &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting the account ID of the bucket of templates")
+	}
+	account := aws.StringValue(identity.Account)
+
+	bucket := c.templateBucket
+	if bucket == "" {
+		if bucket, err = c.ensureTemplateBucket(account); err != nil {
+			return nil, err
+		}
+	}
+
+	key := fmt.Sprintf("%s/%s-%d.json", c.spec.Metadata.Name, stackName, time.Now().Unix())
+	logger.Info("template of stack %q is %d bytes, larger than the %d bytes that can be passed inline, uploading it to s3://%s/%s", stackName, len(body), MaxTemplateBodySize, bucket, key)
+	if _, err := c.s3API.PutObject(&s3.PutObjectInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: aws.String(account),
+		Key:                 aws.String(key),
+		Body:                bytes.NewReader(body),
+		ContentType:         aws.String("application/json"),
+	}); err != nil {
+		return nil, errors.Wrapf(err, "uploading the template of stack %q to S3 bucket %q", stackName, bucket)
+	}
+
+	url, err := c.templateURL(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	return TemplateURL(url), nil
+}
+
+func (c *StackCollection) templateURL(bucket, key string) (string, error) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.region)
+	if !ok {
+		return "", fmt.Errorf("unknown region %q", c.region)
+	}
+	return fmt.Sprintf("https://%s.s3.%s.%s/%s", bucket, c.region, partition.DNSSuffix(), key), nil
+}
+
+// ensureTemplateBucket creates the bucket templates are uploaded to when no bucket is set with --cfn-template-bucket;
+// there is one per account and region, private, encrypted and expiring templates after a week. As its name is
+// predictable, it must be owned by the account
+func (c *StackCollection) ensureTemplateBucket(account string) (string, error) {
+	bucket := fmt.Sprintf("eksctl-cfn-templates-%s-%s", account, c.region)
+
+	if _, err := c.s3API.HeadBucket(&s3.HeadBucketInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: aws.String(account),
+	}); err == nil {
+		return bucket, nil
+	} else if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "Forbidden" {
+		return "", fmt.Errorf("S3 bucket %q is not owned by account %s, set --cfn-template-bucket to a bucket of the account", bucket, account)
+	} else if !ok || awsErr.Code() != "NotFound" {
+		return "", errors.Wrapf(err, "checking whether S3 bucket %q exists", bucket)
+	}
+
+	logger.Info("creating S3 bucket %q for templates too large to be passed inline", bucket)
+	input := &s3.CreateBucketInput{Bucket: aws.String(bucket)}
+	// buckets are created in us-east-1 without a location constraint
+	if c.region != endpoints.UsEast1RegionID {
+		input.CreateBucketConfiguration = &s3.CreateBucketConfiguration{LocationConstraint: aws.String(c.region)}
+	}
+	if _, err := c.s3API.CreateBucket(input); err != nil {
+		return "", errors.Wrapf(err, "creating S3 bucket %q", bucket)
+	}
+
+	if _, err := c.s3API.PutPublicAccessBlock(&s3.PutPublicAccessBlockInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: aws.String(account),
+		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+	}); err != nil {
+		return "", errors.Wrapf(err, "blocking public access to S3 bucket %q", bucket)
+	}
+	if _, err := c.s3API.PutBucketEncryption(&s3.PutBucketEncryptionInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: aws.String(account),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{{
+				ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+					SSEAlgorithm: aws.String(s3.ServerSideEncryptionAes256),
+				},
+			}},
+		},
+	}); err != nil {
+		return "", errors.Wrapf(err, "enabling the encryption of S3 bucket %q", bucket)
+	}
+	if _, err := c.s3API.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+		Bucket:              aws.String(bucket),
+		ExpectedBucketOwner: aws.String(account),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: []*s3.LifecycleRule{{
+				ID:         aws.String("expire-templates"),
+				Status:     aws.String(s3.ExpirationStatusEnabled),
+				Filter:     &s3.LifecycleRuleFilter{Prefix: aws.String("")},
+				Expiration: &s3.LifecycleExpiration{Days: aws.Int64(templateExpirationDays)},
+			}},
+		},
+	}); err != nil {
+		return "", errors.Wrapf(err, "setting the expiration of the templates in S3 bucket %q", bucket)
+	}
+	return bucket, nil
+}
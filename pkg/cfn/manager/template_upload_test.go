@@ -0,0 +1,102 @@
+package manager
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Template upload", func() {
+	var (
+		p             *mockprovider.MockProvider
+		sm            *StackCollection
+		createInput   *cfn.CreateStackInput
+		largeTemplate TemplateBody
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		p.MockCloudFormation().On("CreateStack", mock.Anything).Run(func(args mock.Arguments) {
+			createInput = args[0].(*cfn.CreateStackInput)
+		}).Return(&cfn.CreateStackOutput{StackId: aws.String("id")}, nil)
+		p.MockS3().On("PutObject", mock.MatchedBy(func(input *s3.PutObjectInput) bool {
+			return strings.HasPrefix(aws.StringValue(input.Key), "cluster/eksctl-cluster-nodegroup-ng-") &&
+				aws.StringValue(input.ExpectedBucketOwner) == "123456789012"
+		})).Return(&s3.PutObjectOutput{}, nil)
+		p.MockSTSV2().On("GetCallerIdentity", mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil)
+
+		spec := api.NewClusterConfig()
+		spec.Metadata.Name = "cluster"
+		sm = NewStackCollection(p, spec)
+		largeTemplate = TemplateBody(`{"Description": "` + strings.Repeat("x", MaxTemplateBodySize) + `"}`)
+	})
+
+	createStack := func(templateData TemplateData) error {
+		return sm.DoCreateStackRequest(&Stack{StackName: aws.String("eksctl-cluster-nodegroup-ng")}, templateData, nil, nil, false, false)
+	}
+
+	It("passes templates within the limit inline", func() {
+		Expect(createStack(TemplateBody(`{}`))).To(Succeed())
+		Expect(aws.StringValue(createInput.TemplateBody)).To(Equal(`{}`))
+		Expect(createInput.TemplateURL).To(BeNil())
+		p.MockS3().AssertNotCalled(GinkgoT(), "PutObject", mock.Anything)
+	})
+
+	It("uploads larger templates to the configured bucket", func() {
+		sm.templateBucket = "my-templates"
+		Expect(createStack(largeTemplate)).To(Succeed())
+		Expect(createInput.TemplateBody).To(BeNil())
+		Expect(aws.StringValue(createInput.TemplateURL)).To(HavePrefix("https://my-templates.s3.us-west-2.amazonaws.com/cluster/eksctl-cluster-nodegroup-ng-"))
+		p.MockS3().AssertNotCalled(GinkgoT(), "CreateBucket", mock.Anything)
+	})
+
+	It("creates a private bucket expiring templates when none is configured", func() {
+		bucket := "eksctl-cfn-templates-123456789012-us-west-2"
+		p.MockS3().On("HeadBucket", mock.MatchedBy(func(input *s3.HeadBucketInput) bool {
+			return aws.StringValue(input.ExpectedBucketOwner) == "123456789012"
+		})).Return(nil, awserr.New("NotFound", "not found", nil))
+		p.MockS3().On("CreateBucket", mock.MatchedBy(func(input *s3.CreateBucketInput) bool {
+			return aws.StringValue(input.Bucket) == bucket &&
+				aws.StringValue(input.CreateBucketConfiguration.LocationConstraint) == "us-west-2"
+		})).Return(&s3.CreateBucketOutput{}, nil)
+		p.MockS3().On("PutPublicAccessBlock", mock.MatchedBy(func(input *s3.PutPublicAccessBlockInput) bool {
+			return aws.BoolValue(input.PublicAccessBlockConfiguration.BlockPublicPolicy) &&
+				aws.StringValue(input.ExpectedBucketOwner) == "123456789012"
+		})).Return(&s3.PutPublicAccessBlockOutput{}, nil)
+		p.MockS3().On("PutBucketEncryption", mock.Anything).Return(&s3.PutBucketEncryptionOutput{}, nil)
+		p.MockS3().On("PutBucketLifecycleConfiguration", mock.MatchedBy(func(input *s3.PutBucketLifecycleConfigurationInput) bool {
+			return aws.Int64Value(input.LifecycleConfiguration.Rules[0].Expiration.Days) == templateExpirationDays
+		})).Return(&s3.PutBucketLifecycleConfigurationOutput{}, nil)
+
+		Expect(createStack(largeTemplate)).To(Succeed())
+		Expect(aws.StringValue(createInput.TemplateURL)).To(HavePrefix("https://" + bucket + ".s3.us-west-2.amazonaws.com/"))
+		p.MockS3().AssertExpectations(GinkgoT())
+	})
+
+	It("reuses the bucket once created", func() {
+		p.MockS3().On("HeadBucket", mock.Anything).Return(&s3.HeadBucketOutput{}, nil)
+
+		Expect(createStack(largeTemplate)).To(Succeed())
+		Expect(aws.StringValue(createInput.TemplateURL)).To(HavePrefix("https://eksctl-cfn-templates-123456789012-us-west-2.s3.us-west-2.amazonaws.com/"))
+		p.MockS3().AssertNotCalled(GinkgoT(), "CreateBucket", mock.Anything)
+	})
+
+	It("fails when the bucket is owned by another account", func() {
+		p.MockS3().On("HeadBucket", mock.Anything).Return(nil, awserr.New("Forbidden", "Forbidden", nil))
+
+		err := createStack(largeTemplate)
+		Expect(err).To(MatchError(ContainSubstring(`S3 bucket "eksctl-cfn-templates-123456789012-us-west-2" is not owned by account 123456789012`)))
+		p.MockS3().AssertNotCalled(GinkgoT(), "PutObject", mock.Anything)
+		p.MockS3().AssertNotCalled(GinkgoT(), "CreateBucket", mock.Anything)
+	})
+})
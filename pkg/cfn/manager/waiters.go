@@ -3,6 +3,7 @@ package manager
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/request"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -10,7 +11,9 @@ import (
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awscache"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/metrics"
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
@@ -19,11 +22,22 @@ const (
 	changesetStatus = "Status"
 )
 
+// invalidateDescribeCache drops the cached descriptions of subnets and route tables, which a stack may create or delete;
+// it is called once a stack is created, updated or deleted, and again when the stack is done
+func (c *StackCollection) invalidateDescribeCache() {
+	if invalidator, ok := c.ec2API.(awscache.Invalidator); ok {
+		invalidator.Invalidate()
+	}
+}
+
 // cloudformation.WaitUntilStackCreateComplete doesn't detect in-progress status early enough,
 // so this is custom version that is more suitable for our use, as there is no way to add any
 // custom acceptors
 
-func (c *StackCollection) waitWithAcceptors(i *Stack, acceptors []request.WaiterAcceptor) error {
+// waitWithAcceptors waits for the stack, and records the duration of the wait as the phase of the operation on the
+// stack
+func (c *StackCollection) waitWithAcceptors(i *Stack, phase string, acceptors []request.WaiterAcceptor) error {
+	defer c.invalidateDescribeCache()
 	msg := fmt.Sprintf("waiting for CloudFormation stack %q", *i.StackName)
 
 	newRequest := func() *request.Request {
@@ -37,6 +51,8 @@ func (c *StackCollection) waitWithAcceptors(i *Stack, acceptors []request.Waiter
 		return req
 	}
 
+	diagnoseLaunch := phase == "create-stack" && stackResource(i) == "nodegroup"
+
 	troubleshoot := func(desiredStatus string) error {
 		s, err := c.DescribeStack(i)
 		if err != nil {
@@ -45,10 +61,46 @@ func (c *StackCollection) waitWithAcceptors(i *Stack, acceptors []request.Waiter
 			logger.Critical("unexpected status %q while %s", *s.StackStatus, msg)
 			c.troubleshootStackFailureCause(i, desiredStatus)
 		}
+		if diagnoseLaunch {
+			if launchErr := c.diagnoseNodeGroupLaunch(i); launchErr != nil {
+				return launchErr
+			}
+		}
 		return nil
 	}
 
-	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.waitTimeout, troubleshoot)
+	if diagnoseLaunch {
+		stopWatch := c.watchNodeGroupLaunch(i)
+		defer stopWatch()
+	}
+
+	start := time.Now()
+	err := waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.waitTimeout, troubleshoot)
+	metrics.ObservePhase(phase, stackResource(i), time.Since(start), err)
+	return err
+}
+
+// stackResource returns the kind of resource of a stack, from its tags and name
+func stackResource(s *Stack) string {
+	for _, tag := range s.Tags {
+		switch *tag.Key {
+		case api.NodeGroupNameTag, api.OldNodeGroupNameTag, api.OldNodeGroupIDTag:
+			return "nodegroup"
+		case api.IAMServiceAccountNameTag:
+			return "iamserviceaccount"
+		case api.AddonNameTag:
+			return "addon"
+		case api.KarpenterNameTag:
+			return "karpenter"
+		}
+	}
+	switch {
+	case strings.HasSuffix(*s.StackName, "-cluster"):
+		return "cluster"
+	case strings.HasSuffix(*s.StackName, "-fargate"):
+		return "fargate"
+	}
+	return "other"
 }
 
 type noChangeError struct {
@@ -129,7 +181,7 @@ func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus
 // DoWaitUntilStackIsCreated blocks until the given stack's
 // creation has completed.
 func (c *StackCollection) DoWaitUntilStackIsCreated(i *Stack) error {
-	return c.waitWithAcceptors(i,
+	return c.waitWithAcceptors(i, "create-stack",
 		waiters.MakeAcceptors(
 			stackStatus,
 			cfn.StackStatusCreateComplete,
@@ -171,7 +223,7 @@ func (c *StackCollection) waitUntilStackIsCreated(i *Stack, stack builder.Resour
 }
 
 func (c *StackCollection) doWaitUntilStackIsDeleted(i *Stack) error {
-	return c.waitWithAcceptors(i,
+	return c.waitWithAcceptors(i, "delete-stack",
 		waiters.MakeAcceptors(
 			stackStatus,
 			cfn.StackStatusDeleteComplete,
@@ -215,7 +267,7 @@ func (c *StackCollection) waitUntilStackIsDeleted(i *Stack, errs chan error) {
 }
 
 func (c *StackCollection) doWaitUntilStackIsUpdated(i *Stack) error {
-	return c.waitWithAcceptors(i,
+	return c.waitWithAcceptors(i, "update-stack",
 		waiters.MakeAcceptors(
 			stackStatus,
 			cfn.StackStatusUpdateComplete,
@@ -4,10 +4,10 @@ import (
 	"fmt"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
-	gfn "github.com/weaveworks/goformation/v4/cloudformation"
-	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	gfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 )
 
 // Stack output names
@@ -33,6 +33,12 @@ const (
 	// outputs from nodegroup stack
 	NodeGroupInstanceRoleARN    = "InstanceRoleARN"
 	NodeGroupInstanceProfileARN = "InstanceProfileARN"
+	NodeGroupSecurityGroup      = "SecurityGroup"
+
+	// outputs from the stack of a nodegroup provisioned by a plugin
+	NodeGroupLaunchTemplateID      = "LaunchTemplateID"
+	NodeGroupLaunchTemplateVersion = "LaunchTemplateVersion"
+	NodeGroupSubnetIDs             = "SubnetIDs"
 
 	// outputs to indicate configuration attributes that may have critical effect
 	// on critical effect on forward-compatibility with respect to overall functionality
@@ -44,6 +50,9 @@ const (
 
 	// outputs from Fargate stack:
 	FargatePodExecutionRoleARN = "FargatePodExecutionRoleARN"
+
+	// outputs from alarms stack
+	AlarmsTopicARN = "TopicARN"
 )
 
 type (
@@ -0,0 +1,19 @@
+package template
+
+// EKSPodIdentityAssociation represents a CloudFormation AWS::EKS::PodIdentityAssociation resource
+type EKSPodIdentityAssociation struct {
+	ClusterName    string
+	Namespace      string
+	ServiceAccount string
+	RoleArn        *Value
+}
+
+// Type will return the full type name for the resource
+func (r *EKSPodIdentityAssociation) Type() string {
+	return "AWS::EKS::PodIdentityAssociation"
+}
+
+// Properties will return the properties of the resource
+func (r *EKSPodIdentityAssociation) Properties() interface{} {
+	return r
+}
@@ -1,6 +1,6 @@
 package template
 
-import gfn "github.com/weaveworks/goformation/v4/cloudformation/types"
+import gfn "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
 
 // AttachPolicy attaches the specified policy document
 func (t *Template) AttachPolicy(name string, refRole *Value, policyDoc MapOfInterfaces) {
@@ -41,3 +41,14 @@ func MakeAssumeRoleWithWebIdentityPolicyDocument(providerARN string, condition M
 		"Condition": condition,
 	})
 }
+
+// MakeAssumeRolePolicyDocumentForPodIdentity constructs a trust policy for EKS Pod Identity
+func MakeAssumeRolePolicyDocumentForPodIdentity() MapOfInterfaces {
+	return MakePolicyDocument(MapOfInterfaces{
+		"Effect": "Allow",
+		"Action": []string{"sts:AssumeRole", "sts:TagSession"},
+		"Principal": map[string]string{
+			"Service": "pods.eks.amazonaws.com",
+		},
+	})
+}
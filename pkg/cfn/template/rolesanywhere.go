@@ -0,0 +1,44 @@
+package template
+
+// RolesAnywhereTrustAnchor represents a CloudFormation AWS::RolesAnywhere::TrustAnchor resource
+type RolesAnywhereTrustAnchor struct {
+	Name    string `json:",omitempty"`
+	Enabled bool
+
+	Source RolesAnywhereSource
+}
+
+// RolesAnywhereSource represents the source of trust of a trust anchor
+type RolesAnywhereSource struct {
+	SourceType string
+	SourceData MapOfInterfaces
+}
+
+// Type will return the full type name for the resource
+func (r *RolesAnywhereTrustAnchor) Type() string {
+	return "AWS::RolesAnywhere::TrustAnchor"
+}
+
+// Properties will return the properties of the resource
+func (r *RolesAnywhereTrustAnchor) Properties() interface{} {
+	return r
+}
+
+// RolesAnywhereProfile represents a CloudFormation AWS::RolesAnywhere::Profile resource
+type RolesAnywhereProfile struct {
+	Name    string `json:",omitempty"`
+	Enabled bool
+
+	RoleArns        []*Value `json:",omitempty"`
+	DurationSeconds int      `json:",omitempty"`
+}
+
+// Type will return the full type name for the resource
+func (r *RolesAnywhereProfile) Type() string {
+	return "AWS::RolesAnywhere::Profile"
+}
+
+// Properties will return the properties of the resource
+func (r *RolesAnywhereProfile) Properties() interface{} {
+	return r
+}
@@ -0,0 +1,39 @@
+// Package clustertemplates contains the built-in ClusterConfig templates of `eksctl create cluster --template`
+package clustertemplates
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+const templatesDir = "templates"
+
+//go:embed templates/*.yaml
+var templates embed.FS
+
+// Names returns the sorted names of the built-in templates
+func Names() []string {
+	entries, err := templates.ReadDir(templatesDir)
+	if err != nil {
+		panic(fmt.Sprintf("unexpected error reading embedded templates: %v", err))
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".yaml"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the ClusterConfig YAML of the built-in template with the given name. The template does not set
+// the name or region of the cluster
+func Get(name string) ([]byte, error) {
+	data, err := templates.ReadFile(path.Join(templatesDir, name+".yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("unknown cluster template %q, valid templates are: %s", name, strings.Join(Names(), ", "))
+	}
+	return data, nil
+}
@@ -0,0 +1,11 @@
+package clustertemplates_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestClusterTemplates(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
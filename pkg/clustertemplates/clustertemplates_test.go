@@ -0,0 +1,36 @@
+package clustertemplates_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/clustertemplates"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("Cluster templates", func() {
+	BeforeEach(func() {
+		Expect(api.Register()).To(Succeed())
+	})
+
+	It("lists the built-in templates", func() {
+		Expect(clustertemplates.Names()).To(Equal([]string{"batch-spot", "dev-small", "gpu-training", "prod-ha-private"}))
+	})
+
+	It("parses every template", func() {
+		for _, name := range clustertemplates.Names() {
+			data, err := clustertemplates.Get(name)
+			Expect(err).NotTo(HaveOccurred())
+			cfg, err := eks.ParseConfig(data)
+			Expect(err).NotTo(HaveOccurred(), name)
+			Expect(cfg.ManagedNodeGroups).NotTo(BeEmpty(), name)
+			Expect(cfg.ExpandSpotFallbackNodeGroups()).To(Succeed(), name)
+		}
+	})
+
+	It("fails for unknown templates", func() {
+		_, err := clustertemplates.Get("huge")
+		Expect(err).To(MatchError(`unknown cluster template "huge", valid templates are: batch-spot, dev-small, gpu-training, prod-ha-private`))
+	})
+})
@@ -0,0 +1,273 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+)
+
+// Phase is the phase of a ClusterConfig object.
+type Phase string
+
+// Values for Phase
+const (
+	PhaseCreating Phase = "Creating"
+	PhaseUpdating Phase = "Updating"
+	PhaseReady    Phase = "Ready"
+	PhaseFailed   Phase = "Failed"
+)
+
+// Status is the status of a ClusterConfig object.
+type Status struct {
+	Phase              Phase  `json:"phase,omitempty"`
+	Message            string `json:"message,omitempty"`
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+	LastReconcileTime  string `json:"lastReconcileTime,omitempty"`
+}
+
+// Actions creates and updates the EKS clusters of ClusterConfigs.
+type Actions interface {
+	// ClusterStatus returns the status of the cluster of the ClusterConfig, one of the EKS cluster statuses such as
+	// ACTIVE or CREATING, or an empty string if it does not exist
+	ClusterStatus(cfg *api.ClusterConfig) (string, error)
+	// CreateCluster creates the cluster of the ClusterConfig, like eksctl create cluster
+	CreateCluster(cfg *api.ClusterConfig) error
+	// UpdateCluster converges the existing active cluster to the ClusterConfig, creating the resources missing from
+	// it and deleting those removed from the ClusterConfig
+	UpdateCluster(cfg *api.ClusterConfig) error
+}
+
+// Controller reconciles the EKS clusters of the ClusterConfig objects of a management cluster.
+type Controller struct {
+	client    dynamic.Interface
+	actions   Actions
+	namespace string
+	resync    time.Duration
+	now       func() time.Time
+}
+
+// New creates a Controller watching the ClusterConfig objects of the namespace, or of all namespaces if it is empty.
+// Objects are reconciled again every resync period, to retry failures and pick up changes made outside the controller.
+func New(client dynamic.Interface, actions Actions, namespace string, resync time.Duration) *Controller {
+	return &Controller{
+		client:    client,
+		actions:   actions,
+		namespace: namespace,
+		resync:    resync,
+		now:       time.Now,
+	}
+}
+
+// Run watches the ClusterConfig objects and reconciles them until the context is done. Objects are reconciled one at
+// a time, as eksctl commands share global state such as the logger.
+func (c *Controller) Run(ctx context.Context) error {
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(c.client, c.resync, c.namespace, nil)
+	informer := factory.ForResource(GroupVersionResource).Informer()
+
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), Resource)
+	defer queue.ShutDown()
+	enqueue := func(obj interface{}) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			logger.Warning("ignoring object: %v", err)
+			return
+		}
+		queue.Add(key)
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(oldObj, obj interface{}) {
+			// updates of the status only are ignored, resyncs have the same resource version
+			old, updated := oldObj.(*unstructured.Unstructured), obj.(*unstructured.Unstructured)
+			if old.GetGeneration() != updated.GetGeneration() || old.GetResourceVersion() == updated.GetResourceVersion() {
+				enqueue(obj)
+			}
+		},
+	})
+
+	go informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return errors.New("timed out waiting for the ClusterConfig informer to sync")
+	}
+	logger.Info("watching %s", GroupVersionResource.GroupResource())
+
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		for c.processNextItem(ctx, queue) {
+		}
+	}, time.Second)
+
+	<-ctx.Done()
+	return nil
+}
+
+func (c *Controller) processNextItem(ctx context.Context, queue workqueue.RateLimitingInterface) bool {
+	item, shutdown := queue.Get()
+	if shutdown {
+		return false
+	}
+	defer queue.Done(item)
+
+	key := item.(string)
+	if err := c.Reconcile(ctx, key); err != nil {
+		logger.Warning("reconciling %s: %v", key, err)
+		queue.AddRateLimited(item)
+		return true
+	}
+	queue.Forget(item)
+	return true
+}
+
+// Reconcile compares the ClusterConfig object with the namespace/name key with the live state of its cluster: it
+// creates the cluster if it does not exist, converges it to the object if it is active, and waits for it otherwise,
+// such as while a create interrupted by a restart of the controller completes. The outcome is recorded in the status
+// of the object.
+func (c *Controller) Reconcile(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	obj, err := c.client.Resource(GroupVersionResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		// deleting an object leaves its cluster in place
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cfg, err := clusterConfigOf(obj)
+	if err != nil {
+		// the object will be reconciled again once it is fixed
+		_, statusErr := c.setStatus(ctx, obj, PhaseFailed, err.Error())
+		return statusErr
+	}
+
+	clusterStatus, err := c.actions.ClusterStatus(cfg)
+	if err != nil {
+		return errors.Wrapf(err, "checking the status of cluster %q", cfg.Metadata.Name)
+	}
+
+	var (
+		phase  Phase
+		action func(*api.ClusterConfig) error
+	)
+	switch clusterStatus {
+	case "":
+		phase, action = PhaseCreating, c.actions.CreateCluster
+	case awseks.ClusterStatusActive:
+		phase, action = PhaseUpdating, c.actions.UpdateCluster
+	case awseks.ClusterStatusCreating, awseks.ClusterStatusPending, awseks.ClusterStatusUpdating:
+		// the resources of the object are created once the cluster is active, which resumes a create that was
+		// interrupted before its nodegroups were created
+		phase = PhaseUpdating
+		if clusterStatus != awseks.ClusterStatusUpdating {
+			phase = PhaseCreating
+		}
+		message := fmt.Sprintf("waiting for cluster %q to become active, it is %s", cfg.Metadata.Name, clusterStatus)
+		if _, err := c.setStatus(ctx, obj, phase, message); err != nil {
+			return err
+		}
+		return errors.New(message)
+	default:
+		// the object will be reconciled again at the next resync
+		_, err := c.setStatus(ctx, obj, PhaseFailed, fmt.Sprintf("cluster %q is %s", cfg.Metadata.Name, clusterStatus))
+		return err
+	}
+
+	if obj, err = c.setStatus(ctx, obj, phase, ""); err != nil {
+		return err
+	}
+	logger.Info("reconciling cluster %q of %s (%s)", cfg.Metadata.Name, key, phase)
+	start := c.now()
+	err = action(cfg)
+	reason := ""
+	if err != nil {
+		reason = cmdutils.FailureClass(err)
+	}
+	metrics.ObserveOperation("reconcile", c.now().Sub(start), reason)
+	if err != nil {
+		if _, statusErr := c.setStatus(ctx, obj, PhaseFailed, err.Error()); statusErr != nil {
+			logger.Warning("updating status of %s: %v", key, statusErr)
+		}
+		return err
+	}
+	_, err = c.setStatus(ctx, obj, PhaseReady, "")
+	return err
+}
+
+// clusterConfigOf returns the ClusterConfig of the spec of the object, its name defaults to the name of the object
+func clusterConfigOf(obj *unstructured.Unstructured) (*api.ClusterConfig, error) {
+	spec, ok, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid spec")
+	}
+	if !ok {
+		return nil, errors.New("spec must be set")
+	}
+	spec["apiVersion"] = api.SchemeGroupVersion.String()
+	spec["kind"] = api.ClusterConfigKind
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.Register(); err != nil {
+		return nil, err
+	}
+	cfg, err := eks.ParseConfig(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing ClusterConfig in spec")
+	}
+	if cfg.Metadata == nil {
+		cfg.Metadata = &api.ClusterMeta{}
+	}
+	if cfg.Metadata.Name == "" {
+		cfg.Metadata.Name = obj.GetName()
+	}
+	if cfg.Metadata.Region == "" {
+		return nil, errors.New("spec.metadata.region must be set")
+	}
+	if err := api.ValidateSubmittedConfig(cfg); err != nil {
+		return nil, errors.Wrap(err, "invalid spec")
+	}
+	return cfg, nil
+}
+
+// setStatus updates the status of the object, and returns the updated object
+func (c *Controller) setStatus(ctx context.Context, obj *unstructured.Unstructured, phase Phase, message string) (*unstructured.Unstructured, error) {
+	status := map[string]interface{}{
+		"phase":              string(phase),
+		"observedGeneration": obj.GetGeneration(),
+		"lastReconcileTime":  c.now().UTC().Format(time.RFC3339),
+	}
+	if message != "" {
+		status["message"] = message
+	}
+	obj = obj.DeepCopy()
+	if err := unstructured.SetNestedField(obj.Object, status, "status"); err != nil {
+		return nil, err
+	}
+	updated, err := c.client.Resource(GroupVersionResource).Namespace(obj.GetNamespace()).UpdateStatus(ctx, obj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "updating status of %s/%s", obj.GetNamespace(), obj.GetName())
+	}
+	return updated, nil
+}
@@ -0,0 +1,293 @@
+package controller_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/controller"
+)
+
+type fakeActions struct {
+	status    string
+	statusErr error
+	actionErr error
+	created   []*api.ClusterConfig
+	updated   []*api.ClusterConfig
+}
+
+func (a *fakeActions) ClusterStatus(*api.ClusterConfig) (string, error) {
+	return a.status, a.statusErr
+}
+
+func (a *fakeActions) CreateCluster(cfg *api.ClusterConfig) error {
+	a.created = append(a.created, cfg)
+	return a.actionErr
+}
+
+func (a *fakeActions) UpdateCluster(cfg *api.ClusterConfig) error {
+	a.updated = append(a.updated, cfg)
+	return a.actionErr
+}
+
+func newClusterConfigObject(spec map[string]interface{}, status map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": controller.Group + "/" + controller.Version,
+		"kind":       controller.Kind,
+		"metadata": map[string]interface{}{
+			"name":       "test-cluster",
+			"namespace":  "clusters",
+			"generation": int64(2),
+		},
+		"spec": spec,
+	}}
+	if status != nil {
+		obj.Object["status"] = status
+	}
+	return obj
+}
+
+var _ = Describe("Controller", func() {
+	var (
+		actions *fakeActions
+		client  *dynamicfake.FakeDynamicClient
+		ctrl    *controller.Controller
+	)
+
+	setup := func(obj *unstructured.Unstructured) {
+		actions = &fakeActions{}
+		client = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{controller.GroupVersionResource: controller.Kind + "List"}, obj)
+		ctrl = controller.New(client, actions, "", 0)
+	}
+
+	status := func() map[string]interface{} {
+		obj, err := client.Resource(controller.GroupVersionResource).Namespace("clusters").Get(context.Background(), "test-cluster", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		status, _, err := unstructured.NestedMap(obj.Object, "status")
+		Expect(err).NotTo(HaveOccurred())
+		return status
+	}
+
+	spec := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"region": "us-west-2",
+		},
+		"managedNodeGroups": []interface{}{
+			map[string]interface{}{"name": "ng-1"},
+		},
+	}
+
+	It("creates the cluster if it does not exist", func() {
+		setup(newClusterConfigObject(spec, nil))
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(HaveLen(1))
+		Expect(actions.updated).To(BeEmpty())
+		cfg := actions.created[0]
+		Expect(cfg.Metadata.Name).To(Equal("test-cluster"))
+		Expect(cfg.Metadata.Region).To(Equal("us-west-2"))
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+		Expect(status()).To(HaveKeyWithValue("phase", "Ready"))
+		Expect(status()).To(HaveKeyWithValue("observedGeneration", int64(2)))
+	})
+
+	It("converges an existing active cluster", func() {
+		setup(newClusterConfigObject(spec, nil))
+		actions.status = "ACTIVE"
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(BeEmpty())
+		Expect(actions.updated).To(HaveLen(1))
+		Expect(status()).To(HaveKeyWithValue("phase", "Ready"))
+	})
+
+	It("compares objects whose generation has been reconciled with their cluster again, to revert drift", func() {
+		setup(newClusterConfigObject(spec, map[string]interface{}{
+			"phase":              "Ready",
+			"observedGeneration": int64(2),
+		}))
+		actions.status = "ACTIVE"
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(BeEmpty())
+		Expect(actions.updated).To(HaveLen(1))
+	})
+
+	It("recreates the cluster of a ready object if it was deleted", func() {
+		setup(newClusterConfigObject(spec, map[string]interface{}{
+			"phase":              "Ready",
+			"observedGeneration": int64(2),
+		}))
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(HaveLen(1))
+	})
+
+	It("waits for a cluster being created, such as by an interrupted reconcile, instead of updating it", func() {
+		setup(newClusterConfigObject(spec, map[string]interface{}{
+			"phase":              "Creating",
+			"observedGeneration": int64(2),
+		}))
+		actions.status = "CREATING"
+
+		err := ctrl.Reconcile(context.Background(), "clusters/test-cluster")
+		Expect(err).To(MatchError(`waiting for cluster "test-cluster" to become active, it is CREATING`))
+
+		Expect(actions.created).To(BeEmpty())
+		Expect(actions.updated).To(BeEmpty())
+		Expect(status()).To(HaveKeyWithValue("phase", "Creating"))
+		Expect(status()).To(HaveKeyWithValue("message", `waiting for cluster "test-cluster" to become active, it is CREATING`))
+	})
+
+	It("records clusters that cannot be reconciled in the status", func() {
+		setup(newClusterConfigObject(spec, nil))
+		actions.status = "FAILED"
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(BeEmpty())
+		Expect(actions.updated).To(BeEmpty())
+		Expect(status()).To(HaveKeyWithValue("phase", "Failed"))
+		Expect(status()).To(HaveKeyWithValue("message", `cluster "test-cluster" is FAILED`))
+	})
+
+	It("reconciles objects whose generation changed since they were reconciled", func() {
+		setup(newClusterConfigObject(spec, map[string]interface{}{
+			"phase":              "Ready",
+			"observedGeneration": int64(1),
+		}))
+		actions.status = "ACTIVE"
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.updated).To(HaveLen(1))
+		Expect(status()).To(HaveKeyWithValue("observedGeneration", int64(2)))
+	})
+
+	It("records failures in the status and returns them to retry", func() {
+		setup(newClusterConfigObject(spec, nil))
+		actions.actionErr = errors.New("stack failed")
+
+		err := ctrl.Reconcile(context.Background(), "clusters/test-cluster")
+		Expect(err).To(MatchError("stack failed"))
+
+		Expect(status()).To(HaveKeyWithValue("phase", "Failed"))
+		Expect(status()).To(HaveKeyWithValue("message", "stack failed"))
+	})
+
+	It("records invalid specs in the status without retrying", func() {
+		setup(newClusterConfigObject(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name": "test-cluster",
+			},
+		}, nil))
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(BeEmpty())
+		Expect(status()).To(HaveKeyWithValue("phase", "Failed"))
+		Expect(status()).To(HaveKeyWithValue("message", "spec.metadata.region must be set"))
+	})
+
+	It("rejects unknown fields in the spec", func() {
+		setup(newClusterConfigObject(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"region": "us-west-2",
+			},
+			"nodeGroupz": []interface{}{},
+		}, nil))
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(BeEmpty())
+		Expect(status()).To(HaveKeyWithValue("phase", "Failed"))
+		Expect(status()["message"]).To(ContainSubstring("nodeGroupz"))
+	})
+
+	It("rejects specs running commands or reading files on the controller host", func() {
+		setup(newClusterConfigObject(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"region": "us-west-2",
+			},
+			"hooks": map[string]interface{}{
+				"preDelete": []interface{}{"cat ~/.aws/credentials"},
+			},
+		}, nil))
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(BeEmpty())
+		Expect(actions.updated).To(BeEmpty())
+		Expect(status()).To(HaveKeyWithValue("phase", "Failed"))
+		Expect(status()).To(HaveKeyWithValue("message", "invalid spec: hooks cannot be set, as they would run commands on the host of eksctl"))
+	})
+
+	It("rejects specs reading a well-known policy registry on the controller host", func() {
+		setup(newClusterConfigObject(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"region": "us-west-2",
+			},
+			"iam": map[string]interface{}{
+				"wellKnownPolicyRegistry": "/var/run/secrets/kubernetes.io/serviceaccount/token",
+			},
+		}, nil))
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(BeEmpty())
+		Expect(actions.updated).To(BeEmpty())
+		Expect(status()).To(HaveKeyWithValue("phase", "Failed"))
+		Expect(status()).To(HaveKeyWithValue("message", "invalid spec: iam.wellKnownPolicyRegistry cannot be set, local paths cannot be read"))
+	})
+
+	It("rejects specs running node provisioner plugins on the controller host", func() {
+		setup(newClusterConfigObject(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"region": "us-west-2",
+			},
+			"nodeGroups": []interface{}{
+				map[string]interface{}{
+					"name":        "ng-1",
+					"provisioner": "ocean",
+				},
+			},
+		}, nil))
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(Succeed())
+
+		Expect(actions.created).To(BeEmpty())
+		Expect(actions.updated).To(BeEmpty())
+		Expect(status()).To(HaveKeyWithValue("phase", "Failed"))
+		Expect(status()).To(HaveKeyWithValue("message", `invalid spec: provisioner of nodegroup "ng-1" cannot be set, as it would run a plugin on the host of eksctl`))
+	})
+
+	It("ignores deleted objects", func() {
+		setup(newClusterConfigObject(spec, nil))
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/other-cluster")).To(Succeed())
+
+		Expect(actions.created).To(BeEmpty())
+	})
+
+	It("does not update the status when checking the cluster fails", func() {
+		setup(newClusterConfigObject(spec, nil))
+		actions.statusErr = errors.New("throttled")
+
+		Expect(ctrl.Reconcile(context.Background(), "clusters/test-cluster")).To(MatchError(ContainSubstring("throttled")))
+
+		Expect(status()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Values for the ClusterConfig custom resource watched by the controller
+const (
+	Group    = "controller.eksctl.io"
+	Version  = "v1alpha1"
+	Kind     = "ClusterConfig"
+	Resource = "clusterconfigs"
+)
+
+// GroupVersionResource is the resource of the ClusterConfig objects
+var GroupVersionResource = schema.GroupVersionResource{Group: Group, Version: Version, Resource: Resource}
+
+// CustomResourceDefinition returns the CRD of the ClusterConfig objects. Their spec is an eksctl ClusterConfig, it
+// is validated by eksctl when reconciling rather than by the API server.
+func CustomResourceDefinition() *apiextensionsv1.CustomResourceDefinition {
+	preserveUnknownFields := true
+	return &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+			Kind:       "CustomResourceDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: Resource + "." + Group,
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   Resource,
+				Singular: "clusterconfig",
+				Kind:     Kind,
+				ListKind: Kind + "List",
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    Version,
+					Served:  true,
+					Storage: true,
+					Subresources: &apiextensionsv1.CustomResourceSubresources{
+						Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+					},
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{Name: "Cluster", Type: "string", JSONPath: ".spec.metadata.name"},
+						{Name: "Region", Type: "string", JSONPath: ".spec.metadata.region"},
+						{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+						{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+					},
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type:     "object",
+							Required: []string{"spec"},
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"spec": {
+									Type:                   "object",
+									Description:            "an eksctl ClusterConfig",
+									XPreserveUnknownFields: &preserveUnknownFields,
+								},
+								"status": {
+									Type: "object",
+									Properties: map[string]apiextensionsv1.JSONSchemaProps{
+										"phase":              {Type: "string"},
+										"message":            {Type: "string"},
+										"observedGeneration": {Type: "integer", Format: "int64"},
+										"lastReconcileTime":  {Type: "string", Format: "date-time"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// InstallCRD creates the CRD of the ClusterConfig objects, or updates it if it exists
+func InstallCRD(ctx context.Context, client apiextensionsclientset.Interface) error {
+	crds := client.ApiextensionsV1().CustomResourceDefinitions()
+	crd := CustomResourceDefinition()
+	existing, err := crds.Get(ctx, crd.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = crds.Create(ctx, crd, metav1.CreateOptions{})
+		return errors.Wrapf(err, "creating CRD %q", crd.Name)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "getting CRD %q", crd.Name)
+	}
+	crd.ResourceVersion = existing.ResourceVersion
+	_, err = crds.Update(ctx, crd, metav1.UpdateOptions{})
+	return errors.Wrapf(err, "updating CRD %q", crd.Name)
+}
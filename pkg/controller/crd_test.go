@@ -0,0 +1,26 @@
+package controller_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/controller"
+)
+
+var _ = Describe("InstallCRD", func() {
+	It("creates the CRD, and updates it if it exists", func() {
+		client := apiextensionsfake.NewSimpleClientset()
+		Expect(controller.InstallCRD(context.Background(), client)).To(Succeed())
+		Expect(controller.InstallCRD(context.Background(), client)).To(Succeed())
+
+		crd, err := client.ApiextensionsV1().CustomResourceDefinitions().Get(context.Background(), "clusterconfigs.controller.eksctl.io", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(crd.Spec.Names.Kind).To(Equal("ClusterConfig"))
+		Expect(crd.Spec.Versions).To(HaveLen(1))
+		Expect(crd.Spec.Versions[0].Subresources.Status).NotTo(BeNil())
+	})
+})
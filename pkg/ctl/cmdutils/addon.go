@@ -10,10 +10,12 @@ var addonFlagsIncompatibleWithConfigFile = []string{
 	"version",
 	"service-account-role-arn",
 	"attach-policy-arn",
+	"configuration-values",
 }
 
 func NewCreateOrUpgradeAddonLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
+	l.runUserValidators = true
 	l.flagsIncompatibleWithConfigFile.Insert(addonFlagsIncompatibleWithConfigFile...)
 	l.flagsIncompatibleWithoutConfigFile.Insert(addonFlagsIncompatibleWithoutConfigFile...)
 	l.validateWithConfigFile = func() error {
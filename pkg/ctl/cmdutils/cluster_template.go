@@ -0,0 +1,56 @@
+package cmdutils
+
+import (
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/clustertemplates"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// clusterTemplateIncompatibleFlags are the flags configuring parts of the cluster defined by the template
+var clusterTemplateIncompatibleFlags = append([]string{
+	"fargate",
+	"fargate-only",
+	"with-oidc",
+	"nodegroup-name",
+	"disable-pod-imds",
+	"appmesh-access",
+	"appmesh-preview-access",
+	"alb-ingress-access",
+	"instance-selector-vcpus",
+	"instance-selector-memory",
+	"instance-selector-cpu-architecture",
+	"instance-selector-gpus",
+	"instance-selector-gpu-type",
+	"instance-selector-exclude-burstable",
+	"instance-selector-max-price-per-vcpu",
+	"instance-selector-allow-list",
+	"instance-selector-deny-list",
+	"instance-selector-prefer-reserved",
+	"vpc-private-subnets",
+	"vpc-public-subnets",
+	"vpc-cidr",
+	"vpc-nat-mode",
+	"vpc-from-kops-cluster",
+}, commonNGFlagsIncompatibleWithConfigFile...)
+
+// loadClusterTemplate replaces clusterConfig with the ClusterConfig of the built-in template, keeping the
+// metadata set via flags. clusterConfig is updated in place, as other code holds a reference to it
+func loadClusterTemplate(clusterConfig *api.ClusterConfig, name string) error {
+	data, err := clustertemplates.Get(name)
+	if err != nil {
+		return err
+	}
+	template, err := eks.ParseConfig(data)
+	if err != nil {
+		return errors.Wrapf(err, "loading cluster template %q", name)
+	}
+	if err := template.ExpandSpotFallbackNodeGroups(); err != nil {
+		return errors.Wrapf(err, "loading cluster template %q", name)
+	}
+	template.ApplyNodeGroupDefaults()
+	template.Metadata = clusterConfig.Metadata
+	*clusterConfig = *template
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/cobra"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
@@ -16,6 +17,10 @@ type Cmd struct {
 
 	Plan, Wait, Validate bool
 
+	// Approved is set when --approve is, the changes of the command, including the change sets of its stack
+	// updates, are then executed without prompting
+	Approved bool
+
 	NameArg string
 
 	ClusterConfigFile string
@@ -34,7 +39,7 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 
 	if err := api.ValidateClusterConfig(c.ClusterConfig); err != nil {
 		if c.Validate {
-			return nil, err
+			return nil, NewValidationError(err)
 		}
 		logger.Warning("ignoring validation error: %s", err.Error())
 	}
@@ -42,7 +47,7 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 	for i, ng := range c.ClusterConfig.NodeGroups {
 		if err := api.ValidateNodeGroup(i, ng); err != nil {
 			if c.Validate {
-				return nil, err
+				return nil, NewValidationError(err)
 			}
 			logger.Warning("ignoring validation error: %s", err.Error())
 		}
@@ -54,7 +59,7 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 	for i, ng := range c.ClusterConfig.ManagedNodeGroups {
 		api.SetManagedNodeGroupDefaults(ng, c.ClusterConfig.Metadata)
 		if err := api.ValidateManagedNodeGroup(ng, i); err != nil {
-			return nil, err
+			return nil, NewValidationError(err)
 		}
 	}
 
@@ -62,9 +67,13 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 	if err != nil {
 		return nil, err
 	}
+	ctl.ChangeSetApprover = changeSetApprover
+	if c.Approved {
+		ctl.ChangeSetApprover = manager.AutoApproveChangeSets
+	}
 
 	if !ctl.IsSupportedRegion() {
-		return nil, ErrUnsupportedRegion(&c.ProviderConfig)
+		return nil, NewValidationError(ErrUnsupportedRegion(&c.ProviderConfig))
 	}
 
 	return ctl, nil
@@ -88,6 +88,7 @@ func AddApproveFlag(fs *pflag.FlagSet, cmd *Cmd) {
 	AddPreRun(cmd.CobraCommand, func(cobraCmd *cobra.Command, args []string) {
 		if cobraCmd.Flag("approve").Changed {
 			cmd.Plan = !*approve
+			cmd.Approved = *approve
 		}
 	})
 }
@@ -107,16 +108,19 @@ func GetNameArg(args []string) string {
 // AddCommonFlagsForAWS adds common flags for api.ProviderConfig
 func AddCommonFlagsForAWS(group *NamedFlagSetGroup, p *api.ProviderConfig, addCfnOptions bool) {
 	group.InFlagSet("AWS client", func(fs *pflag.FlagSet) {
-		fs.StringVarP(&p.Profile, "profile", "p", "", "AWS credentials profile to use (overrides the AWS_PROFILE environment variable)")
+		fs.StringVarP(&p.Profile, "profile", "p", defaultProfile(), "AWS credentials profile to use (overrides the AWS_PROFILE environment variable)")
 
 		fs.DurationVar(&p.WaitTimeout, "aws-api-timeout", api.DefaultWaitTimeout, "")
 		// TODO deprecate in 0.2.0
 		if err := fs.MarkHidden("aws-api-timeout"); err != nil {
 			logger.Debug("ignoring error %q", err.Error())
 		}
+		fs.StringVar(&p.SessionName, "session-name", "", "session name of the IAM roles assumed by eksctl, e.g. of the profile, which CloudTrail records with the calls made with them")
+		fs.StringVar(&p.SourceIdentity, "source-identity", "", "source identity set on the IAM roles assumed by eksctl, which CloudTrail records with the calls made with them and those of roles assumed after them")
 		if addCfnOptions {
 			fs.StringVar(&p.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf")
 			fs.BoolVar(&p.CloudFormationDisableRollback, "cfn-disable-rollback", false, "for debugging: If a stack fails, do not roll it back. Be careful, this may lead to unintentional resource consumption!")
+			fs.StringVar(&p.CloudFormationTemplateBucket, "cfn-template-bucket", "", "S3 bucket templates larger than 51,200 bytes are uploaded to, instead of a bucket created by eksctl")
 		}
 	})
 }
@@ -131,6 +135,16 @@ func AddTimeoutFlag(fs *pflag.FlagSet, p *time.Duration) {
 	AddTimeoutFlagWithValue(fs, p, api.DefaultWaitTimeout)
 }
 
+// AddForceAfterFlag adds common --force-after flag for drains
+func AddForceAfterFlag(fs *pflag.FlagSet, p *time.Duration) {
+	fs.DurationVar(p, "force-after", 0, "Delete pods whose eviction is still blocked by PodDisruptionBudgets this long after the drain started, 0 never deletes them")
+}
+
+// AddIfNotExistsFlag adds common --if-not-exists flag, description says what happens to existing resources
+func AddIfNotExistsFlag(fs *pflag.FlagSet, p *bool, description string) {
+	fs.BoolVar(p, "if-not-exists", false, description)
+}
+
 // AddClusterFlag adds a common --cluster flag for cluster name.
 // Use this for commands whose principal resource is *not* a cluster.
 func AddClusterFlag(fs *pflag.FlagSet, meta *api.ClusterMeta) {
@@ -157,7 +171,7 @@ func ClusterNameFlag(cmd *Cmd) string {
 
 // AddRegionFlag adds common --region flag
 func AddRegionFlag(fs *pflag.FlagSet, p *api.ProviderConfig) {
-	fs.StringVarP(&p.Region, "region", "r", "", "AWS region")
+	fs.StringVarP(&p.Region, "region", "r", defaultRegion(), "AWS region")
 }
 
 // AddVersionFlag adds common --version flag
@@ -189,6 +203,21 @@ func AddSubnetIDs(fs *pflag.FlagSet, subnetIDs *[]string, description string) {
 	fs.StringSliceVar(subnetIDs, "subnet-ids", nil, description)
 }
 
+// AddSharedVPCFlag adds the --shared-vpc flag
+func AddSharedVPCFlag(fs *pflag.FlagSet, sharedVPC *bool) {
+	fs.BoolVar(sharedVPC, "shared-vpc", false, "tag the resources created by eksctl with kubernetes.io/cluster/<name>=shared instead of owned, for VPCs shared with other clusters")
+}
+
+// AddWriteOutputsFlag adds the --write-outputs flag
+func AddWriteOutputsFlag(fs *pflag.FlagSet, path *string) {
+	fs.StringVar(path, "write-outputs", "", "write the identifiers of the cluster and the outputs of its stacks as JSON to a file, for later steps of a pipeline to consume")
+}
+
+// AddPreflightIAMFlag adds the --preflight-iam flag
+func AddPreflightIAMFlag(fs *pflag.FlagSet, preflightIAM *bool) {
+	fs.BoolVar(preflightIAM, "preflight-iam", false, "simulate the IAM policies of the caller against the API calls of the operation, and fail listing missing permissions before changing anything")
+}
+
 // AddCommonFlagsForKubeconfig adds common flags for controlling how output kubeconfig is written
 func AddCommonFlagsForKubeconfig(fs *pflag.FlagSet, outputPath, authenticatorRoleARN *string, setContext, autoPath *bool, exampleName string) {
 	fs.StringVar(outputPath, "kubeconfig", kubeconfig.DefaultPath(), "path to write kubeconfig (incompatible with --auto-kubeconfig)")
@@ -200,7 +229,7 @@ func AddCommonFlagsForKubeconfig(fs *pflag.FlagSet, outputPath, authenticatorRol
 // AddCommonFlagsForGetCmd adds common flafs for get commands
 func AddCommonFlagsForGetCmd(fs *pflag.FlagSet, chunkSize *int, outputMode *printers.Type) {
 	fs.IntVar(chunkSize, "chunk-size", 100, "return large lists in chunks rather than all at once, pass 0 to disable")
-	fs.StringVarP(outputMode, "output", "o", "table", "specifies the output format (valid option: table, json, yaml)")
+	fs.StringVarP(outputMode, "output", "o", defaultOutput(), "specifies the output format (valid option: table, json, yaml)")
 }
 
 // AddStringToStringVarPFlag is a wrapper that prefixes the description of the flag for consistency
@@ -222,17 +251,17 @@ func ErrClusterFlagAndArg(cmd *Cmd, nameFlag, nameArg string) error {
 // ErrFlagAndArg may be used to err for options that can be given
 // as flags /and/ arg but only one is allowed to be used.
 func ErrFlagAndArg(kind, flag, arg string) error {
-	return fmt.Errorf("%s=%s and argument %s %s", kind, flag, arg, IncompatibleFlags)
+	return NewValidationError(fmt.Errorf("%s=%s and argument %s %s", kind, flag, arg, IncompatibleFlags))
 }
 
 // ErrMustBeSet is a common error message
 func ErrMustBeSet(pathOrFlag string) error {
-	return fmt.Errorf("%s must be set", pathOrFlag)
+	return NewValidationError(fmt.Errorf("%s must be set", pathOrFlag))
 }
 
 // ErrCannotUseWithConfigFile is a common error message
 func ErrCannotUseWithConfigFile(what string) error {
-	return fmt.Errorf("cannot use %s when --config-file/-f is set", what)
+	return NewValidationError(fmt.Errorf("cannot use %s when --config-file/-f is set", what))
 }
 
 // ErrUnsupportedManagedFlag reports unsupported flags for Managed Nodegroups
@@ -36,6 +36,12 @@ type commonClusterConfigLoader struct {
 	flagsIncompatibleWithoutConfigFile sets.String
 	validateWithConfigFile             func() error
 	validateWithoutConfigFile          func() error
+	// runUserValidators runs the validators of the user config file against the loaded ClusterConfig,
+	// it is set by the loaders of commands that create or update resources
+	runUserValidators bool
+	// mergeDefaultTags merges the tags of the current context of the user config file into metadata.tags of
+	// the config file, it is set by the loaders of commands whose --tags defaults to them
+	mergeDefaultTags bool
 }
 
 var (
@@ -56,8 +62,10 @@ var (
 	commonCreateFlagsIncompatibleWithDryRun = []string{
 		"cfn-disable-rollback",
 		"cfn-role-arn",
+		"cfn-template-bucket",
 		"install-neuron-plugin",
 		"install-nvidia-plugin",
+		"preflight-iam",
 		"profile",
 		"timeout",
 	}
@@ -73,6 +81,7 @@ var (
 		"node-volume-size",
 		"node-volume-type",
 		"max-pods-per-node",
+		"max-pods-calculation",
 		"node-ami",
 		"node-ami-family",
 		"ssh-access",
@@ -105,6 +114,20 @@ func newCommonClusterConfigLoader(cmd *Cmd) *commonClusterConfigLoader {
 
 // Load ClusterConfig or use flags
 func (l *commonClusterConfigLoader) Load() error {
+	if err := l.load(); err != nil {
+		return NewValidationError(err)
+	}
+	// the registry is resolved once the config is complete, whether it comes from a config file, a template or flags
+	if err := eks.LoadWellKnownPolicyRegistry(l.ClusterConfig, l.ClusterConfigFile); err != nil {
+		return NewValidationError(err)
+	}
+	if l.runUserValidators {
+		return RunUserValidators(l.ClusterConfig)
+	}
+	return nil
+}
+
+func (l *commonClusterConfigLoader) load() error {
 	if err := api.Register(); err != nil {
 		return err
 	}
@@ -147,6 +170,10 @@ func (l *commonClusterConfigLoader) Load() error {
 	}
 	l.ProviderConfig.Region = meta.Region
 
+	if l.mergeDefaultTags {
+		meta.Tags = mergeDefaultTags(meta.Tags)
+	}
+
 	return l.validateWithConfigFile()
 }
 
@@ -192,9 +219,22 @@ func NewMetadataLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewUpgradeClusterLoader will load config or use flags for 'eksctl upgrade cluster', like NewMetadataLoader, and
+// runs the validators of the user config file
+func NewUpgradeClusterLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithoutConfigFile = l.validateMetadataWithoutConfigFile
+	l.runUserValidators = true
+
+	return l
+}
+
 // NewCreateClusterLoader will load config or use flags for 'eksctl create cluster'
 func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.NodeGroup, params *CreateClusterCmdParams) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
+	l.runUserValidators = true
+	l.mergeDefaultTags = true
 
 	ngFilter.SetExcludeAll(params.WithoutNodeGroup)
 
@@ -207,6 +247,8 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 		"vpc-cidr",
 		"vpc-nat-mode",
 		"vpc-from-kops-cluster",
+		"shared-vpc",
+		"template",
 	}
 
 	l.flagsIncompatibleWithConfigFile.Insert(append(clusterFlagsIncompatibleWithConfigFile, commonNGFlagsIncompatibleWithConfigFile...)...)
@@ -221,10 +263,12 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 		flagsIncompatibleWithDryRun := append([]string{
 			"authenticator-role-arn",
 			"auto-kubeconfig",
+			"fix-rules",
 			"install-vpc-controllers",
 			"kubeconfig",
 			"set-kubeconfig-context",
 			"write-kubeconfig",
+			"write-outputs",
 		}, commonCreateFlagsIncompatibleWithDryRun...)
 
 		return validateDryRunOptions(l.CobraCommand, flagsIncompatibleWithDryRun)
@@ -233,7 +277,14 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 	l.validateWithConfigFile = func() error {
 		clusterConfig := l.ClusterConfig
 		if clusterConfig.KubernetesNetworkConfig != nil && clusterConfig.KubernetesNetworkConfig.IPv6Enabled() {
-			clusterConfig.VPC = &api.ClusterVPC{}
+			// the VPC of the config file is kept, e.g. vpc.ipFamily, but not defaulted like for IPv4 as NAT and
+			// the default CIDR are not supported with IPv6
+			if clusterConfig.VPC == nil {
+				clusterConfig.VPC = &api.ClusterVPC{}
+			}
+			if added := api.SetIPv6Defaults(clusterConfig); len(added) > 0 {
+				logger.Info("adding addon(s) %s required for IPv6", strings.Join(added, ", "))
+			}
 		} else {
 			if clusterConfig.VPC == nil {
 				clusterConfig.VPC = api.NewClusterVPC()
@@ -264,6 +315,16 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 			return errors.New("vpc.subnets and availabilityZones cannot be set at the same time")
 		}
 
+		if clusterConfig.VPC.CloudFormation != nil && len(clusterConfig.AvailabilityZones) != 0 {
+			return errors.New("vpc.cloudFormation and availabilityZones cannot be set at the same time")
+		}
+
+		if params.FargateOnly {
+			if err := validateFargateOnly(clusterConfig); err != nil {
+				return err
+			}
+		}
+
 		if clusterConfig.GitOps != nil {
 			fluxCfg := clusterConfig.GitOps.Flux
 
@@ -293,6 +354,18 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 			return fmt.Errorf("status fields are read-only")
 		}
 
+		if params.Template != "" {
+			if flagName, found := findChangedFlag(l.CobraCommand, clusterTemplateIncompatibleFlags); found {
+				return errors.Errorf("cannot use --%s with --template", flagName)
+			}
+			if err := loadClusterTemplate(l.ClusterConfig, params.Template); err != nil {
+				return err
+			}
+			setSharedVPC(l.ClusterConfig, params.SharedVPC)
+			// the template is validated like a config file
+			return l.validateWithConfigFile()
+		}
+
 		if err := validateManagedNGFlags(l.CobraCommand, params.Managed); err != nil {
 			return err
 		}
@@ -308,8 +381,19 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 		}
 
 		api.SetClusterEndpointAccessDefaults(l.ClusterConfig.VPC)
+		setSharedVPC(l.ClusterConfig, params.SharedVPC)
 
-		if params.Fargate {
+		if params.FargateOnly {
+			if flagName, found := findChangedFlag(l.CobraCommand, fargateOnlyIncompatibleFlags); found {
+				return errors.Errorf("cannot use --%s with --fargate-only", flagName)
+			}
+			l.ClusterConfig.SetDefaultFargateProfile()
+			l.ClusterConfig.ManagedNodeGroups = nil
+			l.ClusterConfig.NodeGroups = nil
+			if err := validateFargateOnly(l.ClusterConfig); err != nil {
+				return err
+			}
+		} else if params.Fargate {
 			l.ClusterConfig.SetDefaultFargateProfile()
 			// A Fargate-only cluster should have no nodegroups if the `managed` flag wasn't explicitly provided.
 			if !l.CobraCommand.Flag("managed").Changed {
@@ -340,6 +424,20 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 	return l
 }
 
+// setSharedVPC configures the resources created by eksctl to be tagged as shared with other clusters
+func setSharedVPC(clusterConfig *api.ClusterConfig, sharedVPC bool) {
+	if !sharedVPC {
+		return
+	}
+	if clusterConfig.VPC == nil {
+		clusterConfig.VPC = api.NewClusterVPC()
+	}
+	if clusterConfig.VPC.ClusterTags == nil {
+		clusterConfig.VPC.ClusterTags = &api.ClusterTags{}
+	}
+	clusterConfig.VPC.ClusterTags.SharedVPC = api.Enabled()
+}
+
 func validateDryRunOptions(cmd *cobra.Command, incompatibleFlags []string) error {
 	if flagName, found := findChangedFlag(cmd, incompatibleFlags); found {
 		return errors.Errorf("cannot use --%s with --dry-run as this option cannot be represented in ClusterConfig", flagName)
@@ -350,8 +448,10 @@ func validateDryRunOptions(cmd *cobra.Command, incompatibleFlags []string) error
 // NewCreateNodeGroupLoader will load config or use flags for 'eksctl create nodegroup'
 func NewCreateNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *filter.NodeGroupFilter, ngOptions CreateNGOptions, mngOptions CreateManagedNGOptions) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
+	l.runUserValidators = true
+	l.mergeDefaultTags = true
 
-	l.flagsIncompatibleWithConfigFile.Insert(commonNGFlagsIncompatibleWithConfigFile...)
+	l.flagsIncompatibleWithConfigFile.Insert(append([]string{"shared-vpc"}, commonNGFlagsIncompatibleWithConfigFile...)...)
 
 	validateDryRun := func() error {
 		if !ngOptions.DryRun {
@@ -359,6 +459,7 @@ func NewCreateNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *filter.Node
 		}
 		// Filters (--include / --exclude) cannot be represented in ClusterConfig, however, they affect the output, so they're allowed
 		flagsIncompatibleWithDryRun := append([]string{
+			"fix-rules",
 			"update-auth-configmap",
 		}, commonCreateFlagsIncompatibleWithDryRun...)
 
@@ -382,6 +483,7 @@ func NewCreateNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *filter.Node
 		if err := validateUnmanagedNGFlags(l.CobraCommand, mngOptions.Managed); err != nil {
 			return err
 		}
+		setSharedVPC(l.ClusterConfig, ngOptions.SharedVPC)
 		if mngOptions.Managed {
 			l.ClusterConfig.ManagedNodeGroups = []*api.ManagedNodeGroup{makeManagedNodegroup(ng, mngOptions)}
 		} else {
@@ -492,6 +594,9 @@ func normalizeBaseNodeGroup(np api.NodePool, cmd *cobra.Command) {
 	if !flags.Changed("instance-selector-gpus") {
 		ng.InstanceSelector.GPUs = nil
 	}
+	if !flags.Changed("instance-selector-max-price-per-vcpu") {
+		ng.InstanceSelector.MaxPricePerVCPU = nil
+	}
 	if !flags.Changed("enable-ssm") {
 		ng.SSH.EnableSSM = nil
 	}
@@ -636,6 +741,110 @@ func NewUtilsPublicAccessCIDRsLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewUtilsUpdateSupportTypeLoader loads config or uses flags for `eksctl utils update-support-type`
+func NewUtilsUpdateSupportTypeLoader(cmd *Cmd, supportType string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("support-type")
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.UpgradePolicy == nil {
+			return ErrMustBeSet("upgradePolicy.supportType")
+		}
+		return api.ValidateUpgradePolicy(l.ClusterConfig.UpgradePolicy)
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if supportType == "" {
+			return ErrMustBeSet("--support-type")
+		}
+		l.ClusterConfig.UpgradePolicy = &api.UpgradePolicy{
+			SupportType: strings.ToUpper(supportType),
+		}
+		return api.ValidateUpgradePolicy(l.ClusterConfig.UpgradePolicy)
+	}
+	return l
+}
+
+// NewUtilsCreatePullThroughCacheLoader loads config or uses flags for `eksctl utils create-pull-through-cache`
+func NewUtilsCreatePullThroughCacheLoader(cmd *Cmd, upstreamRegistries []string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("upstream-registry")
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.PullThroughCache == nil {
+			return ErrMustBeSet("pullThroughCache")
+		}
+		return api.ValidatePullThroughCache(l.ClusterConfig.PullThroughCache)
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if len(upstreamRegistries) == 0 {
+			return ErrMustBeSet("--upstream-registry")
+		}
+		cache := &api.PullThroughCache{}
+		for _, upstream := range upstreamRegistries {
+			cache.Rules = append(cache.Rules, api.PullThroughCacheRule{UpstreamRegistryURL: upstream})
+		}
+		l.ClusterConfig.PullThroughCache = cache
+		return api.ValidatePullThroughCache(cache)
+	}
+	return l
+}
+
+// NewUtilsUpdateAlarmsLoader loads config for `eksctl utils update-alarms`
+func NewUtilsUpdateAlarmsLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.Alarms == nil {
+			return ErrMustBeSet("alarms")
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+	return l
+}
+
+// NewUtilsDeployStackSetLoader loads config for `eksctl utils deploy-stackset`
+func NewUtilsDeployStackSetLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.StackSet == nil {
+			return ErrMustBeSet("stackSet")
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+	return l
+}
+
+// NewUtilsTemplateStatsLoader will load config for 'eksctl utils template-stats'
+func NewUtilsTemplateStatsLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithConfigFile = func() error {
+		api.SetClusterEndpointAccessDefaults(l.ClusterConfig.VPC)
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+	return l
+}
+
 func parseList(arg string) ([]string, error) {
 	reader := strings.NewReader(arg)
 	csvReader := csv.NewReader(reader)
@@ -645,6 +854,7 @@ func parseList(arg string) ([]string, error) {
 // NewCreateIAMServiceAccountLoader will load config or use flags for 'eksctl create iamserviceaccount'
 func NewCreateIAMServiceAccountLoader(cmd *Cmd, saFilter *filter.IAMServiceAccountFilter) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
+	l.runUserValidators = true
 
 	l.flagsIncompatibleWithConfigFile.Insert(
 		"policy-arn",
@@ -773,6 +983,7 @@ func NewDeleteIAMServiceAccountLoader(cmd *Cmd, sa *api.ClusterIAMServiceAccount
 // NewUpdateNodegroupLoader will load config or use flags for 'eksctl update nodegroup'.
 func NewUpdateNodegroupLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
+	l.runUserValidators = true
 
 	l.validateWithConfigFile = func() error {
 		length := len(l.ClusterConfig.ManagedNodeGroups)
@@ -810,6 +1021,23 @@ func NewUpdateNodegroupLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewUpdateHelmReleaseLoader will load config for 'eksctl update helmrelease'.
+func NewUpdateHelmReleaseLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.HelmReleases) == 0 {
+			return ErrMustBeSet("helmReleases field")
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+	return l
+}
+
 // NewGetNodegroupLoader loads config file and validates command for `eksctl get nodegroup`.
 func NewGetNodegroupLoader(cmd *Cmd, ng *api.NodeGroup) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
@@ -864,6 +1092,20 @@ func NewGetLabelsLoader(cmd *Cmd, ngName string) ClusterConfigLoader {
 	return l
 }
 
+// NewUtilsConvertNodeGroupLoader will load config or use flags for 'eksctl utils convert-nodegroup'
+func NewUtilsConvertNodeGroupLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+	// --name and the name argument are the nodegroup to convert, which is also set in the config file
+	l.flagsIncompatibleWithConfigFile.Delete("name")
+	l.validateWithoutConfigFile = func() error {
+		if cmd.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet(ClusterNameFlag(cmd))
+		}
+		return nil
+	}
+	return l
+}
+
 // NewGetClusterLoader will load config or use flags for 'eksctl get cluster(s)'
 func NewGetClusterLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
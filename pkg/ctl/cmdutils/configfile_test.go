@@ -1,6 +1,8 @@
 package cmdutils
 
 import (
+	"errors"
+	"os"
 	"path/filepath"
 
 	. "github.com/onsi/ginkgo"
@@ -9,6 +11,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/userconfig"
 )
 
 var _ = Describe("cmdutils configfile", func() {
@@ -215,6 +218,50 @@ var _ = Describe("cmdutils configfile", func() {
 				Expect(NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, params).Load()).To(Succeed())
 				Expect(cmd.ClusterConfig.VPC.NAT).To(BeNil())
 			})
+
+			It("should keep the VPC of the config file", func() {
+				configFile, err := os.CreateTemp("", "ipv6-native-*.yaml")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.Remove(configFile.Name())
+				_, err = configFile.WriteString(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: cluster-2
+  region: us-west-2
+  version: "1.21"
+kubernetesNetworkConfig:
+  ipFamily: IPv6
+vpc:
+  ipFamily: IPv6
+addons:
+  - name: vpc-cni
+  - name: coredns
+  - name: kube-proxy
+iam:
+  withOIDC: true
+`)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(configFile.Close()).To(Succeed())
+
+				cmd := &Cmd{
+					CobraCommand:      newCmd(),
+					ClusterConfigFile: configFile.Name(),
+					ClusterConfig:     api.NewClusterConfig(),
+					ProviderConfig:    api.ProviderConfig{},
+				}
+				params := &CreateClusterCmdParams{WithoutNodeGroup: true, CreateManagedNGOptions: CreateManagedNGOptions{
+					Managed: false,
+				}}
+				Expect(NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, params).Load()).To(Succeed())
+				cfg := cmd.ClusterConfig
+				Expect(cfg.VPC.NAT).To(BeNil())
+				Expect(cfg.IPv6Native()).To(BeTrue())
+
+				api.SetClusterConfigDefaults(cfg)
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+				Expect(cfg.IPv6Native()).To(BeTrue())
+			})
 		})
 
 		It("loader should handle named and unnamed nodegroups without config file", func() {
@@ -383,6 +430,185 @@ var _ = Describe("cmdutils configfile", func() {
 				testClusterEndpointAccessDefaults("test_data/cluster-with-vpc-private-access.yaml", true, true)
 			})
 		})
+
+		Describe("with --fargate-only", func() {
+			loadFargateOnly := func(configFile string) (*api.ClusterConfig, error) {
+				cmd := &Cmd{
+					CobraCommand:      newCmd(),
+					ClusterConfigFile: configFile,
+					ClusterConfig:     api.NewClusterConfig(),
+					ProviderConfig:    api.ProviderConfig{},
+				}
+				params := &CreateClusterCmdParams{FargateOnly: true}
+				err := NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), api.NewNodeGroup(), params).Load()
+				return cmd.ClusterConfig, err
+			}
+
+			It("creates no nodegroups and the default Fargate profile without config file", func() {
+				cfg, err := loadFargateOnly("")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.NodeGroups).To(BeEmpty())
+				Expect(cfg.ManagedNodeGroups).To(BeEmpty())
+				Expect(cfg.FargateProfiles).To(HaveLen(1))
+				Expect(cfg.FargateProfiles[0].Name).To(Equal("fp-default"))
+			})
+
+			It("adds the default Fargate profile to config files without profiles", func() {
+				cfg, err := loadFargateOnly(filepath.Join(examplesDir, "02-custom-vpc-cidr-no-nodes.yaml"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.FargateProfiles).To(HaveLen(1))
+				Expect(cfg.FargateProfiles[0].Name).To(Equal("fp-default"))
+			})
+
+			It("rejects config files with nodegroups", func() {
+				_, err := loadFargateOnly(filepath.Join(examplesDir, "16-fargate-profile.yaml"))
+				Expect(err).To(MatchError("a Fargate-only cluster cannot have nodeGroups or managedNodeGroups"))
+			})
+
+			It("rejects addons incompatible with Fargate", func() {
+				_, err := loadFargateOnly("test_data/fargate-only-with-ebs-csi-driver.yaml")
+				Expect(err).To(MatchError(`addon "aws-ebs-csi-driver" is not compatible with Fargate: EBS volumes cannot be attached to pods running on Fargate`))
+			})
+		})
+
+		Describe("with validators in the user config file", func() {
+			BeforeEach(func() {
+				loadUserConfigOnce.Do(func() {})
+				userConfig = &userconfig.Config{
+					Validators: []*userconfig.Validator{
+						{Name: "private-endpoint", Command: `grep -q '"privateAccess":true' "$EKSCTL_CLUSTER_CONFIG" || { echo "the API server endpoint must be private"; exit 1; }`},
+					},
+				}
+			})
+
+			AfterEach(func() {
+				userConfig = &userconfig.Config{}
+			})
+
+			It("rejects the ClusterConfig before eksctl proceeds", func() {
+				cmd := &Cmd{
+					CobraCommand:      newCmd(),
+					ClusterConfigFile: filepath.Join(examplesDir, "01-simple-cluster.yaml"),
+					ClusterConfig:     api.NewClusterConfig(),
+					ProviderConfig:    api.ProviderConfig{},
+				}
+				err := NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, &CreateClusterCmdParams{}).Load()
+				Expect(err).To(MatchError("ClusterConfig rejected by validators:\n- private-endpoint: the API server endpoint must be private"))
+				var validationErr *ValidationError
+				Expect(errors.As(err, &validationErr)).To(BeTrue())
+			})
+
+			It("does not run for commands that do not create or update resources", func() {
+				cmd := &Cmd{
+					CobraCommand:      newCmd(),
+					ClusterConfigFile: filepath.Join(examplesDir, "01-simple-cluster.yaml"),
+					ClusterConfig:     api.NewClusterConfig(),
+					ProviderConfig:    api.ProviderConfig{},
+				}
+				Expect(NewGetClusterLoader(cmd).Load()).To(Succeed())
+			})
+		})
+
+		Describe("with --template", func() {
+			newTemplateCmd := func(configFile string) *Cmd {
+				cfg := api.NewClusterConfig()
+				cfg.Metadata.Name = "templated"
+				cfg.Metadata.Region = "us-west-2"
+				cobraCmd := newCmd()
+				cobraCmd.Flags().String("template", "", "")
+				cobraCmd.Flags().String("node-type", "", "")
+				return &Cmd{
+					CobraCommand:      cobraCmd,
+					ClusterConfigFile: configFile,
+					ClusterConfig:     cfg,
+					ProviderConfig:    api.ProviderConfig{},
+				}
+			}
+
+			It("loads the template into the existing ClusterConfig", func() {
+				cmd := newTemplateCmd("")
+				cfg := cmd.ClusterConfig
+				params := &CreateClusterCmdParams{Template: "batch-spot"}
+				Expect(NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), api.NewNodeGroup(), params).Load()).To(Succeed())
+
+				Expect(cmd.ClusterConfig).To(BeIdenticalTo(cfg))
+				Expect(cfg.Metadata.Name).To(Equal("templated"))
+				Expect(cfg.Metadata.Region).To(Equal("us-west-2"))
+				Expect(cfg.NodeGroups).To(BeEmpty())
+				Expect(cfg.ManagedNodeGroups).To(HaveLen(3))
+				Expect(cfg.GetAllNodeGroupNames()).To(ConsistOf("system", "batch-spot", "batch-on-demand"))
+				Expect(*cfg.VPC.NAT.Gateway).To(Equal(api.ClusterSingleNAT))
+			})
+
+			It("marks the VPC of the template as shared with --shared-vpc", func() {
+				cmd := newTemplateCmd("")
+				params := &CreateClusterCmdParams{Template: "dev-small"}
+				params.SharedVPC = true
+				Expect(NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), api.NewNodeGroup(), params).Load()).To(Succeed())
+				Expect(cmd.ClusterConfig.VPC.ClusterTags).NotTo(BeNil())
+				Expect(api.IsEnabled(cmd.ClusterConfig.VPC.ClusterTags.SharedVPC)).To(BeTrue())
+			})
+
+			It("rejects unknown templates", func() {
+				params := &CreateClusterCmdParams{Template: "huge"}
+				err := NewCreateClusterLoader(newTemplateCmd(""), filter.NewNodeGroupFilter(), api.NewNodeGroup(), params).Load()
+				Expect(err).To(MatchError(ContainSubstring(`unknown cluster template "huge"`)))
+			})
+
+			It("rejects flags configuring the nodegroups of the template", func() {
+				cmd := newTemplateCmd("")
+				Expect(cmd.CobraCommand.Flags().Set("node-type", "m5.xlarge")).To(Succeed())
+				params := &CreateClusterCmdParams{Template: "dev-small"}
+				err := NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), api.NewNodeGroup(), params).Load()
+				Expect(err).To(MatchError("cannot use --node-type with --template"))
+			})
+
+			It("cannot be used with a config file", func() {
+				cmd := newTemplateCmd(filepath.Join(examplesDir, "01-simple-cluster.yaml"))
+				Expect(cmd.CobraCommand.Flags().Set("template", "dev-small")).To(Succeed())
+				params := &CreateClusterCmdParams{Template: "dev-small"}
+				err := NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), api.NewNodeGroup(), params).Load()
+				Expect(err).To(MatchError(ErrCannotUseWithConfigFile("--template").Error()))
+			})
+		})
+	})
+
+	Describe("UtilsUpdateSupportTypeLoader", func() {
+		It("should set the support type from the flag", func() {
+			cmd := &Cmd{
+				CobraCommand:   newCmd(),
+				ClusterConfig:  api.NewClusterConfig(),
+				ProviderConfig: api.ProviderConfig{},
+			}
+			cmd.ClusterConfig.Metadata.Name = "cluster-1"
+
+			Expect(NewUtilsUpdateSupportTypeLoader(cmd, "standard").Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.UpgradePolicy.SupportType).To(Equal(api.SupportTypeStandard))
+		})
+
+		It("should reject an unknown support type", func() {
+			cmd := &Cmd{
+				CobraCommand:   newCmd(),
+				ClusterConfig:  api.NewClusterConfig(),
+				ProviderConfig: api.ProviderConfig{},
+			}
+			cmd.ClusterConfig.Metadata.Name = "cluster-1"
+
+			err := NewUtilsUpdateSupportTypeLoader(cmd, "premium").Load()
+			Expect(err).To(MatchError(`invalid value "PREMIUM" for upgradePolicy.supportType, must be one of STANDARD, EXTENDED`))
+		})
+
+		It("should require the support type", func() {
+			cmd := &Cmd{
+				CobraCommand:   newCmd(),
+				ClusterConfig:  api.NewClusterConfig(),
+				ProviderConfig: api.ProviderConfig{},
+			}
+			cmd.ClusterConfig.Metadata.Name = "cluster-1"
+
+			err := NewUtilsUpdateSupportTypeLoader(cmd, "").Load()
+			Expect(err).To(MatchError(ErrMustBeSet("--support-type")))
+		})
 	})
 
 	Describe("SetLabelLoader", func() {
@@ -18,7 +18,16 @@ type CreateClusterCmdParams struct {
 	Subnets               map[api.SubnetTopology]*[]string
 	WithoutNodeGroup      bool
 	Fargate               bool
+	FargateOnly           bool
+	Template              string
 	DryRun                bool
+	IfNotExists           bool
+	RestrictPublicAccess  string
+	FixSecurityGroupRules bool
+	WriteOutputs          string
+	PreflightIAM          bool
+	NotificationsOptions  NotificationsOptions
+	Provider              string
 	CreateNGOptions
 	CreateManagedNGOptions
 }
@@ -35,4 +44,5 @@ type CreateNGOptions struct {
 	InstallNeuronDevicePlugin bool
 	InstallNvidiaDevicePlugin bool
 	DryRun                    bool
+	SharedVPC                 bool
 }
@@ -3,9 +3,11 @@ package cmdutils
 import (
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/fargate/coredns"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -14,6 +16,7 @@ const (
 	fargateProfileSelectorNamespace = "namespace" // Fargate profile selector's namespace.
 	fargateProfileSelectorLabels    = "labels"    // Fargate profile selector's labels.
 	fargateProfileTags              = "tags"      // Fargate profile tags.
+	fargateWithCoreDNS              = "with-coredns"
 )
 
 // AddFlagsForFargate configures the flags required to interact with Fargate.
@@ -34,6 +37,9 @@ func AddFlagsForFargateProfileCreation(fs *pflag.FlagSet, options *fargate.Creat
 
 	AddStringToStringVarPFlag(fs, &options.Tags, fargateProfileTags, "t", map[string]string{},
 		"Used to tag the AWS resources")
+
+	fs.BoolVar(&options.WithCoreDNS, fargateWithCoreDNS, false,
+		"Also create a Fargate profile selecting CoreDNS, unless one of the profiles selects it, and schedule CoreDNS onto Fargate")
 }
 
 func addFargateProfileName(fs *pflag.FlagSet, profileName *string) {
@@ -56,9 +62,11 @@ var fargateProfileFlagsIncompatibleWithoutConfigFile = []string{}
 // 'eksctl create fargateprofile'
 func NewCreateFargateProfileLoader(cmd *Cmd, options *fargate.CreateOptions) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
+	l.runUserValidators = true
 	l.flagsIncompatibleWithConfigFile.Insert(fargateProfileFlagsIncompatibleWithConfigFile...)
 	l.flagsIncompatibleWithoutConfigFile.Insert(fargateProfileFlagsIncompatibleWithoutConfigFile...)
 	l.validateWithConfigFile = func() error {
+		addCoreDNSFargateProfile(l.ClusterConfig, options)
 		return validateFargateProfiles(l)
 	}
 	l.validateWithoutConfigFile = func() error {
@@ -74,11 +82,19 @@ func NewCreateFargateProfileLoader(cmd *Cmd, options *fargate.CreateOptions) Clu
 		cmd.ClusterConfig.FargateProfiles = []*api.FargateProfile{
 			options.ToFargateProfile(),
 		}
+		addCoreDNSFargateProfile(cmd.ClusterConfig, options)
 		return validateFargateProfiles(l)
 	}
 	return l
 }
 
+// addCoreDNSFargateProfile adds the CoreDNS Fargate profile when requested and none of the profiles selects CoreDNS
+func addCoreDNSFargateProfile(clusterConfig *api.ClusterConfig, options *fargate.CreateOptions) {
+	if options.WithCoreDNS && !coredns.IsSchedulableOnFargate(clusterConfig.FargateProfiles) {
+		clusterConfig.FargateProfiles = append(clusterConfig.FargateProfiles, coredns.FargateProfile())
+	}
+}
+
 func validateFargateProfiles(l *commonClusterConfigLoader) error {
 	for _, profile := range l.ClusterConfig.FargateProfiles {
 		if err := profile.Validate(); err != nil {
@@ -167,3 +183,33 @@ func NewDeleteFargateProfileLoader(cmd *Cmd, options *fargate.Options) ClusterCo
 	}
 	return l
 }
+
+// fargateOnlyIncompatibleFlags are the flags configuring the initial nodegroup, which a Fargate-only cluster does not have
+var fargateOnlyIncompatibleFlags = []string{
+	"managed",
+	"nodegroup-name",
+	"node-type",
+	"instance-types",
+	"spot",
+	"nodes",
+	"nodes-min",
+	"nodes-max",
+}
+
+// validateFargateOnly validates that a cluster has no nodegroups nor addons requiring nodes, and adds
+// the profiles scheduling the default and kube-system namespaces, or only CoreDNS, onto Fargate if needed
+func validateFargateOnly(clusterConfig *api.ClusterConfig) error {
+	if len(clusterConfig.NodeGroups) > 0 || len(clusterConfig.ManagedNodeGroups) > 0 {
+		return errors.New("a Fargate-only cluster cannot have nodeGroups or managedNodeGroups")
+	}
+	if err := fargate.ValidateAddons(clusterConfig.Addons); err != nil {
+		return err
+	}
+	if len(clusterConfig.FargateProfiles) == 0 {
+		clusterConfig.SetDefaultFargateProfile()
+	}
+	if !coredns.IsSchedulableOnFargate(clusterConfig.FargateProfiles) {
+		clusterConfig.FargateProfiles = append(clusterConfig.FargateProfiles, coredns.FargateProfile())
+	}
+	return nil
+}
@@ -57,7 +57,7 @@ func (f *IAMServiceAccountFilter) SetExcludeExistingFilter(stackManager serviceA
 
 	if !overrideExistingServiceAccounts {
 		err := f.ForEach(serviceAccounts, func(_ int, sa *api.ClusterIAMServiceAccount) error {
-			if api.IsEnabled(sa.RoleOnly) {
+			if api.IsEnabled(sa.RoleOnly) || api.IsEnabled(sa.AdoptExisting) {
 				return nil
 			}
 			exists, err := kubernetes.CheckServiceAccountExists(clientSet, sa.ClusterIAMMeta.AsObjectMeta())
@@ -105,24 +105,34 @@ var _ = Describe("iamserviceaccount filter", func() {
 					Name:      "role-only",
 				},
 				RoleOnly: api.Enabled(),
+			}, &api.ClusterIAMServiceAccount{
+				ClusterIAMMeta: api.ClusterIAMMeta{
+					Namespace: "sa",
+					Name:      "adopted",
+				},
+				AdoptExisting: api.Enabled(),
 			})
 			sa1 := metav1.ObjectMeta{Name: "test1", Namespace: "sa"}
 			sa2 := metav1.ObjectMeta{Name: "role-only", Namespace: "sa"}
+			sa3 := metav1.ObjectMeta{Name: "adopted", Namespace: "sa"}
 
 			err := kubernetes.MaybeCreateServiceAccountOrUpdateMetadata(clientSet, sa1)
 			Expect(err).NotTo(HaveOccurred())
 			err = kubernetes.MaybeCreateServiceAccountOrUpdateMetadata(clientSet, sa2)
 			Expect(err).NotTo(HaveOccurred())
+			err = kubernetes.MaybeCreateServiceAccountOrUpdateMetadata(clientSet, sa3)
+			Expect(err).NotTo(HaveOccurred())
 
 			err = filter.SetExcludeExistingFilter(mockLister, clientSet, cfg.IAM.ServiceAccounts, false)
 			Expect(err).NotTo(HaveOccurred())
 
 			included, excluded := filter.MatchAll(cfg.IAM.ServiceAccounts)
-			Expect(included).To(HaveLen(3))
+			Expect(included).To(HaveLen(4))
 			Expect(included.HasAll(
 				"sa/test2",
 				"sa/test3",
 				"sa/role-only",
+				"sa/adopted",
 			)).To(BeTrue())
 			Expect(excluded).To(HaveLen(4))
 			Expect(excluded.HasAll(
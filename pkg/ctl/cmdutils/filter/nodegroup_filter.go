@@ -3,8 +3,6 @@ package filter
 import (
 	"strings"
 
-	"github.com/aws/aws-sdk-go/service/eks"
-
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 
 	"github.com/kris-nova/logger"
@@ -12,6 +10,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -174,10 +173,7 @@ func (f *NodeGroupFilter) findAllNodeGroups(eksAPI eksiface.EKSAPI, lister Stack
 	}
 
 	// Get all nodegroups
-	allNodeGroups, err := eksAPI.ListNodegroups(&eks.ListNodegroupsInput{
-		ClusterName: &clusterConfig.Metadata.Name,
-	})
-
+	allNodeGroups, err := eks.ListNodegroups(eksAPI, clusterConfig.Metadata.Name)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -189,7 +185,7 @@ func (f *NodeGroupFilter) findAllNodeGroups(eksAPI eksiface.EKSAPI, lister Stack
 
 	var nodeGroupsWithoutStacks []string
 
-	for _, node := range allNodeGroups.Nodegroups {
+	for _, node := range allNodeGroups {
 		if !nodeGroupsWithStacksSet.Has(*node) {
 			nodeGroupsWithoutStacks = append(nodeGroupsWithoutStacks, *node)
 		}
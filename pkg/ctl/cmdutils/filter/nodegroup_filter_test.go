@@ -4,7 +4,6 @@ import (
 	"bytes"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
@@ -42,7 +41,7 @@ var _ = Describe("nodegroup filter", func() {
 			filter = NewNodeGroupFilter()
 
 			mockProvider = mockprovider.NewMockProvider()
-			mockProvider.MockEKS().On("ListNodegroups", mock.Anything).Return(&eks.ListNodegroupsOutput{Nodegroups: nil}, nil)
+			mockProvider.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Return(nil)
 		})
 
 		It("regression: should only match the ones included in the filter when non existing ngs are present in the config file", func() {
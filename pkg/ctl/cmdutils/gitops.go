@@ -65,6 +65,9 @@ func (l *GitOpsConfigLoader) Load() error {
 	if l.cmd.ClusterConfig, err = eks.LoadConfigFromFile(l.cmd.ClusterConfigFile); err != nil {
 		return err
 	}
+	if err := eks.LoadWellKnownPolicyRegistry(l.cmd.ClusterConfig, l.cmd.ClusterConfigFile); err != nil {
+		return err
+	}
 
 	meta := l.cmd.ClusterConfig.Metadata
 	if meta == nil {
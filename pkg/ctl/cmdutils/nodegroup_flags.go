@@ -34,6 +34,8 @@ func AddCommonCreateNodeGroupFlags(fs *pflag.FlagSet, cmd *Cmd, ng *api.NodeGrou
 	fs.StringVar(ng.VolumeType, "node-volume-type", *ng.VolumeType, fmt.Sprintf("node volume type (valid options: %s)", strings.Join(api.SupportedNodeVolumeTypes(), ", ")))
 
 	fs.IntVar(&ng.MaxPodsPerNode, "max-pods-per-node", 0, "maximum number of pods per node (set automatically if unspecified)")
+	fs.StringVar(&ng.MaxPodsCalculation, "max-pods-calculation", "", fmt.Sprintf("compute the maximum number of pods per node from the instance type, one of %s, %s, %s",
+		api.MaxPodsCalculationAuto, api.MaxPodsCalculationENI, api.MaxPodsCalculationPrefixDelegation))
 
 	ng.SSH.Allow = fs.Bool("ssh-access", *ng.SSH.Allow, "control SSH access for nodes. Uses ~/.ssh/id_rsa.pub as default key path if enabled")
 	ng.SSH.PublicKeyPath = fs.String("ssh-public-key", "", "SSH public key to use for nodes (import from local path, or use existing EC2 key pair)")
@@ -73,6 +75,12 @@ func AddInstanceSelectorOptions(flagSetGroup *NamedFlagSetGroup, ng *api.NodeGro
 		fs.StringVar(&ng.InstanceSelector.Memory, "instance-selector-memory", "", "4 or 4GiB")
 		fs.StringVar(&ng.InstanceSelector.CPUArchitecture, "instance-selector-cpu-architecture", "", "x86_64, or arm64")
 		ng.InstanceSelector.GPUs = fs.Int("instance-selector-gpus", 0, "an integer value")
+		fs.StringVar(&ng.InstanceSelector.GPUType, "instance-selector-gpu-type", "", "GPU model or manufacturer (T4, A10G, NVIDIA etc)")
+		fs.BoolVar(&ng.InstanceSelector.ExcludeBurstable, "instance-selector-exclude-burstable", false, "exclude burstable instance types (T family)")
+		ng.InstanceSelector.MaxPricePerVCPU = fs.Float64("instance-selector-max-price-per-vcpu", 0, "maximum on-demand price per hour and vCPU in USD (0.05 etc)")
+		fs.StringVar(&ng.InstanceSelector.AllowList, "instance-selector-allow-list", "", "regular expression of the instance types to select")
+		fs.StringVar(&ng.InstanceSelector.DenyList, "instance-selector-deny-list", "", "regular expression of the instance types to exclude")
+		fs.BoolVar(&ng.InstanceSelector.PreferReserved, "instance-selector-prefer-reserved", false, "prefer instance types covered by Reserved Instances and Savings Plans of the account")
 	})
 }
 
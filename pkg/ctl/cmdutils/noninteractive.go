@@ -0,0 +1,154 @@
+package cmdutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// Classes of the failures of commands, reported by the exit code in non-interactive mode
+const (
+	FailureClassError      = "error"
+	FailureClassValidation = "validation"
+	FailureClassAWS        = "aws"
+	FailureClassTimeout    = "timeout"
+)
+
+// exitCodes are the exit codes of the failure classes in non-interactive mode
+var exitCodes = map[string]int{
+	FailureClassError:      1,
+	FailureClassValidation: 2,
+	FailureClassAWS:        3,
+	FailureClassTimeout:    4,
+}
+
+// ValidationError is an error in the flags, arguments or config file given to a command
+type ValidationError struct {
+	err error
+}
+
+// NewValidationError returns err as a ValidationError, unless it is one already
+func NewValidationError(err error) error {
+	var validationErr *ValidationError
+	if err == nil || errors.As(err, &validationErr) {
+		return err
+	}
+	return &ValidationError{err: err}
+}
+
+func (e *ValidationError) Error() string { return e.err.Error() }
+
+func (e *ValidationError) Unwrap() error { return e.err }
+
+// FailureClass returns the class of the error a command failed with
+func FailureClass(err error) string {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return FailureClassValidation
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return FailureClassTimeout
+	}
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		if awsErr.Code() == request.WaiterResourceNotReadyErrorCode {
+			return FailureClassTimeout
+		}
+		return FailureClassAWS
+	}
+	// waits implemented by eksctl fail with errors like "timed out waiting for control plane"
+	if strings.Contains(strings.ToLower(err.Error()), "timed out") {
+		return FailureClassTimeout
+	}
+	return FailureClassError
+}
+
+// ExitCode returns the exit code of a command that failed with err in non-interactive mode
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	return exitCodes[FailureClass(err)]
+}
+
+// Summary is printed once a command is done in non-interactive mode
+type Summary struct {
+	Command         string  `json:"command"`
+	Status          string  `json:"status"`
+	ExitCode        int     `json:"exitCode"`
+	FailureClass    string  `json:"failureClass,omitempty"`
+	Error           string  `json:"error,omitempty"`
+	Duration        string  `json:"duration"`
+	DurationSeconds float64 `json:"durationSeconds"`
+}
+
+// NewSummary returns the summary of a command that started at startTime and failed with err, if not nil
+func NewSummary(command string, startTime time.Time, err error) Summary {
+	duration := time.Since(startTime).Round(time.Second)
+	summary := Summary{
+		Command:         command,
+		Status:          "succeeded",
+		Duration:        duration.String(),
+		DurationSeconds: duration.Seconds(),
+	}
+	if err != nil {
+		summary.Status = "failed"
+		summary.ExitCode = ExitCode(err)
+		summary.FailureClass = FailureClass(err)
+		summary.Error = err.Error()
+	}
+	return summary
+}
+
+// changeSetApprover approves the change sets of the stack updates of the commands, set on their providers by NewCtl;
+// manager.DefaultChangeSetApprover is used if it is nil
+var changeSetApprover manager.ChangeSetApprover
+
+// SetNonInteractive makes commands fail instead of prompting for input
+func SetNonInteractive() {
+	eks.AssumeRoleTokenProvider = func() (string, error) {
+		return "", NewValidationError(errors.New("an MFA token is required to assume the role of the AWS profile, but prompting for it is disabled by --non-interactive"))
+	}
+	if autoApproved {
+		return
+	}
+	changeSetApprover = func(stackName string, _ *manager.ChangeSet) (bool, error) {
+		return false, NewValidationError(fmt.Errorf("changes to stack %q must be approved, but prompting for it is disabled by --non-interactive; use --auto-approve", stackName))
+	}
+}
+
+// autoApproved is set by SetAutoApprove
+var autoApproved bool
+
+// SetAutoApprove makes stack updates execute their change sets without prompting for confirmation
+func SetAutoApprove() {
+	autoApproved = true
+	changeSetApprover = manager.AutoApproveChangeSets
+}
+
+// SetUnattended makes the commands run by a long-running process, e.g. eksctl serve, fail instead of prompting for
+// input on the stdin of the process, which nobody answers; change sets are still executed with --auto-approve
+func SetUnattended(process string) {
+	eks.AssumeRoleTokenProvider = func() (string, error) {
+		return "", NewValidationError(fmt.Errorf("an MFA token is required to assume the role of the AWS profile, but %s cannot prompt for it", process))
+	}
+	if autoApproved {
+		return
+	}
+	changeSetApprover = func(stackName string, _ *manager.ChangeSet) (bool, error) {
+		return false, NewValidationError(fmt.Errorf("changes to stack %q must be approved, but %s cannot prompt for it; run it with --auto-approve", stackName, process))
+	}
+}
+
+// SetAllowReplacement lets stack updates replace or remove the VPC, the subnets and the control plane of clusters
+func SetAllowReplacement() {
+	manager.AllowReplacement = true
+}
@@ -0,0 +1,125 @@
+package cmdutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("non-interactive mode", func() {
+	DescribeTable("classifies failures",
+		func(err error, expectedClass string, expectedExitCode int) {
+			Expect(FailureClass(err)).To(Equal(expectedClass))
+			Expect(ExitCode(err)).To(Equal(expectedExitCode))
+		},
+		Entry("validation errors", ErrMustBeSet("--cluster"), FailureClassValidation, 2),
+		Entry("wrapped validation errors", fmt.Errorf("loading config: %w", NewValidationError(errors.New("invalid"))), FailureClassValidation, 2),
+		Entry("AWS errors", fmt.Errorf("describing cluster: %w", awserr.New("AccessDeniedException", "denied", nil)), FailureClassAWS, 3),
+		Entry("AWS waiter timeouts", awserr.New(request.WaiterResourceNotReadyErrorCode, "exceeded wait attempts", nil), FailureClassTimeout, 4),
+		Entry("context deadlines", fmt.Errorf("waiting for nodes: %w", context.DeadlineExceeded), FailureClassTimeout, 4),
+		Entry("timeouts of eksctl waits", errors.New("timed out waiting for at least 2 nodes to join the cluster"), FailureClassTimeout, 4),
+		Entry("other errors", errors.New("failed to create cluster"), FailureClassError, 1),
+	)
+
+	It("returns exit code 0 when the command succeeded", func() {
+		Expect(ExitCode(nil)).To(Equal(0))
+	})
+
+	It("does not wrap validation errors twice", func() {
+		err := NewValidationError(errors.New("invalid"))
+		Expect(NewValidationError(err)).To(BeIdenticalTo(err))
+		Expect(NewValidationError(nil)).NotTo(HaveOccurred())
+	})
+
+	It("summarises successful commands", func() {
+		summary := NewSummary("eksctl get cluster", time.Now().Add(-time.Minute), nil)
+		Expect(summary.Command).To(Equal("eksctl get cluster"))
+		Expect(summary.Status).To(Equal("succeeded"))
+		Expect(summary.ExitCode).To(Equal(0))
+		Expect(summary.FailureClass).To(BeEmpty())
+		Expect(summary.Error).To(BeEmpty())
+		Expect(summary.Duration).To(Equal("1m0s"))
+		Expect(summary.DurationSeconds).To(Equal(60.0))
+	})
+
+	It("summarises failed commands", func() {
+		summary := NewSummary("eksctl create cluster", time.Now(), errors.New("timed out waiting for control plane"))
+		Expect(summary.Status).To(Equal("failed"))
+		Expect(summary.ExitCode).To(Equal(4))
+		Expect(summary.FailureClass).To(Equal(FailureClassTimeout))
+		Expect(summary.Error).To(Equal("timed out waiting for control plane"))
+	})
+
+	It("fails instead of prompting for MFA tokens", func() {
+		defaultTokenProvider := eks.AssumeRoleTokenProvider
+		defer func() {
+			eks.AssumeRoleTokenProvider = defaultTokenProvider
+			changeSetApprover = nil
+		}()
+
+		SetNonInteractive()
+		_, err := eks.AssumeRoleTokenProvider()
+		Expect(err).To(MatchError(ContainSubstring("prompting for it is disabled by --non-interactive")))
+		Expect(FailureClass(err)).To(Equal(FailureClassValidation))
+	})
+
+	It("fails instead of prompting for the approval of change sets, unless they are auto-approved", func() {
+		defer func() {
+			changeSetApprover = nil
+			autoApproved = false
+		}()
+
+		SetNonInteractive()
+		approved, err := changeSetApprover("eksctl-cluster-cluster", &manager.ChangeSet{})
+		Expect(approved).To(BeFalse())
+		Expect(err).To(MatchError(ContainSubstring("use --auto-approve")))
+		Expect(FailureClass(err)).To(Equal(FailureClassValidation))
+
+		SetAutoApprove()
+		approved, err = changeSetApprover("eksctl-cluster-cluster", &manager.ChangeSet{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(approved).To(BeTrue())
+	})
+
+	It("fails instead of prompting on the stdin of long-running processes, unless change sets are auto-approved", func() {
+		defaultTokenProvider := eks.AssumeRoleTokenProvider
+		defer func() {
+			eks.AssumeRoleTokenProvider = defaultTokenProvider
+			changeSetApprover = nil
+			autoApproved = false
+		}()
+
+		SetUnattended("eksctl serve")
+		_, err := eks.AssumeRoleTokenProvider()
+		Expect(err).To(MatchError(ContainSubstring("eksctl serve cannot prompt for it")))
+		approved, err := changeSetApprover("eksctl-cluster-cluster", &manager.ChangeSet{})
+		Expect(approved).To(BeFalse())
+		Expect(err).To(MatchError(`changes to stack "eksctl-cluster-cluster" must be approved, but eksctl serve cannot prompt for it; run it with --auto-approve`))
+
+		SetAutoApprove()
+		SetUnattended("eksctl serve")
+		approved, err = changeSetApprover("eksctl-cluster-cluster", &manager.ChangeSet{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(approved).To(BeTrue())
+	})
+
+	It("approves the changes of commands run with --approve", func() {
+		cmd := &Cmd{CobraCommand: &cobra.Command{}, Plan: true}
+		AddApproveFlag(cmd.CobraCommand.Flags(), cmd)
+		Expect(cmd.CobraCommand.ParseFlags([]string{"--approve"})).To(Succeed())
+		cmd.CobraCommand.PreRun(cmd.CobraCommand, nil)
+		Expect(cmd.Plan).To(BeFalse())
+		Expect(cmd.Approved).To(BeTrue())
+	})
+})
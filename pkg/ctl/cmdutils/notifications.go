@@ -0,0 +1,35 @@
+package cmdutils
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// NotificationsOptions holds the flags overriding the notifications of the cluster config
+type NotificationsOptions struct {
+	EventBusName string
+	WebhookURL   string
+}
+
+// AddNotificationsFlags adds common `--event-bus` and `--notify-webhook` flags
+func AddNotificationsFlags(fs *pflag.FlagSet, options *NotificationsOptions) {
+	fs.StringVar(&options.EventBusName, "event-bus", "", "name or ARN of an EventBridge event bus to send events about the operation to (overrides notifications.eventBusName)")
+	fs.StringVar(&options.WebhookURL, "notify-webhook", "", "URL notified when the operation starts, succeeds and fails, e.g. a Slack incoming webhook (overrides notifications.webhookURL)")
+}
+
+// SetNotifications sets the notifications of the cluster to the ones given as flags, if any, and validates them
+func SetNotifications(cfg *api.ClusterConfig, options NotificationsOptions) error {
+	if options.EventBusName != "" || options.WebhookURL != "" {
+		if cfg.Notifications == nil {
+			cfg.Notifications = &api.Notifications{}
+		}
+		if options.EventBusName != "" {
+			cfg.Notifications.EventBusName = options.EventBusName
+		}
+		if options.WebhookURL != "" {
+			cfg.Notifications.WebhookURL = options.WebhookURL
+		}
+	}
+	return api.ValidateNotifications(cfg.Notifications)
+}
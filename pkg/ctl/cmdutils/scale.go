@@ -9,6 +9,7 @@ import (
 // NewScaleNodeGroupLoader will load config or use flags for 'eksctl scale nodegroup'
 func NewScaleNodeGroupLoader(cmd *Cmd, ng *api.NodeGroupBase) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
+	l.runUserValidators = true
 
 	l.flagsIncompatibleWithConfigFile.Insert(
 		"nodes",
@@ -57,6 +58,7 @@ func NewScaleNodeGroupLoader(cmd *Cmd, ng *api.NodeGroupBase) ClusterConfigLoade
 // NewScaleAllNodeGroupLoader will load config or use flags for 'eksctl scale nodegroup'
 func NewScaleAllNodeGroupLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
+	l.runUserValidators = true
 
 	l.flagsIncompatibleWithConfigFile.Insert(
 		"nodes",
@@ -79,6 +81,55 @@ func NewScaleAllNodeGroupLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewPauseNodeGroupLoader will load config or use flags for 'eksctl scale nodegroup --to-zero' and
+// 'eksctl scale nodegroup --restore', where the number of nodes is not taken from flags or config
+func NewPauseNodeGroupLoader(cmd *Cmd, ng *api.NodeGroupBase) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+	l.runUserValidators = true
+
+	l.flagsIncompatibleWithConfigFile.Delete("name")
+
+	validate := func() error {
+		for _, flag := range []string{"nodes", "nodes-min", "nodes-max"} {
+			if f := cmd.CobraCommand.Flag(flag); f != nil && f.Changed {
+				return fmt.Errorf("cannot use --%s together with --to-zero or --restore", flag)
+			}
+		}
+
+		if err := validateNameArgument(cmd, ng); err != nil {
+			return err
+		}
+		if ng.Name == "" {
+			return ErrMustBeSet("--name")
+		}
+		return nil
+	}
+
+	l.validateWithConfigFile = func() error {
+		if err := validate(); err != nil {
+			return err
+		}
+		if _, err := l.ClusterConfig.FindNodegroup(ng.Name); err != nil {
+			return err
+		}
+		l.Plan = false
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet(ClusterNameFlag(cmd))
+		}
+		if err := validate(); err != nil {
+			return err
+		}
+		l.Plan = false
+		return nil
+	}
+
+	return l
+}
+
 func validateNameArgument(cmd *Cmd, ng *api.NodeGroupBase) error {
 	if ng.Name != "" && cmd.NameArg != "" {
 		return ErrFlagAndArg("--name", ng.Name, cmd.NameArg)
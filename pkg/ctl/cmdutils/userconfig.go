@@ -0,0 +1,115 @@
+package cmdutils
+
+import (
+	"os"
+	"sync"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/validators"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/userconfig"
+)
+
+var (
+	loadUserConfigOnce sync.Once
+	userConfig         *userconfig.Config
+	// userConfigErr is the error loading the user config file when it exists but cannot be read or parsed
+	userConfigErr error
+)
+
+// loadUserConfig returns the user config file, an empty one when it does not exist, and the error loading it when
+// it exists but cannot be loaded
+func loadUserConfig() (*userconfig.Config, error) {
+	loadUserConfigOnce.Do(func() {
+		userConfig = &userconfig.Config{}
+		path, err := userconfig.Path()
+		if err != nil {
+			logger.Warning("ignoring user config file: %v", err)
+			return
+		}
+		config, err := userconfig.Load(path)
+		if err != nil {
+			logger.Warning("ignoring the defaults of the user config file: %v", err)
+			userConfigErr = err
+			return
+		}
+		userConfig = config
+	})
+	return userConfig, userConfigErr
+}
+
+// UserContext returns the current context of the user config file, holding the defaults of the flags; the
+// defaults are empty when the user config file cannot be loaded, the commands running validators then fail
+func UserContext() *userconfig.Context {
+	config, _ := loadUserConfig()
+	return config.Current()
+}
+
+// UserValidators returns the validators of the user config file, or an error when it exists but cannot be loaded,
+// so that commands fail rather than skip the validators of the organization
+func UserValidators() ([]*userconfig.Validator, error) {
+	config, err := loadUserConfig()
+	if err != nil {
+		return nil, err
+	}
+	return config.Validators, nil
+}
+
+// RunUserValidators runs the validators of the user config file against cfg, for commands which do not load
+// cfg with a ClusterConfigLoader running them
+func RunUserValidators(cfg *api.ClusterConfig) error {
+	userValidators, err := UserValidators()
+	if err != nil {
+		return NewValidationError(err)
+	}
+	return NewValidationError(validators.New(userValidators).Validate(cfg))
+}
+
+// defaultRegion returns the default of --region, the region of the environment variables of the AWS SDK
+// takes precedence over the region of the current context
+func defaultRegion() string {
+	if os.Getenv("AWS_REGION") != "" || os.Getenv("AWS_DEFAULT_REGION") != "" {
+		return ""
+	}
+	return UserContext().Region
+}
+
+// defaultProfile returns the default of --profile, AWS_PROFILE takes precedence over the profile of
+// the current context
+func defaultProfile() string {
+	if os.Getenv("AWS_PROFILE") != "" {
+		return ""
+	}
+	return UserContext().Profile
+}
+
+// defaultOutput returns the default of --output of get commands
+func defaultOutput() string {
+	if output := UserContext().Output; output != "" {
+		return output
+	}
+	return "table"
+}
+
+// DefaultTags returns the default of --tags when creating clusters and nodegroups
+func DefaultTags() map[string]string {
+	tags := map[string]string{}
+	for k, v := range UserContext().Tags {
+		tags[k] = v
+	}
+	return tags
+}
+
+// mergeDefaultTags returns the tags of a config file merged into the tags of the current context, the tags of the
+// config file take precedence
+func mergeDefaultTags(tags map[string]string) map[string]string {
+	merged := DefaultTags()
+	for k, v := range tags {
+		merged[k] = v
+	}
+	if len(merged) == 0 {
+		return tags
+	}
+	return merged
+}
@@ -0,0 +1,104 @@
+package cmdutils
+
+import (
+	"errors"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/userconfig"
+)
+
+var _ = Describe("defaults of the user config file", func() {
+	var envNames = []string{"AWS_REGION", "AWS_DEFAULT_REGION", "AWS_PROFILE"}
+	var savedEnv map[string]string
+
+	BeforeEach(func() {
+		savedEnv = map[string]string{}
+		for _, name := range envNames {
+			if value, ok := os.LookupEnv(name); ok {
+				savedEnv[name] = value
+			}
+			os.Unsetenv(name)
+		}
+		// mark the user config file as loaded
+		loadUserConfigOnce.Do(func() {})
+		userConfig = &userconfig.Config{
+			CurrentContext: "prod",
+			Contexts: map[string]*userconfig.Context{
+				"prod": {
+					Region:  "eu-west-1",
+					Profile: "prod",
+					Output:  "yaml",
+					Tags:    map[string]string{"team": "platform"},
+				},
+			},
+			Validators: []*userconfig.Validator{{Name: "org-policy", Rego: "policy.rego"}},
+		}
+	})
+
+	AfterEach(func() {
+		for _, name := range envNames {
+			os.Unsetenv(name)
+		}
+		for name, value := range savedEnv {
+			os.Setenv(name, value)
+		}
+		userConfig = &userconfig.Config{}
+		userConfigErr = nil
+	})
+
+	It("uses the current context", func() {
+		Expect(defaultRegion()).To(Equal("eu-west-1"))
+		Expect(defaultProfile()).To(Equal("prod"))
+		Expect(defaultOutput()).To(Equal("yaml"))
+		Expect(DefaultTags()).To(Equal(map[string]string{"team": "platform"}))
+	})
+
+	It("gives precedence to the environment variables of the AWS SDK", func() {
+		os.Setenv("AWS_DEFAULT_REGION", "us-east-2")
+		os.Setenv("AWS_PROFILE", "dev")
+		Expect(defaultRegion()).To(BeEmpty())
+		Expect(defaultProfile()).To(BeEmpty())
+	})
+
+	It("merges the tags of config files into the tags of the current context", func() {
+		Expect(mergeDefaultTags(map[string]string{"team": "data", "app": "etl"})).To(Equal(map[string]string{"team": "data", "app": "etl"}))
+		Expect(mergeDefaultTags(map[string]string{"app": "etl"})).To(Equal(map[string]string{"team": "platform", "app": "etl"}))
+		Expect(mergeDefaultTags(nil)).To(Equal(map[string]string{"team": "platform"}))
+
+		userConfig.Contexts["prod"].Tags = nil
+		Expect(mergeDefaultTags(nil)).To(BeNil())
+	})
+
+	It("defaults to the table output", func() {
+		userConfig.Contexts["prod"].Output = ""
+		Expect(defaultOutput()).To(Equal("table"))
+	})
+
+	It("returns the validators of all contexts", func() {
+		userConfig.CurrentContext = ""
+		userValidators, err := UserValidators()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(userValidators).To(Equal([]*userconfig.Validator{{Name: "org-policy", Rego: "policy.rego"}}))
+	})
+
+	It("fails the validators instead of skipping them when the user config file cannot be loaded", func() {
+		userConfig = &userconfig.Config{}
+		userConfigErr = errors.New(`parsing user config file "config": invalid`)
+
+		Expect(UserContext()).To(Equal(&userconfig.Context{}))
+		_, err := UserValidators()
+		Expect(err).To(MatchError(ContainSubstring("parsing user config file")))
+		err = RunUserValidators(api.NewClusterConfig())
+		Expect(err).To(MatchError(ContainSubstring("parsing user config file")))
+		Expect(FailureClass(err)).To(Equal(FailureClassValidation))
+	})
+
+	It("returns a copy of the tags", func() {
+		DefaultTags()["team"] = "other"
+		Expect(UserContext().Tags).To(Equal(map[string]string{"team": "platform"}))
+	})
+})
@@ -0,0 +1,103 @@
+package completion
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+type completeFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// RegisterDynamicCompletions completes regions, and the names of the clusters, nodegroups and addons listed by
+// inventory, in the flags and arguments of the commands of rootCmd
+func RegisterDynamicCompletions(rootCmd *cobra.Command, inventory *Inventory) {
+	regions := complete(func(*cobra.Command) []string {
+		return api.SupportedRegions()
+	})
+	clusters := complete(func(cmd *cobra.Command) []string {
+		return inventory.Clusters(flagValue(cmd, "profile"), flagValue(cmd, "region"))
+	})
+	nodeGroups := complete(func(cmd *cobra.Command) []string {
+		return inventory.NodeGroups(flagValue(cmd, "profile"), flagValue(cmd, "region"), flagValue(cmd, "cluster"))
+	})
+	addons := complete(func(cmd *cobra.Command) []string {
+		return inventory.Addons(flagValue(cmd, "profile"), flagValue(cmd, "region"), flagValue(cmd, "cluster"))
+	})
+
+	forEachCommand(rootCmd, func(cmd *cobra.Command) {
+		registerFlag(cmd, "region", regions)
+		registerFlag(cmd, "cluster", clusters)
+		registerFlag(cmd, "nodegroup", nodeGroups)
+
+		// the names of new resources are not completed
+		if cmd.Parent() == nil || cmd.Parent().Name() == "create" {
+			return
+		}
+		var names completeFunc
+		switch cmd.Name() {
+		case "cluster":
+			names = clusters
+		case "nodegroup":
+			names = nodeGroups
+		case "addon":
+			names = addons
+		default:
+			return
+		}
+		registerFlag(cmd, "name", names)
+		if cmd.ValidArgsFunction == nil {
+			cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+				if len(args) > 0 {
+					return nil, cobra.ShellCompDirectiveNoFileComp
+				}
+				return names(cmd, args, toComplete)
+			}
+		}
+	})
+}
+
+// complete returns a completion function of the names returned by list that start with the text to complete
+func complete(list func(cmd *cobra.Command) []string) completeFunc {
+	return func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		// the output of completion functions is read by the shell, and listing resources must never prompt for input
+		logger.Writer = ioutil.Discard
+		cmdutils.SetNonInteractive()
+
+		var names []string
+		for _, name := range list(cmd) {
+			if strings.HasPrefix(name, toComplete) {
+				names = append(names, name)
+			}
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func registerFlag(cmd *cobra.Command, name string, f completeFunc) {
+	if cmd.Flags().Lookup(name) == nil {
+		return
+	}
+	if err := cmd.RegisterFlagCompletionFunc(name, f); err != nil {
+		logger.Debug("not completing --%s of %q: %v", name, cmd.CommandPath(), err)
+	}
+}
+
+func flagValue(cmd *cobra.Command, name string) string {
+	flag := cmd.Flags().Lookup(name)
+	if flag == nil {
+		return ""
+	}
+	return flag.Value.String()
+}
+
+func forEachCommand(cmd *cobra.Command, f func(*cobra.Command)) {
+	f(cmd)
+	for _, c := range cmd.Commands() {
+		forEachCommand(c, f)
+	}
+}
@@ -0,0 +1,151 @@
+package completion
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/awscache"
+)
+
+type fakeLister struct {
+	calls int
+}
+
+func (l *fakeLister) ListClusters() ([]string, error) {
+	l.calls++
+	return []string{"prod", "dev"}, nil
+}
+
+func (l *fakeLister) ListNodeGroups(clusterName string) ([]string, error) {
+	l.calls++
+	if clusterName != "prod" {
+		return nil, errors.New("cluster not found")
+	}
+	return []string{"ng-2", "ng-1", "ng-1"}, nil
+}
+
+func (l *fakeLister) ListAddons(clusterName string) ([]string, error) {
+	l.calls++
+	return []string{"vpc-cni", "coredns"}, nil
+}
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: staging.us-west-2.eksctl.io
+  cluster:
+    server: https://staging.example.com
+- name: other.eu-west-1.eksctl.io
+  cluster:
+    server: https://other.example.com
+- name: minikube
+  cluster:
+    server: https://127.0.0.1
+`
+
+var _ = Describe("dynamic completion", func() {
+	var (
+		lister         *fakeLister
+		rootCmd        *cobra.Command
+		dir            string
+		originalWriter = logger.Writer
+	)
+
+	newCmd := func(parent *cobra.Command, name string, flags ...string) {
+		cmd := &cobra.Command{Use: name, Run: func(*cobra.Command, []string) {}}
+		for _, flag := range flags {
+			cmd.Flags().String(flag, "", "")
+		}
+		parent.AddCommand(cmd)
+	}
+
+	// complete returns the completions, and the directive to the shell
+	completeWithDirective := func(args ...string) ([]string, string) {
+		out := &bytes.Buffer{}
+		rootCmd.SetOut(out)
+		rootCmd.SetErr(&bytes.Buffer{})
+		rootCmd.SetArgs(append([]string{cobra.ShellCompRequestCmd}, args...))
+		Expect(rootCmd.Execute()).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		// the last line is the directive
+		return lines[:len(lines)-1], lines[len(lines)-1]
+	}
+
+	complete := func(args ...string) []string {
+		names, directive := completeWithDirective(args...)
+		Expect(directive).To(Equal(":4"), "completions should not fall back to files")
+		return names
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "completion")
+		Expect(err).NotTo(HaveOccurred())
+		kubeconfigPath := filepath.Join(dir, "config")
+		Expect(os.WriteFile(kubeconfigPath, []byte(testKubeconfig), 0600)).To(Succeed())
+
+		lister = &fakeLister{}
+		inventory := &Inventory{
+			cache: awscache.New(inventoryTTL, ""),
+			newLister: func(profile, region string) (Lister, error) {
+				return lister, nil
+			},
+			kubeconfigPath: kubeconfigPath,
+		}
+
+		rootCmd = &cobra.Command{Use: "eksctl"}
+		get := &cobra.Command{Use: "get"}
+		create := &cobra.Command{Use: "create"}
+		rootCmd.AddCommand(get, create)
+		newCmd(get, "cluster", "name", "region", "profile")
+		newCmd(get, "nodegroup", "name", "cluster", "region", "profile")
+		newCmd(get, "addon", "name", "cluster", "region", "profile")
+		newCmd(get, "labels", "nodegroup", "cluster", "region", "profile")
+		newCmd(create, "nodegroup", "name", "cluster", "region", "profile")
+		RegisterDynamicCompletions(rootCmd, inventory)
+	})
+
+	AfterEach(func() {
+		logger.Writer = originalWriter
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("completes regions", func() {
+		Expect(complete("get", "cluster", "--region", "us-west")).To(ConsistOf("us-west-1", "us-west-2"))
+	})
+
+	It("completes clusters, including those of the kubeconfig file of the region", func() {
+		Expect(complete("get", "nodegroup", "--cluster", "")).To(Equal([]string{"dev", "other", "prod", "staging"}))
+		Expect(complete("get", "nodegroup", "--region", "us-west-2", "--cluster", "")).To(Equal([]string{"dev", "prod", "staging"}))
+		Expect(complete("get", "cluster", "--name", "p")).To(Equal([]string{"prod"}))
+		Expect(complete("get", "cluster", "d")).To(Equal([]string{"dev"}))
+	})
+
+	It("completes the nodegroups and addons of the cluster", func() {
+		Expect(complete("get", "nodegroup", "--cluster", "prod", "--name", "")).To(Equal([]string{"ng-1", "ng-2"}))
+		Expect(complete("get", "nodegroup", "--cluster", "prod", "")).To(Equal([]string{"ng-1", "ng-2"}))
+		Expect(complete("get", "labels", "--cluster", "prod", "--nodegroup", "")).To(Equal([]string{"ng-1", "ng-2"}))
+		Expect(complete("get", "addon", "--cluster", "prod", "--name", "")).To(Equal([]string{"coredns", "vpc-cni"}))
+	})
+
+	It("does not complete nodegroups without a cluster, or the names of new resources", func() {
+		Expect(complete("get", "nodegroup", "--name", "")).To(BeEmpty())
+		Expect(complete("get", "nodegroup", "--cluster", "dev", "--name", "")).To(BeEmpty())
+		names, _ := completeWithDirective("create", "nodegroup", "--cluster", "prod", "--name", "")
+		Expect(names).To(BeEmpty())
+	})
+
+	It("caches the listed resources", func() {
+		complete("get", "nodegroup", "--cluster", "prod", "--name", "")
+		complete("get", "nodegroup", "--cluster", "prod", "--name", "ng")
+		Expect(lister.calls).To(Equal(1))
+	})
+})
@@ -0,0 +1,213 @@
+package completion
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"k8s.io/client-go/tools/clientcmd"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awscache"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+)
+
+// inventoryTTL is how long the names of the resources of an account are cached for completion
+const inventoryTTL = 10 * time.Minute
+
+// Lister lists the names of the resources of an account in a region
+type Lister interface {
+	ListClusters() ([]string, error)
+	ListNodeGroups(clusterName string) ([]string, error)
+	ListAddons(clusterName string) ([]string, error)
+}
+
+// Inventory lists the names of resources to complete, caching them on disk as the shell runs eksctl every time
+// completions are requested
+type Inventory struct {
+	cache          *awscache.Cache
+	newLister      func(profile, region string) (Lister, error)
+	kubeconfigPath string
+}
+
+// NewInventory creates an Inventory listing resources with the AWS APIs, and caching them in
+// ~/.eksctl/cache/completion
+func NewInventory() *Inventory {
+	var dir string
+	if home, err := os.UserHomeDir(); err == nil {
+		dir = filepath.Join(home, ".eksctl", "cache", "completion")
+	}
+	return &Inventory{
+		cache:          awscache.New(inventoryTTL, dir),
+		newLister:      newAWSLister,
+		kubeconfigPath: kubeconfig.DefaultPath(),
+	}
+}
+
+// Clusters returns the names of the clusters of the region, and of the clusters created by eksctl in the region
+// whose contexts are in the kubeconfig file, so that they are completed even when listing clusters fails
+func (i *Inventory) Clusters(profile, region string) []string {
+	names := i.list("clusters", profile, region, "", func(l Lister) ([]string, error) {
+		return l.ListClusters()
+	})
+	return sortedUnique(append(names, i.kubeconfigClusters(region)...))
+}
+
+// NodeGroups returns the names of the nodegroups of a cluster
+func (i *Inventory) NodeGroups(profile, region, clusterName string) []string {
+	if clusterName == "" {
+		return nil
+	}
+	return sortedUnique(i.list("nodegroups", profile, region, clusterName, func(l Lister) ([]string, error) {
+		return l.ListNodeGroups(clusterName)
+	}))
+}
+
+// Addons returns the names of the addons of a cluster
+func (i *Inventory) Addons(profile, region, clusterName string) []string {
+	if clusterName == "" {
+		return nil
+	}
+	return sortedUnique(i.list("addons", profile, region, clusterName, func(l Lister) ([]string, error) {
+		return l.ListAddons(clusterName)
+	}))
+}
+
+func (i *Inventory) list(kind, profile, region, clusterName string, list func(Lister) ([]string, error)) []string {
+	key := strings.Join([]string{kind, profile, region, clusterName}, "/")
+	var names []string
+	if i.cache.Get(key, &names) {
+		return names
+	}
+	lister, err := i.newLister(profile, region)
+	if err != nil {
+		logger.Debug("not completing %s: %v", kind, err)
+		return nil
+	}
+	names, err = list(lister)
+	if err != nil {
+		logger.Debug("not completing %s: %v", kind, err)
+		return nil
+	}
+	i.cache.Set(key, names)
+	return names
+}
+
+// kubeconfigClusters returns the names of the clusters of the kubeconfig file named by eksctl, which are of the
+// form <name>.<region>.eksctl.io
+func (i *Inventory) kubeconfigClusters(region string) []string {
+	config, err := clientcmd.LoadFromFile(i.kubeconfigPath)
+	if err != nil {
+		logger.Debug("not completing clusters of kubeconfig: %v", err)
+		return nil
+	}
+	var names []string
+	for name := range config.Clusters {
+		parts := strings.Split(strings.TrimSuffix(name, ".eksctl.io"), ".")
+		if len(parts) != 2 || !strings.HasSuffix(name, ".eksctl.io") {
+			continue
+		}
+		if region == "" || parts[1] == region {
+			names = append(names, parts[0])
+		}
+	}
+	return names
+}
+
+func sortedUnique(names []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+// awsLister lists resources with the AWS APIs
+type awsLister struct {
+	ctl *eks.ClusterProvider
+}
+
+func newAWSLister(profile, region string) (Lister, error) {
+	ctl, err := eks.New(&api.ProviderConfig{
+		Profile:     profile,
+		Region:      region,
+		WaitTimeout: api.DefaultWaitTimeout,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &awsLister{ctl: ctl}, nil
+}
+
+func (l *awsLister) ListClusters() ([]string, error) {
+	var names []string
+	input := &awseks.ListClustersInput{}
+	for {
+		output, err := l.ctl.Provider.EKS().ListClusters(input)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, aws.StringValueSlice(output.Clusters)...)
+		if output.NextToken == nil {
+			return names, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// ListNodeGroups lists managed nodegroups, and the nodegroups of the stacks of the cluster, which include
+// unmanaged nodegroups
+func (l *awsLister) ListNodeGroups(clusterName string) ([]string, error) {
+	var names []string
+	input := &awseks.ListNodegroupsInput{ClusterName: aws.String(clusterName)}
+	for {
+		output, err := l.ctl.Provider.EKS().ListNodegroups(input)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, aws.StringValueSlice(output.Nodegroups)...)
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	cfg := api.NewClusterConfig()
+	cfg.Metadata.Name = clusterName
+	cfg.Metadata.Region = l.ctl.Provider.Region()
+	stacks, err := l.ctl.NewStackManager(cfg).ListNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stacks {
+		names = append(names, s.NodeGroupName)
+	}
+	return names, nil
+}
+
+func (l *awsLister) ListAddons(clusterName string) ([]string, error) {
+	var names []string
+	input := &awseks.ListAddonsInput{ClusterName: aws.String(clusterName)}
+	for {
+		output, err := l.ctl.Provider.EKS().ListAddons(input)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, aws.StringValueSlice(output.Addons)...)
+		if output.NextToken == nil {
+			return names, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
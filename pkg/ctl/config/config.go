@@ -0,0 +1,17 @@
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// Command will create the `config` commands
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	verbCmd := cmdutils.NewVerbCmd("config", "Manage the user config file of eksctl", "")
+
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, useContextCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getContextsCmd)
+
+	return verbCmd
+}
@@ -0,0 +1,11 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestCtlConfig(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
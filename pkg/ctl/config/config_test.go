@@ -0,0 +1,65 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/userconfig"
+)
+
+var _ = Describe("config", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "config")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "config")
+		Expect(os.WriteFile(path, []byte(`
+contexts:
+  dev:
+    region: us-east-2
+  prod:
+    region: eu-west-1
+`), 0600)).To(Succeed())
+		os.Setenv(userconfig.EksctlConfigEnvName, path)
+	})
+
+	AfterEach(func() {
+		os.Unsetenv(userconfig.EksctlConfigEnvName)
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	execute := func(args ...string) error {
+		cmd := Command(cmdutils.NewGrouping())
+		cmd.SetArgs(args)
+		return cmd.Execute()
+	}
+
+	Describe("use-context", func() {
+		It("sets the current context", func() {
+			Expect(execute("use-context", "prod")).To(Succeed())
+
+			config, err := userconfig.Load(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.CurrentContext).To(Equal("prod"))
+		})
+
+		It("fails when the context does not exist", func() {
+			err := execute("use-context", "staging")
+			Expect(err).To(MatchError(`context "staging" does not exist, valid options: [dev prod]`))
+			Expect(cmdutils.FailureClass(err)).To(Equal(cmdutils.FailureClassValidation))
+		})
+
+		It("requires the name of the context", func() {
+			Expect(execute("use-context")).To(MatchError(ContainSubstring("accepts 1 arg(s), received 0")))
+		})
+	})
+})
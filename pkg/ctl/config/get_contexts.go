@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/userconfig"
+)
+
+func getContextsCmd(cmd *cmdutils.Cmd) {
+	cmd.SetDescription("get-contexts", "List the contexts of the user config file", "")
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return doGetContexts()
+	}
+}
+
+func doGetContexts() error {
+	path, err := userconfig.Path()
+	if err != nil {
+		return err
+	}
+	config, err := userconfig.Load(path)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "CURRENT\tNAME\tREGION\tPROFILE\tOUTPUT")
+	for _, name := range config.ContextNames() {
+		current := ""
+		if name == config.CurrentContext {
+			current = "*"
+		}
+		context := config.Contexts[name]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, name, context.Region, context.Profile, context.Output)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/userconfig"
+)
+
+func useContextCmd(cmd *cmdutils.Cmd) {
+	cmd.SetDescription("use-context", "Set the current context of the user config file", "The current context holds the defaults of the --region, --profile, --tags and --output flags")
+
+	cmd.CobraCommand.Args = cobra.ExactArgs(1)
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		return doUseContext(args[0])
+	}
+}
+
+func doUseContext(name string) error {
+	path, err := userconfig.Path()
+	if err != nil {
+		return err
+	}
+	config, err := userconfig.Load(path)
+	if err != nil {
+		return err
+	}
+	if err := config.UseContext(name); err != nil {
+		return cmdutils.NewValidationError(err)
+	}
+	if err := config.Save(path); err != nil {
+		return err
+	}
+	logger.Success("switched to context %q", name)
+	return nil
+}
@@ -21,7 +21,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 		"",
 	)
 
-	var force, wait bool
+	var force, wait, ifNotExists bool
 	cmd.ClusterConfig.Addons = []*api.Addon{{}}
 	cmd.FlagSetGroup.InFlagSet("Addon", func(fs *pflag.FlagSet) {
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].Name, "name", "", "Add-on name")
@@ -29,6 +29,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].ServiceAccountRoleARN, "service-account-role-arn", "", "Add-on serviceAccountRoleARN")
 		fs.BoolVar(&force, "force", false, "Force applies the add-on to overwrite an existing add-on")
 		fs.BoolVar(&wait, "wait", false, "Wait for the addon creation to complete")
+		cmdutils.AddIfNotExistsFlag(fs, &ifNotExists, "Update the add-on instead of failing if it already exists")
 
 		fs.StringSliceVar(&cmd.ClusterConfig.Addons[0].AttachPolicyARNs, "attach-policy-arn", []string{}, "ARN of the policies to attach")
 	})
@@ -93,7 +94,12 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 			if force { //force is specified at cmdline level
 				a.Force = true
 			}
-			err := addonManager.Create(a, wait)
+			var err error
+			if ifNotExists {
+				err = addonManager.CreateOrUpdate(a, wait)
+			} else {
+				err = addonManager.Create(a, wait)
+			}
 			if err != nil {
 				return err
 			}
@@ -1,8 +1,10 @@
 package create
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"strings"
@@ -15,17 +17,31 @@ import (
 	kubeclient "k8s.io/client-go/kubernetes"
 
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/alarms"
+	"github.com/weaveworks/eksctl/pkg/actions/bootstrap"
+	"github.com/weaveworks/eksctl/pkg/actions/clusteroutputs"
+	"github.com/weaveworks/eksctl/pkg/actions/collision"
 	"github.com/weaveworks/eksctl/pkg/actions/flux"
+	"github.com/weaveworks/eksctl/pkg/actions/helmrelease"
+	"github.com/weaveworks/eksctl/pkg/actions/hooks"
+	"github.com/weaveworks/eksctl/pkg/actions/iampreflight"
 	karpenteractions "github.com/weaveworks/eksctl/pkg/actions/karpenter"
+	"github.com/weaveworks/eksctl/pkg/actions/localcluster"
+	"github.com/weaveworks/eksctl/pkg/actions/notifications"
+	"github.com/weaveworks/eksctl/pkg/actions/parameterexport"
+	"github.com/weaveworks/eksctl/pkg/actions/pullthroughcache"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/clustertemplates"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/kops"
+	"github.com/weaveworks/eksctl/pkg/logging"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/utils"
+	"github.com/weaveworks/eksctl/pkg/utils/egressip"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 	"github.com/weaveworks/eksctl/pkg/utils/kubectl"
 	"github.com/weaveworks/eksctl/pkg/utils/names"
@@ -34,6 +50,8 @@ import (
 )
 
 const (
+	restrictPublicAccessAuto = "auto"
+
 	vpcControllerInfoMessage = "you no longer need to install the VPC resource controller on Linux worker nodes to run " +
 		"Windows workloads in EKS clusters created after Oct 22, 2021. You can enable Windows IP address management on the EKS control plane via " +
 		"a ConﬁgMap setting (see https://docs.aws.amazon.com/eks/latest/userguide/windows-support.html for details). eksctl will automatically patch the ConfigMap to enable " +
@@ -70,7 +88,7 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", fmt.Sprintf("EKS cluster name (generated if unspecified, e.g. %q)", exampleClusterName))
-		cmdutils.AddStringToStringVarPFlag(fs, &cfg.Metadata.Tags, "tags", "", map[string]string{}, "Used to tag the AWS resources")
+		cmdutils.AddStringToStringVarPFlag(fs, &cfg.Metadata.Tags, "tags", "", cmdutils.DefaultTags(), "Used to tag the AWS resources")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		fs.BoolVar(cfg.IAM.WithOIDC, "with-oidc", false, "Enable the IAM OIDC provider")
 		fs.StringSliceVar(&params.AvailabilityZones, "zones", nil, "(auto-select if unspecified)")
@@ -79,7 +97,14 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		fs.BoolVarP(&params.InstallWindowsVPCController, "install-vpc-controllers", "", false, "Install VPC controller that's required for Windows workloads")
 		fs.BoolVarP(&params.Fargate, "fargate", "", false, "Create a Fargate profile scheduling pods in the default and kube-system namespaces onto Fargate")
+		fs.BoolVar(&params.FargateOnly, "fargate-only", false, "Create a cluster without nodegroups, scheduling pods in the default and kube-system namespaces, including CoreDNS, onto Fargate")
+		fs.StringVar(&params.Template, "template", "", fmt.Sprintf("Create the cluster from a built-in ClusterConfig template, use with --dry-run to review and customize it, valid options: %s", strings.Join(clustertemplates.Names(), ", ")))
 		fs.BoolVarP(&params.DryRun, "dry-run", "", false, "Dry-run mode that skips cluster creation and outputs a ClusterConfig")
+		cmdutils.AddIfNotExistsFlag(fs, &params.IfNotExists, "Create the nodegroups, addons and iamserviceaccounts missing from the cluster if it already exists, instead of failing")
+		cmdutils.AddNotificationsFlags(fs, &params.NotificationsOptions)
+		cmdutils.AddWriteOutputsFlag(fs, &params.WriteOutputs)
+		cmdutils.AddPreflightIAMFlag(fs, &params.PreflightIAM)
+		fs.StringVar(&params.Provider, "provider", localcluster.ProviderAWS, fmt.Sprintf("Create the cluster on AWS, or a local cluster for development with kind, valid options: %s, %s, %s", localcluster.ProviderAWS, localcluster.ProviderKind, localcluster.ProviderDocker))
 
 		_ = fs.MarkDeprecated("install-vpc-controllers", vpcControllerInfoMessage)
 	})
@@ -102,6 +127,9 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 		}
 		fs.StringVar(&params.KopsClusterNameForVPC, "vpc-from-kops-cluster", "", "re-use VPC from a given kops cluster")
 		fs.StringVar(cfg.VPC.NAT.Gateway, "vpc-nat-mode", api.ClusterSingleNAT, "VPC NAT mode, valid options: HighlyAvailable, Single, Disable")
+		fs.StringVar(&params.RestrictPublicAccess, "restrict-public-access", "", "restrict access to the public API server endpoint, valid options: auto (the egress IP address of the caller and vpc.publicAccessCIDRs)")
+		cmdutils.AddSharedVPCFlag(fs, &params.SharedVPC)
+		fs.BoolVar(&params.FixSecurityGroupRules, "fix-rules", false, "add the rules required within the cluster that are missing from vpc.sharedNodeSecurityGroup and vpc.securityGroup")
 	})
 
 	cmdutils.AddInstanceSelectorOptions(cmd.FlagSetGroup, ng)
@@ -114,7 +142,7 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 	})
 }
 
-func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams) error {
+func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams) (err error) {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
@@ -122,6 +150,14 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return api.ErrInvalidName(meta.Name)
 	}
 
+	local, err := localcluster.IsLocal(params.Provider)
+	if err != nil {
+		return cmdutils.NewValidationError(err)
+	}
+	if local {
+		return createLocalCluster(cfg, params)
+	}
+
 	printer := printers.NewJSONPrinter()
 
 	ctl, err := cmd.NewCtl()
@@ -139,6 +175,17 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 
 	cmdutils.LogRegionAndVersionInfo(meta)
 
+	if params.IfNotExists && !params.DryRun {
+		exists, err := ctl.ClusterExists(meta)
+		if err != nil {
+			return err
+		}
+		if exists {
+			logger.Info("cluster %q already exists, skipping its creation", meta.Name)
+			return convergeExistingCluster(cmd, ctl, ngFilter, params)
+		}
+	}
+
 	if cfg.Metadata.Version == "" || cfg.Metadata.Version == "auto" {
 		cfg.Metadata.Version = api.DefaultVersion
 	}
@@ -162,6 +209,14 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return err
 	}
 
+	if err := restrictPublicAccess(cfg, params.RestrictPublicAccess); err != nil {
+		return err
+	}
+
+	if err := cmdutils.SetNotifications(cfg, params.NotificationsOptions); err != nil {
+		return err
+	}
+
 	// if it's a private only cluster warn the user
 	if api.PrivateOnly(cfg.VPC.ClusterEndpoints) {
 		logger.Warning(api.ErrClusterEndpointPrivateOnly.Error())
@@ -192,6 +247,11 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		}
 	}
 	logFiltered := cmdutils.ApplyFilter(cfg, ngFilter)
+	for _, ng := range cfg.NodeGroups {
+		if ng.AccountRoleARN != "" {
+			return fmt.Errorf("nodegroup %q sets accountRoleARN and can only be created with 'eksctl create nodegroup' once the cluster is created; exclude it with --exclude", ng.Name)
+		}
+	}
 	kubeNodeGroups := cmdutils.ToKubeNodeGroups(cfg)
 
 	if err := eks.ValidateFeatureCompatibility(cfg, kubeNodeGroups); err != nil {
@@ -212,8 +272,8 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			return errors.New("running Windows workloads requires having both Windows and Linux (AmazonLinux2) node groups")
 		}
 		logger.Warning(vpcControllerInfoMessage)
-	} else {
-		eks.LogWindowsCompatibility(kubeNodeGroups, cfg.Metadata)
+	} else if err := eks.ValidateLinuxNodeGroupForWindows(kubeNodeGroups); err != nil {
+		return err
 	}
 
 	if err := createOrImportVPC(cmd, cfg, params, ctl); err != nil {
@@ -234,6 +294,34 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return err
 	}
 
+	if err := nodeGroupService.ValidateVolumeEncryption(nodePools, ctl.Provider); err != nil {
+		return err
+	}
+
+	if err := addon.ConfigurePrefixDelegation(cfg); err != nil {
+		return err
+	}
+
+	if cfg.KubernetesNetworkConfig != nil && cfg.KubernetesNetworkConfig.IPv6Enabled() {
+		if err := eks.ValidateIPv6InstanceTypes(ctl.Provider.EC2(), nodePools); err != nil {
+			return err
+		}
+	}
+
+	if err := vpc.ValidateSecurityGroupRules(ctl.Provider.EC2(), cfg, params.FixSecurityGroupRules); err != nil {
+		return err
+	}
+
+	if params.PreflightIAM {
+		if err := iampreflight.New(ctl.Provider).Check(iampreflight.OperationCreateCluster, cfg); err != nil {
+			return err
+		}
+	}
+
+	if err := collision.New(ctl.Provider).Check(cfg, true); err != nil {
+		return err
+	}
+
 	logger.Info("using Kubernetes version %s", meta.Version)
 	logger.Info("creating %s", cfg.LogString())
 
@@ -245,8 +333,12 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return err
 	}
 
+	notifier := notifications.New(cfg, ctl.Provider.EventBridge())
+	operation := notifier.StartOperation("creation")
+	defer func() { operation.Done(err) }()
+
 	stackManager := ctl.NewStackManager(cfg)
-	if cmd.ClusterConfigFile == "" {
+	if cmd.ClusterConfigFile == "" && params.Template == "" {
 		logMsg := func(resource string) {
 			logger.Info("will create 2 separate CloudFormation stacks for cluster itself and the initial %s", resource)
 		}
@@ -266,6 +358,13 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	}
 
 	logger.Info("if you encounter any issues, check CloudFormation console or try 'eksctl utils describe-stacks --region=%s --cluster=%s'", meta.Region, meta.Name)
+
+	if cfg.PullThroughCache != nil {
+		if err := pullthroughcache.New(ctl.Provider.ECR()).Create(cfg.PullThroughCache); err != nil {
+			return err
+		}
+	}
+
 	supportsManagedNodes, err := eks.VersionSupportsManagedNodes(cfg.Metadata.Version)
 	if err != nil {
 		return err
@@ -307,6 +406,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 
 	{ // post-creation action
 		var kubeconfigContextName string
+		logging.AddResult("Endpoint", cfg.Status.Endpoint)
 
 		if params.WriteKubeconfig {
 			kubectlConfig := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), params.AuthenticatorRoleARN, ctl.Provider.Profile())
@@ -317,6 +417,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 				logger.Warning("unable to write kubeconfig %s, please retry with 'eksctl utils write-kubeconfig -n %s': %v", params.KubeconfigPath, meta.Name, err)
 			} else {
 				logger.Success("saved kubeconfig as %q", params.KubeconfigPath)
+				logging.AddResult("Kubeconfig", params.KubeconfigPath)
 			}
 		} else {
 			params.KubeconfigPath = ""
@@ -335,6 +436,30 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		}
 		logger.Success("all EKS cluster resources for %q have been created", meta.Name)
 
+		if cfg.Alarms != nil {
+			if err := alarms.New(cfg, stackManager, ctl.Provider.EC2(), ctl.Provider.ASG()).Apply(); err != nil {
+				return err
+			}
+		}
+
+		if cfg.ParameterExport != nil {
+			cluster, err := ctl.DescribeControlPlane(cfg.Metadata)
+			if err != nil {
+				return err
+			}
+			if err := parameterexport.New(cfg, ctl.Provider.SSM()).Export(cluster); err != nil {
+				return err
+			}
+		}
+
+		if params.WriteOutputs != "" {
+			if err := clusteroutputs.Write(params.WriteOutputs, ctl, cfg, stackManager); err != nil {
+				return err
+			}
+		}
+
+		notifier.ClusterCreated()
+
 		// create Kubernetes client
 		clientSet, err := ctl.NewStdClientSet(cfg)
 		if err != nil {
@@ -367,11 +492,30 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 				return fmt.Errorf("failed to create addons")
 			}
 		}
+		if cfg.Bootstrap != nil && len(cfg.Bootstrap.Manifests) > 0 {
+			rawClient, err := ctl.NewRawClient(cfg)
+			if err != nil {
+				return err
+			}
+			if err := bootstrap.New(rawClient).Apply(cfg.Bootstrap.Manifests); err != nil {
+				return err
+			}
+		}
 		// After we have the cluster config and all the nodes are done, we install Karpenter if necessary.
 		if err := installKarpenter(ctl, cfg, stackManager, clientSet); err != nil {
 			return err
 		}
 
+		if len(cfg.HelmReleases) > 0 {
+			if err := helmrelease.New(cfg, ctl).Apply(context.Background()); err != nil {
+				return err
+			}
+		}
+
+		if err := hooks.New(cfg, ctl).RunPostCreate(); err != nil {
+			return err
+		}
+
 		if cfg.HasGitOpsFluxConfigured() {
 			installer, err := flux.New(clientSet, cfg.GitOps)
 			logger.Info("gitops configuration detected, setting installer to Flux v2")
@@ -405,6 +549,11 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			}
 			logger.Info("fully private cluster %q has been created. For subsequent operations, eksctl must be run from within the cluster's VPC, a peered VPC or some other means like AWS Direct Connect", cfg.Metadata.Name)
 		}
+
+		// the cluster exists at this point, so an unreachable endpoint must not fail the command
+		if err := ctl.ProbeEndpoints(cfg); err != nil {
+			logger.Warning("the endpoints of cluster %q are not ready: %v", meta.Name, err)
+		}
 	}
 
 	logger.Success("%s is ready", meta.LogString())
@@ -435,7 +584,35 @@ func installKarpenter(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, stackMan
 func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmdutils.CreateClusterCmdParams, ctl *eks.ClusterProvider) error {
 	customNetworkingNotice := "custom VPC/subnets will be used; if resulting cluster doesn't function as expected, make sure to review the configuration of VPC/subnets"
 
+	if cfg.VPC.CloudFormation != nil {
+		// import VPC from the outputs of a given stack
+		if params.DryRun {
+			return nil
+		}
+
+		if err := vpc.UseFromStack(ctl.Provider, cfg); err != nil {
+			return err
+		}
+
+		if err := cfg.HasSufficientSubnets(); err != nil {
+			logger.Critical("unable to use given %s", cfg.SubnetInfo())
+			return err
+		}
+
+		if err := cfg.CanUseForPrivateNodeGroups(); err != nil {
+			return err
+		}
+
+		logger.Success("using %s from stack %q", cfg.SubnetInfo(), cfg.VPC.CloudFormation.StackName)
+		logger.Warning(customNetworkingNotice)
+		return nil
+	}
+
 	subnetsGiven := cfg.HasAnySubnets() // this will be false when neither flags nor config has any subnets
+	if subnetsGiven && len(cfg.VPC.SecondaryCIDRs) > 0 {
+		// the subnets of the config file are created by eksctl, in vpc.cidr or vpc.secondaryCIDRs
+		return vpc.UseSubnetsFromSpec(cfg)
+	}
 	if !subnetsGiven && params.KopsClusterNameForVPC == "" {
 		if err := ctl.SetAvailabilityZones(cfg, params.AvailabilityZones); err != nil {
 			return err
@@ -521,3 +698,73 @@ func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmduti
 func checkSubnetsGivenAsFlags(params *cmdutils.CreateClusterCmdParams) bool {
 	return len(*params.Subnets[api.SubnetTopologyPrivate])+len(*params.Subnets[api.SubnetTopologyPublic]) != 0
 }
+
+// egressIPURL is the URL of the service returning the egress IP address of the caller for --restrict-public-access
+var egressIPURL = egressip.DefaultURL
+
+// restrictPublicAccess sets the public access CIDRs of the cluster to the egress IP address of the caller, in
+// addition to any configured CIDRs other than the ones open to the world, so that the public API server endpoint
+// is not open to the world
+func restrictPublicAccess(cfg *api.ClusterConfig, mode string) error {
+	switch mode {
+	case "":
+		return nil
+	case restrictPublicAccessAuto:
+	default:
+		return fmt.Errorf("invalid value %q for --restrict-public-access, valid options: %s", mode, restrictPublicAccessAuto)
+	}
+
+	if cfg.PrivateCluster.Enabled || !api.IsEnabled(cfg.VPC.ClusterEndpoints.PublicAccess) {
+		logger.Warning("public access to the API server endpoint is disabled, ignoring --restrict-public-access")
+		return nil
+	}
+
+	ip, err := egressip.Detect(context.TODO(), http.DefaultClient, egressIPURL)
+	if err != nil {
+		return errors.Wrap(err, "restricting public access to the API server endpoint")
+	}
+
+	cidrs := []string{egressip.CIDR(ip)}
+	for _, cidr := range cfg.VPC.PublicAccessCIDRs {
+		switch cidr {
+		case cidrs[0]:
+		case "0.0.0.0/0", "::/0":
+			logger.Info("replacing public access CIDR %s with the egress IP address of the caller", cidr)
+		default:
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	cfg.VPC.PublicAccessCIDRs = cidrs
+	logger.Info("restricting public access to the API server endpoint to %v", cidrs)
+
+	// nodes reach the public endpoint from the NAT gateway or their own public IP addresses, which are not allowed
+	if !api.IsEnabled(cfg.VPC.ClusterEndpoints.PrivateAccess) {
+		logger.Info("enabling private access to the API server endpoint for nodes to join the cluster")
+		cfg.VPC.ClusterEndpoints.PrivateAccess = api.Enabled()
+	}
+	return nil
+}
+
+// createLocalCluster creates a local cluster with kind from the ClusterConfig, or prints the kind config with
+// --dry-run
+func createLocalCluster(cfg *api.ClusterConfig, params *cmdutils.CreateClusterCmdParams) error {
+	if cfg.Metadata.Version == "" || cfg.Metadata.Version == "auto" {
+		cfg.Metadata.Version = api.DefaultVersion
+	}
+	if cfg.Metadata.Version == "latest" {
+		cfg.Metadata.Version = api.LatestVersion
+	}
+	if params.DryRun {
+		config, err := localcluster.RenderConfig(cfg)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(config))
+		return nil
+	}
+	kubeconfigPath := params.KubeconfigPath
+	if kubeconfigPath == "" {
+		kubeconfigPath = kubeconfig.DefaultPath()
+	}
+	return localcluster.New(cfg, params.Provider, kubeconfigPath).Create()
+}
@@ -0,0 +1,91 @@
+package create
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// convergeExistingCluster creates the iamserviceaccounts, addons and nodegroups of the config that are missing from
+// an existing cluster, and updates the iamserviceaccounts and addons that exist, like running
+// `create iamserviceaccount`, `create addon` and `create nodegroup` with --if-not-exists. Clusters are only converged
+// from a config file
+func convergeExistingCluster(cmd *cmdutils.Cmd, ctl *eks.ClusterProvider, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams) error {
+	cfg := cmd.ClusterConfig
+	meta := cfg.Metadata
+
+	if cmd.ClusterConfigFile == "" {
+		// without a config file the name of the initial nodegroup is generated, so it cannot be matched
+		logger.Info("the missing nodegroups, addons and iamserviceaccounts of an existing cluster are only created from a config file")
+		return nil
+	}
+
+	if err := ctl.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	stackManager := ctl.NewStackManager(cfg)
+
+	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return err
+	}
+	oidcProviderExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return err
+	}
+
+	if len(cfg.IAM.ServiceAccounts) > 0 {
+		if !oidcProviderExists {
+			return errors.Errorf("unable to create iamserviceaccount(s) without IAM OIDC provider enabled, try 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s'", meta.Region, meta.Name)
+		}
+		irsaManager := irsa.New(meta.Name, stackManager, oidc, clientSet)
+		if err := irsaManager.UpdateExistingIAMServiceAccounts(cfg.IAM.ServiceAccounts, false); err != nil {
+			return err
+		}
+		saFilter := filter.NewIAMServiceAccountFilter()
+		if err := saFilter.SetExcludeExistingFilter(stackManager, clientSet, cfg.IAM.ServiceAccounts, false); err != nil {
+			return err
+		}
+		if err := irsaManager.CreateIAMServiceAccount(saFilter.FilterMatching(cfg.IAM.ServiceAccounts), false); err != nil {
+			return err
+		}
+	}
+
+	if len(cfg.Addons) > 0 {
+		addonManager, err := addon.New(cfg, ctl.Provider.EKS(), stackManager, oidcProviderExists, oidc, clientSet, ctl.Provider.WaitTimeout())
+		if err != nil {
+			return err
+		}
+		for _, a := range cfg.Addons {
+			if err := addonManager.CreateOrUpdate(a, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(cfg.NodeGroups) == 0 && len(cfg.ManagedNodeGroups) == 0 {
+		return nil
+	}
+	logger.Info("creating the nodegroups missing from cluster %q", meta.Name)
+	return nodegroup.New(cfg, ctl, clientSet).Create(nodegroup.CreateOpts{
+		InstallNeuronDevicePlugin: params.InstallNeuronDevicePlugin,
+		InstallNvidiaDevicePlugin: params.InstallNvidiaDevicePlugin,
+		UpdateAuthConfigMap:       true,
+		FixSecurityGroupRules:     params.FixSecurityGroupRules,
+		ConfigFileProvided:        true,
+		IfNotExists:               true,
+	}, ngFilter)
+}
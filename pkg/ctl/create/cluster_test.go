@@ -1,12 +1,18 @@
 package create
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/utils/egressip"
 )
 
 var _ = Describe("create cluster", func() {
@@ -26,6 +32,36 @@ var _ = Describe("create cluster", func() {
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(count).To(Equal(1))
 		})
+		It("tags the resources as shared with --shared-vpc", func() {
+			cmd := newMockEmptyCmd("cluster", "--shared-vpc")
+			count := 0
+			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+				createClusterCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams) error {
+					Expect(cmd.ClusterConfig.SubnetClusterTag()).To(BeEmpty())
+					Expect(cmd.ClusterConfig.SecurityGroupClusterTag()).To(Equal("shared"))
+					Expect(cmd.ClusterConfig.InstanceClusterTag()).To(Equal("shared"))
+					count++
+					return nil
+				})
+			})
+			_, err := cmd.execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+		It("sets the outputs file with --write-outputs", func() {
+			cmd := newMockEmptyCmd("cluster", "--write-outputs", "outputs.json")
+			count := 0
+			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+				createClusterCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams) error {
+					Expect(params.WriteOutputs).To(Equal("outputs.json"))
+					count++
+					return nil
+				})
+			})
+			_, err := cmd.execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
 		DescribeTable("create cluster successfully",
 			func(args ...string) {
 				commandArgs := append([]string{"cluster"}, args...)
@@ -53,11 +89,15 @@ var _ = Describe("create cluster", func() {
 			Entry("with vpc-public-subnets flag", "--vpc-public-subnets", "10.0.0.0/24"),
 			Entry("with vpc-from-kops-cluster flag", "--vpc-from-kops-cluster", "dummy-kops-cluster"),
 			Entry("with vpc-nat-mode flag", "--vpc-nat-mode", "Single"),
+			Entry("with shared-vpc flag", "--shared-vpc"),
 			// kubeconfig flags
 			Entry("with write-kubeconfig flag", "--write-kubeconfig"),
 			Entry("with kubeconfig flag", "--kubeconfig", "~/.kube"),
 			Entry("with authenticator-role-arn flag", "--authenticator-role-arn", "arn::dummy::123/role"),
 			Entry("with auto-kubeconfig flag", "--auto-kubeconfig"),
+			Entry("with if-not-exists flag", "--if-not-exists"),
+			Entry("with write-outputs flag", "--write-outputs", "outputs.json"),
+			Entry("with preflight-iam flag", "--preflight-iam"),
 			// common node group flags
 			Entry("with node-type flag", "--node-type", "m5.large"),
 			Entry("with nodes flag", "--nodes", "2"),
@@ -66,6 +106,7 @@ var _ = Describe("create cluster", func() {
 			Entry("with node-volume-size flag", "--node-volume-size", "2"),
 			Entry("with node-volume-type flag", "--node-volume-type", "gp2"),
 			Entry("with max-pods-per-node flag", "--max-pods-per-node", "20"),
+			Entry("with max-pods-calculation flag", "--max-pods-calculation", "auto"),
 			Entry("with ssh-access flag", "--ssh-access", "true"),
 			Entry("with ssh-public-key flag", "--ssh-public-key", "dummy-public-key"),
 			Entry("with enable-ssm flag", "--enable-ssm"),
@@ -139,6 +180,7 @@ var _ = Describe("create cluster", func() {
 			Entry("with vpc-public-subnets flag", "--vpc-public-subnets", "10.0.0.0/24"),
 			Entry("with vpc-from-kops-cluster flag", "--vpc-from-kops-cluster", "dummy-kops-cluster"),
 			Entry("with vpc-nat-mode flag", "--vpc-nat-mode", "Single"),
+			Entry("with shared-vpc flag", "--shared-vpc"),
 			// kubeconfig flags
 			Entry("with write-kubeconfig flag", "--write-kubeconfig"),
 			Entry("with kubeconfig flag", "--kubeconfig", "~/.kube"),
@@ -188,10 +230,58 @@ var _ = Describe("create cluster", func() {
 				args:  []string{"--name", "eksctl-testing-k_8_cluster01"},
 				error: "validation for eksctl-testing-k_8_cluster01 failed, name must satisfy regular expression pattern: [a-zA-Z][-a-zA-Z0-9]*",
 			}),
+			Entry("with --fargate-only and a nodegroup flag", invalidParamsCase{
+				args:  []string{"--name=test", "--fargate-only", "--nodes=3"},
+				error: "cannot use --nodes with --fargate-only",
+			}),
 			Entry("with enableSsm disabled", invalidParamsCase{
 				args:  []string{"--name=test", "--enable-ssm=false"},
 				error: "SSM agent is now built into EKS AMIs and cannot be disabled",
 			}),
 		)
 	})
+
+	Describe("restrict public access", func() {
+		var (
+			server *httptest.Server
+			cfg    *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprintln(w, "192.0.2.10")
+			}))
+			egressIPURL = server.URL
+
+			cfg = api.NewClusterConfig()
+			cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{PublicAccess: api.Enabled(), PrivateAccess: api.Disabled()}
+		})
+
+		AfterEach(func() {
+			server.Close()
+			egressIPURL = egressip.DefaultURL
+		})
+
+		It("replaces the CIDRs open to the world with the egress IP address", func() {
+			cfg.VPC.PublicAccessCIDRs = []string{"0.0.0.0/0", "198.51.100.0/24", "::/0"}
+
+			Expect(restrictPublicAccess(cfg, "auto")).To(Succeed())
+			Expect(cfg.VPC.PublicAccessCIDRs).To(Equal([]string{"192.0.2.10/32", "198.51.100.0/24"}))
+			Expect(*cfg.VPC.ClusterEndpoints.PrivateAccess).To(BeTrue())
+		})
+
+		It("does not duplicate the egress IP address", func() {
+			cfg.VPC.PublicAccessCIDRs = []string{"192.0.2.10/32"}
+
+			Expect(restrictPublicAccess(cfg, "auto")).To(Succeed())
+			Expect(cfg.VPC.PublicAccessCIDRs).To(Equal([]string{"192.0.2.10/32"}))
+		})
+
+		It("leaves the CIDRs unchanged without the flag", func() {
+			cfg.VPC.PublicAccessCIDRs = []string{"0.0.0.0/0"}
+
+			Expect(restrictPublicAccess(cfg, "")).To(Succeed())
+			Expect(cfg.VPC.PublicAccessCIDRs).To(Equal([]string{"0.0.0.0/0"}))
+		})
+	})
 })
@@ -86,6 +86,26 @@ var _ = Describe("create", func() {
 			Expect(profile.Tags).To(HaveKeyWithValue("name", "fp-default"))
 		})
 
+		It("adds a profile for CoreDNS with --with-coredns", func() {
+			cmd := newMockCreateFargateProfileCmd("fargateprofile", "--cluster", "foo", "--namespace", "default", "--with-coredns", "fp-default")
+			_, err := cmd.execute()
+			Expect(err).To(Not(HaveOccurred()))
+			profiles := cmd.cmd.ClusterConfig.FargateProfiles
+			Expect(profiles).To(HaveLen(2))
+			Expect(profiles[0].Name).To(Equal("fp-default"))
+			Expect(profiles[1].Name).To(Equal("fp-coredns"))
+			Expect(profiles[1].Selectors).To(HaveLen(1))
+			Expect(profiles[1].Selectors[0].Namespace).To(Equal("kube-system"))
+			Expect(profiles[1].Selectors[0].Labels).To(Equal(map[string]string{"k8s-app": "kube-dns"}))
+		})
+
+		It("does not add a profile for CoreDNS with --with-coredns when a profile already selects it", func() {
+			cmd := newMockCreateFargateProfileCmd("fargateprofile", "-f", "../../../examples/16-fargate-profile.yaml", "--with-coredns")
+			_, err := cmd.execute()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(cmd.cmd.ClusterConfig.FargateProfiles).To(HaveLen(2))
+		})
+
 		It("supports all arguments to be provided by a ClusterConfig file", func() {
 			cmd := newMockCreateFargateProfileCmd("fargateprofile", "-f", "../../../examples/16-fargate-profile.yaml")
 			_, err := cmd.execute()
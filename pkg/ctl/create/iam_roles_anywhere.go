@@ -0,0 +1,110 @@
+package create
+
+import (
+	"os"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/rolesanywhere"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+type iamRolesAnywhereOptions struct {
+	rolesanywhere.CreateOptions
+
+	certificateBundleFile string
+	sessionDuration       time.Duration
+}
+
+func createIAMRolesAnywhereCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("iam-roles-anywhere", "Create an IAM Roles Anywhere profile to access the cluster",
+		dedent.Dedent(`Creates an IAM Roles Anywhere trust anchor and profile, and an IAM role mapped to
+			Kubernetes user and groups, so that workloads outside of AWS can access the cluster
+			with X.509 certificates issued by the trust anchor instead of long-lived access keys.
+		`),
+	)
+
+	var options iamRolesAnywhereOptions
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doCreateIAMRolesAnywhere(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&options.Name, "name", "", "Name of the trust anchor and profile")
+		fs.StringVar(&options.certificateBundleFile, "certificate-bundle", "", "Path to a PEM-encoded CA certificate bundle to use as the trust anchor")
+		fs.StringVar(&options.ACMPCAARN, "acm-pca-arn", "", "ARN of an ACM Private CA to use as the trust anchor")
+		fs.StringVar(&options.Username, "username", rolesanywhere.DefaultUsername, "User name within Kubernetes to map to the IAM role")
+		fs.StringSliceVar(&options.Groups, "group", []string{}, "Group within Kubernetes to which the IAM role is mapped")
+		fs.DurationVar(&options.sessionDuration, "session-duration", time.Hour, "Duration of the sessions vended by the profile")
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doCreateIAMRolesAnywhere(cmd *cmdutils.Cmd, options iamRolesAnywhereOptions) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+	if options.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrFlagAndArg("--name", options.Name, cmd.NameArg)
+	}
+	if cmd.NameArg != "" {
+		options.Name = cmd.NameArg
+	}
+	if options.Name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+	if (options.certificateBundleFile == "") == (options.ACMPCAARN == "") {
+		return errors.New("exactly one of --certificate-bundle and --acm-pca-arn must be set")
+	}
+	if options.certificateBundleFile != "" {
+		certificateBundle, err := os.ReadFile(options.certificateBundleFile)
+		if err != nil {
+			return errors.Wrap(err, "reading certificate bundle")
+		}
+		options.CertificateBundle = string(certificateBundle)
+	}
+	if options.sessionDuration < 15*time.Minute || options.sessionDuration > 12*time.Hour {
+		return errors.New("--session-duration must be between 15m and 12h")
+	}
+	options.SessionDuration = int(options.sessionDuration.Seconds())
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	output, err := rolesanywhere.New(cfg.Metadata.Name, ctl.NewStackManager(cfg), clientSet).Create(options.CreateOptions)
+	if err != nil {
+		return err
+	}
+
+	logger.Success("created IAM Roles Anywhere profile %q for cluster %q", options.Name, cfg.Metadata.Name)
+	logger.Info("configure the AWS signing helper with --trust-anchor-arn %s --profile-arn %s --role-arn %s", output.TrustAnchorARN, output.ProfileARN, output.RoleARN)
+	return nil
+}
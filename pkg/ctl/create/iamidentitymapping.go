@@ -1,6 +1,8 @@
 package create
 
 import (
+	"os"
+
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/kris-nova/logger"
 	"github.com/lithammer/dedent"
@@ -20,6 +22,9 @@ type iamIdentityMappingOptions struct {
 	Account     string
 	ServiceName string
 	Namespace   string
+
+	MappingsFile string
+	Sync         bool
 }
 
 func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
@@ -32,6 +37,10 @@ func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 			Note aws-iam-authenticator only considers the last entry for any given
 			role. If you create a duplicate entry it will shadow all the previous
 			username and groups mapping.
+
+			Many mappings can be created from a file with --mappings-file, and with
+			--sync the auth ConfigMap is made to exactly match the file, after saving
+			a backup of the previous ConfigMap.
 		`),
 	)
 
@@ -48,6 +57,8 @@ func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 		fs.StringSliceVar(&options.Groups, "group", []string{}, "Group within Kubernetes to which IAM role is mapped")
 		fs.StringVar(&options.ServiceName, "service-name", "", "Service name; valid value: emr-containers")
 		fs.StringVar(&options.Namespace, "namespace", "", "Namespace in which to create RBAC resources (only valid with --service-name)")
+		fs.StringVar(&options.MappingsFile, "mappings-file", "", "Path to a YAML file of mappings to create")
+		fs.BoolVar(&options.Sync, "sync", false, "Remove any mapping not in --mappings-file, except mappings used by nodes")
 		cmdutils.AddIAMIdentityMappingARNFlags(fs, cmd, &options.ARN, "create")
 		cmdutils.AddClusterFlagWithDeprecated(fs, cfg.Metadata)
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
@@ -99,6 +110,17 @@ func doCreateIAMIdentityMapping(cmd *cmdutils.Cmd, options iamIdentityMappingOpt
 		return err
 	}
 
+	if options.MappingsFile != "" {
+		if hasARNOptions() || options.Account != "" || options.ServiceName != "" || options.Namespace != "" {
+			return errors.New("cannot use --arn, --username, --groups, --account, --service-name or --namespace with --mappings-file")
+		}
+		return createIAMIdentityMappingsFromFile(acm, options)
+	}
+
+	if options.Sync {
+		return errors.New("--sync can only be used with --mappings-file")
+	}
+
 	if options.ServiceName != "" {
 		if hasARNOptions() {
 			return errors.New("cannot use --arn, --username, and --groups with --service-name")
@@ -155,3 +177,40 @@ func doCreateIAMIdentityMapping(cmd *cmdutils.Cmd, options iamIdentityMappingOpt
 	}
 	return acm.Save()
 }
+
+func createIAMIdentityMappingsFromFile(acm *authconfigmap.AuthConfigMap, options iamIdentityMappingOptions) error {
+	data, err := os.ReadFile(options.MappingsFile)
+	if err != nil {
+		return errors.Wrapf(err, "reading mappings file %q", options.MappingsFile)
+	}
+	identities, accounts, err := authconfigmap.ParseIdentityMappings(data)
+	if err != nil {
+		return errors.Wrapf(err, "parsing mappings file %q", options.MappingsFile)
+	}
+
+	if options.Sync {
+		backup, err := acm.Backup()
+		if err != nil {
+			return err
+		}
+		if backup != "" {
+			logger.Info("saved a backup of the auth ConfigMap to %q", backup)
+		}
+		if err := acm.SyncIdentities(identities, accounts); err != nil {
+			return err
+		}
+		return acm.Save()
+	}
+
+	for _, id := range identities {
+		if err := acm.AddIdentity(id); err != nil {
+			return err
+		}
+	}
+	for _, account := range accounts {
+		if err := acm.AddAccount(account); err != nil {
+			return err
+		}
+	}
+	return acm.Save()
+}
@@ -15,13 +15,16 @@ import (
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
+type iamServiceAccountOptions struct {
+	OverrideExistingServiceAccounts bool
+	IfNotExists                     bool
+}
+
 func createIAMServiceAccountCmd(cmd *cmdutils.Cmd) {
-	createIAMServiceAccountCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, overrideExistingServiceAccounts bool) error {
-		return doCreateIAMServiceAccount(cmd, overrideExistingServiceAccounts)
-	})
+	createIAMServiceAccountCmdWithRunFunc(cmd, doCreateIAMServiceAccount)
 }
 
-func createIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, overrideExistingServiceAccounts bool) error) {
+func createIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options iamServiceAccountOptions) error) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
@@ -32,13 +35,13 @@ func createIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *
 	cfg.IAM.WithOIDC = api.Enabled()
 	cfg.IAM.ServiceAccounts = append(cfg.IAM.ServiceAccounts, serviceAccount)
 
-	var overrideExistingServiceAccounts bool
+	var options iamServiceAccountOptions
 
 	cmd.SetDescription("iamserviceaccount", "Create an iamserviceaccount - AWS IAM role bound to a Kubernetes service account", "")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, overrideExistingServiceAccounts)
+		return runFunc(cmd, options)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -53,7 +56,8 @@ func createIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *
 
 		cmdutils.AddStringToStringVarPFlag(fs, &serviceAccount.Tags, "tags", "", map[string]string{}, "Used to tag the IAM role")
 
-		fs.BoolVar(&overrideExistingServiceAccounts, "override-existing-serviceaccounts", false, "create IAM roles for existing serviceaccounts and update the serviceaccount")
+		fs.BoolVar(&options.OverrideExistingServiceAccounts, "override-existing-serviceaccounts", false, "create IAM roles for existing serviceaccounts and update the serviceaccount")
+		cmdutils.AddIfNotExistsFlag(fs, &options.IfNotExists, "Update the IAM roles of existing iamserviceaccounts and recreate their missing serviceaccounts, instead of only excluding them")
 
 		cmdutils.AddIAMServiceAccountFilterFlags(fs, &cmd.Include, &cmd.Exclude)
 		cmdutils.AddApproveFlag(fs, cmd)
@@ -65,7 +69,7 @@ func createIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doCreateIAMServiceAccount(cmd *cmdutils.Cmd, overrideExistingServiceAccounts bool) error {
+func doCreateIAMServiceAccount(cmd *cmdutils.Cmd, options iamServiceAccountOptions) error {
 	saFilter := filter.NewIAMServiceAccountFilter()
 
 	if err := cmdutils.NewCreateIAMServiceAccountLoader(cmd, saFilter).Load(); err != nil {
@@ -107,15 +111,23 @@ func doCreateIAMServiceAccount(cmd *cmdutils.Cmd, overrideExistingServiceAccount
 		return errors.New("unable to create iamserviceaccount(s) without IAM OIDC provider enabled")
 	}
 	stackManager := ctl.NewStackManager(cfg)
+	irsaManager := irsa.New(cfg.Metadata.Name, stackManager, oidc, clientSet)
+
+	if options.IfNotExists {
+		// existing iamserviceaccounts are converged before the filter excludes them
+		if err := irsaManager.UpdateExistingIAMServiceAccounts(saFilter.FilterMatching(cfg.IAM.ServiceAccounts), cmd.Plan); err != nil {
+			return err
+		}
+	}
 
-	if err := saFilter.SetExcludeExistingFilter(stackManager, clientSet, cfg.IAM.ServiceAccounts, overrideExistingServiceAccounts); err != nil {
+	if err := saFilter.SetExcludeExistingFilter(stackManager, clientSet, cfg.IAM.ServiceAccounts, options.OverrideExistingServiceAccounts); err != nil {
 		return err
 	}
 
 	filteredServiceAccounts := saFilter.FilterMatching(cfg.IAM.ServiceAccounts)
 	saFilter.LogInfo(cfg.IAM.ServiceAccounts)
-	if !overrideExistingServiceAccounts {
-		logger.Warning("serviceaccounts that exist in Kubernetes will be excluded, use --override-existing-serviceaccounts to override")
+	if !options.OverrideExistingServiceAccounts {
+		logger.Warning("serviceaccounts that exist in Kubernetes will be excluded, use --override-existing-serviceaccounts or set adoptExisting to override")
 	} else {
 		logger.Warning("metadata of serviceaccounts that exist in Kubernetes will be updated, as --override-existing-serviceaccounts was set")
 	}
@@ -124,5 +136,5 @@ func doCreateIAMServiceAccount(cmd *cmdutils.Cmd, overrideExistingServiceAccount
 		return err
 	}
 
-	return irsa.New(cfg.Metadata.Name, stackManager, oidc, clientSet).CreateIAMServiceAccount(filteredServiceAccounts, cmd.Plan)
+	return irsaManager.CreateIAMServiceAccount(filteredServiceAccounts, cmd.Plan)
 }
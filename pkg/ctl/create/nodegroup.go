@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/weaveworks/eksctl/pkg/actions/iampreflight"
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/utils/names"
@@ -22,7 +23,10 @@ type nodegroupOptions struct {
 	cmdutils.CreateManagedNGOptions
 	UpdateAuthConfigMap     bool
 	SkipOutdatedAddonsCheck bool
+	FixSecurityGroupRules   bool
+	IfNotExists             bool
 	SubnetIDs               []string
+	PreflightIAM            bool
 }
 
 func createNodeGroupCmd(cmd *cmdutils.Cmd) {
@@ -57,6 +61,12 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 			return err
 		}
 
+		if options.PreflightIAM {
+			if err := iampreflight.New(ctl.Provider).Check(iampreflight.OperationCreateNodeGroup, cmd.ClusterConfig); err != nil {
+				return err
+			}
+		}
+
 		clientSet, err := ctl.NewStdClientSet(cmd.ClusterConfig)
 		if err != nil {
 			return err
@@ -69,6 +79,8 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 			UpdateAuthConfigMap:       options.UpdateAuthConfigMap,
 			DryRun:                    options.DryRun,
 			SkipOutdatedAddonsCheck:   options.SkipOutdatedAddonsCheck,
+			FixSecurityGroupRules:     options.FixSecurityGroupRules,
+			IfNotExists:               options.IfNotExists,
 			ConfigFileProvided:        cmd.ClusterConfigFile != "",
 		}, ngFilter)
 	})
@@ -94,7 +106,7 @@ func createNodeGroupCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc runFn) {
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to add the nodegroup to")
-		cmdutils.AddStringToStringVarPFlag(fs, &cfg.Metadata.Tags, "tags", "", map[string]string{}, "Used to tag the AWS resources")
+		cmdutils.AddStringToStringVarPFlag(fs, &cfg.Metadata.Tags, "tags", "", cmdutils.DefaultTags(), "Used to tag the AWS resources")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddVersionFlag(fs, cfg.Metadata, `for nodegroups "auto" and "latest" can be used to automatically inherit version from the control plane or force latest`)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
@@ -102,8 +114,12 @@ func createNodeGroupCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc runFn) {
 		cmdutils.AddUpdateAuthConfigMap(fs, &options.UpdateAuthConfigMap, "Add nodegroup IAM role to aws-auth configmap")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		cmdutils.AddSubnetIDs(fs, &options.SubnetIDs, "Define an optional list of subnet IDs to create the nodegroup in")
+		cmdutils.AddSharedVPCFlag(fs, &options.SharedVPC)
 		fs.BoolVarP(&options.DryRun, "dry-run", "", false, "Dry-run mode that skips nodegroup creation and outputs a ClusterConfig")
 		fs.BoolVarP(&options.SkipOutdatedAddonsCheck, "skip-outdated-addons-check", "", false, "whether the creation of ARM nodegroups should proceed when the cluster addons are outdated")
+		fs.BoolVar(&options.FixSecurityGroupRules, "fix-rules", false, "add the rules required within the cluster that are missing from vpc.sharedNodeSecurityGroup and vpc.securityGroup")
+		cmdutils.AddPreflightIAMFlag(fs, &options.PreflightIAM)
+		cmdutils.AddIfNotExistsFlag(fs, &options.IfNotExists, "Add the IAM roles of existing nodegroups missing from aws-auth configmap, instead of only excluding existing nodegroups")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("New nodegroup", func(fs *pflag.FlagSet) {
@@ -32,6 +32,7 @@ var _ = Describe("create nodegroup", func() {
 			Entry("with nodegroup name with a hyphen as flag", "--name", "nodegroup-name"),
 			Entry("with nodegroup name as argument", "nodegroupName"),
 			Entry("with nodegroup name with a hyphen as argument", "nodegroup-name"),
+			Entry("with shared-vpc flag", "--shared-vpc"),
 			Entry("with node-type flag", "--node-type", "m5.large"),
 			Entry("with nodes flag", "--nodes", "2"),
 			Entry("with nodes-min flag", "--nodes-min", "2"),
@@ -39,6 +40,7 @@ var _ = Describe("create nodegroup", func() {
 			Entry("with node-volume-size flag", "--node-volume-size", "2"),
 			Entry("with node-volume-type flag", "--node-volume-type", "gp2"),
 			Entry("with max-pods-per-node flag", "--max-pods-per-node", "20"),
+			Entry("with max-pods-calculation flag", "--max-pods-calculation", "auto"),
 			Entry("with ssh-access flag", "--ssh-access", "true"),
 			Entry("with ssh-public-key flag", "--ssh-public-key", "dummy-public-key"),
 			Entry("with enable-ssm flag", "--enable-ssm"),
@@ -1,9 +1,13 @@
 package delete
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/iampreflight"
+	"github.com/weaveworks/eksctl/pkg/actions/localcluster"
+	"github.com/weaveworks/eksctl/pkg/actions/notifications"
 
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
@@ -12,6 +16,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 )
 
 func deleteClusterCmd(cmd *cmdutils.Cmd) {
@@ -20,10 +25,27 @@ func deleteClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("cluster", "Delete a cluster", "")
 
-	var force bool
+	var (
+		force                bool
+		plan                 bool
+		preflightIAM         bool
+		parallel             int
+		provider             string
+		notificationsOptions cmdutils.NotificationsOptions
+	)
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doDeleteCluster(cmd, force)
+		local, err := localcluster.IsLocal(provider)
+		if err != nil {
+			return cmdutils.NewValidationError(err)
+		}
+		if local {
+			return doDeleteLocalCluster(cmd, provider)
+		}
+		if plan {
+			return doPlanDeleteCluster(cmd)
+		}
+		return doDeleteCluster(cmd, force, preflightIAM, parallel, notificationsOptions)
 	}
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", "EKS cluster name")
@@ -32,20 +54,31 @@ func deleteClusterCmd(cmd *cmdutils.Cmd) {
 		cmd.Wait = false
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
 		fs.BoolVar(&force, "force", false, "Force deletion to continue when errors occur")
+		fs.BoolVar(&plan, "plan", false, "List the stacks and resources that would be deleted, and the resources that would block the deletion, without deleting anything")
+		cmdutils.AddPreflightIAMFlag(fs, &preflightIAM)
+		fs.IntVar(&parallel, "parallel", 20, "Number of stacks to delete at the same time, 0 for no limit")
 
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddNotificationsFlags(fs, &notificationsOptions)
+		fs.StringVar(&provider, "provider", localcluster.ProviderAWS, fmt.Sprintf("Delete the cluster from AWS, or a local cluster created with kind, valid options: %s, %s, %s", localcluster.ProviderAWS, localcluster.ProviderKind, localcluster.ProviderDocker))
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doDeleteCluster(cmd *cmdutils.Cmd, force bool) error {
+func doDeleteCluster(cmd *cmdutils.Cmd, force, preflightIAM bool, parallel int, notificationsOptions cmdutils.NotificationsOptions) error {
+	if parallel < 0 {
+		return fmt.Errorf("--parallel must not be negative, got %d", parallel)
+	}
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
 
 	cfg := cmd.ClusterConfig
+	if err := cmdutils.SetNotifications(cfg, notificationsOptions); err != nil {
+		return err
+	}
 	meta := cmd.ClusterConfig.Metadata
 
 	printer := printers.NewJSONPrinter()
@@ -65,10 +98,52 @@ func doDeleteCluster(cmd *cmdutils.Cmd, force bool) error {
 		return err
 	}
 
+	if preflightIAM {
+		if err := iampreflight.New(ctl.Provider).Check(iampreflight.OperationDeleteCluster, cfg); err != nil {
+			return err
+		}
+	}
+
 	cluster, err := cluster.New(cfg, ctl)
 	if err != nil {
 		return err
 	}
 
-	return cluster.Delete(time.Second*20, cmd.Wait, force)
+	notifier := notifications.New(cfg, ctl.Provider.EventBridge())
+	notifier.ClusterDeletionStarted()
+	operation := notifier.StartOperation("deletion")
+	err = cluster.Delete(time.Second*20, cmd.Wait, force, parallel)
+	operation.Done(err)
+	return err
+}
+
+func doPlanDeleteCluster(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	plan, err := cluster.PlanDeletion(cfg, ctl, ctl.NewStackManager(cfg))
+	if err != nil {
+		return err
+	}
+	logger.Info(plan.Describe())
+	if len(plan.Blockers) > 0 {
+		logger.Warning("%d resource(s) would block the deletion of cluster %q", len(plan.Blockers), cfg.Metadata.Name)
+	}
+	logger.Info("no resources were deleted, rerun without --plan to delete the cluster")
+	return nil
+}
+
+func doDeleteLocalCluster(cmd *cmdutils.Cmd, provider string) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	return localcluster.New(cmd.ClusterConfig, provider, kubeconfig.DefaultPath()).Delete()
 }
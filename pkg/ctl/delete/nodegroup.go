@@ -7,6 +7,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 
 	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -14,15 +15,17 @@ import (
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
 func deleteNodeGroupCmd(cmd *cmdutils.Cmd) {
-	deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error {
-		return doDeleteNodeGroup(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, disableEviction)
+	deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool, forceAfter time.Duration, criticalPods drain.CriticalPodSelector, forceCritical bool) error {
+		return doDeleteNodeGroup(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, disableEviction, forceAfter, criticalPods, forceCritical)
 	})
 }
 
-func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error) {
+func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool, forceAfter time.Duration, criticalPods drain.CriticalPodSelector, forceCritical bool) error) {
 	cfg := api.NewClusterConfig()
 	ng := api.NewNodeGroup()
 	cmd.ClusterConfig = cfg
@@ -30,12 +33,15 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 	var updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool
 	var maxGracePeriod time.Duration
 	var disableEviction bool
+	var forceAfter time.Duration
+	var criticalPods drain.CriticalPodSelector
+	var forceCritical bool
 
 	cmd.SetDescription("nodegroup", "Delete a nodegroup", "", "ng")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, disableEviction)
+		return runFunc(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, disableEviction, forceAfter, criticalPods, forceCritical)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -52,6 +58,10 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 		fs.DurationVar(&maxGracePeriod, "max-grace-period", defaultMaxGracePeriod, "Maximum pods termination grace period")
 		defaultDisableEviction := false
 		fs.BoolVar(&disableEviction, "disable-eviction", defaultDisableEviction, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		cmdutils.AddForceAfterFlag(fs, &forceAfter)
+		fs.StringVar(&criticalPods.Label, "critical-label", drain.DefaultCriticalLabel, "Label or annotation, as key=value or key, marking pods as critical")
+		fs.StringSliceVar(&criticalPods.Namespaces, "critical-namespaces", nil, "Namespaces all pods of which are critical")
+		fs.BoolVar(&forceCritical, "force-critical", false, "Delete nodegroups even if critical pods run on their nodes")
 
 		cmd.Wait = false
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
@@ -61,7 +71,7 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error {
+func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool, forceAfter time.Duration, criticalPods drain.CriticalPodSelector, forceCritical bool) error {
 	ngFilter := filter.NewNodeGroupFilter()
 
 	if err := cmdutils.NewDeleteNodeGroupLoader(cmd, ng, ngFilter).Load(); err != nil {
@@ -119,9 +129,12 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 	allNodeGroups := cmdutils.ToKubeNodeGroups(cfg)
 
 	nodeGroupManager := nodegroup.New(cfg, ctl, clientSet)
+	if err := checkCriticalPods(nodeGroupManager, allNodeGroups, criticalPods, forceCritical, cmd.Plan); err != nil {
+		return err
+	}
 	if deleteNodeGroupDrain {
 		cmdutils.LogIntendedAction(cmd.Plan, "drain %d nodegroup(s) in cluster %q", len(allNodeGroups), cfg.Metadata.Name)
-		err := nodeGroupManager.Drain(allNodeGroups, cmd.Plan, maxGracePeriod, 0, false, disableEviction)
+		err := nodeGroupManager.Drain(allNodeGroups, cmd.Plan, maxGracePeriod, 0, false, disableEviction, forceAfter)
 		if err != nil {
 			logger.Warning("error occurred during drain, to skip drain use '--drain=false' flag")
 			return err
@@ -154,3 +167,27 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 
 	return nil
 }
+
+// checkCriticalPods fails if critical pods run on the nodes of the nodegroups, unless forceCritical is set or in plan
+// mode, where they are only reported
+func checkCriticalPods(nodeGroupManager *nodegroup.Manager, nodeGroups []eks.KubeNodeGroup, selector drain.CriticalPodSelector, forceCritical, plan bool) error {
+	criticalPods, err := nodeGroupManager.FindCriticalPods(nodeGroups, selector)
+	if err != nil {
+		return errors.Wrap(err, "checking for critical pods")
+	}
+	if len(criticalPods) == 0 {
+		return nil
+	}
+	for _, pod := range criticalPods {
+		logger.Warning("critical pod %s/%s runs on node %q: %s", pod.Namespace, pod.Name, pod.NodeName, pod.Reason)
+	}
+	if forceCritical {
+		logger.Warning("deleting nodegroups running %d critical pod(s) as --force-critical was set", len(criticalPods))
+		return nil
+	}
+	if plan {
+		logger.Warning("%d critical pod(s) run on the nodegroups, deleting them will require --force-critical", len(criticalPods))
+		return nil
+	}
+	return fmt.Errorf("%d critical pod(s) run on the nodegroups, use --force-critical to delete them anyway", len(criticalPods))
+}
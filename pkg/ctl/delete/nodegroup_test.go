@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/drain"
 )
 
 type invalidParamsCase struct {
@@ -22,7 +23,7 @@ var _ = Describe("delete", func() {
 			cmd := newMockEmptyCmd(args...)
 			count := 0
 			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-				deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error {
+				deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool, forceAfter time.Duration, criticalPods drain.CriticalPodSelector, forceCritical bool) error {
 					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("clusterName"))
 					Expect(ng.Name).To(Equal("ng"))
 					count++
@@ -37,6 +38,25 @@ var _ = Describe("delete", func() {
 		Entry("with deprecated flag --only", "nodegroup", "--cluster", "clusterName", "--name", "ng", "--only", "ng"),
 	)
 
+	It("parses the flags selecting critical pods", func() {
+		cmd := newMockEmptyCmd("nodegroup", "--cluster", "clusterName", "--name", "ng", "--critical-label", "tier=database", "--critical-namespaces", "payments,billing", "--force-critical")
+		count := 0
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+			deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool, forceAfter time.Duration, criticalPods drain.CriticalPodSelector, forceCritical bool) error {
+				Expect(criticalPods).To(Equal(drain.CriticalPodSelector{
+					Label:      "tier=database",
+					Namespaces: []string{"payments", "billing"},
+				}))
+				Expect(forceCritical).To(BeTrue())
+				count++
+				return nil
+			})
+		})
+		_, err := cmd.execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+	})
+
 	DescribeTable("invalid flags or arguments",
 		func(c invalidParamsCase) {
 			cmd := newDefaultCmd(c.args...)
@@ -0,0 +1,105 @@
+package get
+
+import (
+	"context"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/deprecatedapis"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getDeprecatedAPIsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var upgradeVersion string
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("deprecated-apis", "Get deprecated APIs in use in a cluster", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetDeprecatedAPIs(cmd, params, upgradeVersion)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringVar(&upgradeVersion, "upgrade-version", "", "only show the deprecated APIs that block an upgrade to this Kubernetes version")
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doGetDeprecatedAPIs(cmd *cmdutils.Cmd, params *getCmdParams, upgradeVersion string) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+
+	if params.output == printers.TableType {
+		cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+	} else {
+		//log warnings and errors to stderr
+		logger.Writer = os.Stderr
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	findings, err := deprecatedapis.Get(context.TODO(), clientSet)
+	if err != nil {
+		return err
+	}
+	if upgradeVersion != "" {
+		findings, err = deprecatedapis.BlockingUpgradeTo(findings, upgradeVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(findings) == 0 {
+		logger.Info("no deprecated APIs in use found in cluster %q", cfg.Metadata.Name)
+		return nil
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+	if params.output == printers.TableType {
+		addDeprecatedAPIsTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("deprecatedapis", findings, os.Stdout)
+}
+
+func addDeprecatedAPIsTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("API", func(f deprecatedapis.Finding) string {
+		return f.API()
+	})
+	printer.AddColumn("REMOVED IN", func(f deprecatedapis.Finding) string {
+		return f.RemovedRelease
+	})
+}
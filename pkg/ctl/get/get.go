@@ -24,6 +24,8 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getLabelsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getFargateProfile)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getAddonCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getDeprecatedAPIsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getOperationsCmd)
 
 	return verbCmd
 }
@@ -0,0 +1,103 @@
+package get
+
+import (
+	"os"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/operations"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getOperationsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var id string
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("operations", "Get the operations of eksctl on the stacks of a cluster", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetOperations(cmd, params, id)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringVar(&id, "id", "", "only show the stacks changed by the operation with this ID")
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doGetOperations(cmd *cmdutils.Cmd, params *getCmdParams, id string) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if params.output == printers.TableType {
+		cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+	} else {
+		//log warnings and errors to stderr
+		logger.Writer = os.Stderr
+	}
+
+	stackOperations, err := operations.List(ctl.NewStackManager(cfg), id)
+	if err != nil {
+		return err
+	}
+
+	if len(stackOperations) == 0 {
+		logger.Info("no operations of eksctl found on the stacks of cluster %q", cfg.Metadata.Name)
+		return nil
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+	if params.output == printers.TableType {
+		addOperationsTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("operations", stackOperations, os.Stdout)
+}
+
+func addOperationsTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("ID", func(o operations.StackOperation) string {
+		return o.ID
+	})
+	printer.AddColumn("COMMAND", func(o operations.StackOperation) string {
+		return o.Command
+	})
+	printer.AddColumn("STARTED", func(o operations.StackOperation) string {
+		return o.StartedAt.Format(time.RFC3339)
+	})
+	printer.AddColumn("STACK", func(o operations.StackOperation) string {
+		return o.StackName
+	})
+	printer.AddColumn("STATUS", func(o operations.StackOperation) string {
+		return o.Status
+	})
+	printer.AddColumn("UPDATED", func(o operations.StackOperation) string {
+		return o.UpdatedAt.Format(time.RFC3339)
+	})
+}
@@ -1,6 +1,8 @@
 package scale
 
 import (
+	"errors"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -9,22 +11,30 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 )
 
+type scaleOptions struct {
+	toZero   bool
+	preserve bool
+	restore  bool
+}
+
 func scaleNodeGroupCmd(cmd *cmdutils.Cmd) {
-	scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error {
-		return doScaleNodeGroup(cmd, ng)
+	scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroupBase, options scaleOptions) error {
+		return doScaleNodeGroup(cmd, ng, options)
 	})
 }
 
-func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error) {
+func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroupBase, options scaleOptions) error) {
 	cfg := api.NewClusterConfig()
 	ng := cfg.NewNodeGroup().BaseNodeGroup()
 	cmd.ClusterConfig = cfg
 
+	var options scaleOptions
+
 	cmd.SetDescription("nodegroup", "Scale a nodegroup", "", "ng")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, ng)
+		return runFunc(cmd, ng, options)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -36,6 +46,10 @@ func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		maxCapacity := fs.IntP("nodes-max", "M", -1, "maximum number of nodes")
 		minCapacity := fs.IntP("nodes-min", "m", -1, "minimum number of nodes")
 
+		fs.BoolVar(&options.toZero, "to-zero", false, "scale a managed nodegroup to zero nodes, keeping its maximum size")
+		fs.BoolVar(&options.preserve, "preserve", false, "used with --to-zero, store the current scaling config in a nodegroup tag so that it can be put back with --restore")
+		fs.BoolVar(&options.restore, "restore", false, "restore the scaling config of a managed nodegroup that was scaled with --to-zero --preserve")
+
 		cmdutils.AddPreRun(cmd.CobraCommand, func(cobraCmd *cobra.Command, args []string) {
 			if f := cobraCmd.Flag("nodes"); f.Changed {
 				ng.DesiredCapacity = desiredCapacity
@@ -55,7 +69,29 @@ func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error {
+func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroupBase, options scaleOptions) error {
+	if options.preserve && !options.toZero {
+		return errors.New("--preserve can only be used together with --to-zero")
+	}
+	if options.toZero && options.restore {
+		return errors.New("--to-zero and --restore are mutually exclusive")
+	}
+
+	if options.toZero || options.restore {
+		if err := cmdutils.NewPauseNodeGroupLoader(cmd, ng).Load(); err != nil {
+			return err
+		}
+		ctl, err := cmd.NewProviderForExistingCluster()
+		if err != nil {
+			return err
+		}
+		m := nodegroup.New(cmd.ClusterConfig, ctl, nil)
+		if options.restore {
+			return m.RestoreScale(ng)
+		}
+		return m.ScaleToZero(ng, options.preserve)
+	}
+
 	if ng.Name == "" && cmd.NameArg == "" {
 		if err := cmdutils.NewScaleAllNodeGroupLoader(cmd).Load(); err != nil {
 			return err
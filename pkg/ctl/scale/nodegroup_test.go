@@ -18,7 +18,7 @@ var _ = Describe("scale", func() {
 				cmd := newMockEmptyCmd(args...)
 				count := 0
 				cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-					scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroupBase) error {
+					scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroupBase, options scaleOptions) error {
 						if len(ng.Name) != 0 {
 							Expect(ng.Name).To(Or(Equal("nodeGroup"), Equal("")))
 						} else {
@@ -93,6 +93,22 @@ var _ = Describe("scale", func() {
 				args:  []string{"nodegroup", "-f", "../cmdutils/test_data/scale-ng-test.yaml", "--nodes-min", "2"},
 				error: fmt.Errorf("Error: cannot use --nodes-min when --config-file/-f is set"),
 			}),
+			Entry("--preserve without --to-zero", invalidParamsCase{
+				args:  []string{"nodegroup", "ng", "--cluster", "dummy", "--preserve"},
+				error: fmt.Errorf("Error: --preserve can only be used together with --to-zero"),
+			}),
+			Entry("--to-zero together with --restore", invalidParamsCase{
+				args:  []string{"nodegroup", "ng", "--cluster", "dummy", "--to-zero", "--restore"},
+				error: fmt.Errorf("Error: --to-zero and --restore are mutually exclusive"),
+			}),
+			Entry("--to-zero together with nodes flags", invalidParamsCase{
+				args:  []string{"nodegroup", "ng", "--cluster", "dummy", "--to-zero", "--nodes", "2"},
+				error: fmt.Errorf("Error: cannot use --nodes together with --to-zero or --restore"),
+			}),
+			Entry("--restore without a nodegroup name", invalidParamsCase{
+				args:  []string{"nodegroup", "--cluster", "dummy", "--restore"},
+				error: fmt.Errorf("Error: --name must be set"),
+			}),
 			Entry("with config file and cluster flags", invalidParamsCase{
 				args:  []string{"nodegroup", "-f", "../cmdutils/test_data/scale-ng-test.yaml", "--cluster", "dummyCluster"},
 				error: fmt.Errorf("Error: cannot use --cluster when --config-file/-f is set"),
@@ -4,6 +4,7 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/weaveworks/eksctl/pkg/actions/label"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -15,6 +16,7 @@ import (
 type labelOptions struct {
 	nodeGroupName string
 	labels        map[string]string
+	applyToNodes  bool
 }
 
 func setLabelsCmd(cmd *cmdutils.Cmd) {
@@ -33,6 +35,7 @@ func setLabelsCmd(cmd *cmdutils.Cmd) {
 		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
 		fs.StringVarP(&options.nodeGroupName, "nodegroup", "n", "", "Nodegroup name")
 		cmdutils.AddStringToStringVarPFlag(fs, &options.labels, "labels", "l", nil, "Labels")
+		fs.BoolVar(&options.applyToNodes, "apply-to-nodes", false, "Also apply the labels to existing nodes, instead of only to newly launched ones")
 
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
@@ -64,9 +67,26 @@ func setLabels(cmd *cmdutils.Cmd, options labelOptions) error {
 	}
 
 	manager := label.New(cfg.Metadata.Name, service, ctl.Provider.EKS())
+
+	var clientSet kubernetes.Interface
+	if options.applyToNodes {
+		if clientSet, err = ctl.NewStdClientSet(cfg); err != nil {
+			return err
+		}
+	}
+	setLabels := func(nodeGroupName string, labels map[string]string) error {
+		if err := manager.Set(nodeGroupName, labels); err != nil {
+			return err
+		}
+		if options.applyToNodes {
+			return manager.SetOnNodes(clientSet, nodeGroupName, labels)
+		}
+		return nil
+	}
+
 	// when there is no config file provided
 	if cmd.ClusterConfigFile == "" {
-		if err := manager.Set(options.nodeGroupName, options.labels); err != nil {
+		if err := setLabels(options.nodeGroupName, options.labels); err != nil {
 			return err
 		}
 		logger.Info("done")
@@ -85,7 +105,7 @@ func setLabels(cmd *cmdutils.Cmd, options labelOptions) error {
 			logger.Info("no new labels to add for nodegroup %s", mng.Name)
 			continue
 		}
-		if err := manager.Set(mng.Name, mng.Labels); err != nil {
+		if err := setLabels(mng.Name, mng.Labels); err != nil {
 			return err
 		}
 	}
@@ -10,6 +10,7 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	verbCmd := cmdutils.NewVerbCmd("set", "Set values", "")
 
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, setLabelsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, setTaintsCmd)
 
 	return verbCmd
 }
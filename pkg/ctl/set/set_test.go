@@ -51,6 +51,29 @@ var _ = Describe("set", func() {
 			Expect(err.Error()).To(ContainSubstring("Error: name argument is not supported"))
 		})
 	})
+
+	Describe("taints", func() {
+		It("fails when no flags set", func() {
+			cmd := newMockCmd("taints")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: --taints must be set"))
+		})
+
+		It("fails when --nodegroup flag not set", func() {
+			cmd := newMockCmd("taints", "--cluster", "dummy", "-t", "k=v:NoSchedule")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: --nodegroup must be set"))
+		})
+
+		It("fails when a taint has an invalid effect", func() {
+			cmd := newMockCmd("taints", "--cluster", "dummy", "--nodegroup", "dummyNodeGroup", "-t", "k=v:Sometimes")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: invalid taint effect: Sometimes"))
+		})
+	})
 })
 
 func newMockCmd(args ...string) *mockVerbCmd {
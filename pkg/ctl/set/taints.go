@@ -0,0 +1,94 @@
+package set
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/label"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/managed"
+	"github.com/weaveworks/eksctl/pkg/utils/taints"
+)
+
+type taintOptions struct {
+	nodeGroupName string
+	taints        map[string]string
+	applyToNodes  bool
+}
+
+func setTaintsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("taints", "Create or overwrite taints for managed nodegroups", "")
+
+	var options taintOptions
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return setTaints(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVarP(&options.nodeGroupName, "nodegroup", "n", "", "Nodegroup name")
+		cmdutils.AddStringToStringVarPFlag(fs, &options.taints, "taints", "t", nil, "Taints, in the form key=value:effect or key=:effect")
+		fs.BoolVar(&options.applyToNodes, "apply-to-nodes", false, "Also apply the taints to existing nodes, instead of only to newly launched ones")
+
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func setTaints(cmd *cmdutils.Cmd, options taintOptions) error {
+	cfg := cmd.ClusterConfig
+	if len(options.taints) == 0 {
+		return cmdutils.ErrMustBeSet("--taints")
+	}
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+	if options.nodeGroupName == "" {
+		return cmdutils.ErrMustBeSet("--nodegroup")
+	}
+	if cmd.NameArg != "" {
+		return cmdutils.ErrUnsupportedNameArg()
+	}
+
+	parsedTaints := taints.Parse(options.taints)
+	for _, t := range parsedTaints {
+		if err := taints.Validate(t); err != nil {
+			return err
+		}
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cmd.ClusterConfig.Metadata)
+	logger.Info("setting taint(s) on nodegroup %s in cluster %s", options.nodeGroupName, cmd.ClusterConfig.Metadata)
+
+	service := managed.NewService(ctl.Provider.EKS(), ctl.Provider.SSM(), ctl.Provider.EC2(), manager.NewStackCollection(ctl.Provider, cfg), cfg.Metadata.Name)
+	manager := label.New(cfg.Metadata.Name, service, ctl.Provider.EKS())
+	if err := manager.SetTaints(options.nodeGroupName, parsedTaints); err != nil {
+		return err
+	}
+
+	if options.applyToNodes {
+		clientSet, err := ctl.NewStdClientSet(cfg)
+		if err != nil {
+			return err
+		}
+		if err := manager.SetTaintsOnNodes(clientSet, options.nodeGroupName, parsedTaints); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("done")
+	return nil
+}
@@ -21,10 +21,11 @@ func unsetLabelsCmd(cmd *cmdutils.Cmd) {
 	var (
 		nodeGroupName string
 		removeLabels  []string
+		applyToNodes  bool
 	)
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return unsetLabels(cmd, nodeGroupName, removeLabels)
+		return unsetLabels(cmd, nodeGroupName, removeLabels, applyToNodes)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -32,6 +33,8 @@ func unsetLabelsCmd(cmd *cmdutils.Cmd) {
 		fs.StringVarP(&nodeGroupName, "nodegroup", "n", "", "Nodegroup name")
 		fs.StringSliceVarP(&removeLabels, "labels", "l", nil, "List of labels to remove")
 
+		fs.BoolVar(&applyToNodes, "apply-to-nodes", false, "Also remove the labels from existing nodes, instead of only from newly launched ones")
+
 		_ = cobra.MarkFlagRequired(fs, "labels")
 
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
@@ -42,7 +45,7 @@ func unsetLabelsCmd(cmd *cmdutils.Cmd) {
 
 }
 
-func unsetLabels(cmd *cmdutils.Cmd, nodeGroupName string, removeLabels []string) error {
+func unsetLabels(cmd *cmdutils.Cmd, nodeGroupName string, removeLabels []string, applyToNodes bool) error {
 	cfg := cmd.ClusterConfig
 	if cfg.Metadata.Name == "" {
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
@@ -68,6 +71,16 @@ func unsetLabels(cmd *cmdutils.Cmd, nodeGroupName string, removeLabels []string)
 		return err
 	}
 
+	if applyToNodes {
+		clientSet, err := ctl.NewStdClientSet(cfg)
+		if err != nil {
+			return err
+		}
+		if err := manager.UnsetOnNodes(clientSet, nodeGroupName, removeLabels); err != nil {
+			return err
+		}
+	}
+
 	logger.Info("done")
 	return nil
 }
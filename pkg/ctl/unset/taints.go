@@ -0,0 +1,85 @@
+package unset
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/label"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/managed"
+)
+
+func unsetTaintsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("taints", "Remove taints from managed nodegroups", "")
+
+	var (
+		nodeGroupName string
+		removeKeys    []string
+		applyToNodes  bool
+	)
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return unsetTaints(cmd, nodeGroupName, removeKeys, applyToNodes)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVarP(&nodeGroupName, "nodegroup", "n", "", "Nodegroup name")
+		fs.StringSliceVarP(&removeKeys, "taints", "t", nil, "List of keys of the taints to remove")
+
+		fs.BoolVar(&applyToNodes, "apply-to-nodes", false, "Also remove the taints from existing nodes, instead of only from newly launched ones")
+
+		_ = cobra.MarkFlagRequired(fs, "taints")
+
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func unsetTaints(cmd *cmdutils.Cmd, nodeGroupName string, removeKeys []string, applyToNodes bool) error {
+	cfg := cmd.ClusterConfig
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+	if nodeGroupName == "" {
+		return cmdutils.ErrMustBeSet("--nodegroup")
+	}
+
+	if cmd.NameArg != "" {
+		return cmdutils.ErrUnsupportedNameArg()
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cmd.ClusterConfig.Metadata)
+	logger.Info("removing taint(s) from nodegroup %s in cluster %s", nodeGroupName, cmd.ClusterConfig.Metadata)
+
+	service := managed.NewService(ctl.Provider.EKS(), ctl.Provider.SSM(), ctl.Provider.EC2(), manager.NewStackCollection(ctl.Provider, cfg), cfg.Metadata.Name)
+	manager := label.New(cfg.Metadata.Name, service, ctl.Provider.EKS())
+	if err := manager.UnsetTaints(nodeGroupName, removeKeys); err != nil {
+		return err
+	}
+
+	if applyToNodes {
+		clientSet, err := ctl.NewStdClientSet(cfg)
+		if err != nil {
+			return err
+		}
+		if err := manager.UnsetTaintsOnNodes(clientSet, nodeGroupName, removeKeys); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("done")
+	return nil
+}
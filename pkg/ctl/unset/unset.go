@@ -11,6 +11,7 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	verbCmd := cmdutils.NewVerbCmd("unset", "Unset values", "")
 
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, unsetLabelsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, unsetTaintsCmd)
 
 	return verbCmd
 }
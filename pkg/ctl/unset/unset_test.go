@@ -50,6 +50,22 @@ var _ = Describe("unset", func() {
 			Expect(err.Error()).To(ContainSubstring("Error: name argument is not supported"))
 		})
 	})
+
+	Describe("taints", func() {
+		It("fails when no flags set", func() {
+			cmd := newMockCmd("taints")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: required flag(s) \"taints\" not set"))
+		})
+
+		It("fails when --nodegroup flag not set", func() {
+			cmd := newMockCmd("taints", "--cluster", "dummy", "-t", "k")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: --nodegroup must be set"))
+		})
+	})
 })
 
 func newMockCmd(args ...string) *mockVerbCmd {
@@ -21,14 +21,16 @@ func updateAddonCmd(cmd *cmdutils.Cmd) {
 		"",
 	)
 
-	var force, wait bool
+	var force, wait, dryRun bool
 	cmd.ClusterConfig.Addons = []*api.Addon{{}}
 	cmd.FlagSetGroup.InFlagSet("Addon", func(fs *pflag.FlagSet) {
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].Name, "name", "", "Addon name")
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].Version, "version", "", "Add-on version. Use `eksctl utils describe-addon-versions` to discover a version or set to \"latest\"")
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].ServiceAccountRoleARN, "service-account-role-arn", "", "Addon serviceAccountRoleARN")
+		fs.StringVar(&cmd.ClusterConfig.Addons[0].ConfigurationValues, "configuration-values", "", "Addon configuration values, as a JSON or YAML document")
 		fs.BoolVar(&force, "force", false, "Force applies the add-on to overwrite an existing add-on")
 		fs.BoolVar(&wait, "wait", false, "Wait for the addon update to complete")
+		fs.BoolVar(&dryRun, "dry-run", false, "Print the changes of the version and the configuration values of the addon the update would make, without updating it")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -41,11 +43,11 @@ func updateAddonCmd(cmd *cmdutils.Cmd) {
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return updateAddon(cmd, force, wait)
+		return updateAddon(cmd, force, wait, dryRun)
 	}
 }
 
-func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
+func updateAddon(cmd *cmdutils.Cmd, force, wait, dryRun bool) error {
 	if err := cmdutils.NewCreateOrUpgradeAddonLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -81,12 +83,21 @@ func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
 	logger.Info("Kubernetes version %q in use by cluster %q", *output.Cluster.Version, cmd.ClusterConfig.Metadata.Name)
 	cmd.ClusterConfig.Metadata.Version = *output.Cluster.Version
 
-	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, nil, cmd.ProviderConfig.WaitTimeout)
+	clientSet, err := clusterProvider.NewStdClientSet(cmd.ClusterConfig)
+	if err != nil {
+		return err
+	}
+
+	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, clientSet, cmd.ProviderConfig.WaitTimeout)
 
 	if err != nil {
 		return err
 	}
 
+	if dryRun {
+		return planAddonUpdates(addonManager, cmd.ClusterConfig.Addons)
+	}
+
 	for _, a := range cmd.ClusterConfig.Addons {
 		if force { //force is specified at cmdline level
 			a.Force = true
@@ -99,3 +110,19 @@ func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
 
 	return nil
 }
+
+func planAddonUpdates(addonManager *addon.Manager, addons []*api.Addon) error {
+	for _, a := range addons {
+		plan, err := addonManager.PlanUpdate(a)
+		if err != nil {
+			return err
+		}
+		if !plan.HasChanges() {
+			logger.Info("no changes to the version or the configuration values of addon %q", a.Name)
+			continue
+		}
+		fmt.Print(plan.Diff())
+	}
+	logger.Warning("no changes were applied, run again without '--dry-run' to update the addons")
+	return nil
+}
@@ -0,0 +1,44 @@
+package update
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/helmrelease"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateHelmReleaseCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(
+		"helmrelease",
+		"Install or upgrade the Helm releases of a config file",
+		"",
+		"helmreleases",
+	)
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return updateHelmReleases(cmd)
+	}
+}
+
+func updateHelmReleases(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewUpdateHelmReleaseLoader(cmd).Load(); err != nil {
+		return err
+	}
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	return helmrelease.New(cmd.ClusterConfig, ctl).Apply(context.Background())
+}
@@ -1,9 +1,12 @@
 package upgrade
 
 import (
+	"errors"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/clusteroutputs"
+	"github.com/weaveworks/eksctl/pkg/actions/notifications"
 
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
@@ -32,6 +35,12 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
+	var (
+		notificationsOptions cmdutils.NotificationsOptions
+		schedule             bool
+		cancelSchedule       bool
+		writeOutputs         string
+	)
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", "EKS cluster name")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
@@ -43,15 +52,37 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		cmdutils.AddApproveFlag(fs, cmd)
 
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, upgradeClusterTimeout)
+		cmdutils.AddNotificationsFlags(fs, &notificationsOptions)
+		cmdutils.AddWriteOutputsFlag(fs, &writeOutputs)
+		fs.BoolVar(&schedule, "schedule", false, "schedule the upgrade of the control plane in the maintenanceWindow of the config file, instead of upgrading it now")
+		fs.BoolVar(&cancelSchedule, "cancel-schedule", false, "cancel the scheduled upgrade of the control plane")
 	})
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
 
-		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		if err := cmdutils.NewUpgradeClusterLoader(cmd).Load(); err != nil {
 			return err
 		}
-		return runFunc(cmd)
+		if err := cmdutils.SetNotifications(cmd.ClusterConfig, notificationsOptions); err != nil {
+			return err
+		}
+		if schedule && cancelSchedule {
+			return cmdutils.NewValidationError(errors.New("--schedule and --cancel-schedule are mutually exclusive"))
+		}
+		if schedule || cancelSchedule {
+			if writeOutputs != "" {
+				return cmdutils.NewValidationError(errors.New("--write-outputs cannot be used with --schedule or --cancel-schedule"))
+			}
+			return doScheduleUpgrade(cmd, cancelSchedule)
+		}
+		if err := runFunc(cmd); err != nil {
+			return err
+		}
+		if writeOutputs == "" || cmd.Plan {
+			return nil
+		}
+		return doWriteOutputs(cmd, writeOutputs)
 	}
 }
 
@@ -80,5 +111,40 @@ func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	return c.Upgrade(cmd.Plan)
+	if cmd.Plan {
+		return c.Upgrade(cmd.Plan)
+	}
+	operation := notifications.New(cfg, ctl.Provider.EventBridge()).StartOperation("upgrade")
+	err = c.Upgrade(cmd.Plan)
+	operation.Done(err)
+	return err
+}
+
+// doWriteOutputs writes the outputs of the upgraded cluster to a file
+func doWriteOutputs(cmd *cmdutils.Cmd, path string) error {
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	return clusteroutputs.Write(path, ctl, cmd.ClusterConfig, ctl.NewStackManager(cmd.ClusterConfig))
+}
+
+// doScheduleUpgrade schedules the upgrade of the control plane in the maintenance window of the cluster, or cancels it
+func doScheduleUpgrade(cmd *cmdutils.Cmd, cancel bool) error {
+	cfg := cmd.ClusterConfig
+	if !cancel && cfg.MaintenanceWindow == nil {
+		return cmdutils.NewValidationError(errors.New("--schedule requires a config file with maintenanceWindow set"))
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	scheduler := cluster.NewUpgradeScheduler(cfg, ctl.Provider.SSM())
+	if cancel {
+		return scheduler.Cancel(cmd.Plan)
+	}
+	return scheduler.Schedule(ctl.ControlPlaneVersion(), cmd.Plan)
 }
@@ -42,6 +42,18 @@ var _ = Describe("upgrade cluster", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("accepts the --write-outputs flag", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--write-outputs", "outputs.json")
+			_, err := cmd.Execute()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects --write-outputs with --schedule", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--write-outputs", "outputs.json", "--schedule")
+			_, err := cmd.Execute()
+			Expect(err).To(MatchError("--write-outputs cannot be used with --schedule or --cancel-schedule"))
+		})
+
 		It("accepts --approve flag", func() {
 			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--approve")
 			_, err := cmd.Execute()
@@ -1,12 +1,15 @@
 package upgrade
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/actions/notifications"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 )
@@ -19,10 +22,20 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("nodegroup", "Upgrade nodegroup", "")
 
-	var options nodegroup.UpgradeOptions
-	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+	var (
+		options                  nodegroup.UpgradeOptions
+		notificationsOptions     cmdutils.NotificationsOptions
+		maxUnavailable           int
+		maxUnavailablePercentage int
+	)
+	cmd.CobraCommand.RunE = func(cobraCmd *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return upgradeNodeGroup(cmd, options)
+		updateConfig, err := newUpdateConfig(cobraCmd.Flags(), maxUnavailable, maxUnavailablePercentage)
+		if err != nil {
+			return err
+		}
+		options.UpdateConfig = updateConfig
+		return upgradeNodeGroup(cmd, options, notificationsOptions)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("Nodegroup", func(fs *pflag.FlagSet) {
@@ -32,6 +45,8 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.BoolVar(&options.ForceUpgrade, "force-upgrade", false, "Force the update if the existing node group's pods are unable to be drained due to a pod disruption budget issue")
 		fs.StringVar(&options.ReleaseVersion, "release-version", "", "AMI version of the EKS optimized AMI to use")
 		fs.BoolVar(&options.Wait, "wait", true, "nodegroup upgrade to complete")
+		fs.IntVar(&maxUnavailable, "max-unavailable", 0, "maximum number of nodes unavailable at once during the upgrade, set as the updateConfig of the nodegroup before upgrading it")
+		fs.IntVar(&maxUnavailablePercentage, "max-unavailable-percentage", 0, "maximum percentage of nodes unavailable at once during the upgrade, set as the updateConfig of the nodegroup before upgrading it")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -39,13 +54,36 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		// found with experimentation
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, upgradeNodegroupTimeout)
+		cmdutils.AddNotificationsFlags(fs, &notificationsOptions)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 
 }
 
-func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions) error {
+// newUpdateConfig returns the update config set with the --max-unavailable and --max-unavailable-percentage flags,
+// or nil when neither is set
+func newUpdateConfig(fs *pflag.FlagSet, maxUnavailable, maxUnavailablePercentage int) (*api.NodeGroupUpdateConfig, error) {
+	maxUnavailableSet, maxUnavailablePercentageSet := fs.Changed("max-unavailable"), fs.Changed("max-unavailable-percentage")
+	switch {
+	case maxUnavailableSet && maxUnavailablePercentageSet:
+		return nil, cmdutils.NewValidationError(errors.New("--max-unavailable and --max-unavailable-percentage are mutually exclusive"))
+	case maxUnavailableSet:
+		if maxUnavailable < 1 || maxUnavailable > 100 {
+			return nil, cmdutils.NewValidationError(errors.New("--max-unavailable must be between 1 and 100"))
+		}
+		return &api.NodeGroupUpdateConfig{MaxUnavailable: &maxUnavailable}, nil
+	case maxUnavailablePercentageSet:
+		if maxUnavailablePercentage < 1 || maxUnavailablePercentage > 100 {
+			return nil, cmdutils.NewValidationError(errors.New("--max-unavailable-percentage must be between 1 and 100"))
+		}
+		return &api.NodeGroupUpdateConfig{MaxUnavailablePercentage: &maxUnavailablePercentage}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions, notificationsOptions cmdutils.NotificationsOptions) error {
 	cfg := cmd.ClusterConfig
 	if cfg.Metadata.Name == "" {
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
@@ -63,6 +101,14 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions) error
 		return cmdutils.ErrMustBeSet("name")
 	}
 
+	if err := cmdutils.SetNotifications(cfg, notificationsOptions); err != nil {
+		return err
+	}
+
+	if err := cmdutils.RunUserValidators(cfg); err != nil {
+		return err
+	}
+
 	ctl, err := cmd.NewProviderForExistingCluster()
 	if err != nil {
 		return err
@@ -77,5 +123,19 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions) error
 		return err
 	}
 
-	return nodegroup.New(cfg, ctl, clientSet).Upgrade(options)
+	notifier := notifications.New(cfg, ctl.Provider.EventBridge())
+	operation := notifier.StartOperation(fmt.Sprintf("upgrade of nodegroup %q", options.NodegroupName))
+	err = nodegroup.New(cfg, ctl, clientSet).Upgrade(options)
+	operation.Done(err)
+	if err != nil {
+		return err
+	}
+
+	notifier.NodeGroupUpgraded(notifications.NodeGroupUpgrade{
+		NodeGroupName:         options.NodegroupName,
+		KubernetesVersion:     options.KubernetesVersion,
+		ReleaseVersion:        options.ReleaseVersion,
+		LaunchTemplateVersion: options.LaunchTemplateVersion,
+	})
+	return nil
 }
@@ -34,6 +34,21 @@ var _ = Describe("upgrade", func() {
 			Expect(err.Error()).To(ContainSubstring("usage"))
 		})
 	})
+
+	Describe("nodegroup", func() {
+		It("rejects both --max-unavailable and --max-unavailable-percentage", func() {
+			cmd := newMockCmd("nodegroup", "--cluster", "foo", "--name", "ng", "--max-unavailable", "2", "--max-unavailable-percentage", "20")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--max-unavailable and --max-unavailable-percentage are mutually exclusive"))
+		})
+		It("rejects a --max-unavailable-percentage over 100", func() {
+			cmd := newMockCmd("nodegroup", "--cluster", "foo", "--name", "ng", "--max-unavailable-percentage", "150")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--max-unavailable-percentage must be between 1 and 100"))
+		})
+	})
 })
 
 func newMockCmd(args ...string) *mockVerbCmd {
@@ -75,6 +75,11 @@ func doAssociateIAMOIDCProvider(cmd *cmdutils.Cmd) error {
 		}
 	} else {
 		logger.Info("IAM Open ID Connect provider is already associated with cluster %q in %q", meta.Name, meta.Region)
+		if !cmd.Plan {
+			if err := oidc.EnsureClientIDs(); err != nil {
+				return err
+			}
+		}
 	}
 
 	cmdutils.LogPlanModeWarning(cmd.Plan && !providerExists)
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/account"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func bootstrapAccountCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(
+		"bootstrap-account",
+		"Create the account-level prerequisites of clusters",
+		"Create the service-linked roles used by EKS, managed nodegroups, Fargate, Auto Scaling and Spot, the KMS grants allowing Auto Scaling to launch instances with volumes encrypted by customer managed keys, and the CloudWatch Logs resource policy for log delivery, so that creating the first cluster of an account does not fail on them",
+	)
+
+	var kmsKeyIDs []string
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return doBootstrapAccount(cmd, kmsKeyIDs)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringSliceVar(&kmsKeyIDs, "volume-kms-key-ids", nil, "Customer managed KMS keys encrypting node volumes, in addition to the default EBS encryption key of the region")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doBootstrapAccount(cmd *cmdutils.Cmd, kmsKeyIDs []string) error {
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := account.New(ctl.Provider).Bootstrap(kmsKeyIDs); err != nil {
+		return err
+	}
+	logger.Success("account-level prerequisites are ready in %q", ctl.Provider.Region())
+	return nil
+}
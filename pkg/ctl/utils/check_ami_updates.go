@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/amiupdates"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+type checkAMIUpdatesOptions struct {
+	commands       bool
+	openPR         bool
+	noReleaseNotes bool
+}
+
+func checkAMIUpdatesCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options checkAMIUpdatesOptions
+
+	cmd.SetDescription("check-ami-updates", "Compare the AMIs of the nodegroups to the latest release of their channel",
+		"Compare the AMIs of the nodegroups to the latest EKS optimized Amazon Linux 2 or Bottlerocket AMI for their Kubernetes version, and report the CVEs fixed by newer releases")
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return doCheckAMIUpdates(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("AMI updates", func(fs *pflag.FlagSet) {
+		fs.BoolVar(&options.commands, "commands", false, "print the commands upgrading the nodegroups with a newer AMI")
+		fs.BoolVar(&options.openPR, "open-pr", false, "open a pull request updating the AMIs pinned in the config file, with git and the GitHub CLI")
+		fs.BoolVar(&options.noReleaseNotes, "no-release-notes", false, "do not read the CVEs fixed by newer releases from their GitHub release notes")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doCheckAMIUpdates(cmd *cmdutils.Cmd, options checkAMIUpdatesOptions) error {
+	if options.openPR && cmd.ClusterConfigFile == "" {
+		return cmdutils.NewValidationError(errors.New("--open-pr requires --config-file"))
+	}
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+
+	var releaseNotes amiupdates.ReleaseNotes
+	if !options.noReleaseNotes {
+		releaseNotes = amiupdates.NewGitHubReleaseNotes()
+	}
+	stackManager := ctl.NewStackManager(cfg)
+	statuses, err := amiupdates.New(cfg.Metadata.Name, stackManager, ctl.Provider.EKS(), ctl.Provider.EC2(), ctl.Provider.SSM(), releaseNotes).Check()
+	if err != nil {
+		return err
+	}
+
+	updates := 0
+	for _, s := range statuses {
+		switch {
+		case s.SkipReason != "":
+			logger.Info("nodegroup %q: skipped, %s", s.NodeGroup, s.SkipReason)
+		case !s.UpdateAvailable():
+			logger.Info("nodegroup %q: %s %s is the latest release", s.NodeGroup, s.ImageFamily, s.Current)
+		default:
+			updates++
+			logger.Warning("nodegroup %q: %s %s (%s) can be updated to %s (%s)", s.NodeGroup, s.ImageFamily, s.Current, s.CurrentRelease, s.Latest, s.LatestRelease)
+			if len(s.SecurityFixes) > 0 {
+				logger.Warning("nodegroup %q: newer releases fix %s", s.NodeGroup, strings.Join(s.SecurityFixes, ", "))
+			}
+		}
+	}
+	if updates == 0 {
+		logger.Success("the AMIs of all nodegroups of cluster %q are up to date", cfg.Metadata.Name)
+		return nil
+	}
+
+	if options.commands {
+		for _, command := range amiupdates.UpgradeCommands(cfg.Metadata.Name, statuses) {
+			fmt.Println(command)
+		}
+	}
+	if options.openPR {
+		return amiupdates.NewPullRequest(cfg.Metadata.Name, cmd.ClusterConfigFile).Open(statuses)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/connectivity"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func checkPrivateConnectivityCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription(
+		"check-private-connectivity",
+		"Check the network configuration nodes of a fully-private cluster need to join it",
+		"Check the VPC endpoints, their security groups and the DNS resolution of their host names from the node subnets, using SSM to run a probe on nodes",
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doCheckPrivateConnectivity(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doCheckPrivateConnectivity(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cluster, err := ctl.DescribeControlPlane(cfg.Metadata)
+	if err != nil {
+		return err
+	}
+
+	endpointServices := api.RequiredEndpointServices()
+	if cfg.PrivateCluster != nil {
+		endpointServices = cfg.PrivateCluster.EndpointServices()
+	}
+
+	results, err := connectivity.New(ctl.Provider.EC2(), ctl.Provider.SSM(), cluster, cfg.Metadata.Region).Check(endpointServices)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Passed {
+			logger.Success("%s: %s", result.Check, result.Message)
+		} else {
+			failed++
+			logger.Warning("%s: %s", result.Check, result.Message)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d connectivity checks failed for cluster %q", failed, len(results), cfg.Metadata.Name)
+	}
+	logger.Info("all connectivity checks passed for cluster %q", cfg.Metadata.Name)
+	return nil
+}
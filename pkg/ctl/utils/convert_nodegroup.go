@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func convertNodeGroupCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var (
+		options nodegroup.ConvertOptions
+		to      string
+	)
+
+	cmd.SetDescription("convert-nodegroup", "Convert a nodegroup to a managed or self-managed nodegroup",
+		"Creates a nodegroup of the other type with the settings of an existing nodegroup, drains the existing nodegroup and deletes it. "+
+			"Self-managed nodegroups are converted from their config, which must be set with --config-file")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doConvertNodeGroup(cmd, options, to)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVarP(&options.NodeGroupName, "name", "n", "", "Name of the nodegroup to convert")
+		fs.StringVar(&to, "to", "", "Type of the new nodegroup, one of: managed, unmanaged")
+		fs.StringVar(&options.NewName, "new-name", "", "Name of the new nodegroup (defaults to the name of the nodegroup suffixed with its new type)")
+		defaultMaxGracePeriod, _ := time.ParseDuration("10m")
+		fs.DurationVar(&options.MaxGracePeriod, "max-grace-period", defaultMaxGracePeriod, "Maximum pods termination grace period")
+		fs.BoolVar(&options.DisableEviction, "disable-eviction", false, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		cmdutils.AddForceAfterFlag(fs, &options.ForceAfter)
+
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doConvertNodeGroup(cmd *cmdutils.Cmd, options nodegroup.ConvertOptions, to string) error {
+	if err := cmdutils.NewUtilsConvertNodeGroupLoader(cmd).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+
+	if options.NodeGroupName != "" && cmd.NameArg != "" {
+		return cmdutils.ErrFlagAndArg("--name", options.NodeGroupName, cmd.NameArg)
+	}
+	if cmd.NameArg != "" {
+		options.NodeGroupName = cmd.NameArg
+	}
+	if options.NodeGroupName == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	switch api.NodeGroupType(to) {
+	case api.NodeGroupTypeManaged:
+		options.ToManaged = true
+	case api.NodeGroupTypeUnmanaged:
+	default:
+		return fmt.Errorf("--to must be one of: %s, %s", api.NodeGroupTypeManaged, api.NodeGroupTypeUnmanaged)
+	}
+	if options.NewName == "" {
+		options.NewName = fmt.Sprintf("%s-%s", options.NodeGroupName, to)
+	}
+	if api.IsInvalidNameArg(options.NewName) {
+		return api.ErrInvalidName(options.NewName)
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "replace nodegroup %q with %s nodegroup %q in cluster %q", options.NodeGroupName, to, options.NewName, cfg.Metadata.Name)
+	if cmd.Plan {
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := nodegroup.New(cfg, ctl, clientSet).Convert(options); err != nil {
+		return err
+	}
+	cmdutils.LogCompletedAction(false, "replaced nodegroup %q with %s nodegroup %q", options.NodeGroupName, to, options.NewName)
+	return nil
+}
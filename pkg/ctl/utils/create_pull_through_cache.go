@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/pullthroughcache"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func createPullThroughCacheCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(
+		"create-pull-through-cache",
+		"Create ECR pull-through cache rules",
+		"Create ECR pull-through cache rules for public registries, so that nodes without internet access can pull their images",
+	)
+
+	var upstreamRegistries []string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewUtilsCreatePullThroughCacheLoader(cmd, upstreamRegistries).Load(); err != nil {
+			return err
+		}
+		return doCreatePullThroughCache(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringSliceVar(&upstreamRegistries, "upstream-registry", nil, "Upstream registries to create rules for, e.g. quay.io,ghcr.io,registry.k8s.io")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doCreatePullThroughCache(cmd *cmdutils.Cmd) error {
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := pullthroughcache.New(ctl.Provider.ECR()).Create(cmd.ClusterConfig.PullThroughCache); err != nil {
+		return err
+	}
+	logger.Success("pull-through cache rules are ready in %q", ctl.Provider.Region())
+	return nil
+}
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/stackset"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+func deployStackSetCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(
+		"deploy-stackset",
+		"Deploy the prerequisites of a cluster to the accounts of organizational units",
+		"Deploy the VPC and the service role of the cluster defined in the config file as a CloudFormation StackSet to the accounts of the organizational units in the stackSet section, so that they can be created ahead of clusters across many accounts. Without --approve, the template of the StackSet is printed instead",
+	)
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		if err := cmdutils.NewUtilsDeployStackSetLoader(cmd).Load(); err != nil {
+			return err
+		}
+		return doDeployStackSet(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doDeployStackSet(cmd *cmdutils.Cmd) error {
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if cfg.VPC.ID == "" && !cfg.HasAnySubnets() {
+		// availability zone names are mapped to different zones in each account, but exist in all accounts
+		if err := ctl.SetAvailabilityZones(cfg, nil); err != nil {
+			return err
+		}
+		if err := vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones); err != nil {
+			return err
+		}
+	} else if len(cfg.VPC.SecondaryCIDRs) > 0 {
+		if err := vpc.UseSubnetsFromSpec(cfg); err != nil {
+			return err
+		}
+	}
+
+	deployer := stackset.New(ctl.Provider)
+	if cmd.Plan {
+		templateBody, err := deployer.Render(cfg)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(templateBody))
+		cmdutils.LogIntendedAction(cmd.Plan, "deploy StackSet %q to the accounts of organizational units %v in %q", cfg.StackSet.Name, cfg.StackSet.OrganizationalUnitIDs, ctl.Provider.Region())
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+
+	if err := deployer.Deploy(cfg); err != nil {
+		return err
+	}
+	logger.Success("prerequisites of cluster %q are deployed to the accounts of organizational units %v", cfg.Metadata.Name, cfg.StackSet.OrganizationalUnitIDs)
+	return nil
+}
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/actions/rightsize"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+type rightsizeOptions struct {
+	source  string
+	period  time.Duration
+	options rightsize.Options
+}
+
+func rightsizeCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options rightsizeOptions
+
+	cmd.SetDescription("rightsize", "Recommend instance types and numbers of nodes for nodegroups from their utilization", "")
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return doRightsize(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Rightsize", func(fs *pflag.FlagSet) {
+		fs.StringVar(&options.source, "source", rightsize.SourceMetricsServer, fmt.Sprintf("source of the utilization of nodes, the current usage from %s or the average usage over --period from CloudWatch Container Insights (valid options: %s, %s)", rightsize.SourceMetricsServer, rightsize.SourceMetricsServer, rightsize.SourceCloudWatch))
+		fs.DurationVar(&options.period, "period", 7*24*time.Hour, fmt.Sprintf("period to average the utilization of nodes over, with --source=%s", rightsize.SourceCloudWatch))
+		fs.Float64Var(&options.options.TargetUtilization, "target-utilization", 0.7, "fraction of the CPU and memory of the nodes to use")
+		fs.IntVar(&options.options.MinNodes, "min-nodes", 1, "minimum number of nodes to recommend per nodegroup")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doRightsize(cmd *cmdutils.Cmd, options rightsizeOptions) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet("--cluster")
+	}
+	if options.source != rightsize.SourceMetricsServer && options.source != rightsize.SourceCloudWatch {
+		return cmdutils.NewValidationError(fmt.Errorf("invalid value %q for --source, valid options: %s, %s", options.source, rightsize.SourceMetricsServer, rightsize.SourceCloudWatch))
+	}
+	if options.options.TargetUtilization <= 0 || options.options.TargetUtilization > 1 {
+		return cmdutils.NewValidationError(errors.New("--target-utilization must be greater than 0 and at most 1"))
+	}
+	if options.options.MinNodes < 1 {
+		return cmdutils.NewValidationError(errors.New("--min-nodes must be at least 1"))
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return errors.Wrap(err, "listing nodes")
+	}
+
+	var source rightsize.UtilizationSource
+	if options.source == rightsize.SourceCloudWatch {
+		source = rightsize.NewCloudWatchSource(ctl.Provider.CloudWatch(), cfg.Metadata.Name, options.period)
+	} else {
+		source = rightsize.NewMetricsServerSource(clientSet.CoreV1().RESTClient())
+	}
+
+	recommendations, err := rightsize.New(ctl.Provider.EC2(), source, options.options).Recommend(nodes.Items)
+	if err != nil {
+		return err
+	}
+	for _, r := range recommendations {
+		logger.Info("nodegroup %q: %d x %s, CPU %.0f%%, memory %.0f%% used", r.NodeGroup, r.CurrentNodes, r.CurrentInstanceType, r.CPUUtilization*100, r.MemoryUtilization*100)
+		if r.Changed() {
+			logger.Info("nodegroup %q: recommended %d x %s", r.NodeGroup, r.RecommendedNodes, r.RecommendedInstanceType)
+		}
+	}
+
+	diff := rightsize.Diff(recommendations)
+	if diff == "" {
+		logger.Success("all nodegroups are sized for a utilization of %.0f%%", options.options.TargetUtilization*100)
+		return nil
+	}
+	logger.Info("apply the following changes to the nodegroups in the config file of cluster %q", cfg.Metadata.Name)
+	fmt.Print(diff)
+	return nil
+}
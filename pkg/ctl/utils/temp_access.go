@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/weaveworks/eksctl/pkg/actions/tempaccess"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+)
+
+type tempAccessOptions struct {
+	clusterRole   string
+	duration      time.Duration
+	kubeconfig    string
+	revoke        string
+	revokeExpired bool
+}
+
+func tempAccessCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("temp-access", "Grant temporary access to a cluster",
+		dedent.Dedent(`Writes a kubeconfig with a token that expires after the given duration, e.g. for
+			break-glass access. The token is issued for a service account bound to a cluster role,
+			whose annotation records who granted the access and when it expires. Expired access is
+			rejected by the cluster; its service accounts are deleted with --revoke-expired.
+		`),
+	)
+
+	var options tempAccessOptions
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doTempAccess(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&options.clusterRole, "cluster-role", "cluster-admin", "Cluster role to grant")
+		fs.DurationVar(&options.duration, "duration", time.Hour, fmt.Sprintf("Duration of the access, between %s and %s", tempaccess.MinDuration, tempaccess.MaxDuration))
+		fs.StringVar(&options.kubeconfig, "kubeconfig", "", "Path to write the kubeconfig with the token to, defaults to <name of the access>.kubeconfig")
+		fs.StringVar(&options.revoke, "revoke", "", "Only revoke the temporary access with the given name before it expires")
+		fs.BoolVar(&options.revokeExpired, "revoke-expired", false, "Only delete the service accounts of the temporary access that has expired")
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doTempAccess(cmd *cmdutils.Cmd, options tempAccessOptions) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+	if options.revoke != "" && options.revokeExpired {
+		return errors.New("--revoke and --revoke-expired cannot be used together")
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	manager := tempaccess.New(clientSet)
+
+	switch {
+	case options.revoke != "":
+		if err := manager.Revoke(options.revoke); err != nil {
+			return err
+		}
+		logger.Success("revoked temporary access %q", options.revoke)
+		return nil
+	case options.revokeExpired:
+		revoked, err := manager.RevokeExpired()
+		if err != nil {
+			return err
+		}
+		if len(revoked) == 0 {
+			logger.Info("no expired temporary access to revoke")
+		}
+		return nil
+	}
+
+	record, token, err := manager.Grant(options.clusterRole, ctl.GetCallerARN(), options.duration)
+	if err != nil {
+		return err
+	}
+	config := kubeconfig.NewForUser(cfg, record.Name)
+	config.AuthInfos[config.Contexts[config.CurrentContext].AuthInfo].Token = token
+	path := options.kubeconfig
+	if path == "" {
+		path = record.Name + ".kubeconfig"
+	}
+	if err := clientcmd.WriteToFile(*config, path); err != nil {
+		if revokeErr := manager.Revoke(record.Name); revokeErr != nil {
+			logger.Warning("failed to revoke temporary access %q: %v", record.Name, revokeErr)
+		}
+		return errors.Wrapf(err, "writing kubeconfig %q", path)
+	}
+	logger.Success("granted %q access until %s with the kubeconfig %q", record.ClusterRole, record.ExpiresAt.Format(time.RFC3339), path)
+	logger.Info("run 'eksctl utils temp-access --cluster=%s --revoke=%s' to revoke it before it expires", cfg.Metadata.Name, record.Name)
+	return nil
+}
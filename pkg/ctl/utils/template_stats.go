@@ -0,0 +1,118 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/templatestats"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+func templateStatsCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(
+		"template-stats",
+		"Report the size and resource counts of the CloudFormation stacks of a cluster",
+		"Render the templates of the cluster stack and of the stacks of all nodegroups in the config file, and report their size, numbers of resources, outputs, parameters and mappings, with warnings for stacks close to or exceeding the CloudFormation quotas",
+	)
+
+	var output printers.Type
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		if err := cmdutils.NewUtilsTemplateStatsLoader(cmd).Load(); err != nil {
+			return err
+		}
+		return doTemplateStats(cmd, output)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", string(printers.TableType), "specifies the output format (valid option: table, json, yaml)")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doTemplateStats(cmd *cmdutils.Cmd, output printers.Type) error {
+	cfg := cmd.ClusterConfig
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if cfg.VPC.ID == "" && !cfg.HasAnySubnets() {
+		if err := ctl.SetAvailabilityZones(cfg, nil); err != nil {
+			return err
+		}
+		if err := vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones); err != nil {
+			return err
+		}
+	} else if len(cfg.VPC.SecondaryCIDRs) > 0 {
+		if err := vpc.UseSubnetsFromSpec(cfg); err != nil {
+			return err
+		}
+	}
+
+	stats, err := templatestats.Render(ctl.Provider, cfg)
+	if err != nil {
+		return err
+	}
+
+	var items []*templatestats.StackStats
+	for i := range stats {
+		items = append(items, &stats[i])
+	}
+	if output == printers.TableType {
+		addTemplateStatsTableColumns(printer.(*printers.TablePrinter))
+	}
+	if err := printer.PrintObjWithKind("stacks", items, os.Stdout); err != nil {
+		return err
+	}
+
+	if output == printers.TableType {
+		for _, s := range stats {
+			for _, warning := range s.Warnings {
+				logger.Warning("stack %q: %s", s.StackName, warning)
+			}
+		}
+	}
+	return nil
+}
+
+func addTemplateStatsTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("STACK", func(s *templatestats.StackStats) string {
+		return s.StackName
+	})
+	printer.AddColumn("SIZE", func(s *templatestats.StackStats) string {
+		return fmt.Sprintf("%d", s.Size)
+	})
+	printer.AddColumn("RESOURCES", func(s *templatestats.StackStats) string {
+		return fmt.Sprintf("%d/%d", s.Resources, templatestats.MaxResources)
+	})
+	printer.AddColumn("OUTPUTS", func(s *templatestats.StackStats) string {
+		return fmt.Sprintf("%d/%d", s.Outputs, templatestats.MaxOutputs)
+	})
+	printer.AddColumn("PARAMETERS", func(s *templatestats.StackStats) string {
+		return fmt.Sprintf("%d/%d", s.Parameters, templatestats.MaxParameters)
+	})
+	printer.AddColumn("MAPPINGS", func(s *templatestats.StackStats) string {
+		return fmt.Sprintf("%d/%d", s.Mappings, templatestats.MaxMappings)
+	})
+	printer.AddColumn("S3 UPLOAD", func(s *templatestats.StackStats) string {
+		return fmt.Sprintf("%t", s.UploadedToS3())
+	})
+}
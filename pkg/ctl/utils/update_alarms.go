@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/alarms"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateAlarmsCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(
+		"update-alarms",
+		"Create or update the CloudWatch alarms of a cluster",
+		"Create or update the CloudWatch alarms defined in the alarms section of the config file, e.g. to monitor nodegroups created since the alarms were last updated",
+	)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewUtilsUpdateAlarmsLoader(cmd).Load(); err != nil {
+			return err
+		}
+		return doUpdateAlarms(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doUpdateAlarms(cmd *cmdutils.Cmd) error {
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if err := ctl.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	if cfg.VPC.ID == "" {
+		cfg.VPC.ID = aws.StringValue(ctl.Status.ClusterInfo.Cluster.ResourcesVpcConfig.VpcId)
+	}
+
+	if err := alarms.New(cfg, ctl.NewStackManager(cfg), ctl.Provider.EC2(), ctl.Provider.ASG()).Apply(); err != nil {
+		return err
+	}
+	logger.Success("CloudWatch alarms of cluster %q are up to date", cfg.Metadata.Name)
+	return nil
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateOIDCThumbprintsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-oidc-thumbprints", "Update the thumbprints of the IAM OIDC provider of a cluster",
+		"Re-computes the thumbprint of the root CA of the cluster's OIDC issuer and updates the IAM OIDC provider when the root CA has been rotated")
+
+	var skipIssuerVerification bool
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateOIDCThumbprints(cmd, skipIssuerVerification)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlagWithDeprecated(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		fs.BoolVar(&skipIssuerVerification, "skip-issuer-verification", false, "Do not verify the certificate of the OIDC issuer against the local trust store, only supported for issuers hosted by EKS")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doUpdateOIDCThumbprints(cmd *cmdutils.Cmd, skipIssuerVerification bool) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return err
+	}
+
+	if skipIssuerVerification {
+		if err := oidc.SkipIssuerVerification(); err != nil {
+			return err
+		}
+	}
+
+	providerExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return err
+	}
+	if !providerExists {
+		return errors.Errorf("no IAM Open ID Connect provider is associated with cluster %q in %q", meta.Name, meta.Region)
+	}
+
+	upToDate, err := oidc.CheckThumbprint()
+	if err != nil {
+		return err
+	}
+	if upToDate {
+		logger.Info("thumbprint of IAM Open ID Connect provider for cluster %q in %q is up to date", meta.Name, meta.Region)
+		return nil
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "update thumbprint of IAM Open ID Connect provider for cluster %q in %q", meta.Name, meta.Region)
+	if !cmd.Plan {
+		if err := oidc.UpdateThumbprint(); err != nil {
+			return err
+		}
+		logger.Success("updated thumbprint of IAM Open ID Connect provider for cluster %q in %q", meta.Name, meta.Region)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
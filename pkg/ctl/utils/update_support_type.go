@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateSupportTypeCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-support-type", "Update the support type of a cluster",
+		"STANDARD support upgrades the control plane once the standard support of its Kubernetes version ends, EXTENDED support keeps it on its version at a higher cost")
+
+	var supportType string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateSupportType(cmd, supportType)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&supportType, "support-type", "", "support type of the cluster, STANDARD or EXTENDED")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doUpdateSupportType(cmd *cmdutils.Cmd, supportType string) error {
+	if err := cmdutils.NewUtilsUpdateSupportTypeLoader(cmd, supportType).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	currentSupportType, err := ctl.GetCurrentSupportType(meta.Name)
+	if err != nil {
+		return err
+	}
+	logger.Info("current support type: %s", currentSupportType)
+
+	if currentSupportType == cfg.UpgradePolicy.SupportType {
+		logger.Success("support type of cluster %q in %q is already %s", meta.Name, meta.Region, currentSupportType)
+		return nil
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "update support type of cluster %q in %q to %s", meta.Name, meta.Region, cfg.UpgradePolicy.SupportType)
+	if cfg.UpgradePolicy.SupportType == api.SupportTypeExtended {
+		logger.Warning("clusters in extended support are billed at a higher rate once the standard support of their Kubernetes version ends")
+	}
+
+	if !cmd.Plan {
+		if err := ctl.UpdateSupportType(cfg); err != nil {
+			return err
+		}
+		cmdutils.LogCompletedAction(false, "support type of cluster %q in %q has been updated to %s", meta.Name, meta.Region, cfg.UpgradePolicy.SupportType)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
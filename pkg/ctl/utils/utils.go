@@ -17,6 +17,8 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateLegacySubnetSettings)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableLoggingCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, associateIAMOIDCProviderCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateOIDCThumbprintsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, tempAccessCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, installWindowsVPCController)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateClusterEndpointsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, publicAccessCIDRsCmd)
@@ -24,6 +26,17 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, schemaCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, nodeGroupHealthCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeAddonVersionsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, convertNodeGroupCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createPullThroughCacheCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, checkPrivateConnectivityCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateAlarmsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, waitCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, rightsizeCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, bootstrapAccountCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deployStackSetCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, templateStatsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, checkAMIUpdatesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateSupportTypeCmd)
 
 	return verbCmd
 }
@@ -130,6 +130,78 @@ var _ = Describe("utils", func() {
 			Expect(err.Error()).To(ContainSubstring("usage"))
 		})
 	})
+
+	Describe("convert-nodegroup", func() {
+		It("requires the nodegroup name", func() {
+			cmd := newMockCmd("convert-nodegroup", "--cluster", "foo", "--to", "managed")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--name must be set"))
+		})
+		It("requires a valid nodegroup type", func() {
+			cmd := newMockCmd("convert-nodegroup", "--cluster", "foo", "--name", "ng", "--to", "fargate")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--to must be one of: managed, unmanaged"))
+		})
+		It("requires the cluster name without a config file", func() {
+			cmd := newMockCmd("convert-nodegroup", "--name", "ng", "--to", "managed")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--cluster must be set"))
+		})
+	})
+
+	Describe("wait", func() {
+		It("requires the condition", func() {
+			cmd := newMockCmd("wait", "--cluster", "foo")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--for must be set"))
+		})
+		It("requires a valid condition", func() {
+			cmd := newMockCmd("wait", "--cluster", "foo", "--for", "cluster-ready")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`invalid value "cluster-ready" for --for`))
+		})
+		It("requires the nodegroup name", func() {
+			cmd := newMockCmd("wait", "--cluster", "foo", "--for", "nodegroup-ready")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--name must be set"))
+		})
+		It("does not accept a name when waiting for the cluster", func() {
+			cmd := newMockCmd("wait", "--cluster", "foo", "--for", "cluster-active", "--name", "ng")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--name cannot be used with --for=cluster-active"))
+		})
+	})
+
+	Describe("rightsize", func() {
+		It("requires a valid source", func() {
+			cmd := newMockCmd("rightsize", "--cluster", "foo", "--source", "prometheus")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`invalid value "prometheus" for --source`))
+		})
+		It("requires a valid target utilization", func() {
+			cmd := newMockCmd("rightsize", "--cluster", "foo", "--target-utilization", "1.5")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--target-utilization must be greater than 0 and at most 1"))
+		})
+	})
+
+	Describe("check-ami-updates", func() {
+		It("requires a config file to open a pull request", func() {
+			cmd := newMockCmd("check-ami-updates", "--cluster", "foo", "--open-pr")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--open-pr requires --config-file"))
+		})
+	})
 })
 
 func newMockCmd(args ...string) *mockVerbCmd {
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/wait"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func waitCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var condition, name string
+
+	cmd.SetDescription("wait", "Wait for a condition of a cluster, nodegroup or addon", fmt.Sprintf("Block until the condition is met, failing if it is not met before --timeout. Valid conditions: %s", strings.Join(wait.Conditions(), ", ")))
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return doWait(cmd, condition, name)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		fs.StringVar(&condition, "for", "", fmt.Sprintf("condition to wait for (valid options: %s)", strings.Join(wait.Conditions(), ", ")))
+		fs.StringVarP(&name, "name", "n", "", "name of the nodegroup or addon")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doWait(cmd *cmdutils.Cmd, condition, name string) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet("--cluster")
+	}
+	switch condition {
+	case "":
+		return cmdutils.ErrMustBeSet("--for")
+	case wait.ConditionClusterActive:
+		if name != "" {
+			return cmdutils.NewValidationError(fmt.Errorf("--name cannot be used with --for=%s", condition))
+		}
+	case wait.ConditionNodeGroupReady, wait.ConditionAddonActive:
+		if name == "" {
+			return cmdutils.ErrMustBeSet("--name")
+		}
+	default:
+		return cmdutils.NewValidationError(fmt.Errorf("invalid value %q for --for, valid options: %s", condition, strings.Join(wait.Conditions(), ", ")))
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	clientSet := func() (kubernetes.Interface, error) {
+		if err := ctl.RefreshClusterStatus(cfg); err != nil {
+			return nil, err
+		}
+		return ctl.NewStdClientSet(cfg)
+	}
+	waiter := wait.New(cfg.Metadata.Name, ctl.Provider.EKS(), ctl.NewStackManager(cfg), clientSet, cmd.ProviderConfig.WaitTimeout)
+	return waiter.For(condition, name)
+}
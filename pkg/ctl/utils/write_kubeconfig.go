@@ -2,11 +2,14 @@ package utils
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/clientcmd"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
@@ -21,13 +24,14 @@ func writeKubeconfigCmd(cmd *cmdutils.Cmd) {
 		outputPath           string
 		authenticatorRoleARN string
 		setContext, autoPath bool
+		options              writeKubeconfigOptions
 	)
 
 	cmd.SetDescription("write-kubeconfig", "Write kubeconfig file for a given cluster", "")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doWriteKubeconfigCmd(cmd, outputPath, authenticatorRoleARN, setContext, autoPath)
+		return doWriteKubeconfigCmd(cmd, outputPath, authenticatorRoleARN, setContext, autoPath, options)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -39,16 +43,41 @@ func writeKubeconfigCmd(cmd *cmdutils.Cmd) {
 
 	cmd.FlagSetGroup.InFlagSet("Output kubeconfig", func(fs *pflag.FlagSet) {
 		cmdutils.AddCommonFlagsForKubeconfig(fs, &outputPath, &authenticatorRoleARN, &setContext, &autoPath, "<name>")
+		fs.StringVar(&options.authenticator, "authenticator", "", fmt.Sprintf("command used by kubectl to get a token, one of %s (default: the first one found in PATH)", strings.Join(kubeconfig.AuthenticatorCommands(), ", ")))
+		fs.StringVar(&options.authenticatorProfile, "authenticator-profile", "", "AWS profile used by the authenticator (default: the profile used by eksctl)")
+		fs.StringVar(&options.contextNameTemplate, "context-name-template", "", "Go template for the name of the context, using {{.Username}}, {{.ClusterName}}, {{.Region}} and {{.Cluster}} (default: \"{{.Username}}@{{.Cluster}}\")")
+		fs.StringVar(&options.userNameTemplate, "user-name-template", "", "Go template for the name of the user, using the same fields as --context-name-template (default: the name of the context)")
+		fs.BoolVar(&options.merge, "merge", true, "if false then the kubeconfig is written to stdout instead of being merged into --kubeconfig")
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
-func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, outputPath, roleARN string, setContext, autoPath bool) error {
+type writeKubeconfigOptions struct {
+	authenticator        string
+	authenticatorProfile string
+	contextNameTemplate  string
+	userNameTemplate     string
+	merge                bool
+}
+
+func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, outputPath, roleARN string, setContext, autoPath bool, options writeKubeconfigOptions) error {
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
 
+	if options.authenticator != "" && !isAuthenticatorCommand(options.authenticator) {
+		return fmt.Errorf("invalid value %q for --authenticator, must be one of %s", options.authenticator, strings.Join(kubeconfig.AuthenticatorCommands(), ", "))
+	}
+
+	if !options.merge {
+		if autoPath || cmd.CobraCommand.Flags().Changed("kubeconfig") {
+			return fmt.Errorf("--merge=false and --kubeconfig/--auto-kubeconfig %s", cmdutils.IncompatibleFlags)
+		}
+		// keep stdout for the kubeconfig
+		logger.Writer = os.Stderr
+	}
+
 	cfg := cmd.ClusterConfig
 
 	// TODO: move this into a loader when --config-file gets added to this command
@@ -81,7 +110,31 @@ func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, outputPath, roleARN string, setCont
 		return err
 	}
 
-	kubectlConfig := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), roleARN, ctl.Provider.Profile())
+	authenticator := options.authenticator
+	if authenticator == "" {
+		authenticator = kubeconfig.DefaultAuthenticator()
+	}
+	profile := options.authenticatorProfile
+	if profile == "" {
+		profile = ctl.Provider.Profile()
+	}
+	kubectlConfig := kubeconfig.NewForUser(cfg, ctl.GetUsername())
+	kubeconfig.AppendAuthenticator(kubectlConfig, cfg.Metadata, authenticator, roleARN, profile)
+
+	nameData := kubeconfig.NewNameTemplateData(cfg.Metadata, ctl.GetUsername())
+	if err := kubeconfig.RenameCurrentContext(kubectlConfig, options.contextNameTemplate, options.userNameTemplate, nameData); err != nil {
+		return err
+	}
+
+	if !options.merge {
+		data, err := clientcmd.Write(*kubectlConfig)
+		if err != nil {
+			return errors.Wrap(err, "serializing kubeconfig")
+		}
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
 	filename, err := kubeconfig.Write(outputPath, *kubectlConfig, setContext)
 	if err != nil {
 		return errors.Wrap(err, "writing kubeconfig")
@@ -91,3 +144,12 @@ func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, outputPath, roleARN string, setCont
 
 	return nil
 }
+
+func isAuthenticatorCommand(authenticator string) bool {
+	for _, command := range kubeconfig.AuthenticatorCommands() {
+		if authenticator == command {
+			return true
+		}
+	}
+	return false
+}
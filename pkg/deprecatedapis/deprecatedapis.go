@@ -0,0 +1,118 @@
+package deprecatedapis
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+// deprecatedAPIsMetric is the gauge set by the API server for every deprecated API that has been requested
+// since it started
+const deprecatedAPIsMetric = "apiserver_requested_deprecated_apis"
+
+// Finding is a deprecated API that is in use in the cluster
+type Finding struct {
+	Group          string
+	Version        string
+	Resource       string
+	Subresource    string
+	RemovedRelease string
+}
+
+// API returns the group, version and resource of the deprecated API, e.g. extensions/v1beta1/ingresses
+func (f Finding) API() string {
+	api := f.Version + "/" + f.Resource
+	if f.Group != "" {
+		api = f.Group + "/" + api
+	}
+	if f.Subresource != "" {
+		api += "/" + f.Subresource
+	}
+	return api
+}
+
+// BlocksUpgradeTo returns true when the deprecated API is removed in the given Kubernetes version or earlier
+func (f Finding) BlocksUpgradeTo(version string) (bool, error) {
+	if f.RemovedRelease == "" {
+		return false, nil
+	}
+	c, err := utils.CompareVersions(f.RemovedRelease, version)
+	if err != nil {
+		return false, err
+	}
+	return c <= 0, nil
+}
+
+// Get fetches the metrics of the API server and returns the deprecated APIs in use in the cluster
+func Get(ctx context.Context, clientSet kubernetes.Interface) ([]Finding, error) {
+	metrics, err := clientSet.Discovery().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching API server metrics")
+	}
+	return ParseDeprecatedAPIs(bytes.NewReader(metrics))
+}
+
+// ParseDeprecatedAPIs returns the deprecated APIs reported in the given API server metrics, in the Prometheus
+// text format
+func ParseDeprecatedAPIs(metrics io.Reader) ([]Finding, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(metrics)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing API server metrics")
+	}
+
+	family, ok := families[deprecatedAPIsMetric]
+	if !ok {
+		return nil, nil
+	}
+
+	var findings []Finding
+	for _, m := range family.GetMetric() {
+		if m.GetGauge().GetValue() == 0 {
+			continue
+		}
+		labels := labelValues(m)
+		findings = append(findings, Finding{
+			Group:          labels["group"],
+			Version:        labels["version"],
+			Resource:       labels["resource"],
+			Subresource:    labels["subresource"],
+			RemovedRelease: labels["removed_release"],
+		})
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		return findings[i].API() < findings[j].API()
+	})
+	return findings, nil
+}
+
+// BlockingUpgradeTo returns the findings of deprecated APIs removed in the given Kubernetes version or earlier
+func BlockingUpgradeTo(findings []Finding, version string) ([]Finding, error) {
+	var blocking []Finding
+	for _, f := range findings {
+		blocks, err := f.BlocksUpgradeTo(version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid removed release for %s", f.API())
+		}
+		if blocks {
+			blocking = append(blocking, f)
+		}
+	}
+	return blocking, nil
+}
+
+func labelValues(m *dto.Metric) map[string]string {
+	labels := make(map[string]string)
+	for _, l := range m.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	return labels
+}
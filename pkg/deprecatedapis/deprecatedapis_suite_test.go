@@ -0,0 +1,11 @@
+package deprecatedapis_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestDeprecatedAPIs(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,77 @@
+package deprecatedapis_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/deprecatedapis"
+)
+
+const metrics = `# HELP apiserver_requested_deprecated_apis [ALPHA] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="extensions",removed_release="1.22",resource="ingresses",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="policy",removed_release="1.25",resource="podsecuritypolicies",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="batch",removed_release="1.25",resource="cronjobs",subresource="status",version="v1beta1"} 0
+# HELP apiserver_request_total [STABLE] Counter of apiserver requests.
+# TYPE apiserver_request_total counter
+apiserver_request_total{code="200",verb="GET"} 42
+`
+
+var _ = Describe("Insights", func() {
+	Describe("ParseDeprecatedAPIs", func() {
+		It("returns the deprecated APIs in use", func() {
+			findings, err := deprecatedapis.ParseDeprecatedAPIs(strings.NewReader(metrics))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findings).To(Equal([]deprecatedapis.Finding{
+				{
+					Group:          "extensions",
+					Version:        "v1beta1",
+					Resource:       "ingresses",
+					RemovedRelease: "1.22",
+				},
+				{
+					Group:          "policy",
+					Version:        "v1beta1",
+					Resource:       "podsecuritypolicies",
+					RemovedRelease: "1.25",
+				},
+			}))
+			Expect(findings[0].API()).To(Equal("extensions/v1beta1/ingresses"))
+		})
+
+		It("returns no findings when no deprecated API has been requested", func() {
+			findings, err := deprecatedapis.ParseDeprecatedAPIs(strings.NewReader("apiserver_request_total 1\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findings).To(BeEmpty())
+		})
+
+		It("fails on invalid metrics", func() {
+			_, err := deprecatedapis.ParseDeprecatedAPIs(strings.NewReader("not metrics{"))
+			Expect(err).To(MatchError(ContainSubstring("parsing API server metrics")))
+		})
+	})
+
+	Describe("BlockingUpgradeTo", func() {
+		It("returns the APIs removed in the target version or earlier", func() {
+			findings, err := deprecatedapis.ParseDeprecatedAPIs(strings.NewReader(metrics))
+			Expect(err).NotTo(HaveOccurred())
+
+			blocking, err := deprecatedapis.BlockingUpgradeTo(findings, "1.21")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blocking).To(BeEmpty())
+
+			blocking, err = deprecatedapis.BlockingUpgradeTo(findings, "1.22")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blocking).To(HaveLen(1))
+			Expect(blocking[0].Resource).To(Equal("ingresses"))
+		})
+
+		It("ignores APIs without a removed release", func() {
+			blocking, err := deprecatedapis.BlockingUpgradeTo([]deprecatedapis.Finding{{Version: "v1", Resource: "foos"}}, "1.22")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blocking).To(BeEmpty())
+		})
+	})
+})
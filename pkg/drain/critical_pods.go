@@ -0,0 +1,98 @@
+package drain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultCriticalLabel is the label or annotation marking pods as critical by default
+const DefaultCriticalLabel = "eksctl.io/critical=true"
+
+// CriticalPodSelector selects the pods which must not be removed along with their nodes without confirmation
+type CriticalPodSelector struct {
+	// Label is a label or annotation marking pods as critical, either as key=value, or as a key
+	// matching any value
+	Label string
+	// Namespaces are namespaces all pods of which are critical
+	Namespaces []string
+}
+
+// CriticalPod is a pod selected by a CriticalPodSelector
+type CriticalPod struct {
+	Namespace string
+	Name      string
+	NodeName  string
+	// Reason is why the pod is critical
+	Reason string
+}
+
+// FindCriticalPods returns the pods running on nodes that are selected by selector. Pods of DaemonSets, which are
+// not removed from the cluster along with the nodes, and pods that have completed are ignored
+func FindCriticalPods(clientSet kubernetes.Interface, nodes []corev1.Node, selector CriticalPodSelector) ([]CriticalPod, error) {
+	key, value, hasValue := parseCriticalLabel(selector.Label)
+	namespaces := sets.NewString(selector.Namespaces...)
+
+	nodeNames := sets.NewString()
+	for _, node := range nodes {
+		nodeNames.Insert(node.Name)
+	}
+
+	pods, err := clientSet.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing pods")
+	}
+
+	var critical []CriticalPod
+	for _, pod := range pods.Items {
+		if !nodeNames.Has(pod.Spec.NodeName) || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed || isDaemonSetPod(pod) {
+			continue
+		}
+		var reason string
+		switch {
+		case key != "" && matchesCriticalLabel(pod.Labels, key, value, hasValue):
+			reason = fmt.Sprintf("has label %q", selector.Label)
+		case key != "" && matchesCriticalLabel(pod.Annotations, key, value, hasValue):
+			reason = fmt.Sprintf("has annotation %q", selector.Label)
+		case namespaces.Has(pod.Namespace):
+			reason = fmt.Sprintf("runs in protected namespace %q", pod.Namespace)
+		default:
+			continue
+		}
+		critical = append(critical, CriticalPod{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			NodeName:  pod.Spec.NodeName,
+			Reason:    reason,
+		})
+	}
+	return critical, nil
+}
+
+func parseCriticalLabel(label string) (key, value string, hasValue bool) {
+	parts := strings.SplitN(label, "=", 2)
+	if len(parts) == 2 {
+		return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), true
+	}
+	return strings.TrimSpace(label), "", false
+}
+
+func matchesCriticalLabel(labels map[string]string, key, value string, hasValue bool) bool {
+	v, ok := labels[key]
+	return ok && (!hasValue || v == value)
+}
+
+func isDaemonSetPod(pod corev1.Pod) bool {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package drain_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/drain"
+)
+
+var _ = Describe("FindCriticalPods", func() {
+	newPod := func(namespace, name, nodeName string, labels, annotations map[string]string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels, Annotations: annotations},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	nodes := []corev1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "deleted"}}}
+
+	It("returns the pods on the nodes with the critical label or annotation, or in protected namespaces", func() {
+		critical := map[string]string{"eksctl.io/critical": "true"}
+
+		daemonSetPod := newPod("payments", "agent", "deleted", critical, nil)
+		daemonSetPod.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "agent"}}
+		completedPod := newPod("default", "job", "deleted", critical, nil)
+		completedPod.Status.Phase = corev1.PodSucceeded
+
+		clientSet := fake.NewSimpleClientset([]runtime.Object{
+			newPod("default", "labelled", "deleted", critical, nil),
+			newPod("default", "annotated", "deleted", nil, critical),
+			newPod("default", "not-critical", "deleted", map[string]string{"eksctl.io/critical": "false"}, nil),
+			newPod("default", "other-node", "kept", critical, nil),
+			newPod("payments", "api", "deleted", nil, nil),
+			daemonSetPod,
+			completedPod,
+		}...)
+
+		pods, err := drain.FindCriticalPods(clientSet, nodes, drain.CriticalPodSelector{
+			Label:      drain.DefaultCriticalLabel,
+			Namespaces: []string{"payments"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pods).To(ConsistOf(
+			drain.CriticalPod{Namespace: "default", Name: "labelled", NodeName: "deleted", Reason: `has label "eksctl.io/critical=true"`},
+			drain.CriticalPod{Namespace: "default", Name: "annotated", NodeName: "deleted", Reason: `has annotation "eksctl.io/critical=true"`},
+			drain.CriticalPod{Namespace: "payments", Name: "api", NodeName: "deleted", Reason: `runs in protected namespace "payments"`},
+		))
+	})
+
+	It("matches any value when the label is a key", func() {
+		clientSet := fake.NewSimpleClientset(
+			newPod("default", "database", "deleted", map[string]string{"stateful": "postgres"}, nil),
+		)
+
+		pods, err := drain.FindCriticalPods(clientSet, nodes, drain.CriticalPodSelector{Label: "stateful"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pods).To(HaveLen(1))
+		Expect(pods[0].Name).To(Equal("database"))
+	})
+
+	It("returns nothing when no pods are critical", func() {
+		clientSet := fake.NewSimpleClientset(newPod("default", "web", "deleted", nil, nil))
+
+		pods, err := drain.FindCriticalPods(clientSet, nodes, drain.CriticalPodSelector{Label: drain.DefaultCriticalLabel})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pods).To(BeEmpty())
+	})
+})
@@ -129,7 +129,7 @@ func (d *Evictor) EvictOrDeletePod(pod corev1.Pod) error {
 	if d.UseEvictions {
 		return d.evictPod(pod)
 	}
-	return d.deletePod(pod)
+	return d.DeletePod(pod)
 }
 
 // evictPod will evict the give Pod, or return an error if it couldn't
@@ -150,8 +150,8 @@ func (d *Evictor) evictPod(pod corev1.Pod) error {
 	return d.client.PolicyV1beta1().Evictions(eviction.Namespace).Evict(context.TODO(), eviction)
 }
 
-// deletePod will Delete the given Pod, or return an error if it couldn't
-func (d *Evictor) deletePod(pod corev1.Pod) error {
+// DeletePod will Delete the given Pod, bypassing PodDisruptionBudgets, or return an error if it couldn't
+func (d *Evictor) DeletePod(pod corev1.Pod) error {
 	return d.client.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, d.makeDeleteOptions(pod))
 }
 
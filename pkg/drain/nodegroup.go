@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/weaveworks/eksctl/pkg/drain/evictor"
@@ -30,6 +31,7 @@ const retryDelay = 5 * time.Second
 type Evictor interface {
 	CanUseEvictions() error
 	EvictOrDeletePod(pod corev1.Pod) error
+	DeletePod(pod corev1.Pod) error
 	GetPodsForEviction(nodeName string) (*evictor.PodDeleteList, []error)
 }
 
@@ -40,9 +42,14 @@ type NodeGroupDrainer struct {
 	waitTimeout         time.Duration
 	nodeDrainWaitPeriod time.Duration
 	undo                bool
+	disableEviction     bool
+	// forceAfter is how long after the drain started pods whose eviction is blocked by
+	// PodDisruptionBudgets are deleted, 0 means never
+	forceAfter time.Duration
+	started    time.Time
 }
 
-func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, waitTimeout, maxGracePeriod, nodeDrainWaitPeriod time.Duration, undo, disableEviction bool) NodeGroupDrainer {
+func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, waitTimeout, maxGracePeriod, nodeDrainWaitPeriod time.Duration, undo, disableEviction bool, forceAfter time.Duration) NodeGroupDrainer {
 	ignoreDaemonSets := []metav1.ObjectMeta{
 		{
 			Namespace: "kube-system",
@@ -76,6 +83,8 @@ func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, w
 		waitTimeout:         waitTimeout,
 		nodeDrainWaitPeriod: nodeDrainWaitPeriod,
 		undo:                undo,
+		disableEviction:     disableEviction,
+		forceAfter:          forceAfter,
 	}
 }
 
@@ -101,6 +110,11 @@ func (n *NodeGroupDrainer) Drain() error {
 		return nil // no need to kill any pods
 	}
 
+	if !n.disableEviction {
+		n.logPodDisruptionBudgetReport(nodes.Items)
+	}
+	n.started = time.Now()
+
 	drainedNodes := sets.NewString()
 	// loop until all nodes are drained to handle accidental scale-up
 	// or any other changes in the ASG
@@ -187,7 +201,13 @@ func (n *NodeGroupDrainer) evictPods(node string) (int, error) {
 	pending := len(pods)
 	for _, pod := range pods {
 		// TODO: handle API rate limiter error
-		if err := n.evictor.EvictOrDeletePod(pod); err != nil {
+		err := n.evictor.EvictOrDeletePod(pod)
+		if apierrors.IsTooManyRequests(err) && n.forceAfter > 0 && time.Since(n.started) >= n.forceAfter {
+			// evictions blocked by PodDisruptionBudgets are rejected with 429 Too Many Requests
+			logger.Warning("deleting pod %s/%s as its eviction is still blocked by a PodDisruptionBudget after %s", pod.Namespace, pod.Name, n.forceAfter)
+			err = n.evictor.DeletePod(pod)
+		}
+		if err != nil {
 			return pending, errors.Wrapf(err, "error evicting pod: %s/%s", pod.Namespace, pod.Name)
 		}
 	}
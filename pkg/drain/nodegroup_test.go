@@ -6,6 +6,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/weaveworks/eksctl/pkg/drain/evictor"
 
@@ -73,7 +74,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("does not error", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second*10, time.Second, false, false)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second*10, time.Second, false, false, 0)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
@@ -117,7 +118,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("times out and errors", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*2, time.Second*0, time.Second, false, false)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*2, time.Second*0, time.Second, false, false, 0)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
@@ -131,7 +132,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("errors", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, time.Second, false, false)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, time.Second, false, false, 0)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
@@ -175,7 +176,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("does not error", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, time.Second, false, true)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, time.Second, false, true, 0)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
@@ -191,6 +192,51 @@ var _ = Describe("Drain", func() {
 		})
 	})
 
+	When("evictions are blocked by a PodDisruptionBudget", func() {
+		var pod corev1.Pod
+
+		BeforeEach(func() {
+			pod = corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pod-1",
+				},
+			}
+
+			fakeEvictor.GetPodsForEvictionReturnsOnCall(0, &evictor.PodDeleteList{
+				Items: []evictor.PodDelete{
+					{
+						Pod: pod,
+						Status: evictor.PodDeleteStatus{
+							Delete: true,
+						},
+					},
+				},
+			}, nil)
+			fakeEvictor.GetPodsForEvictionReturnsOnCall(1, &evictor.PodDeleteList{}, nil)
+
+			fakeEvictor.EvictOrDeletePodReturns(apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10))
+
+			_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("deletes the pods once forceAfter has elapsed", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, time.Second, false, false, time.Nanosecond)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			err := nodeGroupDrainer.Drain()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeEvictor.EvictOrDeletePodCallCount()).To(Equal(1))
+			Expect(fakeEvictor.DeletePodCallCount()).To(Equal(1))
+			Expect(fakeEvictor.DeletePodArgsForCall(0)).To(Equal(pod))
+		})
+	})
+
 	When("undo is true", func() {
 		BeforeEach(func() {
 			_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
@@ -205,7 +251,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("uncordons all the nodes", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, time.Second, true, false)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, time.Second, true, false, 0)
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain()
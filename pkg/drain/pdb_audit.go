@@ -0,0 +1,120 @@
+package drain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BlockingPodDisruptionBudget is a PodDisruptionBudget that will block the eviction of pods on drained nodes
+type BlockingPodDisruptionBudget struct {
+	Namespace string
+	Name      string
+	// Reason is why no pod selected by the PodDisruptionBudget can be evicted
+	Reason string
+	// Pods are the pods on the drained nodes selected by the PodDisruptionBudget
+	Pods []string
+}
+
+// AuditPodDisruptionBudgets returns the PodDisruptionBudgets that select pods running on nodes and will block their
+// eviction: those with a maxUnavailable of 0, a minAvailable not less than the number of pods they select, e.g. for
+// singleton deployments, or that currently allow no disruptions
+func AuditPodDisruptionBudgets(clientSet kubernetes.Interface, nodes []corev1.Node) ([]BlockingPodDisruptionBudget, error) {
+	nodeNames := sets.NewString()
+	for _, node := range nodes {
+		nodeNames.Insert(node.Name)
+	}
+
+	// policy/v1 is only served from Kubernetes 1.21
+	pdbs, err := clientSet.PolicyV1beta1().PodDisruptionBudgets(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing PodDisruptionBudgets")
+	}
+
+	var blocking []BlockingPodDisruptionBudget
+	for _, pdb := range pdbs.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			logger.Debug("skipping PodDisruptionBudget %s/%s with invalid selector: %v", pdb.Namespace, pdb.Name, err)
+			continue
+		}
+		pods, err := clientSet.CoreV1().Pods(pdb.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing pods selected by PodDisruptionBudget %s/%s", pdb.Namespace, pdb.Name)
+		}
+
+		var drainedPods []string
+		running := 0
+		for _, pod := range pods.Items {
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			running++
+			if nodeNames.Has(pod.Spec.NodeName) {
+				drainedPods = append(drainedPods, pod.Name)
+			}
+		}
+		if len(drainedPods) == 0 {
+			continue
+		}
+
+		if reason := blockingReason(pdb, running); reason != "" {
+			blocking = append(blocking, BlockingPodDisruptionBudget{
+				Namespace: pdb.Namespace,
+				Name:      pdb.Name,
+				Reason:    reason,
+				Pods:      drainedPods,
+			})
+		}
+	}
+	return blocking, nil
+}
+
+func blockingReason(pdb policyv1beta1.PodDisruptionBudget, pods int) string {
+	spec := pdb.Spec
+	if spec.MaxUnavailable != nil {
+		if maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(spec.MaxUnavailable, pods, true); err == nil && maxUnavailable == 0 {
+			return fmt.Sprintf("maxUnavailable is %s", spec.MaxUnavailable.String())
+		}
+	}
+	if spec.MinAvailable != nil {
+		if minAvailable, err := intstr.GetScaledValueFromIntOrPercent(spec.MinAvailable, pods, true); err == nil && minAvailable >= pods {
+			return fmt.Sprintf("minAvailable %s is not less than the %d pod(s) it selects", spec.MinAvailable.String(), pods)
+		}
+	}
+	if pdb.Status.ExpectedPods > 0 && pdb.Status.DisruptionsAllowed == 0 {
+		return fmt.Sprintf("no disruptions are currently allowed, %d of %d pods are healthy", pdb.Status.CurrentHealthy, pdb.Status.ExpectedPods)
+	}
+	return ""
+}
+
+// logPodDisruptionBudgetReport warns about the PodDisruptionBudgets blocking the drain of the nodegroup and how to
+// proceed, so that drains do not stall silently until they time out
+func (n *NodeGroupDrainer) logPodDisruptionBudgetReport(nodes []corev1.Node) {
+	blocking, err := AuditPodDisruptionBudgets(n.clientSet, nodes)
+	if err != nil {
+		logger.Warning("unable to audit PodDisruptionBudgets before draining nodegroup %q: %v", n.ng.NameString(), err)
+		return
+	}
+	if len(blocking) == 0 {
+		return
+	}
+
+	for _, pdb := range blocking {
+		logger.Warning("PodDisruptionBudget %s/%s will block the eviction of %d pod(s) from nodegroup %q: %s", pdb.Namespace, pdb.Name, len(pdb.Pods), n.ng.NameString(), pdb.Reason)
+	}
+	if n.forceAfter > 0 {
+		logger.Warning("pods whose eviction is still blocked %s after the drain started will be deleted", n.forceAfter)
+		return
+	}
+	logger.Warning("the drain of nodegroup %q will time out after %s unless these pods can be evicted; "+
+		"use --force-after to delete pods still blocked after a given time, or --disable-eviction to bypass PodDisruptionBudgets altogether", n.ng.NameString(), n.waitTimeout)
+}
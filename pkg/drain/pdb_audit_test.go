@@ -0,0 +1,104 @@
+package drain_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/drain"
+)
+
+var _ = Describe("AuditPodDisruptionBudgets", func() {
+	newPod := func(name, app, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": app}},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	newPDB := func(app string, spec policyv1beta1.PodDisruptionBudgetSpec, status policyv1beta1.PodDisruptionBudgetStatus) *policyv1beta1.PodDisruptionBudget {
+		spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}}
+		return &policyv1beta1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: app, Namespace: "default"},
+			Spec:       spec,
+			Status:     status,
+		}
+	}
+
+	intOrString := func(v intstr.IntOrString) *intstr.IntOrString {
+		return &v
+	}
+
+	drainedNodes := []corev1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "drained"}}}
+
+	It("reports the PodDisruptionBudgets blocking the eviction of pods on the drained nodes", func() {
+		objects := []runtime.Object{
+			newPod("zero-1", "zero", "drained"),
+			newPDB("zero", policyv1beta1.PodDisruptionBudgetSpec{MaxUnavailable: intOrString(intstr.FromInt(0))}, policyv1beta1.PodDisruptionBudgetStatus{}),
+
+			newPod("singleton-1", "singleton", "drained"),
+			newPDB("singleton", policyv1beta1.PodDisruptionBudgetSpec{MinAvailable: intOrString(intstr.FromInt(1))}, policyv1beta1.PodDisruptionBudgetStatus{}),
+
+			newPod("unhealthy-1", "unhealthy", "drained"),
+			newPod("unhealthy-2", "unhealthy", "other"),
+			newPDB("unhealthy", policyv1beta1.PodDisruptionBudgetSpec{MaxUnavailable: intOrString(intstr.FromInt(1))}, policyv1beta1.PodDisruptionBudgetStatus{
+				ExpectedPods:       2,
+				CurrentHealthy:     1,
+				DisruptionsAllowed: 0,
+			}),
+
+			newPod("replicated-1", "replicated", "drained"),
+			newPod("replicated-2", "replicated", "other"),
+			newPDB("replicated", policyv1beta1.PodDisruptionBudgetSpec{MinAvailable: intOrString(intstr.FromString("50%"))}, policyv1beta1.PodDisruptionBudgetStatus{
+				ExpectedPods:       2,
+				CurrentHealthy:     2,
+				DisruptionsAllowed: 1,
+			}),
+
+			newPod("elsewhere-1", "elsewhere", "other"),
+			newPDB("elsewhere", policyv1beta1.PodDisruptionBudgetSpec{MaxUnavailable: intOrString(intstr.FromInt(0))}, policyv1beta1.PodDisruptionBudgetStatus{}),
+		}
+
+		blocking, err := drain.AuditPodDisruptionBudgets(fake.NewSimpleClientset(objects...), drainedNodes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(blocking).To(ConsistOf(
+			drain.BlockingPodDisruptionBudget{
+				Namespace: "default",
+				Name:      "zero",
+				Reason:    "maxUnavailable is 0",
+				Pods:      []string{"zero-1"},
+			},
+			drain.BlockingPodDisruptionBudget{
+				Namespace: "default",
+				Name:      "singleton",
+				Reason:    "minAvailable 1 is not less than the 1 pod(s) it selects",
+				Pods:      []string{"singleton-1"},
+			},
+			drain.BlockingPodDisruptionBudget{
+				Namespace: "default",
+				Name:      "unhealthy",
+				Reason:    "no disruptions are currently allowed, 1 of 2 pods are healthy",
+				Pods:      []string{"unhealthy-1"},
+			},
+		))
+	})
+
+	It("treats percentages like the disruption controller", func() {
+		objects := []runtime.Object{
+			newPod("all-1", "all", "drained"),
+			newPod("all-2", "all", "other"),
+			newPDB("all", policyv1beta1.PodDisruptionBudgetSpec{MinAvailable: intOrString(intstr.FromString("100%"))}, policyv1beta1.PodDisruptionBudgetStatus{}),
+		}
+
+		blocking, err := drain.AuditPodDisruptionBudgets(fake.NewSimpleClientset(objects...), drainedNodes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(blocking).To(HaveLen(1))
+		Expect(blocking[0].Reason).To(Equal("minAvailable 100% is not less than the 2 pod(s) it selects"))
+	})
+})
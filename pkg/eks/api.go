@@ -1,19 +1,33 @@
 package eks
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"time"
 
+	stsv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
+	"github.com/aws/aws-sdk-go/service/savingsplans/savingsplansiface"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -33,6 +47,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -45,11 +61,14 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/ami"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awscache"
 	"github.com/weaveworks/eksctl/pkg/az"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	ekscreds "github.com/weaveworks/eksctl/pkg/credentials"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/utils/file"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -59,6 +78,9 @@ type ClusterProvider struct {
 	Provider api.ClusterProvider
 	// informative fields, i.e. used as outputs
 	Status *ProviderStatus
+	// ChangeSetApprover decides whether the change sets of the stack updates of the stack managers of the provider
+	// are executed, manager.DefaultChangeSetApprover if nil
+	ChangeSetApprover manager.ChangeSetApprover
 }
 
 //counterfeiter:generate -o fakes/fake_kube_provider.go . KubeProvider
@@ -73,6 +95,10 @@ type KubeProvider interface {
 	WaitForNodes(clientSet kubernetes.Interface, ng KubeNodeGroup) error
 }
 
+// AssumeRoleTokenProvider provides the MFA tokens required to assume the roles of AWS profiles, by default
+// prompting for them on stdin
+var AssumeRoleTokenProvider = stscreds.StdinTokenProvider
+
 // ProviderServices stores the used APIs
 type ProviderServices struct {
 	spec  *api.ProviderConfig
@@ -88,6 +114,14 @@ type ProviderServices struct {
 
 	cloudtrail     cloudtrailiface.CloudTrailAPI
 	cloudwatchlogs cloudwatchlogsiface.CloudWatchLogsAPI
+	cloudwatch     cloudwatchiface.CloudWatchAPI
+	ecr            ecriface.ECRAPI
+	eventbridge    eventbridgeiface.EventBridgeAPI
+	s3             s3iface.S3API
+	kms            kmsiface.KMSAPI
+	savingsplans   savingsplansiface.SavingsPlansAPI
+
+	*ServicesV2
 
 	session *session.Session
 }
@@ -103,6 +137,11 @@ func (p ProviderServices) CloudFormationDisableRollback() bool {
 	return p.spec.CloudFormationDisableRollback
 }
 
+// CloudFormationTemplateBucket returns, if any, the S3 bucket templates too large to be passed inline are uploaded to
+func (p ProviderServices) CloudFormationTemplateBucket() string {
+	return p.spec.CloudFormationTemplateBucket
+}
+
 // ASG returns a representation of the AutoScaling API
 func (p ProviderServices) ASG() autoscalingiface.AutoScalingAPI { return p.asg }
 
@@ -135,6 +174,24 @@ func (p ProviderServices) CloudWatchLogs() cloudwatchlogsiface.CloudWatchLogsAPI
 	return p.cloudwatchlogs
 }
 
+// CloudWatch returns a representation of the CloudWatch API
+func (p ProviderServices) CloudWatch() cloudwatchiface.CloudWatchAPI { return p.cloudwatch }
+
+// ECR returns a representation of the ECR API
+func (p ProviderServices) ECR() ecriface.ECRAPI { return p.ecr }
+
+// EventBridge returns a representation of the EventBridge API
+func (p ProviderServices) EventBridge() eventbridgeiface.EventBridgeAPI { return p.eventbridge }
+
+// S3 returns a representation of the S3 API
+func (p ProviderServices) S3() s3iface.S3API { return p.s3 }
+
+// KMS returns a representation of the KMS API
+func (p ProviderServices) KMS() kmsiface.KMSAPI { return p.kms }
+
+// SavingsPlans returns a representation of the Savings Plans API
+func (p ProviderServices) SavingsPlans() savingsplansiface.SavingsPlansAPI { return p.savingsplans }
+
 // Region returns provider-level region setting
 func (p ProviderServices) Region() string { return p.spec.Region }
 
@@ -180,14 +237,31 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) (*ClusterProv
 		}
 	}
 
-	provider.session = s
-	provider.asg = autoscaling.New(s)
-	provider.cfn = cloudformation.New(s)
-	provider.eks = awseks.New(s)
-	provider.ec2 = ec2.New(s)
-	provider.elb = elb.New(s)
-	provider.elbv2 = elbv2.New(s)
-	provider.sts = sts.New(s,
+	provider.setSession(s)
+
+	c.Status = &ProviderStatus{
+		sessionCreds: s.Config.Credentials,
+	}
+
+	if clusterSpec != nil {
+		clusterSpec.Metadata.Region = c.Provider.Region()
+	}
+
+	return c, c.checkAuth()
+}
+
+// setSession creates the AWS APIs using the given session, overriding their endpoints if any custom
+// endpoints are specified
+func (p *ProviderServices) setSession(s *session.Session) {
+	p.session = s
+	p.ServicesV2 = newServicesV2(newV2Config(s))
+	p.asg = autoscaling.New(s)
+	p.cfn = cloudformation.New(s)
+	p.eks = awseks.New(s)
+	p.ec2 = ec2.New(s)
+	p.elb = elb.New(s)
+	p.elbv2 = elbv2.New(s)
+	p.sts = sts.New(s,
 		// STS retrier has to be disabled, as it's not very helpful
 		// (see https://github.com/weaveworks/eksctl/issues/705)
 		request.WithRetryer(s.Config.Copy(),
@@ -196,57 +270,75 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) (*ClusterProv
 			},
 		),
 	)
-	provider.ssm = ssm.New(s)
-	provider.iam = iam.New(s)
-	provider.cloudtrail = cloudtrail.New(s)
-	provider.cloudwatchlogs = cloudwatchlogs.New(s)
-
-	c.Status = &ProviderStatus{
-		sessionCreds: s.Config.Credentials,
-	}
+	p.ssm = ssm.New(s)
+	p.iam = iam.New(s)
+	p.cloudtrail = cloudtrail.New(s)
+	p.cloudwatchlogs = cloudwatchlogs.New(s)
+	p.cloudwatch = cloudwatch.New(s)
+	p.ecr = ecr.New(s)
+	p.eventbridge = eventbridge.New(s)
+	p.s3 = s3.New(s)
+	p.kms = kms.New(s)
+	p.savingsplans = savingsplans.New(s)
 
 	// override sessions if any custom endpoints specified
 	if endpoint, ok := os.LookupEnv("AWS_CLOUDFORMATION_ENDPOINT"); ok {
 		logger.Debug("Setting CloudFormation endpoint to %s", endpoint)
-		provider.cfn = cloudformation.New(s, s.Config.Copy().WithEndpoint(endpoint))
+		p.cfn = cloudformation.New(s, s.Config.Copy().WithEndpoint(endpoint))
 	}
 	if endpoint, ok := os.LookupEnv("AWS_EKS_ENDPOINT"); ok {
 		logger.Debug("Setting EKS endpoint to %s", endpoint)
-		provider.eks = awseks.New(s, s.Config.Copy().WithEndpoint(endpoint))
+		p.eks = awseks.New(s, s.Config.Copy().WithEndpoint(endpoint))
 	}
 	if endpoint, ok := os.LookupEnv("AWS_EC2_ENDPOINT"); ok {
 		logger.Debug("Setting EC2 endpoint to %s", endpoint)
-		provider.ec2 = ec2.New(s, s.Config.Copy().WithEndpoint(endpoint))
+		p.ec2 = ec2.New(s, s.Config.Copy().WithEndpoint(endpoint))
 
 	}
 	if endpoint, ok := os.LookupEnv("AWS_ELB_ENDPOINT"); ok {
 		logger.Debug("Setting ELB endpoint to %s", endpoint)
-		provider.elb = elb.New(s, s.Config.Copy().WithEndpoint(endpoint))
+		p.elb = elb.New(s, s.Config.Copy().WithEndpoint(endpoint))
 
 	}
 	if endpoint, ok := os.LookupEnv("AWS_ELBV2_ENDPOINT"); ok {
 		logger.Debug("Setting ELBV2 endpoint to %s", endpoint)
-		provider.elbv2 = elbv2.New(s, s.Config.Copy().WithEndpoint(endpoint))
+		p.elbv2 = elbv2.New(s, s.Config.Copy().WithEndpoint(endpoint))
 
 	}
 	if endpoint, ok := os.LookupEnv("AWS_STS_ENDPOINT"); ok {
 		logger.Debug("Setting STS endpoint to %s", endpoint)
-		provider.sts = sts.New(s, s.Config.Copy().WithEndpoint(endpoint))
+		p.sts = sts.New(s, s.Config.Copy().WithEndpoint(endpoint))
 	}
 	if endpoint, ok := os.LookupEnv("AWS_IAM_ENDPOINT"); ok {
 		logger.Debug("Setting IAM endpoint to %s", endpoint)
-		provider.iam = iam.New(s, s.Config.Copy().WithEndpoint(endpoint))
+		p.iam = iam.New(s, s.Config.Copy().WithEndpoint(endpoint))
 	}
 	if endpoint, ok := os.LookupEnv("AWS_CLOUDTRAIL_ENDPOINT"); ok {
 		logger.Debug("Setting CloudTrail endpoint to %s", endpoint)
-		provider.cloudtrail = cloudtrail.New(s, s.Config.Copy().WithEndpoint(endpoint))
+		p.cloudtrail = cloudtrail.New(s, s.Config.Copy().WithEndpoint(endpoint))
 	}
 
-	if clusterSpec != nil {
-		clusterSpec.Metadata.Region = c.Provider.Region()
-	}
+	// each provider has its own cache, so that responses are not kept across the commands of long-running processes
+	// such as eksctl serve and eksctl controller
+	p.ec2 = awscache.NewEC2(p.ec2, awscache.NewFromEnv(), aws.StringValue(s.Config.Region), s.Config.Credentials)
+}
 
-	return c, c.checkAuth()
+// NewProviderForRole returns the AWS APIs of the given provider, called with the credentials of the given
+// IAM role, e.g. of another account
+func NewProviderForRole(provider api.ClusterProvider, roleARN string) api.ClusterProvider {
+	p := &ProviderServices{
+		spec: &api.ProviderConfig{
+			CloudFormationDisableRollback: provider.CloudFormationDisableRollback(),
+			Region:                        provider.Region(),
+			Profile:                       provider.Profile(),
+			WaitTimeout:                   provider.WaitTimeout(),
+		},
+	}
+	s := provider.Session()
+	p.setSession(s.Copy(&aws.Config{
+		Credentials: stscreds.NewCredentials(s, roleARN),
+	}))
+	return p
 }
 
 // ParseConfig parses data into a ClusterConfig
@@ -282,10 +374,53 @@ func LoadConfigFromFile(configFile string) (*api.ClusterConfig, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "loading config file %q", configFile)
 	}
+	if err := clusterConfig.ExpandSpotFallbackNodeGroups(); err != nil {
+		return nil, errors.Wrapf(err, "loading config file %q", configFile)
+	}
+	clusterConfig.ApplyNodeGroupDefaults()
+	resolveBootstrapManifests(clusterConfig, configFile)
 	return clusterConfig, nil
 
 }
 
+// LoadWellKnownPolicyRegistry reads the registry referenced by iam.wellKnownPolicyRegistry and sets the
+// custom well-known policies of the config. Relative registry paths are resolved from the directory of the
+// config file, or from the working directory when the config was not loaded from a file
+func LoadWellKnownPolicyRegistry(clusterConfig *api.ClusterConfig, configFile string) error {
+	var registry *api.WellKnownPolicyRegistry
+	if clusterConfig.IAM != nil && clusterConfig.IAM.WellKnownPolicyRegistry != "" {
+		registryFile := clusterConfig.IAM.WellKnownPolicyRegistry
+		if !filepath.IsAbs(registryFile) && configFile != "" && configFile != "-" {
+			registryFile = filepath.Join(filepath.Dir(configFile), registryFile)
+		}
+		data, err := os.ReadFile(registryFile)
+		if err != nil {
+			return errors.Wrapf(err, "reading well-known policy registry %q", registryFile)
+		}
+		registry = &api.WellKnownPolicyRegistry{}
+		if err := yaml.UnmarshalStrict(data, registry); err != nil {
+			return errors.Wrapf(err, "loading well-known policy registry %q", registryFile)
+		}
+	}
+	return clusterConfig.SetCustomWellKnownPolicies(registry)
+}
+
+// resolveBootstrapManifests resolves the relative paths of bootstrap manifests from the directory of the config file
+func resolveBootstrapManifests(clusterConfig *api.ClusterConfig, configFile string) {
+	if clusterConfig.Bootstrap == nil || configFile == "-" {
+		return
+	}
+	resolve := func(manifests []string) {
+		for i, manifest := range manifests {
+			if manifest != "" && !filepath.IsAbs(manifest) && !file.IsURL(manifest) {
+				manifests[i] = filepath.Join(filepath.Dir(configFile), manifest)
+			}
+		}
+	}
+	resolve(clusterConfig.Bootstrap.Manifests)
+	resolve(clusterConfig.Bootstrap.PreDeleteManifests)
+}
+
 func readConfig(configFile string) ([]byte, error) {
 	if configFile == "-" {
 		return io.ReadAll(os.Stdin)
@@ -319,8 +454,8 @@ func (c *ClusterProvider) GetCredentialsEnv() ([]string, error) {
 // checkAuth checks the AWS authentication
 func (c *ClusterProvider) checkAuth() error {
 
-	input := &sts.GetCallerIdentityInput{}
-	output, err := c.Provider.STS().GetCallerIdentity(input)
+	input := &stsv2.GetCallerIdentityInput{}
+	output, err := c.Provider.STSV2().GetCallerIdentity(context.TODO(), input)
 	if err != nil {
 		return errors.Wrap(err, "checking AWS STS access – cannot get role ARN for current session")
 	}
@@ -424,12 +559,19 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 		}))
 	}
 
+	// the handlers of the session are used by the STS clients assuming the role of the profile too
+	handlers := defaults.Handlers()
+	if spec.SessionName != "" || spec.SourceIdentity != "" {
+		handlers.Validate.PushFrontNamed(assumeRoleAttributionHandler(spec.SessionName, spec.SourceIdentity))
+	}
+
 	// Create the options for the session
 	opts := session.Options{
 		Config:                  *config,
+		Handlers:                handlers,
 		SharedConfigState:       session.SharedConfigEnable,
 		Profile:                 spec.Profile,
-		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
+		AssumeRoleTokenProvider: AssumeRoleTokenProvider,
 	}
 
 	stscreds.DefaultDuration = 30 * time.Minute
@@ -441,6 +583,7 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 		Fn: request.MakeAddToUserAgentHandler(
 			"eksctl", version.String()),
 	})
+	s.Handlers.Complete.PushBackNamed(metrics.AWSRequestHandler)
 
 	if spec.Region == "" {
 		if api.IsSetAndNonEmptyString(s.Config.Region) {
@@ -457,7 +600,42 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 	return s
 }
 
+// assumeRoleAttributionHandler sets the session name and the source identity of the roles assumed by eksctl, which
+// CloudTrail records as the principal of the calls made with the role, so that they can be attributed to a user
+// or a pipeline
+func assumeRoleAttributionHandler(sessionName, sourceIdentity string) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "eksctlAssumeRoleAttribution",
+		Fn: func(r *request.Request) {
+			input, ok := r.Params.(*sts.AssumeRoleInput)
+			if !ok || r.Operation.Name != "AssumeRole" {
+				return
+			}
+			if sessionName != "" {
+				input.RoleSessionName = aws.String(sessionName)
+			}
+			if sourceIdentity != "" {
+				input.SourceIdentity = aws.String(sourceIdentity)
+			}
+		},
+	}
+}
+
 // NewStackManager returns a new stack manager
 func (c *ClusterProvider) NewStackManager(spec *api.ClusterConfig) manager.StackManager {
-	return manager.NewStackCollection(c.Provider, spec)
+	return c.newStackCollection(c.Provider, spec)
+}
+
+// NewStackManagerForRole returns a stack manager calling CloudFormation with the credentials of the given IAM role,
+// e.g. of another account
+func (c *ClusterProvider) NewStackManagerForRole(spec *api.ClusterConfig, roleARN string) manager.StackManager {
+	return c.newStackCollection(NewProviderForRole(c.Provider, roleARN), spec)
+}
+
+func (c *ClusterProvider) newStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) *manager.StackCollection {
+	stackCollection := manager.NewStackCollection(provider, spec)
+	if c.ChangeSetApprover != nil {
+		stackCollection.SetChangeSetApprover(c.ChangeSetApprover)
+	}
+	return stackCollection
 }
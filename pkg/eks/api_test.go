@@ -2,8 +2,11 @@ package eks_test
 
 import (
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
@@ -68,6 +71,33 @@ var _ = Describe("eksctl API", func() {
 			Expect(err.Error()).To(HavePrefix(`loading config file "testdata/old-version.json": no kind "ClusterConfig" is registered for version "eksctl.io/v1alpha3" in scheme`))
 		})
 
+		It("should load custom well-known policies from the registry", func() {
+			cfg, err := LoadConfigFromFile("testdata/custom-well-known-policies.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(LoadWellKnownPolicyRegistry(cfg, "testdata/custom-well-known-policies.yaml")).To(Succeed())
+			Expect(cfg.IAM.ServiceAccounts[0].WellKnownPolicies.CustomPolicies).To(Equal(map[string]api.CustomWellKnownPolicy{
+				"bucket-reader": {AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}},
+			}))
+		})
+
+		It("should reject custom well-known policies not in the registry", func() {
+			cfg, err := LoadConfigFromFile("testdata/custom-well-known-policies-unknown.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			err = LoadWellKnownPolicyRegistry(cfg, "testdata/custom-well-known-policies-unknown.yaml")
+			Expect(err).To(MatchError(`iam.serviceAccounts[0].wellKnownPolicies: custom well-known policy "bucket-writer" not found in the registry`))
+		})
+
+		It("should resolve bootstrap manifest paths from the directory of the config file", func() {
+			cfg, err := LoadConfigFromFile("testdata/bootstrap-manifests.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Bootstrap.Manifests).To(Equal([]string{
+				"testdata/manifests/namespaces.yaml",
+				"/etc/manifests/priority-classes.yaml",
+				"https://example.com/network-policies.yaml",
+			}))
+			Expect(cfg.Bootstrap.PreDeleteManifests).To(Equal([]string{"testdata/manifests/services.yaml"}))
+		})
+
 		It("should error when cannot read a file", func() {
 			_, err := LoadConfigFromFile("../../examples/nothing.xml")
 			Expect(err).To(HaveOccurred())
@@ -153,3 +183,46 @@ func mockDescribeImages(p *mockprovider.MockProvider, amiID string, matcher func
 			},
 		}, nil)
 }
+
+var _ = Describe("assuming roles", func() {
+	var stsClient *sts.STS
+
+	BeforeEach(func() {
+		stsClient = sts.New(session.Must(session.NewSession(&aws.Config{
+			Region:      aws.String("us-west-2"),
+			Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		})))
+	})
+
+	It("sets the session name and the source identity of the role", func() {
+		input := &sts.AssumeRoleInput{
+			RoleArn:         aws.String("arn:aws:iam::123456789012:role/admin"),
+			RoleSessionName: aws.String("1642000000000000000"),
+		}
+		req, _ := stsClient.AssumeRoleRequest(input)
+		AssumeRoleAttributionHandler("ci-pipeline", "jane").Fn(req)
+
+		Expect(input.RoleSessionName).To(Equal(aws.String("ci-pipeline")))
+		Expect(input.SourceIdentity).To(Equal(aws.String("jane")))
+	})
+
+	It("keeps the session name of the SDK when only the source identity is set", func() {
+		input := &sts.AssumeRoleInput{
+			RoleArn:         aws.String("arn:aws:iam::123456789012:role/admin"),
+			RoleSessionName: aws.String("1642000000000000000"),
+		}
+		req, _ := stsClient.AssumeRoleRequest(input)
+		AssumeRoleAttributionHandler("", "jane").Fn(req)
+
+		Expect(input.RoleSessionName).To(Equal(aws.String("1642000000000000000")))
+		Expect(input.SourceIdentity).To(Equal(aws.String("jane")))
+	})
+
+	It("does not change other STS requests", func() {
+		input := &sts.GetCallerIdentityInput{}
+		req, _ := stsClient.GetCallerIdentityRequest(input)
+		AssumeRoleAttributionHandler("ci-pipeline", "jane").Fn(req)
+
+		Expect(input).To(Equal(&sts.GetCallerIdentityInput{}))
+	})
+})
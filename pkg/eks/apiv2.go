@@ -0,0 +1,144 @@
+package eks
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/logging"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+// serviceConfig is the retry and timeout configuration of the client of an AWS service
+type serviceConfig struct {
+	// maxAttempts is the number of attempts of each request, including the first one
+	maxAttempts int
+	// timeout is the timeout of each attempt, including reading the response
+	timeout time.Duration
+}
+
+// defaultServiceConfig is the configuration of the clients of services not in serviceConfigs, it retries as
+// many times as the clients of the AWS SDK v1
+var defaultServiceConfig = serviceConfig{
+	maxAttempts: maxRetries + 1,
+}
+
+// serviceConfigs are the configurations of the clients of services that differ from defaultServiceConfig
+var serviceConfigs = map[string]serviceConfig{
+	// retrying STS is not very helpful, see https://github.com/weaveworks/eksctl/issues/705
+	sts.ServiceID: {
+		maxAttempts: 2,
+		timeout:     30 * time.Second,
+	},
+}
+
+// endpointEnvVars are the environment variables overriding the endpoints of services
+var endpointEnvVars = map[string]string{
+	sts.ServiceID: "AWS_STS_ENDPOINT",
+}
+
+// ServicesV2 holds the clients of the AWS services called with the AWS SDK for Go v2
+type ServicesV2 struct {
+	sts *sts.Client
+}
+
+// STSV2 returns the STS client of the AWS SDK for Go v2
+func (s *ServicesV2) STSV2() awsapi.STS { return s.sts }
+
+func newServicesV2(cfg aws.Config) *ServicesV2 {
+	return &ServicesV2{
+		sts: sts.NewFromConfig(configFor(cfg, sts.ServiceID)),
+	}
+}
+
+// newV2Config returns the configuration of the clients of the AWS SDK for Go v2; they share the region,
+// credentials and logging of the session of the AWS SDK v1, so that profiles, assumed roles, MFA tokens and the
+// credentials cache are handled once for both SDKs
+func newV2Config(s *session.Session) aws.Config {
+	cfg := aws.Config{
+		Region:                      aws.ToString(s.Config.Region),
+		Credentials:                 &v1CredentialsProvider{credentials: s.Config.Credentials},
+		EndpointResolverWithOptions: aws.EndpointResolverWithOptionsFunc(resolveEndpointFromEnv),
+		APIOptions: []func(*middleware.Stack) error{
+			awsmiddleware.AddUserAgentKeyValue("eksctl", version.String()),
+			metrics.AddAWSV2Middleware,
+		},
+		Logger: logging.LoggerFunc(func(_ logging.Classification, format string, v ...interface{}) {
+			logger.Debug(format, v...)
+		}),
+	}
+	if logger.Level >= api.AWSDebugLevel {
+		cfg.ClientLogMode = aws.LogRequestWithBody | aws.LogResponseWithBody | aws.LogRetries
+	}
+	return cfg
+}
+
+// configFor returns the configuration of the client of the service, with its retry and timeout configuration
+func configFor(cfg aws.Config, serviceID string) aws.Config {
+	sc, ok := serviceConfigs[serviceID]
+	if !ok {
+		sc = defaultServiceConfig
+	}
+	cfg.Retryer = func() aws.Retryer {
+		return retry.NewStandard(func(o *retry.StandardOptions) {
+			o.MaxAttempts = sc.maxAttempts
+		})
+	}
+	if sc.timeout > 0 {
+		cfg.HTTPClient = awshttp.NewBuildableClient().WithTimeout(sc.timeout)
+	}
+	return cfg
+}
+
+// resolveEndpointFromEnv resolves the endpoints of services overridden with environment variables, like those of
+// the clients of the AWS SDK v1, and falls back to the default endpoints otherwise
+func resolveEndpointFromEnv(service, region string, _ ...interface{}) (aws.Endpoint, error) {
+	if envVar, ok := endpointEnvVars[service]; ok {
+		if endpoint, ok := os.LookupEnv(envVar); ok {
+			logger.Debug("Setting %s endpoint to %s", service, endpoint)
+			return aws.Endpoint{
+				URL:           endpoint,
+				SigningRegion: region,
+			}, nil
+		}
+	}
+	return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+}
+
+// v1CredentialsProvider provides the credentials of the AWS SDK v1 to the clients of the AWS SDK v2
+type v1CredentialsProvider struct {
+	credentials *credentials.Credentials
+}
+
+// Retrieve returns the credentials, they are cached and refreshed by the AWS SDK v1
+func (p *v1CredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	value, err := p.credentials.GetWithContext(ctx)
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, "retrieving credentials")
+	}
+	creds := aws.Credentials{
+		AccessKeyID:     value.AccessKeyID,
+		SecretAccessKey: value.SecretAccessKey,
+		SessionToken:    value.SessionToken,
+		Source:          value.ProviderName,
+	}
+	if expires, err := p.credentials.ExpiresAt(); err == nil {
+		creds.CanExpire = true
+		creds.Expires = expires
+	}
+	return creds, nil
+}
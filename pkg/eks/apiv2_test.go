@@ -0,0 +1,63 @@
+package eks_test
+
+import (
+	"context"
+	"os"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("AWS SDK v2 configuration", func() {
+	var cfg awsv2.Config
+
+	BeforeEach(func() {
+		s := session.Must(session.NewSession(&aws.Config{
+			Region:      aws.String("us-west-2"),
+			Credentials: credentials.NewStaticCredentials("key-id", "secret", "token"),
+		}))
+		cfg = NewV2Config(s)
+	})
+
+	It("shares the region and credentials of the session", func() {
+		Expect(cfg.Region).To(Equal("us-west-2"))
+		creds, err := cfg.Credentials.Retrieve(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.AccessKeyID).To(Equal("key-id"))
+		Expect(creds.SecretAccessKey).To(Equal("secret"))
+		Expect(creds.SessionToken).To(Equal("token"))
+		Expect(creds.CanExpire).To(BeFalse())
+	})
+
+	It("configures retries per service", func() {
+		Expect(ConfigFor(cfg, sts.ServiceID).Retryer().MaxAttempts()).To(Equal(2))
+		Expect(ConfigFor(cfg, sts.ServiceID).HTTPClient).NotTo(BeNil())
+		Expect(ConfigFor(cfg, "EC2").Retryer().MaxAttempts()).To(Equal(14))
+	})
+
+	Context("resolving endpoints", func() {
+		AfterEach(func() {
+			Expect(os.Unsetenv("AWS_STS_ENDPOINT")).To(Succeed())
+		})
+
+		It("uses endpoints overridden with environment variables", func() {
+			Expect(os.Setenv("AWS_STS_ENDPOINT", "https://sts.example.com")).To(Succeed())
+			endpoint, err := cfg.EndpointResolverWithOptions.ResolveEndpoint(sts.ServiceID, "us-west-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoint.URL).To(Equal("https://sts.example.com"))
+			Expect(endpoint.SigningRegion).To(Equal("us-west-2"))
+		})
+
+		It("falls back to the default endpoints", func() {
+			_, err := cfg.EndpointResolverWithOptions.ResolveEndpoint(sts.ServiceID, "us-west-2")
+			Expect(err).To(BeAssignableToTypeOf(&awsv2.EndpointNotFoundError{}))
+		})
+	})
+})
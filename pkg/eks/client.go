@@ -43,6 +43,11 @@ func (c *ClusterProvider) NewClient(spec *api.ClusterConfig) (*Client, error) {
 	return client.new(spec, c.Provider.STS())
 }
 
+// GetCallerARN returns the ARN of the IAM identity used by the current session
+func (c *ClusterProvider) GetCallerARN() string {
+	return c.Status.iamRoleARN
+}
+
 // GetUsername extracts the username part from the IAM role ARN
 func (c *ClusterProvider) GetUsername() string {
 	usernameParts := strings.Split(c.Status.iamRoleARN, "/")
@@ -124,6 +129,16 @@ func (c *ClusterProvider) NewRawClient(spec *api.ClusterConfig) (*kubewrapper.Ra
 	return kubewrapper.NewRawClient(clientSet, client.rawConfig)
 }
 
+// NewRESTClientGetter creates a RESTClientGetter for the cluster with an embedded STS token, defaulting to namespace,
+// so that clients such as Helm connect to the cluster of the spec rather than to the current context of the user
+func (c *ClusterProvider) NewRESTClientGetter(spec *api.ClusterConfig, namespace string) (*kubewrapper.RESTClientGetter, error) {
+	client, err := c.NewClient(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Kubernetes client config with embedded token")
+	}
+	return kubewrapper.NewRESTClientGetter(client.Config, namespace), nil
+}
+
 // ServerVersion will use discovery API to fetch version of Kubernetes control plane
 func (c *ClusterProvider) ServerVersion(rawClient *kubewrapper.RawClient) (string, error) {
 	return rawClient.ServerVersion()
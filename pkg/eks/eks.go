@@ -199,6 +199,18 @@ func (c *ClusterProvider) CanDelete(spec *api.ClusterConfig) (bool, error) {
 	return true, nil
 }
 
+// ClusterExists returns true when the EKS cluster exists, in any state
+func (c *ClusterProvider) ClusterExists(meta *api.ClusterMeta) (bool, error) {
+	if _, err := c.DescribeControlPlane(meta); err != nil {
+		if awsError, ok := errors.Cause(err).(awserr.Error); ok &&
+			awsError.Code() == awseks.ErrCodeResourceNotFoundException {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "checking whether cluster %q exists", meta.Name)
+	}
+	return true, nil
+}
+
 // CanOperate returns true when a cluster can be operated, otherwise it returns false along with an error explaining the reason
 func (c *ClusterProvider) CanOperate(spec *api.ClusterConfig) (bool, error) {
 	err := c.RefreshClusterStatusIfStale(spec)
@@ -275,8 +287,15 @@ func (c *ClusterProvider) NewOpenIDConnectManager(spec *api.ClusterConfig) (*iam
 		return nil, fmt.Errorf("unknown EKS ARN: %q", spec.Status.ARN)
 	}
 
-	return iamoidc.NewOpenIDConnectManager(c.Provider.IAM(), parsedARN.AccountID,
+	oidc, err := iamoidc.NewOpenIDConnectManager(c.Provider.IAM(), parsedARN.AccountID,
 		*c.Status.ClusterInfo.Cluster.Identity.Oidc.Issuer, parsedARN.Partition, sharedTags(c.Status.ClusterInfo.Cluster))
+	if err != nil {
+		return nil, err
+	}
+	if spec.IAM != nil {
+		oidc.AddClientIDs(spec.IAM.ServiceAccountAudiences()...)
+	}
+	return oidc, nil
 }
 
 func sharedTags(cluster *awseks.Cluster) map[string]string {
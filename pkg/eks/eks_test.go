@@ -470,6 +470,67 @@ var _ = Describe("EKS API wrapper", func() {
 		})
 	})
 
+	Describe("ClusterExists", func() {
+		cfg := api.NewClusterConfig()
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+
+			c = &ClusterProvider{
+				Provider: p,
+				Status:   &ProviderStatus{},
+			}
+		})
+		It("returns true for existing clusters", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).
+				Return(&awseks.DescribeClusterOutput{Cluster: &awseks.Cluster{}}, nil)
+
+			exists, err := c.ClusterExists(cfg.Metadata)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+		It("returns false for clusters that are not found", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).
+				Return(nil, awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil))
+
+			exists, err := c.ClusterExists(cfg.Metadata)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+		It("forwards API errors", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).
+				Return(nil, awserr.New(awseks.ErrCodeBadRequestException, "", nil))
+
+			_, err := c.ClusterExists(cfg.Metadata)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ListNodegroups", func() {
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+		})
+		It("lists the nodegroups of all pages", func() {
+			p.MockEKS().On("ListNodegroupsPages", &awseks.ListNodegroupsInput{
+				ClusterName: aws.String("cluster"),
+			}, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"ng-1", "ng-2"})}, false)
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"ng-3"})}, true)
+			}).Return(nil)
+
+			nodegroups, err := ListNodegroups(p.EKS(), "cluster")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(aws.StringValueSlice(nodegroups)).To(Equal([]string{"ng-1", "ng-2", "ng-3"}))
+		})
+		It("forwards API errors", func() {
+			p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).
+				Return(awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil))
+
+			_, err := ListNodegroups(p.EKS(), "cluster")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	type managedNodesSupportCase struct {
 		platformVersion   string
 		kubernetesVersion string
@@ -702,4 +763,25 @@ var _ = Describe("EKS API wrapper", func() {
 			kubernetesVersion: "1.12",
 		}),
 	)
+
+	DescribeTable("Linux nodegroup validation for Windows nodegroups", func(amiFamilies []string, expectErr bool) {
+		var nodeGroups []KubeNodeGroup
+		for i, amiFamily := range amiFamilies {
+			ng := api.NewNodeGroup()
+			ng.Name = fmt.Sprintf("ng-%d", i)
+			ng.AMIFamily = amiFamily
+			nodeGroups = append(nodeGroups, ng)
+		}
+		err := ValidateLinuxNodeGroupForWindows(nodeGroups)
+		if expectErr {
+			Expect(err).To(MatchError(ContainSubstring("running Windows workloads requires a Linux nodegroup")))
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("no nodegroups", nil, false),
+		Entry("Linux nodegroups", []string{api.NodeImageFamilyAmazonLinux2, api.NodeImageFamilyBottlerocket}, false),
+		Entry("Windows and Linux nodegroups", []string{api.NodeImageFamilyWindowsServer2019CoreContainer, api.NodeImageFamilyUbuntu2004}, false),
+		Entry("Windows nodegroups only", []string{api.NodeImageFamilyWindowsServer2019CoreContainer, api.NodeImageFamilyWindowsServer2019FullContainer}, true),
+	)
 })
@@ -0,0 +1,186 @@
+package eks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/discovery"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+)
+
+// HostResolver resolves host names, it is implemented by net.Resolver
+type HostResolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// EndpointProber probes the API server endpoint and the OIDC issuer of a cluster, so that DNS propagation delays and
+// endpoint access misconfigurations surface when the cluster is created or upgraded instead of on first use
+type EndpointProber struct {
+	Resolver   HostResolver
+	HTTPClient *http.Client
+	// Timeout is how long to wait for the endpoint to resolve and serve requests
+	Timeout time.Duration
+	// Interval is the delay between attempts
+	Interval time.Duration
+}
+
+// EndpointAccess is the access to the API server endpoint of a cluster
+type EndpointAccess struct {
+	Public  bool
+	Private bool
+}
+
+// ProbeEndpoints probes the API server endpoint and, when IAM OIDC is enabled, the OIDC issuer of the cluster
+func (c *ClusterProvider) ProbeEndpoints(cfg *api.ClusterConfig) error {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	cluster := c.Status.ClusterInfo.Cluster
+
+	var access EndpointAccess
+	if vpcConfig := cluster.ResourcesVpcConfig; vpcConfig != nil {
+		access.Public = aws.BoolValue(vpcConfig.EndpointPublicAccess)
+		access.Private = aws.BoolValue(vpcConfig.EndpointPrivateAccess)
+	}
+	var issuer string
+	if cfg.IAM != nil && api.IsEnabled(cfg.IAM.WithOIDC) && cluster.Identity != nil && cluster.Identity.Oidc != nil {
+		issuer = aws.StringValue(cluster.Identity.Oidc.Issuer)
+	}
+
+	clientSet, err := c.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	prober := &EndpointProber{
+		Resolver:   net.DefaultResolver,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		Timeout:    c.Provider.WaitTimeout(),
+		Interval:   10 * time.Second,
+	}
+	return prober.Probe(aws.StringValue(cluster.Endpoint), access, issuer, clientSet.Discovery())
+}
+
+// Probe checks that endpoint resolves and serves requests through the access path it resolves to, and that issuer,
+// if set, serves its OpenID Connect discovery document. Private-only endpoints are probed once, and only a warning
+// is logged if they cannot be reached, as they can only be reached from within the VPC or connected networks
+func (p *EndpointProber) Probe(endpoint string, access EndpointAccess, issuer string, serverVersion discovery.ServerVersionInterface) error {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Hostname() == "" {
+		return fmt.Errorf("invalid API server endpoint %q", endpoint)
+	}
+	host := endpointURL.Hostname()
+
+	var addrs []string
+	if err := p.retry(func() (err error) {
+		addrs, err = p.Resolver.LookupHost(context.TODO(), host)
+		return err
+	}); err != nil {
+		return errors.Wrapf(err, "API server endpoint %q does not resolve, DNS propagation may not be complete", host)
+	}
+
+	path := "public"
+	if allPrivate(addrs) {
+		path = "private"
+	} else if !access.Public {
+		return fmt.Errorf("API server endpoint %q resolves to public addresses %s but public access is disabled", host, strings.Join(addrs, ", "))
+	}
+	logger.Debug("API server endpoint %q resolves to %s addresses %s", host, path, strings.Join(addrs, ", "))
+
+	probeAPI := func() error {
+		_, err := serverVersion.ServerVersion()
+		return err
+	}
+	if !access.Public {
+		if err := probeAPI(); err != nil {
+			logger.Warning("API server endpoint %q only allows private access and cannot be reached from here, "+
+				"subsequent operations must be run from within the cluster's VPC, a peered VPC or some other means like AWS Direct Connect: %v", host, err)
+		}
+	} else if err := p.retry(probeAPI); err != nil {
+		if path == "private" {
+			return errors.Wrapf(err, "API server endpoint %q resolves to private addresses but cannot be reached, "+
+				"check that the security groups of the cluster allow HTTPS traffic from this host", host)
+		}
+		return errors.Wrapf(err, "API server endpoint %q cannot be reached through public access, "+
+			"check that vpc.publicAccessCIDRs allow the egress IP address of this host", host)
+	}
+	logger.Info("API server endpoint %q is reachable through %s access", host, path)
+
+	if issuer == "" {
+		return nil
+	}
+	if err := p.retry(func() error {
+		return p.probeIssuer(issuer)
+	}); err != nil {
+		return errors.Wrapf(err, "OIDC issuer %q is not ready", issuer)
+	}
+	logger.Info("OIDC issuer %q is ready", issuer)
+	return nil
+}
+
+// probeIssuer checks that the OpenID Connect discovery document of the issuer can be fetched and refers to it
+func (p *EndpointProber) probeIssuer(issuer string) error {
+	resp, err := p.HTTPClient.Get(strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %q fetching the OpenID Connect discovery document", resp.Status)
+	}
+
+	var document struct {
+		Issuer string `json:"issuer"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return errors.Wrap(err, "parsing the OpenID Connect discovery document")
+	}
+	if document.Issuer != issuer {
+		return fmt.Errorf("the OpenID Connect discovery document refers to issuer %q", document.Issuer)
+	}
+	return nil
+}
+
+// retry calls op until it succeeds or the timeout expires, returning the last error
+func (p *EndpointProber) retry(op func() error) error {
+	var lastErr error
+	w := waiter.Waiter{
+		Operation: func() (bool, error) {
+			lastErr = op()
+			if lastErr != nil {
+				logger.Debug("probe failed: %v", lastErr)
+			}
+			return lastErr == nil, nil
+		},
+		NextDelay: func(_ int) time.Duration {
+			return p.Interval
+		},
+	}
+	if err := w.WaitWithTimeout(p.Timeout); err != nil {
+		if err == context.DeadlineExceeded && lastErr != nil {
+			return errors.Wrapf(lastErr, "timed out after %s", p.Timeout)
+		}
+		return err
+	}
+	return nil
+}
+
+func allPrivate(addrs []string) bool {
+	for _, addr := range addrs {
+		ip := net.ParseIP(addr)
+		if ip == nil || !ip.IsPrivate() {
+			return false
+		}
+	}
+	return len(addrs) > 0
+}
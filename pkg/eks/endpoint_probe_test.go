@@ -0,0 +1,134 @@
+package eks_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/version"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+type fakeResolver struct {
+	addrs []string
+	err   error
+}
+
+func (f *fakeResolver) LookupHost(_ context.Context, _ string) ([]string, error) {
+	return f.addrs, f.err
+}
+
+type fakeServerVersion struct {
+	err   error
+	calls int
+}
+
+func (f *fakeServerVersion) ServerVersion() (*version.Info, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &version.Info{}, nil
+}
+
+var _ = Describe("Endpoint probe", func() {
+	const endpoint = "https://ABCDEF.gr7.us-west-2.eks.amazonaws.com"
+
+	var (
+		resolver      *fakeResolver
+		serverVersion *fakeServerVersion
+		prober        *eks.EndpointProber
+		public        = eks.EndpointAccess{Public: true, Private: true}
+	)
+
+	BeforeEach(func() {
+		resolver = &fakeResolver{addrs: []string{"54.1.2.3"}}
+		serverVersion = &fakeServerVersion{}
+		prober = &eks.EndpointProber{
+			Resolver:   resolver,
+			HTTPClient: http.DefaultClient,
+			Timeout:    50 * time.Millisecond,
+			Interval:   time.Millisecond,
+		}
+	})
+
+	It("succeeds when the endpoint is reachable", func() {
+		Expect(prober.Probe(endpoint, public, "", serverVersion)).To(Succeed())
+		Expect(serverVersion.calls).To(Equal(1))
+	})
+
+	It("fails when the endpoint does not resolve", func() {
+		resolver.err = errors.New("no such host")
+		err := prober.Probe(endpoint, public, "", serverVersion)
+		Expect(err).To(MatchError(ContainSubstring("does not resolve, DNS propagation may not be complete")))
+		Expect(serverVersion.calls).To(BeZero())
+	})
+
+	It("fails when a public endpoint cannot be reached", func() {
+		serverVersion.err = errors.New("i/o timeout")
+		err := prober.Probe(endpoint, public, "", serverVersion)
+		Expect(err).To(MatchError(ContainSubstring("cannot be reached through public access")))
+	})
+
+	It("fails when a private address cannot be reached", func() {
+		resolver.addrs = []string{"10.0.1.2"}
+		serverVersion.err = errors.New("i/o timeout")
+		err := prober.Probe(endpoint, public, "", serverVersion)
+		Expect(err).To(MatchError(ContainSubstring("resolves to private addresses but cannot be reached")))
+	})
+
+	It("fails when the endpoint resolves to public addresses without public access", func() {
+		err := prober.Probe(endpoint, eks.EndpointAccess{Private: true}, "", serverVersion)
+		Expect(err).To(MatchError(ContainSubstring("resolves to public addresses 54.1.2.3 but public access is disabled")))
+	})
+
+	It("only warns when a private-only endpoint cannot be reached", func() {
+		resolver.addrs = []string{"10.0.1.2"}
+		serverVersion.err = errors.New("i/o timeout")
+		Expect(prober.Probe(endpoint, eks.EndpointAccess{Private: true}, "", serverVersion)).To(Succeed())
+		Expect(serverVersion.calls).To(Equal(1))
+	})
+
+	Context("OIDC issuer", func() {
+		var (
+			server         *httptest.Server
+			documentIssuer string
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/id/ABCDEF/.well-known/openid-configuration" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprintf(w, `{"issuer": %q}`, documentIssuer)
+			}))
+			documentIssuer = server.URL + "/id/ABCDEF"
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("succeeds when the discovery document is served", func() {
+			Expect(prober.Probe(endpoint, public, server.URL+"/id/ABCDEF", serverVersion)).To(Succeed())
+		})
+
+		It("fails when the discovery document is not found", func() {
+			err := prober.Probe(endpoint, public, server.URL+"/id/OTHER", serverVersion)
+			Expect(err).To(MatchError(ContainSubstring(`unexpected status "404 Not Found"`)))
+		})
+
+		It("fails when the discovery document refers to another issuer", func() {
+			documentIssuer = "https://example.com"
+			err := prober.Probe(endpoint, public, server.URL+"/id/ABCDEF", serverVersion)
+			Expect(err).To(MatchError(ContainSubstring(`refers to issuer "https://example.com"`)))
+		})
+	})
+})
@@ -0,0 +1,38 @@
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/savingsplans/savingsplansiface"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var KeyPolicyAllowsServiceLinkedRole = keyPolicyAllowsServiceLinkedRole
+
+func ValidateVolumeEncryptionWithAPIs(ec2API ec2iface.EC2API, kmsAPI kmsiface.KMSAPI, nodePools []api.NodePool) error {
+	v := &volumeEncryptionValidator{
+		ec2API: ec2API,
+		kmsAPI: kmsAPI,
+	}
+	return v.validate(nodePools)
+}
+
+var ResolveMaxPods = resolveMaxPods
+
+var NewV2Config = newV2Config
+
+var ConfigFor = configFor
+
+func CoveredInstanceFamiliesWithAPIs(ec2API ec2iface.EC2API, savingsPlansAPI savingsplansiface.SavingsPlansAPI, region string) (map[string]bool, bool, error) {
+	r := &reservedCapacity{
+		ec2API:          ec2API,
+		savingsPlansAPI: savingsPlansAPI,
+		region:          region,
+	}
+	return r.coveredFamilies()
+}
+
+var PreferCoveredFamilies = preferCoveredFamilies
+
+var AssumeRoleAttributionHandler = assumeRoleAttributionHandler
@@ -4,38 +4,39 @@ package fakes
 import (
 	"sync"
 
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/instancetypes"
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
 	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
 type FakeInstanceSelector struct {
-	FilterStub        func(selector.Filters) ([]string, error)
-	filterMutex       sync.RWMutex
-	filterArgsForCall []struct {
+	FilterVerboseStub        func(selector.Filters) ([]instancetypes.Details, error)
+	filterVerboseMutex       sync.RWMutex
+	filterVerboseArgsForCall []struct {
 		arg1 selector.Filters
 	}
-	filterReturns struct {
-		result1 []string
+	filterVerboseReturns struct {
+		result1 []instancetypes.Details
 		result2 error
 	}
-	filterReturnsOnCall map[int]struct {
-		result1 []string
+	filterVerboseReturnsOnCall map[int]struct {
+		result1 []instancetypes.Details
 		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeInstanceSelector) Filter(arg1 selector.Filters) ([]string, error) {
-	fake.filterMutex.Lock()
-	ret, specificReturn := fake.filterReturnsOnCall[len(fake.filterArgsForCall)]
-	fake.filterArgsForCall = append(fake.filterArgsForCall, struct {
+func (fake *FakeInstanceSelector) FilterVerbose(arg1 selector.Filters) ([]instancetypes.Details, error) {
+	fake.filterVerboseMutex.Lock()
+	ret, specificReturn := fake.filterVerboseReturnsOnCall[len(fake.filterVerboseArgsForCall)]
+	fake.filterVerboseArgsForCall = append(fake.filterVerboseArgsForCall, struct {
 		arg1 selector.Filters
 	}{arg1})
-	stub := fake.FilterStub
-	fakeReturns := fake.filterReturns
-	fake.recordInvocation("Filter", []interface{}{arg1})
-	fake.filterMutex.Unlock()
+	stub := fake.FilterVerboseStub
+	fakeReturns := fake.filterVerboseReturns
+	fake.recordInvocation("FilterVerbose", []interface{}{arg1})
+	fake.filterVerboseMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -45,47 +46,47 @@ func (fake *FakeInstanceSelector) Filter(arg1 selector.Filters) ([]string, error
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeInstanceSelector) FilterCallCount() int {
-	fake.filterMutex.RLock()
-	defer fake.filterMutex.RUnlock()
-	return len(fake.filterArgsForCall)
+func (fake *FakeInstanceSelector) FilterVerboseCallCount() int {
+	fake.filterVerboseMutex.RLock()
+	defer fake.filterVerboseMutex.RUnlock()
+	return len(fake.filterVerboseArgsForCall)
 }
 
-func (fake *FakeInstanceSelector) FilterCalls(stub func(selector.Filters) ([]string, error)) {
-	fake.filterMutex.Lock()
-	defer fake.filterMutex.Unlock()
-	fake.FilterStub = stub
+func (fake *FakeInstanceSelector) FilterVerboseCalls(stub func(selector.Filters) ([]instancetypes.Details, error)) {
+	fake.filterVerboseMutex.Lock()
+	defer fake.filterVerboseMutex.Unlock()
+	fake.FilterVerboseStub = stub
 }
 
-func (fake *FakeInstanceSelector) FilterArgsForCall(i int) selector.Filters {
-	fake.filterMutex.RLock()
-	defer fake.filterMutex.RUnlock()
-	argsForCall := fake.filterArgsForCall[i]
+func (fake *FakeInstanceSelector) FilterVerboseArgsForCall(i int) selector.Filters {
+	fake.filterVerboseMutex.RLock()
+	defer fake.filterVerboseMutex.RUnlock()
+	argsForCall := fake.filterVerboseArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeInstanceSelector) FilterReturns(result1 []string, result2 error) {
-	fake.filterMutex.Lock()
-	defer fake.filterMutex.Unlock()
-	fake.FilterStub = nil
-	fake.filterReturns = struct {
-		result1 []string
+func (fake *FakeInstanceSelector) FilterVerboseReturns(result1 []instancetypes.Details, result2 error) {
+	fake.filterVerboseMutex.Lock()
+	defer fake.filterVerboseMutex.Unlock()
+	fake.FilterVerboseStub = nil
+	fake.filterVerboseReturns = struct {
+		result1 []instancetypes.Details
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeInstanceSelector) FilterReturnsOnCall(i int, result1 []string, result2 error) {
-	fake.filterMutex.Lock()
-	defer fake.filterMutex.Unlock()
-	fake.FilterStub = nil
-	if fake.filterReturnsOnCall == nil {
-		fake.filterReturnsOnCall = make(map[int]struct {
-			result1 []string
+func (fake *FakeInstanceSelector) FilterVerboseReturnsOnCall(i int, result1 []instancetypes.Details, result2 error) {
+	fake.filterVerboseMutex.Lock()
+	defer fake.filterVerboseMutex.Unlock()
+	fake.FilterVerboseStub = nil
+	if fake.filterVerboseReturnsOnCall == nil {
+		fake.filterVerboseReturnsOnCall = make(map[int]struct {
+			result1 []instancetypes.Details
 			result2 error
 		})
 	}
-	fake.filterReturnsOnCall[i] = struct {
-		result1 []string
+	fake.filterVerboseReturnsOnCall[i] = struct {
+		result1 []instancetypes.Details
 		result2 error
 	}{result1, result2}
 }
@@ -93,8 +94,8 @@ func (fake *FakeInstanceSelector) FilterReturnsOnCall(i int, result1 []string, r
 func (fake *FakeInstanceSelector) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.filterMutex.RLock()
-	defer fake.filterMutex.RUnlock()
+	fake.filterVerboseMutex.RLock()
+	defer fake.filterVerboseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
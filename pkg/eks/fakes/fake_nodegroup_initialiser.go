@@ -7,8 +7,8 @@ import (
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
-	"k8s.io/client-go/kubernetes"
 )
 
 type FakeNodeGroupInitialiser struct {
@@ -92,6 +92,30 @@ type FakeNodeGroupInitialiser struct {
 	validateLegacySubnetsForNodeGroupsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateNameCollisionsStub        func(*v1alpha5.ClusterConfig, v1alpha5.ClusterProvider) error
+	validateNameCollisionsMutex       sync.RWMutex
+	validateNameCollisionsArgsForCall []struct {
+		arg1 *v1alpha5.ClusterConfig
+		arg2 v1alpha5.ClusterProvider
+	}
+	validateNameCollisionsReturns struct {
+		result1 error
+	}
+	validateNameCollisionsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateVolumeEncryptionStub        func([]v1alpha5.NodePool, v1alpha5.ClusterProvider) error
+	validateVolumeEncryptionMutex       sync.RWMutex
+	validateVolumeEncryptionArgsForCall []struct {
+		arg1 []v1alpha5.NodePool
+		arg2 v1alpha5.ClusterProvider
+	}
+	validateVolumeEncryptionReturns struct {
+		result1 error
+	}
+	validateVolumeEncryptionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -519,23 +543,138 @@ func (fake *FakeNodeGroupInitialiser) ValidateLegacySubnetsForNodeGroupsReturnsO
 	}{result1}
 }
 
+func (fake *FakeNodeGroupInitialiser) ValidateNameCollisions(arg1 *v1alpha5.ClusterConfig, arg2 v1alpha5.ClusterProvider) error {
+	fake.validateNameCollisionsMutex.Lock()
+	ret, specificReturn := fake.validateNameCollisionsReturnsOnCall[len(fake.validateNameCollisionsArgsForCall)]
+	fake.validateNameCollisionsArgsForCall = append(fake.validateNameCollisionsArgsForCall, struct {
+		arg1 *v1alpha5.ClusterConfig
+		arg2 v1alpha5.ClusterProvider
+	}{arg1, arg2})
+	stub := fake.ValidateNameCollisionsStub
+	fakeReturns := fake.validateNameCollisionsReturns
+	fake.recordInvocation("ValidateNameCollisions", []interface{}{arg1, arg2})
+	fake.validateNameCollisionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateNameCollisionsCallCount() int {
+	fake.validateNameCollisionsMutex.RLock()
+	defer fake.validateNameCollisionsMutex.RUnlock()
+	return len(fake.validateNameCollisionsArgsForCall)
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateNameCollisionsCalls(stub func(*v1alpha5.ClusterConfig, v1alpha5.ClusterProvider) error) {
+	fake.validateNameCollisionsMutex.Lock()
+	defer fake.validateNameCollisionsMutex.Unlock()
+	fake.ValidateNameCollisionsStub = stub
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateNameCollisionsArgsForCall(i int) (*v1alpha5.ClusterConfig, v1alpha5.ClusterProvider) {
+	fake.validateNameCollisionsMutex.RLock()
+	defer fake.validateNameCollisionsMutex.RUnlock()
+	argsForCall := fake.validateNameCollisionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateNameCollisionsReturns(result1 error) {
+	fake.validateNameCollisionsMutex.Lock()
+	defer fake.validateNameCollisionsMutex.Unlock()
+	fake.ValidateNameCollisionsStub = nil
+	fake.validateNameCollisionsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateNameCollisionsReturnsOnCall(i int, result1 error) {
+	fake.validateNameCollisionsMutex.Lock()
+	defer fake.validateNameCollisionsMutex.Unlock()
+	fake.ValidateNameCollisionsStub = nil
+	if fake.validateNameCollisionsReturnsOnCall == nil {
+		fake.validateNameCollisionsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateNameCollisionsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateVolumeEncryption(arg1 []v1alpha5.NodePool, arg2 v1alpha5.ClusterProvider) error {
+	var arg1Copy []v1alpha5.NodePool
+	if arg1 != nil {
+		arg1Copy = make([]v1alpha5.NodePool, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.validateVolumeEncryptionMutex.Lock()
+	ret, specificReturn := fake.validateVolumeEncryptionReturnsOnCall[len(fake.validateVolumeEncryptionArgsForCall)]
+	fake.validateVolumeEncryptionArgsForCall = append(fake.validateVolumeEncryptionArgsForCall, struct {
+		arg1 []v1alpha5.NodePool
+		arg2 v1alpha5.ClusterProvider
+	}{arg1Copy, arg2})
+	stub := fake.ValidateVolumeEncryptionStub
+	fakeReturns := fake.validateVolumeEncryptionReturns
+	fake.recordInvocation("ValidateVolumeEncryption", []interface{}{arg1Copy, arg2})
+	fake.validateVolumeEncryptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateVolumeEncryptionCallCount() int {
+	fake.validateVolumeEncryptionMutex.RLock()
+	defer fake.validateVolumeEncryptionMutex.RUnlock()
+	return len(fake.validateVolumeEncryptionArgsForCall)
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateVolumeEncryptionCalls(stub func([]v1alpha5.NodePool, v1alpha5.ClusterProvider) error) {
+	fake.validateVolumeEncryptionMutex.Lock()
+	defer fake.validateVolumeEncryptionMutex.Unlock()
+	fake.ValidateVolumeEncryptionStub = stub
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateVolumeEncryptionArgsForCall(i int) ([]v1alpha5.NodePool, v1alpha5.ClusterProvider) {
+	fake.validateVolumeEncryptionMutex.RLock()
+	defer fake.validateVolumeEncryptionMutex.RUnlock()
+	argsForCall := fake.validateVolumeEncryptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateVolumeEncryptionReturns(result1 error) {
+	fake.validateVolumeEncryptionMutex.Lock()
+	defer fake.validateVolumeEncryptionMutex.Unlock()
+	fake.ValidateVolumeEncryptionStub = nil
+	fake.validateVolumeEncryptionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNodeGroupInitialiser) ValidateVolumeEncryptionReturnsOnCall(i int, result1 error) {
+	fake.validateVolumeEncryptionMutex.Lock()
+	defer fake.validateVolumeEncryptionMutex.Unlock()
+	fake.ValidateVolumeEncryptionStub = nil
+	if fake.validateVolumeEncryptionReturnsOnCall == nil {
+		fake.validateVolumeEncryptionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateVolumeEncryptionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNodeGroupInitialiser) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.doAllNodegroupStackTasksMutex.RLock()
-	defer fake.doAllNodegroupStackTasksMutex.RUnlock()
-	fake.doesAWSNodeUseIRSAMutex.RLock()
-	defer fake.doesAWSNodeUseIRSAMutex.RUnlock()
-	fake.expandInstanceSelectorOptionsMutex.RLock()
-	defer fake.expandInstanceSelectorOptionsMutex.RUnlock()
-	fake.newAWSSelectorSessionMutex.RLock()
-	defer fake.newAWSSelectorSessionMutex.RUnlock()
-	fake.normalizeMutex.RLock()
-	defer fake.normalizeMutex.RUnlock()
-	fake.validateExistingNodeGroupsForCompatibilityMutex.RLock()
-	defer fake.validateExistingNodeGroupsForCompatibilityMutex.RUnlock()
-	fake.validateLegacySubnetsForNodeGroupsMutex.RLock()
-	defer fake.validateLegacySubnetsForNodeGroupsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,61 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// ValidateIPv6InstanceTypes checks that the nodes of an IPv6 cluster use instance types built on the Nitro System,
+// as pods are assigned IPv6 prefixes, and that nodegroups in IPv6-only subnets use instance types supporting IPv6
+func ValidateIPv6InstanceTypes(ec2API ec2iface.EC2API, nodePools []api.NodePool) error {
+	for _, np := range nodePools {
+		var instanceTypes []string
+		for _, instanceType := range nodePoolInstanceTypes(np) {
+			if instanceType != "" && instanceType != "mixed" {
+				instanceTypes = append(instanceTypes, instanceType)
+			}
+		}
+		if len(instanceTypes) == 0 {
+			continue
+		}
+
+		output, err := ec2API.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+			InstanceTypes: aws.StringSlice(instanceTypes),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "couldn't retrieve instance type description for %v", instanceTypes)
+		}
+
+		var unsupported []string
+		for _, it := range output.InstanceTypes {
+			if !isNitroInstanceType(it) {
+				unsupported = append(unsupported, aws.StringValue(it.InstanceType))
+			}
+		}
+		if len(unsupported) > 0 {
+			return fmt.Errorf("IPv6 is only supported on instance types built on the Nitro System; nodegroup %q uses %s",
+				np.BaseNodeGroup().Name, strings.Join(unsupported, ", "))
+		}
+
+		if ng, ok := np.(*api.NodeGroup); ok && api.IsEnabled(ng.IPv6Only) {
+			var withoutIPv6 []string
+			for _, it := range output.InstanceTypes {
+				if it.NetworkInfo == nil || !aws.BoolValue(it.NetworkInfo.Ipv6Supported) {
+					withoutIPv6 = append(withoutIPv6, aws.StringValue(it.InstanceType))
+				}
+			}
+			if len(withoutIPv6) > 0 {
+				return fmt.Errorf("nodegroup %q is in IPv6-only subnets, which instance types %s cannot be launched in as they do not support IPv6",
+					ng.Name, strings.Join(withoutIPv6, ", "))
+			}
+		}
+	}
+	return nil
+}
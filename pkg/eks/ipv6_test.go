@@ -0,0 +1,72 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("IPv6 instance types", func() {
+	var provider *mockprovider.MockProvider
+
+	instanceType := func(name, hypervisor string) *ec2.InstanceTypeInfo {
+		return &ec2.InstanceTypeInfo{
+			InstanceType: aws.String(name),
+			Hypervisor:   aws.String(hypervisor),
+			BareMetal:    aws.Bool(false),
+		}
+	}
+
+	newNodeGroup := func(instanceTypes ...string) *api.ManagedNodeGroup {
+		ng := api.NewManagedNodeGroup()
+		ng.Name = "ng"
+		ng.InstanceTypes = instanceTypes
+		return ng
+	}
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+	})
+
+	It("accepts instance types built on the Nitro System", func() {
+		provider.MockEC2().On("DescribeInstanceTypes", mock.MatchedBy(func(input *ec2.DescribeInstanceTypesInput) bool {
+			types := aws.StringValueSlice(input.InstanceTypes)
+			return len(types) == 2 && types[0] == "m5.large" && types[1] == "c5.xlarge"
+		})).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{instanceType("m5.large", "nitro"), instanceType("c5.xlarge", "nitro")},
+		}, nil)
+
+		Expect(eks.ValidateIPv6InstanceTypes(provider.EC2(), []api.NodePool{newNodeGroup("m5.large", "c5.xlarge")})).To(Succeed())
+	})
+
+	It("rejects instance types not built on the Nitro System", func() {
+		provider.MockEC2().On("DescribeInstanceTypes", mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{instanceType("m5.large", "nitro"), instanceType("m4.large", "xen")},
+		}, nil)
+
+		err := eks.ValidateIPv6InstanceTypes(provider.EC2(), []api.NodePool{newNodeGroup("m5.large", "m4.large")})
+		Expect(err).To(MatchError(`IPv6 is only supported on instance types built on the Nitro System; nodegroup "ng" uses m4.large`))
+	})
+
+	It("rejects instance types not supporting IPv6 for nodegroups in IPv6-only subnets", func() {
+		withIPv6 := instanceType("m5.large", "nitro")
+		withIPv6.NetworkInfo = &ec2.NetworkInfo{Ipv6Supported: aws.Bool(true)}
+		provider.MockEC2().On("DescribeInstanceTypes", mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{withIPv6, instanceType("t3a.nano", "nitro")},
+		}, nil)
+
+		ng := api.NewNodeGroup()
+		ng.Name = "ipv6-only"
+		ng.IPv6Only = api.Enabled()
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{InstanceTypes: []string{"m5.large", "t3a.nano"}}
+
+		err := eks.ValidateIPv6InstanceTypes(provider.EC2(), []api.NodePool{ng})
+		Expect(err).To(MatchError(`nodegroup "ipv6-only" is in IPv6-only subnets, which instance types t3a.nano cannot be launched in as they do not support IPv6`))
+	})
+})
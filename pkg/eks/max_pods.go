@@ -0,0 +1,111 @@
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	// ipv4AddressesPerPrefix is the number of IPv4 addresses in a /28 prefix assigned with prefix delegation
+	ipv4AddressesPerPrefix = 16
+	// the maximum number of pods recommended by EKS when using prefix delegation,
+	// see https://docs.aws.amazon.com/eks/latest/userguide/cni-increase-ip-addresses.html
+	prefixDelegationMaxPodsSmallInstances = 110
+	prefixDelegationMaxPodsLargeInstances = 250
+	prefixDelegationLargeInstanceVCPUs    = 30
+)
+
+// resolveMaxPods sets maxPodsPerNode to the maximum number of pods per node computed with maxPodsCalculation.
+// For nodegroups with multiple instance types, the lowest value across all instance types is used
+func resolveMaxPods(ec2API ec2iface.EC2API, np api.NodePool) error {
+	ng := np.BaseNodeGroup()
+	mode := ng.MaxPodsCalculation
+	if mode == "" {
+		return nil
+	}
+
+	instanceTypes := nodePoolInstanceTypes(np)
+	output, err := ec2API.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice(instanceTypes),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "couldn't retrieve instance type description for %v", instanceTypes)
+	}
+	if len(output.InstanceTypes) == 0 {
+		return errors.Errorf("couldn't retrieve instance type description for %v", instanceTypes)
+	}
+
+	if mode == api.MaxPodsCalculationAuto {
+		mode = api.MaxPodsCalculationPrefixDelegation
+		for _, it := range output.InstanceTypes {
+			if !isNitroInstanceType(it) {
+				mode = api.MaxPodsCalculationENI
+				break
+			}
+		}
+		ng.MaxPodsCalculation = mode
+	}
+
+	var maxPods int
+	for _, it := range output.InstanceTypes {
+		instanceMaxPods, err := maxPodsForInstanceType(it, mode)
+		if err != nil {
+			return errors.Wrapf(err, "computing maxPodsPerNode for nodegroup %q", ng.Name)
+		}
+		if maxPods == 0 || instanceMaxPods < maxPods {
+			maxPods = instanceMaxPods
+		}
+	}
+	ng.MaxPodsPerNode = maxPods
+	logger.Info("nodegroup %q will use maxPodsPerNode of %d [%s]", ng.Name, maxPods, mode)
+	return nil
+}
+
+func maxPodsForInstanceType(it *ec2.InstanceTypeInfo, mode string) (int, error) {
+	instanceType := aws.StringValue(it.InstanceType)
+	if it.NetworkInfo == nil {
+		return 0, errors.Errorf("couldn't retrieve the network information of instance type %s", instanceType)
+	}
+	enis := int(aws.Int64Value(it.NetworkInfo.MaximumNetworkInterfaces))
+	ipsPerENI := int(aws.Int64Value(it.NetworkInfo.Ipv4AddressesPerInterface))
+
+	if mode == api.MaxPodsCalculationENI {
+		return enis*(ipsPerENI-1) + 2, nil
+	}
+
+	if !isNitroInstanceType(it) {
+		return 0, errors.Errorf("prefix delegation is only supported on instance types built on the Nitro System, and %s is not", instanceType)
+	}
+	maxPods := enis*(ipsPerENI-1)*ipv4AddressesPerPrefix + 2
+	limit := prefixDelegationMaxPodsSmallInstances
+	if it.VCpuInfo != nil && aws.Int64Value(it.VCpuInfo.DefaultVCpus) >= prefixDelegationLargeInstanceVCPUs {
+		limit = prefixDelegationMaxPodsLargeInstances
+	}
+	if maxPods > limit {
+		maxPods = limit
+	}
+	return maxPods, nil
+}
+
+func isNitroInstanceType(it *ec2.InstanceTypeInfo) bool {
+	return aws.StringValue(it.Hypervisor) == ec2.InstanceTypeHypervisorNitro || aws.BoolValue(it.BareMetal)
+}
+
+func nodePoolInstanceTypes(np api.NodePool) []string {
+	switch ng := np.(type) {
+	case *api.NodeGroup:
+		if ng.InstancesDistribution != nil && len(ng.InstancesDistribution.InstanceTypes) > 0 {
+			return ng.InstancesDistribution.InstanceTypes
+		}
+	case *api.ManagedNodeGroup:
+		if len(ng.InstanceTypes) > 0 {
+			return ng.InstanceTypes
+		}
+	}
+	return []string{np.BaseNodeGroup().InstanceType}
+}
@@ -0,0 +1,110 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Max pods per node", func() {
+	var provider *mockprovider.MockProvider
+
+	instanceType := func(name, hypervisor string, enis, ipsPerENI, vCPUs int64) *ec2.InstanceTypeInfo {
+		return &ec2.InstanceTypeInfo{
+			InstanceType: aws.String(name),
+			Hypervisor:   aws.String(hypervisor),
+			BareMetal:    aws.Bool(false),
+			NetworkInfo: &ec2.NetworkInfo{
+				MaximumNetworkInterfaces:  aws.Int64(enis),
+				Ipv4AddressesPerInterface: aws.Int64(ipsPerENI),
+			},
+			VCpuInfo: &ec2.VCpuInfo{DefaultVCpus: aws.Int64(vCPUs)},
+		}
+	}
+
+	newNodeGroup := func(calculation string, instanceTypes ...string) *api.ManagedNodeGroup {
+		ng := api.NewManagedNodeGroup()
+		ng.Name = "ng"
+		ng.MaxPodsCalculation = calculation
+		if len(instanceTypes) == 1 {
+			ng.InstanceType = instanceTypes[0]
+		} else {
+			ng.InstanceTypes = instanceTypes
+		}
+		return ng
+	}
+
+	mockInstanceTypes := func(instanceTypes ...*ec2.InstanceTypeInfo) {
+		provider.MockEC2().On("DescribeInstanceTypes", mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: instanceTypes,
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		provider = mockprovider.NewMockProvider()
+	})
+
+	It("does nothing when maxPodsCalculation is not set", func() {
+		ng := newNodeGroup("", "m5.large")
+		ng.MaxPodsPerNode = 20
+		Expect(eks.ResolveMaxPods(provider.EC2(), ng)).To(Succeed())
+		Expect(ng.MaxPodsPerNode).To(Equal(20))
+		provider.MockEC2().AssertNotCalled(GinkgoT(), "DescribeInstanceTypes", mock.Anything)
+	})
+
+	It("computes maxPodsPerNode from ENI limits", func() {
+		mockInstanceTypes(instanceType("m5.large", "nitro", 3, 10, 2))
+		ng := newNodeGroup(api.MaxPodsCalculationENI, "m5.large")
+		Expect(eks.ResolveMaxPods(provider.EC2(), ng)).To(Succeed())
+		Expect(ng.MaxPodsPerNode).To(Equal(29))
+	})
+
+	It("computes maxPodsPerNode for prefix delegation with the recommended limits", func() {
+		mockInstanceTypes(instanceType("m5.large", "nitro", 3, 10, 2))
+		ng := newNodeGroup(api.MaxPodsCalculationPrefixDelegation, "m5.large")
+		Expect(eks.ResolveMaxPods(provider.EC2(), ng)).To(Succeed())
+		Expect(ng.MaxPodsPerNode).To(Equal(110))
+
+		provider = mockprovider.NewMockProvider()
+		mockInstanceTypes(instanceType("m5.16xlarge", "nitro", 15, 50, 64))
+		ng = newNodeGroup(api.MaxPodsCalculationPrefixDelegation, "m5.16xlarge")
+		Expect(eks.ResolveMaxPods(provider.EC2(), ng)).To(Succeed())
+		Expect(ng.MaxPodsPerNode).To(Equal(250))
+	})
+
+	It("uses the lowest value across instance types", func() {
+		mockInstanceTypes(instanceType("m5.large", "nitro", 3, 10, 2), instanceType("t3.medium", "nitro", 3, 6, 2))
+		ng := newNodeGroup(api.MaxPodsCalculationENI, "m5.large", "t3.medium")
+		Expect(eks.ResolveMaxPods(provider.EC2(), ng)).To(Succeed())
+		Expect(ng.MaxPodsPerNode).To(Equal(17))
+	})
+
+	It("resolves auto to prefix delegation for Nitro instance types", func() {
+		mockInstanceTypes(instanceType("m5.large", "nitro", 3, 10, 2))
+		ng := newNodeGroup(api.MaxPodsCalculationAuto, "m5.large")
+		Expect(eks.ResolveMaxPods(provider.EC2(), ng)).To(Succeed())
+		Expect(ng.MaxPodsCalculation).To(Equal(api.MaxPodsCalculationPrefixDelegation))
+		Expect(ng.MaxPodsPerNode).To(Equal(110))
+	})
+
+	It("resolves auto to ENI limits when an instance type is not built on Nitro", func() {
+		mockInstanceTypes(instanceType("m5.large", "nitro", 3, 10, 2), instanceType("m4.large", "xen", 2, 10, 2))
+		ng := newNodeGroup(api.MaxPodsCalculationAuto, "m5.large", "m4.large")
+		Expect(eks.ResolveMaxPods(provider.EC2(), ng)).To(Succeed())
+		Expect(ng.MaxPodsCalculation).To(Equal(api.MaxPodsCalculationENI))
+		Expect(ng.MaxPodsPerNode).To(Equal(20))
+	})
+
+	It("fails for prefix delegation on instance types not built on Nitro", func() {
+		mockInstanceTypes(instanceType("m4.large", "xen", 2, 10, 2))
+		ng := newNodeGroup(api.MaxPodsCalculationPrefixDelegation, "m4.large")
+		err := eks.ResolveMaxPods(provider.EC2(), ng)
+		Expect(err).To(MatchError(ContainSubstring("m4.large is not")))
+	})
+})
@@ -0,0 +1,718 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	request "github.com/aws/aws-sdk-go/aws/request"
+	savingsplans "github.com/aws/aws-sdk-go/service/savingsplans"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SavingsPlansAPI is an autogenerated mock type for the SavingsPlansAPI type
+type SavingsPlansAPI struct {
+	mock.Mock
+}
+
+// CreateSavingsPlan provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) CreateSavingsPlan(_a0 *savingsplans.CreateSavingsPlanInput) (*savingsplans.CreateSavingsPlanOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *savingsplans.CreateSavingsPlanOutput
+	if rf, ok := ret.Get(0).(func(*savingsplans.CreateSavingsPlanInput) *savingsplans.CreateSavingsPlanOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.CreateSavingsPlanOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*savingsplans.CreateSavingsPlanInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSavingsPlanRequest provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) CreateSavingsPlanRequest(_a0 *savingsplans.CreateSavingsPlanInput) (*request.Request, *savingsplans.CreateSavingsPlanOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*savingsplans.CreateSavingsPlanInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *savingsplans.CreateSavingsPlanOutput
+	if rf, ok := ret.Get(1).(func(*savingsplans.CreateSavingsPlanInput) *savingsplans.CreateSavingsPlanOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*savingsplans.CreateSavingsPlanOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// CreateSavingsPlanWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SavingsPlansAPI) CreateSavingsPlanWithContext(_a0 context.Context, _a1 *savingsplans.CreateSavingsPlanInput, _a2 ...request.Option) (*savingsplans.CreateSavingsPlanOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *savingsplans.CreateSavingsPlanOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *savingsplans.CreateSavingsPlanInput, ...request.Option) *savingsplans.CreateSavingsPlanOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.CreateSavingsPlanOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *savingsplans.CreateSavingsPlanInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteQueuedSavingsPlan provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DeleteQueuedSavingsPlan(_a0 *savingsplans.DeleteQueuedSavingsPlanInput) (*savingsplans.DeleteQueuedSavingsPlanOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *savingsplans.DeleteQueuedSavingsPlanOutput
+	if rf, ok := ret.Get(0).(func(*savingsplans.DeleteQueuedSavingsPlanInput) *savingsplans.DeleteQueuedSavingsPlanOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DeleteQueuedSavingsPlanOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*savingsplans.DeleteQueuedSavingsPlanInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteQueuedSavingsPlanRequest provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DeleteQueuedSavingsPlanRequest(_a0 *savingsplans.DeleteQueuedSavingsPlanInput) (*request.Request, *savingsplans.DeleteQueuedSavingsPlanOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*savingsplans.DeleteQueuedSavingsPlanInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *savingsplans.DeleteQueuedSavingsPlanOutput
+	if rf, ok := ret.Get(1).(func(*savingsplans.DeleteQueuedSavingsPlanInput) *savingsplans.DeleteQueuedSavingsPlanOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*savingsplans.DeleteQueuedSavingsPlanOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteQueuedSavingsPlanWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SavingsPlansAPI) DeleteQueuedSavingsPlanWithContext(_a0 context.Context, _a1 *savingsplans.DeleteQueuedSavingsPlanInput, _a2 ...request.Option) (*savingsplans.DeleteQueuedSavingsPlanOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *savingsplans.DeleteQueuedSavingsPlanOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *savingsplans.DeleteQueuedSavingsPlanInput, ...request.Option) *savingsplans.DeleteQueuedSavingsPlanOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DeleteQueuedSavingsPlanOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *savingsplans.DeleteQueuedSavingsPlanInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlanRates provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DescribeSavingsPlanRates(_a0 *savingsplans.DescribeSavingsPlanRatesInput) (*savingsplans.DescribeSavingsPlanRatesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *savingsplans.DescribeSavingsPlanRatesOutput
+	if rf, ok := ret.Get(0).(func(*savingsplans.DescribeSavingsPlanRatesInput) *savingsplans.DescribeSavingsPlanRatesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DescribeSavingsPlanRatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*savingsplans.DescribeSavingsPlanRatesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlanRatesRequest provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DescribeSavingsPlanRatesRequest(_a0 *savingsplans.DescribeSavingsPlanRatesInput) (*request.Request, *savingsplans.DescribeSavingsPlanRatesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*savingsplans.DescribeSavingsPlanRatesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *savingsplans.DescribeSavingsPlanRatesOutput
+	if rf, ok := ret.Get(1).(func(*savingsplans.DescribeSavingsPlanRatesInput) *savingsplans.DescribeSavingsPlanRatesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*savingsplans.DescribeSavingsPlanRatesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlanRatesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SavingsPlansAPI) DescribeSavingsPlanRatesWithContext(_a0 context.Context, _a1 *savingsplans.DescribeSavingsPlanRatesInput, _a2 ...request.Option) (*savingsplans.DescribeSavingsPlanRatesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *savingsplans.DescribeSavingsPlanRatesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *savingsplans.DescribeSavingsPlanRatesInput, ...request.Option) *savingsplans.DescribeSavingsPlanRatesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DescribeSavingsPlanRatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *savingsplans.DescribeSavingsPlanRatesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlans provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DescribeSavingsPlans(_a0 *savingsplans.DescribeSavingsPlansInput) (*savingsplans.DescribeSavingsPlansOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *savingsplans.DescribeSavingsPlansOutput
+	if rf, ok := ret.Get(0).(func(*savingsplans.DescribeSavingsPlansInput) *savingsplans.DescribeSavingsPlansOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DescribeSavingsPlansOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*savingsplans.DescribeSavingsPlansInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlansOfferingRates provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DescribeSavingsPlansOfferingRates(_a0 *savingsplans.DescribeSavingsPlansOfferingRatesInput) (*savingsplans.DescribeSavingsPlansOfferingRatesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *savingsplans.DescribeSavingsPlansOfferingRatesOutput
+	if rf, ok := ret.Get(0).(func(*savingsplans.DescribeSavingsPlansOfferingRatesInput) *savingsplans.DescribeSavingsPlansOfferingRatesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DescribeSavingsPlansOfferingRatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*savingsplans.DescribeSavingsPlansOfferingRatesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlansOfferingRatesRequest provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DescribeSavingsPlansOfferingRatesRequest(_a0 *savingsplans.DescribeSavingsPlansOfferingRatesInput) (*request.Request, *savingsplans.DescribeSavingsPlansOfferingRatesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*savingsplans.DescribeSavingsPlansOfferingRatesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *savingsplans.DescribeSavingsPlansOfferingRatesOutput
+	if rf, ok := ret.Get(1).(func(*savingsplans.DescribeSavingsPlansOfferingRatesInput) *savingsplans.DescribeSavingsPlansOfferingRatesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*savingsplans.DescribeSavingsPlansOfferingRatesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlansOfferingRatesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SavingsPlansAPI) DescribeSavingsPlansOfferingRatesWithContext(_a0 context.Context, _a1 *savingsplans.DescribeSavingsPlansOfferingRatesInput, _a2 ...request.Option) (*savingsplans.DescribeSavingsPlansOfferingRatesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *savingsplans.DescribeSavingsPlansOfferingRatesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *savingsplans.DescribeSavingsPlansOfferingRatesInput, ...request.Option) *savingsplans.DescribeSavingsPlansOfferingRatesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DescribeSavingsPlansOfferingRatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *savingsplans.DescribeSavingsPlansOfferingRatesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlansOfferings provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DescribeSavingsPlansOfferings(_a0 *savingsplans.DescribeSavingsPlansOfferingsInput) (*savingsplans.DescribeSavingsPlansOfferingsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *savingsplans.DescribeSavingsPlansOfferingsOutput
+	if rf, ok := ret.Get(0).(func(*savingsplans.DescribeSavingsPlansOfferingsInput) *savingsplans.DescribeSavingsPlansOfferingsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DescribeSavingsPlansOfferingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*savingsplans.DescribeSavingsPlansOfferingsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlansOfferingsRequest provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DescribeSavingsPlansOfferingsRequest(_a0 *savingsplans.DescribeSavingsPlansOfferingsInput) (*request.Request, *savingsplans.DescribeSavingsPlansOfferingsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*savingsplans.DescribeSavingsPlansOfferingsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *savingsplans.DescribeSavingsPlansOfferingsOutput
+	if rf, ok := ret.Get(1).(func(*savingsplans.DescribeSavingsPlansOfferingsInput) *savingsplans.DescribeSavingsPlansOfferingsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*savingsplans.DescribeSavingsPlansOfferingsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlansOfferingsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SavingsPlansAPI) DescribeSavingsPlansOfferingsWithContext(_a0 context.Context, _a1 *savingsplans.DescribeSavingsPlansOfferingsInput, _a2 ...request.Option) (*savingsplans.DescribeSavingsPlansOfferingsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *savingsplans.DescribeSavingsPlansOfferingsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *savingsplans.DescribeSavingsPlansOfferingsInput, ...request.Option) *savingsplans.DescribeSavingsPlansOfferingsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DescribeSavingsPlansOfferingsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *savingsplans.DescribeSavingsPlansOfferingsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlansRequest provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) DescribeSavingsPlansRequest(_a0 *savingsplans.DescribeSavingsPlansInput) (*request.Request, *savingsplans.DescribeSavingsPlansOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*savingsplans.DescribeSavingsPlansInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *savingsplans.DescribeSavingsPlansOutput
+	if rf, ok := ret.Get(1).(func(*savingsplans.DescribeSavingsPlansInput) *savingsplans.DescribeSavingsPlansOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*savingsplans.DescribeSavingsPlansOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeSavingsPlansWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SavingsPlansAPI) DescribeSavingsPlansWithContext(_a0 context.Context, _a1 *savingsplans.DescribeSavingsPlansInput, _a2 ...request.Option) (*savingsplans.DescribeSavingsPlansOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *savingsplans.DescribeSavingsPlansOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *savingsplans.DescribeSavingsPlansInput, ...request.Option) *savingsplans.DescribeSavingsPlansOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.DescribeSavingsPlansOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *savingsplans.DescribeSavingsPlansInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsForResource provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) ListTagsForResource(_a0 *savingsplans.ListTagsForResourceInput) (*savingsplans.ListTagsForResourceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *savingsplans.ListTagsForResourceOutput
+	if rf, ok := ret.Get(0).(func(*savingsplans.ListTagsForResourceInput) *savingsplans.ListTagsForResourceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.ListTagsForResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*savingsplans.ListTagsForResourceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsForResourceRequest provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) ListTagsForResourceRequest(_a0 *savingsplans.ListTagsForResourceInput) (*request.Request, *savingsplans.ListTagsForResourceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*savingsplans.ListTagsForResourceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *savingsplans.ListTagsForResourceOutput
+	if rf, ok := ret.Get(1).(func(*savingsplans.ListTagsForResourceInput) *savingsplans.ListTagsForResourceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*savingsplans.ListTagsForResourceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListTagsForResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SavingsPlansAPI) ListTagsForResourceWithContext(_a0 context.Context, _a1 *savingsplans.ListTagsForResourceInput, _a2 ...request.Option) (*savingsplans.ListTagsForResourceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *savingsplans.ListTagsForResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *savingsplans.ListTagsForResourceInput, ...request.Option) *savingsplans.ListTagsForResourceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.ListTagsForResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *savingsplans.ListTagsForResourceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagResource provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) TagResource(_a0 *savingsplans.TagResourceInput) (*savingsplans.TagResourceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *savingsplans.TagResourceOutput
+	if rf, ok := ret.Get(0).(func(*savingsplans.TagResourceInput) *savingsplans.TagResourceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.TagResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*savingsplans.TagResourceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagResourceRequest provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) TagResourceRequest(_a0 *savingsplans.TagResourceInput) (*request.Request, *savingsplans.TagResourceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*savingsplans.TagResourceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *savingsplans.TagResourceOutput
+	if rf, ok := ret.Get(1).(func(*savingsplans.TagResourceInput) *savingsplans.TagResourceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*savingsplans.TagResourceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// TagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SavingsPlansAPI) TagResourceWithContext(_a0 context.Context, _a1 *savingsplans.TagResourceInput, _a2 ...request.Option) (*savingsplans.TagResourceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *savingsplans.TagResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *savingsplans.TagResourceInput, ...request.Option) *savingsplans.TagResourceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.TagResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *savingsplans.TagResourceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UntagResource provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) UntagResource(_a0 *savingsplans.UntagResourceInput) (*savingsplans.UntagResourceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *savingsplans.UntagResourceOutput
+	if rf, ok := ret.Get(0).(func(*savingsplans.UntagResourceInput) *savingsplans.UntagResourceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.UntagResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*savingsplans.UntagResourceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UntagResourceRequest provides a mock function with given fields: _a0
+func (_m *SavingsPlansAPI) UntagResourceRequest(_a0 *savingsplans.UntagResourceInput) (*request.Request, *savingsplans.UntagResourceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*savingsplans.UntagResourceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *savingsplans.UntagResourceOutput
+	if rf, ok := ret.Get(1).(func(*savingsplans.UntagResourceInput) *savingsplans.UntagResourceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*savingsplans.UntagResourceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UntagResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SavingsPlansAPI) UntagResourceWithContext(_a0 context.Context, _a1 *savingsplans.UntagResourceInput, _a2 ...request.Option) (*savingsplans.UntagResourceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *savingsplans.UntagResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *savingsplans.UntagResourceInput, ...request.Option) *savingsplans.UntagResourceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*savingsplans.UntagResourceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *savingsplans.UntagResourceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -4,11 +4,17 @@ import (
 	_ "github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface" // used for testing
 	_ "github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	_ "github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	_ "github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	_ "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	_ "github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	_ "github.com/aws/aws-sdk-go/service/eks/eksiface"
 	_ "github.com/aws/aws-sdk-go/service/elb/elbiface"
 	_ "github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	_ "github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
 	_ "github.com/aws/aws-sdk-go/service/iam/iamiface"
+	_ "github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	_ "github.com/aws/aws-sdk-go/service/s3/s3iface"
+	_ "github.com/aws/aws-sdk-go/service/savingsplans/savingsplansiface"
 	_ "github.com/aws/aws-sdk-go/service/sts/stsiface"
 	_ "github.com/vektra/mockery"
 )
@@ -24,5 +30,11 @@ import (
 //go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/iam/iamiface --name=IAMAPI --output=./
 //go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/cloudtrail/cloudtrailiface --name=CloudTrailAPI --output=./
 //go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/cloudwatchlogs/cloudwatchlogsiface --name=CloudWatchLogsAPI --output=./
+//go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/cloudwatch/cloudwatchiface --name=CloudWatchAPI --output=./
 //go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/ssm/ssmiface --name=SSMAPI --output=./
+//go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/ecr/ecriface --name=ECRAPI --output=./
+//go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/eventbridge/eventbridgeiface --name=EventBridgeAPI --output=./
+//go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/s3/s3iface --name=S3API --output=./
+//go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/kms/kmsiface --name=KMSAPI --output=./
+//go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/savingsplans/savingsplansiface --name=SavingsPlansAPI --output=./
 //go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/aws/client --name=ConfigProvider --output=./
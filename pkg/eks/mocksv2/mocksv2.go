@@ -0,0 +1,8 @@
+package mocksv2
+
+import (
+	_ "github.com/vektra/mockery" // used for testing
+)
+
+// Run make check-all-generated-files-up-to-date to generate the mocks of the interfaces in pkg/awsapi
+//go:generate "${GOBIN}/mockery" --tags netgo --dir=../../awsapi --name=STS --output=./
@@ -9,6 +9,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	awsiam "github.com/aws/aws-sdk-go/service/iam"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
@@ -179,16 +181,23 @@ func hasAmazonLinux2Node(nodeGroups []KubeNodeGroup) bool {
 	return false
 }
 
-// LogWindowsCompatibility logs Windows compatibility messages
-func LogWindowsCompatibility(nodeGroups []KubeNodeGroup, clusterMeta *api.ClusterMeta) {
-	if hasWindowsNode(nodeGroups) {
-		if !hasAmazonLinux2Node(nodeGroups) {
-			logger.Warning("a Linux node group is required to support Windows workloads")
-			logger.Warning("add it using 'eksctl create nodegroup --cluster=%s --node-ami-family=%s'", clusterMeta.Name, api.NodeImageFamilyAmazonLinux2)
+// hasLinuxNode reports whether there's at least one Linux node in nodeGroups
+func hasLinuxNode(nodeGroups []KubeNodeGroup) bool {
+	for _, ng := range nodeGroups {
+		if !api.IsWindowsImage(ng.GetAMIFamily()) {
+			return true
 		}
-		logger.Warning("Windows VPC resource controller is required to run Windows workloads")
-		logger.Warning("install it using 'eksctl utils install-vpc-controllers --name=%s --region=%s --approve'", clusterMeta.Name, clusterMeta.Region)
 	}
+	return false
+}
+
+// ValidateLinuxNodeGroupForWindows validates that Windows nodegroups are created along with a Linux nodegroup, which
+// runs CoreDNS and the other cluster components that Windows workloads depend on
+func ValidateLinuxNodeGroupForWindows(nodeGroups []KubeNodeGroup) error {
+	if hasWindowsNode(nodeGroups) && !hasLinuxNode(nodeGroups) {
+		return errors.New("running Windows workloads requires a Linux nodegroup to run CoreDNS and the other cluster components, add one to the nodegroups to create")
+	}
+	return nil
 }
 
 //go:generate "${GOBIN}/mockery" --name=KubeNodeGroup --output=mocks/
@@ -311,3 +320,18 @@ func newSuspendProcesses(c *ClusterProvider, spec *api.ClusterConfig, nodegroup
 		},
 	}
 }
+
+// ListNodegroups returns the names of all managed nodegroups of the cluster, across all pages of results, as EKS
+// returns at most 100 nodegroups per call
+func ListNodegroups(eksAPI eksiface.EKSAPI, clusterName string) ([]*string, error) {
+	var nodegroups []*string
+	if err := eksAPI.ListNodegroupsPages(&awseks.ListNodegroupsInput{
+		ClusterName: &clusterName,
+	}, func(output *awseks.ListNodegroupsOutput, _ bool) bool {
+		nodegroups = append(nodegroups, output.Nodegroups...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return nodegroups, nil
+}
@@ -3,13 +3,16 @@ package eks
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/bytequantity"
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/instancetypes"
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"github.com/weaveworks/eksctl/pkg/actions/collision"
 	"github.com/weaveworks/eksctl/pkg/ami"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
@@ -28,8 +31,9 @@ const maxInstanceTypes = 40
 //counterfeiter:generate -o fakes/fake_instance_selector.go . InstanceSelector
 // InstanceSelector selects a set of instance types matching the specified instance selector criteria
 type InstanceSelector interface {
-	// Filter returns a set of instance types matching the specified instance selector filters
-	Filter(selector.Filters) ([]string, error)
+	// FilterVerbose returns the details of the instance types matching the specified instance selector filters,
+	// with their on-demand prices if they have been fetched
+	FilterVerbose(selector.Filters) ([]instancetypes.Details, error)
 }
 
 //counterfeiter:generate -o fakes/fake_nodegroup_initialiser.go . NodeGroupInitialiser
@@ -39,6 +43,8 @@ type NodeGroupInitialiser interface {
 	ExpandInstanceSelectorOptions(nodePools []api.NodePool, clusterAZs []string) error
 	NewAWSSelectorSession(provider api.ClusterProvider)
 	ValidateLegacySubnetsForNodeGroups(spec *api.ClusterConfig, provider api.ClusterProvider) error
+	ValidateVolumeEncryption(nodePools []api.NodePool, provider api.ClusterProvider) error
+	ValidateNameCollisions(spec *api.ClusterConfig, provider api.ClusterProvider) error
 	DoesAWSNodeUseIRSA(provider api.ClusterProvider, clientSet kubernetes.Interface) (bool, error)
 	DoAllNodegroupStackTasks(taskTree *tasks.TaskTree, region, name string) error
 	ValidateExistingNodeGroupsForCompatibility(cfg *api.ClusterConfig, stackManager manager.StackManager) error
@@ -48,6 +54,8 @@ type NodeGroupInitialiser interface {
 type NodeGroupService struct {
 	Provider         api.ClusterProvider
 	instanceSelector InstanceSelector
+	// coveredFamilies holds the instance families covered by Reserved Instances and Savings Plans, once fetched
+	coveredFamilies map[string]bool
 }
 
 // NewNodeGroupService creates a new NodeGroupService
@@ -111,6 +119,10 @@ func (m *NodeGroupService) Normalize(nodePools []api.NodePool, clusterMeta *api.
 		if publicKeyName != "" {
 			ng.SSH.PublicKeyName = &publicKeyName
 		}
+
+		if err := resolveMaxPods(m.Provider.EC2(), np); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -144,6 +156,12 @@ func (m *NodeGroupService) ExpandInstanceSelectorOptions(nodePools []api.NodePoo
 			return errors.Errorf("instance selector filters resulted in %d instance types, which is greater than the maximum of %d, please set more selector options", len(instanceTypes), maxInstanceTypes)
 		}
 
+		if baseNG.InstanceSelector.PreferReserved {
+			if instanceTypes, err = m.orderByReservedCapacity(instanceTypes); err != nil {
+				return err
+			}
+		}
+
 		switch ng := np.(type) {
 		case *api.NodeGroup:
 			if ng.InstancesDistribution == nil {
@@ -206,11 +224,39 @@ func (m *NodeGroupService) expandInstanceSelector(ins *api.InstanceSelector, azs
 		cpuArch = defaultCPUArch
 	}
 	filters.CPUArchitecture = aws.String(cpuArch)
+	if ins.ExcludeBurstable {
+		filters.Burstable = aws.Bool(false)
+	}
+	if ins.AllowList != "" {
+		allowList, err := regexp.Compile(ins.AllowList)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value %q for instanceSelector.allowList", ins.AllowList)
+		}
+		filters.AllowList = allowList
+	}
+	if ins.DenyList != "" {
+		denyList, err := regexp.Compile(ins.DenyList)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value %q for instanceSelector.denyList", ins.DenyList)
+		}
+		filters.DenyList = denyList
+	}
+	if ins.MaxPricePerVCPU != nil {
+		if err := m.fetchOndemandPrices(); err != nil {
+			return nil, err
+		}
+	}
 
-	instanceTypes, err := m.instanceSelector.Filter(filters)
+	details, err := m.instanceSelector.FilterVerbose(filters)
 	if err != nil {
 		return nil, errors.Wrap(err, "error querying instance types for the specified instance selector criteria")
 	}
+	var instanceTypes []string
+	for _, d := range details {
+		if matchesGPUType(d, ins.GPUType) && matchesMaxPricePerVCPU(d, ins.MaxPricePerVCPU) {
+			instanceTypes = append(instanceTypes, aws.StringValue(d.InstanceType))
+		}
+	}
 	if len(instanceTypes) == 0 {
 		return nil, errors.New("instance selector criteria matched no instances; consider broadening your criteria so that more instance types are returned")
 	}
@@ -218,10 +264,56 @@ func (m *NodeGroupService) expandInstanceSelector(ins *api.InstanceSelector, azs
 	return instanceTypes, nil
 }
 
+// fetchOndemandPrices fetches the on-demand prices of all instance types of the region, as the instance selector only
+// returns the prices of instance types when they have been fetched beforehand
+func (m *NodeGroupService) fetchOndemandPrices() error {
+	s, ok := m.instanceSelector.(*selector.Selector)
+	if !ok || s.EC2Pricing.LastOnDemandCacheUTC() != nil {
+		return nil
+	}
+	logger.Info("fetching on-demand prices of instance types")
+	return errors.Wrap(s.EC2Pricing.HydrateOndemandCache(), "error fetching on-demand prices of instance types")
+}
+
+// matchesGPUType returns whether the instance type has GPUs of the model or manufacturer, or gpuType is empty
+func matchesGPUType(d instancetypes.Details, gpuType string) bool {
+	if gpuType == "" {
+		return true
+	}
+	if d.GpuInfo == nil {
+		return false
+	}
+	for _, gpu := range d.GpuInfo.Gpus {
+		if strings.EqualFold(aws.StringValue(gpu.Name), gpuType) || strings.EqualFold(aws.StringValue(gpu.Manufacturer), gpuType) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesMaxPricePerVCPU returns whether the on-demand price per vCPU of the instance type is known and at most
+// maxPrice, or maxPrice is nil
+func matchesMaxPricePerVCPU(d instancetypes.Details, maxPrice *float64) bool {
+	if maxPrice == nil {
+		return true
+	}
+	if d.OndemandPricePerHour == nil || d.VCpuInfo == nil || aws.Int64Value(d.VCpuInfo.DefaultVCpus) == 0 {
+		logger.Debug("excluding instance type %s with an unknown price per vCPU", aws.StringValue(d.InstanceType))
+		return false
+	}
+	return *d.OndemandPricePerHour/float64(*d.VCpuInfo.DefaultVCpus) <= *maxPrice
+}
+
 func (m *NodeGroupService) ValidateLegacySubnetsForNodeGroups(spec *api.ClusterConfig, provider api.ClusterProvider) error {
 	return vpc.ValidateLegacySubnetsForNodeGroups(spec, provider)
 }
 
+// ValidateNameCollisions checks that no stack, IAM role, launch template or Auto Scaling group already exists with the
+// names of those created for the nodegroups of spec, which would otherwise fail their stacks midway
+func (m *NodeGroupService) ValidateNameCollisions(spec *api.ClusterConfig, provider api.ClusterProvider) error {
+	return collision.New(provider).Check(spec, false)
+}
+
 // DoAllNodegroupStackTasks iterates over nodegroup tasks and returns any errors.
 func (m *NodeGroupService) DoAllNodegroupStackTasks(taskTree *tasks.TaskTree, region, name string) error {
 	logger.Info(taskTree.Describe())
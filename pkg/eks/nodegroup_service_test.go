@@ -1,7 +1,9 @@
 package eks_test
 
 import (
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/instancetypes"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -25,7 +27,11 @@ var _ = Describe("Instance Selector", func() {
 	makeInstanceSelector := func(instanceTypes ...string) func() *fakes.FakeInstanceSelector {
 		return func() *fakes.FakeInstanceSelector {
 			s := &fakes.FakeInstanceSelector{}
-			s.FilterReturns(instanceTypes, nil)
+			var details []instancetypes.Details
+			for _, instanceType := range instanceTypes {
+				details = append(details, instanceTypeDetails(instanceType, 2, nil))
+			}
+			s.FilterVerboseReturns(details, nil)
 			return s
 		}
 	}
@@ -41,10 +47,10 @@ var _ = Describe("Instance Selector", func() {
 			Expect(err.Error()).To(ContainSubstring(isc.expectedErr))
 			return
 		}
-		Expect(instanceSelectorFake.FilterCallCount()).To(Equal(len(isc.nodeGroups)))
+		Expect(instanceSelectorFake.FilterVerboseCallCount()).To(Equal(len(isc.nodeGroups)))
 
 		for i := range isc.nodeGroups {
-			Expect(*instanceSelectorFake.FilterArgsForCall(i).AvailabilityZones).To(Equal(isc.expectedAZs))
+			Expect(*instanceSelectorFake.FilterVerboseArgsForCall(i).AvailabilityZones).To(Equal(isc.expectedAZs))
 		}
 
 		Expect(err).NotTo(HaveOccurred())
@@ -216,8 +222,83 @@ var _ = Describe("Instance Selector", func() {
 			expectedInstanceTypes:      []string{"c3.large", "c4.large", "c5.large"},
 		}),
 	)
+
+	Describe("Filtering instance types", func() {
+		var (
+			instanceSelectorFake *fakes.FakeInstanceSelector
+			ng                   *api.ManagedNodeGroup
+		)
+
+		BeforeEach(func() {
+			instanceSelectorFake = &fakes.FakeInstanceSelector{}
+			instanceSelectorFake.FilterVerboseReturns([]instancetypes.Details{
+				instanceTypeDetails("m5.large", 2, aws.Float64(0.096)),
+				instanceTypeDetails("g4dn.xlarge", 4, aws.Float64(0.526), &ec2.GpuDeviceInfo{
+					Name:         aws.String("T4"),
+					Manufacturer: aws.String("NVIDIA"),
+				}),
+				instanceTypeDetails("g5.xlarge", 4, aws.Float64(1.006), &ec2.GpuDeviceInfo{
+					Name:         aws.String("A10G"),
+					Manufacturer: aws.String("NVIDIA"),
+				}),
+				instanceTypeDetails("x2gd.large", 2, nil),
+			}, nil)
+			ng = &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{}}
+		})
+
+		expand := func(ins *api.InstanceSelector) error {
+			ng.InstanceSelector = ins
+			return eks.NewNodeGroupService(nil, instanceSelectorFake).ExpandInstanceSelectorOptions([]api.NodePool{ng}, []string{"az1"})
+		}
+
+		It("passes burstable exclusion and allow and deny lists to the instance selector", func() {
+			Expect(expand(&api.InstanceSelector{
+				ExcludeBurstable: true,
+				AllowList:        "^(m5|g4dn)",
+				DenyList:         "metal",
+			})).To(Succeed())
+			filters := instanceSelectorFake.FilterVerboseArgsForCall(0)
+			Expect(*filters.Burstable).To(BeFalse())
+			Expect(filters.AllowList.String()).To(Equal("^(m5|g4dn)"))
+			Expect(filters.DenyList.String()).To(Equal("metal"))
+		})
+
+		It("selects instance types with GPUs of the model", func() {
+			Expect(expand(&api.InstanceSelector{GPUType: "t4"})).To(Succeed())
+			Expect(ng.InstanceTypes).To(Equal([]string{"g4dn.xlarge"}))
+		})
+
+		It("selects instance types with GPUs of the manufacturer", func() {
+			Expect(expand(&api.InstanceSelector{GPUType: "nvidia"})).To(Succeed())
+			Expect(ng.InstanceTypes).To(Equal([]string{"g4dn.xlarge", "g5.xlarge"}))
+		})
+
+		It("selects instance types with a known price per vCPU below the maximum", func() {
+			Expect(expand(&api.InstanceSelector{MaxPricePerVCPU: aws.Float64(0.15)})).To(Succeed())
+			Expect(ng.InstanceTypes).To(Equal([]string{"m5.large", "g4dn.xlarge"}))
+		})
+
+		It("fails when no instance type matches the filters", func() {
+			err := expand(&api.InstanceSelector{GPUType: "A100"})
+			Expect(err).To(MatchError(ContainSubstring("instance selector criteria matched no instances")))
+		})
+	})
 })
 
+func instanceTypeDetails(instanceType string, vCPUs int64, pricePerHour *float64, gpus ...*ec2.GpuDeviceInfo) instancetypes.Details {
+	details := instancetypes.Details{
+		InstanceTypeInfo: ec2.InstanceTypeInfo{
+			InstanceType: aws.String(instanceType),
+			VCpuInfo:     &ec2.VCpuInfo{DefaultVCpus: aws.Int64(vCPUs)},
+		},
+		OndemandPricePerHour: pricePerHour,
+	}
+	if len(gpus) > 0 {
+		details.GpuInfo = &ec2.GpuInfo{Gpus: gpus}
+	}
+	return details
+}
+
 func tooManyTypes() []string {
 	instances := make([]string, 41)
 	for i := range instances {
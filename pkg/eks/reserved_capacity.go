@@ -0,0 +1,116 @@
+package eks
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
+	"github.com/aws/aws-sdk-go/service/savingsplans/savingsplansiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+// reservedCapacity finds the instance families covered by the active Reserved Instances and EC2 Instance Savings
+// Plans of the account in a region
+type reservedCapacity struct {
+	ec2API          ec2iface.EC2API
+	savingsPlansAPI savingsplansiface.SavingsPlansAPI
+	region          string
+}
+
+// coveredFamilies returns the set of covered instance families, and whether the account has a Compute Savings Plan,
+// which covers all instance families
+func (r *reservedCapacity) coveredFamilies() (map[string]bool, bool, error) {
+	families := map[string]bool{}
+
+	reservations, err := r.ec2API.DescribeReservedInstances(&ec2.DescribeReservedInstancesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.ReservedInstanceStateActive}),
+			},
+		},
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "describing Reserved Instances")
+	}
+	for _, ri := range reservations.ReservedInstances {
+		families[instanceFamily(aws.StringValue(ri.InstanceType))] = true
+	}
+
+	computeSavingsPlan := false
+	input := &savingsplans.DescribeSavingsPlansInput{
+		States: aws.StringSlice([]string{savingsplans.SavingsPlanStateActive}),
+	}
+	for {
+		output, err := r.savingsPlansAPI.DescribeSavingsPlans(input)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "describing Savings Plans")
+		}
+		for _, sp := range output.SavingsPlans {
+			switch aws.StringValue(sp.SavingsPlanType) {
+			case savingsplans.SavingsPlanTypeCompute:
+				computeSavingsPlan = true
+			case savingsplans.SavingsPlanTypeEc2instance:
+				if aws.StringValue(sp.Region) == r.region {
+					families[aws.StringValue(sp.Ec2InstanceFamily)] = true
+				}
+			}
+		}
+		if output.NextToken == nil || *output.NextToken == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return families, computeSavingsPlan, nil
+}
+
+// instanceFamily returns the family of an instance type, e.g. m5 for m5.large
+func instanceFamily(instanceType string) string {
+	return strings.SplitN(instanceType, ".", 2)[0]
+}
+
+// preferCoveredFamilies returns the instance types with those of the covered families first, keeping the order of
+// instance types otherwise, as nodegroups launch on-demand instances of the first instance types first
+func preferCoveredFamilies(instanceTypes []string, families map[string]bool) []string {
+	ordered := append([]string(nil), instanceTypes...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return families[instanceFamily(ordered[i])] && !families[instanceFamily(ordered[j])]
+	})
+	return ordered
+}
+
+// orderByReservedCapacity orders the instance types by the coverage of Reserved Instances and Savings Plans of the
+// account, fetching the coverage the first time it is needed
+func (m *NodeGroupService) orderByReservedCapacity(instanceTypes []string) ([]string, error) {
+	if m.coveredFamilies == nil {
+		r := &reservedCapacity{
+			ec2API:          m.Provider.EC2(),
+			savingsPlansAPI: m.Provider.SavingsPlans(),
+			region:          m.Provider.Region(),
+		}
+		families, computeSavingsPlan, err := r.coveredFamilies()
+		if err != nil {
+			return nil, errors.Wrap(err, "error finding instance families covered by Reserved Instances and Savings Plans")
+		}
+		if computeSavingsPlan {
+			logger.Info("the account has a Compute Savings Plan, which covers all instance families")
+		}
+		m.coveredFamilies = families
+	}
+	ordered := preferCoveredFamilies(instanceTypes, m.coveredFamilies)
+	var covered []string
+	for _, instanceType := range ordered {
+		if m.coveredFamilies[instanceFamily(instanceType)] {
+			covered = append(covered, instanceType)
+		}
+	}
+	if len(covered) > 0 {
+		logger.Info("preferring instance types %v covered by Reserved Instances or Savings Plans", covered)
+	}
+	return ordered, nil
+}
@@ -0,0 +1,109 @@
+package eks_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
+	"github.com/aws/aws-sdk-go/service/savingsplans/savingsplansiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+// fakeSavingsPlans returns the pages of savings plans in order
+type fakeSavingsPlans struct {
+	savingsplansiface.SavingsPlansAPI
+	pages  []*savingsplans.DescribeSavingsPlansOutput
+	inputs []*savingsplans.DescribeSavingsPlansInput
+}
+
+func (f *fakeSavingsPlans) DescribeSavingsPlans(input *savingsplans.DescribeSavingsPlansInput) (*savingsplans.DescribeSavingsPlansOutput, error) {
+	copied := *input
+	f.inputs = append(f.inputs, &copied)
+	page := f.pages[0]
+	f.pages = f.pages[1:]
+	return page, nil
+}
+
+var _ = Describe("Reserved capacity", func() {
+	var (
+		p            *mockprovider.MockProvider
+		savingsPlans *fakeSavingsPlans
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		savingsPlans = &fakeSavingsPlans{}
+	})
+
+	It("returns the families of active Reserved Instances and EC2 Instance Savings Plans of the region", func() {
+		p.MockEC2().On("DescribeReservedInstances", mock.MatchedBy(func(input *ec2.DescribeReservedInstancesInput) bool {
+			return len(input.Filters) == 1 && *input.Filters[0].Name == "state" && *input.Filters[0].Values[0] == "active"
+		})).Return(&ec2.DescribeReservedInstancesOutput{
+			ReservedInstances: []*ec2.ReservedInstances{
+				{InstanceType: aws.String("m5.large")},
+				{InstanceType: aws.String("m5.2xlarge")},
+			},
+		}, nil)
+		savingsPlans.pages = []*savingsplans.DescribeSavingsPlansOutput{
+			{
+				SavingsPlans: []*savingsplans.SavingsPlan{
+					{SavingsPlanType: aws.String(savingsplans.SavingsPlanTypeEc2instance), Ec2InstanceFamily: aws.String("c5"), Region: aws.String("us-west-2")},
+					{SavingsPlanType: aws.String(savingsplans.SavingsPlanTypeEc2instance), Ec2InstanceFamily: aws.String("r5"), Region: aws.String("eu-west-1")},
+				},
+				NextToken: aws.String("token"),
+			},
+			{
+				SavingsPlans: []*savingsplans.SavingsPlan{
+					{SavingsPlanType: aws.String(savingsplans.SavingsPlanTypeSageMaker)},
+				},
+			},
+		}
+
+		families, computeSavingsPlan, err := eks.CoveredInstanceFamiliesWithAPIs(p.EC2(), savingsPlans, "us-west-2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(families).To(Equal(map[string]bool{"m5": true, "c5": true}))
+		Expect(computeSavingsPlan).To(BeFalse())
+
+		Expect(savingsPlans.inputs).To(HaveLen(2))
+		Expect(aws.StringValueSlice(savingsPlans.inputs[0].States)).To(Equal([]string{"active"}))
+		Expect(savingsPlans.inputs[0].NextToken).To(BeNil())
+		Expect(*savingsPlans.inputs[1].NextToken).To(Equal("token"))
+	})
+
+	It("reports Compute Savings Plans", func() {
+		p.MockEC2().On("DescribeReservedInstances", mock.Anything).Return(&ec2.DescribeReservedInstancesOutput{}, nil)
+		savingsPlans.pages = []*savingsplans.DescribeSavingsPlansOutput{
+			{
+				SavingsPlans: []*savingsplans.SavingsPlan{
+					{SavingsPlanType: aws.String(savingsplans.SavingsPlanTypeCompute)},
+				},
+			},
+		}
+
+		families, computeSavingsPlan, err := eks.CoveredInstanceFamiliesWithAPIs(p.EC2(), savingsPlans, "us-west-2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(families).To(BeEmpty())
+		Expect(computeSavingsPlan).To(BeTrue())
+	})
+
+	It("returns an error when Reserved Instances cannot be described", func() {
+		p.MockEC2().On("DescribeReservedInstances", mock.Anything).Return(nil, errors.New("access denied"))
+
+		_, _, err := eks.CoveredInstanceFamiliesWithAPIs(p.EC2(), savingsPlans, "us-west-2")
+		Expect(err).To(MatchError(ContainSubstring("describing Reserved Instances: access denied")))
+	})
+
+	It("orders the instance types of covered families first", func() {
+		ordered := eks.PreferCoveredFamilies(
+			[]string{"c5.large", "m5.large", "t3.large", "m5a.large", "r5.large"},
+			map[string]bool{"m5": true, "r5": true},
+		)
+		Expect(ordered).To(Equal([]string{"m5.large", "r5.large", "c5.large", "t3.large", "m5a.large"}))
+	})
+})
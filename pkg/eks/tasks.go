@@ -22,7 +22,7 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/actions/irsa"
 	"github.com/weaveworks/eksctl/pkg/addons"
-	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
 	"github.com/weaveworks/eksctl/pkg/fargate"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
@@ -94,7 +94,7 @@ func (v *VPCControllerTask) Do(errCh chan error) error {
 		return err
 	}
 
-	stackCollection := manager.NewStackCollection(v.ClusterProvider.Provider, v.ClusterConfig)
+	stackCollection := v.ClusterProvider.NewStackManager(v.ClusterConfig)
 
 	clientSet, err := v.ClusterProvider.NewStdClientSet(v.ClusterConfig)
 	if err != nil {
@@ -181,6 +181,23 @@ func newEFADevicePluginTask(
 	return &t
 }
 
+func newSpotFallbackPrioritiesTask(
+	clusterProvider *ClusterProvider,
+	spec *api.ClusterConfig,
+	groups []string,
+) tasks.Task {
+	return &tasks.GenericTask{
+		Description: "configure cluster-autoscaler priorities of spot fallback nodegroups",
+		Doer: func() error {
+			clientSet, err := clusterProvider.NewStdClientSet(spec)
+			if err != nil {
+				return errors.Wrap(err, "error creating Clientset")
+			}
+			return defaultaddons.ConfigureSpotFallbackPriorities(clientSet, groups)
+		},
+	}
+}
+
 type restartDaemonsetTask struct {
 	name            string
 	namespace       string
@@ -382,6 +399,10 @@ func (c *ClusterProvider) ClusterTasksForNodeGroups(cfg *api.ClusterConfig, inst
 		tasks.Append(newEFADevicePluginTask(c, cfg))
 	}
 
+	if groups := cfg.SpotFallbackGroups(); len(groups) > 0 {
+		tasks.Append(newSpotFallbackPrioritiesTask(c, cfg, groups))
+	}
+
 	return tasks
 }
 
@@ -0,0 +1,27 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+)
+
+// The version of aws-sdk-go eksctl is built with predates the upgrade policies of clusters and the configuration
+// values of addons. Until it is bumped, the EKS operations using them are sent by SendEKSRequest with shapes holding
+// the missing fields; it should be replaced by the typed EKS API once the SDK is bumped
+
+// eksRequestSender is implemented by the EKS client of aws-sdk-go
+type eksRequestSender interface {
+	NewRequest(operation *request.Operation, params, data interface{}) *request.Request
+}
+
+// SendEKSRequest sends an EKS request with the given shapes through the EKS client of aws-sdk-go, eksAPI must be
+// such a client rather than another implementation of eksiface.EKSAPI
+func SendEKSRequest(eksAPI eksiface.EKSAPI, operation *request.Operation, input, output interface{}) error {
+	sender, ok := eksAPI.(eksRequestSender)
+	if !ok {
+		return fmt.Errorf("EKS client %T cannot send %s requests, which require the EKS client of aws-sdk-go", eksAPI, operation.Name)
+	}
+	return sender.NewRequest(operation, input, output).Send()
+}
@@ -0,0 +1,91 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// The shapes of the EKS operations reading and updating upgrade policies, sent with SendEKSRequest, only hold the
+// fields eksctl uses
+
+type upgradePolicy struct {
+	_ struct{} `type:"structure"`
+
+	SupportType *string `locationName:"supportType" type:"string"`
+}
+
+type describeClusterUpgradePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `location:"uri" locationName:"name" type:"string" required:"true"`
+}
+
+type describeClusterUpgradePolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	Cluster *clusterUpgradePolicy `locationName:"cluster" type:"structure"`
+}
+
+type clusterUpgradePolicy struct {
+	_ struct{} `type:"structure"`
+
+	UpgradePolicy *upgradePolicy `locationName:"upgradePolicy" type:"structure"`
+}
+
+type updateClusterUpgradePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	Name          *string        `location:"uri" locationName:"name" type:"string" required:"true"`
+	UpgradePolicy *upgradePolicy `locationName:"upgradePolicy" type:"structure"`
+}
+
+type updateClusterUpgradePolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	Update *eks.Update `locationName:"update" type:"structure"`
+}
+
+// GetCurrentSupportType returns the support type of the cluster, or an empty string if EKS does not report it
+func (c *ClusterProvider) GetCurrentSupportType(clusterName string) (string, error) {
+	output := &describeClusterUpgradePolicyOutput{}
+	err := SendEKSRequest(c.Provider.EKS(), &request.Operation{
+		Name:       "DescribeCluster",
+		HTTPMethod: "GET",
+		HTTPPath:   "/clusters/{name}",
+	}, &describeClusterUpgradePolicyInput{Name: aws.String(clusterName)}, output)
+	if err != nil {
+		return "", errors.Wrapf(err, "describing upgrade policy of cluster %q", clusterName)
+	}
+	if output.Cluster == nil || output.Cluster.UpgradePolicy == nil {
+		return "", nil
+	}
+	return aws.StringValue(output.Cluster.UpgradePolicy.SupportType), nil
+}
+
+// UpdateSupportType calls eks.UpdateClusterConfig and updates the support type of the cluster
+func (c *ClusterProvider) UpdateSupportType(clusterConfig *api.ClusterConfig) error {
+	output := &updateClusterUpgradePolicyOutput{}
+	err := SendEKSRequest(c.Provider.EKS(), &request.Operation{
+		Name:       "UpdateClusterConfig",
+		HTTPMethod: "POST",
+		HTTPPath:   "/clusters/{name}/update-config",
+	}, &updateClusterUpgradePolicyInput{
+		Name: aws.String(clusterConfig.Metadata.Name),
+		UpgradePolicy: &upgradePolicy{
+			SupportType: aws.String(clusterConfig.UpgradePolicy.SupportType),
+		},
+	}, output)
+	if err != nil {
+		return err
+	}
+	if output.Update == nil {
+		return fmt.Errorf("unexpected response from EKS API - no update returned for cluster %q", clusterConfig.Metadata.Name)
+	}
+	return c.waitForUpdateToSucceed(clusterConfig.Metadata.Name, output.Update)
+}
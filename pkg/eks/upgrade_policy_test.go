@@ -0,0 +1,107 @@
+package eks_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+type eksEndpointProvider struct {
+	*mockprovider.MockProvider
+	eks eksiface.EKSAPI
+}
+
+func (p *eksEndpointProvider) EKS() eksiface.EKSAPI { return p.eks }
+
+var _ = Describe("Upgrade policy", func() {
+	var (
+		ctl      *ClusterProvider
+		server   *httptest.Server
+		requests []string
+		bodies   []map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		bodies = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			if len(body) > 0 {
+				var decoded map[string]interface{}
+				Expect(json.Unmarshal(body, &decoded)).To(Succeed())
+				bodies = append(bodies, decoded)
+			}
+
+			switch r.URL.Path {
+			case "/clusters/my-cluster":
+				_, _ = w.Write([]byte(`{"cluster": {"name": "my-cluster", "upgradePolicy": {"supportType": "EXTENDED"}}}`))
+			case "/clusters/my-cluster/update-config":
+				_, _ = w.Write([]byte(`{"update": {"id": "u123", "type": "UpgradePolicyUpdate", "status": "InProgress"}}`))
+			case "/clusters/my-cluster/updates/u123":
+				_, _ = w.Write([]byte(`{"update": {"id": "u123", "type": "UpgradePolicyUpdate", "status": "Successful"}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		sess := session.Must(session.NewSession(&aws.Config{
+			Region:      aws.String("us-west-2"),
+			Endpoint:    aws.String(server.URL),
+			Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		}))
+		ctl = &ClusterProvider{
+			Provider: &eksEndpointProvider{
+				MockProvider: mockprovider.NewMockProvider(),
+				eks:          awseks.New(sess),
+			},
+			Status: &ProviderStatus{},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("reads the support type of the cluster", func() {
+		supportType, err := ctl.GetCurrentSupportType("my-cluster")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(supportType).To(Equal(api.SupportTypeExtended))
+		Expect(requests).To(Equal([]string{"GET /clusters/my-cluster"}))
+	})
+
+	It("updates the support type and waits for the update to succeed", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.UpgradePolicy = &api.UpgradePolicy{SupportType: api.SupportTypeStandard}
+
+		Expect(ctl.UpdateSupportType(cfg)).To(Succeed())
+		Expect(requests).To(Equal([]string{
+			"POST /clusters/my-cluster/update-config",
+			"GET /clusters/my-cluster/updates/u123",
+		}))
+		Expect(bodies).To(ConsistOf(map[string]interface{}{
+			"upgradePolicy": map[string]interface{}{"supportType": "STANDARD"},
+		}))
+	})
+
+	It("fails with EKS clients that cannot send requests", func() {
+		ctl.Provider = mockprovider.NewMockProvider()
+		_, err := ctl.GetCurrentSupportType("my-cluster")
+		Expect(err).To(MatchError(ContainSubstring("cannot send DescribeCluster requests")))
+	})
+})
@@ -0,0 +1,203 @@
+package eks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// autoScalingServiceLinkedRole is the role used by EC2 Auto Scaling, for both managed and
+// self-managed nodegroups, to launch instances with encrypted volumes
+const autoScalingServiceLinkedRole = "AWSServiceRoleForAutoScaling"
+
+// ValidateVolumeEncryption checks that the KMS keys used to encrypt the volumes of the nodegroups,
+// either set in volumeKmsKeyID or the account's default EBS encryption key, can be used by the
+// Auto Scaling service-linked role; otherwise instances fail to launch without a clear error
+func (m *NodeGroupService) ValidateVolumeEncryption(nodePools []api.NodePool, provider api.ClusterProvider) error {
+	v := &volumeEncryptionValidator{
+		ec2API: provider.EC2(),
+		kmsAPI: provider.KMS(),
+	}
+	return v.validate(nodePools)
+}
+
+type volumeEncryptionValidator struct {
+	ec2API ec2iface.EC2API
+	kmsAPI kmsiface.KMSAPI
+
+	defaultKeyID *string
+}
+
+func (v *volumeEncryptionValidator) validate(nodePools []api.NodePool) error {
+	for _, np := range nodePools {
+		ng := np.BaseNodeGroup()
+		if api.IsSetAndNonEmptyString(ng.VolumeKmsKeyID) {
+			if err := v.validateKey(*ng.VolumeKmsKeyID); err != nil {
+				return errors.Wrapf(err, "invalid volumeKmsKeyID for nodegroup %q", ng.Name)
+			}
+			continue
+		}
+
+		defaultKeyID, err := v.getDefaultKeyID()
+		if err != nil {
+			return err
+		}
+		if defaultKeyID == "" {
+			continue
+		}
+		if api.IsDisabled(ng.VolumeEncrypted) {
+			logger.Warning("EBS encryption by default is enabled in this region, volumes of nodegroup %q will be encrypted even though volumeEncrypted is disabled", ng.Name)
+		}
+		if err := v.validateKey(defaultKeyID); err != nil {
+			return errors.Wrapf(err, "EBS encryption by default is enabled in this region, and the default KMS key cannot be used by nodegroup %q; set volumeKmsKeyID to use a different key", ng.Name)
+		}
+	}
+	return nil
+}
+
+// getDefaultKeyID returns the KMS key used for EBS encryption by default, or an empty string if it is disabled
+func (v *volumeEncryptionValidator) getDefaultKeyID() (string, error) {
+	if v.defaultKeyID != nil {
+		return *v.defaultKeyID, nil
+	}
+
+	var keyID string
+	encryption, err := v.ec2API.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
+	if err != nil {
+		return "", errors.Wrap(err, "checking EBS encryption by default")
+	}
+	if aws.BoolValue(encryption.EbsEncryptionByDefault) {
+		output, err := v.ec2API.GetEbsDefaultKmsKeyId(&ec2.GetEbsDefaultKmsKeyIdInput{})
+		if err != nil {
+			return "", errors.Wrap(err, "getting the default KMS key for EBS encryption")
+		}
+		keyID = aws.StringValue(output.KmsKeyId)
+	}
+	v.defaultKeyID = &keyID
+	return keyID, nil
+}
+
+func (v *volumeEncryptionValidator) validateKey(keyID string) error {
+	key, err := v.kmsAPI.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String(keyID)})
+	if err != nil {
+		if isAccessDenied(err) {
+			logger.Warning("unable to describe KMS key %q, skipping validation of its key policy: %v", keyID, err)
+			return nil
+		}
+		return errors.Wrapf(err, "describing KMS key %q", keyID)
+	}
+	metadata := key.KeyMetadata
+	if aws.StringValue(metadata.KeyManager) == kms.KeyManagerTypeAws {
+		// AWS managed keys can always be used by the service-linked role
+		return nil
+	}
+	if state := aws.StringValue(metadata.KeyState); state != kms.KeyStateEnabled {
+		return fmt.Errorf("KMS key %q is in state %s", keyID, state)
+	}
+
+	policy, err := v.kmsAPI.GetKeyPolicy(&kms.GetKeyPolicyInput{
+		KeyId:      metadata.Arn,
+		PolicyName: aws.String("default"),
+	})
+	if err != nil {
+		if isAccessDenied(err) {
+			logger.Warning("unable to get the key policy of KMS key %q, skipping its validation: %v", keyID, err)
+			return nil
+		}
+		return errors.Wrapf(err, "getting key policy of KMS key %q", keyID)
+	}
+	allowed, err := keyPolicyAllowsServiceLinkedRole(aws.StringValue(policy.Policy))
+	if err != nil {
+		return errors.Wrapf(err, "parsing key policy of KMS key %q", keyID)
+	}
+	if allowed {
+		return nil
+	}
+
+	granted := false
+	err = v.kmsAPI.ListGrantsPages(&kms.ListGrantsInput{KeyId: metadata.Arn}, func(page *kms.ListGrantsResponse, _ bool) bool {
+		for _, grant := range page.Grants {
+			if strings.Contains(aws.StringValue(grant.GranteePrincipal), autoScalingServiceLinkedRole) {
+				granted = true
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil && !isAccessDenied(err) {
+		return errors.Wrapf(err, "listing grants of KMS key %q", keyID)
+	}
+	if granted {
+		return nil
+	}
+	return fmt.Errorf("neither the key policy nor the grants of KMS key %q allow the %s service-linked role to use it, "+
+		"see https://docs.aws.amazon.com/autoscaling/ec2/userguide/key-policy-requirements-EBS-encryption.html", keyID, autoScalingServiceLinkedRole)
+}
+
+// keyPolicyAllowsServiceLinkedRole returns whether any statement of a key policy allows the
+// Auto Scaling service-linked role, either explicitly or through a wildcard principal
+func keyPolicyAllowsServiceLinkedRole(policy string) (bool, error) {
+	// key policies returned by the API may be URL-encoded
+	if decoded, err := url.QueryUnescape(policy); err == nil {
+		policy = decoded
+	}
+
+	var document struct {
+		Statement []struct {
+			Effect    string
+			Principal interface{}
+		}
+	}
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return false, err
+	}
+
+	for _, statement := range document.Statement {
+		if statement.Effect != "Allow" {
+			continue
+		}
+		for _, principal := range principalValues(statement.Principal) {
+			if principal == "*" || strings.HasSuffix(principal, "/"+autoScalingServiceLinkedRole) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func principalValues(principal interface{}) []string {
+	switch p := principal.(type) {
+	case string:
+		return []string{p}
+	case []interface{}:
+		var values []string
+		for _, v := range p {
+			values = append(values, principalValues(v)...)
+		}
+		return values
+	case map[string]interface{}:
+		var values []string
+		for _, v := range p {
+			values = append(values, principalValues(v)...)
+		}
+		return values
+	}
+	return nil
+}
+
+func isAccessDenied(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && (awsErr.Code() == "AccessDeniedException" || awsErr.Code() == "AccessDenied")
+}
@@ -0,0 +1,162 @@
+package eks_test
+
+import (
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+const (
+	slrPolicy  = `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": ["arn:aws:iam::123456789012:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"]}, "Action": "kms:*", "Resource": "*"}]}`
+	rootPolicy = `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "kms:*", "Resource": "*"}]}`
+)
+
+type fakeKMS struct {
+	kmsiface.KMSAPI
+
+	keyManager  string
+	keyState    string
+	policy      string
+	grants      []*kms.GrantListEntry
+	describeErr error
+}
+
+func (f *fakeKMS) DescribeKey(input *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+	if f.describeErr != nil {
+		return nil, f.describeErr
+	}
+	return &kms.DescribeKeyOutput{
+		KeyMetadata: &kms.KeyMetadata{
+			Arn:        aws.String("arn:aws:kms:us-west-2:123456789012:key/" + *input.KeyId),
+			KeyManager: aws.String(f.keyManager),
+			KeyState:   aws.String(f.keyState),
+		},
+	}, nil
+}
+
+func (f *fakeKMS) GetKeyPolicy(_ *kms.GetKeyPolicyInput) (*kms.GetKeyPolicyOutput, error) {
+	return &kms.GetKeyPolicyOutput{Policy: aws.String(f.policy)}, nil
+}
+
+func (f *fakeKMS) ListGrantsPages(_ *kms.ListGrantsInput, fn func(*kms.ListGrantsResponse, bool) bool) error {
+	fn(&kms.ListGrantsResponse{Grants: f.grants}, true)
+	return nil
+}
+
+var _ = Describe("Volume encryption validation", func() {
+	DescribeTable("key policies", func(policy string, expected bool) {
+		allowed, err := eks.KeyPolicyAllowsServiceLinkedRole(policy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(Equal(expected))
+	},
+		Entry("service-linked role principal", slrPolicy, true),
+		Entry("URL-encoded policy", url.QueryEscape(slrPolicy), true),
+		Entry("wildcard principal", `{"Statement": [{"Effect": "Allow", "Principal": "*"}]}`, true),
+		Entry("account root only", rootPolicy, false),
+		Entry("denied service-linked role", `{"Statement": [{"Effect": "Deny", "Principal": {"AWS": "arn:aws:iam::123456789012:role/AWSServiceRoleForAutoScaling"}}]}`, false),
+	)
+
+	var (
+		p         *mockprovider.MockProvider
+		kmsAPI    *fakeKMS
+		nodePools []api.NodePool
+	)
+
+	mockDefaultEncryption := func(enabled bool) {
+		p.MockEC2().On("GetEbsEncryptionByDefault", mock.Anything).Return(&ec2.GetEbsEncryptionByDefaultOutput{
+			EbsEncryptionByDefault: aws.Bool(enabled),
+		}, nil)
+		p.MockEC2().On("GetEbsDefaultKmsKeyId", mock.Anything).Return(&ec2.GetEbsDefaultKmsKeyIdOutput{
+			KmsKeyId: aws.String("default-key"),
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		kmsAPI = &fakeKMS{
+			keyManager: kms.KeyManagerTypeCustomer,
+			keyState:   kms.KeyStateEnabled,
+			policy:     rootPolicy,
+		}
+		ng := api.NewNodeGroup()
+		ng.Name = "ng"
+		nodePools = []api.NodePool{ng}
+	})
+
+	Context("volumeKmsKeyID is set", func() {
+		BeforeEach(func() {
+			nodePools[0].BaseNodeGroup().VolumeKmsKeyID = aws.String("custom-key")
+		})
+
+		It("accepts a key whose policy allows the service-linked role", func() {
+			kmsAPI.policy = slrPolicy
+			Expect(eks.ValidateVolumeEncryptionWithAPIs(p.EC2(), kmsAPI, nodePools)).To(Succeed())
+			p.MockEC2().AssertNotCalled(GinkgoT(), "GetEbsEncryptionByDefault", mock.Anything)
+		})
+
+		It("accepts a key with a grant for the service-linked role", func() {
+			kmsAPI.grants = []*kms.GrantListEntry{{
+				GranteePrincipal: aws.String("arn:aws:iam::123456789012:role/aws-service-role/autoscaling.amazonaws.com/AWSServiceRoleForAutoScaling"),
+			}}
+			Expect(eks.ValidateVolumeEncryptionWithAPIs(p.EC2(), kmsAPI, nodePools)).To(Succeed())
+		})
+
+		It("accepts AWS managed keys", func() {
+			kmsAPI.keyManager = kms.KeyManagerTypeAws
+			Expect(eks.ValidateVolumeEncryptionWithAPIs(p.EC2(), kmsAPI, nodePools)).To(Succeed())
+		})
+
+		It("rejects a key that the service-linked role cannot use", func() {
+			err := eks.ValidateVolumeEncryptionWithAPIs(p.EC2(), kmsAPI, nodePools)
+			Expect(err).To(MatchError(ContainSubstring(`invalid volumeKmsKeyID for nodegroup "ng"`)))
+			Expect(err).To(MatchError(ContainSubstring("AWSServiceRoleForAutoScaling")))
+		})
+
+		It("rejects a disabled key", func() {
+			kmsAPI.keyState = kms.KeyStateDisabled
+			err := eks.ValidateVolumeEncryptionWithAPIs(p.EC2(), kmsAPI, nodePools)
+			Expect(err).To(MatchError(ContainSubstring("is in state Disabled")))
+		})
+
+		It("skips the validation when access to the key is denied", func() {
+			kmsAPI.describeErr = awserr.New("AccessDeniedException", "denied", nil)
+			Expect(eks.ValidateVolumeEncryptionWithAPIs(p.EC2(), kmsAPI, nodePools)).To(Succeed())
+		})
+	})
+
+	Context("volumeKmsKeyID is not set", func() {
+		It("does not check any key when EBS encryption by default is disabled", func() {
+			mockDefaultEncryption(false)
+			Expect(eks.ValidateVolumeEncryptionWithAPIs(p.EC2(), kmsAPI, nodePools)).To(Succeed())
+			p.MockEC2().AssertNotCalled(GinkgoT(), "GetEbsDefaultKmsKeyId", mock.Anything)
+		})
+
+		It("rejects a default key that the service-linked role cannot use", func() {
+			mockDefaultEncryption(true)
+			err := eks.ValidateVolumeEncryptionWithAPIs(p.EC2(), kmsAPI, nodePools)
+			Expect(err).To(MatchError(ContainSubstring("EBS encryption by default is enabled")))
+		})
+
+		It("checks the default key once for all nodegroups", func() {
+			mockDefaultEncryption(true)
+			kmsAPI.policy = slrPolicy
+			ng := api.NewManagedNodeGroup()
+			ng.Name = "mng"
+			nodePools = append(nodePools, ng)
+			Expect(eks.ValidateVolumeEncryptionWithAPIs(p.EC2(), kmsAPI, nodePools)).To(Succeed())
+			p.MockEC2().AssertNumberOfCalls(GinkgoT(), "GetEbsEncryptionByDefault", 1)
+		})
+	})
+})
@@ -0,0 +1,23 @@
+package fargate
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// incompatibleAddons are the EKS addons that cannot work in clusters without nodes, with the reason why
+var incompatibleAddons = map[string]string{
+	"aws-ebs-csi-driver": "EBS volumes cannot be attached to pods running on Fargate",
+}
+
+// ValidateAddons returns an error when one of the addons cannot work in a cluster whose pods all run on Fargate
+func ValidateAddons(addons []*api.Addon) error {
+	for _, addon := range addons {
+		if reason, ok := incompatibleAddons[strings.ToLower(addon.Name)]; ok {
+			return fmt.Errorf("addon %q is not compatible with Fargate: %s", addon.Name, reason)
+		}
+	}
+	return nil
+}
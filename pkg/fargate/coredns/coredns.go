@@ -27,8 +27,17 @@ const (
 	// scheduling.
 	ComputeTypeAnnotationKey = "eks.amazonaws.com/compute-type"
 	computeTypeFargate       = "fargate"
+	// FargateProfileName is the name of the Fargate profile created to
+	// schedule CoreDNS onto Fargate.
+	FargateProfileName = "fp-coredns"
 )
 
+// podLabels are the labels of the pods of EKS' coredns deployment.
+var podLabels = map[string]string{
+	"k8s-app":                     "kube-dns",
+	"eks.amazonaws.com/component": Name,
+}
+
 // IsSchedulableOnFargate analyzes the provided profiles to determine whether
 // EKS' coredns deployment should be scheduled onto Fargate.
 func IsSchedulableOnFargate(profiles []*api.FargateProfile) bool {
@@ -43,7 +52,32 @@ func IsSchedulableOnFargate(profiles []*api.FargateProfile) bool {
 }
 
 func selectsCoreDNS(selector api.FargateProfileSelector) bool {
-	return selector.Namespace == Namespace && len(selector.Labels) == 0
+	if selector.Namespace != Namespace {
+		return false
+	}
+	for k, v := range selector.Labels {
+		if podLabels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// FargateProfile returns a Fargate profile selecting only EKS' coredns pods,
+// so that CoreDNS can be scheduled onto Fargate in clusters where the other
+// kube-system workloads run on nodegroups.
+func FargateProfile() *api.FargateProfile {
+	return &api.FargateProfile{
+		Name: FargateProfileName,
+		Selectors: []api.FargateProfileSelector{
+			{
+				Namespace: Namespace,
+				Labels: map[string]string{
+					"k8s-app": podLabels["k8s-app"],
+				},
+			},
+		},
+	}
 }
 
 // IsScheduledOnFargate checks if EKS' coredns is scheduled onto Fargate.
@@ -102,6 +102,24 @@ var _ = Describe("coredns", func() {
 			Expect(coredns.IsSchedulableOnFargate(cfg.FargateProfiles)).To(BeTrue())
 		})
 
+		It("should return true when a Fargate profile matches kube-system and CoreDNS' labels", func() {
+			Expect(coredns.IsSchedulableOnFargate([]*api.FargateProfile{coredns.FargateProfile()})).To(BeTrue())
+			Expect(coredns.IsSchedulableOnFargate([]*api.FargateProfile{
+				{
+					Name: "system",
+					Selectors: []api.FargateProfileSelector{
+						{
+							Namespace: "kube-system",
+							Labels: map[string]string{
+								"k8s-app":                     "kube-dns",
+								"eks.amazonaws.com/component": "coredns",
+							},
+						},
+					},
+				},
+			})).To(BeTrue())
+		})
+
 		It("should return false when a Fargate profile matches kube-system but has labels", func() {
 			Expect(coredns.IsSchedulableOnFargate(profileNotSelectingCoreDNSBecauseOfLabels)).To(BeFalse())
 		})
@@ -20,6 +20,10 @@ type CreateOptions struct {
 	ProfileSelectorLabels map[string]string
 	// +optional
 	Tags map[string]string
+	// WithCoreDNS also creates a Fargate profile for CoreDNS, unless one of
+	// the profiles selects it, and schedules CoreDNS onto Fargate
+	// +optional
+	WithCoreDNS bool
 }
 
 // Validate validates this Options object's fields.
@@ -23,17 +23,14 @@ func (c *Client) DeleteProfile(name string, waitForDeletion bool) error {
 		return c.waitForDeletion(name)
 	}
 
-	profiles, err := c.api.ListFargateProfiles(&eks.ListFargateProfilesInput{
-		ClusterName: &c.clusterName,
-	})
-
+	profiles, err := c.ListProfiles()
 	if err != nil {
 		return err
 	}
 
 	//If waitForDeletion is false then the profile might still exist until deletion finishes
-	if len(profiles.FargateProfileNames) == 0 ||
-		(len(profiles.FargateProfileNames) == 1 && *profiles.FargateProfileNames[0] == name) {
+	if len(profiles) == 0 ||
+		(len(profiles) == 1 && *profiles[0] == name) {
 		stack, err := c.stackManager.GetFargateStack()
 		if err != nil {
 			logger.Debug("failed to fetch fargate stack to delete, skipping deletion")
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
@@ -94,6 +95,15 @@ var _ = Describe("fargate", func() {
 				Expect(out[1]).To(Equal(apiFargateProfile(testGreen)))
 			})
 
+			It("returns the Fargate profiles of all pages", func() {
+				client := fargate.NewWithRetryPolicy(clusterName, mockForPagedReadProfiles(), &retryPolicy, neverCalledStackManager)
+				out, err := client.ReadProfiles()
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(out).To(HaveLen(2))
+				Expect(out[0]).To(Equal(apiFargateProfile(testBlue)))
+				Expect(out[1]).To(Equal(apiFargateProfile(testGreen)))
+			})
+
 			It("returns an empty array if no Fargate profile exists", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForEmptyReadProfiles(), &retryPolicy, neverCalledStackManager)
 				out, err := client.ReadProfiles()
@@ -322,16 +332,31 @@ func mockForReadProfiles() *mocks.EKSAPI {
 	return &mockClient
 }
 
+func mockForPagedReadProfiles() *mocks.EKSAPI {
+	mockClient := mocks.EKSAPI{}
+	mockClient.Mock.On("ListFargateProfilesPages", &eks.ListFargateProfilesInput{
+		ClusterName: strings.Pointer(clusterName),
+	}, mock.Anything).Once().Run(func(args mock.Arguments) {
+		consume := args[1].(func(*eks.ListFargateProfilesOutput, bool) bool)
+		consume(&eks.ListFargateProfilesOutput{FargateProfileNames: []*string{strings.Pointer(testBlue)}}, false)
+		consume(&eks.ListFargateProfilesOutput{FargateProfileNames: []*string{strings.Pointer(testGreen)}}, true)
+	}).Return(nil)
+	mockDescribeFargateProfile(&mockClient, testBlue, "ACTIVE")
+	mockDescribeFargateProfile(&mockClient, testGreen, "ACTIVE")
+	return &mockClient
+}
+
 func mockListFargateProfiles(mockClient *mocks.EKSAPI, names ...string) {
 	profileNames := make([]*string, len(names))
 	for i, name := range names {
 		profileNames[i] = strings.Pointer(name)
 	}
-	mockClient.Mock.On("ListFargateProfiles", &eks.ListFargateProfilesInput{
+	mockClient.Mock.On("ListFargateProfilesPages", &eks.ListFargateProfilesInput{
 		ClusterName: strings.Pointer(clusterName),
-	}).Once().Return(&eks.ListFargateProfilesOutput{
-		FargateProfileNames: profileNames,
-	}, nil)
+	}, mock.Anything).Once().Run(func(args mock.Arguments) {
+		consume := args[1].(func(*eks.ListFargateProfilesOutput, bool) bool)
+		consume(&eks.ListFargateProfilesOutput{FargateProfileNames: profileNames}, true)
+	}).Return(nil)
 }
 
 func mockForReadProfile() *mocks.EKSAPI {
@@ -394,9 +419,9 @@ func mockForEmptyReadProfile() *mocks.EKSAPI {
 
 func mockForFailureOnReadProfiles() *mocks.EKSAPI {
 	mockClient := mocks.EKSAPI{}
-	mockClient.Mock.On("ListFargateProfiles", &eks.ListFargateProfilesInput{
+	mockClient.Mock.On("ListFargateProfilesPages", &eks.ListFargateProfilesInput{
 		ClusterName: strings.Pointer(clusterName),
-	}).Return(nil, errors.New("the Internet broke down"))
+	}, mock.Anything).Return(errors.New("the Internet broke down"))
 	return &mockClient
 }
 
@@ -38,12 +38,15 @@ func (c *Client) ReadProfiles() ([]*api.FargateProfile, error) {
 
 // ListProfiles lists all existing Fargate profiles.
 func (c *Client) ListProfiles() ([]*string, error) {
-	out, err := c.api.ListFargateProfiles(listRequest(c.clusterName))
-	if err != nil {
+	var profiles []*string
+	if err := c.api.ListFargateProfilesPages(listRequest(c.clusterName), func(out *eks.ListFargateProfilesOutput, _ bool) bool {
+		logger.Debug("Fargate profile: list request: received %v profile(s): %#v", len(out.FargateProfileNames), out)
+		profiles = append(profiles, out.FargateProfileNames...)
+		return true
+	}); err != nil {
 		return nil, errors.Wrapf(err, "failed to get Fargate profile(s) for cluster %q", c.clusterName)
 	}
-	logger.Debug("Fargate profile: list request: received %v profile(s): %#v", len(out.FargateProfileNames), out)
-	return out.FargateProfileNames, nil
+	return profiles, nil
 }
 
 func listRequest(clusterName string) *eks.ListFargateProfilesInput {
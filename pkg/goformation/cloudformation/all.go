@@ -0,0 +1,3346 @@
+package cloudformation
+
+import (
+	"fmt"
+
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudformation"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/cloudwatch"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/eks"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/iam"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/logs"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/route53"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/route53resolver"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/sns"
+)
+
+// AllResources fetches an iterable map all CloudFormation and SAM resources
+func AllResources() map[string]Resource {
+	return map[string]Resource{
+		"AWS::CloudFormation::CustomResource":                         &cloudformation.CustomResource{},
+		"AWS::CloudFormation::Macro":                                  &cloudformation.Macro{},
+		"AWS::CloudFormation::ModuleDefaultVersion":                   &cloudformation.ModuleDefaultVersion{},
+		"AWS::CloudFormation::ModuleVersion":                          &cloudformation.ModuleVersion{},
+		"AWS::CloudFormation::PublicTypeVersion":                      &cloudformation.PublicTypeVersion{},
+		"AWS::CloudFormation::Publisher":                              &cloudformation.Publisher{},
+		"AWS::CloudFormation::ResourceDefaultVersion":                 &cloudformation.ResourceDefaultVersion{},
+		"AWS::CloudFormation::ResourceVersion":                        &cloudformation.ResourceVersion{},
+		"AWS::CloudFormation::Stack":                                  &cloudformation.Stack{},
+		"AWS::CloudFormation::StackSet":                               &cloudformation.StackSet{},
+		"AWS::CloudFormation::TypeActivation":                         &cloudformation.TypeActivation{},
+		"AWS::CloudFormation::WaitCondition":                          &cloudformation.WaitCondition{},
+		"AWS::CloudFormation::WaitConditionHandle":                    &cloudformation.WaitConditionHandle{},
+		"AWS::CloudWatch::Alarm":                                      &cloudwatch.Alarm{},
+		"AWS::CloudWatch::AnomalyDetector":                            &cloudwatch.AnomalyDetector{},
+		"AWS::CloudWatch::CompositeAlarm":                             &cloudwatch.CompositeAlarm{},
+		"AWS::CloudWatch::Dashboard":                                  &cloudwatch.Dashboard{},
+		"AWS::CloudWatch::InsightRule":                                &cloudwatch.InsightRule{},
+		"AWS::CloudWatch::MetricStream":                               &cloudwatch.MetricStream{},
+		"AWS::EC2::CapacityReservation":                               &ec2.CapacityReservation{},
+		"AWS::EC2::CapacityReservationFleet":                          &ec2.CapacityReservationFleet{},
+		"AWS::EC2::CarrierGateway":                                    &ec2.CarrierGateway{},
+		"AWS::EC2::ClientVpnAuthorizationRule":                        &ec2.ClientVpnAuthorizationRule{},
+		"AWS::EC2::ClientVpnEndpoint":                                 &ec2.ClientVpnEndpoint{},
+		"AWS::EC2::ClientVpnRoute":                                    &ec2.ClientVpnRoute{},
+		"AWS::EC2::ClientVpnTargetNetworkAssociation":                 &ec2.ClientVpnTargetNetworkAssociation{},
+		"AWS::EC2::CustomerGateway":                                   &ec2.CustomerGateway{},
+		"AWS::EC2::DHCPOptions":                                       &ec2.DHCPOptions{},
+		"AWS::EC2::EC2Fleet":                                          &ec2.EC2Fleet{},
+		"AWS::EC2::EIP":                                               &ec2.EIP{},
+		"AWS::EC2::EIPAssociation":                                    &ec2.EIPAssociation{},
+		"AWS::EC2::EgressOnlyInternetGateway":                         &ec2.EgressOnlyInternetGateway{},
+		"AWS::EC2::EnclaveCertificateIamRoleAssociation":              &ec2.EnclaveCertificateIamRoleAssociation{},
+		"AWS::EC2::FlowLog":                                           &ec2.FlowLog{},
+		"AWS::EC2::GatewayRouteTableAssociation":                      &ec2.GatewayRouteTableAssociation{},
+		"AWS::EC2::Host":                                              &ec2.Host{},
+		"AWS::EC2::IPAM":                                              &ec2.IPAM{},
+		"AWS::EC2::IPAMAllocation":                                    &ec2.IPAMAllocation{},
+		"AWS::EC2::IPAMPool":                                          &ec2.IPAMPool{},
+		"AWS::EC2::IPAMScope":                                         &ec2.IPAMScope{},
+		"AWS::EC2::Instance":                                          &ec2.Instance{},
+		"AWS::EC2::InternetGateway":                                   &ec2.InternetGateway{},
+		"AWS::EC2::LaunchTemplate":                                    &ec2.LaunchTemplate{},
+		"AWS::EC2::LocalGatewayRoute":                                 &ec2.LocalGatewayRoute{},
+		"AWS::EC2::LocalGatewayRouteTableVPCAssociation":              &ec2.LocalGatewayRouteTableVPCAssociation{},
+		"AWS::EC2::NatGateway":                                        &ec2.NatGateway{},
+		"AWS::EC2::NetworkAcl":                                        &ec2.NetworkAcl{},
+		"AWS::EC2::NetworkAclEntry":                                   &ec2.NetworkAclEntry{},
+		"AWS::EC2::NetworkInsightsAnalysis":                           &ec2.NetworkInsightsAnalysis{},
+		"AWS::EC2::NetworkInsightsPath":                               &ec2.NetworkInsightsPath{},
+		"AWS::EC2::NetworkInterface":                                  &ec2.NetworkInterface{},
+		"AWS::EC2::NetworkInterfaceAttachment":                        &ec2.NetworkInterfaceAttachment{},
+		"AWS::EC2::NetworkInterfacePermission":                        &ec2.NetworkInterfacePermission{},
+		"AWS::EC2::PlacementGroup":                                    &ec2.PlacementGroup{},
+		"AWS::EC2::PrefixList":                                        &ec2.PrefixList{},
+		"AWS::EC2::Route":                                             &ec2.Route{},
+		"AWS::EC2::RouteTable":                                        &ec2.RouteTable{},
+		"AWS::EC2::SecurityGroup":                                     &ec2.SecurityGroup{},
+		"AWS::EC2::SecurityGroupEgress":                               &ec2.SecurityGroupEgress{},
+		"AWS::EC2::SecurityGroupIngress":                              &ec2.SecurityGroupIngress{},
+		"AWS::EC2::SpotFleet":                                         &ec2.SpotFleet{},
+		"AWS::EC2::Subnet":                                            &ec2.Subnet{},
+		"AWS::EC2::SubnetCidrBlock":                                   &ec2.SubnetCidrBlock{},
+		"AWS::EC2::SubnetNetworkAclAssociation":                       &ec2.SubnetNetworkAclAssociation{},
+		"AWS::EC2::SubnetRouteTableAssociation":                       &ec2.SubnetRouteTableAssociation{},
+		"AWS::EC2::TrafficMirrorFilter":                               &ec2.TrafficMirrorFilter{},
+		"AWS::EC2::TrafficMirrorFilterRule":                           &ec2.TrafficMirrorFilterRule{},
+		"AWS::EC2::TrafficMirrorSession":                              &ec2.TrafficMirrorSession{},
+		"AWS::EC2::TrafficMirrorTarget":                               &ec2.TrafficMirrorTarget{},
+		"AWS::EC2::TransitGateway":                                    &ec2.TransitGateway{},
+		"AWS::EC2::TransitGatewayAttachment":                          &ec2.TransitGatewayAttachment{},
+		"AWS::EC2::TransitGatewayConnect":                             &ec2.TransitGatewayConnect{},
+		"AWS::EC2::TransitGatewayMulticastDomain":                     &ec2.TransitGatewayMulticastDomain{},
+		"AWS::EC2::TransitGatewayMulticastDomainAssociation":          &ec2.TransitGatewayMulticastDomainAssociation{},
+		"AWS::EC2::TransitGatewayMulticastGroupMember":                &ec2.TransitGatewayMulticastGroupMember{},
+		"AWS::EC2::TransitGatewayMulticastGroupSource":                &ec2.TransitGatewayMulticastGroupSource{},
+		"AWS::EC2::TransitGatewayPeeringAttachment":                   &ec2.TransitGatewayPeeringAttachment{},
+		"AWS::EC2::TransitGatewayRoute":                               &ec2.TransitGatewayRoute{},
+		"AWS::EC2::TransitGatewayRouteTable":                          &ec2.TransitGatewayRouteTable{},
+		"AWS::EC2::TransitGatewayRouteTableAssociation":               &ec2.TransitGatewayRouteTableAssociation{},
+		"AWS::EC2::TransitGatewayRouteTablePropagation":               &ec2.TransitGatewayRouteTablePropagation{},
+		"AWS::EC2::TransitGatewayVpcAttachment":                       &ec2.TransitGatewayVpcAttachment{},
+		"AWS::EC2::VPC":                                               &ec2.VPC{},
+		"AWS::EC2::VPCCidrBlock":                                      &ec2.VPCCidrBlock{},
+		"AWS::EC2::VPCDHCPOptionsAssociation":                         &ec2.VPCDHCPOptionsAssociation{},
+		"AWS::EC2::VPCEndpoint":                                       &ec2.VPCEndpoint{},
+		"AWS::EC2::VPCEndpointConnectionNotification":                 &ec2.VPCEndpointConnectionNotification{},
+		"AWS::EC2::VPCEndpointService":                                &ec2.VPCEndpointService{},
+		"AWS::EC2::VPCEndpointServicePermissions":                     &ec2.VPCEndpointServicePermissions{},
+		"AWS::EC2::VPCGatewayAttachment":                              &ec2.VPCGatewayAttachment{},
+		"AWS::EC2::VPCPeeringConnection":                              &ec2.VPCPeeringConnection{},
+		"AWS::EC2::VPNConnection":                                     &ec2.VPNConnection{},
+		"AWS::EC2::VPNConnectionRoute":                                &ec2.VPNConnectionRoute{},
+		"AWS::EC2::VPNGateway":                                        &ec2.VPNGateway{},
+		"AWS::EC2::VPNGatewayRoutePropagation":                        &ec2.VPNGatewayRoutePropagation{},
+		"AWS::EC2::Volume":                                            &ec2.Volume{},
+		"AWS::EC2::VolumeAttachment":                                  &ec2.VolumeAttachment{},
+		"AWS::EKS::Addon":                                             &eks.Addon{},
+		"AWS::EKS::Cluster":                                           &eks.Cluster{},
+		"AWS::EKS::FargateProfile":                                    &eks.FargateProfile{},
+		"AWS::EKS::Nodegroup":                                         &eks.Nodegroup{},
+		"AWS::IAM::AccessKey":                                         &iam.AccessKey{},
+		"AWS::IAM::Group":                                             &iam.Group{},
+		"AWS::IAM::InstanceProfile":                                   &iam.InstanceProfile{},
+		"AWS::IAM::ManagedPolicy":                                     &iam.ManagedPolicy{},
+		"AWS::IAM::OIDCProvider":                                      &iam.OIDCProvider{},
+		"AWS::IAM::Policy":                                            &iam.Policy{},
+		"AWS::IAM::Role":                                              &iam.Role{},
+		"AWS::IAM::SAMLProvider":                                      &iam.SAMLProvider{},
+		"AWS::IAM::ServerCertificate":                                 &iam.ServerCertificate{},
+		"AWS::IAM::ServiceLinkedRole":                                 &iam.ServiceLinkedRole{},
+		"AWS::IAM::User":                                              &iam.User{},
+		"AWS::IAM::UserToGroupAddition":                               &iam.UserToGroupAddition{},
+		"AWS::IAM::VirtualMFADevice":                                  &iam.VirtualMFADevice{},
+		"AWS::Logs::Destination":                                      &logs.Destination{},
+		"AWS::Logs::LogGroup":                                         &logs.LogGroup{},
+		"AWS::Logs::LogStream":                                        &logs.LogStream{},
+		"AWS::Logs::MetricFilter":                                     &logs.MetricFilter{},
+		"AWS::Logs::QueryDefinition":                                  &logs.QueryDefinition{},
+		"AWS::Logs::ResourcePolicy":                                   &logs.ResourcePolicy{},
+		"AWS::Logs::SubscriptionFilter":                               &logs.SubscriptionFilter{},
+		"AWS::Route53::DNSSEC":                                        &route53.DNSSEC{},
+		"AWS::Route53::HealthCheck":                                   &route53.HealthCheck{},
+		"AWS::Route53::HostedZone":                                    &route53.HostedZone{},
+		"AWS::Route53::KeySigningKey":                                 &route53.KeySigningKey{},
+		"AWS::Route53::RecordSet":                                     &route53.RecordSet{},
+		"AWS::Route53::RecordSetGroup":                                &route53.RecordSetGroup{},
+		"AWS::Route53Resolver::ResolverQueryLoggingConfig":            &route53resolver.ResolverQueryLoggingConfig{},
+		"AWS::Route53Resolver::ResolverQueryLoggingConfigAssociation": &route53resolver.ResolverQueryLoggingConfigAssociation{},
+		"AWS::Route53Resolver::ResolverRuleAssociation":               &route53resolver.ResolverRuleAssociation{},
+		"AWS::SNS::Subscription":                                      &sns.Subscription{},
+		"AWS::SNS::Topic":                                             &sns.Topic{},
+		"AWS::SNS::TopicPolicy":                                       &sns.TopicPolicy{},
+	}
+}
+
+// GetAllCloudFormationCustomResourceResources retrieves all cloudformation.CustomResource items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationCustomResourceResources() map[string]*cloudformation.CustomResource {
+	results := map[string]*cloudformation.CustomResource{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.CustomResource:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationCustomResourceWithName retrieves all cloudformation.CustomResource items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationCustomResourceWithName(name string) (*cloudformation.CustomResource, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.CustomResource:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.CustomResource not found", name)
+}
+
+// GetAllCloudFormationMacroResources retrieves all cloudformation.Macro items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationMacroResources() map[string]*cloudformation.Macro {
+	results := map[string]*cloudformation.Macro{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.Macro:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationMacroWithName retrieves all cloudformation.Macro items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationMacroWithName(name string) (*cloudformation.Macro, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.Macro:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.Macro not found", name)
+}
+
+// GetAllCloudFormationModuleDefaultVersionResources retrieves all cloudformation.ModuleDefaultVersion items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationModuleDefaultVersionResources() map[string]*cloudformation.ModuleDefaultVersion {
+	results := map[string]*cloudformation.ModuleDefaultVersion{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.ModuleDefaultVersion:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationModuleDefaultVersionWithName retrieves all cloudformation.ModuleDefaultVersion items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationModuleDefaultVersionWithName(name string) (*cloudformation.ModuleDefaultVersion, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.ModuleDefaultVersion:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.ModuleDefaultVersion not found", name)
+}
+
+// GetAllCloudFormationModuleVersionResources retrieves all cloudformation.ModuleVersion items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationModuleVersionResources() map[string]*cloudformation.ModuleVersion {
+	results := map[string]*cloudformation.ModuleVersion{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.ModuleVersion:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationModuleVersionWithName retrieves all cloudformation.ModuleVersion items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationModuleVersionWithName(name string) (*cloudformation.ModuleVersion, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.ModuleVersion:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.ModuleVersion not found", name)
+}
+
+// GetAllCloudFormationPublicTypeVersionResources retrieves all cloudformation.PublicTypeVersion items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationPublicTypeVersionResources() map[string]*cloudformation.PublicTypeVersion {
+	results := map[string]*cloudformation.PublicTypeVersion{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.PublicTypeVersion:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationPublicTypeVersionWithName retrieves all cloudformation.PublicTypeVersion items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationPublicTypeVersionWithName(name string) (*cloudformation.PublicTypeVersion, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.PublicTypeVersion:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.PublicTypeVersion not found", name)
+}
+
+// GetAllCloudFormationPublisherResources retrieves all cloudformation.Publisher items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationPublisherResources() map[string]*cloudformation.Publisher {
+	results := map[string]*cloudformation.Publisher{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.Publisher:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationPublisherWithName retrieves all cloudformation.Publisher items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationPublisherWithName(name string) (*cloudformation.Publisher, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.Publisher:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.Publisher not found", name)
+}
+
+// GetAllCloudFormationResourceDefaultVersionResources retrieves all cloudformation.ResourceDefaultVersion items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationResourceDefaultVersionResources() map[string]*cloudformation.ResourceDefaultVersion {
+	results := map[string]*cloudformation.ResourceDefaultVersion{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.ResourceDefaultVersion:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationResourceDefaultVersionWithName retrieves all cloudformation.ResourceDefaultVersion items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationResourceDefaultVersionWithName(name string) (*cloudformation.ResourceDefaultVersion, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.ResourceDefaultVersion:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.ResourceDefaultVersion not found", name)
+}
+
+// GetAllCloudFormationResourceVersionResources retrieves all cloudformation.ResourceVersion items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationResourceVersionResources() map[string]*cloudformation.ResourceVersion {
+	results := map[string]*cloudformation.ResourceVersion{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.ResourceVersion:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationResourceVersionWithName retrieves all cloudformation.ResourceVersion items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationResourceVersionWithName(name string) (*cloudformation.ResourceVersion, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.ResourceVersion:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.ResourceVersion not found", name)
+}
+
+// GetAllCloudFormationStackResources retrieves all cloudformation.Stack items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationStackResources() map[string]*cloudformation.Stack {
+	results := map[string]*cloudformation.Stack{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.Stack:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationStackWithName retrieves all cloudformation.Stack items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationStackWithName(name string) (*cloudformation.Stack, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.Stack:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.Stack not found", name)
+}
+
+// GetAllCloudFormationStackSetResources retrieves all cloudformation.StackSet items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationStackSetResources() map[string]*cloudformation.StackSet {
+	results := map[string]*cloudformation.StackSet{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.StackSet:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationStackSetWithName retrieves all cloudformation.StackSet items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationStackSetWithName(name string) (*cloudformation.StackSet, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.StackSet:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.StackSet not found", name)
+}
+
+// GetAllCloudFormationTypeActivationResources retrieves all cloudformation.TypeActivation items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationTypeActivationResources() map[string]*cloudformation.TypeActivation {
+	results := map[string]*cloudformation.TypeActivation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.TypeActivation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationTypeActivationWithName retrieves all cloudformation.TypeActivation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationTypeActivationWithName(name string) (*cloudformation.TypeActivation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.TypeActivation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.TypeActivation not found", name)
+}
+
+// GetAllCloudFormationWaitConditionResources retrieves all cloudformation.WaitCondition items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationWaitConditionResources() map[string]*cloudformation.WaitCondition {
+	results := map[string]*cloudformation.WaitCondition{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.WaitCondition:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationWaitConditionWithName retrieves all cloudformation.WaitCondition items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationWaitConditionWithName(name string) (*cloudformation.WaitCondition, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.WaitCondition:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.WaitCondition not found", name)
+}
+
+// GetAllCloudFormationWaitConditionHandleResources retrieves all cloudformation.WaitConditionHandle items from an AWS CloudFormation template
+func (t *Template) GetAllCloudFormationWaitConditionHandleResources() map[string]*cloudformation.WaitConditionHandle {
+	results := map[string]*cloudformation.WaitConditionHandle{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudformation.WaitConditionHandle:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudFormationWaitConditionHandleWithName retrieves all cloudformation.WaitConditionHandle items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudFormationWaitConditionHandleWithName(name string) (*cloudformation.WaitConditionHandle, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudformation.WaitConditionHandle:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudformation.WaitConditionHandle not found", name)
+}
+
+// GetAllCloudWatchAlarmResources retrieves all cloudwatch.Alarm items from an AWS CloudFormation template
+func (t *Template) GetAllCloudWatchAlarmResources() map[string]*cloudwatch.Alarm {
+	results := map[string]*cloudwatch.Alarm{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudwatch.Alarm:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudWatchAlarmWithName retrieves all cloudwatch.Alarm items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudWatchAlarmWithName(name string) (*cloudwatch.Alarm, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudwatch.Alarm:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudwatch.Alarm not found", name)
+}
+
+// GetAllCloudWatchAnomalyDetectorResources retrieves all cloudwatch.AnomalyDetector items from an AWS CloudFormation template
+func (t *Template) GetAllCloudWatchAnomalyDetectorResources() map[string]*cloudwatch.AnomalyDetector {
+	results := map[string]*cloudwatch.AnomalyDetector{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudwatch.AnomalyDetector:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudWatchAnomalyDetectorWithName retrieves all cloudwatch.AnomalyDetector items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudWatchAnomalyDetectorWithName(name string) (*cloudwatch.AnomalyDetector, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudwatch.AnomalyDetector:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudwatch.AnomalyDetector not found", name)
+}
+
+// GetAllCloudWatchCompositeAlarmResources retrieves all cloudwatch.CompositeAlarm items from an AWS CloudFormation template
+func (t *Template) GetAllCloudWatchCompositeAlarmResources() map[string]*cloudwatch.CompositeAlarm {
+	results := map[string]*cloudwatch.CompositeAlarm{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudwatch.CompositeAlarm:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudWatchCompositeAlarmWithName retrieves all cloudwatch.CompositeAlarm items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudWatchCompositeAlarmWithName(name string) (*cloudwatch.CompositeAlarm, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudwatch.CompositeAlarm:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudwatch.CompositeAlarm not found", name)
+}
+
+// GetAllCloudWatchDashboardResources retrieves all cloudwatch.Dashboard items from an AWS CloudFormation template
+func (t *Template) GetAllCloudWatchDashboardResources() map[string]*cloudwatch.Dashboard {
+	results := map[string]*cloudwatch.Dashboard{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudwatch.Dashboard:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudWatchDashboardWithName retrieves all cloudwatch.Dashboard items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudWatchDashboardWithName(name string) (*cloudwatch.Dashboard, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudwatch.Dashboard:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudwatch.Dashboard not found", name)
+}
+
+// GetAllCloudWatchInsightRuleResources retrieves all cloudwatch.InsightRule items from an AWS CloudFormation template
+func (t *Template) GetAllCloudWatchInsightRuleResources() map[string]*cloudwatch.InsightRule {
+	results := map[string]*cloudwatch.InsightRule{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudwatch.InsightRule:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudWatchInsightRuleWithName retrieves all cloudwatch.InsightRule items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudWatchInsightRuleWithName(name string) (*cloudwatch.InsightRule, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudwatch.InsightRule:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudwatch.InsightRule not found", name)
+}
+
+// GetAllCloudWatchMetricStreamResources retrieves all cloudwatch.MetricStream items from an AWS CloudFormation template
+func (t *Template) GetAllCloudWatchMetricStreamResources() map[string]*cloudwatch.MetricStream {
+	results := map[string]*cloudwatch.MetricStream{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *cloudwatch.MetricStream:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetCloudWatchMetricStreamWithName retrieves all cloudwatch.MetricStream items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetCloudWatchMetricStreamWithName(name string) (*cloudwatch.MetricStream, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *cloudwatch.MetricStream:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type cloudwatch.MetricStream not found", name)
+}
+
+// GetAllEC2CapacityReservationResources retrieves all ec2.CapacityReservation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2CapacityReservationResources() map[string]*ec2.CapacityReservation {
+	results := map[string]*ec2.CapacityReservation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.CapacityReservation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2CapacityReservationWithName retrieves all ec2.CapacityReservation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2CapacityReservationWithName(name string) (*ec2.CapacityReservation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.CapacityReservation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.CapacityReservation not found", name)
+}
+
+// GetAllEC2CapacityReservationFleetResources retrieves all ec2.CapacityReservationFleet items from an AWS CloudFormation template
+func (t *Template) GetAllEC2CapacityReservationFleetResources() map[string]*ec2.CapacityReservationFleet {
+	results := map[string]*ec2.CapacityReservationFleet{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.CapacityReservationFleet:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2CapacityReservationFleetWithName retrieves all ec2.CapacityReservationFleet items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2CapacityReservationFleetWithName(name string) (*ec2.CapacityReservationFleet, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.CapacityReservationFleet:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.CapacityReservationFleet not found", name)
+}
+
+// GetAllEC2CarrierGatewayResources retrieves all ec2.CarrierGateway items from an AWS CloudFormation template
+func (t *Template) GetAllEC2CarrierGatewayResources() map[string]*ec2.CarrierGateway {
+	results := map[string]*ec2.CarrierGateway{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.CarrierGateway:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2CarrierGatewayWithName retrieves all ec2.CarrierGateway items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2CarrierGatewayWithName(name string) (*ec2.CarrierGateway, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.CarrierGateway:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.CarrierGateway not found", name)
+}
+
+// GetAllEC2ClientVpnAuthorizationRuleResources retrieves all ec2.ClientVpnAuthorizationRule items from an AWS CloudFormation template
+func (t *Template) GetAllEC2ClientVpnAuthorizationRuleResources() map[string]*ec2.ClientVpnAuthorizationRule {
+	results := map[string]*ec2.ClientVpnAuthorizationRule{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.ClientVpnAuthorizationRule:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2ClientVpnAuthorizationRuleWithName retrieves all ec2.ClientVpnAuthorizationRule items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2ClientVpnAuthorizationRuleWithName(name string) (*ec2.ClientVpnAuthorizationRule, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.ClientVpnAuthorizationRule:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.ClientVpnAuthorizationRule not found", name)
+}
+
+// GetAllEC2ClientVpnEndpointResources retrieves all ec2.ClientVpnEndpoint items from an AWS CloudFormation template
+func (t *Template) GetAllEC2ClientVpnEndpointResources() map[string]*ec2.ClientVpnEndpoint {
+	results := map[string]*ec2.ClientVpnEndpoint{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.ClientVpnEndpoint:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2ClientVpnEndpointWithName retrieves all ec2.ClientVpnEndpoint items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2ClientVpnEndpointWithName(name string) (*ec2.ClientVpnEndpoint, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.ClientVpnEndpoint:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.ClientVpnEndpoint not found", name)
+}
+
+// GetAllEC2ClientVpnRouteResources retrieves all ec2.ClientVpnRoute items from an AWS CloudFormation template
+func (t *Template) GetAllEC2ClientVpnRouteResources() map[string]*ec2.ClientVpnRoute {
+	results := map[string]*ec2.ClientVpnRoute{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.ClientVpnRoute:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2ClientVpnRouteWithName retrieves all ec2.ClientVpnRoute items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2ClientVpnRouteWithName(name string) (*ec2.ClientVpnRoute, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.ClientVpnRoute:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.ClientVpnRoute not found", name)
+}
+
+// GetAllEC2ClientVpnTargetNetworkAssociationResources retrieves all ec2.ClientVpnTargetNetworkAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2ClientVpnTargetNetworkAssociationResources() map[string]*ec2.ClientVpnTargetNetworkAssociation {
+	results := map[string]*ec2.ClientVpnTargetNetworkAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.ClientVpnTargetNetworkAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2ClientVpnTargetNetworkAssociationWithName retrieves all ec2.ClientVpnTargetNetworkAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2ClientVpnTargetNetworkAssociationWithName(name string) (*ec2.ClientVpnTargetNetworkAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.ClientVpnTargetNetworkAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.ClientVpnTargetNetworkAssociation not found", name)
+}
+
+// GetAllEC2CustomerGatewayResources retrieves all ec2.CustomerGateway items from an AWS CloudFormation template
+func (t *Template) GetAllEC2CustomerGatewayResources() map[string]*ec2.CustomerGateway {
+	results := map[string]*ec2.CustomerGateway{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.CustomerGateway:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2CustomerGatewayWithName retrieves all ec2.CustomerGateway items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2CustomerGatewayWithName(name string) (*ec2.CustomerGateway, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.CustomerGateway:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.CustomerGateway not found", name)
+}
+
+// GetAllEC2DHCPOptionsResources retrieves all ec2.DHCPOptions items from an AWS CloudFormation template
+func (t *Template) GetAllEC2DHCPOptionsResources() map[string]*ec2.DHCPOptions {
+	results := map[string]*ec2.DHCPOptions{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.DHCPOptions:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2DHCPOptionsWithName retrieves all ec2.DHCPOptions items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2DHCPOptionsWithName(name string) (*ec2.DHCPOptions, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.DHCPOptions:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.DHCPOptions not found", name)
+}
+
+// GetAllEC2EC2FleetResources retrieves all ec2.EC2Fleet items from an AWS CloudFormation template
+func (t *Template) GetAllEC2EC2FleetResources() map[string]*ec2.EC2Fleet {
+	results := map[string]*ec2.EC2Fleet{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.EC2Fleet:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2EC2FleetWithName retrieves all ec2.EC2Fleet items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2EC2FleetWithName(name string) (*ec2.EC2Fleet, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.EC2Fleet:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.EC2Fleet not found", name)
+}
+
+// GetAllEC2EIPResources retrieves all ec2.EIP items from an AWS CloudFormation template
+func (t *Template) GetAllEC2EIPResources() map[string]*ec2.EIP {
+	results := map[string]*ec2.EIP{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.EIP:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2EIPWithName retrieves all ec2.EIP items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2EIPWithName(name string) (*ec2.EIP, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.EIP:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.EIP not found", name)
+}
+
+// GetAllEC2EIPAssociationResources retrieves all ec2.EIPAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2EIPAssociationResources() map[string]*ec2.EIPAssociation {
+	results := map[string]*ec2.EIPAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.EIPAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2EIPAssociationWithName retrieves all ec2.EIPAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2EIPAssociationWithName(name string) (*ec2.EIPAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.EIPAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.EIPAssociation not found", name)
+}
+
+// GetAllEC2EgressOnlyInternetGatewayResources retrieves all ec2.EgressOnlyInternetGateway items from an AWS CloudFormation template
+func (t *Template) GetAllEC2EgressOnlyInternetGatewayResources() map[string]*ec2.EgressOnlyInternetGateway {
+	results := map[string]*ec2.EgressOnlyInternetGateway{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.EgressOnlyInternetGateway:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2EgressOnlyInternetGatewayWithName retrieves all ec2.EgressOnlyInternetGateway items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2EgressOnlyInternetGatewayWithName(name string) (*ec2.EgressOnlyInternetGateway, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.EgressOnlyInternetGateway:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.EgressOnlyInternetGateway not found", name)
+}
+
+// GetAllEC2EnclaveCertificateIamRoleAssociationResources retrieves all ec2.EnclaveCertificateIamRoleAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2EnclaveCertificateIamRoleAssociationResources() map[string]*ec2.EnclaveCertificateIamRoleAssociation {
+	results := map[string]*ec2.EnclaveCertificateIamRoleAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.EnclaveCertificateIamRoleAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2EnclaveCertificateIamRoleAssociationWithName retrieves all ec2.EnclaveCertificateIamRoleAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2EnclaveCertificateIamRoleAssociationWithName(name string) (*ec2.EnclaveCertificateIamRoleAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.EnclaveCertificateIamRoleAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.EnclaveCertificateIamRoleAssociation not found", name)
+}
+
+// GetAllEC2FlowLogResources retrieves all ec2.FlowLog items from an AWS CloudFormation template
+func (t *Template) GetAllEC2FlowLogResources() map[string]*ec2.FlowLog {
+	results := map[string]*ec2.FlowLog{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.FlowLog:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2FlowLogWithName retrieves all ec2.FlowLog items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2FlowLogWithName(name string) (*ec2.FlowLog, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.FlowLog:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.FlowLog not found", name)
+}
+
+// GetAllEC2GatewayRouteTableAssociationResources retrieves all ec2.GatewayRouteTableAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2GatewayRouteTableAssociationResources() map[string]*ec2.GatewayRouteTableAssociation {
+	results := map[string]*ec2.GatewayRouteTableAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.GatewayRouteTableAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2GatewayRouteTableAssociationWithName retrieves all ec2.GatewayRouteTableAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2GatewayRouteTableAssociationWithName(name string) (*ec2.GatewayRouteTableAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.GatewayRouteTableAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.GatewayRouteTableAssociation not found", name)
+}
+
+// GetAllEC2HostResources retrieves all ec2.Host items from an AWS CloudFormation template
+func (t *Template) GetAllEC2HostResources() map[string]*ec2.Host {
+	results := map[string]*ec2.Host{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.Host:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2HostWithName retrieves all ec2.Host items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2HostWithName(name string) (*ec2.Host, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.Host:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.Host not found", name)
+}
+
+// GetAllEC2IPAMResources retrieves all ec2.IPAM items from an AWS CloudFormation template
+func (t *Template) GetAllEC2IPAMResources() map[string]*ec2.IPAM {
+	results := map[string]*ec2.IPAM{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.IPAM:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2IPAMWithName retrieves all ec2.IPAM items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2IPAMWithName(name string) (*ec2.IPAM, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.IPAM:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.IPAM not found", name)
+}
+
+// GetAllEC2IPAMAllocationResources retrieves all ec2.IPAMAllocation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2IPAMAllocationResources() map[string]*ec2.IPAMAllocation {
+	results := map[string]*ec2.IPAMAllocation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.IPAMAllocation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2IPAMAllocationWithName retrieves all ec2.IPAMAllocation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2IPAMAllocationWithName(name string) (*ec2.IPAMAllocation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.IPAMAllocation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.IPAMAllocation not found", name)
+}
+
+// GetAllEC2IPAMPoolResources retrieves all ec2.IPAMPool items from an AWS CloudFormation template
+func (t *Template) GetAllEC2IPAMPoolResources() map[string]*ec2.IPAMPool {
+	results := map[string]*ec2.IPAMPool{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.IPAMPool:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2IPAMPoolWithName retrieves all ec2.IPAMPool items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2IPAMPoolWithName(name string) (*ec2.IPAMPool, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.IPAMPool:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.IPAMPool not found", name)
+}
+
+// GetAllEC2IPAMScopeResources retrieves all ec2.IPAMScope items from an AWS CloudFormation template
+func (t *Template) GetAllEC2IPAMScopeResources() map[string]*ec2.IPAMScope {
+	results := map[string]*ec2.IPAMScope{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.IPAMScope:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2IPAMScopeWithName retrieves all ec2.IPAMScope items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2IPAMScopeWithName(name string) (*ec2.IPAMScope, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.IPAMScope:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.IPAMScope not found", name)
+}
+
+// GetAllEC2InstanceResources retrieves all ec2.Instance items from an AWS CloudFormation template
+func (t *Template) GetAllEC2InstanceResources() map[string]*ec2.Instance {
+	results := map[string]*ec2.Instance{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.Instance:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2InstanceWithName retrieves all ec2.Instance items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2InstanceWithName(name string) (*ec2.Instance, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.Instance:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.Instance not found", name)
+}
+
+// GetAllEC2InternetGatewayResources retrieves all ec2.InternetGateway items from an AWS CloudFormation template
+func (t *Template) GetAllEC2InternetGatewayResources() map[string]*ec2.InternetGateway {
+	results := map[string]*ec2.InternetGateway{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.InternetGateway:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2InternetGatewayWithName retrieves all ec2.InternetGateway items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2InternetGatewayWithName(name string) (*ec2.InternetGateway, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.InternetGateway:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.InternetGateway not found", name)
+}
+
+// GetAllEC2LaunchTemplateResources retrieves all ec2.LaunchTemplate items from an AWS CloudFormation template
+func (t *Template) GetAllEC2LaunchTemplateResources() map[string]*ec2.LaunchTemplate {
+	results := map[string]*ec2.LaunchTemplate{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.LaunchTemplate:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2LaunchTemplateWithName retrieves all ec2.LaunchTemplate items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2LaunchTemplateWithName(name string) (*ec2.LaunchTemplate, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.LaunchTemplate:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.LaunchTemplate not found", name)
+}
+
+// GetAllEC2LocalGatewayRouteResources retrieves all ec2.LocalGatewayRoute items from an AWS CloudFormation template
+func (t *Template) GetAllEC2LocalGatewayRouteResources() map[string]*ec2.LocalGatewayRoute {
+	results := map[string]*ec2.LocalGatewayRoute{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.LocalGatewayRoute:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2LocalGatewayRouteWithName retrieves all ec2.LocalGatewayRoute items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2LocalGatewayRouteWithName(name string) (*ec2.LocalGatewayRoute, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.LocalGatewayRoute:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.LocalGatewayRoute not found", name)
+}
+
+// GetAllEC2LocalGatewayRouteTableVPCAssociationResources retrieves all ec2.LocalGatewayRouteTableVPCAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2LocalGatewayRouteTableVPCAssociationResources() map[string]*ec2.LocalGatewayRouteTableVPCAssociation {
+	results := map[string]*ec2.LocalGatewayRouteTableVPCAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.LocalGatewayRouteTableVPCAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2LocalGatewayRouteTableVPCAssociationWithName retrieves all ec2.LocalGatewayRouteTableVPCAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2LocalGatewayRouteTableVPCAssociationWithName(name string) (*ec2.LocalGatewayRouteTableVPCAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.LocalGatewayRouteTableVPCAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.LocalGatewayRouteTableVPCAssociation not found", name)
+}
+
+// GetAllEC2NatGatewayResources retrieves all ec2.NatGateway items from an AWS CloudFormation template
+func (t *Template) GetAllEC2NatGatewayResources() map[string]*ec2.NatGateway {
+	results := map[string]*ec2.NatGateway{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.NatGateway:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2NatGatewayWithName retrieves all ec2.NatGateway items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2NatGatewayWithName(name string) (*ec2.NatGateway, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.NatGateway:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.NatGateway not found", name)
+}
+
+// GetAllEC2NetworkAclResources retrieves all ec2.NetworkAcl items from an AWS CloudFormation template
+func (t *Template) GetAllEC2NetworkAclResources() map[string]*ec2.NetworkAcl {
+	results := map[string]*ec2.NetworkAcl{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkAcl:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2NetworkAclWithName retrieves all ec2.NetworkAcl items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2NetworkAclWithName(name string) (*ec2.NetworkAcl, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkAcl:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.NetworkAcl not found", name)
+}
+
+// GetAllEC2NetworkAclEntryResources retrieves all ec2.NetworkAclEntry items from an AWS CloudFormation template
+func (t *Template) GetAllEC2NetworkAclEntryResources() map[string]*ec2.NetworkAclEntry {
+	results := map[string]*ec2.NetworkAclEntry{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkAclEntry:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2NetworkAclEntryWithName retrieves all ec2.NetworkAclEntry items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2NetworkAclEntryWithName(name string) (*ec2.NetworkAclEntry, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkAclEntry:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.NetworkAclEntry not found", name)
+}
+
+// GetAllEC2NetworkInsightsAnalysisResources retrieves all ec2.NetworkInsightsAnalysis items from an AWS CloudFormation template
+func (t *Template) GetAllEC2NetworkInsightsAnalysisResources() map[string]*ec2.NetworkInsightsAnalysis {
+	results := map[string]*ec2.NetworkInsightsAnalysis{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInsightsAnalysis:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2NetworkInsightsAnalysisWithName retrieves all ec2.NetworkInsightsAnalysis items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2NetworkInsightsAnalysisWithName(name string) (*ec2.NetworkInsightsAnalysis, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInsightsAnalysis:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.NetworkInsightsAnalysis not found", name)
+}
+
+// GetAllEC2NetworkInsightsPathResources retrieves all ec2.NetworkInsightsPath items from an AWS CloudFormation template
+func (t *Template) GetAllEC2NetworkInsightsPathResources() map[string]*ec2.NetworkInsightsPath {
+	results := map[string]*ec2.NetworkInsightsPath{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInsightsPath:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2NetworkInsightsPathWithName retrieves all ec2.NetworkInsightsPath items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2NetworkInsightsPathWithName(name string) (*ec2.NetworkInsightsPath, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInsightsPath:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.NetworkInsightsPath not found", name)
+}
+
+// GetAllEC2NetworkInterfaceResources retrieves all ec2.NetworkInterface items from an AWS CloudFormation template
+func (t *Template) GetAllEC2NetworkInterfaceResources() map[string]*ec2.NetworkInterface {
+	results := map[string]*ec2.NetworkInterface{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInterface:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2NetworkInterfaceWithName retrieves all ec2.NetworkInterface items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2NetworkInterfaceWithName(name string) (*ec2.NetworkInterface, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInterface:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.NetworkInterface not found", name)
+}
+
+// GetAllEC2NetworkInterfaceAttachmentResources retrieves all ec2.NetworkInterfaceAttachment items from an AWS CloudFormation template
+func (t *Template) GetAllEC2NetworkInterfaceAttachmentResources() map[string]*ec2.NetworkInterfaceAttachment {
+	results := map[string]*ec2.NetworkInterfaceAttachment{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInterfaceAttachment:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2NetworkInterfaceAttachmentWithName retrieves all ec2.NetworkInterfaceAttachment items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2NetworkInterfaceAttachmentWithName(name string) (*ec2.NetworkInterfaceAttachment, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInterfaceAttachment:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.NetworkInterfaceAttachment not found", name)
+}
+
+// GetAllEC2NetworkInterfacePermissionResources retrieves all ec2.NetworkInterfacePermission items from an AWS CloudFormation template
+func (t *Template) GetAllEC2NetworkInterfacePermissionResources() map[string]*ec2.NetworkInterfacePermission {
+	results := map[string]*ec2.NetworkInterfacePermission{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInterfacePermission:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2NetworkInterfacePermissionWithName retrieves all ec2.NetworkInterfacePermission items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2NetworkInterfacePermissionWithName(name string) (*ec2.NetworkInterfacePermission, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.NetworkInterfacePermission:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.NetworkInterfacePermission not found", name)
+}
+
+// GetAllEC2PlacementGroupResources retrieves all ec2.PlacementGroup items from an AWS CloudFormation template
+func (t *Template) GetAllEC2PlacementGroupResources() map[string]*ec2.PlacementGroup {
+	results := map[string]*ec2.PlacementGroup{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.PlacementGroup:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2PlacementGroupWithName retrieves all ec2.PlacementGroup items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2PlacementGroupWithName(name string) (*ec2.PlacementGroup, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.PlacementGroup:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.PlacementGroup not found", name)
+}
+
+// GetAllEC2PrefixListResources retrieves all ec2.PrefixList items from an AWS CloudFormation template
+func (t *Template) GetAllEC2PrefixListResources() map[string]*ec2.PrefixList {
+	results := map[string]*ec2.PrefixList{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.PrefixList:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2PrefixListWithName retrieves all ec2.PrefixList items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2PrefixListWithName(name string) (*ec2.PrefixList, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.PrefixList:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.PrefixList not found", name)
+}
+
+// GetAllEC2RouteResources retrieves all ec2.Route items from an AWS CloudFormation template
+func (t *Template) GetAllEC2RouteResources() map[string]*ec2.Route {
+	results := map[string]*ec2.Route{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.Route:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2RouteWithName retrieves all ec2.Route items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2RouteWithName(name string) (*ec2.Route, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.Route:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.Route not found", name)
+}
+
+// GetAllEC2RouteTableResources retrieves all ec2.RouteTable items from an AWS CloudFormation template
+func (t *Template) GetAllEC2RouteTableResources() map[string]*ec2.RouteTable {
+	results := map[string]*ec2.RouteTable{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.RouteTable:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2RouteTableWithName retrieves all ec2.RouteTable items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2RouteTableWithName(name string) (*ec2.RouteTable, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.RouteTable:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.RouteTable not found", name)
+}
+
+// GetAllEC2SecurityGroupResources retrieves all ec2.SecurityGroup items from an AWS CloudFormation template
+func (t *Template) GetAllEC2SecurityGroupResources() map[string]*ec2.SecurityGroup {
+	results := map[string]*ec2.SecurityGroup{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.SecurityGroup:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2SecurityGroupWithName retrieves all ec2.SecurityGroup items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2SecurityGroupWithName(name string) (*ec2.SecurityGroup, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.SecurityGroup:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.SecurityGroup not found", name)
+}
+
+// GetAllEC2SecurityGroupEgressResources retrieves all ec2.SecurityGroupEgress items from an AWS CloudFormation template
+func (t *Template) GetAllEC2SecurityGroupEgressResources() map[string]*ec2.SecurityGroupEgress {
+	results := map[string]*ec2.SecurityGroupEgress{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.SecurityGroupEgress:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2SecurityGroupEgressWithName retrieves all ec2.SecurityGroupEgress items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2SecurityGroupEgressWithName(name string) (*ec2.SecurityGroupEgress, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.SecurityGroupEgress:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.SecurityGroupEgress not found", name)
+}
+
+// GetAllEC2SecurityGroupIngressResources retrieves all ec2.SecurityGroupIngress items from an AWS CloudFormation template
+func (t *Template) GetAllEC2SecurityGroupIngressResources() map[string]*ec2.SecurityGroupIngress {
+	results := map[string]*ec2.SecurityGroupIngress{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.SecurityGroupIngress:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2SecurityGroupIngressWithName retrieves all ec2.SecurityGroupIngress items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2SecurityGroupIngressWithName(name string) (*ec2.SecurityGroupIngress, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.SecurityGroupIngress:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.SecurityGroupIngress not found", name)
+}
+
+// GetAllEC2SpotFleetResources retrieves all ec2.SpotFleet items from an AWS CloudFormation template
+func (t *Template) GetAllEC2SpotFleetResources() map[string]*ec2.SpotFleet {
+	results := map[string]*ec2.SpotFleet{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.SpotFleet:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2SpotFleetWithName retrieves all ec2.SpotFleet items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2SpotFleetWithName(name string) (*ec2.SpotFleet, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.SpotFleet:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.SpotFleet not found", name)
+}
+
+// GetAllEC2SubnetResources retrieves all ec2.Subnet items from an AWS CloudFormation template
+func (t *Template) GetAllEC2SubnetResources() map[string]*ec2.Subnet {
+	results := map[string]*ec2.Subnet{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.Subnet:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2SubnetWithName retrieves all ec2.Subnet items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2SubnetWithName(name string) (*ec2.Subnet, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.Subnet:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.Subnet not found", name)
+}
+
+// GetAllEC2SubnetCidrBlockResources retrieves all ec2.SubnetCidrBlock items from an AWS CloudFormation template
+func (t *Template) GetAllEC2SubnetCidrBlockResources() map[string]*ec2.SubnetCidrBlock {
+	results := map[string]*ec2.SubnetCidrBlock{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.SubnetCidrBlock:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2SubnetCidrBlockWithName retrieves all ec2.SubnetCidrBlock items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2SubnetCidrBlockWithName(name string) (*ec2.SubnetCidrBlock, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.SubnetCidrBlock:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.SubnetCidrBlock not found", name)
+}
+
+// GetAllEC2SubnetNetworkAclAssociationResources retrieves all ec2.SubnetNetworkAclAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2SubnetNetworkAclAssociationResources() map[string]*ec2.SubnetNetworkAclAssociation {
+	results := map[string]*ec2.SubnetNetworkAclAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.SubnetNetworkAclAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2SubnetNetworkAclAssociationWithName retrieves all ec2.SubnetNetworkAclAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2SubnetNetworkAclAssociationWithName(name string) (*ec2.SubnetNetworkAclAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.SubnetNetworkAclAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.SubnetNetworkAclAssociation not found", name)
+}
+
+// GetAllEC2SubnetRouteTableAssociationResources retrieves all ec2.SubnetRouteTableAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2SubnetRouteTableAssociationResources() map[string]*ec2.SubnetRouteTableAssociation {
+	results := map[string]*ec2.SubnetRouteTableAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.SubnetRouteTableAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2SubnetRouteTableAssociationWithName retrieves all ec2.SubnetRouteTableAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2SubnetRouteTableAssociationWithName(name string) (*ec2.SubnetRouteTableAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.SubnetRouteTableAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.SubnetRouteTableAssociation not found", name)
+}
+
+// GetAllEC2TrafficMirrorFilterResources retrieves all ec2.TrafficMirrorFilter items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TrafficMirrorFilterResources() map[string]*ec2.TrafficMirrorFilter {
+	results := map[string]*ec2.TrafficMirrorFilter{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TrafficMirrorFilter:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TrafficMirrorFilterWithName retrieves all ec2.TrafficMirrorFilter items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TrafficMirrorFilterWithName(name string) (*ec2.TrafficMirrorFilter, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TrafficMirrorFilter:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TrafficMirrorFilter not found", name)
+}
+
+// GetAllEC2TrafficMirrorFilterRuleResources retrieves all ec2.TrafficMirrorFilterRule items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TrafficMirrorFilterRuleResources() map[string]*ec2.TrafficMirrorFilterRule {
+	results := map[string]*ec2.TrafficMirrorFilterRule{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TrafficMirrorFilterRule:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TrafficMirrorFilterRuleWithName retrieves all ec2.TrafficMirrorFilterRule items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TrafficMirrorFilterRuleWithName(name string) (*ec2.TrafficMirrorFilterRule, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TrafficMirrorFilterRule:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TrafficMirrorFilterRule not found", name)
+}
+
+// GetAllEC2TrafficMirrorSessionResources retrieves all ec2.TrafficMirrorSession items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TrafficMirrorSessionResources() map[string]*ec2.TrafficMirrorSession {
+	results := map[string]*ec2.TrafficMirrorSession{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TrafficMirrorSession:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TrafficMirrorSessionWithName retrieves all ec2.TrafficMirrorSession items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TrafficMirrorSessionWithName(name string) (*ec2.TrafficMirrorSession, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TrafficMirrorSession:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TrafficMirrorSession not found", name)
+}
+
+// GetAllEC2TrafficMirrorTargetResources retrieves all ec2.TrafficMirrorTarget items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TrafficMirrorTargetResources() map[string]*ec2.TrafficMirrorTarget {
+	results := map[string]*ec2.TrafficMirrorTarget{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TrafficMirrorTarget:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TrafficMirrorTargetWithName retrieves all ec2.TrafficMirrorTarget items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TrafficMirrorTargetWithName(name string) (*ec2.TrafficMirrorTarget, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TrafficMirrorTarget:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TrafficMirrorTarget not found", name)
+}
+
+// GetAllEC2TransitGatewayResources retrieves all ec2.TransitGateway items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayResources() map[string]*ec2.TransitGateway {
+	results := map[string]*ec2.TransitGateway{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGateway:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayWithName retrieves all ec2.TransitGateway items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayWithName(name string) (*ec2.TransitGateway, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGateway:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGateway not found", name)
+}
+
+// GetAllEC2TransitGatewayAttachmentResources retrieves all ec2.TransitGatewayAttachment items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayAttachmentResources() map[string]*ec2.TransitGatewayAttachment {
+	results := map[string]*ec2.TransitGatewayAttachment{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayAttachment:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayAttachmentWithName retrieves all ec2.TransitGatewayAttachment items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayAttachmentWithName(name string) (*ec2.TransitGatewayAttachment, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayAttachment:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayAttachment not found", name)
+}
+
+// GetAllEC2TransitGatewayConnectResources retrieves all ec2.TransitGatewayConnect items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayConnectResources() map[string]*ec2.TransitGatewayConnect {
+	results := map[string]*ec2.TransitGatewayConnect{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayConnect:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayConnectWithName retrieves all ec2.TransitGatewayConnect items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayConnectWithName(name string) (*ec2.TransitGatewayConnect, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayConnect:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayConnect not found", name)
+}
+
+// GetAllEC2TransitGatewayMulticastDomainResources retrieves all ec2.TransitGatewayMulticastDomain items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayMulticastDomainResources() map[string]*ec2.TransitGatewayMulticastDomain {
+	results := map[string]*ec2.TransitGatewayMulticastDomain{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayMulticastDomain:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayMulticastDomainWithName retrieves all ec2.TransitGatewayMulticastDomain items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayMulticastDomainWithName(name string) (*ec2.TransitGatewayMulticastDomain, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayMulticastDomain:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayMulticastDomain not found", name)
+}
+
+// GetAllEC2TransitGatewayMulticastDomainAssociationResources retrieves all ec2.TransitGatewayMulticastDomainAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayMulticastDomainAssociationResources() map[string]*ec2.TransitGatewayMulticastDomainAssociation {
+	results := map[string]*ec2.TransitGatewayMulticastDomainAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayMulticastDomainAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayMulticastDomainAssociationWithName retrieves all ec2.TransitGatewayMulticastDomainAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayMulticastDomainAssociationWithName(name string) (*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayMulticastDomainAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayMulticastDomainAssociation not found", name)
+}
+
+// GetAllEC2TransitGatewayMulticastGroupMemberResources retrieves all ec2.TransitGatewayMulticastGroupMember items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayMulticastGroupMemberResources() map[string]*ec2.TransitGatewayMulticastGroupMember {
+	results := map[string]*ec2.TransitGatewayMulticastGroupMember{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayMulticastGroupMember:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayMulticastGroupMemberWithName retrieves all ec2.TransitGatewayMulticastGroupMember items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayMulticastGroupMemberWithName(name string) (*ec2.TransitGatewayMulticastGroupMember, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayMulticastGroupMember:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayMulticastGroupMember not found", name)
+}
+
+// GetAllEC2TransitGatewayMulticastGroupSourceResources retrieves all ec2.TransitGatewayMulticastGroupSource items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayMulticastGroupSourceResources() map[string]*ec2.TransitGatewayMulticastGroupSource {
+	results := map[string]*ec2.TransitGatewayMulticastGroupSource{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayMulticastGroupSource:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayMulticastGroupSourceWithName retrieves all ec2.TransitGatewayMulticastGroupSource items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayMulticastGroupSourceWithName(name string) (*ec2.TransitGatewayMulticastGroupSource, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayMulticastGroupSource:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayMulticastGroupSource not found", name)
+}
+
+// GetAllEC2TransitGatewayPeeringAttachmentResources retrieves all ec2.TransitGatewayPeeringAttachment items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayPeeringAttachmentResources() map[string]*ec2.TransitGatewayPeeringAttachment {
+	results := map[string]*ec2.TransitGatewayPeeringAttachment{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayPeeringAttachment:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayPeeringAttachmentWithName retrieves all ec2.TransitGatewayPeeringAttachment items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayPeeringAttachmentWithName(name string) (*ec2.TransitGatewayPeeringAttachment, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayPeeringAttachment:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayPeeringAttachment not found", name)
+}
+
+// GetAllEC2TransitGatewayRouteResources retrieves all ec2.TransitGatewayRoute items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayRouteResources() map[string]*ec2.TransitGatewayRoute {
+	results := map[string]*ec2.TransitGatewayRoute{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayRoute:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayRouteWithName retrieves all ec2.TransitGatewayRoute items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayRouteWithName(name string) (*ec2.TransitGatewayRoute, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayRoute:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayRoute not found", name)
+}
+
+// GetAllEC2TransitGatewayRouteTableResources retrieves all ec2.TransitGatewayRouteTable items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayRouteTableResources() map[string]*ec2.TransitGatewayRouteTable {
+	results := map[string]*ec2.TransitGatewayRouteTable{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayRouteTable:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayRouteTableWithName retrieves all ec2.TransitGatewayRouteTable items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayRouteTableWithName(name string) (*ec2.TransitGatewayRouteTable, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayRouteTable:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayRouteTable not found", name)
+}
+
+// GetAllEC2TransitGatewayRouteTableAssociationResources retrieves all ec2.TransitGatewayRouteTableAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayRouteTableAssociationResources() map[string]*ec2.TransitGatewayRouteTableAssociation {
+	results := map[string]*ec2.TransitGatewayRouteTableAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayRouteTableAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayRouteTableAssociationWithName retrieves all ec2.TransitGatewayRouteTableAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayRouteTableAssociationWithName(name string) (*ec2.TransitGatewayRouteTableAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayRouteTableAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayRouteTableAssociation not found", name)
+}
+
+// GetAllEC2TransitGatewayRouteTablePropagationResources retrieves all ec2.TransitGatewayRouteTablePropagation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayRouteTablePropagationResources() map[string]*ec2.TransitGatewayRouteTablePropagation {
+	results := map[string]*ec2.TransitGatewayRouteTablePropagation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayRouteTablePropagation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayRouteTablePropagationWithName retrieves all ec2.TransitGatewayRouteTablePropagation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayRouteTablePropagationWithName(name string) (*ec2.TransitGatewayRouteTablePropagation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayRouteTablePropagation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayRouteTablePropagation not found", name)
+}
+
+// GetAllEC2TransitGatewayVpcAttachmentResources retrieves all ec2.TransitGatewayVpcAttachment items from an AWS CloudFormation template
+func (t *Template) GetAllEC2TransitGatewayVpcAttachmentResources() map[string]*ec2.TransitGatewayVpcAttachment {
+	results := map[string]*ec2.TransitGatewayVpcAttachment{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayVpcAttachment:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2TransitGatewayVpcAttachmentWithName retrieves all ec2.TransitGatewayVpcAttachment items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2TransitGatewayVpcAttachmentWithName(name string) (*ec2.TransitGatewayVpcAttachment, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.TransitGatewayVpcAttachment:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.TransitGatewayVpcAttachment not found", name)
+}
+
+// GetAllEC2VPCResources retrieves all ec2.VPC items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPCResources() map[string]*ec2.VPC {
+	results := map[string]*ec2.VPC{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPC:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPCWithName retrieves all ec2.VPC items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPCWithName(name string) (*ec2.VPC, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPC:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPC not found", name)
+}
+
+// GetAllEC2VPCCidrBlockResources retrieves all ec2.VPCCidrBlock items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPCCidrBlockResources() map[string]*ec2.VPCCidrBlock {
+	results := map[string]*ec2.VPCCidrBlock{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPCCidrBlock:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPCCidrBlockWithName retrieves all ec2.VPCCidrBlock items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPCCidrBlockWithName(name string) (*ec2.VPCCidrBlock, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPCCidrBlock:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPCCidrBlock not found", name)
+}
+
+// GetAllEC2VPCDHCPOptionsAssociationResources retrieves all ec2.VPCDHCPOptionsAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPCDHCPOptionsAssociationResources() map[string]*ec2.VPCDHCPOptionsAssociation {
+	results := map[string]*ec2.VPCDHCPOptionsAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPCDHCPOptionsAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPCDHCPOptionsAssociationWithName retrieves all ec2.VPCDHCPOptionsAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPCDHCPOptionsAssociationWithName(name string) (*ec2.VPCDHCPOptionsAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPCDHCPOptionsAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPCDHCPOptionsAssociation not found", name)
+}
+
+// GetAllEC2VPCEndpointResources retrieves all ec2.VPCEndpoint items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPCEndpointResources() map[string]*ec2.VPCEndpoint {
+	results := map[string]*ec2.VPCEndpoint{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPCEndpoint:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPCEndpointWithName retrieves all ec2.VPCEndpoint items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPCEndpointWithName(name string) (*ec2.VPCEndpoint, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPCEndpoint:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPCEndpoint not found", name)
+}
+
+// GetAllEC2VPCEndpointConnectionNotificationResources retrieves all ec2.VPCEndpointConnectionNotification items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPCEndpointConnectionNotificationResources() map[string]*ec2.VPCEndpointConnectionNotification {
+	results := map[string]*ec2.VPCEndpointConnectionNotification{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPCEndpointConnectionNotification:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPCEndpointConnectionNotificationWithName retrieves all ec2.VPCEndpointConnectionNotification items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPCEndpointConnectionNotificationWithName(name string) (*ec2.VPCEndpointConnectionNotification, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPCEndpointConnectionNotification:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPCEndpointConnectionNotification not found", name)
+}
+
+// GetAllEC2VPCEndpointServiceResources retrieves all ec2.VPCEndpointService items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPCEndpointServiceResources() map[string]*ec2.VPCEndpointService {
+	results := map[string]*ec2.VPCEndpointService{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPCEndpointService:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPCEndpointServiceWithName retrieves all ec2.VPCEndpointService items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPCEndpointServiceWithName(name string) (*ec2.VPCEndpointService, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPCEndpointService:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPCEndpointService not found", name)
+}
+
+// GetAllEC2VPCEndpointServicePermissionsResources retrieves all ec2.VPCEndpointServicePermissions items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPCEndpointServicePermissionsResources() map[string]*ec2.VPCEndpointServicePermissions {
+	results := map[string]*ec2.VPCEndpointServicePermissions{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPCEndpointServicePermissions:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPCEndpointServicePermissionsWithName retrieves all ec2.VPCEndpointServicePermissions items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPCEndpointServicePermissionsWithName(name string) (*ec2.VPCEndpointServicePermissions, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPCEndpointServicePermissions:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPCEndpointServicePermissions not found", name)
+}
+
+// GetAllEC2VPCGatewayAttachmentResources retrieves all ec2.VPCGatewayAttachment items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPCGatewayAttachmentResources() map[string]*ec2.VPCGatewayAttachment {
+	results := map[string]*ec2.VPCGatewayAttachment{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPCGatewayAttachment:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPCGatewayAttachmentWithName retrieves all ec2.VPCGatewayAttachment items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPCGatewayAttachmentWithName(name string) (*ec2.VPCGatewayAttachment, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPCGatewayAttachment:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPCGatewayAttachment not found", name)
+}
+
+// GetAllEC2VPCPeeringConnectionResources retrieves all ec2.VPCPeeringConnection items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPCPeeringConnectionResources() map[string]*ec2.VPCPeeringConnection {
+	results := map[string]*ec2.VPCPeeringConnection{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPCPeeringConnection:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPCPeeringConnectionWithName retrieves all ec2.VPCPeeringConnection items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPCPeeringConnectionWithName(name string) (*ec2.VPCPeeringConnection, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPCPeeringConnection:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPCPeeringConnection not found", name)
+}
+
+// GetAllEC2VPNConnectionResources retrieves all ec2.VPNConnection items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPNConnectionResources() map[string]*ec2.VPNConnection {
+	results := map[string]*ec2.VPNConnection{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPNConnection:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPNConnectionWithName retrieves all ec2.VPNConnection items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPNConnectionWithName(name string) (*ec2.VPNConnection, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPNConnection:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPNConnection not found", name)
+}
+
+// GetAllEC2VPNConnectionRouteResources retrieves all ec2.VPNConnectionRoute items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPNConnectionRouteResources() map[string]*ec2.VPNConnectionRoute {
+	results := map[string]*ec2.VPNConnectionRoute{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPNConnectionRoute:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPNConnectionRouteWithName retrieves all ec2.VPNConnectionRoute items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPNConnectionRouteWithName(name string) (*ec2.VPNConnectionRoute, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPNConnectionRoute:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPNConnectionRoute not found", name)
+}
+
+// GetAllEC2VPNGatewayResources retrieves all ec2.VPNGateway items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPNGatewayResources() map[string]*ec2.VPNGateway {
+	results := map[string]*ec2.VPNGateway{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPNGateway:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPNGatewayWithName retrieves all ec2.VPNGateway items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPNGatewayWithName(name string) (*ec2.VPNGateway, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPNGateway:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPNGateway not found", name)
+}
+
+// GetAllEC2VPNGatewayRoutePropagationResources retrieves all ec2.VPNGatewayRoutePropagation items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VPNGatewayRoutePropagationResources() map[string]*ec2.VPNGatewayRoutePropagation {
+	results := map[string]*ec2.VPNGatewayRoutePropagation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VPNGatewayRoutePropagation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VPNGatewayRoutePropagationWithName retrieves all ec2.VPNGatewayRoutePropagation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VPNGatewayRoutePropagationWithName(name string) (*ec2.VPNGatewayRoutePropagation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VPNGatewayRoutePropagation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VPNGatewayRoutePropagation not found", name)
+}
+
+// GetAllEC2VolumeResources retrieves all ec2.Volume items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VolumeResources() map[string]*ec2.Volume {
+	results := map[string]*ec2.Volume{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.Volume:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VolumeWithName retrieves all ec2.Volume items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VolumeWithName(name string) (*ec2.Volume, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.Volume:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.Volume not found", name)
+}
+
+// GetAllEC2VolumeAttachmentResources retrieves all ec2.VolumeAttachment items from an AWS CloudFormation template
+func (t *Template) GetAllEC2VolumeAttachmentResources() map[string]*ec2.VolumeAttachment {
+	results := map[string]*ec2.VolumeAttachment{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *ec2.VolumeAttachment:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEC2VolumeAttachmentWithName retrieves all ec2.VolumeAttachment items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEC2VolumeAttachmentWithName(name string) (*ec2.VolumeAttachment, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *ec2.VolumeAttachment:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type ec2.VolumeAttachment not found", name)
+}
+
+// GetAllEKSAddonResources retrieves all eks.Addon items from an AWS CloudFormation template
+func (t *Template) GetAllEKSAddonResources() map[string]*eks.Addon {
+	results := map[string]*eks.Addon{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *eks.Addon:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEKSAddonWithName retrieves all eks.Addon items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEKSAddonWithName(name string) (*eks.Addon, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *eks.Addon:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type eks.Addon not found", name)
+}
+
+// GetAllEKSClusterResources retrieves all eks.Cluster items from an AWS CloudFormation template
+func (t *Template) GetAllEKSClusterResources() map[string]*eks.Cluster {
+	results := map[string]*eks.Cluster{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *eks.Cluster:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEKSClusterWithName retrieves all eks.Cluster items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEKSClusterWithName(name string) (*eks.Cluster, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *eks.Cluster:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type eks.Cluster not found", name)
+}
+
+// GetAllEKSFargateProfileResources retrieves all eks.FargateProfile items from an AWS CloudFormation template
+func (t *Template) GetAllEKSFargateProfileResources() map[string]*eks.FargateProfile {
+	results := map[string]*eks.FargateProfile{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *eks.FargateProfile:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEKSFargateProfileWithName retrieves all eks.FargateProfile items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEKSFargateProfileWithName(name string) (*eks.FargateProfile, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *eks.FargateProfile:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type eks.FargateProfile not found", name)
+}
+
+// GetAllEKSNodegroupResources retrieves all eks.Nodegroup items from an AWS CloudFormation template
+func (t *Template) GetAllEKSNodegroupResources() map[string]*eks.Nodegroup {
+	results := map[string]*eks.Nodegroup{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *eks.Nodegroup:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetEKSNodegroupWithName retrieves all eks.Nodegroup items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetEKSNodegroupWithName(name string) (*eks.Nodegroup, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *eks.Nodegroup:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type eks.Nodegroup not found", name)
+}
+
+// GetAllIAMAccessKeyResources retrieves all iam.AccessKey items from an AWS CloudFormation template
+func (t *Template) GetAllIAMAccessKeyResources() map[string]*iam.AccessKey {
+	results := map[string]*iam.AccessKey{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.AccessKey:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMAccessKeyWithName retrieves all iam.AccessKey items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMAccessKeyWithName(name string) (*iam.AccessKey, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.AccessKey:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.AccessKey not found", name)
+}
+
+// GetAllIAMGroupResources retrieves all iam.Group items from an AWS CloudFormation template
+func (t *Template) GetAllIAMGroupResources() map[string]*iam.Group {
+	results := map[string]*iam.Group{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.Group:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMGroupWithName retrieves all iam.Group items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMGroupWithName(name string) (*iam.Group, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.Group:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.Group not found", name)
+}
+
+// GetAllIAMInstanceProfileResources retrieves all iam.InstanceProfile items from an AWS CloudFormation template
+func (t *Template) GetAllIAMInstanceProfileResources() map[string]*iam.InstanceProfile {
+	results := map[string]*iam.InstanceProfile{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.InstanceProfile:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMInstanceProfileWithName retrieves all iam.InstanceProfile items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMInstanceProfileWithName(name string) (*iam.InstanceProfile, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.InstanceProfile:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.InstanceProfile not found", name)
+}
+
+// GetAllIAMManagedPolicyResources retrieves all iam.ManagedPolicy items from an AWS CloudFormation template
+func (t *Template) GetAllIAMManagedPolicyResources() map[string]*iam.ManagedPolicy {
+	results := map[string]*iam.ManagedPolicy{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.ManagedPolicy:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMManagedPolicyWithName retrieves all iam.ManagedPolicy items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMManagedPolicyWithName(name string) (*iam.ManagedPolicy, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.ManagedPolicy:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.ManagedPolicy not found", name)
+}
+
+// GetAllIAMOIDCProviderResources retrieves all iam.OIDCProvider items from an AWS CloudFormation template
+func (t *Template) GetAllIAMOIDCProviderResources() map[string]*iam.OIDCProvider {
+	results := map[string]*iam.OIDCProvider{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.OIDCProvider:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMOIDCProviderWithName retrieves all iam.OIDCProvider items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMOIDCProviderWithName(name string) (*iam.OIDCProvider, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.OIDCProvider:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.OIDCProvider not found", name)
+}
+
+// GetAllIAMPolicyResources retrieves all iam.Policy items from an AWS CloudFormation template
+func (t *Template) GetAllIAMPolicyResources() map[string]*iam.Policy {
+	results := map[string]*iam.Policy{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.Policy:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMPolicyWithName retrieves all iam.Policy items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMPolicyWithName(name string) (*iam.Policy, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.Policy:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.Policy not found", name)
+}
+
+// GetAllIAMRoleResources retrieves all iam.Role items from an AWS CloudFormation template
+func (t *Template) GetAllIAMRoleResources() map[string]*iam.Role {
+	results := map[string]*iam.Role{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.Role:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMRoleWithName retrieves all iam.Role items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMRoleWithName(name string) (*iam.Role, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.Role:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.Role not found", name)
+}
+
+// GetAllIAMSAMLProviderResources retrieves all iam.SAMLProvider items from an AWS CloudFormation template
+func (t *Template) GetAllIAMSAMLProviderResources() map[string]*iam.SAMLProvider {
+	results := map[string]*iam.SAMLProvider{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.SAMLProvider:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMSAMLProviderWithName retrieves all iam.SAMLProvider items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMSAMLProviderWithName(name string) (*iam.SAMLProvider, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.SAMLProvider:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.SAMLProvider not found", name)
+}
+
+// GetAllIAMServerCertificateResources retrieves all iam.ServerCertificate items from an AWS CloudFormation template
+func (t *Template) GetAllIAMServerCertificateResources() map[string]*iam.ServerCertificate {
+	results := map[string]*iam.ServerCertificate{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.ServerCertificate:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMServerCertificateWithName retrieves all iam.ServerCertificate items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMServerCertificateWithName(name string) (*iam.ServerCertificate, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.ServerCertificate:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.ServerCertificate not found", name)
+}
+
+// GetAllIAMServiceLinkedRoleResources retrieves all iam.ServiceLinkedRole items from an AWS CloudFormation template
+func (t *Template) GetAllIAMServiceLinkedRoleResources() map[string]*iam.ServiceLinkedRole {
+	results := map[string]*iam.ServiceLinkedRole{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.ServiceLinkedRole:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMServiceLinkedRoleWithName retrieves all iam.ServiceLinkedRole items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMServiceLinkedRoleWithName(name string) (*iam.ServiceLinkedRole, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.ServiceLinkedRole:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.ServiceLinkedRole not found", name)
+}
+
+// GetAllIAMUserResources retrieves all iam.User items from an AWS CloudFormation template
+func (t *Template) GetAllIAMUserResources() map[string]*iam.User {
+	results := map[string]*iam.User{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.User:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMUserWithName retrieves all iam.User items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMUserWithName(name string) (*iam.User, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.User:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.User not found", name)
+}
+
+// GetAllIAMUserToGroupAdditionResources retrieves all iam.UserToGroupAddition items from an AWS CloudFormation template
+func (t *Template) GetAllIAMUserToGroupAdditionResources() map[string]*iam.UserToGroupAddition {
+	results := map[string]*iam.UserToGroupAddition{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.UserToGroupAddition:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMUserToGroupAdditionWithName retrieves all iam.UserToGroupAddition items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMUserToGroupAdditionWithName(name string) (*iam.UserToGroupAddition, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.UserToGroupAddition:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.UserToGroupAddition not found", name)
+}
+
+// GetAllIAMVirtualMFADeviceResources retrieves all iam.VirtualMFADevice items from an AWS CloudFormation template
+func (t *Template) GetAllIAMVirtualMFADeviceResources() map[string]*iam.VirtualMFADevice {
+	results := map[string]*iam.VirtualMFADevice{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *iam.VirtualMFADevice:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetIAMVirtualMFADeviceWithName retrieves all iam.VirtualMFADevice items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetIAMVirtualMFADeviceWithName(name string) (*iam.VirtualMFADevice, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *iam.VirtualMFADevice:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type iam.VirtualMFADevice not found", name)
+}
+
+// GetAllLogsDestinationResources retrieves all logs.Destination items from an AWS CloudFormation template
+func (t *Template) GetAllLogsDestinationResources() map[string]*logs.Destination {
+	results := map[string]*logs.Destination{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *logs.Destination:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetLogsDestinationWithName retrieves all logs.Destination items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetLogsDestinationWithName(name string) (*logs.Destination, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *logs.Destination:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type logs.Destination not found", name)
+}
+
+// GetAllLogsLogGroupResources retrieves all logs.LogGroup items from an AWS CloudFormation template
+func (t *Template) GetAllLogsLogGroupResources() map[string]*logs.LogGroup {
+	results := map[string]*logs.LogGroup{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *logs.LogGroup:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetLogsLogGroupWithName retrieves all logs.LogGroup items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetLogsLogGroupWithName(name string) (*logs.LogGroup, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *logs.LogGroup:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type logs.LogGroup not found", name)
+}
+
+// GetAllLogsLogStreamResources retrieves all logs.LogStream items from an AWS CloudFormation template
+func (t *Template) GetAllLogsLogStreamResources() map[string]*logs.LogStream {
+	results := map[string]*logs.LogStream{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *logs.LogStream:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetLogsLogStreamWithName retrieves all logs.LogStream items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetLogsLogStreamWithName(name string) (*logs.LogStream, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *logs.LogStream:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type logs.LogStream not found", name)
+}
+
+// GetAllLogsMetricFilterResources retrieves all logs.MetricFilter items from an AWS CloudFormation template
+func (t *Template) GetAllLogsMetricFilterResources() map[string]*logs.MetricFilter {
+	results := map[string]*logs.MetricFilter{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *logs.MetricFilter:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetLogsMetricFilterWithName retrieves all logs.MetricFilter items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetLogsMetricFilterWithName(name string) (*logs.MetricFilter, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *logs.MetricFilter:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type logs.MetricFilter not found", name)
+}
+
+// GetAllLogsQueryDefinitionResources retrieves all logs.QueryDefinition items from an AWS CloudFormation template
+func (t *Template) GetAllLogsQueryDefinitionResources() map[string]*logs.QueryDefinition {
+	results := map[string]*logs.QueryDefinition{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *logs.QueryDefinition:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetLogsQueryDefinitionWithName retrieves all logs.QueryDefinition items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetLogsQueryDefinitionWithName(name string) (*logs.QueryDefinition, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *logs.QueryDefinition:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type logs.QueryDefinition not found", name)
+}
+
+// GetAllLogsResourcePolicyResources retrieves all logs.ResourcePolicy items from an AWS CloudFormation template
+func (t *Template) GetAllLogsResourcePolicyResources() map[string]*logs.ResourcePolicy {
+	results := map[string]*logs.ResourcePolicy{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *logs.ResourcePolicy:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetLogsResourcePolicyWithName retrieves all logs.ResourcePolicy items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetLogsResourcePolicyWithName(name string) (*logs.ResourcePolicy, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *logs.ResourcePolicy:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type logs.ResourcePolicy not found", name)
+}
+
+// GetAllLogsSubscriptionFilterResources retrieves all logs.SubscriptionFilter items from an AWS CloudFormation template
+func (t *Template) GetAllLogsSubscriptionFilterResources() map[string]*logs.SubscriptionFilter {
+	results := map[string]*logs.SubscriptionFilter{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *logs.SubscriptionFilter:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetLogsSubscriptionFilterWithName retrieves all logs.SubscriptionFilter items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetLogsSubscriptionFilterWithName(name string) (*logs.SubscriptionFilter, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *logs.SubscriptionFilter:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type logs.SubscriptionFilter not found", name)
+}
+
+// GetAllRoute53DNSSECResources retrieves all route53.DNSSEC items from an AWS CloudFormation template
+func (t *Template) GetAllRoute53DNSSECResources() map[string]*route53.DNSSEC {
+	results := map[string]*route53.DNSSEC{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *route53.DNSSEC:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetRoute53DNSSECWithName retrieves all route53.DNSSEC items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetRoute53DNSSECWithName(name string) (*route53.DNSSEC, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *route53.DNSSEC:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type route53.DNSSEC not found", name)
+}
+
+// GetAllRoute53HealthCheckResources retrieves all route53.HealthCheck items from an AWS CloudFormation template
+func (t *Template) GetAllRoute53HealthCheckResources() map[string]*route53.HealthCheck {
+	results := map[string]*route53.HealthCheck{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *route53.HealthCheck:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetRoute53HealthCheckWithName retrieves all route53.HealthCheck items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetRoute53HealthCheckWithName(name string) (*route53.HealthCheck, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *route53.HealthCheck:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type route53.HealthCheck not found", name)
+}
+
+// GetAllRoute53HostedZoneResources retrieves all route53.HostedZone items from an AWS CloudFormation template
+func (t *Template) GetAllRoute53HostedZoneResources() map[string]*route53.HostedZone {
+	results := map[string]*route53.HostedZone{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *route53.HostedZone:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetRoute53HostedZoneWithName retrieves all route53.HostedZone items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetRoute53HostedZoneWithName(name string) (*route53.HostedZone, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *route53.HostedZone:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type route53.HostedZone not found", name)
+}
+
+// GetAllRoute53KeySigningKeyResources retrieves all route53.KeySigningKey items from an AWS CloudFormation template
+func (t *Template) GetAllRoute53KeySigningKeyResources() map[string]*route53.KeySigningKey {
+	results := map[string]*route53.KeySigningKey{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *route53.KeySigningKey:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetRoute53KeySigningKeyWithName retrieves all route53.KeySigningKey items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetRoute53KeySigningKeyWithName(name string) (*route53.KeySigningKey, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *route53.KeySigningKey:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type route53.KeySigningKey not found", name)
+}
+
+// GetAllRoute53RecordSetResources retrieves all route53.RecordSet items from an AWS CloudFormation template
+func (t *Template) GetAllRoute53RecordSetResources() map[string]*route53.RecordSet {
+	results := map[string]*route53.RecordSet{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *route53.RecordSet:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetRoute53RecordSetWithName retrieves all route53.RecordSet items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetRoute53RecordSetWithName(name string) (*route53.RecordSet, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *route53.RecordSet:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type route53.RecordSet not found", name)
+}
+
+// GetAllRoute53RecordSetGroupResources retrieves all route53.RecordSetGroup items from an AWS CloudFormation template
+func (t *Template) GetAllRoute53RecordSetGroupResources() map[string]*route53.RecordSetGroup {
+	results := map[string]*route53.RecordSetGroup{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *route53.RecordSetGroup:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetRoute53RecordSetGroupWithName retrieves all route53.RecordSetGroup items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetRoute53RecordSetGroupWithName(name string) (*route53.RecordSetGroup, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *route53.RecordSetGroup:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type route53.RecordSetGroup not found", name)
+}
+
+// GetAllRoute53ResolverResolverQueryLoggingConfigResources retrieves all route53resolver.ResolverQueryLoggingConfig items from an AWS CloudFormation template
+func (t *Template) GetAllRoute53ResolverResolverQueryLoggingConfigResources() map[string]*route53resolver.ResolverQueryLoggingConfig {
+	results := map[string]*route53resolver.ResolverQueryLoggingConfig{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *route53resolver.ResolverQueryLoggingConfig:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetRoute53ResolverResolverQueryLoggingConfigWithName retrieves all route53resolver.ResolverQueryLoggingConfig items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetRoute53ResolverResolverQueryLoggingConfigWithName(name string) (*route53resolver.ResolverQueryLoggingConfig, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *route53resolver.ResolverQueryLoggingConfig:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type route53resolver.ResolverQueryLoggingConfig not found", name)
+}
+
+// GetAllRoute53ResolverResolverQueryLoggingConfigAssociationResources retrieves all route53resolver.ResolverQueryLoggingConfigAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllRoute53ResolverResolverQueryLoggingConfigAssociationResources() map[string]*route53resolver.ResolverQueryLoggingConfigAssociation {
+	results := map[string]*route53resolver.ResolverQueryLoggingConfigAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *route53resolver.ResolverQueryLoggingConfigAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetRoute53ResolverResolverQueryLoggingConfigAssociationWithName retrieves all route53resolver.ResolverQueryLoggingConfigAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetRoute53ResolverResolverQueryLoggingConfigAssociationWithName(name string) (*route53resolver.ResolverQueryLoggingConfigAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *route53resolver.ResolverQueryLoggingConfigAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type route53resolver.ResolverQueryLoggingConfigAssociation not found", name)
+}
+
+// GetAllRoute53ResolverResolverRuleAssociationResources retrieves all route53resolver.ResolverRuleAssociation items from an AWS CloudFormation template
+func (t *Template) GetAllRoute53ResolverResolverRuleAssociationResources() map[string]*route53resolver.ResolverRuleAssociation {
+	results := map[string]*route53resolver.ResolverRuleAssociation{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *route53resolver.ResolverRuleAssociation:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetRoute53ResolverResolverRuleAssociationWithName retrieves all route53resolver.ResolverRuleAssociation items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetRoute53ResolverResolverRuleAssociationWithName(name string) (*route53resolver.ResolverRuleAssociation, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *route53resolver.ResolverRuleAssociation:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type route53resolver.ResolverRuleAssociation not found", name)
+}
+
+// GetAllSNSSubscriptionResources retrieves all sns.Subscription items from an AWS CloudFormation template
+func (t *Template) GetAllSNSSubscriptionResources() map[string]*sns.Subscription {
+	results := map[string]*sns.Subscription{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *sns.Subscription:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetSNSSubscriptionWithName retrieves all sns.Subscription items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetSNSSubscriptionWithName(name string) (*sns.Subscription, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *sns.Subscription:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type sns.Subscription not found", name)
+}
+
+// GetAllSNSTopicResources retrieves all sns.Topic items from an AWS CloudFormation template
+func (t *Template) GetAllSNSTopicResources() map[string]*sns.Topic {
+	results := map[string]*sns.Topic{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *sns.Topic:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetSNSTopicWithName retrieves all sns.Topic items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetSNSTopicWithName(name string) (*sns.Topic, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *sns.Topic:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type sns.Topic not found", name)
+}
+
+// GetAllSNSTopicPolicyResources retrieves all sns.TopicPolicy items from an AWS CloudFormation template
+func (t *Template) GetAllSNSTopicPolicyResources() map[string]*sns.TopicPolicy {
+	results := map[string]*sns.TopicPolicy{}
+	for name, untyped := range t.Resources {
+		switch resource := untyped.(type) {
+		case *sns.TopicPolicy:
+			results[name] = resource
+		}
+	}
+	return results
+}
+
+// GetSNSTopicPolicyWithName retrieves all sns.TopicPolicy items from an AWS CloudFormation template
+// whose logical ID matches the provided name. Returns an error if not found.
+func (t *Template) GetSNSTopicPolicyWithName(name string) (*sns.TopicPolicy, error) {
+	if untyped, ok := t.Resources[name]; ok {
+		switch resource := untyped.(type) {
+		case *sns.TopicPolicy:
+			return resource, nil
+		}
+	}
+	return nil, fmt.Errorf("resource %q of type sns.TopicPolicy not found", name)
+}
@@ -0,0 +1,218 @@
+package cloudformation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sanathkr/yaml"
+
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
+)
+
+// Template represents an AWS CloudFormation template
+// see: http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/template-anatomy.html
+type Template struct {
+	AWSTemplateFormatVersion string                 `json:"AWSTemplateFormatVersion,omitempty"`
+	Transform                *Transform             `json:"Transform,omitempty"`
+	Description              string                 `json:"Description,omitempty"`
+	Metadata                 map[string]interface{} `json:"Metadata,omitempty"`
+	Parameters               Parameters             `json:"Parameters,omitempty"`
+	Mappings                 map[string]interface{} `json:"Mappings,omitempty"`
+	Conditions               map[string]interface{} `json:"Conditions,omitempty"`
+	Resources                Resources              `json:"Resources,omitempty"`
+	Outputs                  Outputs                `json:"Outputs,omitempty"`
+}
+
+// Parameter is an input parameter of a template
+type Parameter struct {
+	Type                  string      `json:"Type"`
+	Description           string      `json:"Description,omitempty"`
+	Default               interface{} `json:"Default,omitempty"`
+	AllowedPattern        string      `json:"AllowedPattern,omitempty"`
+	AllowedValues         []string    `json:"AllowedValues,omitempty"`
+	ConstraintDescription string      `json:"ConstraintDescription,omitempty"`
+	MaxLength             int         `json:"MaxLength,omitempty"`
+	MinLength             int         `json:"MinLength,omitempty"`
+	MaxValue              float64     `json:"MaxValue,omitempty"`
+	MinValue              float64     `json:"MinValue,omitempty"`
+	NoEcho                bool        `json:"NoEcho,omitempty"`
+}
+
+// Output is an output value of a template
+type Output struct {
+	Value       interface{} `json:"Value"`
+	Description string      `json:"Description,omitempty"`
+	Export      *Export     `json:"Export,omitempty"`
+}
+
+// Export is the name an output is exported as, for cross-stack references
+type Export struct {
+	Name *types.Value `json:"Name,omitempty"`
+}
+
+// Resource is a resource of a template
+type Resource interface {
+	AWSCloudFormationType() string
+}
+
+// Parameters are the parameters of a template, keyed by name
+type Parameters map[string]Parameter
+
+// Resources are the resources of a template, keyed by logical name
+type Resources map[string]Resource
+
+// Outputs are the outputs of a template, keyed by logical name
+type Outputs map[string]Output
+
+// UnmarshalJSON unmarshals each resource into the type modelling its resource type, or into a CustomResource
+// if the type is not modelled
+func (resources *Resources) UnmarshalJSON(b []byte) error {
+	// Resources
+	var rawResources map[string]*json.RawMessage
+	err := json.Unmarshal(b, &rawResources)
+
+	if err != nil {
+		return err
+	}
+
+	newResources := Resources{}
+	for name, raw := range rawResources {
+		res, err := unmarshallResource(name, raw)
+		if err != nil {
+			return err
+		}
+		newResources[name] = res
+	}
+
+	*resources = newResources
+	return nil
+}
+
+func unmarshallResource(name string, rawJSON *json.RawMessage) (Resource, error) {
+	var err error
+
+	type rType struct {
+		Type string
+	}
+
+	var rtype rType
+	if err = json.Unmarshal(*rawJSON, &rtype); err != nil {
+		return nil, err
+	}
+
+	if rtype.Type == "" {
+		return nil, fmt.Errorf("Cannot find Type for %v", name)
+	}
+
+	// Custom Resource Handler
+	var resourceStruct Resource
+
+	if strings.HasPrefix(rtype.Type, "Custom::") {
+		resourceStruct = &CustomResource{Type: rtype.Type}
+	} else if r, ok := AllResources()[rtype.Type]; ok {
+		resourceStruct = r
+	} else {
+		// only the resource types used by eksctl are modelled, others, e.g. extra resources added to stacks by
+		// users, are kept untyped
+		resourceStruct = &CustomResource{Type: rtype.Type}
+	}
+
+	err = json.Unmarshal(*rawJSON, resourceStruct)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceStruct, nil
+}
+
+// Transform are the macros processing a template, either a single one or a list
+type Transform struct {
+	String *string
+
+	StringArray *[]string
+}
+
+func (t Transform) value() interface{} {
+	if t.String != nil {
+		return t.String
+	}
+
+	if t.StringArray != nil {
+		return t.StringArray
+	}
+
+	return nil
+}
+
+// MarshalJSON marshals the transform as a string or a list
+func (t *Transform) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value())
+}
+
+// UnmarshalJSON unmarshals a string or a list of strings
+func (t *Transform) UnmarshalJSON(b []byte) error {
+	var typecheck interface{}
+	if err := json.Unmarshal(b, &typecheck); err != nil {
+		return err
+	}
+
+	switch val := typecheck.(type) {
+
+	case string:
+		t.String = &val
+
+	case []string:
+		t.StringArray = &val
+
+	case []interface{}:
+		var strslice []string
+		for _, i := range val {
+			switch str := i.(type) {
+			case string:
+				strslice = append(strslice, str)
+			}
+		}
+		t.StringArray = &strslice
+	}
+
+	return nil
+}
+
+// NewTemplate creates a new AWS CloudFormation template struct
+func NewTemplate() *Template {
+	return &Template{
+		AWSTemplateFormatVersion: "2010-09-09",
+		Description:              "",
+		Metadata:                 map[string]interface{}{},
+		Parameters:               Parameters{},
+		Mappings:                 map[string]interface{}{},
+		Conditions:               map[string]interface{}{},
+		Resources:                Resources{},
+		Outputs:                  Outputs{},
+	}
+}
+
+// JSON converts an AWS CloudFormation template object to JSON
+func (t *Template) JSON() ([]byte, error) {
+
+	j, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+// YAML converts an AWS CloudFormation template object to YAML
+func (t *Template) YAML() ([]byte, error) {
+
+	j, err := t.JSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.JSONToYAML(j)
+
+}
@@ -0,0 +1,350 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// The names of the intrinsic functions
+var (
+	Ref           = "Ref"
+	FnBase64      = "Fn::Base64"
+	FnCIDR        = "Fn::Cidr"
+	FnAnd         = "Fn::And"
+	FnEquals      = "Fn::Equals"
+	FnIf          = "Fn::If"
+	FnNot         = "Fn::Not"
+	FnOr          = "Fn::Or"
+	FnFindInMap   = "Fn::FindInMap"
+	FnGetAtt      = "Fn::GetAtt"
+	FnGetAZs      = "Fn::GetAZs"
+	FnImportValue = "Fn::ImportValue"
+	FnJoin        = "Fn::Join"
+	FnSub         = "Fn::Sub"
+	FnSelect      = "Fn::Select"
+	FnSplit       = "Fn::Split"
+)
+
+// The references to the pseudo parameters
+var (
+	RefAccountID        = MakeRef(AccountID)
+	RefNotificationARNs = MakeRef(NotificationARNs)
+	RefNoValue          = MakeRef(NoValue)
+	RefPartition        = MakeRef(Partition)
+	RefRegion           = MakeRef(Region)
+	RefStackID          = MakeRef(StackID)
+	RefStackName        = MakeRef(StackName)
+)
+
+// Intrinsic is a call to an intrinsic function, marshalled as a map from the name of the function to its
+// arguments
+type Intrinsic struct {
+	Value map[string]interface{}
+}
+
+// MakeIntrinsic returns a Value calling the intrinsic function k with the arguments v
+func MakeIntrinsic(k string, v interface{}) *Value {
+	return NewValue(
+		Intrinsic{
+			map[string]interface{}{
+				k: v,
+			},
+		},
+	)
+}
+
+// MakeRef returns a reference to the parameter or resource r
+func MakeRef(r string) *Value { return MakeIntrinsic(Ref, r) }
+
+// MakeFnCIDR returns an Fn::Cidr of count n CIDR blocks with l subnet bits in the block arg
+func MakeFnCIDR(arg, n, l *Value) *Value {
+	return MakeIntrinsic(FnCIDR,
+		[]*Value{
+			arg,
+			n,
+			l,
+		},
+	)
+}
+
+// MakeFnEquals returns an Fn::Equals comparing value1 and value2
+func MakeFnEquals(value1, value2 *Value) *Value {
+	return MakeIntrinsic(FnEquals, []*Value{value1, value2})
+}
+
+// MakeFnGetAtt returns an Fn::GetAtt of the attribute attr of the resource name
+func MakeFnGetAtt(name string, attr *Value) *Value {
+	return MakeIntrinsic(FnGetAtt, []interface{}{name, attr})
+}
+
+// MakeFnGetAttString returns an Fn::GetAtt of the attribute attr of the resource name
+func MakeFnGetAttString(name string, attr string) *Value {
+	return MakeFnGetAtt(name, NewString(attr))
+}
+
+// MakeFnImportValue returns an Fn::ImportValue of the output exported as arg
+func MakeFnImportValue(arg *Value) *Value { return MakeIntrinsic(FnImportValue, arg) }
+
+// MakeFnImportValueString returns an Fn::ImportValue of the output exported as arg
+func MakeFnImportValueString(arg string) *Value { return MakeFnImportValue(NewString(arg)) }
+
+// MakeFnJoin returns an Fn::Join of args with the delimiter sep
+func MakeFnJoin(sep string, args []*Value) *Value {
+	return MakeIntrinsic(FnJoin,
+		[]interface{}{
+			sep,
+			args,
+		},
+	)
+}
+
+// MakeFnSubString returns an Fn::Sub substituting the variables in arg
+func MakeFnSubString(arg string) *Value { return MakeIntrinsic(FnSub, arg) }
+
+// MakeFnSelect returns an Fn::Select of the item at index i of the list arg
+func MakeFnSelect(i, arg *Value) *Value {
+	return MakeIntrinsic(FnSelect,
+		[]interface{}{
+			i,
+			arg,
+		},
+	)
+}
+
+// MakeFnSplit returns an Fn::Split of arg by the delimiter sep
+func MakeFnSplit(sep string, arg *Value) *Value {
+	return MakeIntrinsic(FnSplit,
+		[]interface{}{sep, arg},
+	)
+}
+
+// MakeFnSplitString returns an Fn::Split of arg by the delimiter sep
+func MakeFnSplitString(sep string, arg string) *Value {
+	return MakeFnSplit(sep, NewString(arg))
+}
+
+// MakeFnFindInMap returns an Fn::FindInMap of the value of secondLevelKey under topLevelKey in the mapping
+// mapName
+func MakeFnFindInMap(mapName *Value, topLevelKey *Value, secondLevelKey *Value) *Value {
+	return MakeIntrinsic(
+		FnFindInMap,
+		[]interface{}{mapName, topLevelKey, secondLevelKey},
+	)
+}
+
+// MakeFnBase64 returns an Fn::Base64 of arg
+func MakeFnBase64(arg *Value) *Value { return MakeIntrinsic(FnBase64, arg) }
+
+// MakeFnBase64String returns an Fn::Base64 of arg
+func MakeFnBase64String(arg string) *Value { return MakeFnBase64(NewString(arg)) }
+
+// MakeFnGetAZs returns an Fn::GetAZs of the availability zones of region, or of the region of the stack if region
+// is empty
+func MakeFnGetAZs(region *Value) *Value { return MakeIntrinsic(FnGetAZs, region) }
+
+// MakeFnIf returns an Fn::If of valueIfTrue if the condition is true, and of valueIfFalse otherwise
+func MakeFnIf(condition string, valueIfTrue, valueIfFalse *Value) *Value {
+	return MakeIntrinsic(FnIf,
+		[]interface{}{
+			condition,
+			valueIfTrue,
+			valueIfFalse,
+		},
+	)
+}
+
+// MakeFnNot returns an Fn::Not negating condition
+func MakeFnNot(condition *Value) *Value {
+	return MakeIntrinsic(FnNot, []*Value{condition})
+}
+
+// MakeFnAnd returns an Fn::And of conditions
+func MakeFnAnd(conditions ...*Value) *Value {
+	return MakeIntrinsic(FnAnd, conditions)
+}
+
+// MakeFnOr returns an Fn::Or of conditions
+func MakeFnOr(conditions ...*Value) *Value {
+	return MakeIntrinsic(FnOr, conditions)
+}
+
+// MarshalJSON marshals the call as a map
+func (v Intrinsic) MarshalJSON() ([]byte, error) { return json.Marshal(&v.Value) }
+
+func makeIntrinsic(typ string, arg interface{}) (*Value, error) {
+	switch typ {
+	case Ref:
+		switch a := arg.(type) {
+		case string:
+			return MakeRef(a), nil
+		default:
+			return nil, fmt.Errorf("Cannot create Ref")
+		}
+	case FnGetAtt:
+		switch a := arg.(type) {
+		case []interface{}:
+			if len(a) != 2 {
+				return nil, fmt.Errorf("Cannot parse FnGetAtt")
+			}
+			logicalName, ok := a[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("Cannot parse FnGetAtt")
+			}
+			attr, err := NewValueFromPrimitive(a[1])
+			if err != nil {
+				return nil, err
+			}
+			return MakeFnGetAtt(logicalName, attr), nil
+		case string:
+			splits := strings.Split(a, ".")
+			if len(splits) != 2 {
+				return nil, fmt.Errorf("Cannot parse parameters for FnGetAtt")
+			}
+			return MakeFnGetAtt(splits[0], NewString(splits[1])), nil
+		default:
+			return nil, fmt.Errorf("Cannot parse FnGetAtt")
+		}
+	case FnImportValue:
+		argV, err := NewValueFromPrimitive(arg)
+		if err != nil {
+			return nil, err
+		}
+		return MakeIntrinsic(typ, argV), nil
+	case FnCIDR:
+		switch a := arg.(type) {
+		case []interface{}:
+			if len(a) != 3 {
+				return nil, fmt.Errorf("Cannot parse FnGetAtt")
+			}
+			var vs []*Value
+			for _, v := range a {
+				vv, err := NewValueFromPrimitive(v)
+				if err != nil {
+					return nil, err
+				}
+				vs = append(vs, vv)
+			}
+			return MakeFnCIDR(vs[0], vs[1], vs[2]), nil
+		}
+		return nil, fmt.Errorf("Expected array as argument for Fn::CIDR")
+	case FnJoin:
+		switch a := arg.(type) {
+		case []interface{}:
+			if len(a) != 2 {
+				return nil, fmt.Errorf("Couldn't parse Fn::Join")
+			}
+			del, ok := a[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("Couldn't parse delimiter")
+			}
+			rawVs, ok := a[1].([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("Couldn't parse delimiter")
+			}
+			var vs []*Value
+			for _, v := range rawVs {
+				vv, err := NewValueFromPrimitive(v)
+				if err != nil {
+					return nil, err
+				}
+				vs = append(vs, vv)
+			}
+			return MakeFnJoin(del, vs), nil
+		}
+		return nil, fmt.Errorf("Expected array as argument for Fn::CIDR")
+	case FnEquals:
+		switch a := arg.(type) {
+		case []interface{}:
+			if len(a) != 2 {
+				return nil, fmt.Errorf("Cannot parse FnEquals")
+			}
+			var vs []*Value
+			for _, v := range a {
+				vv, err := NewValueFromPrimitive(v)
+				if err != nil {
+					return nil, err
+				}
+				vs = append(vs, vv)
+			}
+			return MakeFnEquals(vs[0], vs[1]), nil
+		}
+		return nil, fmt.Errorf("Expected array as argument for Fn::CIDR")
+	case FnSelect:
+		switch a := arg.(type) {
+		case []interface{}:
+			if len(a) != 2 {
+				return nil, fmt.Errorf("Cannot parse Fn::Select")
+			}
+			var vs []*Value
+			for _, v := range a {
+				vv, err := NewValueFromPrimitive(v)
+				if err != nil {
+					return nil, err
+				}
+				vs = append(vs, vv)
+			}
+			return MakeFnSelect(vs[0], vs[1]), nil
+		}
+		return nil, fmt.Errorf("Expected array as argument for Fn::Select")
+	case FnSub:
+		return MakeIntrinsic(typ, arg), nil
+	case FnSplit:
+		switch a := arg.(type) {
+		case []interface{}:
+			if len(a) != 2 {
+				return nil, fmt.Errorf("Couldn't parse Fn::Split")
+			}
+			del, ok := a[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("Couldn't parse delimiter")
+			}
+			src, err := NewValueFromPrimitive(a[1])
+			if err != nil {
+				return nil, err
+			}
+			return MakeFnSplit(del, src), nil
+		}
+		return nil, fmt.Errorf("Expected array as argument for Fn::CIDR")
+	case FnFindInMap:
+		switch a := arg.(type) {
+		case []interface{}:
+			if len(a) != 3 {
+				return nil, fmt.Errorf("Cannot parse %s intrinsic arguments as list", FnFindInMap)
+			}
+			var vs []*Value
+			for _, v := range a {
+				vv, err := NewValueFromPrimitive(v)
+				if err != nil {
+					return nil, err
+				}
+				vs = append(vs, vv)
+			}
+			return MakeFnFindInMap(vs[0], vs[1], vs[2]), nil
+		}
+		return nil, fmt.Errorf("Expected array as argument for %s", FnFindInMap)
+	// "Unsupported" intrinsics
+	case FnBase64, FnGetAZs, FnAnd, FnIf, FnNot, FnOr:
+		return MakeIntrinsic(typ, arg), nil
+	default:
+		return nil, nil
+	}
+}
+
+// IntrinsicFromMap attempts to parse a map to an intrinsic function
+func IntrinsicFromMap(raw map[string]interface{}) (*Value, error) {
+	if len(raw) != 1 {
+		return nil, fmt.Errorf("map must contain one key")
+	}
+	for k, v := range raw {
+		intr, err := makeIntrinsic(k, v)
+		if err != nil {
+			return nil, err
+		}
+		if intr != nil {
+			return intr, nil
+		}
+		return nil, fmt.Errorf("map didn't contain intrinsic function key")
+	}
+	panic("Unreachable!")
+}
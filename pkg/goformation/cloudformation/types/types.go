@@ -0,0 +1,226 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// The pseudo parameters predefined by CloudFormation
+const (
+	AccountID        = "AWS::AccountId"
+	NotificationARNs = "AWS::NotificationARNs"
+	NoValue          = "AWS::NoValue"
+	Partition        = "AWS::Partition"
+	Region           = "AWS::Region"
+	StackID          = "AWS::StackId"
+	StackName        = "AWS::StackName"
+)
+
+// Value is a value of a property of a template, either a primitive, a list, a map or an intrinsic function
+type Value struct {
+	value json.Marshaler
+}
+
+// NewValue returns a Value which marshals to v
+func NewValue(v json.Marshaler) *Value { return &Value{value: v} }
+
+// NewValueFromPrimitive converts a value unmarshalled from JSON to a Value, parsing maps holding an intrinsic
+// function
+func NewValueFromPrimitive(raw interface{}) (*Value, error) {
+	switch p := raw.(type) {
+	case string:
+		return NewString(p), nil
+	case float64:
+		return NewDouble(p), nil
+	case json.Number:
+		i, err := p.Int64()
+		if err == nil {
+			if i <= int64(^uint(0)>>1) {
+				return NewInteger(int(i)), nil
+			}
+			return NewLong(i), nil
+		}
+		f, err := p.Float64()
+		if err == nil {
+			return NewDouble(f), nil
+		}
+		return NewString(p.String()), nil
+	case int:
+		return NewInteger(p), nil
+	case int64:
+		return NewLong(p), nil
+	case bool:
+		return NewBoolean(p), nil
+	case map[string]interface{}:
+		asIntr, err := IntrinsicFromMap(p)
+		if err == nil {
+			return asIntr, nil
+		}
+		return NewValue(AnythingMap(p)), nil
+	case []interface{}:
+		var vs []*Value
+		for _, s := range p {
+			v, err := NewValueFromPrimitive(s)
+			if err != nil {
+				return nil, err
+			}
+			vs = append(vs, v)
+		}
+		return NewSlice(vs...), nil
+	default:
+		return nil, fmt.Errorf("cannot handle type %s", reflect.ValueOf(p).Kind())
+	}
+
+}
+
+// Raw returns the underlying value
+func (v *Value) Raw() interface{} { return v.value }
+
+func (v *Value) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+	if x, ok := v.Raw().(string); ok {
+		return x
+	}
+	return fmt.Sprintf("%v", v.value)
+}
+
+// UnmarshalJSON unmarshals any JSON value, keeping numbers as integers where possible
+func (v *Value) UnmarshalJSON(b []byte) error {
+	var raw interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+
+	newV, err := NewValueFromPrimitive(raw)
+	if err != nil {
+		return err
+	}
+	*v = *newV
+	return nil
+}
+
+// MarshalJSON marshals the underlying value
+func (v Value) MarshalJSON() ([]byte, error) {
+	return v.value.MarshalJSON()
+}
+
+// String is a string value
+type String string
+
+// NewString returns a string Value
+func NewString(v string) *Value { return NewValue(String(v)) }
+
+// MarshalJSON marshals the string
+func (v String) MarshalJSON() ([]byte, error) {
+	x := string(v)
+	return json.Marshal(&x)
+}
+
+// Slice is a list of values
+type Slice []*Value
+
+// NewSlice returns nil if it would otherwise be empty
+func NewSlice(v ...*Value) *Value {
+	if len(v) == 0 {
+		return nil
+	}
+	return NewValue(Slice(v))
+}
+
+// MarshalJSON marshals the list
+func (v Slice) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]*Value(v))
+}
+
+// NewStringSlice returns a list Value of strings, or nil if ss is empty
+func NewStringSlice(ss ...string) *Value {
+	var vs []*Value
+	for _, s := range ss {
+		vs = append(vs, NewString(s))
+	}
+	return NewSlice(vs...)
+}
+
+// AnythingMap is a map value of arbitrary JSON values
+type AnythingMap map[string]interface{}
+
+// MarshalJSON marshals the map
+func (v AnythingMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}(v))
+}
+
+// Convert converts the map into obj by round-tripping it through JSON
+func (v AnythingMap) Convert(obj interface{}) error {
+	x := map[string]interface{}{}
+	x = v
+	data, err := json.Marshal(x)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, obj)
+}
+
+// Long is a 64-bit integer value
+type Long int64
+
+// NewLong returns a 64-bit integer Value
+func NewLong(v int64) *Value { return NewValue(Long(v)) }
+
+// MarshalJSON marshals the integer
+func (v Long) MarshalJSON() ([]byte, error) {
+	x := int64(v)
+	return json.Marshal(&x)
+}
+
+// Integer is an integer value
+type Integer int
+
+// NewInteger returns an integer Value
+func NewInteger(v int) *Value { return NewValue(Integer(v)) }
+
+// MarshalJSON marshals the integer
+func (v Integer) MarshalJSON() ([]byte, error) {
+	x := int(v)
+	return json.Marshal(&x)
+}
+
+// Double is a floating point value
+type Double float64
+
+// NewDouble returns a floating point Value
+func NewDouble(v float64) *Value { return NewValue(Double(v)) }
+
+// MarshalJSON marshals the number
+func (v Double) MarshalJSON() ([]byte, error) {
+	x := float64(v)
+	return json.Marshal(&x)
+}
+
+// Boolean is a boolean value
+type Boolean bool
+
+var (
+	trueValue  = NewBoolean(true)
+	falseValue = NewBoolean(false)
+)
+
+// True returns the true Value
+func True() *Value { return trueValue }
+
+// False returns the false Value
+func False() *Value { return falseValue }
+
+// NewBoolean returns a boolean Value
+func NewBoolean(v bool) *Value { return NewValue(Boolean(v)) }
+
+// MarshalJSON marshals the boolean
+func (v Boolean) MarshalJSON() ([]byte, error) {
+	x := bool(v)
+	return json.Marshal(&x)
+}
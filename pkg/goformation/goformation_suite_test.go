@@ -0,0 +1,13 @@
+package goformation_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGoformation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Goformation Suite")
+}
@@ -0,0 +1,90 @@
+package goformation_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/goformation"
+	"github.com/weaveworks/eksctl/pkg/goformation/cloudformation"
+	gfnec2 "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/ec2"
+	gfnt "github.com/weaveworks/eksctl/pkg/goformation/cloudformation/types"
+)
+
+var _ = Describe("Goformation", func() {
+	It("parses the resources of templates into their types", func() {
+		template, err := goformation.ParseJSON([]byte(`{
+			"Resources": {
+				"VPC": {"Type": "AWS::EC2::VPC", "Properties": {"CidrBlock": "192.168.0.0/16"}}
+			}
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+
+		vpc, err := template.GetEC2VPCWithName("VPC")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vpc.CidrBlock.String()).To(Equal("192.168.0.0/16"))
+		Expect(template.GetAllEC2VPCResources()).To(HaveKey("VPC"))
+	})
+
+	It("keeps resources of types that are not modelled", func() {
+		template, err := goformation.ParseYAML([]byte(`
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: logs
+  Lookup:
+    Type: Custom::Lookup
+    Properties:
+      ServiceToken: arn
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(template.Resources).To(HaveLen(2))
+
+		bucket, ok := template.Resources["Bucket"].(*cloudformation.CustomResource)
+		Expect(ok).To(BeTrue())
+		Expect(bucket.AWSCloudFormationType()).To(Equal("AWS::S3::Bucket"))
+		Expect(bucket.Properties).To(HaveKeyWithValue("BucketName", "logs"))
+		Expect(template.Resources["Lookup"].AWSCloudFormationType()).To(Equal("Custom::Lookup"))
+	})
+
+	It("renders templates", func() {
+		template := cloudformation.NewTemplate()
+		template.Resources["VPC"] = &gfnec2.VPC{}
+		data, err := template.JSON()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"Type": "AWS::EC2::VPC"`))
+	})
+
+	It("renders intrinsic functions", func() {
+		template := cloudformation.NewTemplate()
+		template.Resources["VPC"] = &gfnec2.VPC{
+			CidrBlock: gfnt.MakeFnIf("HasCIDR", gfnt.MakeRef("CIDR"), gfnt.NewString("192.168.0.0/16")),
+		}
+		template.Conditions["HasCIDR"] = gfnt.MakeFnNot(gfnt.MakeFnOr(
+			gfnt.MakeFnEquals(gfnt.MakeRef("CIDR"), gfnt.NewString("")),
+			gfnt.MakeFnAnd(gfnt.True(), gfnt.False()),
+		))
+		template.Outputs["AZs"] = cloudformation.Output{Value: gfnt.MakeFnGetAZs(gfnt.RefRegion)}
+		template.Outputs["Data"] = cloudformation.Output{Value: gfnt.MakeFnBase64String("data")}
+		data, err := template.JSON()
+		Expect(err).NotTo(HaveOccurred())
+
+		parsed, err := goformation.ParseJSON(data)
+		Expect(err).NotTo(HaveOccurred())
+		vpc, err := parsed.GetEC2VPCWithName("VPC")
+		Expect(err).NotTo(HaveOccurred())
+		cidr, err := vpc.CidrBlock.MarshalJSON()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cidr).To(MatchJSON(`{"Fn::If": ["HasCIDR", {"Ref": "CIDR"}, "192.168.0.0/16"]}`))
+		Expect(parsed.Conditions["HasCIDR"]).To(Equal(map[string]interface{}{
+			"Fn::Not": []interface{}{map[string]interface{}{
+				"Fn::Or": []interface{}{
+					map[string]interface{}{"Fn::Equals": []interface{}{map[string]interface{}{"Ref": "CIDR"}, ""}},
+					map[string]interface{}{"Fn::And": []interface{}{true, false}},
+				},
+			}},
+		}))
+		Expect(parsed.Outputs["AZs"].Value).To(Equal(map[string]interface{}{"Fn::GetAZs": map[string]interface{}{"Ref": "AWS::Region"}}))
+		Expect(parsed.Outputs["Data"].Value).To(Equal(map[string]interface{}{"Fn::Base64": "data"}))
+	})
+})
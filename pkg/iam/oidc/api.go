@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -23,6 +24,7 @@ type OpenIDConnectManager struct {
 	accountID string
 	partition string
 	audience  string
+	clientIDs []string
 	tags      map[string]string
 
 	issuerURL          *url.URL
@@ -56,6 +58,7 @@ func NewOpenIDConnectManager(iamapi iamiface.IAMAPI, accountID, issuer, partitio
 		partition: partition,
 		tags:      tags,
 		audience:  defaultAudience,
+		clientIDs: []string{defaultAudience},
 		issuerURL: issuerURL,
 	}
 	return m, nil
@@ -97,7 +100,7 @@ func (m *OpenIDConnectManager) CreateProvider() error {
 	}
 
 	input := &awsiam.CreateOpenIDConnectProviderInput{
-		ClientIDList:   aws.StringSlice([]string{m.audience}),
+		ClientIDList:   aws.StringSlice(m.clientIDs),
 		ThumbprintList: []*string{&m.issuerCAThumbprint},
 		// It has no name or tags, it's keyed to the URL
 		Url:  aws.String(m.issuerURL.String()),
@@ -111,6 +114,111 @@ func (m *OpenIDConnectManager) CreateProvider() error {
 	return nil
 }
 
+// AddClientIDs adds audiences to the client IDs of the provider, so that tokens with these
+// audiences can be used to assume IAM roles
+func (m *OpenIDConnectManager) AddClientIDs(clientIDs ...string) {
+	for _, clientID := range clientIDs {
+		if !containsString(m.clientIDs, clientID) {
+			m.clientIDs = append(m.clientIDs, clientID)
+		}
+	}
+}
+
+// EnsureClientIDs adds any missing client ID to an existing provider
+func (m *OpenIDConnectManager) EnsureClientIDs() error {
+	if len(m.clientIDs) == 1 {
+		// the default client ID is set when the provider is created
+		return nil
+	}
+	output, err := m.iam.GetOpenIDConnectProvider(&awsiam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: &m.ProviderARN,
+	})
+	if err != nil {
+		return errors.Wrap(err, "getting OIDC provider")
+	}
+	existing := aws.StringValueSlice(output.ClientIDList)
+	for _, clientID := range m.clientIDs {
+		if containsString(existing, clientID) {
+			continue
+		}
+		if _, err := m.iam.AddClientIDToOpenIDConnectProvider(&awsiam.AddClientIDToOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: &m.ProviderARN,
+			ClientID:                 aws.String(clientID),
+		}); err != nil {
+			return errors.Wrapf(err, "adding client ID %q to OIDC provider", clientID)
+		}
+	}
+	return nil
+}
+
+// WithAudience returns a copy of the manager making trust policies for tokens with the given audience
+func (m *OpenIDConnectManager) WithAudience(audience string) *OpenIDConnectManager {
+	withAudience := *m
+	withAudience.audience = audience
+	return &withAudience
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckThumbprint re-computes the thumbprint of the issuer's root CA and returns true when
+// it is already trusted by the provider
+func (m *OpenIDConnectManager) CheckThumbprint() (bool, error) {
+	if err := m.getIssuerCAThumbprint(); err != nil {
+		return false, err
+	}
+	output, err := m.iam.GetOpenIDConnectProvider(&awsiam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: &m.ProviderARN,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "getting OIDC provider")
+	}
+	return containsString(aws.StringValueSlice(output.ThumbprintList), m.issuerCAThumbprint), nil
+}
+
+// UpdateThumbprint replaces the thumbprints of the provider with the one computed by CheckThumbprint,
+// this is needed when the root CA of the issuer has been rotated
+func (m *OpenIDConnectManager) UpdateThumbprint() error {
+	if m.issuerCAThumbprint == "" {
+		if err := m.getIssuerCAThumbprint(); err != nil {
+			return err
+		}
+	}
+	input := &awsiam.UpdateOpenIDConnectProviderThumbprintInput{
+		OpenIDConnectProviderArn: &m.ProviderARN,
+		ThumbprintList:           []*string{&m.issuerCAThumbprint},
+	}
+	if _, err := m.iam.UpdateOpenIDConnectProviderThumbprint(input); err != nil {
+		return errors.Wrap(err, "updating OIDC provider thumbprint")
+	}
+	return nil
+}
+
+// SkipIssuerVerification makes the manager connect to the issuer without verifying its certificate
+// against the local trust store. This is only supported for issuers hosted by EKS, as IAM verifies
+// their certificate using its own library of trusted root CAs
+func (m *OpenIDConnectManager) SkipIssuerVerification() error {
+	if !m.isHostedByEKS() {
+		return fmt.Errorf("skipping verification of the certificate of OIDC issuer %q is only supported for issuers hosted by EKS", m.issuerURL.Hostname())
+	}
+	m.insecureSkipVerify = true
+	return nil
+}
+
+func (m *OpenIDConnectManager) isHostedByEKS() bool {
+	hostname := m.issuerURL.Hostname()
+	if !strings.HasPrefix(hostname, "oidc.eks.") {
+		return false
+	}
+	return strings.HasSuffix(hostname, ".amazonaws.com") || strings.HasSuffix(hostname, ".amazonaws.com.cn")
+}
+
 // DeleteProvider will delete the provider using IAM API, it may return an error
 // the API call fails
 func (m *OpenIDConnectManager) DeleteProvider() error {
@@ -156,9 +264,19 @@ func (m *OpenIDConnectManager) getIssuerCAThumbprint() error {
 }
 
 // MakeAssumeRolePolicyDocumentWithServiceAccountConditions constructs a trust policy document for the given
-// provider
+// provider. A service account name containing wildcards matches all the service accounts of the namespace it matches
 func (m *OpenIDConnectManager) MakeAssumeRolePolicyDocumentWithServiceAccountConditions(serviceAccountNamespace, serviceAccountName string) cft.MapOfInterfaces {
 	subject := fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccountNamespace, serviceAccountName)
+	if strings.ContainsAny(serviceAccountName, "*?") {
+		return cft.MakeAssumeRoleWithWebIdentityPolicyDocument(m.ProviderARN, cft.MapOfInterfaces{
+			"StringLike": map[string]string{
+				m.hostnameAndPath() + ":sub": subject,
+			},
+			"StringEquals": map[string]string{
+				m.hostnameAndPath() + ":aud": m.audience,
+			},
+		})
+	}
 	return cft.MakeAssumeRoleWithWebIdentityPolicyDocument(m.ProviderARN, cft.MapOfInterfaces{
 		"StringEquals": map[string]string{
 			m.hostnameAndPath() + ":sub": subject,
@@ -257,6 +257,39 @@ var _ = Describe("EKS/IAM API wrapper", func() {
 			Expect(js).To(MatchJSON(expected))
 		})
 
+		It("should construct assume role policy document for service accounts matching a wildcard", func() {
+			exists, err := oidc.CheckProviderExists()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+
+			document := oidc.MakeAssumeRolePolicyDocumentWithServiceAccountConditions("test-ns1", "test-sa-*")
+
+			expected := `{
+				"Version": "2012-10-17",
+				"Statement": [
+					{
+						"Effect": "Allow",
+						"Principal": {
+							"Federated": "` + fakeProviderARN + `"
+						},
+						"Action": ["sts:AssumeRoleWithWebIdentity"],
+						"Condition": {
+							"StringLike": {
+								"localhost/:sub": "system:serviceaccount:test-ns1:test-sa-*"
+							},
+							"StringEquals": {
+								"localhost/:aud": "sts.amazonaws.com"
+							}
+						}
+					}
+				]
+			}`
+
+			js, err := json.Marshal(document)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(js).To(MatchJSON(expected))
+		})
+
 		It("should construct assume role policy document", func() {
 			exists, err := oidc.CheckProviderExists()
 			Expect(err).NotTo(HaveOccurred())
@@ -341,6 +374,93 @@ var _ = Describe("EKS/IAM API wrapper", func() {
 
 	})
 
+	Describe("client IDs", func() {
+		var (
+			provider *mockprovider.MockProvider
+			oidc     *OpenIDConnectManager
+		)
+
+		BeforeEach(func() {
+			provider = mockprovider.NewMockProvider()
+			var err error
+			oidc, err = NewOpenIDConnectManager(provider.IAM(), "12345", exampleIssuer, "aws", nil)
+			Expect(err).NotTo(HaveOccurred())
+			oidc.ProviderARN = fakeProviderARN
+		})
+
+		It("does not update the provider when only the default client ID is used", func() {
+			oidc.AddClientIDs(defaultAudience)
+			Expect(oidc.EnsureClientIDs()).To(Succeed())
+			provider.MockIAM().AssertNotCalled(GinkgoT(), "GetOpenIDConnectProvider", mock.Anything)
+		})
+
+		It("adds missing client IDs to an existing provider", func() {
+			oidc.AddClientIDs("existing-audience", "new-audience", "new-audience")
+			provider.MockIAM().On("GetOpenIDConnectProvider", mock.Anything).Return(&awsiam.GetOpenIDConnectProviderOutput{
+				ClientIDList: aws.StringSlice([]string{defaultAudience, "existing-audience"}),
+			}, nil)
+			var added []string
+			provider.MockIAM().On("AddClientIDToOpenIDConnectProvider", mock.MatchedBy(func(input *awsiam.AddClientIDToOpenIDConnectProviderInput) bool {
+				added = append(added, *input.ClientID)
+				return *input.OpenIDConnectProviderArn == fakeProviderARN
+			})).Return(&awsiam.AddClientIDToOpenIDConnectProviderOutput{}, nil)
+
+			Expect(oidc.EnsureClientIDs()).To(Succeed())
+			Expect(added).To(Equal([]string{"new-audience"}))
+		})
+
+		It("makes trust policies for a different audience", func() {
+			document := oidc.WithAudience("custom-audience").MakeAssumeRolePolicyDocument()
+			actual, err := json.Marshal(document)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(actual)).To(ContainSubstring(`:aud":"custom-audience"`))
+
+			document = oidc.MakeAssumeRolePolicyDocument()
+			actual, err = json.Marshal(document)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(actual)).To(ContainSubstring(`:aud":"sts.amazonaws.com"`))
+		})
+	})
+
+	Describe("thumbprints", func() {
+		var provider *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			provider = mockprovider.NewMockProvider()
+		})
+
+		It("updates the thumbprint of the provider", func() {
+			oidc, err := NewOpenIDConnectManager(provider.IAM(), "12345", exampleIssuer, "aws", nil)
+			Expect(err).NotTo(HaveOccurred())
+			oidc.ProviderARN = fakeProviderARN
+			oidc.issuerCAThumbprint = "new-thumbprint"
+
+			provider.MockIAM().On("UpdateOpenIDConnectProviderThumbprint", mock.MatchedBy(func(input *awsiam.UpdateOpenIDConnectProviderThumbprintInput) bool {
+				return *input.OpenIDConnectProviderArn == fakeProviderARN &&
+					len(input.ThumbprintList) == 1 && *input.ThumbprintList[0] == "new-thumbprint"
+			})).Return(&awsiam.UpdateOpenIDConnectProviderThumbprintOutput{}, nil)
+
+			Expect(oidc.UpdateThumbprint()).To(Succeed())
+			provider.MockIAM().AssertExpectations(GinkgoT())
+		})
+
+		It("only skips verification of the issuer certificate for issuers hosted by EKS", func() {
+			oidc, err := NewOpenIDConnectManager(provider.IAM(), "12345", "https://oidc.eks.us-west-2.amazonaws.com/id/13EBFE0C5BD60778E91DFE559E02689C", "aws", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oidc.SkipIssuerVerification()).To(Succeed())
+			Expect(oidc.insecureSkipVerify).To(BeTrue())
+
+			oidc, err = NewOpenIDConnectManager(provider.IAM(), "12345", "https://oidc.eks.cn-north-1.amazonaws.com.cn/id/13EBFE0C5BD60778E91DFE559E02689C", "aws-cn", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oidc.SkipIssuerVerification()).To(Succeed())
+
+			oidc, err = NewOpenIDConnectManager(provider.IAM(), "12345", exampleIssuer, "aws", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oidc.SkipIssuerVerification()).To(MatchError(ContainSubstring("only supported for issuers hosted by EKS")))
+			Expect(oidc.insecureSkipVerify).To(BeFalse())
+		})
+	})
+
 	Describe("OIDC AWS partition test", func() {
 		var (
 			provider *mockprovider.MockProvider
@@ -33,6 +33,18 @@ type FakeHelmInstaller struct {
 	installChartReturnsOnCall map[int]struct {
 		result1 error
 	}
+	InstallOrUpgradeChartStub        func(context.Context, providers.InstallChartOpts) error
+	installOrUpgradeChartMutex       sync.RWMutex
+	installOrUpgradeChartArgsForCall []struct {
+		arg1 context.Context
+		arg2 providers.InstallChartOpts
+	}
+	installOrUpgradeChartReturns struct {
+		result1 error
+	}
+	installOrUpgradeChartReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -161,13 +173,71 @@ func (fake *FakeHelmInstaller) InstallChartReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHelmInstaller) InstallOrUpgradeChart(arg1 context.Context, arg2 providers.InstallChartOpts) error {
+	fake.installOrUpgradeChartMutex.Lock()
+	ret, specificReturn := fake.installOrUpgradeChartReturnsOnCall[len(fake.installOrUpgradeChartArgsForCall)]
+	fake.installOrUpgradeChartArgsForCall = append(fake.installOrUpgradeChartArgsForCall, struct {
+		arg1 context.Context
+		arg2 providers.InstallChartOpts
+	}{arg1, arg2})
+	stub := fake.InstallOrUpgradeChartStub
+	fakeReturns := fake.installOrUpgradeChartReturns
+	fake.recordInvocation("InstallOrUpgradeChart", []interface{}{arg1, arg2})
+	fake.installOrUpgradeChartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHelmInstaller) InstallOrUpgradeChartCallCount() int {
+	fake.installOrUpgradeChartMutex.RLock()
+	defer fake.installOrUpgradeChartMutex.RUnlock()
+	return len(fake.installOrUpgradeChartArgsForCall)
+}
+
+func (fake *FakeHelmInstaller) InstallOrUpgradeChartCalls(stub func(context.Context, providers.InstallChartOpts) error) {
+	fake.installOrUpgradeChartMutex.Lock()
+	defer fake.installOrUpgradeChartMutex.Unlock()
+	fake.InstallOrUpgradeChartStub = stub
+}
+
+func (fake *FakeHelmInstaller) InstallOrUpgradeChartArgsForCall(i int) (context.Context, providers.InstallChartOpts) {
+	fake.installOrUpgradeChartMutex.RLock()
+	defer fake.installOrUpgradeChartMutex.RUnlock()
+	argsForCall := fake.installOrUpgradeChartArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeHelmInstaller) InstallOrUpgradeChartReturns(result1 error) {
+	fake.installOrUpgradeChartMutex.Lock()
+	defer fake.installOrUpgradeChartMutex.Unlock()
+	fake.InstallOrUpgradeChartStub = nil
+	fake.installOrUpgradeChartReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHelmInstaller) InstallOrUpgradeChartReturnsOnCall(i int, result1 error) {
+	fake.installOrUpgradeChartMutex.Lock()
+	defer fake.installOrUpgradeChartMutex.Unlock()
+	fake.InstallOrUpgradeChartStub = nil
+	if fake.installOrUpgradeChartReturnsOnCall == nil {
+		fake.installOrUpgradeChartReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.installOrUpgradeChartReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHelmInstaller) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.addRepoMutex.RLock()
-	defer fake.addRepoMutex.RUnlock()
-	fake.installChartMutex.RLock()
-	defer fake.installChartMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
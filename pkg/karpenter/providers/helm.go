@@ -34,4 +34,6 @@ type HelmInstaller interface {
 	// InstallChart takes a releaseName's name and a chart name and installs it. If namespace is not empty
 	// it will install into that namespace and create the namespace. Version is required.
 	InstallChart(ctx context.Context, opts InstallChartOpts) error
+	// InstallOrUpgradeChart installs the chart if the release does not exist yet, and upgrades it otherwise.
+	InstallOrUpgradeChart(ctx context.Context, opts InstallChartOpts) error
 }
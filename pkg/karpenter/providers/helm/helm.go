@@ -2,6 +2,7 @@ package helm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -15,6 +16,8 @@ import (
 	"helm.sh/helm/v3/pkg/cli"
 	"helm.sh/helm/v3/pkg/getter"
 	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
 )
@@ -22,6 +25,8 @@ import (
 // Options defines options for the Helm Installer.
 type Options struct {
 	Namespace string
+	// RESTClientGetter connects to the cluster the charts are installed in
+	RESTClientGetter genericclioptions.RESTClientGetter
 }
 
 // Installer implement the HelmInstaller interface.
@@ -35,7 +40,10 @@ type Installer struct {
 func NewInstaller(opts Options) (*Installer, error) {
 	settings := cli.New()
 	actionConfig := new(action.Configuration)
-	if err := actionConfig.Init(settings.RESTClientGetter(), opts.Namespace, "", logger.Debug); err != nil {
+	if opts.RESTClientGetter == nil {
+		return nil, errors.New("a REST client getter for the cluster is required")
+	}
+	if err := actionConfig.Init(opts.RESTClientGetter, opts.Namespace, "", logger.Debug); err != nil {
 		return nil, fmt.Errorf("failed to initialize action config: %w", err)
 	}
 	return &Installer{
@@ -109,3 +117,38 @@ func (i *Installer) InstallChart(ctx context.Context, opts providers.InstallChar
 	logger.Debug("successfully installed helm chart: %s", release.Name)
 	return nil
 }
+
+// InstallOrUpgradeChart installs the chart if the release does not exist yet, and upgrades it otherwise.
+func (i *Installer) InstallOrUpgradeChart(ctx context.Context, opts providers.InstallChartOpts) error {
+	history := action.NewHistory(i.ActionConfig)
+	history.Max = 1
+	if _, err := history.Run(opts.ReleaseName); err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return i.InstallChart(ctx, opts)
+		}
+		return fmt.Errorf("failed to get release history: %w", err)
+	}
+
+	client := action.NewUpgrade(i.ActionConfig)
+	client.Wait = true
+	client.Namespace = opts.Namespace
+	client.Version = opts.Version
+	client.Timeout = 10 * time.Minute
+
+	chartPath, err := client.ChartPathOptions.LocateChart(opts.ChartName, i.Settings)
+	if err != nil {
+		return fmt.Errorf("failed to locate chart: %w", err)
+	}
+
+	ch, err := loader.Load(chartPath)
+	if err != nil {
+		return fmt.Errorf("failed to load chart: %w", err)
+	}
+
+	release, err := client.RunWithContext(ctx, opts.ReleaseName, ch, opts.Values)
+	if err != nil {
+		return fmt.Errorf("failed to upgrade chart: %w", err)
+	}
+	logger.Debug("successfully upgraded helm chart: %s", release.Name)
+	return nil
+}
@@ -14,10 +14,12 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/otiai10/copy"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/cli"
 	"helm.sh/helm/v3/pkg/getter"
 	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/repo"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
@@ -261,6 +263,47 @@ var _ = Describe("HelmInstaller", func() {
 				Expect(err).To(MatchError(ContainSubstring("failed to install chart: failed to install CRD crds/karpenter.sh_provisioners.yaml: nope")))
 			})
 		})
+		When("the release already exists", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(filepath.Join(tmp, "repositories.yaml"), []byte(expectedRepositoryYaml), 0644)).To(Succeed())
+				Expect(copy.Copy(filepath.Join("testdata", "karpenter-0.4.3.tgz"), filepath.Join(tmp, "karpenter-0.4.3.tgz"))).To(Succeed())
+				Expect(copy.Copy(filepath.Join("testdata", "karpenter-index.yaml"), filepath.Join(tmp, "karpenter-index.yaml"))).To(Succeed())
+				ch, err := loader.Load(filepath.Join("testdata", "karpenter-0.4.3.tgz"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actionConfig.Releases.Create(&release.Release{
+					Name:      "karpenter",
+					Namespace: "karpenter",
+					Version:   1,
+					Chart:     ch,
+					Info:      &release.Info{Status: release.StatusDeployed},
+				})).To(Succeed())
+			})
+
+			It("upgrades the release", func() {
+				Expect(installerUnderTest.InstallOrUpgradeChart(context.Background(), providers.InstallChartOpts{
+					ChartName:   "karpenter/karpenter",
+					Namespace:   "karpenter",
+					ReleaseName: "karpenter",
+					Values:      values,
+					Version:     "0.4.3",
+				})).To(Succeed())
+				last, err := actionConfig.Releases.Last("karpenter")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(last.Version).To(Equal(2))
+				Expect(last.Config).To(Equal(values))
+			})
+
+			It("errors when the version is unknown", func() {
+				err := installerUnderTest.InstallOrUpgradeChart(context.Background(), providers.InstallChartOpts{
+					ChartName:   "karpenter/karpenter",
+					Namespace:   "karpenter",
+					ReleaseName: "karpenter",
+					Values:      values,
+					Version:     "0.1.0",
+				})
+				Expect(err).To(MatchError(ContainSubstring("failed to locate chart")))
+			})
+		})
 	})
 })
 
@@ -0,0 +1,63 @@
+package kubernetes
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// RESTClientGetter is a genericclioptions.RESTClientGetter for a kubeconfig, e.g. the one eksctl generates for a
+// cluster, rather than the kubeconfig of the user
+type RESTClientGetter struct {
+	clientConfig clientcmd.ClientConfig
+}
+
+var _ genericclioptions.RESTClientGetter = &RESTClientGetter{}
+
+// NewRESTClientGetter creates a RESTClientGetter for the current context of the kubeconfig, defaulting to namespace
+func NewRESTClientGetter(config *clientcmdapi.Config, namespace string) *RESTClientGetter {
+	overrides := &clientcmd.ConfigOverrides{
+		Context: clientcmdapi.Context{Namespace: namespace},
+	}
+	return &RESTClientGetter{
+		clientConfig: clientcmd.NewDefaultClientConfig(*config, overrides),
+	}
+}
+
+// ToRESTConfig returns the REST config of the kubeconfig
+func (g *RESTClientGetter) ToRESTConfig() (*restclient.Config, error) {
+	return g.clientConfig.ClientConfig()
+}
+
+// ToDiscoveryClient returns a discovery client caching its results in memory
+func (g *RESTClientGetter) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	config, err := g.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return memory.NewMemCacheClient(discoveryClient), nil
+}
+
+// ToRESTMapper returns a REST mapper backed by the discovery client
+func (g *RESTClientGetter) ToRESTMapper() (meta.RESTMapper, error) {
+	discoveryClient, err := g.ToDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(discoveryClient)
+	return restmapper.NewShortcutExpander(mapper, discoveryClient), nil
+}
+
+// ToRawKubeConfigLoader returns the client config of the kubeconfig
+func (g *RESTClientGetter) ToRawKubeConfigLoader() clientcmd.ClientConfig {
+	return g.clientConfig
+}
@@ -0,0 +1,32 @@
+package kubernetes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+var _ = Describe("RESTClientGetter", func() {
+	It("connects to the current context of the given kubeconfig in the given namespace", func() {
+		config := clientcmdapi.NewConfig()
+		config.Clusters["cluster-1"] = &clientcmdapi.Cluster{Server: "https://cluster-1.example.com"}
+		config.Clusters["cluster-2"] = &clientcmdapi.Cluster{Server: "https://cluster-2.example.com"}
+		config.AuthInfos["user"] = &clientcmdapi.AuthInfo{Token: "token"}
+		config.Contexts["cluster-1"] = &clientcmdapi.Context{Cluster: "cluster-1", AuthInfo: "user"}
+		config.Contexts["cluster-2"] = &clientcmdapi.Context{Cluster: "cluster-2", AuthInfo: "user"}
+		config.CurrentContext = "cluster-2"
+
+		getter := kubernetes.NewRESTClientGetter(config, "kube-system")
+
+		restConfig, err := getter.ToRESTConfig()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restConfig.Host).To(Equal("https://cluster-2.example.com"))
+		Expect(restConfig.BearerToken).To(Equal("token"))
+
+		namespace, _, err := getter.ToRawKubeConfigLoader().Namespace()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(namespace).To(Equal("kube-system"))
+	})
+})
@@ -0,0 +1,301 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/kris-nova/logger"
+	lol "github.com/kris-nova/lolgopher"
+)
+
+// Formats of the log lines
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Subsystems whose log level can be set separately, see subsystemPackages
+const (
+	SubsystemCFN    = "cfn"
+	SubsystemEKS    = "eks"
+	SubsystemK8s    = "k8s"
+	SubsystemEksctl = "eksctl"
+)
+
+const (
+	modulePrefix = "github.com/weaveworks/eksctl/pkg/"
+
+	// maxLevel is the verbosity logging everything, higher verbosity levels turn on more logging in other libraries
+	maxLevel = 4
+)
+
+// subsystemPackages are the packages, relative to pkg/, logging in each subsystem. Other packages log in the
+// eksctl subsystem
+var subsystemPackages = map[string][]string{
+	SubsystemCFN: {"cfn"},
+	SubsystemEKS: {"eks", "managed", "fargate", "ami", "nodebootstrap"},
+	SubsystemK8s: {"kubernetes", "addons", "authconfigmap", "drain", "utils/kubectl"},
+}
+
+// levels maps the prefixes of the log lines to the minimum verbosity level they are logged at
+var levels = map[string]int{
+	logger.PreAlways:     0,
+	logger.PreSuccess:    0,
+	logger.PreDeprecated: 0,
+	logger.PreCritical:   1,
+	logger.PreWarning:    2,
+	logger.PreInfo:       3,
+	logger.PreDebug:      4,
+}
+
+var levelNames = map[string]int{
+	"critical": 1,
+	"warning":  2,
+	"info":     3,
+	"debug":    4,
+}
+
+// Options configures the logger
+type Options struct {
+	// Level is the verbosity level, from 0 to 5
+	Level int
+	// Color is one of true, false and fabulous
+	Color string
+	// Format is one of text and json
+	Format string
+	// SubsystemLevels overrides Level for the subsystems, by name
+	SubsystemLevels map[string]string
+}
+
+// Configure sets up the logger, and the format of the log lines
+func Configure(options Options) error {
+	if options.Format != FormatText && options.Format != FormatJSON {
+		return fmt.Errorf("invalid log format %q, valid options: %s, %s", options.Format, FormatText, FormatJSON)
+	}
+	subsystemLevels, err := ParseSubsystemLevels(options.SubsystemLevels)
+	if err != nil {
+		return err
+	}
+
+	logger.Layout = "2006-01-02 15:04:05"
+
+	// log lines are filtered by subsystem when formatted, so all levels enabled in any subsystem must be logged
+	bitwiseLevelFor := options.Level
+	for _, level := range subsystemLevels {
+		if level > bitwiseLevelFor {
+			bitwiseLevelFor = level
+		}
+	}
+	logger.BitwiseLevel = bitwiseLevel(bitwiseLevelFor)
+
+	colorize := options.Color == "true" && options.Format == FormatText
+	switch {
+	case options.Format == FormatJSON:
+	case options.Color == "fabulous":
+		logger.Writer = lol.NewLolWriter()
+	case options.Color == "true":
+		logger.Writer = color.Output
+	}
+
+	l := &lineFormatter{
+		level:           options.Level,
+		subsystemLevels: subsystemLevels,
+		json:            options.Format == FormatJSON,
+		colorize:        colorize,
+		now:             time.Now,
+	}
+	logger.Line = l.line
+	formatter = l
+	return nil
+}
+
+// ParseSubsystemLevels returns the verbosity level of each subsystem, given by name (debug, info, warning,
+// critical) or as a verbosity level
+func ParseSubsystemLevels(subsystemLevels map[string]string) (map[string]int, error) {
+	levels := map[string]int{}
+	for subsystem, value := range subsystemLevels {
+		if !isSubsystem(subsystem) {
+			return nil, fmt.Errorf("invalid subsystem %q, valid options: %s", subsystem, strings.Join(Subsystems(), ", "))
+		}
+		level, ok := levelNames[strings.ToLower(value)]
+		if !ok {
+			var err error
+			if level, err = strconv.Atoi(value); err != nil || level < 0 || level > maxLevel {
+				return nil, fmt.Errorf("invalid log level %q for subsystem %q, valid options: debug, info, warning, critical or 0-%d", value, subsystem, maxLevel)
+			}
+		}
+		levels[subsystem] = level
+	}
+	return levels, nil
+}
+
+// Subsystems returns the names of the subsystems
+func Subsystems() []string {
+	subsystems := []string{SubsystemEksctl}
+	for subsystem := range subsystemPackages {
+		subsystems = append(subsystems, subsystem)
+	}
+	sort.Strings(subsystems)
+	return subsystems
+}
+
+func isSubsystem(name string) bool {
+	if name == SubsystemEksctl {
+		return true
+	}
+	_, ok := subsystemPackages[name]
+	return ok
+}
+
+func bitwiseLevel(level int) int {
+	switch level {
+	case 4:
+		return logger.LogDeprecated | logger.LogAlways | logger.LogSuccess | logger.LogCritical | logger.LogWarning | logger.LogInfo | logger.LogDebug
+	case 3:
+		return logger.LogDeprecated | logger.LogAlways | logger.LogSuccess | logger.LogCritical | logger.LogWarning | logger.LogInfo
+	case 2:
+		return logger.LogDeprecated | logger.LogAlways | logger.LogSuccess | logger.LogCritical | logger.LogWarning
+	case 1:
+		return logger.LogDeprecated | logger.LogAlways | logger.LogSuccess | logger.LogCritical
+	case 0:
+		return logger.LogDeprecated | logger.LogAlways | logger.LogSuccess
+	default:
+		return logger.LogDeprecated | logger.LogEverything
+	}
+}
+
+// jsonLine is a log line in the JSON format
+type jsonLine struct {
+	Time      string `json:"time"`
+	Level     string `json:"level"`
+	Subsystem string `json:"subsystem"`
+	Task      string `json:"task,omitempty"`
+	Message   string `json:"msg"`
+}
+
+// formatter formats the log lines once the logger is configured
+var formatter *lineFormatter
+
+type lineFormatter struct {
+	level           int
+	subsystemLevels map[string]int
+	json            bool
+	colorize        bool
+	now             func() time.Time
+}
+
+// line formats a log line, it returns an empty line when the level of the subsystem logging it is lower
+func (l *lineFormatter) line(prefix, format string, a ...interface{}) string {
+	return l.format(prefix, "", format, a...)
+}
+
+// format formats a log line of the task with the given correlation ID, if any
+func (l *lineFormatter) format(prefix, taskID, format string, a ...interface{}) string {
+	subsystem := SubsystemEksctl
+	if len(l.subsystemLevels) > 0 || l.json {
+		subsystem = callerSubsystem()
+	}
+	level, ok := l.subsystemLevels[subsystem]
+	if !ok {
+		level = l.level
+	}
+	if levels[prefix] > level {
+		return ""
+	}
+
+	message := fmt.Sprintf(format, a...)
+	if l.json {
+		data, err := json.Marshal(jsonLine{
+			Time:      l.now().Format(time.RFC3339),
+			Level:     strings.ToLower(strings.TrimSpace(prefix)),
+			Subsystem: subsystem,
+			Task:      taskID,
+			Message:   strings.TrimRight(message, "\n"),
+		})
+		if err != nil {
+			return fmt.Sprintf("%q\n", message)
+		}
+		return string(data) + "\n"
+	}
+
+	if !strings.HasSuffix(message, "\n") {
+		message += "\n"
+	}
+	var colorize func(format string, a ...interface{}) string
+	var icon string
+	switch prefix {
+	case logger.PreAlways:
+		icon = "✿"
+		colorize = color.GreenString
+	case logger.PreCritical:
+		icon = "✖"
+		colorize = color.RedString
+	case logger.PreInfo:
+		icon = "ℹ"
+		colorize = color.CyanString
+	case logger.PreDebug:
+		icon = "▶"
+		colorize = color.GreenString
+	case logger.PreSuccess:
+		icon = "✔"
+		colorize = color.CyanString
+	case logger.PreWarning:
+		icon = "!"
+		colorize = color.GreenString
+	default:
+		icon = "ℹ"
+		colorize = color.CyanString
+	}
+
+	out := fmt.Sprintf("%s [%s]  %s", l.now().Format(logger.Layout), icon, message)
+	if l.colorize {
+		out = colorize("%s", out)
+	}
+	return out
+}
+
+// callerSubsystem returns the subsystem of the package that called the logger
+func callerSubsystem() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "github.com/kris-nova/logger") && !strings.HasPrefix(frame.Function, modulePrefix+"logging.") {
+			return subsystemOf(frame.Function)
+		}
+		if !more {
+			return SubsystemEksctl
+		}
+	}
+}
+
+// subsystemOf returns the subsystem of a function, given by its fully qualified name
+func subsystemOf(function string) string {
+	if !strings.HasPrefix(function, modulePrefix) {
+		return SubsystemEksctl
+	}
+	pkg := strings.TrimPrefix(function, modulePrefix)
+	// the package path ends at the first dot after the last slash
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		if j := strings.Index(pkg[i:], "."); j >= 0 {
+			pkg = pkg[:i+j]
+		}
+	} else if j := strings.Index(pkg, "."); j >= 0 {
+		pkg = pkg[:j]
+	}
+	for subsystem, packages := range subsystemPackages {
+		for _, p := range packages {
+			if pkg == p || strings.HasPrefix(pkg, p+"/") {
+				return subsystem
+			}
+		}
+	}
+	return SubsystemEksctl
+}
@@ -0,0 +1,127 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Logging", func() {
+	var (
+		out            *bytes.Buffer
+		originalWriter = logger.Writer
+		originalLine   = logger.Line
+		originalLevel  = logger.BitwiseLevel
+	)
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+	})
+
+	AfterEach(func() {
+		logger.Writer = originalWriter
+		logger.Line = originalLine
+		logger.BitwiseLevel = originalLevel
+	})
+
+	configure := func(options Options) {
+		Expect(Configure(options)).To(Succeed())
+		logger.Writer = out
+	}
+
+	decodeLines := func() []jsonLine {
+		var lines []jsonLine
+		for _, l := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			var line jsonLine
+			ExpectWithOffset(1, json.Unmarshal([]byte(l), &line)).To(Succeed())
+			lines = append(lines, line)
+		}
+		return lines
+	}
+
+	It("formats text lines", func() {
+		configure(Options{Level: 3, Color: "false", Format: FormatText})
+		logger.Info("creating %s", "cluster-1")
+		logger.Debug("not logged")
+
+		Expect(out.String()).To(MatchRegexp(`^\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2} \[ℹ\]  creating cluster-1\n$`))
+	})
+
+	It("formats JSON lines", func() {
+		configure(Options{Level: 3, Color: "true", Format: FormatJSON})
+		logger.Warning("%d%% of %d nodes are ready\n", 100, 2)
+
+		lines := decodeLines()
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0].Level).To(Equal("warning"))
+		Expect(lines[0].Message).To(Equal("100% of 2 nodes are ready"))
+		Expect(lines[0].Subsystem).To(Equal(SubsystemEksctl))
+		Expect(lines[0].Task).To(BeEmpty())
+		_, err := time.Parse(time.RFC3339, lines[0].Time)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("sets the level of subsystems", func() {
+		configure(Options{Level: 3, Color: "false", Format: FormatText, SubsystemLevels: map[string]string{SubsystemEksctl: "debug"}})
+		logger.Debug("logged")
+		Expect(out.String()).To(ContainSubstring("logged"))
+
+		out.Reset()
+		configure(Options{Level: 4, Color: "false", Format: FormatText, SubsystemLevels: map[string]string{SubsystemEksctl: "1"}})
+		logger.Warning("not logged")
+		logger.Critical("logged")
+		Expect(out.String()).NotTo(ContainSubstring("not logged"))
+		Expect(out.String()).To(ContainSubstring("logged"))
+	})
+
+	It("adds the correlation ID of the task to the lines logged by the task", func() {
+		configure(Options{Level: 4, Color: "false", Format: FormatJSON})
+
+		task := StartTask()
+		task.Debug("started task %s", task.ID)
+		logger.Info("outside task")
+		task.Warning("task failed")
+
+		lines := decodeLines()
+		Expect(lines).To(HaveLen(3))
+		Expect(task.ID).To(HavePrefix("task-"))
+		Expect(StartTask().ID).NotTo(Equal(task.ID))
+		Expect(lines[0].Task).To(Equal(task.ID))
+		Expect(lines[0].Level).To(Equal("debug"))
+		Expect(lines[0].Message).To(Equal("started task " + task.ID))
+		Expect(lines[1].Task).To(BeEmpty())
+		Expect(lines[2].Task).To(Equal(task.ID))
+		Expect(lines[2].Level).To(Equal("warning"))
+	})
+
+	It("does not log the lines of tasks below the level", func() {
+		configure(Options{Level: 3, Color: "false", Format: FormatJSON})
+		StartTask().Debug("not logged")
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("rejects invalid options", func() {
+		Expect(Configure(Options{Format: "yaml"})).To(MatchError(`invalid log format "yaml", valid options: text, json`))
+		Expect(Configure(Options{Format: FormatText, SubsystemLevels: map[string]string{"iam": "debug"}})).To(MatchError(`invalid subsystem "iam", valid options: cfn, eks, eksctl, k8s`))
+		Expect(Configure(Options{Format: FormatText, SubsystemLevels: map[string]string{"cfn": "verbose"}})).To(MatchError(ContainSubstring(`invalid log level "verbose" for subsystem "cfn"`)))
+	})
+
+	table.DescribeTable("subsystem of a function", func(function, subsystem string) {
+		Expect(subsystemOf(function)).To(Equal(subsystem))
+	},
+		table.Entry("CloudFormation", "github.com/weaveworks/eksctl/pkg/cfn/manager.(*StackCollection).DoCreateStackRequest", SubsystemCFN),
+		table.Entry("EKS", "github.com/weaveworks/eksctl/pkg/eks.(*ClusterProvider).WaitForControlPlane", SubsystemEKS),
+		table.Entry("managed nodegroups", "github.com/weaveworks/eksctl/pkg/managed.(*Service).UpgradeNodeGroup.func1", SubsystemEKS),
+		table.Entry("Kubernetes", "github.com/weaveworks/eksctl/pkg/kubernetes.(*RawResource).CreateOrReplace", SubsystemK8s),
+		table.Entry("kubectl", "github.com/weaveworks/eksctl/pkg/utils/kubectl.CheckAllCommands", SubsystemK8s),
+		table.Entry("package with a subsystem prefix", "github.com/weaveworks/eksctl/pkg/eksctl.Run", SubsystemEksctl),
+		table.Entry("other package", "github.com/weaveworks/eksctl/pkg/ctl/create.doCreateCluster", SubsystemEksctl),
+		table.Entry("other module", "main.main", SubsystemEksctl),
+	)
+})
@@ -0,0 +1,290 @@
+package logging
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+const (
+	progressBarWidth = 10
+	// progressBlockWidth is the width of the block bouncing in the progress bar of running tasks
+	progressBlockWidth = 3
+	progressInterval   = 200 * time.Millisecond
+)
+
+// trackedTask is a task whose progress is rendered
+type trackedTask struct {
+	description string
+	started     time.Time
+	finished    time.Time
+	err         error
+}
+
+func (t *trackedTask) done() bool {
+	return !t.finished.IsZero()
+}
+
+// result is a noteworthy result of a command, like the endpoint of a cluster
+type result struct {
+	name  string
+	value string
+}
+
+// Progress renders the progress of the tasks of a command instead of their log lines, and a summary of the command
+// once it is done. When interactive, the running tasks are redrawn in place, otherwise a line is written when a task
+// starts and when it is done
+type Progress struct {
+	mu          sync.Mutex
+	out         io.Writer
+	interactive bool
+	colorize    bool
+	now         func() time.Time
+
+	tasks      []*trackedTask
+	results    []result
+	drawnLines int
+	frame      int
+	stop       chan struct{}
+}
+
+// progress is the renderer of the command, there is none when it is nil
+var progress *Progress
+
+// EnableProgress renders the progress of tasks to out, it returns the writer that log lines must be written to so
+// that they do not mix with the rendered tasks
+func EnableProgress(out io.Writer, interactive, colorize bool) io.Writer {
+	progress = newProgress(out, interactive, colorize, time.Now)
+	if interactive {
+		go progress.animate()
+	}
+	return progress
+}
+
+func newProgress(out io.Writer, interactive, colorize bool, now func() time.Time) *Progress {
+	return &Progress{
+		out:         out,
+		interactive: interactive,
+		colorize:    colorize,
+		now:         now,
+		stop:        make(chan struct{}),
+	}
+}
+
+// TrackTask renders the progress of a task if enabled, the returned function must be called with the error of the
+// task once it is done
+func TrackTask(description string) func(err error) {
+	if progress == nil {
+		return func(error) {}
+	}
+	return progress.track(description)
+}
+
+// AddResult adds a noteworthy result of the command to its summary, like the endpoint of a cluster or the path of
+// a kubeconfig file
+func AddResult(name, value string) {
+	if progress == nil || value == "" {
+		return
+	}
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+	progress.results = append(progress.results, result{name: name, value: value})
+}
+
+// FinishProgress stops rendering the progress of tasks, and writes the summary of the command that started at
+// startTime and failed with err, if not nil
+func FinishProgress(command string, startTime time.Time, err error) {
+	if progress == nil {
+		return
+	}
+	progress.finish(command, startTime, err)
+}
+
+func (p *Progress) track(description string) func(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	task := &trackedTask{
+		description: strings.TrimSpace(description),
+		started:     p.now(),
+	}
+	p.tasks = append(p.tasks, task)
+	if p.interactive {
+		p.redraw()
+	} else {
+		fmt.Fprintf(p.out, "%s %s\n", p.color(color.CyanString, "▶"), task.description)
+	}
+
+	return func(err error) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		task.finished = p.now()
+		task.err = err
+		if p.interactive {
+			p.redraw()
+		} else {
+			fmt.Fprintln(p.out, p.taskLine(task))
+		}
+	}
+}
+
+// Write writes log lines above the rendered tasks
+func (p *Progress) Write(data []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.clear()
+	n, err := p.out.Write(data)
+	p.draw()
+	return n, err
+}
+
+func (p *Progress) animate() {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.mu.Lock()
+			p.frame++
+			p.redraw()
+			p.mu.Unlock()
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+func (p *Progress) finish(command string, startTime time.Time, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.interactive {
+		close(p.stop)
+		p.clear()
+	}
+
+	if len(p.tasks) > 0 {
+		printer := printers.NewTablePrinter().(*printers.TablePrinter)
+		printer.AddColumn("TASK", func(t *trackedTask) string {
+			return t.description
+		})
+		printer.AddColumn("STATUS", func(t *trackedTask) string {
+			switch {
+			case !t.done():
+				return "interrupted"
+			case t.err != nil:
+				return "failed"
+			default:
+				return "completed"
+			}
+		})
+		printer.AddColumn("DURATION", func(t *trackedTask) string {
+			return p.duration(t).String()
+		})
+		table := &bytes.Buffer{}
+		if err := printer.PrintObj(p.tasks, table); err == nil {
+			fmt.Fprintf(p.out, "\n%s\n", table.String())
+		}
+	}
+
+	for _, r := range p.results {
+		fmt.Fprintf(p.out, "%s: %s\n", r.name, r.value)
+	}
+
+	duration := p.now().Sub(startTime).Round(time.Second)
+	if err != nil {
+		fmt.Fprintf(p.out, "%s %s failed after %s: %s\n", p.color(color.RedString, "✖"), command, duration, err)
+	} else {
+		fmt.Fprintf(p.out, "%s %s succeeded in %s\n", p.color(color.GreenString, "✔"), command, duration)
+	}
+	progress = nil
+}
+
+func (p *Progress) redraw() {
+	p.clear()
+	p.draw()
+}
+
+// clear erases the rendered tasks
+func (p *Progress) clear() {
+	if p.drawnLines == 0 {
+		return
+	}
+	fmt.Fprintf(p.out, "\x1b[%dA\x1b[J", p.drawnLines)
+	p.drawnLines = 0
+}
+
+// draw renders the running and failed tasks, and the number of completed tasks, so that the rendered lines fit in
+// the terminal however many tasks are done
+func (p *Progress) draw() {
+	if !p.interactive || len(p.tasks) == 0 {
+		return
+	}
+	var lines []string
+	completed := 0
+	for _, t := range p.tasks {
+		if t.done() && t.err == nil {
+			completed++
+			continue
+		}
+		lines = append(lines, p.taskLine(t))
+	}
+	if completed > 0 {
+		lines = append([]string{fmt.Sprintf("%s %d of %d tasks completed", p.color(color.GreenString, "✔"), completed, len(p.tasks))}, lines...)
+	}
+	for _, line := range lines {
+		fmt.Fprintln(p.out, line)
+	}
+	p.drawnLines = len(lines)
+}
+
+func (p *Progress) taskLine(t *trackedTask) string {
+	var icon, bar string
+	switch {
+	case !t.done():
+		icon = p.color(color.CyanString, "▶")
+		bar = p.color(color.CyanString, p.runningBar())
+	case t.err != nil:
+		icon = p.color(color.RedString, "✖")
+		bar = p.color(color.RedString, strings.Repeat("█", progressBarWidth))
+	default:
+		icon = p.color(color.GreenString, "✔")
+		bar = p.color(color.GreenString, strings.Repeat("█", progressBarWidth))
+	}
+	line := fmt.Sprintf("%s [%s] %s (%s)", icon, bar, t.description, p.duration(t))
+	if t.err != nil {
+		line += ": " + t.err.Error()
+	}
+	return line
+}
+
+// runningBar returns a progress bar with a block bouncing from one end to the other, as the progress of tasks is
+// not known
+func (p *Progress) runningBar() string {
+	positions := progressBarWidth - progressBlockWidth
+	position := p.frame % (2 * positions)
+	if position > positions {
+		position = 2*positions - position
+	}
+	return strings.Repeat(" ", position) + strings.Repeat("█", progressBlockWidth) + strings.Repeat(" ", positions-position)
+}
+
+func (p *Progress) duration(t *trackedTask) time.Duration {
+	end := t.finished
+	if !t.done() {
+		end = p.now()
+	}
+	return end.Sub(t.started).Round(time.Second)
+}
+
+func (p *Progress) color(colorize func(format string, a ...interface{}) string, s string) string {
+	if !p.colorize {
+		return s
+	}
+	return colorize("%s", s)
+}
@@ -0,0 +1,107 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Progress", func() {
+	var (
+		out       *bytes.Buffer
+		now       time.Time
+		startTime time.Time
+	)
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		startTime = time.Date(2022, 2, 14, 10, 0, 0, 0, time.UTC)
+		now = startTime
+	})
+
+	AfterEach(func() {
+		progress = nil
+	})
+
+	enable := func(interactive bool) {
+		progress = newProgress(out, interactive, false, func() time.Time { return now })
+	}
+
+	It("does nothing when disabled", func() {
+		TrackTask("create cluster control plane")(nil)
+		AddResult("Endpoint", "https://example.com")
+		FinishProgress("eksctl create cluster", startTime, nil)
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("writes a line when tasks start and are done when not interactive", func() {
+		enable(false)
+
+		done := TrackTask("create cluster control plane \"cluster-1\"\n")
+		now = now.Add(90 * time.Second)
+		done(nil)
+		TrackTask(`create managed nodegroup "ng-1"`)(errors.New("stack failed"))
+
+		Expect(strings.Split(out.String(), "\n")).To(Equal([]string{
+			`▶ create cluster control plane "cluster-1"`,
+			`✔ [██████████] create cluster control plane "cluster-1" (1m30s)`,
+			`▶ create managed nodegroup "ng-1"`,
+			`✖ [██████████] create managed nodegroup "ng-1" (0s): stack failed`,
+			"",
+		}))
+	})
+
+	It("redraws the running tasks in place when interactive", func() {
+		enable(true)
+
+		done := TrackTask("create cluster control plane")
+		Expect(out.String()).To(Equal("▶ [███       ] create cluster control plane (0s)\n"))
+
+		out.Reset()
+		progress.frame = 9
+		TrackTask(`create managed nodegroup "ng-1"`)
+		Expect(out.String()).To(Equal("\x1b[1A\x1b[J" +
+			"▶ [     ███  ] create cluster control plane (0s)\n" +
+			"▶ [     ███  ] create managed nodegroup \"ng-1\" (0s)\n"))
+
+		out.Reset()
+		done(nil)
+		Expect(out.String()).To(Equal("\x1b[2A\x1b[J" +
+			"✔ 1 of 2 tasks completed\n" +
+			"▶ [     ███  ] create managed nodegroup \"ng-1\" (0s)\n"))
+
+		out.Reset()
+		_, err := progress.Write([]byte("warning\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(Equal("\x1b[2A\x1b[J" +
+			"warning\n" +
+			"✔ 1 of 2 tasks completed\n" +
+			"▶ [     ███  ] create managed nodegroup \"ng-1\" (0s)\n"))
+	})
+
+	It("writes a summary of the tasks and the results of the command", func() {
+		enable(false)
+
+		done := TrackTask("create cluster control plane")
+		now = now.Add(10 * time.Minute)
+		done(nil)
+		TrackTask(`create managed nodegroup "ng-1"`)
+		AddResult("Endpoint", "https://example.com")
+		AddResult("Kubeconfig", "")
+		out.Reset()
+		now = now.Add(2 * time.Minute)
+		FinishProgress("eksctl create cluster", startTime, errors.New("failed to create cluster"))
+
+		Expect(out.String()).To(ContainSubstring("TASK"))
+		Expect(out.String()).To(MatchRegexp(`create cluster control plane\s+completed\s+10m0s`))
+		Expect(out.String()).To(MatchRegexp(`create managed nodegroup "ng-1"\s+interrupted\s+2m0s`))
+		Expect(out.String()).To(ContainSubstring("Endpoint: https://example.com\n"))
+		Expect(out.String()).NotTo(ContainSubstring("Kubeconfig"))
+		Expect(out.String()).To(HaveSuffix("✖ eksctl create cluster failed after 12m0s: failed to create cluster\n"))
+		Expect(progress).To(BeNil())
+	})
+})
@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kris-nova/logger"
+)
+
+// lastTaskID is the number of the last correlation ID assigned to a task
+var lastTaskID = struct {
+	sync.Mutex
+	id int
+}{}
+
+// TaskLogger logs the lines of a task, adding the correlation ID of the task to them in the JSON format
+type TaskLogger struct {
+	// ID is the correlation ID of the task
+	ID string
+}
+
+// StartTask assigns a correlation ID to a task, and returns the logger of the task
+func StartTask() *TaskLogger {
+	lastTaskID.Lock()
+	defer lastTaskID.Unlock()
+	lastTaskID.id++
+	return &TaskLogger{ID: fmt.Sprintf("task-%d", lastTaskID.id)}
+}
+
+// Debug logs a debug line of the task
+func (t *TaskLogger) Debug(format string, a ...interface{}) {
+	t.log(logger.LogDebug, logger.PreDebug, format, a...)
+}
+
+// Info logs an information line of the task
+func (t *TaskLogger) Info(format string, a ...interface{}) {
+	t.log(logger.LogInfo, logger.PreInfo, format, a...)
+}
+
+// Warning logs a warning line of the task
+func (t *TaskLogger) Warning(format string, a ...interface{}) {
+	t.log(logger.LogWarning, logger.PreWarning, format, a...)
+}
+
+func (t *TaskLogger) log(level int, prefix, format string, a ...interface{}) {
+	if logger.BitwiseLevel&level == 0 {
+		return
+	}
+	line := logger.Line(prefix, format, a...)
+	if formatter != nil {
+		line = formatter.format(prefix, t.ID, format, a...)
+	}
+	fmt.Fprint(logger.Writer, line)
+}
@@ -0,0 +1,140 @@
+// Package metrics records Prometheus metrics of the operations of eksctl, to track provisioning times and failures
+// when eksctl runs in automation.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Values of the result label
+const (
+	ResultSucceeded = "succeeded"
+	ResultFailed    = "failed"
+)
+
+// durationBuckets span from API calls of a few milliseconds to clusters taking over half an hour to create
+var durationBuckets = prometheus.ExponentialBuckets(0.01, 2.5, 15)
+
+var (
+	// Registry holds the metrics of eksctl
+	Registry = prometheus.NewRegistry()
+
+	operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "eksctl_operation_duration_seconds",
+		Help:    "Duration of eksctl operations, such as commands, applies of the API server and reconciles of the controller",
+		Buckets: durationBuckets,
+	}, []string{"operation", "result"})
+
+	operationFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "eksctl_operation_failures_total",
+		Help: "Failures of eksctl operations by reason",
+	}, []string{"operation", "reason"})
+
+	phaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "eksctl_phase_duration_seconds",
+		Help:    "Duration of the phases of eksctl operations, such as waiting for the CloudFormation stack of a nodegroup to be created",
+		Buckets: durationBuckets,
+	}, []string{"phase", "resource", "result"})
+
+	awsAPICalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "eksctl_aws_api_calls_total",
+		Help: "AWS API calls made by eksctl, by error code; the code is empty for calls that succeeded",
+	}, []string{"service", "operation", "code"})
+
+	awsAPICallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "eksctl_aws_api_call_duration_seconds",
+		Help:    "Duration of the AWS API calls made by eksctl, including retries",
+		Buckets: durationBuckets,
+	}, []string{"service", "operation"})
+)
+
+func init() {
+	Registry.MustRegister(operationDuration, operationFailures, phaseDuration, awsAPICalls, awsAPICallDuration)
+}
+
+// ObserveOperation records an operation that took duration, and failed for reason if it is not empty
+func ObserveOperation(operation string, duration time.Duration, reason string) {
+	result := ResultSucceeded
+	if reason != "" {
+		result = ResultFailed
+		operationFailures.WithLabelValues(operation, reason).Inc()
+	}
+	operationDuration.WithLabelValues(operation, result).Observe(duration.Seconds())
+}
+
+// ObservePhase records a phase of an operation on a kind of resource that took duration and failed with err, if not nil
+func ObservePhase(phase, resource string, duration time.Duration, err error) {
+	phaseDuration.WithLabelValues(phase, resource, resultOf(err)).Observe(duration.Seconds())
+}
+
+// AWSRequestHandler records the AWS API calls of the sessions it is added to, as a Complete handler
+var AWSRequestHandler = request.NamedHandler{
+	Name: "eksctlMetrics",
+	Fn: func(r *request.Request) {
+		operation := ""
+		if r.Operation != nil {
+			operation = r.Operation.Name
+		}
+		code := ""
+		if r.Error != nil {
+			code = "Unknown"
+			if awsErr, ok := r.Error.(awserr.Error); ok {
+				code = awsErr.Code()
+			}
+		}
+		observeAWSAPICall(r.ClientInfo.ServiceName, operation, code, time.Since(r.Time))
+	},
+}
+
+// AddAWSV2Middleware records the AWS API calls of the clients of the AWS SDK v2 it is added to, as an API option
+func AddAWSV2Middleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("eksctlMetrics", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		code := ""
+		if err != nil {
+			code = "Unknown"
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) {
+				code = apiErr.ErrorCode()
+			}
+		}
+		// the signing name matches the service name of the clients of the AWS SDK v1, e.g. sts
+		observeAWSAPICall(awsmiddleware.GetSigningName(ctx), awsmiddleware.GetOperationName(ctx), code, time.Since(start))
+		return out, metadata, err
+	}), middleware.After)
+}
+
+func observeAWSAPICall(service, operation, code string, duration time.Duration) {
+	awsAPICalls.WithLabelValues(service, operation, code).Inc()
+	awsAPICallDuration.WithLabelValues(service, operation).Observe(duration.Seconds())
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// WriteFile writes the metrics to a file in the Prometheus text format, e.g. for the textfile collector of the node
+// exporter
+func WriteFile(path string) error {
+	return prometheus.WriteToTextfile(path, Registry)
+}
+
+func resultOf(err error) string {
+	if err != nil {
+		return ResultFailed
+	}
+	return ResultSucceeded
+}
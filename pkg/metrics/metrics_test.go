@@ -0,0 +1,120 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/metrics"
+)
+
+var _ = Describe("Metrics", func() {
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		metrics.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+		Expect(recorder.Code).To(Equal(200))
+		return recorder.Body.String()
+	}
+
+	It("records operations and their failures", func() {
+		metrics.ObserveOperation("eksctl create cluster", 20*time.Minute, "")
+		metrics.ObserveOperation("eksctl create nodegroup", time.Minute, "aws")
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`eksctl_operation_duration_seconds_count{operation="eksctl create cluster",result="succeeded"} 1`))
+		Expect(body).To(ContainSubstring(`eksctl_operation_duration_seconds_count{operation="eksctl create nodegroup",result="failed"} 1`))
+		Expect(body).To(ContainSubstring(`eksctl_operation_failures_total{operation="eksctl create nodegroup",reason="aws"} 1`))
+		Expect(body).NotTo(ContainSubstring(`eksctl_operation_failures_total{operation="eksctl create cluster"`))
+	})
+
+	It("records phases", func() {
+		metrics.ObservePhase("create-stack", "nodegroup", 3*time.Minute, nil)
+		metrics.ObservePhase("delete-stack", "cluster", 10*time.Minute, errors.New("timed out"))
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`eksctl_phase_duration_seconds_count{phase="create-stack",resource="nodegroup",result="succeeded"} 1`))
+		Expect(body).To(ContainSubstring(`eksctl_phase_duration_seconds_count{phase="delete-stack",resource="cluster",result="failed"} 1`))
+	})
+
+	It("records AWS API calls", func() {
+		newRequest := func(err error) *request.Request {
+			return &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceName: "cloudformation"},
+				Operation:  &request.Operation{Name: "DescribeStacks"},
+				Time:       time.Now().Add(-time.Second),
+				Error:      err,
+			}
+		}
+		metrics.AWSRequestHandler.Fn(newRequest(nil))
+		metrics.AWSRequestHandler.Fn(newRequest(nil))
+		metrics.AWSRequestHandler.Fn(newRequest(awserr.New("Throttling", "Rate exceeded", nil)))
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`eksctl_aws_api_calls_total{code="",operation="DescribeStacks",service="cloudformation"} 2`))
+		Expect(body).To(ContainSubstring(`eksctl_aws_api_calls_total{code="Throttling",operation="DescribeStacks",service="cloudformation"} 1`))
+		Expect(body).To(ContainSubstring(`eksctl_aws_api_call_duration_seconds_count{operation="DescribeStacks",service="cloudformation"} 3`))
+	})
+
+	It("records AWS API calls of the clients of the AWS SDK v2", func() {
+		responses := []*http.Response{
+			{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`<GetCallerIdentityResponse><GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult></GetCallerIdentityResponse>`))},
+			{StatusCode: 403, Body: io.NopCloser(strings.NewReader(`<ErrorResponse><Error><Type>Sender</Type><Code>ExpiredToken</Code><Message>The security token included in the request is expired</Message></Error></ErrorResponse>`))},
+		}
+		client := sts.New(sts.Options{
+			Region:      "us-west-2",
+			Credentials: aws.AnonymousCredentials{},
+			HTTPClient: httpClientFunc(func(*http.Request) (*http.Response, error) {
+				response := responses[0]
+				responses = responses[1:]
+				response.Header = http.Header{}
+				return response, nil
+			}),
+			APIOptions: []func(*middleware.Stack) error{metrics.AddAWSV2Middleware},
+		})
+
+		_, err := client.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+		Expect(err).To(HaveOccurred())
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`eksctl_aws_api_calls_total{code="",operation="GetCallerIdentity",service="sts"} 1`))
+		Expect(body).To(ContainSubstring(`eksctl_aws_api_calls_total{code="ExpiredToken",operation="GetCallerIdentity",service="sts"} 1`))
+		Expect(body).To(ContainSubstring(`eksctl_aws_api_call_duration_seconds_count{operation="GetCallerIdentity",service="sts"} 2`))
+	})
+
+	It("writes the metrics to a file", func() {
+		metrics.ObserveOperation("eksctl delete cluster", time.Minute, "")
+
+		dir, err := os.MkdirTemp("", "metrics")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "eksctl.prom")
+		Expect(metrics.WriteFile(path)).To(Succeed())
+
+		data, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`eksctl_operation_duration_seconds_count{operation="eksctl delete cluster",result="succeeded"} 1`))
+	})
+})
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
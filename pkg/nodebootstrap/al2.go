@@ -30,6 +30,15 @@ func (b *AmazonLinux2) UserData() (string, error) {
 		scripts = append(scripts, script{name: "efa.al2.sh", contents: assets.EfaAl2Sh})
 	}
 
+	if api.IsEnabled(b.ng.IPv6Only) {
+		scripts = append(scripts, script{name: "ipv6-only.al2.sh", contents: assets.IPv6OnlyAl2Sh})
+	}
+
+	// nodegroups not using containerd are rejected by the validation of pullThroughCache
+	if b.clusterConfig.PullThroughCache != nil && b.ng.GetContainerRuntime() == api.ContainerRuntimeContainerD {
+		scripts = append(scripts, script{name: "pull-through-cache.al2.sh", contents: assets.PullThroughCacheAl2Sh})
+	}
+
 	body, err := linuxConfig(b.clusterConfig, al2BootScript, assets.BootstrapAl2Sh, b.ng, scripts...)
 	if err != nil {
 		return "", errors.Wrap(err, "encoding user data")
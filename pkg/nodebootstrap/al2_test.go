@@ -67,6 +67,69 @@ var _ = Describe("AmazonLinux2 User Data", func() {
 		})
 	})
 
+	When("the cluster has a pull-through cache", func() {
+		BeforeEach(func() {
+			clusterConfig.Metadata.Region = "us-west-2"
+			clusterConfig.PullThroughCache = &api.PullThroughCache{
+				Rules: []api.PullThroughCacheRule{
+					{UpstreamRegistryURL: "quay.io"},
+					{UpstreamRegistryURL: "ghcr.io", ECRRepositoryPrefix: "github"},
+				},
+			}
+		})
+
+		It("adds the pull-through cache script for containerd nodes", func() {
+			ng.ContainerRuntime = aws.String(api.ContainerRuntimeContainerD)
+			userData, err := newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/var/lib/cloud/scripts/eksctl/pull-through-cache.al2.sh"))
+			Expect(cloudCfg.WriteFiles[1].Content).To(ContainSubstring("AWS_REGION=us-west-2"))
+			Expect(cloudCfg.WriteFiles[1].Content).To(ContainSubstring("PULL_THROUGH_CACHE_RULES=quay.io=quay,ghcr.io=github"))
+		})
+
+		It("does not add the pull-through cache script for dockerd nodes", func() {
+			ng.ContainerRuntime = aws.String(api.ContainerRuntimeDockerD)
+			userData, err := newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			for _, f := range cloudCfg.WriteFiles {
+				Expect(f.Path).NotTo(HaveSuffix("pull-through-cache.al2.sh"))
+			}
+		})
+	})
+
+	When("the nodegroup is in IPv6-only subnets", func() {
+		BeforeEach(func() {
+			ng.Name = "ipv6-only"
+			ng.IPv6Only = api.Enabled()
+			clusterConfig.Status.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+				IPFamily:        "ipv6",
+				ServiceIPv6CIDR: "fd30:1c53:5f8a::/108",
+			}
+		})
+
+		It("bootstraps the node for IPv6 and configures dualstack endpoints", func() {
+			userData, err := newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[1].Content).To(ContainSubstring("IP_FAMILY=ipv6"))
+			Expect(cloudCfg.WriteFiles[1].Content).To(ContainSubstring("SERVICE_IPV6_CIDR=fd30:1c53:5f8a::/108"))
+			Expect(cloudCfg.WriteFiles[1].Content).To(ContainSubstring("CLUSTER_DNS=fd30:1c53:5f8a::a"))
+			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/var/lib/cloud/scripts/eksctl/ipv6-only.al2.sh"))
+			Expect(cloudCfg.WriteFiles[2].Content).To(ContainSubstring("AWS_USE_DUALSTACK_ENDPOINT=true"))
+		})
+
+		It("cannot bootstrap custom AMIs", func() {
+			ng.CustomAMI = true
+			_, err := nodebootstrap.NewBootstrapper(clusterConfig, ng)
+			Expect(err).To(MatchError(`nodegroup "ipv6-only" uses a custom AMI, which cannot be bootstrapped in IPv6-only subnets`))
+		})
+	})
+
 	type bootScriptEntry struct {
 		clusterConfig    *api.ClusterConfig
 		ng               *api.NodeGroup
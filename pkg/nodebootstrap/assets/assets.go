@@ -33,6 +33,14 @@ var EfaManagedBoothook string
 //go:embed scripts/install-ssm.al2.sh
 var InstallSsmAl2Sh string
 
+//IPv6OnlyAl2Sh holds the ipv6-only.al2.sh contents
+//go:embed scripts/ipv6-only.al2.sh
+var IPv6OnlyAl2Sh string
+
 //KubeletYaml holds the kubelet.yaml contents
 //go:embed scripts/kubelet.yaml
 var KubeletYaml string
+
+//PullThroughCacheAl2Sh holds the pull-through-cache.al2.sh contents
+//go:embed scripts/pull-through-cache.al2.sh
+var PullThroughCacheAl2Sh string
@@ -0,0 +1,20 @@
+#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+# Nodes in IPv6-only subnets can only reach the AWS services through their dualstack endpoints,
+# the AWS CLI, the SDKs and the kubelet ECR credential provider are configured to use them
+echo "eksctl: configuring the AWS CLI and SDKs to use dualstack endpoints"
+echo "AWS_USE_DUALSTACK_ENDPOINT=true" >> /etc/environment
+aws configure set default.use_dualstack_endpoint true
+aws configure set default.s3.use_dualstack_endpoint true
+
+for service in kubelet containerd; do
+  mkdir -p "/etc/systemd/system/${service}.service.d"
+  cat > "/etc/systemd/system/${service}.service.d/10-eksctl-dualstack.conf" <<CONF
+[Service]
+Environment=AWS_USE_DUALSTACK_ENDPOINT=true
+CONF
+done
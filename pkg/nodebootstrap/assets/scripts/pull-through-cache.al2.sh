@@ -0,0 +1,83 @@
+#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+source /etc/eksctl/kubelet.env # file written by bootstrapper
+
+CONTAINERD_CONFIG='/etc/eks/containerd/containerd-config.toml'
+CERTS_DIR='/etc/containerd/certs.d'
+REFRESH_SCRIPT='/usr/local/bin/eksctl-pull-through-cache'
+REGISTRY_TABLE='[plugins."io.containerd.grpc.v1.cri".registry]'
+
+echo "eksctl: enabling registry host configuration in ${CONTAINERD_CONFIG}"
+if grep -q '^config_path' "${CONTAINERD_CONFIG}"; then
+  grep -q "^config_path = \"${CERTS_DIR}" "${CONTAINERD_CONFIG}" ||
+    echo "eksctl: warning: config_path of ${CONTAINERD_CONFIG} does not start with ${CERTS_DIR}, images will not be pulled through the cache"
+elif grep -qF "${REGISTRY_TABLE}" "${CONTAINERD_CONFIG}"; then
+  sed -i "/^\[plugins.\"io.containerd.grpc.v1.cri\".registry\]$/a config_path = \"${CERTS_DIR}\"" "${CONTAINERD_CONFIG}"
+else
+  printf '\n%s\nconfig_path = "%s"\n' "${REGISTRY_TABLE}" "${CERTS_DIR}" >> "${CONTAINERD_CONFIG}"
+fi
+
+# ECR authorization tokens expire after 12 hours, the hosts of the upstream registries
+# are rewritten with a new token by a timer
+cat > "${REFRESH_SCRIPT}" <<'EOF'
+#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+source /etc/eksctl/kubelet.env
+
+read -r TOKEN ENDPOINT < <(aws ecr get-authorization-token --region "${AWS_REGION}" --output text \
+  --query 'authorizationData[0].[authorizationToken,proxyEndpoint]')
+
+IFS=',' read -ra RULES <<< "${PULL_THROUGH_CACHE_RULES}"
+for rule in "${RULES[@]}"; do
+  upstream="${rule%%=*}"
+  prefix="${rule#*=}"
+  mkdir -p "/etc/containerd/certs.d/${upstream}"
+  hosts="/etc/containerd/certs.d/${upstream}/hosts.toml"
+  cat > "${hosts}.tmp" <<HOSTS
+server = "https://${upstream}"
+
+[host."${ENDPOINT}/v2/${prefix}"]
+  capabilities = ["pull", "resolve"]
+  override_path = true
+  [host."${ENDPOINT}/v2/${prefix}".header]
+    authorization = "Basic ${TOKEN}"
+HOSTS
+  chmod 0600 "${hosts}.tmp"
+  mv "${hosts}.tmp" "${hosts}"
+done
+EOF
+chmod 0755 "${REFRESH_SCRIPT}"
+
+cat > /etc/systemd/system/eksctl-pull-through-cache.service <<EOF
+[Unit]
+Description=Refresh the ECR credentials of the pull-through cache
+
+[Service]
+Type=oneshot
+ExecStart=${REFRESH_SCRIPT}
+EOF
+
+cat > /etc/systemd/system/eksctl-pull-through-cache.timer <<EOF
+[Unit]
+Description=Refresh the ECR credentials of the pull-through cache every 6 hours
+
+[Timer]
+OnBootSec=1min
+OnUnitActiveSec=6h
+
+[Install]
+WantedBy=timers.target
+EOF
+
+echo "eksctl: configuring containerd to pull ${PULL_THROUGH_CACHE_RULES} through ECR"
+"${REFRESH_SCRIPT}"
+systemctl daemon-reload
+systemctl enable --now eksctl-pull-through-cache.timer
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap/assets"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap/utils"
 )
 
 // ManagedAL2 is a bootstrapper for managed Amazon Linux 2 nodegroups
@@ -48,8 +50,13 @@ func (m *ManagedAL2) UserData() (string, error) {
 
 	if ng.OverrideBootstrapCommand != nil {
 		scripts = append(scripts, *ng.OverrideBootstrapCommand)
-	} else if ng.MaxPodsPerNode != 0 {
-		scripts = append(scripts, makeMaxPodsScript(ng.MaxPodsPerNode))
+	} else {
+		if ng.MaxPodsPerNode != 0 {
+			scripts = append(scripts, makeMaxPodsScript(ng.MaxPodsPerNode))
+		}
+		if script := makeKubeletLabelsAndTaintsScript(ng); script != "" {
+			scripts = append(scripts, script)
+		}
 	}
 
 	if api.IsEnabled(ng.EFAEnabled) {
@@ -102,6 +109,33 @@ KUBELET_CONFIG=/etc/kubernetes/kubelet/kubelet-config.json
 	return script
 }
 
+// makeKubeletLabelsAndTaintsScript passes the labels and taints rejected by the EKS API to kubelet.
+// As kubelet only honours the last --register-with-taints flag, all taints are passed when any need to be
+func makeKubeletLabelsAndTaintsScript(ng *api.ManagedNodeGroup) string {
+	_, kubeletLabels := ng.SplitLabelsForEKS()
+	_, kubeletTaints := ng.SplitTaintsForEKS()
+
+	var args []string
+	if len(kubeletLabels) > 0 {
+		var labels []string
+		for k, v := range kubeletLabels {
+			labels = append(labels, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(labels)
+		args = append(args, "--node-labels="+strings.Join(labels, ","))
+	}
+	if len(kubeletTaints) > 0 {
+		args = append(args, "--register-with-taints="+utils.FormatTaints(ng.Taints))
+	}
+	if len(args) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(`#!/bin/sh
+set -ex
+sed -i '/^KUBELET_EXTRA_ARGS=/a KUBELET_EXTRA_ARGS+=" %s"' /etc/eks/bootstrap.sh`, strings.Join(args, " "))
+}
+
 func createMimeMessage(writer io.Writer, scripts, cloudboots []string, mimeBoundary string) error {
 	mw := multipart.NewWriter(writer)
 	if mimeBoundary != "" {
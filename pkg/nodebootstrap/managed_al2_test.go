@@ -148,6 +148,42 @@ pop /tmp/aws-efa-installer
 
 cloud-init-per once efa_info /opt/amazon/efa/bin/fi_info -p efa
 
+--//--
+`,
+	}),
+	Entry("labels and taints rejected by EKS", managedEntry{
+		ng: &api.ManagedNodeGroup{
+			NodeGroupBase: &api.NodeGroupBase{
+				Name: "ng",
+				Labels: map[string]string{
+					"node.kubernetes.io/worker":    "",
+					"node.kubernetes.io/lifecycle": "normal",
+					"role":                         "worker",
+				},
+			},
+			Taints: []api.NodeGroupTaint{
+				{
+					Key:    "dedicated",
+					Value:  "worker",
+					Effect: "NoSchedule",
+				},
+				{
+					Key:    "node.kubernetes.io/special",
+					Value:  "true",
+					Effect: "NoExecute",
+				},
+			},
+		},
+		expectedUserData: `MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary=//
+
+--//
+Content-Type: text/x-shellscript
+Content-Type: charset="us-ascii"
+
+#!/bin/sh
+set -ex
+sed -i '/^KUBELET_EXTRA_ARGS=/a KUBELET_EXTRA_ARGS+=" --node-labels=node.kubernetes.io/lifecycle=normal,node.kubernetes.io/worker= --register-with-taints=dedicated=worker:NoSchedule,node.kubernetes.io/special=true:NoExecute"' /etc/eks/bootstrap.sh
 --//--
 `,
 	}),
@@ -134,6 +134,31 @@ NODE_TAINTS=`, "\n")))
 		})
 	})
 
+	When("labels and taints rejected by EKS are set on a managed nodegroup", func() {
+		It("passes them to kubelet in the env file", func() {
+			mng := &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					AMIFamily: "Ubuntu2004",
+					Labels:    map[string]string{"node.kubernetes.io/lifecycle": "normal"},
+				},
+				Taints: []api.NodeGroupTaint{
+					{
+						Key:    "node.kubernetes.io/special",
+						Effect: "NoSchedule",
+					},
+				},
+			}
+			userData, err := nodebootstrap.NewManagedBootstrapper(clusterConfig, mng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			file := cloudCfg.WriteFiles[1]
+			Expect(file.Path).To(Equal("/etc/eksctl/kubelet.env"))
+			Expect(file.Content).To(ContainSubstring("NODE_LABELS=node.kubernetes.io/lifecycle=normal"))
+			Expect(file.Content).To(ContainSubstring("NODE_TAINTS=node.kubernetes.io/special=:NoSchedule"))
+		})
+	})
+
 	When("clusterDNS is set on the node config", func() {
 		BeforeEach(func() {
 			ng.ClusterDNS = "1.2.3.4"
@@ -61,6 +61,9 @@ func NewBootstrapper(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) (Boots
 	case api.NodeImageFamilyAmazonLinux2:
 		// TODO remove
 		if ng.CustomAMI {
+			if api.IsEnabled(ng.IPv6Only) {
+				return nil, errors.Errorf("nodegroup %q uses a custom AMI, which cannot be bootstrapped in IPv6-only subnets", ng.Name)
+			}
 			logger.Warning("Custom AMI detected for nodegroup %s, using legacy nodebootstrap mechanism. Please refer to https://github.com/weaveworks/eksctl/issues/3563 for upcoming breaking changes", ng.Name)
 			return legacy.NewAL2Bootstrapper(clusterConfig, ng), nil
 		}
@@ -91,6 +94,15 @@ func GetClusterDNS(clusterConfig *api.ClusterConfig) (string, error) {
 		return "", nil
 	}
 
+	if networkConfig.ServiceIPv6CIDR != "" {
+		ip, _, err := net.ParseCIDR(networkConfig.ServiceIPv6CIDR)
+		if err != nil {
+			return "", errors.Wrapf(err, "unexpected error parsing kubernetesNetworkConfig.serviceIPv6CIDR: %q", networkConfig.ServiceIPv6CIDR)
+		}
+		ip[net.IPv6len-1] = 10
+		return ip.String(), nil
+	}
+
 	ip, _, err := net.ParseCIDR(networkConfig.ServiceIPv4CIDR)
 	if err != nil {
 		return "", errors.Wrapf(err, "unexpected error parsing kubernetesNetworkConfig.serviceIPv4CIDR: %q", networkConfig.ServiceIPv4CIDR)
@@ -182,6 +194,16 @@ func makeBootstrapEnv(clusterConfig *api.ClusterConfig, np api.NodePool) cloudco
 
 	if unmanaged, ok := np.(*api.NodeGroup); ok && ng.AMIFamily == api.NodeImageFamilyAmazonLinux2 {
 		variables["CONTAINER_RUNTIME"] = unmanaged.GetContainerRuntime()
+		if api.IsEnabled(unmanaged.IPv6Only) {
+			variables["IP_FAMILY"] = "ipv6"
+			if networkConfig := clusterConfig.Status.KubernetesNetworkConfig; networkConfig != nil {
+				variables["SERVICE_IPV6_CIDR"] = networkConfig.ServiceIPv6CIDR
+			}
+		}
+		if clusterConfig.PullThroughCache != nil {
+			variables["AWS_REGION"] = clusterConfig.Metadata.Region
+			variables["PULL_THROUGH_CACHE_RULES"] = formatPullThroughCacheRules(clusterConfig.PullThroughCache)
+		}
 	}
 
 	return cloudconfig.File{
@@ -190,6 +212,19 @@ func makeBootstrapEnv(clusterConfig *api.ClusterConfig, np api.NodePool) cloudco
 	}
 }
 
+// formatPullThroughCacheRules formats the rules as comma-separated <upstream registry>=<repository prefix> pairs
+func formatPullThroughCacheRules(cache *api.PullThroughCache) string {
+	var rules []string
+	for _, rule := range cache.Rules {
+		prefix := rule.ECRRepositoryPrefix
+		if prefix == "" {
+			prefix = api.DefaultECRRepositoryPrefix(rule.UpstreamRegistryURL)
+		}
+		rules = append(rules, rule.UpstreamRegistryURL+"="+prefix)
+	}
+	return strings.Join(rules, ",")
+}
+
 func makeKeyValues(kv map[string]string, separator string) string {
 	var params []string
 	for k, v := range kv {
@@ -0,0 +1,182 @@
+package nodeprovisioner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+// BinaryPrefix is the prefix of the name of a node provisioner plugin binary,
+// e.g. the plugin `ocean` is run as `eksctl-provisioner-ocean`.
+const BinaryPrefix = "eksctl-provisioner-"
+
+// Action is an action a node provisioner plugin is asked to perform.
+type Action string
+
+// Actions supported by node provisioner plugins.
+const (
+	ActionCreate Action = "create"
+	ActionDelete Action = "delete"
+	ActionScale  Action = "scale"
+)
+
+// Request is passed to the plugin as a JSON file.
+type Request struct {
+	Cluster   Cluster   `json:"cluster"`
+	NodeGroup NodeGroup `json:"nodeGroup"`
+}
+
+// Cluster describes the cluster the nodes join.
+type Cluster struct {
+	Name                     string `json:"name"`
+	Region                   string `json:"region"`
+	Version                  string `json:"version,omitempty"`
+	Endpoint                 string `json:"endpoint,omitempty"`
+	CertificateAuthorityData []byte `json:"certificateAuthorityData,omitempty"`
+}
+
+// NodeGroup describes the nodegroup and the resources eksctl created for it.
+type NodeGroup struct {
+	Name      string `json:"name"`
+	StackName string `json:"stackName"`
+	// Config is only set when the nodegroup is created
+	Config *api.NodeGroup `json:"config,omitempty"`
+
+	MinSize         *int `json:"minSize,omitempty"`
+	MaxSize         *int `json:"maxSize,omitempty"`
+	DesiredCapacity *int `json:"desiredCapacity,omitempty"`
+
+	InstanceRoleARN       string   `json:"instanceRoleARN,omitempty"`
+	InstanceProfileARN    string   `json:"instanceProfileARN,omitempty"`
+	LaunchTemplateID      string   `json:"launchTemplateID,omitempty"`
+	LaunchTemplateVersion string   `json:"launchTemplateVersion,omitempty"`
+	SubnetIDs             []string `json:"subnetIDs,omitempty"`
+}
+
+// NewRequest creates a request for the nodegroup stack, populated from the cluster config and the stack outputs.
+func NewRequest(spec *api.ClusterConfig, nodeGroupName string, stack *cfn.Stack) (*Request, error) {
+	req := &Request{
+		Cluster: Cluster{
+			Name:    spec.Metadata.Name,
+			Region:  spec.Metadata.Region,
+			Version: spec.Metadata.Version,
+		},
+		NodeGroup: NodeGroup{
+			Name:      nodeGroupName,
+			StackName: *stack.StackName,
+		},
+	}
+	if spec.Status != nil {
+		req.Cluster.Endpoint = spec.Status.Endpoint
+		req.Cluster.CertificateAuthorityData = spec.Status.CertificateAuthorityData
+	}
+
+	ng := &req.NodeGroup
+	collectors := map[string]outputs.Collector{
+		outputs.NodeGroupLaunchTemplateID: func(v string) error {
+			ng.LaunchTemplateID = v
+			return nil
+		},
+		outputs.NodeGroupLaunchTemplateVersion: func(v string) error {
+			ng.LaunchTemplateVersion = v
+			return nil
+		},
+		outputs.NodeGroupSubnetIDs: func(v string) error {
+			ng.SubnetIDs = strings.Split(v, ",")
+			return nil
+		},
+	}
+	optionalCollectors := map[string]outputs.Collector{
+		outputs.NodeGroupInstanceRoleARN: func(v string) error {
+			ng.InstanceRoleARN = v
+			return nil
+		},
+		outputs.NodeGroupInstanceProfileARN: func(v string) error {
+			ng.InstanceProfileARN = v
+			return nil
+		},
+	}
+	if err := outputs.Collect(*stack, collectors, optionalCollectors); err != nil {
+		return nil, fmt.Errorf("collecting outputs of stack %q: %w", *stack.StackName, err)
+	}
+	return req, nil
+}
+
+// ProvisionerName returns the name of the plugin that provisions the nodes of the stack, if any.
+func ProvisionerName(stack *cfn.Stack) string {
+	for _, tag := range stack.Tags {
+		if *tag.Key == api.NodeProvisionerTag {
+			return *tag.Value
+		}
+	}
+	return ""
+}
+
+// Plugin runs a node provisioner plugin binary.
+type Plugin struct {
+	Name        string
+	LookPath    func(file string) (string, error)
+	NewExecutor func(envVars executor.EnvVars) executor.Executor
+}
+
+// New creates a Plugin for the provisioner name.
+func New(name string) *Plugin {
+	return &Plugin{
+		Name:        name,
+		LookPath:    exec.LookPath,
+		NewExecutor: executor.NewShellExecutor,
+	}
+}
+
+// BinaryName returns the name of the plugin binary.
+func (p *Plugin) BinaryName() string {
+	return BinaryPrefix + p.Name
+}
+
+// Run runs the plugin with the action, passing it the request as a JSON file.
+func (p *Plugin) Run(action Action, req *Request) error {
+	binary := p.BinaryName()
+	if _, err := p.LookPath(binary); errors.Is(err, exec.ErrNotFound) {
+		return fmt.Errorf("%q plugin was not found on your path", binary)
+	} else if err != nil {
+		return fmt.Errorf("failed to lookup node provisioner plugin: %w", err)
+	}
+
+	requestFile, err := os.CreateTemp("", binary)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(requestFile.Name()); err != nil {
+			logger.Critical("failed to remove temporary file %s", requestFile.Name())
+		}
+	}()
+	if err := json.NewEncoder(requestFile).Encode(req); err != nil {
+		return fmt.Errorf("writing node provisioner request: %w", err)
+	}
+	if err := requestFile.Close(); err != nil {
+		return err
+	}
+
+	shell := p.NewExecutor(executor.EnvVars{
+		"EKSCTL_VERSION":        version.GetVersion(),
+		"EKSCTL_CLUSTER_NAME":   req.Cluster.Name,
+		"EKSCTL_NODEGROUP_NAME": req.NodeGroup.Name,
+	})
+	logger.Info("running %s %s for nodegroup %q", binary, action, req.NodeGroup.Name)
+	if err := shell.Exec(binary, string(action), requestFile.Name()); err != nil {
+		return fmt.Errorf("%s %s failed for nodegroup %q: %w", binary, action, req.NodeGroup.Name, err)
+	}
+	return nil
+}
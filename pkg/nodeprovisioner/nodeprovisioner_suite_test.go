@@ -0,0 +1,13 @@
+package nodeprovisioner_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestNodeProvisioner(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Node Provisioner Suite")
+}
@@ -0,0 +1,147 @@
+package nodeprovisioner_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/executor/fakes"
+	"github.com/weaveworks/eksctl/pkg/nodeprovisioner"
+)
+
+var _ = Describe("Node provisioner plugins", func() {
+	var (
+		cfg   *api.ClusterConfig
+		stack *cfn.Stack
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Version = "1.21"
+		cfg.Status = &api.ClusterStatus{Endpoint: "https://example.com", CertificateAuthorityData: []byte("ca")}
+
+		stack = &cfn.Stack{
+			StackName: aws.String("eksctl-cluster-1-nodegroup-ng-1"),
+			Tags: []*cfn.Tag{
+				{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+				{Key: aws.String(api.NodeProvisionerTag), Value: aws.String("ocean")},
+			},
+			Outputs: []*cfn.Output{
+				{OutputKey: aws.String("InstanceRoleARN"), OutputValue: aws.String("arn:aws:iam::123456789012:role/node")},
+				{OutputKey: aws.String("InstanceProfileARN"), OutputValue: aws.String("arn:aws:iam::123456789012:instance-profile/node")},
+				{OutputKey: aws.String("LaunchTemplateID"), OutputValue: aws.String("lt-1234")},
+				{OutputKey: aws.String("LaunchTemplateVersion"), OutputValue: aws.String("1")},
+				{OutputKey: aws.String("SubnetIDs"), OutputValue: aws.String("subnet-1,subnet-2")},
+			},
+		}
+	})
+
+	It("reads the provisioner name from the stack tags", func() {
+		Expect(nodeprovisioner.ProvisionerName(stack)).To(Equal("ocean"))
+		stack.Tags = stack.Tags[:1]
+		Expect(nodeprovisioner.ProvisionerName(stack)).To(BeEmpty())
+	})
+
+	It("creates a request from the cluster config and the stack outputs", func() {
+		req, err := nodeprovisioner.NewRequest(cfg, "ng-1", stack)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*req).To(Equal(nodeprovisioner.Request{
+			Cluster: nodeprovisioner.Cluster{
+				Name:                     "cluster-1",
+				Region:                   "us-west-2",
+				Version:                  "1.21",
+				Endpoint:                 "https://example.com",
+				CertificateAuthorityData: []byte("ca"),
+			},
+			NodeGroup: nodeprovisioner.NodeGroup{
+				Name:                  "ng-1",
+				StackName:             "eksctl-cluster-1-nodegroup-ng-1",
+				InstanceRoleARN:       "arn:aws:iam::123456789012:role/node",
+				InstanceProfileARN:    "arn:aws:iam::123456789012:instance-profile/node",
+				LaunchTemplateID:      "lt-1234",
+				LaunchTemplateVersion: "1",
+				SubnetIDs:             []string{"subnet-1", "subnet-2"},
+			},
+		}))
+	})
+
+	It("fails to create a request when the stack has no launch template outputs", func() {
+		stack.Outputs = stack.Outputs[:2]
+		_, err := nodeprovisioner.NewRequest(cfg, "ng-1", stack)
+		Expect(err).To(MatchError(ContainSubstring(`collecting outputs of stack "eksctl-cluster-1-nodegroup-ng-1"`)))
+	})
+
+	Context("running the plugin", func() {
+		var (
+			fakeExecutor *fakes.FakeExecutor
+			envVars      executor.EnvVars
+			plugin       *nodeprovisioner.Plugin
+			req          *nodeprovisioner.Request
+		)
+
+		BeforeEach(func() {
+			fakeExecutor = &fakes.FakeExecutor{}
+			plugin = &nodeprovisioner.Plugin{
+				Name: "ocean",
+				LookPath: func(file string) (string, error) {
+					return "/usr/local/bin/" + file, nil
+				},
+				NewExecutor: func(e executor.EnvVars) executor.Executor {
+					envVars = e
+					return fakeExecutor
+				},
+			}
+			var err error
+			req, err = nodeprovisioner.NewRequest(cfg, "ng-1", stack)
+			Expect(err).NotTo(HaveOccurred())
+			req.NodeGroup.DesiredCapacity = aws.Int(3)
+		})
+
+		It("runs the plugin binary with the action and the request file", func() {
+			var written nodeprovisioner.Request
+			fakeExecutor.ExecStub = func(_ string, args ...string) error {
+				data, err := os.ReadFile(args[1])
+				Expect(err).NotTo(HaveOccurred())
+				return json.Unmarshal(data, &written)
+			}
+
+			Expect(plugin.Run(nodeprovisioner.ActionScale, req)).To(Succeed())
+			Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+			command, args := fakeExecutor.ExecArgsForCall(0)
+			Expect(command).To(Equal("eksctl-provisioner-ocean"))
+			Expect(args[0]).To(Equal("scale"))
+			Expect(written).To(Equal(*req))
+			Expect(envVars).To(HaveKeyWithValue("EKSCTL_CLUSTER_NAME", "cluster-1"))
+			Expect(envVars).To(HaveKeyWithValue("EKSCTL_NODEGROUP_NAME", "ng-1"))
+			Expect(envVars).To(HaveKey("EKSCTL_VERSION"))
+
+			_, err := os.Stat(args[1])
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("returns an error when the plugin fails", func() {
+			fakeExecutor.ExecReturns(errors.New("exit status 1"))
+			err := plugin.Run(nodeprovisioner.ActionCreate, req)
+			Expect(err).To(MatchError(`eksctl-provisioner-ocean create failed for nodegroup "ng-1": exit status 1`))
+		})
+
+		It("returns an error when the plugin is not on the path", func() {
+			plugin.LookPath = func(string) (string, error) {
+				return "", exec.ErrNotFound
+			}
+			err := plugin.Run(nodeprovisioner.ActionDelete, req)
+			Expect(err).To(MatchError(`"eksctl-provisioner-ocean" plugin was not found on your path`))
+			Expect(fakeExecutor.ExecCallCount()).To(BeZero())
+		})
+	})
+})
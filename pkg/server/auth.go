@@ -0,0 +1,118 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/executor"
+)
+
+// AuthTokenEnvVar is the environment variable holding the bearer token passed to authentication commands
+const AuthTokenEnvVar = "EKSCTL_AUTH_TOKEN"
+
+// Authenticator authenticates the requests to the API.
+type Authenticator interface {
+	// Authenticate returns the name of the user making the request, or an error if it cannot be authenticated
+	Authenticate(r *http.Request) (string, error)
+}
+
+// bearerToken returns the bearer token of the Authorization header of the request
+func bearerToken(r *http.Request) (string, error) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return "", errors.New("no bearer token in the Authorization header")
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+	if token == "" {
+		return "", errors.New("empty bearer token")
+	}
+	return token, nil
+}
+
+// TokenAuthenticator authenticates requests with a static set of bearer tokens.
+type TokenAuthenticator struct {
+	// users are the names of the users by token
+	users map[string]string
+}
+
+// NewTokenFileAuthenticator creates a TokenAuthenticator from a CSV file with a token and a user name per line, like
+// the static token files of the Kubernetes API server.
+func NewTokenFileAuthenticator(path string) (*TokenAuthenticator, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening token file %q", path)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing token file %q", path)
+	}
+	users := map[string]string{}
+	for i, record := range records {
+		if len(record) < 2 || record[0] == "" || record[1] == "" {
+			return nil, fmt.Errorf("line %d of token file %q must have a token and a user name", i+1, path)
+		}
+		users[record[0]] = record[1]
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("token file %q has no tokens", path)
+	}
+	return &TokenAuthenticator{users: users}, nil
+}
+
+// Authenticate returns the user of the bearer token of the request
+func (a *TokenAuthenticator) Authenticate(r *http.Request) (string, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return "", err
+	}
+	for t, user := range a.users {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return user, nil
+		}
+	}
+	return "", errors.New("unknown bearer token")
+}
+
+// CommandAuthenticator authenticates requests with a command, e.g. one validating tokens with an identity provider.
+type CommandAuthenticator struct {
+	// Command is run with sh -c, with the bearer token of the request in EKSCTL_AUTH_TOKEN; it authenticates the
+	// request by exiting with a zero status and printing the name of the user
+	Command     string
+	NewExecutor func(envVars executor.EnvVars) executor.Executor
+}
+
+// NewCommandAuthenticator creates a CommandAuthenticator running the command.
+func NewCommandAuthenticator(command string) *CommandAuthenticator {
+	return &CommandAuthenticator{
+		Command:     command,
+		NewExecutor: executor.NewShellExecutor,
+	}
+}
+
+// Authenticate runs the command with the bearer token of the request, and returns the user it prints
+func (a *CommandAuthenticator) Authenticate(r *http.Request) (string, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return "", err
+	}
+	out, err := a.NewExecutor(executor.EnvVars{AuthTokenEnvVar: token}).ExecWithOut("sh", "-c", a.Command)
+	if err != nil {
+		return "", errors.Wrap(err, "authentication command rejected the bearer token")
+	}
+	user := strings.TrimSpace(string(out))
+	if user == "" {
+		return "", errors.New("authentication command printed no user name")
+	}
+	return user, nil
+}
@@ -0,0 +1,115 @@
+package server_test
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/executor/fakes"
+	"github.com/weaveworks/eksctl/pkg/server"
+)
+
+var _ = Describe("Authenticators", func() {
+	newRequest := func(authorization string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "/v1/clusters", nil)
+		Expect(err).NotTo(HaveOccurred())
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return req
+	}
+
+	Describe("TokenAuthenticator", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "tokens")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		writeTokenFile := func(content string) string {
+			path := filepath.Join(dir, "tokens.csv")
+			Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+			return path
+		}
+
+		It("authenticates the users of the token file", func() {
+			authenticator, err := server.NewTokenFileAuthenticator(writeTokenFile("# platform team\ntoken-1,alice\ntoken-2,bob,1002\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(authenticator.Authenticate(newRequest("Bearer token-2"))).To(Equal("bob"))
+			_, err = authenticator.Authenticate(newRequest("Bearer token-3"))
+			Expect(err).To(MatchError("unknown bearer token"))
+			_, err = authenticator.Authenticate(newRequest("Basic dG9rZW4tMQ=="))
+			Expect(err).To(MatchError("no bearer token in the Authorization header"))
+			_, err = authenticator.Authenticate(newRequest(""))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects token files without users", func() {
+			_, err := server.NewTokenFileAuthenticator(writeTokenFile("token-1\n"))
+			Expect(err).To(MatchError(ContainSubstring("line 1 of token file")))
+			_, err = server.NewTokenFileAuthenticator(writeTokenFile(""))
+			Expect(err).To(MatchError(ContainSubstring("has no tokens")))
+		})
+	})
+
+	Describe("CommandAuthenticator", func() {
+		var (
+			fakeExecutor  *fakes.FakeExecutor
+			envVars       executor.EnvVars
+			authenticator *server.CommandAuthenticator
+		)
+
+		BeforeEach(func() {
+			fakeExecutor = &fakes.FakeExecutor{}
+			authenticator = &server.CommandAuthenticator{
+				Command: "./check-token.sh",
+				NewExecutor: func(e executor.EnvVars) executor.Executor {
+					envVars = e
+					return fakeExecutor
+				},
+			}
+		})
+
+		It("returns the user printed by the command", func() {
+			fakeExecutor.ExecWithOutReturns([]byte("alice\n"), nil)
+
+			Expect(authenticator.Authenticate(newRequest("Bearer token-1"))).To(Equal("alice"))
+			Expect(envVars).To(Equal(executor.EnvVars{server.AuthTokenEnvVar: "token-1"}))
+			command, args := fakeExecutor.ExecWithOutArgsForCall(0)
+			Expect(command).To(Equal("sh"))
+			Expect(args).To(Equal([]string{"-c", "./check-token.sh"}))
+		})
+
+		It("rejects tokens the command rejects", func() {
+			fakeExecutor.ExecWithOutReturns(nil, errors.New("exit status 1"))
+
+			_, err := authenticator.Authenticate(newRequest("Bearer token-1"))
+			Expect(err).To(MatchError(ContainSubstring("authentication command rejected the bearer token")))
+		})
+
+		It("rejects tokens when the command prints no user", func() {
+			fakeExecutor.ExecWithOutReturns([]byte("\n"), nil)
+
+			_, err := authenticator.Authenticate(newRequest("Bearer token-1"))
+			Expect(err).To(MatchError("authentication command printed no user name"))
+		})
+
+		It("does not run the command without a bearer token", func() {
+			_, err := authenticator.Authenticate(newRequest(""))
+			Expect(err).To(HaveOccurred())
+			Expect(fakeExecutor.ExecWithOutCallCount()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package server
+
+import (
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+
+	"github.com/weaveworks/eksctl/pkg/actions/templatestats"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// listChunkSize is the chunk size of the clusters listed from EKS
+const listChunkSize = 100
+
+// EKSBackend is the Backend calling AWS, with the credentials of the server.
+type EKSBackend struct {
+	// ProviderConfig is the configuration of the AWS clients, its region is replaced with the region of each request
+	ProviderConfig api.ProviderConfig
+	// ApplyFunc creates the cluster of a ClusterConfig, like eksctl create cluster
+	ApplyFunc func(cfg *api.ClusterConfig) error
+}
+
+func (b *EKSBackend) newProvider(region string, cfg *api.ClusterConfig) (*eks.ClusterProvider, error) {
+	providerConfig := b.ProviderConfig
+	providerConfig.Region = region
+	return eks.New(&providerConfig, cfg)
+}
+
+// ListClusters returns the clusters of the region
+func (b *EKSBackend) ListClusters(region string) ([]*api.ClusterConfig, error) {
+	ctl, err := b.newProvider(region, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ctl.ListClusters(listChunkSize, false, eks.New)
+}
+
+// GetCluster returns the cluster
+func (b *EKSBackend) GetCluster(region, name string) (*awseks.Cluster, error) {
+	ctl, err := b.newProvider(region, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ctl.GetCluster(name)
+}
+
+// Plan returns the stats of the stacks eksctl would create for the ClusterConfig, like eksctl utils template-stats
+func (b *EKSBackend) Plan(cfg *api.ClusterConfig) ([]templatestats.StackStats, error) {
+	ctl, err := b.newProvider(cfg.Metadata.Region, cfg)
+	if err != nil {
+		return nil, err
+	}
+	setVPCDefaults(cfg)
+	if cfg.VPC.ID == "" && !cfg.HasAnySubnets() {
+		if err := ctl.SetAvailabilityZones(cfg, nil); err != nil {
+			return nil, err
+		}
+		if err := vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones); err != nil {
+			return nil, err
+		}
+	} else if len(cfg.VPC.SecondaryCIDRs) > 0 {
+		if err := vpc.UseSubnetsFromSpec(cfg); err != nil {
+			return nil, err
+		}
+	}
+	return templatestats.Render(ctl.Provider, cfg)
+}
+
+// setVPCDefaults sets the defaults of the VPC that eksctl create cluster sets before rendering the stacks, the VPC
+// section of the ClusterConfig is optional
+func setVPCDefaults(cfg *api.ClusterConfig) {
+	if cfg.VPC == nil {
+		cfg.VPC = api.NewClusterVPC()
+	}
+	api.SetClusterEndpointAccessDefaults(cfg.VPC)
+}
+
+// Apply creates the cluster of the ClusterConfig
+func (b *EKSBackend) Apply(cfg *api.ClusterConfig) error {
+	return b.ApplyFunc(cfg)
+}
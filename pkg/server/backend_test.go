@@ -0,0 +1,23 @@
+package server
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("EKSBackend", func() {
+	It("sets the VPC defaults of ClusterConfigs without a VPC", func() {
+		Expect(api.Register()).To(Succeed())
+		cfg, err := eks.ParseConfig([]byte("apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\nmetadata:\n  name: cluster\n  region: us-west-2\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.VPC).To(BeNil())
+
+		setVPCDefaults(cfg)
+		Expect(cfg.VPC).NotTo(BeNil())
+		Expect(cfg.VPC.CIDR).To(Equal(api.NewClusterVPC().CIDR))
+		Expect(cfg.VPC.ClusterEndpoints).To(Equal(api.ClusterEndpointAccessDefaults()))
+	})
+})
@@ -0,0 +1,8 @@
+package server
+
+import "time"
+
+// SetNow sets the clock of the server
+func (s *Server) SetNow(now func() time.Time) {
+	s.now = now
+}
@@ -0,0 +1,367 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/google/uuid"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/actions/templatestats"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+)
+
+// maxConfigSize is the maximum size of the ClusterConfigs in request bodies
+const maxConfigSize = 1 << 20
+
+// operationRetention is how long operations are kept once they are finished
+const operationRetention = 24 * time.Hour
+
+// Backend runs the operations of the API.
+type Backend interface {
+	// ListClusters returns the clusters of the region
+	ListClusters(region string) ([]*api.ClusterConfig, error)
+	// GetCluster returns the cluster
+	GetCluster(region, name string) (*awseks.Cluster, error)
+	// Plan returns the stats of the stacks eksctl would create for the ClusterConfig
+	Plan(cfg *api.ClusterConfig) ([]templatestats.StackStats, error)
+	// Apply creates the cluster of the ClusterConfig, and returns once it is created
+	Apply(cfg *api.ClusterConfig) error
+}
+
+// OperationStatus is the status of an operation.
+type OperationStatus string
+
+// Values for OperationStatus
+const (
+	OperationRunning   OperationStatus = "Running"
+	OperationSucceeded OperationStatus = "Succeeded"
+	OperationFailed    OperationStatus = "Failed"
+)
+
+// Operation is an apply started through the API, which runs after the request returns.
+type Operation struct {
+	ID         string          `json:"id"`
+	Cluster    string          `json:"cluster"`
+	Region     string          `json:"region"`
+	User       string          `json:"user"`
+	Status     OperationStatus `json:"status"`
+	Error      string          `json:"error,omitempty"`
+	StartedAt  time.Time       `json:"startedAt"`
+	FinishedAt *time.Time      `json:"finishedAt,omitempty"`
+}
+
+// Server serves the eksctl API over HTTP.
+type Server struct {
+	backend       Backend
+	authenticator Authenticator
+	mux           *http.ServeMux
+	now           func() time.Time
+
+	// applies run one at a time, as commands share global state such as the logger
+	applyLock sync.Mutex
+
+	operationsLock sync.Mutex
+	operations     map[string]*Operation
+}
+
+// New creates a Server for the backend; every request must be authenticated by the authenticator.
+func New(backend Backend, authenticator Authenticator) *Server {
+	s := &Server{
+		backend:       backend,
+		authenticator: authenticator,
+		mux:           http.NewServeMux(),
+		now:           time.Now,
+		operations:    map[string]*Operation{},
+	}
+	s.mux.HandleFunc("/v1/clusters", s.handleClusters)
+	s.mux.HandleFunc("/v1/clusters/", s.handleCluster)
+	s.mux.HandleFunc("/v1/plan", s.handlePlan)
+	s.mux.HandleFunc("/v1/apply", s.handleApply)
+	s.mux.HandleFunc("/v1/operations", s.handleOperations)
+	s.mux.HandleFunc("/v1/operations/", s.handleOperation)
+	s.mux.Handle("/metrics", metrics.Handler())
+	return s
+}
+
+// ServeHTTP authenticates and serves the request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		logger.Debug("rejecting %s %s: %v", r.Method, r.URL.Path, err)
+		writeError(w, http.StatusUnauthorized, errors.New("unauthorized"))
+		return
+	}
+	logger.Info("%s %s by %q", r.Method, r.URL.Path, user)
+	s.mux.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userKey{}, user)))
+}
+
+// Serve accepts connections on the listener until the context is done, and then waits for the requests in progress
+// to complete
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	httpServer := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.Serve(listener)
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		return httpServer.Shutdown(shutdownCtx)
+	}
+}
+
+type userKey struct{}
+
+func userFrom(r *http.Request) string {
+	user, _ := r.Context().Value(userKey{}).(string)
+	return user
+}
+
+func (s *Server) handleClusters(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	region, ok := requireRegion(w, r)
+	if !ok {
+		return
+	}
+	clusters, err := s.backend.ListClusters(region)
+	if err != nil {
+		writeBackendError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"clusters": clusters})
+}
+
+func (s *Server) handleCluster(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/v1/clusters/")
+	if name == "" || strings.Contains(name, "/") {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no such path %q", r.URL.Path))
+		return
+	}
+	region, ok := requireRegion(w, r)
+	if !ok {
+		return
+	}
+	cluster, err := s.backend.GetCluster(region, name)
+	if err != nil {
+		writeBackendError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, cluster)
+}
+
+func (s *Server) handlePlan(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	cfg, ok := readClusterConfig(w, r)
+	if !ok {
+		return
+	}
+	stacks, err := s.backend.Plan(cfg)
+	if err != nil {
+		writeBackendError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"stacks": stacks})
+}
+
+func (s *Server) handleApply(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	cfg, ok := readClusterConfig(w, r)
+	if !ok {
+		return
+	}
+	op := &Operation{
+		ID:        uuid.NewString(),
+		Cluster:   cfg.Metadata.Name,
+		Region:    cfg.Metadata.Region,
+		User:      userFrom(r),
+		Status:    OperationRunning,
+		StartedAt: s.now(),
+	}
+	s.operationsLock.Lock()
+	s.pruneOperations()
+	s.operations[op.ID] = op
+	response := *op
+	s.operationsLock.Unlock()
+
+	go s.apply(op, cfg)
+
+	w.Header().Set("Location", "/v1/operations/"+op.ID)
+	writeJSON(w, http.StatusAccepted, response)
+}
+
+func (s *Server) apply(op *Operation, cfg *api.ClusterConfig) {
+	s.applyLock.Lock()
+	defer s.applyLock.Unlock()
+
+	logger.Info("operation %s: applying cluster %q in %q for %q", op.ID, op.Cluster, op.Region, op.User)
+	err := s.backend.Apply(cfg)
+
+	s.operationsLock.Lock()
+	defer s.operationsLock.Unlock()
+	finishedAt := s.now()
+	op.FinishedAt = &finishedAt
+	reason := ""
+	if err != nil {
+		reason = cmdutils.FailureClass(err)
+	}
+	metrics.ObserveOperation("apply", finishedAt.Sub(op.StartedAt), reason)
+	if err != nil {
+		logger.Warning("operation %s: %v", op.ID, err)
+		op.Status = OperationFailed
+		op.Error = err.Error()
+		return
+	}
+	logger.Success("operation %s: applied cluster %q", op.ID, op.Cluster)
+	op.Status = OperationSucceeded
+}
+
+// pruneOperations removes the operations finished more than operationRetention ago, so that the operations of a
+// long-running server do not grow without bound; operationsLock must be held
+func (s *Server) pruneOperations() {
+	expiry := s.now().Add(-operationRetention)
+	for id, op := range s.operations {
+		if op.FinishedAt != nil && op.FinishedAt.Before(expiry) {
+			delete(s.operations, id)
+		}
+	}
+}
+
+func (s *Server) handleOperations(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	s.operationsLock.Lock()
+	s.pruneOperations()
+	operations := []Operation{}
+	for _, op := range s.operations {
+		operations = append(operations, *op)
+	}
+	s.operationsLock.Unlock()
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].StartedAt.Before(operations[j].StartedAt)
+	})
+	writeJSON(w, http.StatusOK, map[string]interface{}{"operations": operations})
+}
+
+func (s *Server) handleOperation(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	id := strings.TrimPrefix(r.URL.Path, "/v1/operations/")
+	s.operationsLock.Lock()
+	s.pruneOperations()
+	op, ok := s.operations[id]
+	var response Operation
+	if ok {
+		response = *op
+	}
+	s.operationsLock.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("operation %q not found", id))
+		return
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+		return false
+	}
+	return true
+}
+
+func requireRegion(w http.ResponseWriter, r *http.Request) (string, bool) {
+	region := r.URL.Query().Get("region")
+	if region == "" {
+		writeError(w, http.StatusBadRequest, errors.New("the region query parameter must be set"))
+		return "", false
+	}
+	return region, true
+}
+
+// readClusterConfig reads the ClusterConfig of the request body, in YAML or JSON
+func readClusterConfig(w http.ResponseWriter, r *http.Request) (*api.ClusterConfig, bool) {
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxConfigSize+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.Wrap(err, "reading request body"))
+		return nil, false
+	}
+	if len(data) > maxConfigSize {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("the ClusterConfig must be smaller than %d bytes", maxConfigSize))
+		return nil, false
+	}
+	if err := api.Register(); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	cfg, err := eks.ParseConfig(data)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errors.Wrap(err, "parsing ClusterConfig"))
+		return nil, false
+	}
+	if cfg.Metadata == nil || cfg.Metadata.Name == "" {
+		writeError(w, http.StatusBadRequest, errors.New("metadata.name must be set"))
+		return nil, false
+	}
+	if cfg.Metadata.Region == "" {
+		writeError(w, http.StatusBadRequest, errors.New("metadata.region must be set"))
+		return nil, false
+	}
+	if err := api.ValidateSubmittedConfig(cfg); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return nil, false
+	}
+	return cfg, true
+}
+
+func writeBackendError(w http.ResponseWriter, err error) {
+	if awsError, ok := errors.Cause(err).(awserr.Error); ok && awsError.Code() == awseks.ErrCodeResourceNotFoundException {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	writeError(w, http.StatusInternalServerError, err)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Debug("writing response: %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+package server_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/templatestats"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/server"
+)
+
+type fakeBackend struct {
+	mu          sync.Mutex
+	applied     []*api.ClusterConfig
+	applyResult chan error
+}
+
+func (b *fakeBackend) ListClusters(region string) ([]*api.ClusterConfig, error) {
+	cfg := api.NewClusterConfig()
+	cfg.Metadata.Name = "cluster-1"
+	cfg.Metadata.Region = region
+	return []*api.ClusterConfig{cfg}, nil
+}
+
+func (b *fakeBackend) GetCluster(_, name string) (*awseks.Cluster, error) {
+	if name != "cluster-1" {
+		return nil, awserr.New(awseks.ErrCodeResourceNotFoundException, "No cluster found for name: "+name, nil)
+	}
+	return &awseks.Cluster{Name: aws.String(name), Status: aws.String(awseks.ClusterStatusActive)}, nil
+}
+
+func (b *fakeBackend) Plan(cfg *api.ClusterConfig) ([]templatestats.StackStats, error) {
+	return []templatestats.StackStats{{StackName: "eksctl-" + cfg.Metadata.Name + "-cluster", Resources: 30}}, nil
+}
+
+func (b *fakeBackend) Apply(cfg *api.ClusterConfig) error {
+	b.mu.Lock()
+	b.applied = append(b.applied, cfg)
+	b.mu.Unlock()
+	return <-b.applyResult
+}
+
+type staticAuthenticator struct{}
+
+func (staticAuthenticator) Authenticate(r *http.Request) (string, error) {
+	if r.Header.Get("Authorization") != "Bearer token" {
+		return "", errors.New("unknown bearer token")
+	}
+	return "alice", nil
+}
+
+const clusterConfig = `
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: cluster-2
+  region: us-west-2
+`
+
+var _ = Describe("Server", func() {
+	var (
+		backend    *fakeBackend
+		srv        *server.Server
+		httpServer *httptest.Server
+	)
+
+	BeforeEach(func() {
+		backend = &fakeBackend{applyResult: make(chan error)}
+		srv = server.New(backend, staticAuthenticator{})
+		httpServer = httptest.NewServer(srv)
+	})
+
+	AfterEach(func() {
+		httpServer.Close()
+	})
+
+	request := func(method, path, body string) (int, map[string]interface{}) {
+		req, err := http.NewRequest(method, httpServer.URL+path, strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer token")
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.Header.Get("Content-Type")).To(Equal("application/json"))
+		var decoded map[string]interface{}
+		Expect(json.NewDecoder(resp.Body).Decode(&decoded)).To(Succeed())
+		return resp.StatusCode, decoded
+	}
+
+	It("rejects unauthenticated requests", func() {
+		resp, err := http.Get(httpServer.URL + "/v1/clusters?region=us-west-2")
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("lists the clusters of a region", func() {
+		status, body := request(http.MethodGet, "/v1/clusters?region=eu-west-1", "")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body["clusters"]).To(HaveLen(1))
+		metadata := body["clusters"].([]interface{})[0].(map[string]interface{})["metadata"].(map[string]interface{})
+		Expect(metadata["name"]).To(Equal("cluster-1"))
+		Expect(metadata["region"]).To(Equal("eu-west-1"))
+	})
+
+	It("requires a region", func() {
+		status, body := request(http.MethodGet, "/v1/clusters", "")
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(body["error"]).To(Equal("the region query parameter must be set"))
+	})
+
+	It("gets a cluster", func() {
+		status, body := request(http.MethodGet, "/v1/clusters/cluster-1?region=us-west-2", "")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body["Name"]).To(Equal("cluster-1"))
+	})
+
+	It("returns not found for clusters that do not exist", func() {
+		status, _ := request(http.MethodGet, "/v1/clusters/cluster-2?region=us-west-2", "")
+		Expect(status).To(Equal(http.StatusNotFound))
+	})
+
+	It("plans the stacks of a ClusterConfig", func() {
+		status, body := request(http.MethodPost, "/v1/plan", clusterConfig)
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body["stacks"]).To(ConsistOf(HaveKeyWithValue("stackName", "eksctl-cluster-2-cluster")))
+	})
+
+	It("rejects invalid ClusterConfigs", func() {
+		status, body := request(http.MethodPost, "/v1/plan", "apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\nmetadata:\n  name: cluster-2\n")
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(body["error"]).To(Equal("metadata.region must be set"))
+
+		status, body = request(http.MethodPost, "/v1/plan", "apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\nunknown: field\n")
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(body["error"]).To(ContainSubstring("parsing ClusterConfig"))
+	})
+
+	It("rejects ClusterConfigs running commands or reading files on the server", func() {
+		status, body := request(http.MethodPost, "/v1/apply", clusterConfig+"hooks:\n  postCreate:\n  - cat /etc/passwd\n")
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(body["error"]).To(Equal("hooks cannot be set, as they would run commands on the host of eksctl"))
+
+		status, body = request(http.MethodPost, "/v1/apply", clusterConfig+"bootstrap:\n  manifests:\n  - /etc/kubernetes/admin.yaml\n")
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(body["error"]).To(Equal("bootstrap.manifests[0] must be an http(s) URL, local paths cannot be read"))
+
+		backend.mu.Lock()
+		Expect(backend.applied).To(BeEmpty())
+		backend.mu.Unlock()
+	})
+
+	It("rejects ClusterConfigs reading a well-known policy registry on the server", func() {
+		status, body := request(http.MethodPost, "/v1/apply", clusterConfig+"iam:\n  wellKnownPolicyRegistry: ../../etc/passwd\n")
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(body["error"]).To(Equal("iam.wellKnownPolicyRegistry cannot be set, local paths cannot be read"))
+
+		backend.mu.Lock()
+		Expect(backend.applied).To(BeEmpty())
+		backend.mu.Unlock()
+	})
+
+	It("rejects ClusterConfigs running node provisioner plugins on the server", func() {
+		status, body := request(http.MethodPost, "/v1/apply", clusterConfig+"nodeGroups:\n- name: ng-1\n  provisioner: ocean\n")
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(body["error"]).To(Equal(`provisioner of nodegroup "ng-1" cannot be set, as it would run a plugin on the host of eksctl`))
+
+		backend.mu.Lock()
+		Expect(backend.applied).To(BeEmpty())
+		backend.mu.Unlock()
+	})
+
+	It("rejects unsupported methods", func() {
+		status, _ := request(http.MethodGet, "/v1/apply", "")
+		Expect(status).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("applies ClusterConfigs as operations", func() {
+		status, body := request(http.MethodPost, "/v1/apply", clusterConfig)
+		Expect(status).To(Equal(http.StatusAccepted))
+		Expect(body["status"]).To(Equal("Running"))
+		Expect(body["user"]).To(Equal("alice"))
+		Expect(body["cluster"]).To(Equal("cluster-2"))
+		id := body["id"].(string)
+
+		backend.applyResult <- nil
+		Eventually(func() interface{} {
+			_, body := request(http.MethodGet, "/v1/operations/"+id, "")
+			return body["status"]
+		}).Should(Equal("Succeeded"))
+
+		backend.mu.Lock()
+		Expect(backend.applied).To(HaveLen(1))
+		Expect(backend.applied[0].Metadata.Name).To(Equal("cluster-2"))
+		backend.mu.Unlock()
+
+		status, body = request(http.MethodGet, "/v1/operations", "")
+		Expect(status).To(Equal(http.StatusOK))
+		Expect(body["operations"]).To(ConsistOf(HaveKeyWithValue("id", id)))
+	})
+
+	It("reports failed operations", func() {
+		_, body := request(http.MethodPost, "/v1/apply", clusterConfig)
+		id := body["id"].(string)
+
+		backend.applyResult <- errors.New("creating cluster failed")
+		Eventually(func() interface{} {
+			_, body := request(http.MethodGet, "/v1/operations/"+id, "")
+			return body["status"]
+		}).Should(Equal("Failed"))
+		_, body = request(http.MethodGet, "/v1/operations/"+id, "")
+		Expect(body["error"]).To(Equal("creating cluster failed"))
+		Expect(body["finishedAt"]).NotTo(BeNil())
+	})
+
+	It("serves metrics of operations to authenticated requests", func() {
+		_, body := request(http.MethodPost, "/v1/apply", clusterConfig)
+		id := body["id"].(string)
+		backend.applyResult <- errors.New("creating cluster failed")
+		Eventually(func() interface{} {
+			_, body := request(http.MethodGet, "/v1/operations/"+id, "")
+			return body["status"]
+		}).Should(Equal("Failed"))
+
+		resp, err := http.Get(httpServer.URL + "/metrics")
+		Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+
+		req, err := http.NewRequest(http.MethodGet, httpServer.URL+"/metrics", nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Authorization", "Bearer token")
+		resp, err = http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		metrics, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(metrics)).To(ContainSubstring(`eksctl_operation_failures_total{operation="apply",reason="error"}`))
+	})
+
+	It("removes operations a day after they finished", func() {
+		var (
+			clockLock sync.Mutex
+			now       = time.Date(2022, 2, 1, 12, 0, 0, 0, time.UTC)
+		)
+		srv.SetNow(func() time.Time {
+			clockLock.Lock()
+			defer clockLock.Unlock()
+			return now
+		})
+		advance := func(d time.Duration) {
+			clockLock.Lock()
+			defer clockLock.Unlock()
+			now = now.Add(d)
+		}
+
+		_, body := request(http.MethodPost, "/v1/apply", clusterConfig)
+		finished := body["id"].(string)
+		backend.applyResult <- nil
+		Eventually(func() interface{} {
+			_, body := request(http.MethodGet, "/v1/operations/"+finished, "")
+			return body["status"]
+		}).Should(Equal("Succeeded"))
+
+		advance(23 * time.Hour)
+		_, body = request(http.MethodPost, "/v1/apply", clusterConfig)
+		running := body["id"].(string)
+		status, _ := request(http.MethodGet, "/v1/operations/"+finished, "")
+		Expect(status).To(Equal(http.StatusOK))
+
+		advance(2 * time.Hour)
+		status, _ = request(http.MethodGet, "/v1/operations/"+finished, "")
+		Expect(status).To(Equal(http.StatusNotFound))
+		_, body = request(http.MethodGet, "/v1/operations", "")
+		Expect(body["operations"]).To(ConsistOf(HaveKeyWithValue("id", running)))
+
+		backend.applyResult <- nil
+	})
+
+	It("returns not found for unknown operations", func() {
+		status, _ := request(http.MethodGet, "/v1/operations/unknown", "")
+		Expect(status).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"crypto/tls"
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+// NewTLSListener wraps the listener to serve HTTPS with the certificate and private key of the files
+func NewTLSListener(listener net.Listener, certFile, keyFile string) (net.Listener, error) {
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading TLS certificate")
+	}
+	return tls.NewListener(listener, &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
+// IsLoopback reports whether the address only accepts connections from the local host
+func IsLoopback(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	return ok && tcpAddr.IP.IsLoopback()
+}
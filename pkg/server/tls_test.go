@@ -0,0 +1,96 @@
+package server_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/server"
+)
+
+var _ = Describe("TLS", func() {
+	writeCertificate := func(dir string) (certFile, keyFile string) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "localhost"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			IsCA:         true,
+			KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+
+			BasicConstraintsValid: true,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).NotTo(HaveOccurred())
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		certFile = filepath.Join(dir, "server.crt")
+		keyFile = filepath.Join(dir, "server.key")
+		Expect(os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)).To(Succeed())
+		Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)).To(Succeed())
+		return certFile, keyFile
+	}
+
+	It("serves HTTPS with the certificate", func() {
+		dir, err := os.MkdirTemp("", "eksctl-serve")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		certFile, keyFile := writeCertificate(dir)
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		tlsListener, err := server.NewTLSListener(listener, certFile, keyFile)
+		Expect(err).NotTo(HaveOccurred())
+		httpServer := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})}
+		go func() { _ = httpServer.Serve(tlsListener) }()
+		defer httpServer.Close()
+
+		certPEM, err := os.ReadFile(certFile)
+		Expect(err).NotTo(HaveOccurred())
+		rootCAs := x509.NewCertPool()
+		Expect(rootCAs.AppendCertsFromPEM(certPEM)).To(BeTrue())
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12},
+		}}
+		resp, err := client.Get("https://" + tlsListener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+		Expect(resp.TLS).NotTo(BeNil())
+	})
+
+	It("fails without a valid certificate", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		defer listener.Close()
+		_, err = server.NewTLSListener(listener, "missing.crt", "missing.key")
+		Expect(err).To(MatchError(ContainSubstring("loading TLS certificate")))
+	})
+
+	It("reports loopback addresses", func() {
+		Expect(server.IsLoopback(&net.TCPAddr{IP: net.ParseIP("127.0.0.1")})).To(BeTrue())
+		Expect(server.IsLoopback(&net.TCPAddr{IP: net.ParseIP("::1")})).To(BeTrue())
+		Expect(server.IsLoopback(&net.TCPAddr{IP: net.IPv4zero})).To(BeFalse())
+		Expect(server.IsLoopback(&net.TCPAddr{IP: net.ParseIP("10.0.0.1")})).To(BeFalse())
+	})
+})
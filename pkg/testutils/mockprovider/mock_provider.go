@@ -8,12 +8,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/savingsplans/savingsplansiface"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 
@@ -25,7 +31,9 @@ import (
 	//"github.com/aws/aws-sdk-go/awstesting/unit"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
 )
 
 // ProviderConfig holds current global config
@@ -60,10 +68,17 @@ type MockProvider struct {
 	elb            *mocks.ELBAPI
 	elbv2          *mocks.ELBV2API
 	sts            *mocks.STSAPI
+	stsV2          *mocksv2.STS
 	ssm            *mocks.SSMAPI
 	iam            *mocks.IAMAPI
 	cloudtrail     *mocks.CloudTrailAPI
 	cloudwatchlogs *mocks.CloudWatchLogsAPI
+	cloudwatch     *mocks.CloudWatchAPI
+	ecr            *mocks.ECRAPI
+	eventbridge    *mocks.EventBridgeAPI
+	s3             *mocks.S3API
+	kms            *mocks.KMSAPI
+	savingsplans   *mocks.SavingsPlansAPI
 	configProvider *mocks.ConfigProvider
 }
 
@@ -79,10 +94,17 @@ func NewMockProvider() *MockProvider {
 		elb:            &mocks.ELBAPI{},
 		elbv2:          &mocks.ELBV2API{},
 		sts:            &mocks.STSAPI{},
+		stsV2:          &mocksv2.STS{},
 		ssm:            &mocks.SSMAPI{},
 		iam:            &mocks.IAMAPI{},
 		cloudtrail:     &mocks.CloudTrailAPI{},
 		cloudwatchlogs: &mocks.CloudWatchLogsAPI{},
+		cloudwatch:     &mocks.CloudWatchAPI{},
+		ecr:            &mocks.ECRAPI{},
+		eventbridge:    &mocks.EventBridgeAPI{},
+		s3:             &mocks.S3API{},
+		kms:            &mocks.KMSAPI{},
+		savingsplans:   &mocks.SavingsPlansAPI{},
 		configProvider: &mocks.ConfigProvider{},
 	}
 }
@@ -98,6 +120,9 @@ func (m MockProvider) CloudFormationDisableRollback() bool {
 	return false
 }
 
+// CloudFormationTemplateBucket returns, if any, the S3 bucket templates too large to be passed inline are uploaded to
+func (m MockProvider) CloudFormationTemplateBucket() string { return "" }
+
 // MockCloudFormation returns a mocked CloudFormation API
 func (m MockProvider) MockCloudFormation() *mocks.CloudFormationAPI {
 	return m.CloudFormation().(*mocks.CloudFormationAPI)
@@ -121,9 +146,15 @@ func (m MockProvider) EC2() ec2iface.EC2API { return m.ec2 }
 // ELB returns a representation of the ELB API
 func (m MockProvider) ELB() elbiface.ELBAPI { return m.elb }
 
+// MockELB returns a mocked ELB API
+func (m MockProvider) MockELB() *mocks.ELBAPI { return m.ELB().(*mocks.ELBAPI) }
+
 // ELBV2 returns a representation of the ELBV2 API
 func (m MockProvider) ELBV2() elbv2iface.ELBV2API { return m.elbv2 }
 
+// MockELBV2 returns a mocked ELBV2 API
+func (m MockProvider) MockELBV2() *mocks.ELBV2API { return m.ELBV2().(*mocks.ELBV2API) }
+
 // MockEC2 returns a mocked EC2 API
 func (m MockProvider) MockEC2() *mocks.EC2API { return m.EC2().(*mocks.EC2API) }
 
@@ -133,6 +164,12 @@ func (m MockProvider) STS() stsiface.STSAPI { return m.sts }
 // MockSTS returns a mocked STS API
 func (m MockProvider) MockSTS() *mocks.STSAPI { return m.STS().(*mocks.STSAPI) }
 
+// STSV2 returns a representation of the STS v2 API
+func (m MockProvider) STSV2() awsapi.STS { return m.stsV2 }
+
+// MockSTSV2 returns a mocked STS v2 API
+func (m MockProvider) MockSTSV2() *mocksv2.STS { return m.STSV2().(*mocksv2.STS) }
+
 // SSM returns a representation of the SSM API
 func (m MockProvider) SSM() ssmiface.SSMAPI { return m.ssm }
 
@@ -161,6 +198,54 @@ func (m MockProvider) MockCloudWatchLogs() *mocks.CloudWatchLogsAPI {
 	return m.CloudWatchLogs().(*mocks.CloudWatchLogsAPI)
 }
 
+// CloudWatch returns a representation of the CloudWatch API
+func (m MockProvider) CloudWatch() cloudwatchiface.CloudWatchAPI { return m.cloudwatch }
+
+// MockCloudWatch returns a mocked CloudWatch API
+func (m MockProvider) MockCloudWatch() *mocks.CloudWatchAPI {
+	return m.CloudWatch().(*mocks.CloudWatchAPI)
+}
+
+// ECR returns a representation of the ECR API
+func (m MockProvider) ECR() ecriface.ECRAPI { return m.ecr }
+
+// MockECR returns a mocked ECR API
+func (m MockProvider) MockECR() *mocks.ECRAPI {
+	return m.ECR().(*mocks.ECRAPI)
+}
+
+// EventBridge returns a representation of the EventBridge API
+func (m MockProvider) EventBridge() eventbridgeiface.EventBridgeAPI { return m.eventbridge }
+
+// MockEventBridge returns a mocked EventBridge API
+func (m MockProvider) MockEventBridge() *mocks.EventBridgeAPI {
+	return m.EventBridge().(*mocks.EventBridgeAPI)
+}
+
+// S3 returns a representation of the S3 API
+func (m MockProvider) S3() s3iface.S3API { return m.s3 }
+
+// MockS3 returns a mocked S3 API
+func (m MockProvider) MockS3() *mocks.S3API {
+	return m.S3().(*mocks.S3API)
+}
+
+// KMS returns a representation of the KMS API
+func (m MockProvider) KMS() kmsiface.KMSAPI { return m.kms }
+
+// MockKMS returns a mocked KMS API
+func (m MockProvider) MockKMS() *mocks.KMSAPI {
+	return m.KMS().(*mocks.KMSAPI)
+}
+
+// SavingsPlans returns a representation of the Savings Plans API
+func (m MockProvider) SavingsPlans() savingsplansiface.SavingsPlansAPI { return m.savingsplans }
+
+// MockSavingsPlans returns a mocked Savings Plans API
+func (m MockProvider) MockSavingsPlans() *mocks.SavingsPlansAPI {
+	return m.SavingsPlans().(*mocks.SavingsPlansAPI)
+}
+
 // Profile returns current profile setting
 func (m MockProvider) Profile() string { return ProviderConfig.Profile }
 
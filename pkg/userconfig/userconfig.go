@@ -0,0 +1,135 @@
+package userconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// EksctlConfigEnvName defines an environment property to configure where the user config file lives.
+const EksctlConfigEnvName = "EKSCTL_CONFIG"
+
+// Config is the user config file, holding the defaults of the flags of eksctl per named context
+type Config struct {
+	CurrentContext string              `yaml:"currentContext,omitempty"`
+	Contexts       map[string]*Context `yaml:"contexts,omitempty"`
+	// Validators apply in every context
+	Validators []*Validator `yaml:"validators,omitempty"`
+}
+
+// Context holds the defaults of the flags of eksctl
+type Context struct {
+	// Region is the default of --region
+	Region string `yaml:"region,omitempty"`
+	// Profile is the default of --profile
+	Profile string `yaml:"profile,omitempty"`
+	// Tags are the default of --tags when creating clusters and nodegroups
+	Tags map[string]string `yaml:"tags,omitempty"`
+	// Output is the default of --output of get commands
+	Output string `yaml:"output,omitempty"`
+}
+
+// Validator is a validation plugin that every ClusterConfig must pass before eksctl creates or updates resources,
+// either a command or a Rego policy
+type Validator struct {
+	// Name identifies the validator in errors
+	Name string `yaml:"name"`
+	// Command is run with sh -c, with the path of the ClusterConfig, in JSON, in EKSCTL_CLUSTER_CONFIG;
+	// a non-zero exit status rejects the ClusterConfig, with the output of the command as the reason
+	Command string `yaml:"command,omitempty"`
+	// Rego is the path of a Rego policy evaluated with opa, each message of its data.eksctl.deny
+	// rules rejects the ClusterConfig
+	Rego string `yaml:"rego,omitempty"`
+}
+
+// Path returns the path of the user config file, ~/.eksctl/config unless set by EKSCTL_CONFIG
+func Path() (string, error) {
+	if path := os.Getenv(EksctlConfigEnvName); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".eksctl", "config"), nil
+}
+
+// Load reads the user config file, a missing file is an empty config
+func Load(path string) (*Config, error) {
+	config := &Config{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return config, nil
+		}
+		return nil, errors.Wrapf(err, "reading user config file %q", path)
+	}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, errors.Wrapf(err, "parsing user config file %q", path)
+	}
+	if config.CurrentContext != "" && config.Contexts[config.CurrentContext] == nil {
+		return nil, fmt.Errorf("current context %q of user config file %q does not exist", config.CurrentContext, path)
+	}
+	for i, v := range config.Validators {
+		if err := v.validate(); err != nil {
+			return nil, errors.Wrapf(err, "validators[%d] of user config file %q", i, path)
+		}
+	}
+	return config, nil
+}
+
+func (v *Validator) validate() error {
+	if v.Name == "" {
+		return errors.New("name must be set")
+	}
+	if (v.Command == "") == (v.Rego == "") {
+		return fmt.Errorf("exactly one of command and rego must be set for validator %q", v.Name)
+	}
+	return nil
+}
+
+// Save writes the user config file, creating its directory if needed
+func (c *Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "creating directory of user config file %q", path)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return errors.Wrapf(err, "writing user config file %q", path)
+	}
+	return nil
+}
+
+// UseContext sets the current context
+func (c *Config) UseContext(name string) error {
+	if c.Contexts[name] == nil {
+		return fmt.Errorf("context %q does not exist, valid options: %v", name, c.ContextNames())
+	}
+	c.CurrentContext = name
+	return nil
+}
+
+// Current returns the current context, an empty one when none is set
+func (c *Config) Current() *Context {
+	if context := c.Contexts[c.CurrentContext]; context != nil {
+		return context
+	}
+	return &Context{}
+}
+
+// ContextNames returns the sorted names of the contexts
+func (c *Config) ContextNames() []string {
+	var names []string
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
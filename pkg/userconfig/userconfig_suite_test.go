@@ -0,0 +1,11 @@
+package userconfig_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestUserConfig(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
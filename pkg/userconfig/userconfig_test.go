@@ -0,0 +1,146 @@
+package userconfig_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/userconfig"
+)
+
+var _ = Describe("user config file", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "userconfig")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, ".eksctl", "config")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	writeConfig := func(content string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+	}
+
+	It("returns the path set by EKSCTL_CONFIG", func() {
+		os.Setenv(userconfig.EksctlConfigEnvName, path)
+		defer os.Unsetenv(userconfig.EksctlConfigEnvName)
+		Expect(userconfig.Path()).To(Equal(path))
+	})
+
+	It("loads a missing file as an empty config", func() {
+		config, err := userconfig.Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Current()).To(Equal(&userconfig.Context{}))
+	})
+
+	It("loads the current context", func() {
+		writeConfig(`
+currentContext: prod
+contexts:
+  prod:
+    region: eu-west-1
+    profile: prod
+    output: yaml
+    tags:
+      team: platform
+  dev:
+    region: us-east-2
+`)
+		config, err := userconfig.Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.ContextNames()).To(Equal([]string{"dev", "prod"}))
+		Expect(config.Current()).To(Equal(&userconfig.Context{
+			Region:  "eu-west-1",
+			Profile: "prod",
+			Output:  "yaml",
+			Tags:    map[string]string{"team": "platform"},
+		}))
+	})
+
+	It("fails to load unknown fields", func() {
+		writeConfig(`
+contexts:
+  prod:
+    regoin: eu-west-1
+`)
+		_, err := userconfig.Load(path)
+		Expect(err).To(MatchError(ContainSubstring("parsing user config file")))
+	})
+
+	It("fails to load a current context that does not exist", func() {
+		writeConfig(`
+currentContext: prod
+contexts:
+  dev:
+    region: us-east-2
+`)
+		_, err := userconfig.Load(path)
+		Expect(err).To(MatchError(ContainSubstring(`current context "prod"`)))
+	})
+
+	It("loads validators", func() {
+		writeConfig(`
+validators:
+- name: instance-types
+  command: ./check-instance-types.sh
+- name: org-policy
+  rego: /etc/eksctl/policy.rego
+`)
+		config, err := userconfig.Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Validators).To(Equal([]*userconfig.Validator{
+			{Name: "instance-types", Command: "./check-instance-types.sh"},
+			{Name: "org-policy", Rego: "/etc/eksctl/policy.rego"},
+		}))
+	})
+
+	It("fails to load validators without a name", func() {
+		writeConfig(`
+validators:
+- command: ./check.sh
+`)
+		_, err := userconfig.Load(path)
+		Expect(err).To(MatchError(ContainSubstring("validators[0] of user config file")))
+		Expect(err).To(MatchError(ContainSubstring("name must be set")))
+	})
+
+	It("fails to load validators with both a command and a Rego policy", func() {
+		writeConfig(`
+validators:
+- name: org-policy
+  command: ./check.sh
+  rego: policy.rego
+`)
+		_, err := userconfig.Load(path)
+		Expect(err).To(MatchError(ContainSubstring(`exactly one of command and rego must be set for validator "org-policy"`)))
+	})
+
+	It("saves the context in use", func() {
+		writeConfig(`
+contexts:
+  dev:
+    region: us-east-2
+`)
+		config, err := userconfig.Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.UseContext("prod")).To(MatchError(`context "prod" does not exist, valid options: [dev]`))
+		Expect(config.UseContext("dev")).To(Succeed())
+		Expect(config.Save(path)).To(Succeed())
+
+		config, err = userconfig.Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CurrentContext).To(Equal("dev"))
+		Expect(config.Current().Region).To(Equal("us-east-2"))
+	})
+})
@@ -0,0 +1,55 @@
+package egressip
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultURL is the URL of the AWS service returning the public IP address requests are made from
+const DefaultURL = "https://checkip.amazonaws.com"
+
+const timeout = 10 * time.Second
+
+// Detect returns the public IPv4 address the requests of the caller egress from, as returned by the service at
+// the given URL
+func Detect(ctx context.Context, client *http.Client, url string) (net.IP, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "detecting egress IP address with %s", url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("detecting egress IP address with %s: unexpected status %s", url, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64))
+	if err != nil {
+		return nil, errors.Wrap(err, "reading egress IP address")
+	}
+
+	address := strings.TrimSpace(string(body))
+	ip := net.ParseIP(address).To4()
+	if ip == nil {
+		return nil, fmt.Errorf("egress IP address %q is not an IPv4 address", address)
+	}
+	return ip, nil
+}
+
+// CIDR returns the /32 CIDR of the given IPv4 address
+func CIDR(ip net.IP) string {
+	return ip.String() + "/32"
+}
@@ -0,0 +1,58 @@
+package egressip_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/utils/egressip"
+)
+
+func TestEgressIP(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
+
+var _ = Describe("Detect", func() {
+	var (
+		server   *httptest.Server
+		response string
+		status   int
+	)
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(status)
+			fmt.Fprint(w, response)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("returns the IPv4 address of the response", func() {
+		response = "203.0.113.10\n"
+		ip, err := egressip.Detect(context.Background(), server.Client(), server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(egressip.CIDR(ip)).To(Equal("203.0.113.10/32"))
+	})
+
+	It("returns an error for an IPv6 address", func() {
+		response = "2001:db8::1"
+		_, err := egressip.Detect(context.Background(), server.Client(), server.URL)
+		Expect(err).To(MatchError(`egress IP address "2001:db8::1" is not an IPv4 address`))
+	})
+
+	It("returns an error for an unexpected status", func() {
+		status = http.StatusServiceUnavailable
+		_, err := egressip.Detect(context.Background(), server.Client(), server.URL)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status 503")))
+	})
+})
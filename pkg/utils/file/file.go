@@ -22,3 +22,8 @@ func ExpandPath(p string) string {
 
 	return p
 }
+
+// IsURL checks whether the location is an http(s) URL rather than a file path
+func IsURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
@@ -7,6 +7,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"text/template"
 
 	"github.com/gofrs/flock"
 	"github.com/kris-nova/logger"
@@ -115,13 +116,86 @@ func NewForUser(spec *api.ClusterConfig, username string) *clientcmdapi.Config {
 // a suitable authenticator and respecting provider settings
 func NewForKubectl(spec *api.ClusterConfig, username, roleARN, profile string) *clientcmdapi.Config {
 	config := NewForUser(spec, username)
+	AppendAuthenticator(config, spec.Metadata, DefaultAuthenticator(), roleARN, profile)
+	return config
+}
+
+// DefaultAuthenticator returns the first available authenticator, falling back to aws-iam-authenticator
+func DefaultAuthenticator() string {
 	authenticator, found := LookupAuthenticator()
 	if !found {
-		// fall back to aws-iam-authenticator
-		authenticator = AWSIAMAuthenticator
+		return AWSIAMAuthenticator
 	}
-	AppendAuthenticator(config, spec.Metadata, authenticator, roleARN, profile)
-	return config
+	return authenticator
+}
+
+// NameTemplateData holds the fields available to the templates naming the context and user of a kubeconfig
+type NameTemplateData struct {
+	// Username is the name of the IAM user or role
+	Username string
+	// ClusterName is the name of the EKS cluster
+	ClusterName string
+	// Region is the region of the EKS cluster
+	Region string
+	// Cluster is the name of the cluster in the kubeconfig
+	Cluster string
+}
+
+// NewNameTemplateData returns the data used to render the context and user names of a cluster
+func NewNameTemplateData(meta *api.ClusterMeta, username string) NameTemplateData {
+	return NameTemplateData{
+		Username:    username,
+		ClusterName: meta.Name,
+		Region:      meta.Region,
+		Cluster:     meta.String(),
+	}
+}
+
+// RenameCurrentContext renames the current context and its user using Go templates, an empty
+// template keeps the existing name
+func RenameCurrentContext(config *clientcmdapi.Config, contextTemplate, userTemplate string, data NameTemplateData) error {
+	currentName := config.CurrentContext
+	context, ok := config.Contexts[currentName]
+	if !ok {
+		return fmt.Errorf("current context %q not found", currentName)
+	}
+
+	render := func(name, text, current string) (string, error) {
+		if text == "" {
+			return current, nil
+		}
+		t, err := template.New(name).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return "", errors.Wrapf(err, "parsing %s template", name)
+		}
+		var b strings.Builder
+		if err := t.Execute(&b, data); err != nil {
+			return "", errors.Wrapf(err, "rendering %s template", name)
+		}
+		if b.Len() == 0 {
+			return "", fmt.Errorf("%s template %q renders an empty name", name, text)
+		}
+		return b.String(), nil
+	}
+
+	contextName, err := render("context name", contextTemplate, currentName)
+	if err != nil {
+		return err
+	}
+	userName, err := render("user name", userTemplate, context.AuthInfo)
+	if err != nil {
+		return err
+	}
+
+	if authInfo, ok := config.AuthInfos[context.AuthInfo]; ok {
+		delete(config.AuthInfos, context.AuthInfo)
+		config.AuthInfos[userName] = authInfo
+	}
+	context.AuthInfo = userName
+	delete(config.Contexts, currentName)
+	config.Contexts[contextName] = context
+	config.CurrentContext = contextName
+	return nil
 }
 
 // AppendAuthenticator appends the AWS IAM  authenticator, and
@@ -386,8 +460,8 @@ func deleteClusterInfo(existing *clientcmdapi.Config, meta *api.ClusterMeta) boo
 			delete(existing.Contexts, name)
 			logger.Debug("removed context for %q from kubeconfig", name)
 			isChanged = true
-			if _, ok := existing.AuthInfos[name]; ok {
-				delete(existing.AuthInfos, name)
+			if _, ok := existing.AuthInfos[context.AuthInfo]; ok {
+				delete(existing.AuthInfos, context.AuthInfo)
 				logger.Debug("removed user for %q from kubeconfig", name)
 			}
 			currentContextName = name
@@ -145,6 +145,42 @@ var _ = Describe("Kubeconfig", func() {
 		Expect(readConfig.CurrentContext).To(Equal("minikube"))
 	})
 
+	Context("rename current context", func() {
+		var (
+			config   *api.Config
+			nameData kubeconfig.NameTemplateData
+		)
+
+		BeforeEach(func() {
+			clusterConfig := eksctlapi.NewClusterConfig()
+			clusterConfig.Metadata.Name = "foo"
+			clusterConfig.Metadata.Region = "us-west-2"
+			clusterConfig.Status = &eksctlapi.ClusterStatus{Endpoint: "https://127.0.0.1:8443"}
+			config = kubeconfig.NewForKubectl(clusterConfig, "admin", "", "")
+			nameData = kubeconfig.NewNameTemplateData(clusterConfig.Metadata, "admin")
+		})
+
+		It("renames the context and the user", func() {
+			Expect(kubeconfig.RenameCurrentContext(config, "{{.ClusterName}}-{{.Region}}", "{{.Username}}-{{.ClusterName}}", nameData)).To(Succeed())
+			Expect(config.CurrentContext).To(Equal("foo-us-west-2"))
+			Expect(config.Contexts).To(HaveLen(1))
+			Expect(config.Contexts["foo-us-west-2"].AuthInfo).To(Equal("admin-foo"))
+			Expect(config.Contexts["foo-us-west-2"].Cluster).To(Equal("foo.us-west-2.eksctl.io"))
+			Expect(config.AuthInfos).To(HaveLen(1))
+			Expect(config.AuthInfos["admin-foo"].Exec).NotTo(BeNil())
+		})
+
+		It("keeps the existing names when templates are empty", func() {
+			Expect(kubeconfig.RenameCurrentContext(config, "", "", nameData)).To(Succeed())
+			Expect(config.CurrentContext).To(Equal("admin@foo.us-west-2.eksctl.io"))
+			Expect(config.AuthInfos).To(HaveKey("admin@foo.us-west-2.eksctl.io"))
+		})
+
+		It("errors on unknown template fields", func() {
+			Expect(kubeconfig.RenameCurrentContext(config, "{{.Account}}", "", nameData)).To(MatchError(ContainSubstring("rendering context name template")))
+		})
+	})
+
 	var (
 		kubeconfigPathToRestore string
 		hasKubeconfigPath       bool
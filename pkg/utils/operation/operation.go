@@ -0,0 +1,112 @@
+// Package operation marks the stack operations of a run of an eksctl command with CloudFormation client request
+// tokens. CloudFormation records the token on the events of the stack, and CloudTrail in the parameters of the
+// request, which attributes the changes of stacks to the run of the command that made them
+package operation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/weaveworks/eksctl/pkg/utils/names"
+)
+
+const (
+	tokenPrefix    = "eksctl"
+	maxTokenLength = 128
+	idTimeFormat   = "20060102150405"
+	idRandomLength = 4
+)
+
+var (
+	invalidCommandChars = regexp.MustCompile(`[^a-zA-Z0-9-]+`)
+	idRE                = regexp.MustCompile(fmt.Sprintf(`^\d{%d}[a-f0-9]{%d}$`, len(idTimeFormat), idRandomLength))
+)
+
+// Operation is a run of an eksctl command
+type Operation struct {
+	// ID is unique to the run, and starts with the UTC time the run started at
+	ID string
+	// Command run, e.g. `create-nodegroup`
+	Command string
+}
+
+var (
+	mu       sync.Mutex
+	current  *Operation
+	sequence int
+)
+
+// Start sets the operation the stack operations of the process are marked with, given the path of the command run,
+// e.g. `eksctl create nodegroup`
+func Start(commandPath string, now time.Time) Operation {
+	fields := strings.Fields(commandPath)
+	if len(fields) > 0 && fields[0] == tokenPrefix {
+		fields = fields[1:]
+	}
+	op := Operation{
+		ID:      now.UTC().Format(idTimeFormat) + names.RandomName(idRandomLength, "abcdef0123456789"),
+		Command: invalidCommandChars.ReplaceAllString(strings.Join(fields, "-"), ""),
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	current = &op
+	sequence = 0
+	return op
+}
+
+// Stop clears the operation of the process, so that the stack operations that follow are not marked
+func Stop() {
+	mu.Lock()
+	defer mu.Unlock()
+	current = nil
+	sequence = 0
+}
+
+// Current returns the operation of the process, if one was started
+func Current() (Operation, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	if current == nil {
+		return Operation{}, false
+	}
+	return *current, true
+}
+
+// NextToken returns a client request token for the next stack operation of the current operation, or nil if no
+// operation was started. Each stack operation gets its own token, as CloudFormation treats requests with the token
+// of an earlier request on the same stack as retries of that request
+func NextToken() *string {
+	mu.Lock()
+	defer mu.Unlock()
+	if current == nil {
+		return nil
+	}
+	sequence++
+	token := fmt.Sprintf("%s-%s-%d-%s", tokenPrefix, current.ID, sequence, current.Command)
+	if len(token) > maxTokenLength {
+		token = token[:maxTokenLength]
+	}
+	token = strings.TrimSuffix(token, "-")
+	return &token
+}
+
+// ParseToken returns the operation a client request token was returned for by NextToken
+func ParseToken(token string) (Operation, bool) {
+	parts := strings.SplitN(token, "-", 4)
+	if len(parts) != 4 || parts[0] != tokenPrefix || !idRE.MatchString(parts[1]) {
+		return Operation{}, false
+	}
+	return Operation{ID: parts[1], Command: parts[3]}, true
+}
+
+// StartedAt returns the time the operation started at, to the second
+func (o Operation) StartedAt() (time.Time, error) {
+	if len(o.ID) < len(idTimeFormat) {
+		return time.Time{}, fmt.Errorf("invalid operation ID %q", o.ID)
+	}
+	return time.Parse(idTimeFormat, o.ID[:len(idTimeFormat)])
+}
@@ -0,0 +1,64 @@
+package operation_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/utils/operation"
+)
+
+func TestOperation(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
+
+var _ = Describe("operation", func() {
+	startTime := time.Date(2022, 3, 6, 2, 30, 0, 0, time.UTC)
+
+	AfterEach(func() {
+		operation.Stop()
+	})
+
+	It("does not return tokens until an operation is started", func() {
+		_, ok := operation.Current()
+		Expect(ok).To(BeFalse())
+		Expect(operation.NextToken()).To(BeNil())
+	})
+
+	It("returns a token per stack operation that parses back to the operation", func() {
+		op := operation.Start("eksctl create nodegroup", startTime)
+		Expect(op.Command).To(Equal("create-nodegroup"))
+		Expect(op.ID).To(HavePrefix("20220306023000"))
+
+		current, ok := operation.Current()
+		Expect(ok).To(BeTrue())
+		Expect(current).To(Equal(op))
+
+		first, second := *operation.NextToken(), *operation.NextToken()
+		Expect(first).To(Equal("eksctl-" + op.ID + "-1-create-nodegroup"))
+		Expect(second).To(Equal("eksctl-" + op.ID + "-2-create-nodegroup"))
+
+		parsed, ok := operation.ParseToken(second)
+		Expect(ok).To(BeTrue())
+		Expect(parsed).To(Equal(op))
+		Expect(parsed.StartedAt()).To(Equal(startTime))
+	})
+
+	It("keeps tokens within the limits of CloudFormation", func() {
+		operation.Start("eksctl utils "+strings.Repeat("very-long_command ", 10), startTime)
+		token := *operation.NextToken()
+		Expect(len(token)).To(BeNumerically("<=", 128))
+		Expect(token).To(MatchRegexp(`^[a-zA-Z0-9][-a-zA-Z0-9]*[a-zA-Z0-9]$`))
+	})
+
+	It("does not parse tokens of other clients", func() {
+		for _, token := range []string{"Console-CreateStack-7f59c3cf-00d3-40c7-b2ff-e75db0987002", "eksctl-abc-1-create-cluster", ""} {
+			_, ok := operation.ParseToken(token)
+			Expect(ok).To(BeFalse(), token)
+		}
+	})
+})
@@ -0,0 +1,147 @@
+package tasks
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+// FaultInjectionEnvName is the environment variable listing the tasks to fail on purpose, so that the retry and
+// rollback behaviour of tools wrapping eksctl can be tested. It holds comma-separated rules of the form
+// `<substring>[#<n>][=<class>]`, where a rule fails every task whose description contains the substring, or only
+// the n-th of them when n is set. Tasks are numbered in the order they appear in the task tree, so that the same
+// tasks fail on every run even when they run in parallel. The class sets the kind of error returned by the failed
+// task, `error` (the default), `aws` or `timeout`.
+const FaultInjectionEnvName = "EKSCTL_FAULT_INJECTION"
+
+const (
+	faultClassError   = "error"
+	faultClassAWS     = "aws"
+	faultClassTimeout = "timeout"
+)
+
+// InjectedFaultErrorCode is the code of the AWS errors returned by tasks failed with the aws class
+const InjectedFaultErrorCode = "InjectedFault"
+
+type faultRule struct {
+	substring string
+	// n is the number of the matching task to fail, all matching tasks fail if it is 0
+	n     int
+	class string
+
+	matches int
+}
+
+func parseFaultRules(spec string) ([]*faultRule, error) {
+	var rules []*faultRule
+	for _, r := range strings.Split(spec, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		rule := &faultRule{class: faultClassError}
+		if i := strings.LastIndex(r, "="); i >= 0 {
+			rule.class = r[i+1:]
+			r = r[:i]
+			switch rule.class {
+			case faultClassError, faultClassAWS, faultClassTimeout:
+			default:
+				return nil, fmt.Errorf("invalid %s rule %q: class must be one of %s, %s or %s", FaultInjectionEnvName, r, faultClassError, faultClassAWS, faultClassTimeout)
+			}
+		}
+		if i := strings.LastIndex(r, "#"); i >= 0 {
+			n, err := strconv.Atoi(r[i+1:])
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid %s rule %q: the task number must be a positive integer", FaultInjectionEnvName, r)
+			}
+			rule.n = n
+			r = r[:i]
+		}
+		if r == "" {
+			return nil, fmt.Errorf("invalid %s rule: the task description substring must not be empty", FaultInjectionEnvName)
+		}
+		rule.substring = r
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// match counts the task if its description matches the rule and reports whether it must fail
+func (r *faultRule) match(description string) bool {
+	if !strings.Contains(description, r.substring) {
+		return false
+	}
+	r.matches++
+	return r.n == 0 || r.n == r.matches
+}
+
+func (r *faultRule) err(description string) error {
+	msg := fmt.Sprintf("injected failure in task %q (%s)", description, FaultInjectionEnvName)
+	switch r.class {
+	case faultClassAWS:
+		return awserr.New(InjectedFaultErrorCode, msg, nil)
+	case faultClassTimeout:
+		return errors.New("timed out: " + msg)
+	default:
+		return errors.New(msg)
+	}
+}
+
+// injectedFaultTask replaces a task failed on purpose
+type injectedFaultTask struct {
+	description string
+	err         error
+}
+
+func (t *injectedFaultTask) Describe() string { return t.description }
+
+func (t *injectedFaultTask) Do(errs chan error) error {
+	close(errs)
+	return t.err
+}
+
+// injectFaults replaces the tasks of the tree and its sub-trees matching the rules of FaultInjectionEnvName with
+// failing tasks, it only does so once for a tree
+func (t *TaskTree) injectFaults() error {
+	if t.faultsInjected {
+		return nil
+	}
+	spec, ok := os.LookupEnv(FaultInjectionEnvName)
+	if !ok || spec == "" {
+		return nil
+	}
+	rules, err := parseFaultRules(spec)
+	if err != nil {
+		return err
+	}
+	logger.Warning("%s is set, tasks matching %q will fail on purpose", FaultInjectionEnvName, spec)
+	t.injectFaultRules(rules)
+	return nil
+}
+
+func (t *TaskTree) injectFaultRules(rules []*faultRule) {
+	t.faultsInjected = true
+	for i, task := range t.Tasks {
+		if subTree, ok := task.(*TaskTree); ok {
+			subTree.injectFaultRules(rules)
+			continue
+		}
+		description := task.Describe()
+		var err error
+		for _, rule := range rules {
+			// every rule counts the task, even when an earlier rule already fails it
+			if rule.match(description) && err == nil {
+				err = rule.err(description)
+			}
+		}
+		if err != nil {
+			logger.Debug("injecting failure in task %q", description)
+			t.Tasks[i] = &injectedFaultTask{description: description, err: err}
+		}
+	}
+}
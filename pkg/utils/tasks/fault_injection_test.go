@@ -0,0 +1,114 @@
+package tasks
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fault injection", func() {
+	var (
+		mu  sync.Mutex
+		ran []string
+	)
+
+	newTask := func(info string) Task {
+		return &GenericTask{
+			Description: info,
+			Doer: func() error {
+				mu.Lock()
+				defer mu.Unlock()
+				ran = append(ran, info)
+				return nil
+			},
+		}
+	}
+
+	newNodeGroupTasks := func() *TaskTree {
+		nodeGroupTasks := &TaskTree{Parallel: true, IsSubTask: true}
+		for i := 1; i <= 4; i++ {
+			nodeGroupTasks.Append(newTask(fmt.Sprintf("create nodegroup \"ng-%d\"", i)))
+		}
+		taskTree := &TaskTree{}
+		taskTree.Append(newTask("create cluster control plane"), nodeGroupTasks)
+		return taskTree
+	}
+
+	BeforeEach(func() {
+		ran = nil
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv(FaultInjectionEnvName)).To(Succeed())
+	})
+
+	It("runs all tasks when it is not set", func() {
+		Expect(newNodeGroupTasks().DoAllSync()).To(BeEmpty())
+		Expect(ran).To(HaveLen(5))
+	})
+
+	It("fails the n-th matching task", func() {
+		Expect(os.Setenv(FaultInjectionEnvName, "nodegroup#3")).To(Succeed())
+
+		errs := newNodeGroupTasks().DoAllSync()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError(`injected failure in task "create nodegroup \"ng-3\"" (EKSCTL_FAULT_INJECTION)`))
+		Expect(ran).To(ConsistOf("create cluster control plane", `create nodegroup "ng-1"`, `create nodegroup "ng-2"`, `create nodegroup "ng-4"`))
+	})
+
+	It("fails all matching tasks and stops sequential tasks", func() {
+		Expect(os.Setenv(FaultInjectionEnvName, "control plane")).To(Succeed())
+
+		errs := newNodeGroupTasks().DoAllSync()
+		Expect(errs).To(HaveLen(1))
+		Expect(ran).To(BeEmpty())
+	})
+
+	It("fails tasks with AWS and timeout errors", func() {
+		Expect(os.Setenv(FaultInjectionEnvName, "ng-1=aws, ng-2=timeout")).To(Succeed())
+
+		taskTree := &TaskTree{Parallel: true}
+		taskTree.Append(newTask(`create nodegroup "ng-1"`), newTask(`create nodegroup "ng-2"`))
+		errs := taskTree.DoAllSync()
+		Expect(errs).To(HaveLen(2))
+		var awsErrs, timeoutErrs int
+		for _, err := range errs {
+			if awsErr, ok := err.(awserr.Error); ok {
+				Expect(awsErr.Code()).To(Equal(InjectedFaultErrorCode))
+				awsErrs++
+			} else {
+				Expect(err.Error()).To(HavePrefix("timed out"))
+				timeoutErrs++
+			}
+		}
+		Expect(awsErrs).To(Equal(1))
+		Expect(timeoutErrs).To(Equal(1))
+	})
+
+	It("fails the task returned by Do", func() {
+		Expect(os.Setenv(FaultInjectionEnvName, "nodegroup#1")).To(Succeed())
+
+		errs := make(chan error)
+		Expect(newNodeGroupTasks().Do(errs)).To(Succeed())
+		var allErrs []error
+		for err := range errs {
+			allErrs = append(allErrs, err)
+		}
+		Expect(allErrs).To(HaveLen(1))
+		Expect(allErrs[0]).To(MatchError(ContainSubstring(`"create nodegroup \"ng-1\""`)))
+	})
+
+	It("returns an error for invalid rules", func() {
+		for _, spec := range []string{"nodegroup#0", "nodegroup#third", "#2", "nodegroup=panic"} {
+			Expect(os.Setenv(FaultInjectionEnvName, spec)).To(Succeed())
+			errs := newNodeGroupTasks().DoAllSync()
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(MatchError(ContainSubstring("invalid EKSCTL_FAULT_INJECTION rule")))
+			Expect(ran).To(BeEmpty())
+		}
+	})
+})
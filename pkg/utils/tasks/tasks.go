@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/logging"
 )
 
 // Task is a common interface for the stack manager tasks
@@ -43,10 +45,17 @@ func (t SynchronousTask) Do(errCh chan error) error {
 
 // TaskTree wraps a set of tasks
 type TaskTree struct {
-	Tasks     []Task
-	Parallel  bool
+	Tasks    []Task
+	Parallel bool
+	// Limit is the maximum number of tasks running at the same time in the tree and all of its sub-trees, there is
+	// no limit if it is 0
+	Limit     int
 	PlanMode  bool
 	IsSubTask bool
+
+	faultsInjected bool
+	// running is shared by the tree and its sub-trees, and holds a slot for each task running under the limit
+	running chan struct{}
 }
 
 // Append new tasks to the set
@@ -54,6 +63,32 @@ func (t *TaskTree) Append(newTasks ...Task) {
 	t.Tasks = append(t.Tasks, newTasks...)
 }
 
+// SetLimit limits the number of tasks running at the same time in the tree and all of its sub-trees together
+func (t *TaskTree) SetLimit(limit int) {
+	var running chan struct{}
+	if limit > 0 {
+		running = make(chan struct{}, limit)
+	}
+	t.setLimit(limit, running)
+}
+
+func (t *TaskTree) setLimit(limit int, running chan struct{}) {
+	t.Limit = limit
+	t.running = running
+	for _, task := range t.Tasks {
+		if subTree, ok := task.(*TaskTree); ok {
+			subTree.setLimit(limit, running)
+		}
+	}
+}
+
+func (t *TaskTree) limiter() chan struct{} {
+	if t.Limit > 0 && t.running == nil {
+		t.SetLimit(t.Limit)
+	}
+	return t.running
+}
+
 // Len returns number of tasks in the set
 func (t *TaskTree) Len() int {
 	if t == nil {
@@ -141,12 +176,18 @@ func (t *TaskTree) Do(allErrs chan error) error {
 		return nil
 	}
 
+	if err := t.injectFaults(); err != nil {
+		close(allErrs)
+		return err
+	}
+
 	errs := make(chan error)
 
+	running := t.limiter()
 	if t.Parallel {
-		go doParallelTasks(errs, t.Tasks)
+		go doParallelTasks(errs, t.Tasks, running)
 	} else {
-		go doSequentialTasks(errs, t.Tasks)
+		go doSequentialTasks(errs, t.Tasks, running)
 	}
 
 	go func() {
@@ -167,12 +208,17 @@ func (t *TaskTree) DoAllSync() []error {
 		return nil
 	}
 
+	if err := t.injectFaults(); err != nil {
+		return []error{err}
+	}
+
 	errs := make(chan error)
 
+	running := t.limiter()
 	if t.Parallel {
-		go doParallelTasks(errs, t.Tasks)
+		go doParallelTasks(errs, t.Tasks, running)
 	} else {
-		go doSequentialTasks(errs, t.Tasks)
+		go doSequentialTasks(errs, t.Tasks, running)
 	}
 
 	allErrs := []error{}
@@ -182,29 +228,43 @@ func (t *TaskTree) DoAllSync() []error {
 	return allErrs
 }
 
-func doSingleTask(allErrs chan error, task Task) bool {
+// doSingleTask runs the task, holding a slot of running while it runs unless it is a tree, whose own tasks hold them
+func doSingleTask(allErrs chan error, task Task, running chan struct{}) bool {
+	if _, ok := task.(*TaskTree); !ok && running != nil {
+		running <- struct{}{}
+		defer func() { <-running }()
+	}
 	desc := task.Describe()
-	logger.Debug("started task: %s", desc)
+	taskLogger := logging.StartTask()
+	taskLogger.Debug("started task %s: %s", taskLogger.ID, desc)
+	// only the tasks of trees are rendered, as trees are described by all of their tasks
+	trackDone := func(error) {}
+	if _, ok := task.(*TaskTree); !ok {
+		trackDone = logging.TrackTask(desc)
+	}
 	errs := make(chan error)
 	if err := task.Do(errs); err != nil {
+		trackDone(err)
 		allErrs <- err
 		return false
 	}
 	if err := <-errs; err != nil {
+		trackDone(err)
 		allErrs <- err
 		return false
 	}
-	logger.Debug("completed task: %s", desc)
+	trackDone(nil)
+	taskLogger.Debug("completed task: %s", desc)
 	return true
 }
 
-func doParallelTasks(allErrs chan error, tasks []Task) {
+func doParallelTasks(allErrs chan error, tasks []Task, running chan struct{}) {
 	wg := &sync.WaitGroup{}
 	wg.Add(len(tasks))
 	for t := range tasks {
 		go func(t int) {
 			defer wg.Done()
-			if ok := doSingleTask(allErrs, tasks[t]); !ok {
+			if ok := doSingleTask(allErrs, tasks[t], running); !ok {
 				logger.Debug("failed task: %s (will continue until other parallel tasks are completed)", tasks[t].Describe())
 			}
 		}(t)
@@ -214,9 +274,9 @@ func doParallelTasks(allErrs chan error, tasks []Task) {
 	close(allErrs)
 }
 
-func doSequentialTasks(allErrs chan error, tasks []Task) {
+func doSequentialTasks(allErrs chan error, tasks []Task, running chan struct{}) {
 	for t := range tasks {
-		if ok := doSingleTask(allErrs, tasks[t]); !ok {
+		if ok := doSingleTask(allErrs, tasks[t], running); !ok {
 			logger.Debug("failed task: %s (will not run other sequential tasks)", tasks[t].Describe())
 			break
 		}
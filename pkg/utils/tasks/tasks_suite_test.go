@@ -0,0 +1,13 @@
+package tasks
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTasks(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tasks Suite")
+}
@@ -621,5 +621,43 @@ var _ = Describe("TaskTree", func() {
 				Expect(errs[0].Error()).To(Equal("t1.3 always fails"))
 			}
 		})
+
+		It("should not run more parallel tasks than the limit across all sub-trees", func() {
+			var running, maxRunning, completed int32
+
+			newTask := func(info string) Task {
+				return &GenericTask{
+					Description: info,
+					Doer: func() error {
+						n := atomic.AddInt32(&running, 1)
+						for {
+							m := atomic.LoadInt32(&maxRunning)
+							if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+								break
+							}
+						}
+						time.Sleep(20 * time.Millisecond)
+						atomic.AddInt32(&running, -1)
+						atomic.AddInt32(&completed, 1)
+						return nil
+					},
+				}
+			}
+
+			tasks := &TaskTree{Parallel: true}
+			for i := 1; i <= 3; i++ {
+				subTask := &TaskTree{Parallel: true, IsSubTask: true}
+				for j := 0; j < 4; j++ {
+					subTask.Append(newTask(fmt.Sprintf("t%d.%d", i, j)))
+				}
+				tasks.Append(subTask)
+			}
+			tasks.SetLimit(2)
+			Expect(tasks.Tasks[0].(*TaskTree).Limit).To(Equal(2))
+
+			Expect(tasks.DoAllSync()).To(HaveLen(0))
+			Expect(atomic.LoadInt32(&completed)).To(Equal(int32(12)))
+			Expect(atomic.LoadInt32(&maxRunning)).To(Equal(int32(2)))
+		})
 	})
 })
@@ -85,23 +85,22 @@ func MakeErrorCodeAcceptors(errorCode string) []request.WaiterAcceptor {
 	}
 }
 
-// makeWaiterDelay returns delay ranging between 15s and 20s
+// makeWaiterDelay returns a delay that starts at 5s and doubles with each attempt up to 15s, plus up to 5s of
+// jitter, so that the many stacks waited for at the same time, e.g. when deleting the nodegroups of a cluster,
+// are not all polled at once and hit the API rate limits
 func makeWaiterDelay() request.WaiterDelay {
 	const (
-		base        = 15 * time.Second
-		offsetSteps = 200
-		offsetMax   = 5000
-		stepMult    = offsetMax / offsetSteps
+		base      = 5 * time.Second
+		maxDelay  = 15 * time.Second
+		maxJitter = 5 * time.Second
 	)
 
-	offsets := rand.Perm(offsetSteps)
-
 	return func(attempt int) time.Duration {
-		s := rand.Intn(offsetSteps)
-		d := stepMult * offsets[s]
-
-		offset := time.Duration(d) * time.Millisecond
-
-		return base + offset
+		// attempt is the number of times the status has been checked already
+		d := maxDelay
+		if attempt >= 1 && attempt <= 2 {
+			d = base << (attempt - 1)
+		}
+		return d + time.Duration(rand.Int63n(int64(maxJitter)))
 	}
 }
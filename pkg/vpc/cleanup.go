@@ -13,7 +13,8 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
 
-func fmtSecurityGroupNameRegexForCluster(name string) string {
+// FmtSecurityGroupNameRegexForCluster returns the regex matching the names of the security groups eksctl creates for a cluster
+func FmtSecurityGroupNameRegexForCluster(name string) string {
 	const ourSecurityGroupNameRegexFmt = "^eksctl-%s-(cluster|nodegroup)-.+$"
 	return fmt.Sprintf(ourSecurityGroupNameRegexFmt, name)
 }
@@ -32,7 +33,7 @@ func findDanglingENIs(ec2API ec2iface.EC2API, spec *api.ClusterConfig) ([]string
 		},
 	}
 
-	securityGroupRE, err := regexp.Compile(fmtSecurityGroupNameRegexForCluster(spec.Metadata.Name))
+	securityGroupRE, err := regexp.Compile(FmtSecurityGroupNameRegexForCluster(spec.Metadata.Name))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create security group regex")
 	}
@@ -0,0 +1,201 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// anyIPv4 is the destination of the egress rules required towards the nodes before their security group exists
+const anyIPv4 = "0.0.0.0/0"
+
+// securityGroupRule is an ingress or egress rule required between the existing security groups of a cluster.
+// The peer of the rule is either a security group or a CIDR
+type securityGroupRule struct {
+	groupID     string
+	egress      bool
+	peerGroupID string
+	peerCIDR    string
+	protocol    string
+	fromPort    int64
+	toPort      int64
+	description string
+}
+
+func (r securityGroupRule) String() string {
+	ports := "all traffic"
+	if r.protocol != "-1" {
+		ports = fmt.Sprintf("%s %d-%d", r.protocol, r.fromPort, r.toPort)
+	}
+	direction := "ingress %s from %s"
+	if r.egress {
+		direction = "egress %s to %s"
+	}
+	peer := r.peerGroupID
+	if peer == "" {
+		peer = r.peerCIDR
+	}
+	return fmt.Sprintf("%s: "+direction+" (%s)", r.groupID, ports, peer, r.description)
+}
+
+// allowedBy returns whether the permission allows the traffic of the rule. Traffic with a security group is also
+// allowed by a permission for all IPv4 addresses
+func (r securityGroupRule) allowedBy(p *ec2.IpPermission) bool {
+	protocol := aws.StringValue(p.IpProtocol)
+	if protocol != "-1" && (protocol != r.protocol || aws.Int64Value(p.FromPort) > r.fromPort || aws.Int64Value(p.ToPort) < r.toPort) {
+		return false
+	}
+	for _, pair := range p.UserIdGroupPairs {
+		if r.peerGroupID != "" && aws.StringValue(pair.GroupId) == r.peerGroupID {
+			return true
+		}
+	}
+	for _, ipRange := range p.IpRanges {
+		if cidr := aws.StringValue(ipRange.CidrIp); cidr == anyIPv4 || cidr == r.peerCIDR {
+			return true
+		}
+	}
+	return false
+}
+
+func (r securityGroupRule) ipPermission() *ec2.IpPermission {
+	p := &ec2.IpPermission{
+		IpProtocol: aws.String(r.protocol),
+	}
+	if r.peerGroupID != "" {
+		p.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
+			GroupId:     aws.String(r.peerGroupID),
+			Description: aws.String(r.description),
+		}}
+	} else {
+		p.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(r.peerCIDR),
+			Description: aws.String(r.description),
+		}}
+	}
+	if r.protocol != "-1" {
+		p.FromPort = aws.Int64(r.fromPort)
+		p.ToPort = aws.Int64(r.toPort)
+	}
+	return p
+}
+
+// requiredSecurityGroupRules returns the rules vpc.sharedNodeSecurityGroup and vpc.securityGroup need for nodes
+// to resolve DNS names and the control plane to reach kubelets and webhooks, validating each security group that
+// is set independently. The ingress rules between the control plane and the nodes are only required when eksctl
+// does not manage the rules of the shared node security group
+func requiredSecurityGroupRules(spec *api.ClusterConfig) []securityGroupRule {
+	nodeSG, controlPlaneSG := spec.VPC.SharedNodeSecurityGroup, spec.VPC.SecurityGroup
+	var rules []securityGroupRule
+	if nodeSG != "" {
+		rules = append(rules,
+			securityGroupRule{groupID: nodeSG, peerGroupID: nodeSG, protocol: "-1", description: "Allow nodes to communicate with each other (all ports)"},
+		)
+	}
+	if nodeSG != "" && controlPlaneSG != "" && api.IsDisabled(spec.VPC.ManageSharedNodeSecurityGroupRules) {
+		rules = append(rules,
+			securityGroupRule{groupID: controlPlaneSG, peerGroupID: nodeSG, protocol: "tcp", fromPort: 443, toPort: 443, description: "Allow nodes to communicate with the API server"},
+			securityGroupRule{groupID: nodeSG, peerGroupID: controlPlaneSG, protocol: "tcp", fromPort: 443, toPort: 443, description: "Allow the control plane to communicate with webhooks"},
+			securityGroupRule{groupID: nodeSG, peerGroupID: controlPlaneSG, protocol: "tcp", fromPort: 1025, toPort: 65535, description: "Allow the control plane to communicate with kubelets and webhooks"},
+		)
+	}
+	if controlPlaneSG != "" {
+		// the security groups of the nodes are created with the cluster or the nodegroups when no shared node
+		// security group is set, so the control plane must be able to reach any address on these ports
+		peerGroupID, peerCIDR := nodeSG, ""
+		if nodeSG == "" {
+			peerCIDR = anyIPv4
+		}
+		rules = append(rules,
+			securityGroupRule{groupID: controlPlaneSG, egress: true, peerGroupID: peerGroupID, peerCIDR: peerCIDR, protocol: "tcp", fromPort: 443, toPort: 443, description: "Allow the control plane to communicate with webhooks"},
+			securityGroupRule{groupID: controlPlaneSG, egress: true, peerGroupID: peerGroupID, peerCIDR: peerCIDR, protocol: "tcp", fromPort: 1025, toPort: 65535, description: "Allow the control plane to communicate with kubelets and webhooks"},
+		)
+	}
+	return rules
+}
+
+// ValidateSecurityGroupRules checks that the existing security groups set in vpc.sharedNodeSecurityGroup and
+// vpc.securityGroup have the rules required within the cluster. When fixRules is set, the missing rules
+// are added, otherwise an error listing them is returned
+func ValidateSecurityGroupRules(ec2API ec2iface.EC2API, spec *api.ClusterConfig, fixRules bool) error {
+	rules := requiredSecurityGroupRules(spec)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var groupIDs []string
+	for _, groupID := range []string{spec.VPC.SharedNodeSecurityGroup, spec.VPC.SecurityGroup} {
+		if groupID != "" && (len(groupIDs) == 0 || groupIDs[0] != groupID) {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+	output, err := ec2API.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice(groupIDs),
+	})
+	if err != nil {
+		return errors.Wrap(err, "describing security groups")
+	}
+	ingress, egress := map[string][]*ec2.IpPermission{}, map[string][]*ec2.IpPermission{}
+	for _, sg := range output.SecurityGroups {
+		ingress[aws.StringValue(sg.GroupId)] = sg.IpPermissions
+		egress[aws.StringValue(sg.GroupId)] = sg.IpPermissionsEgress
+	}
+
+	var missing []securityGroupRule
+	for _, rule := range rules {
+		permissions := ingress[rule.groupID]
+		if rule.egress {
+			permissions = egress[rule.groupID]
+		}
+		if !hasPermission(permissions, rule) {
+			missing = append(missing, rule)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if !fixRules {
+		var lines []string
+		for _, rule := range missing {
+			lines = append(lines, "- "+rule.String())
+		}
+		return fmt.Errorf("existing security groups are missing rules required by the cluster, without which DNS resolution and webhooks fail:\n%s\nadd the rules, or use --fix-rules to have eksctl add them", strings.Join(lines, "\n"))
+	}
+
+	for _, rule := range missing {
+		logger.Info("adding security group rule %s", rule)
+		if rule.egress {
+			if _, err := ec2API.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{
+				GroupId:       aws.String(rule.groupID),
+				IpPermissions: []*ec2.IpPermission{rule.ipPermission()},
+			}); err != nil {
+				return errors.Wrapf(err, "adding egress rule to security group %q", rule.groupID)
+			}
+			continue
+		}
+		if _, err := ec2API.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(rule.groupID),
+			IpPermissions: []*ec2.IpPermission{rule.ipPermission()},
+		}); err != nil {
+			return errors.Wrapf(err, "adding ingress rule to security group %q", rule.groupID)
+		}
+	}
+	return nil
+}
+
+func hasPermission(permissions []*ec2.IpPermission, rule securityGroupRule) bool {
+	for _, p := range permissions {
+		if rule.allowedBy(p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package vpc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ValidateSecurityGroupRules", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	selfRule := &ec2.IpPermission{
+		IpProtocol:       aws.String("-1"),
+		UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-nodes")}},
+	}
+
+	mockSecurityGroups := func(sgs ...*ec2.SecurityGroup) {
+		p.MockEC2().On("DescribeSecurityGroups", mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: sgs}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.VPC.SharedNodeSecurityGroup = "sg-nodes"
+	})
+
+	It("does nothing without existing security groups", func() {
+		cfg.VPC.SharedNodeSecurityGroup = ""
+		Expect(ValidateSecurityGroupRules(p.EC2(), cfg, false)).To(Succeed())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeSecurityGroups", mock.Anything)
+	})
+
+	It("accepts a shared node security group allowing traffic between nodes", func() {
+		mockSecurityGroups(&ec2.SecurityGroup{GroupId: aws.String("sg-nodes"), IpPermissions: []*ec2.IpPermission{selfRule}})
+		Expect(ValidateSecurityGroupRules(p.EC2(), cfg, false)).To(Succeed())
+	})
+
+	It("returns the missing rules", func() {
+		cfg.VPC.SecurityGroup = "sg-control-plane"
+		cfg.VPC.ManageSharedNodeSecurityGroupRules = api.Disabled()
+		mockSecurityGroups(
+			&ec2.SecurityGroup{GroupId: aws.String("sg-nodes"), IpPermissions: []*ec2.IpPermission{
+				{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int64(53),
+					ToPort:           aws.Int64(53),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-nodes")}},
+				},
+				{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int64(0),
+					ToPort:           aws.Int64(65535),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-control-plane")}},
+				},
+			}},
+			&ec2.SecurityGroup{GroupId: aws.String("sg-control-plane"), IpPermissionsEgress: []*ec2.IpPermission{
+				{
+					IpProtocol: aws.String("-1"),
+					IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+				},
+			}},
+		)
+
+		err := ValidateSecurityGroupRules(p.EC2(), cfg, false)
+		Expect(err).To(MatchError(ContainSubstring("- sg-nodes: ingress all traffic from sg-nodes (Allow nodes to communicate with each other (all ports))\n" +
+			"- sg-control-plane: ingress tcp 443-443 from sg-nodes (Allow nodes to communicate with the API server)\n" +
+			"add the rules, or use --fix-rules")))
+		p.MockEC2().AssertNotCalled(GinkgoT(), "AuthorizeSecurityGroupIngress", mock.Anything)
+	})
+
+	It("adds the missing rules with fixRules", func() {
+		mockSecurityGroups(&ec2.SecurityGroup{GroupId: aws.String("sg-nodes")})
+		p.MockEC2().On("AuthorizeSecurityGroupIngress", mock.Anything).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+
+		Expect(ValidateSecurityGroupRules(p.EC2(), cfg, true)).To(Succeed())
+		p.MockEC2().AssertCalled(GinkgoT(), "AuthorizeSecurityGroupIngress", &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: aws.String("sg-nodes"),
+			IpPermissions: []*ec2.IpPermission{{
+				IpProtocol: aws.String("-1"),
+				UserIdGroupPairs: []*ec2.UserIdGroupPair{{
+					GroupId:     aws.String("sg-nodes"),
+					Description: aws.String("Allow nodes to communicate with each other (all ports)"),
+				}},
+			}},
+		})
+	})
+
+	It("validates the control plane security group without a shared node security group", func() {
+		cfg.VPC.SharedNodeSecurityGroup = ""
+		cfg.VPC.SecurityGroup = "sg-control-plane"
+		mockSecurityGroups(&ec2.SecurityGroup{GroupId: aws.String("sg-control-plane"), IpPermissionsEgress: []*ec2.IpPermission{
+			{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+			},
+		}})
+
+		err := ValidateSecurityGroupRules(p.EC2(), cfg, false)
+		Expect(err).To(MatchError(ContainSubstring("- sg-control-plane: egress tcp 1025-65535 to 0.0.0.0/0 (Allow the control plane to communicate with kubelets and webhooks)\n" +
+			"add the rules, or use --fix-rules")))
+		Expect(err.Error()).NotTo(ContainSubstring("egress tcp 443-443"))
+		p.MockEC2().AssertCalled(GinkgoT(), "DescribeSecurityGroups", &ec2.DescribeSecurityGroupsInput{
+			GroupIds: aws.StringSlice([]string{"sg-control-plane"}),
+		})
+	})
+
+	It("adds the missing egress rules of the control plane security group with fixRules", func() {
+		cfg.VPC.SecurityGroup = "sg-control-plane"
+		mockSecurityGroups(
+			&ec2.SecurityGroup{GroupId: aws.String("sg-nodes"), IpPermissions: []*ec2.IpPermission{selfRule}},
+			&ec2.SecurityGroup{GroupId: aws.String("sg-control-plane"), IpPermissionsEgress: []*ec2.IpPermission{
+				{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int64(443),
+					ToPort:           aws.Int64(443),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-nodes")}},
+				},
+			}},
+		)
+		p.MockEC2().On("AuthorizeSecurityGroupEgress", mock.Anything).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+
+		Expect(ValidateSecurityGroupRules(p.EC2(), cfg, true)).To(Succeed())
+		p.MockEC2().AssertNumberOfCalls(GinkgoT(), "AuthorizeSecurityGroupEgress", 1)
+		p.MockEC2().AssertCalled(GinkgoT(), "AuthorizeSecurityGroupEgress", &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId: aws.String("sg-control-plane"),
+			IpPermissions: []*ec2.IpPermission{{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(1025),
+				ToPort:     aws.Int64(65535),
+				UserIdGroupPairs: []*ec2.UserIdGroupPair{{
+					GroupId:     aws.String("sg-nodes"),
+					Description: aws.String("Allow the control plane to communicate with kubelets and webhooks"),
+				}},
+			}},
+		})
+	})
+})
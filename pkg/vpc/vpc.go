@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -58,9 +59,18 @@ func SetSubnets(vpc *api.ClusterVPC, availabilityZones []string) error {
 		return fmt.Errorf("cannot create more than 16 subnets, %d requested", subnetsTotal)
 	}
 
+	privateCIDRs := zoneCIDRs[zonesTotal:]
+	if len(vpc.SecondaryCIDRs) > 0 {
+		// carve the private subnets from the first secondary CIDR, leaving the primary one to the public subnets
+		privateCIDRs, err = splitSecondaryCIDR(vpc.SecondaryCIDRs[0], zonesTotal)
+		if err != nil {
+			return err
+		}
+	}
+
 	for i, zone := range availabilityZones {
 		public := zoneCIDRs[i]
-		private := zoneCIDRs[i+zonesTotal]
+		private := privateCIDRs[i]
 		vpc.Subnets.Private.SetAZ(zone, api.Network{
 			CIDR: &ipnet.IPNet{IPNet: *private},
 		})
@@ -73,6 +83,57 @@ func SetSubnets(vpc *api.ClusterVPC, availabilityZones []string) error {
 	return nil
 }
 
+// splitSecondaryCIDR splits a secondary CIDR of the VPC into the smallest power of two of subnets covering the
+// availability zones, so that the private subnets get as many addresses as possible
+func splitSecondaryCIDR(cidr string, zones int) ([]*net.IPNet, error) {
+	_, parent, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	ip4 := parent.IP.To4()
+	if ip4 == nil {
+		return nil, fmt.Errorf("Unexpected IP address type: %s", parent)
+	}
+	bits := 0
+	for 1<<bits < zones {
+		bits++
+	}
+	prefix, size := parent.Mask.Size()
+	if prefix+bits > size {
+		return nil, fmt.Errorf("secondary VPC CIDR %s is too small for %d subnets", cidr, zones)
+	}
+
+	var subnets []*net.IPNet
+	for i := 0; i < 1<<bits; i++ {
+		n := binary.BigEndian.Uint32(ip4) + uint32(i)<<uint(size-prefix-bits)
+		subnetIP := make(net.IP, len(ip4))
+		binary.BigEndian.PutUint32(subnetIP, n)
+		subnets = append(subnets, &net.IPNet{
+			IP:   subnetIP,
+			Mask: net.CIDRMask(prefix+bits, size),
+		})
+	}
+	logger.Debug("secondary VPC CIDR (%s) was divided into %d subnets %v", cidr, len(subnets), subnets)
+	return subnets, nil
+}
+
+// UseSubnetsFromSpec sets the availability zones of the VPC eksctl creates to those of the subnets of the config
+// file, which eksctl creates in vpc.cidr or vpc.secondaryCIDRs
+func UseSubnetsFromSpec(spec *api.ClusterConfig) error {
+	zones := map[string]struct{}{}
+	for _, subnets := range []api.AZSubnetMapping{spec.VPC.Subnets.Private, spec.VPC.Subnets.Public} {
+		for _, subnet := range subnets {
+			zones[subnet.AZ] = struct{}{}
+		}
+	}
+	spec.AvailabilityZones = nil
+	for zone := range zones {
+		spec.AvailabilityZones = append(spec.AvailabilityZones, zone)
+	}
+	sort.Strings(spec.AvailabilityZones)
+	return spec.HasSufficientSubnets()
+}
+
 func SplitInto16(parent *net.IPNet) ([]*net.IPNet, error) {
 	networkLength, _ := parent.Mask.Size()
 	networkLength += 4
@@ -254,6 +315,58 @@ func UseFromClusterStack(provider api.ClusterProvider, stack *cfn.Stack, spec *a
 	return outputs.Collect(*stack, requiredCollectors, optionalCollectors)
 }
 
+// UseFromStack imports the VPC and subnets of vpc.cloudFormation, reading their IDs from
+// the outputs of the referenced stack
+// NOTE: it respects all fields set in spec.VPC, like ImportSubnetsFromSpec
+func UseFromStack(provider api.ClusterProvider, spec *api.ClusterConfig) error {
+	stackRef := spec.VPC.CloudFormation
+	output, err := provider.CloudFormation().DescribeStacks(&cfn.DescribeStacksInput{
+		StackName: aws.String(stackRef.StackName),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing VPC stack %q", stackRef.StackName)
+	}
+	if len(output.Stacks) == 0 {
+		return fmt.Errorf("VPC stack %q not found", stackRef.StackName)
+	}
+	stack := output.Stacks[0]
+
+	importSubnets := func(topology api.SubnetTopology) outputs.Collector {
+		return func(v string) error {
+			var subnetIDs []string
+			for _, id := range strings.Split(v, ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					subnetIDs = append(subnetIDs, id)
+				}
+			}
+			return ImportSubnetsFromIDList(provider.EC2(), spec, topology, subnetIDs)
+		}
+	}
+
+	requiredCollectors := map[string]outputs.Collector{
+		stackRef.Outputs.VPC: func(v string) error {
+			return importVPC(provider.EC2(), spec, v)
+		},
+	}
+	optionalCollectors := map[string]outputs.Collector{
+		stackRef.Outputs.PrivateSubnets: importSubnets(api.SubnetTopologyPrivate),
+		stackRef.Outputs.PublicSubnets:  importSubnets(api.SubnetTopologyPublic),
+	}
+
+	// the VPC is imported first, for the subnets to be validated against it
+	if err := outputs.NewCollectorSet(requiredCollectors).MustCollect(*stack); err != nil {
+		return err
+	}
+	if !outputs.Exists(*stack, stackRef.Outputs.PrivateSubnets) && !outputs.Exists(*stack, stackRef.Outputs.PublicSubnets) {
+		return fmt.Errorf("VPC stack %q has neither output %q nor %q", stackRef.StackName, stackRef.Outputs.PrivateSubnets, stackRef.Outputs.PublicSubnets)
+	}
+	if err := outputs.Collect(*stack, nil, optionalCollectors); err != nil {
+		return err
+	}
+	cleanupSubnets(spec)
+	return nil
+}
+
 // importVPC will update spec with VPC ID/CIDR
 // NOTE: it does respect all fields set in spec.VPC, and will error if
 // there is a mismatch of local vs remote states
@@ -316,7 +429,7 @@ func ImportSubnets(ec2API ec2iface.EC2API, spec *api.ClusterConfig, topology api
 			return fmt.Errorf("given %s is in %s, not in %s", *sn.SubnetId, *sn.VpcId, spec.VPC.ID)
 		}
 
-		if err := spec.ImportSubnet(topology, *sn.AvailabilityZone, *sn.SubnetId, *sn.CidrBlock); err != nil {
+		if err := spec.ImportSubnet(topology, *sn.AvailabilityZone, *sn.SubnetId, aws.StringValue(sn.CidrBlock)); err != nil {
 			return err
 		}
 		spec.AppendAvailabilityZone(*sn.AvailabilityZone)
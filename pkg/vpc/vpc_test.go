@@ -215,8 +215,56 @@ var _ = Describe("VPC", func() {
 			vpc:               api.NewClusterVPC(),
 			availabilityZones: []string{"1", "2", "3"},
 		}),
+		Entry("VPC with an invalid secondary CIDR", setSubnetsCase{
+			vpc: func() *api.ClusterVPC {
+				vpc := api.NewClusterVPC()
+				vpc.SecondaryCIDRs = []string{"100.64.0.0"}
+				return vpc
+			}(),
+			availabilityZones: []string{"1", "2"},
+			error:             fmt.Errorf("invalid CIDR address: 100.64.0.0"),
+		}),
 	)
 
+	It("carves the private subnets from the first secondary CIDR", func() {
+		vpc := api.NewClusterVPC()
+		vpc.SecondaryCIDRs = []string{"100.64.0.0/16", "100.65.0.0/16"}
+		Expect(SetSubnets(vpc, []string{"az-1", "az-2", "az-3"})).To(Succeed())
+
+		Expect(vpc.Subnets.Public["az-1"].CIDR.String()).To(Equal("192.168.0.0/19"))
+		Expect(vpc.Subnets.Public["az-3"].CIDR.String()).To(Equal("192.168.64.0/19"))
+		Expect(vpc.Subnets.Private["az-1"].CIDR.String()).To(Equal("100.64.0.0/18"))
+		Expect(vpc.Subnets.Private["az-2"].CIDR.String()).To(Equal("100.64.64.0/18"))
+		Expect(vpc.Subnets.Private["az-3"].CIDR.String()).To(Equal("100.64.128.0/18"))
+	})
+
+	It("sizes the private subnets of the secondary CIDR by the number of availability zones", func() {
+		vpc := api.NewClusterVPC()
+		vpc.SecondaryCIDRs = []string{"100.64.0.0/16"}
+		Expect(SetSubnets(vpc, []string{"az-1", "az-2"})).To(Succeed())
+
+		Expect(vpc.Subnets.Private["az-1"].CIDR.String()).To(Equal("100.64.0.0/17"))
+		Expect(vpc.Subnets.Private["az-2"].CIDR.String()).To(Equal("100.64.128.0/17"))
+	})
+
+	It("uses the availability zones of the subnets eksctl creates in the secondary CIDRs", func() {
+		cfg := api.NewClusterConfig()
+		cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/16"}
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"az-2": {CIDR: ipnet.MustParseCIDR("100.64.128.0/17")},
+				"az-1": {CIDR: ipnet.MustParseCIDR("100.64.0.0/17")},
+			}),
+			Public: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"az-1": {CIDR: ipnet.MustParseCIDR("192.168.0.0/24")},
+				"az-2": {CIDR: ipnet.MustParseCIDR("192.168.1.0/24")},
+			}),
+		}
+		Expect(UseSubnetsFromSpec(cfg)).To(Succeed())
+
+		Expect(cfg.AvailabilityZones).To(Equal([]string{"az-1", "az-2"}))
+	})
+
 	DescribeTable("Use from Cluster",
 		func(clusterCase useFromClusterCase) {
 			p := mockprovider.NewMockProvider()
@@ -1031,4 +1079,93 @@ var _ = Describe("VPC", func() {
 			})
 		})
 	})
+
+	Describe("UseFromStack", func() {
+		var (
+			p   *mockprovider.MockProvider
+			cfg *api.ClusterConfig
+		)
+
+		mockStackOutputs := func(stackOutputs map[string]string) {
+			stack := &cfn.Stack{StackName: aws.String("network")}
+			for key, value := range stackOutputs {
+				stack.Outputs = append(stack.Outputs, &cfn.Output{OutputKey: aws.String(key), OutputValue: aws.String(value)})
+			}
+			p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: aws.String("network")}).
+				Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{stack}}, nil)
+		}
+
+		subnets := map[string]*ec2.Subnet{
+			"private1": {AvailabilityZone: aws.String("az1"), CidrBlock: aws.String("192.168.0.0/20")},
+			"private2": {AvailabilityZone: aws.String("az2"), CidrBlock: aws.String("192.168.16.0/20")},
+			"public1":  {AvailabilityZone: aws.String("az1"), CidrBlock: aws.String("192.168.32.0/20")},
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cfg = api.NewClusterConfig()
+			cfg.VPC.CIDR = nil
+			cfg.VPC.CloudFormation = &api.VPCCloudFormation{StackName: "network"}
+			api.SetClusterConfigDefaults(cfg)
+
+			p.MockEC2().On("DescribeVpcs", &ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc1"})}).
+				Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{
+					CidrBlock: aws.String("192.168.0.0/16"),
+					VpcId:     aws.String("vpc1"),
+				}}}, nil)
+			p.MockEC2().On("DescribeSubnets", Anything).Return(func(input *ec2.DescribeSubnetsInput) *ec2.DescribeSubnetsOutput {
+				output := &ec2.DescribeSubnetsOutput{}
+				for _, id := range aws.StringValueSlice(input.SubnetIds) {
+					subnet := *subnets[id]
+					subnet.SubnetId = aws.String(id)
+					subnet.VpcId = aws.String("vpc1")
+					output.Subnets = append(output.Subnets, &subnet)
+				}
+				return output
+			}, nil)
+		})
+
+		It("imports the VPC and subnets from the default outputs", func() {
+			mockStackOutputs(map[string]string{
+				"VPC":            "vpc1",
+				"SubnetsPrivate": "private1,private2",
+			})
+
+			Expect(UseFromStack(p, cfg)).To(Succeed())
+			Expect(cfg.VPC.ID).To(Equal("vpc1"))
+			Expect(cfg.VPC.CIDR.String()).To(Equal("192.168.0.0/16"))
+			Expect(cfg.VPC.Subnets.Private).To(HaveLen(2))
+			Expect(cfg.VPC.Subnets.Private["az2"].ID).To(Equal("private2"))
+			Expect(cfg.VPC.Subnets.Public).To(BeEmpty())
+			Expect(cfg.AvailabilityZones).To(ConsistOf("az1", "az2"))
+		})
+
+		It("reads the IDs from the mapped outputs", func() {
+			cfg.VPC.CloudFormation.Outputs = &api.VPCStackOutputs{
+				VPC:            "NetworkVpcId",
+				PrivateSubnets: "AppSubnetIds",
+				PublicSubnets:  "DmzSubnetIds",
+			}
+			mockStackOutputs(map[string]string{
+				"NetworkVpcId": "vpc1",
+				"AppSubnetIds": "private1, private2",
+				"DmzSubnetIds": "public1",
+			})
+
+			Expect(UseFromStack(p, cfg)).To(Succeed())
+			Expect(cfg.VPC.ID).To(Equal("vpc1"))
+			Expect(cfg.VPC.Subnets.Private).To(HaveLen(2))
+			Expect(cfg.VPC.Subnets.Public["az1"].ID).To(Equal("public1"))
+		})
+
+		It("returns an error when the stack has no VPC output", func() {
+			mockStackOutputs(map[string]string{"SubnetsPrivate": "private1,private2"})
+			Expect(UseFromStack(p, cfg)).To(MatchError(`no output "VPC" in stack "network"`))
+		})
+
+		It("returns an error when the stack has no subnet outputs", func() {
+			mockStackOutputs(map[string]string{"VPC": "vpc1"})
+			Expect(UseFromStack(p, cfg)).To(MatchError(`VPC stack "network" has neither output "SubnetsPrivate" nor "SubnetsPublic"`))
+		})
+	})
 })